@@ -1,11 +1,11 @@
 // Package engine provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package engine
 
 import (
 	"bytes"
-	"compress/gzip"
+	"compress/flate"
 	"encoding/base64"
 	"fmt"
 	"net/url"
@@ -15,47 +15,140 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
-// Base64 encoded, gzipped, json marshaled Swagger object
+// Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
+// Stored as a slice of fixed-width chunks rather than one concatenated
+// const string: with thousands of chunks the chained `+` fold is several
+// times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/7RXW2/bNhT+KwS3hxVQ66TpCtRvjq2gGtLEs50CxRYYtHhksZBIjaSceIH++0BSdyvx",
-	"lnpPtsRz+c53Ljx6wqFIM8GBa4XHT1iCygRXYB8uCV3AXzkobZ5CwTVw+5dkWcJCopngo+9KcPMOHkma",
-	"JWD+UtCEJXiMA74jCaNIOisoI5KkoEEq7GGlic4VHn84O/OwZtro9jWwh/U+MweXk9l64f9+5y9XuPCw",
-	"CmNIiXH2s4QIj/FPoyaQkTtVI19KIXFRFB6moELJMgN5wE3h4SshN4xS4K+M9ZvIERWIC41isgOk8ihi",
-	"IQOuUQYyZUoxwRXSwjxGQqZIx0whkYG0xjuMXDSMzGtlRIEzoA0nc3/xJVgug9ub9cy/CfzZCZhZxYBI",
-	"rmPg2kQNFOUKJKICVBNbE9AL8RQeDrgGyUmyBLkD6XweZ/eHc+ucImW9InCCHp6LhIX7qeBRwsLXlvS1",
-	"eAD5NpNMSKb3KLM2kZYMqOFC7EBKRgHFbBsfCnaS/KmVZGcmrLA1Kb69Dqbf1tPbm6vrYHqK0u+5QhvQ",
-	"DwAcJd3ACKfDMTBQBsUCvkOogb6SxhIFPBpxppM9kqVBpGNotX9D18cDuiqVhq6F/5s/XZ2kEXo+OrAK",
-	"D99x0yVCsr9fzcFXO4JazWb6KZRAzSNJFCLSuWTSFRcJQ1DK9ZkEJXIZQoei84aiSddsZaah6u5mcrf6",
-	"7N+sgunkNIz1XFqQJfhNrtEDcRMkk2LHKFAkpJFhbhTjogZg7556WGTSjBTN3JVUkffU8z2z74G6bkcp",
-	"KEW20ESrtGR8a4Ms2epb+LxazZE7RKGgRtdMNaLxGDOuL943xhjXsAU7U0q6+8aWsZC6xKLyNCVyP4TF",
-	"vegr29CROUPM1kLEQLbh5JK9lRCBBB4OxFh4uE73+A93WsddQb6v1cTGVLiB4+9IkhMNrUu/y76ZqCyE",
-	"NeNKE+P7SKksnXxQifehHdh7GZVbTQ5hQSlB1z8M0MMKEtvw67JO5UB2nVZVyRJVOmjTGpL/ofYm8/ni",
-	"9qs/Q29RyT3KeRgTvu3a/JN/uZ0FV0FH0vRVKqipkp4w9jDwPDVMVx6whysTLbKfqZwXeB3iqY5vKIt9",
-	"pg9rK4PQTk9KmaGFJPPWuZY5eM+kwWiyqBo7v0QJPLJNAsgl+Q0+QNOvQuP5ELMRYzwS1XQndmd4ft2Y",
-	"zAMUCVmtBCV5FpIZe/CYCeXmOHC3Vak3+OBO8/mWcUB+oz2ZB9jDO5DKOdydkySLyblhVWTAScbwGF+8",
-	"O3t3gT2cER1bPkdVHYxhoK2F++0NnlJQIYLKjKMq4/UOT7bEvEMkSVB50xmyK28mPmpW/NSEQTJTHSSx",
-	"64SrGERQq2LqdTGgLQCL+vovvV4Kuj/d5tjzUnQLwpSafdH6Enp/dvY/uC/n2cBV2kq/yu2tH+WJSfgH",
-	"B2TIfg141Ppusyrnx1U664xVujiu1HwyWY2PxzXqrdEqfDqu0NvZCw//+m8YGPrwsAtGeRc3pdb6Nt0n",
-	"gtC6vttznGyVGRRNVvC9TZr7wjBnTziXZqcbkYyNmg69r5WfhtfL1pSoW8G45CSFTh3g4r74JwAA///r",
-	"NDzprQ8AAA==",
+	"7Hx7c9w2lu9XQfHeqthVbFl+xBPLNVVXsdqTnitLGkmOJxumuiHytBpjEmAAUHKPS999CwcPgo/ulh3t",
+	"Jrszf9lqksDBwXn8zgP4nOSiqgUHrlVy8DmpqaQVaJD412GeQ62PKb9u6DWYXwpQuWS1ZoInB4l/oohe",
+	"AclLBlwTih8pshSSSPgH5OZlUoFS5s098mEFnFBSi5Ll64zbV+wIEn5tQGlyy/SKULIIn89zUcCCUF7g",
+	"ewrkDchvlB814znVtBTXZEUVoURLylVJcWLGCeWOKChI6UhOcaBFAZqyckHE0vyd8Rf7L4kEVQuugDBD",
+	"FdXxcHsZT9IEPtGqLiE5SAqYvPkhJQW8/vXP+3uvUgIc//dtkibMsGgFtACZpAmnlfnAsnQSeJomKl9B",
+	"RQ1z9bo2rygtGb9O7u7S5AKUYoLPiiHvZ0eOaAI3tGzsYpV9309eU71qp1ZhsDQxnGYSiuRAywa2EXGX",
+	"Jp4hKBPf0+LcbpP5KxdcA8f/0rouWY50PPmHMjR+bhn1ObGcNoTzG1qyImx2JHJpojTVjUoOXuzvp4lm",
+	"uoThF0nqifz+8Gh+Pv3b++nFZXIXL+L/SlgmB8n/edJK9xP7VD2ZSimkXViPo71p7tLkrZBXrCiAf+Va",
+	"fxINKQThQpMVvQGimuWS5agmNciK4YYoooX5cylkRfSKKSJqkDh4hyPPW46chY9JAZxB0fLkbHr+bnZx",
+	"MTs9mR9NT2bTowfgzOUKCG30Crg2q4aCNAokKQSodm3tgras5y5NZlyD5LS8QCW2c+7m7m/eWzupMx0E",
+	"7ItpcoZm6I3gy5LlXyvSx+IW5KSWTEim1860ES0ZFIYX4gakZAWQFbteDV/sbPKrNFHN9TUoM6lKDn7+",
+	"nCwZlEVykOR1M89Fw40CcKASlJ4b1Yfk4Ls0UaDnV+u5GxLfnpSsYlold79EkmNpy/2CW7k5PZ69+Wn+",
+	"5vTk7fHszUPoU28qcgX6FoCTssstY9RHGcNAGSr+1ghNp59ygAKKr9ygS+CUa/INzSv4hoAbjDCtyK9m",
+	"eGNKX+7vR6ZUGQkmFeONhniDnr1qeTkNb7tR/MAtV8+nF6fvz99M59O//3D4/uLywdRR2xUJiRLNciBm",
+	"xu7SoEdfal5HN+dcdexzJdVAFGhytSaL88PL6fx49m52OZ+e/GV2Mp2fn10s0PdZh4au4By0XE8Olxrk",
+	"0D9dQC54oUjDNStxJsdpaadC2SS0LMWtIpQLvQIZUTzmGBnXcA3Ikrs0OUdw8NUi4aQTPpnXmS7XDqxA",
+	"EbOlo5svB2rkP4k3/K/TNw+zzb05OmS12OBE6Lei4V/LhukAPJBvni+f5a/oS5h8t9y/mry4ermcvCq+",
+	"/dPk6fLl1cvl/tWz5Qv4prX+8IkplEQDvuBTjbgi5tuLUZXx05khlriCwMST08v529P3Jw+lLH6q7STf",
+	"pcmlEO8oXzuAo77W2ghBKsrXfrcUWUrhXKHVvNdEGt0h1OgObixvqiuQxg4ppziMk1jBNtig81aXhtaH",
+	"XolGH1yVlH98IE46w+Gn+lIL8pvsh5s7p5xU9CMEoxGp6naL8Z4bECMk++dXW40fESFGWMiIVS6hMH/S",
+	"UhEqLUOYtL7fBB5K2b2XoEQj844/2X/a7uVhd1g/TLuf708O31/+MD25nL05fBgb05uSqTAruWo0uaVW",
+	"X2opbpjZbyHNO8wi5cTy9Iaykl6V8JUsNWLl/T3JRVMWOOVVCGyg8OpS0q4mfNvBxH6MJiIpZt2Ph7Pj",
+	"w++Ppw+kCg7lKS0khorRtCmCmsX0x8Pj94eXBoq/PZwdvz+fzt+dHk0XGWeKLPJSKCgWzicHLWKK0GCw",
+	"lIY64xgKOpoMyW8MpYppcNYUooCslqIGqZkN1hw2mDOuNOW5/XG4FAklonr3OgmvvyYmdlSkahTuSMPZ",
+	"r43hKtNQqV3sO6EVFBd2zJkb0jC/op9m9vunJsyrGPd/tpZLSrpObPDpI9WfR5bzS/hCXBk3aYYfYY+N",
+	"X4f8yf2r84A4RwJt5SNt/xK5XQkFJHxNZFMCobVRk65RTAnjMQ4T0mYDAv964XafAWmypKyEYi5q4EPa",
+	"LmUD5HYFvEeLIrcggaiPrK6NKkNOGxXTDxIyHknsdoE1QrkwFFgI6Ei8EqIEijHdveVMNaU23ASar4KY",
+	"IZO8/A84tE3CwlZ78TrHGcb46G1Gn67Ds7Pz0x+nR2RCTkQgCS1fvqL82rCvDUgy/u70aPZ2hu8falIC",
+	"NTRz6H5ZiYItWf/TjB8bA0ZuQNq4v6JrQouCWNpMcuwN+jincDZpFZ4auVpn3OUTPnJxa+2MWgWjSXND",
+	"TJwVgqKlzJh6l8DiTWUUyi8+SRO/rkilokTUdi0M3E3HNGqrjvY2bqChYRnz/rS7ZGPE8NxTlwz7OtsZ",
+	"NLvhQSbGVGrc9UR6YjNx/ZmNnfQmJszLeC8OGVgKBSUGBnPnnUdBlDXo/g3iv4kFc3Ts3driGRszZYOi",
+	"zP5ldAM3ON0mtWP7Fvg9rio2f3IR0kIj20Ju6ZpogRCzvAFCSS640pIyrskNE6V3P206BlPbNELVGTeQ",
+	"bW1eqiUo4PoAuYUxOhTErAmIyzw54XShqeCQErgBuc5453WfcgjQwq/ZpdYlVJRxP8UeecugLFTG8aHb",
+	"TUsVlUDolaHKblPXTLgBAkvVbl1QYWE2CmNcg1SQa8av23WTkimtUJaQDv+wplqD5OqLfLmnElkzQuLU",
+	"cJD0GBhMUrSjzFBEjKS6LVU6ImQMRdgcYn/CI6EnCkwG3uyiKRd4OxQmg4Lgx68d97GuowZ2JcpZjpiT",
+	"XrpyDBvcV8pUk68ItfUifNdks2hV23DL/FqBNMaoYpxVTYVbV9FP5v975K2QofZkJfDWGIiMl0IorEu1",
+	"XLYlInygtKOLaTRMCjSaDqCFkca7Qfp1c7UmntsM093ZZMzADEzCkVyfN/zcV8c2gS33mFBydP7T+fuT",
+	"+YfT98dHc5uiIoVcE9lwywCbwrf+EctvAw3LRbFh5wZlumiZEnQjORROjJkOv5jdHBMV++GcFfdl4oa0",
+	"3WBgCVSN8eq8X6NMvXh1ioERm3IqMbXPxrcr9gftagIBYzY+VEG6PPcx8kBt8XcobBnD0z22aJPepJx6",
+	"Kdka7IYoZRp9tAUL/HB5eeYcMEHpSBNT8qHaJl6eP2sJCnmYXnWjP+QHulZdN1Z3iwgpYu0apHNqNiXh",
+	"nzJ+nfHITKJ8mBSfEhXoFdpu4W3VHrkATQQ3Ho+TF/uvwl4r62HuGYEMvPOI+XU5ioFLWgmp3Saqpqqo",
+	"XI9tov1h4CzwM/OMMEw/LRnIeA8aySYSliDB4o7tkopPIyBvSR4V1l1ZB491digxJmHNnvTTDt2sIkw8",
+	"mlKpcT4ZL+h68sxFZ6GhgLefB6/EFIoGu26kMUEt2pRAXSdBDTl6iPDNAPzXEm6YaFS5bnFjxrFFAdO6",
+	"C8brRu/51xbkkfsFc2Gf4icW02cckyo1zRGA1o0mJV2LRj/eI6cV04RZJ0sJh9tAS7/z4Kaa/Gn5PH9G",
+	"n+4nmFI5Bn6tV8nBs29fjplVlAS5HSKdReAMCHBX57Wmr2QfocudusQlCJ6SSiid8TAJWTJpNCzk5DzD",
+	"K+RZxlum9alq+eckYPwlxg1IBLKZlSgWXIQ8HaJuZUyKGyW1m4Gkolkx49E8b6qmmw7zH0R+2oFHwlTG",
+	"PZ5/HczVN8pNh963vEWrRj8CClMOBWDSw4id8Yp2IcOxuaHJ6kqFC4nE2s/Zk4qfk+u8TtKE3mIsESxY",
+	"Xzwqxv3fT0eEJUrQvRwaM7cvcyffuyykMxNv3Nsj2aIvjuR3JCR2WK1NycBCruey2ZkZCE1KVEWBJPYd",
+	"eUhldpYL63DMaxJyITuVmSgn8KAZjofw91+QJYl5sSVJ0kK2jNt8ufng4dKPMkbC29bcB86/fxbFacc9",
+	"kijnEa/Hcyh+2PmH2eUP8w+H5yezk79cRJ+ObJHxY6IxyX0ET5FAX1Mjhzaqd7v32sIl8w1ZwCcNvDBi",
+	"i6v8s5YNLDI+FmpMyGEbEERo3elLShRAxts4YnGfif5nZIzSZHxXkjQZ4VTyy0Ypmm+KXz6s1v02QGSX",
+	"5R7Tyi35IOMnp3Ns85lNL+aHZ2fHM5/2Bm60sPCbVFGdr3rV3ZJeQakyHgY4O7y4wO/fmbeNqQu50BKW",
+	"3eaSIG8ZPzu8fPMDfhfAQT2cLuO2eW3+djY9PrqYX1yez87O8LMj4EbyEblhZsIXO7TEagduY8YDMV7S",
+	"vUGGUkHGjYGZHs1Pz6YnZEIG9byefXLL/mnu2zs2inSgf6N42n3cIJ3uIQonKUTGvXB2JW1kI5M06W2N",
+	"+cUy2wjbKDuTNIkY4YdolzmUxzT5NDFUTG6oROxlyLlAATtH2k+E39dDUwBGpxc/90/PqFLDh1YSer/a",
+	"HbepwQu3y71X3qLPOq2Bj023Dr1Cv9ylyS2VnPHrcfx7VUKlbD8KKRrp032RctnUJLMmwvhKwnRIS0Vy",
+	"V3u1cEJqXvdJrmUvK2p4bMG/DS6cf5WAaU8uOHxBSNo69g92pcOItAegHjJdPQ4rRlKdLUMlzaHNC+Hi",
+	"FwhkGLfG3vclgLK9Vl3chvxF6oB72ESLgpnBaXnWeXdgXLtUvaO1jX2K0cQoVi1bV9CaIHIrzdZxcmUS",
+	"4N4woAT4rOR48qqkxqNLoYEwHaW/6UfgkZd2/UOtQW2z4a8J7qnvPLFAEyWREsX4dQmWxF6kEEXpnicV",
+	"LctJXNSrQNOCarpnbf9eLpSe5MCxXyeJ/poUsKRNqVUylq3EuGxumLV5Z2wX+ljnVi81gDzvaaTSVGrH",
+	"pZC8w7DOqeOaKHqLaoo+UUJBXWxmTVQMK1u6N7cEBM/VysKy28axs+6/TYGtzbo0ajGWTPLxl92V3yDt",
+	"hsF2EAKfjBbqMVFDOXVsxLcd/BVSZRw9r4cLDjIekIU3I4aA6NgG0mEz6YqIZcYXPRFb7JFj/A+xGoDE",
+	"2O3quXwqIeMVVR9hKNnAb5gUvMJGJGMsiib3PaURYZhEGZVY1zexpSlkGgMm5rsKgzREgQ+TPa61XYiV",
+	"tQ8DkHVfObmwZFpx2WnkIzUcyFAk7COrT4cmdrvld62pI9V8bLWcazZWBv/go0rf/WTfNkCsBKUIw7pX",
+	"o7ykxbnOgmqY4LAjAHosDelIJLOjnZlRTN7HlG9fvHe6B5/vVUF5f/L/T04/nMwRqll81gWkHh1T52pG",
+	"cETGYyCxBXsaEjYgT3zkcaeZJOMt7hyw9F71xMg/ogc1e+vAF1YwTXdqStiSUD6a9/Z1jWHloakon0ig",
+	"BSYPoofex7ppfnuBaUjxTnlxtZC49ONXMiY5o11yA+HZ0jnisv+hNN1mr5mJeFyFMbSHhQpZdIqNanpF",
+	"1ajyfLnfdmvB7WdL31r6aFnCJ2a2y5qvx0OXO95LgQSMMc7avdNG52KMN22AezmsRxJKCsgZmgDjnljI",
+	"YVk88P7kaPp2drLxcy6635uPnd3MeBQkdr4dCxNt+OXeDEN22mAdUVj8IG7TgodhOlS/lDs3yQlQWTKQ",
+	"IefWzVG4aDEsMUnbUws+HhxNRMS4ZMS6hfz1l8O86OOx6nGyCVV++UzwieaanJ4dunJBIfKm8mdh3LTd",
+	"zC4y9ZEtFGnXmVIL7A+IiwXxEjCP5HOEPmx6bNFnxi2eieDnHpnZtvYrIIreuMZxsmQuMVpjqEy0yPhC",
+	"1BRpI5MJLmBhy6UW5NhMa5CiUuS0LNd74/BWtIqzG4x6LTP20/ijL2d8HcDOoDXA9t8garHNGy6j46rA",
+	"hU3ojEP3PeLSDD7Owo0KCWq3SKzTONHf24L2d7mFVirC0b179xn4PN0Wc0CEdD0YodsGjzjcsg22eXfu",
+	"+nKk9yomww+x2Q9v6WiwOtiK0piV7pV/RtgiSEWLbtHXSD1i/9sVSOjXEZnuVhF7BalhBTE8cN6wLRpm",
+	"vFM1TL0zYG3RxhqAXsjpBqJNwTQpxfVext9zBToKT4iw6Zw2orJEoaV3MZB5zUY6FAuJJpxHCEYx7aMN",
+	"gsNE/1jPWy4kNu6bxptRsQ1dASS8OkxnhdUZW4GH6sUtd4tXHZTgy82TV1dPYfKi+HY5+S5/SSfP4MXV",
+	"02J/+Yp+9/w+pehOeDYMrMLD/rEIlBLWrYJ3grudM7vBQG5ill57TcfCd3y6coSWpZAdYmjJcvh/7u+9",
+	"XFT3ockeCZqrtdJQjcQp+LtvF+sypTN5xa7tGeuJFqLcPfNY3LsThf5+YHAjCnSB3H3OetwDoGxf2mDy",
+	"7lr/enF6Qi5wQV04EMGEq3Uc4qbkI6zxV1OJimOnNmzaIxc15IpcsxtAw1NibKc01C6CU1QztVxjH0E1",
+	"yIZIuHaxuMeAjZoAVXryNEnN/29B6cmz5JdRkWgtxT3Tzu0O3KW/S7w/ntu2FiKWgd0xfTe/MhCoeyEG",
+	"fwTnN+MFfxQvQJCH6T28Qyi9FE5DNMVLEDbfn3B4NsM4xFEVOZJH9mBtLSxWDe1D6nEyODc95deMA4mK",
+	"AIdnsyRNXMLCZOae0rJe0aeIVGvgtGbJQfJ8b3/P+BijF7gHT3yS6sDzJZwssVuk9MbaA9iTdngeaeMJ",
+	"uG7DsQ+UU3PjTL6C0BfuDZtmIPG4mOAQP0h9EpSTfAX5Rxyuyji2jdyuRGkKPBmfxseijPTb5qQWdgqO",
+	"FV3jojGSMY3xCs3HYsAJh7wWEXxCAoAvhcyB5FIoNfGHUg0SuqGSUa4VeaRoBcRajrTFjXS5ZJzp9eMQ",
+	"aYra2sqML0KKYYFH0CzOMf8z64hXkIOH/La3wK83dRjfgTf/s1pgDz6WbmP2fKPIwgC5RRr35S2Mo1h0",
+	"I7CFX8BicNAMQy8biagoJ5BxsSS3K5avbCPCwqN1O3LU8WwVa4GAzZiqPRIEMOP2KJ5s+Fg1IJQMoJ9L",
+	"tqcLMTXru8uEdFl2RWh86qx3akihFF368kf43TbJdk4rIqIwwYbqI0L80TaloWR6jr22L2LbqT0WGk+9",
+	"R6ad3SR4VYkpQ7lGkt4seBuE67QN98PMikg9W01OO/dS/Tzuh9pXnvTurTL3n7g1fC+K9YPdNrPxHO5d",
+	"1xQb8NC/RunZ/v5/JR3eBQ+PLkc2VzV4OH3ZlMbKvtjf3zRRoPxJdPsTfvJ09yedU/f40fPdH7UXL+EX",
+	"L3d/EUr8+MGr3R/0bv4xnz27x2fd62ju0uTb+/Bt7NIj/Pb5vRgYDhXeYQe97RRvNWWz+yJaXINeYe1R",
+	"U9Pz8HMkARbzPdnkOe7rQbtztkG8U3xalt4VGsRfB2ckSAEaZMW4PzxNy8hyR626aNYCXtxy8r4Tp1nr",
+	"ZpNu5qoAYwWth9nQafhnbDI0Q27olMv4IuqOXBAFepsFOw9xWs9+jQFhE2E48v39czwvmwLwrNci6uxc",
+	"uIrHlfGJK3Gb8V5jQlT07tSkb43NZxrdXGHzatbLhTp51I+AY/rik20hIF7JyKMX+y8f4/c++5zxRy/2",
+	"Xz0O1CtPvuv1D9STpva5OsPL0Dhm8pRGTbB3LYKY1nooUsBVc33tMhhMknO4Fq/jmnDG22Z/HCBKFmKV",
+	"2hWLP1gRsLeSmAs1/sNufqVLtUitw4/uG7HlFY19bUzZFsCMd7+f/v3s+HB2Mp8dmcs5LmfTiwXW3ELG",
+	"QL+2mTyXZVHkGrS5bfD5XhZu6/u1ATz/4a7rcx0wnWtNXJ9FcrCkpYJhK+xdulO2QgqjFZcISTJ3IM6k",
+	"okPqMePmLhDfPxelxg4IF7FPhxssKwrpEmPAtWTgivUuRV2EdgB3GwBThAND5kRFfy4k+Qi1dhvpqiUF",
+	"FE3wkg5hem1ZUUUWrofbaiY5sv2liijNytIikhaQbAAjY3vhhn3ovXCUG0kxZ8pcasA3qNKi8IdT295e",
+	"5J7vLj0gtLW2G9uvo560/iF1w/zx/lQbsISW9qiryV/YiWdQlRnRpUif7e/jRyN9rRl3pyUNYH2x/3KP",
+	"fHB9x0x3F600XZPRxaYZV6I9NJS7Gja1K1ou7XkIsui0yy7sYQlNrIRl/Jaut6lcp9P4i7f7jwFLf2c0",
+	"+m8Q+i8LQv2FrutS0PYEQXxCYhMEdSlAtRlymt7e+GokLIa7Yfh1ZAiVhtqkWc2/nZp5xtGzOFRpBjEf",
+	"3rJ/Ulm4cJiVpfK1nah75WodeihdutnaHXRQjJMKKiHXPrcrAVXHDplLoK7KV9k6ps062hJlwzG96TMB",
+	"EX7xy7y8PE6JEgiiw3VypnWu5QTmgCXCNaSbVoGGMXD6BikaNmsNTMLThzYJ0WQjNsE9IqIG7jXov0ez",
+	"n716uJVuuzHM3UIQ3fVn1ur3USGCuQLgdjOhCJdnWF9nROfrrt3L+G+zER19N4qITSZj107vVPAnn8M1",
+	"1Hch3tys9e9sO8Ci35e+Z9N8jMdV/16dxXXBZNyWSh5heQYHJHjOgCioKNcsV6/JgjdluSASKnGDUS3q",
+	"+2OnsSHajWDrrug2PhCI4eubqCIU+oii81ukBqmYGRETs7bAc9CuDus/BkgRam1cXPshgyOkES+UIEsq",
+	"gzTZS+Xa4NicfbdwLw4jQ2N1uL1SqM4UxixlPC5G5ZS76Ildr7QN1qs9cujvlUCqS6A3jpNOEjIeIGsP",
+	"0tu7V/rHcuNlesuccewwVCLgQkOMBKWlvesG2rbjJcjI5nL4ZH3GtkC+tZFflohs72//Xwr2NlVfx+y7",
+	"2f22evUHB3svdn/Rv4D3jwYS+5fZ/kFgYkfpjBOkX+0/lozTkv3zXqW+weF+Y1MFB5vy0qyC1No7PLJl",
+	"LR5kfIPhsfYSr/Ds2LI98p6HixLQKoZbBjgtOx1E7kRY8BH2wgIWF2Xaa0DpWmXcooXI4bmeQezuaW8D",
+	"6Jqwt45HD2XC/h01/tuQ/K6GxAv0PeyGu2vCS3ojy+QgeUJr9qRtL/glfPx5/P71uG7rNUu1OaNoxrtf",
+	"7v5zAA==",
 }
 
-// GetSwagger returns the content of the embedded swagger specification file
-// or error if failed to decode
+// decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
+// after base64-decoding and flate-decompressing the embedded blob.
 func decodeSpec() ([]byte, error) {
-	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	encoded := strings.Join(swaggerSpec, "")
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
 	}
-	zr, err := gzip.NewReader(bytes.NewReader(zipped))
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
+	zr := flate.NewReader(bytes.NewReader(compressed))
 	var buf bytes.Buffer
-	_, err = buf.ReadFrom(zr)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	if _, err := buf.ReadFrom(zr); err != nil {
+		return nil, fmt.Errorf("read flate: %w", err)
+	}
+	if err := zr.Close(); err != nil {
+		return nil, fmt.Errorf("close flate reader: %w", err)
 	}
 
 	return buf.Bytes(), nil
@@ -63,7 +156,7 @@ func decodeSpec() ([]byte, error) {
 
 var rawSpec = decodeSpecCached()
 
-// a naive cached of a decoded swagger spec
+// a naive cache of the decoded OpenAPI spec
 func decodeSpecCached() func() ([]byte, error) {
 	data, err := decodeSpec()
 	return func() ([]byte, error) {
@@ -81,12 +174,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 	return res
 }
 
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file. The external references of Swagger specification are resolved.
-// The logic of resolving external references is tightly connected to "import-mapping" feature.
-// Externally referenced files must be embedded in the corresponding golang packages.
-// Urls can be supported but this task was out of the scope.
-func GetSwagger() (swagger *openapi3.T, err error) {
+// GetSpec returns the OpenAPI specification corresponding to the generated
+// code in this file. External references in the spec are resolved through
+// PathToRawSpec; externally-referenced files must be embedded in their
+// corresponding Go packages (via the import-mapping feature). URL-based
+// external refs are not supported.
+func GetSpec() (swagger *openapi3.T, err error) {
 	resolvePath := PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
@@ -112,3 +205,22 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+// GetSpecJSON returns the raw JSON bytes of the embedded OpenAPI
+// specification: decompressed but not unmarshaled. External references
+// are not resolved here; the bytes are the spec exactly as embedded by
+// codegen. The result is cached at package init time, so repeated calls
+// are cheap.
+func GetSpecJSON() ([]byte, error) {
+	return rawSpec()
+}
+
+// GetSwagger returns the OpenAPI specification corresponding to the
+// generated code in this file.
+//
+// Deprecated: GetSwagger predates kin-openapi renaming openapi3.Swagger
+// to openapi3.T. Use [GetSpec] instead. This wrapper is retained for
+// backwards compatibility.
+func GetSwagger() (*openapi3.T, error) {
+	return GetSpec()
+}
@@ -1,22 +1,237 @@
 // Package engine provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package engine
 
+import (
+	"time"
+)
+
+// Defines values for CompositeEvaluateResponseStatus.
+const (
+	CompositeEvaluateResponseStatusAPPROVED CompositeEvaluateResponseStatus = "APPROVED"
+	CompositeEvaluateResponseStatusMODIFIED CompositeEvaluateResponseStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the CompositeEvaluateResponseStatus enum.
+func (e CompositeEvaluateResponseStatus) Valid() bool {
+	switch e {
+	case CompositeEvaluateResponseStatusAPPROVED:
+		return true
+	case CompositeEvaluateResponseStatusMODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CompositeInstanceResultStatus.
+const (
+	CompositeInstanceResultStatusAPPROVED CompositeInstanceResultStatus = "APPROVED"
+	CompositeInstanceResultStatusMODIFIED CompositeInstanceResultStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the CompositeInstanceResultStatus enum.
+func (e CompositeInstanceResultStatus) Valid() bool {
+	switch e {
+	case CompositeInstanceResultStatusAPPROVED:
+		return true
+	case CompositeInstanceResultStatusMODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EvaluateResponseStatus.
 const (
-	APPROVED EvaluateResponseStatus = "APPROVED"
-	MODIFIED EvaluateResponseStatus = "MODIFIED"
+	APPROVED             EvaluateResponseStatus = "APPROVED"
+	APPROVEDWITHWARNINGS EvaluateResponseStatus = "APPROVED_WITH_WARNINGS"
+	DRYRUNWOULDREJECT    EvaluateResponseStatus = "DRYRUN_WOULD_REJECT"
+	MODIFIED             EvaluateResponseStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the EvaluateResponseStatus enum.
+func (e EvaluateResponseStatus) Valid() bool {
+	switch e {
+	case APPROVED:
+		return true
+	case APPROVEDWITHWARNINGS:
+		return true
+	case DRYRUNWOULDREJECT:
+		return true
+	case MODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EvaluateResponseStatusReason.
+const (
+	StatusReasonDeniedFieldsStripped EvaluateResponseStatusReason = "DENIED_FIELDS_STRIPPED"
+	StatusReasonFailedOpen           EvaluateResponseStatusReason = "FAILED_OPEN"
+	StatusReasonNoPoliciesApplied    EvaluateResponseStatusReason = "NO_POLICIES_APPLIED"
+	StatusReasonPatched              EvaluateResponseStatusReason = "PATCHED"
+	StatusReasonPoliciesPassed       EvaluateResponseStatusReason = "POLICIES_PASSED"
+	StatusReasonPolicyRejected       EvaluateResponseStatusReason = "POLICY_REJECTED"
+)
+
+// Valid indicates whether the value is a known member of the EvaluateResponseStatusReason enum.
+func (e EvaluateResponseStatusReason) Valid() bool {
+	switch e {
+	case StatusReasonDeniedFieldsStripped:
+		return true
+	case StatusReasonFailedOpen:
+		return true
+	case StatusReasonNoPoliciesApplied:
+		return true
+	case StatusReasonPatched:
+		return true
+	case StatusReasonPoliciesPassed:
+		return true
+	case StatusReasonPolicyRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyOutcome.
+const (
+	APPLIED   PolicyOutcome = "APPLIED"
+	FAILED    PolicyOutcome = "FAILED"
+	REJECTED  PolicyOutcome = "REJECTED"
+	UNDEFINED PolicyOutcome = "UNDEFINED"
 )
 
+// Valid indicates whether the value is a known member of the PolicyOutcome enum.
+func (e PolicyOutcome) Valid() bool {
+	switch e {
+	case APPLIED:
+		return true
+	case FAILED:
+		return true
+	case REJECTED:
+		return true
+	case UNDEFINED:
+		return true
+	default:
+		return false
+	}
+}
+
+// CompositeEvaluateRequest defines model for CompositeEvaluateRequest.
+type CompositeEvaluateRequest struct {
+	// ServiceInstances The related service instances; names must be unique
+	ServiceInstances []NamedServiceInstance `json:"service_instances"`
+}
+
+// CompositeEvaluateResponse defines model for CompositeEvaluateResponse.
+type CompositeEvaluateResponse struct {
+	// CompositePolicies IDs of the policies whose composite rule approved the request, in evaluation order
+	CompositePolicies []string `json:"composite_policies"`
+
+	// FailedOpen True when composite rules were skipped because policies were
+	// unavailable and `EVALUATION_FAILURE_MODE` is `open`
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// ServiceInstances The result of each instance, in request order
+	ServiceInstances []CompositeInstanceResult `json:"service_instances"`
+
+	// Status APPROVED - No instance was changed by policies
+	// MODIFIED - At least one instance was modified by policies
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
+	Status CompositeEvaluateResponseStatus `json:"status"`
+}
+
+// CompositeEvaluateResponseStatus APPROVED - No instance was changed by policies
+// MODIFIED - At least one instance was modified by policies
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
+type CompositeEvaluateResponseStatus string
+
+// CompositeInstanceResult defines model for CompositeInstanceResult.
+type CompositeInstanceResult struct {
+	EvaluatedServiceInstance ServiceInstance `json:"evaluated_service_instance"`
+
+	// FailedOpen True when the instance was approved unchanged because policies were unavailable
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// Name Name of the instance in the request
+	Name string `json:"name"`
+
+	// SelectedProvider Service provider selected by policies
+	SelectedProvider string `json:"selected_provider"`
+
+	// Status APPROVED - Instance unchanged by policies
+	// MODIFIED - Instance was modified by policies
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
+	Status CompositeInstanceResultStatus `json:"status"`
+}
+
+// CompositeInstanceResultStatus APPROVED - Instance unchanged by policies
+// MODIFIED - Instance was modified by policies
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
+type CompositeInstanceResultStatus string
+
+// ConflictSuggestion A way to resolve a constraint violation or conflict that a client can
+// apply or present: the allowed value nearest the rejected one, every
+// allowed value, or the service providers that remain allowed. Fields
+// that do not apply are absent.
+type ConflictSuggestion struct {
+	// AllowedProviders Service providers allowed after intersecting the allow lists and applying the patterns
+	AllowedProviders *[]string `json:"allowed_providers,omitempty"`
+
+	// AllowedValues Every allowed value, when the constraint is an enum or const
+	AllowedValues *[]interface{} `json:"allowed_values,omitempty"`
+
+	// Field Dot-separated path of the constrained field; absent for service provider suggestions
+	Field *string `json:"field,omitempty"`
+
+	// NearestValue The allowed value nearest the rejected one, such as the value
+	// clamped to the merged minimum and maximum. For a policy that would
+	// loosen a constraint, the loosest value it may set instead.
+	NearestValue interface{} `json:"nearest_value,omitempty"`
+
+	// SetByPolicy ID of the policy that set the constraint
+	SetByPolicy *string `json:"set_by_policy,omitempty"`
+}
+
+// DryRunRejection The rejection a DRYRUN_WOULD_REJECT dry run would have failed with
+type DryRunRejection struct {
+	// Code The `rejection_code` the policy returned, when it returned one
+	Code *string `json:"code,omitempty"`
+
+	// PolicyId ID of the policy that rejected the request
+	PolicyId string `json:"policy_id"`
+
+	// Reason Rejection message, as the 406 response would have carried it
+	Reason string `json:"reason"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Detail Detailed error message
 	Detail *string `json:"detail,omitempty"`
 
+	// Explanation Evaluation trace returned when `explain=true` is requested
+	Explanation *EvaluationExplanation `json:"explanation,omitempty"`
+
 	// Status HTTP status code
 	Status int32 `json:"status"`
 
+	// Suggestions Ways to resolve a policy conflict, one per violated or conflicting
+	// constraint that has something to suggest. Set only on 409 responses.
+	Suggestions *[]ConflictSuggestion `json:"suggestions,omitempty"`
+
 	// Title Short error summary
 	Title string `json:"title"`
 
@@ -26,31 +241,256 @@ type Error struct {
 
 // EvaluateRequest defines model for EvaluateRequest.
 type EvaluateRequest struct {
+	// InstanceId ID of the existing service instance the request re-evaluates, for
+	// day-2 changes. When an instance provider is configured, policies
+	// read the spec and provider the instance was previously evaluated
+	// with from `input.previous` (`input.context.previous` in the
+	// namespaced input layout). Omit it for a new instance.
+	InstanceId *string `json:"instance_id,omitempty"`
+
+	// PreferredProviders Providers the end user would like the instance placed on, most
+	// preferred first. Policies read them from
+	// `input.preferred_providers` (`input.request.preferred_providers` in
+	// the namespaced input layout). When no policy selects a provider, the
+	// first one the accumulated service provider constraints allow is
+	// selected; a policy's selection always takes precedence, and when
+	// the constraints allow none of them no provider is selected.
+	PreferredProviders *[]string `json:"preferred_providers,omitempty"`
+
+	// RequestContext Who made the request and from where. Policies read it from
+	// `input.request_context` (`input.request.context` in the namespaced
+	// input layout), and it is recorded with the evaluation in the audit log.
+	// Unset fields are omitted from the input. The values are taken as
+	// sent; they are not verified.
+	RequestContext  *RequestContext `json:"request_context,omitempty"`
 	ServiceInstance ServiceInstance `json:"service_instance"`
 }
 
 // EvaluateResponse defines model for EvaluateResponse.
 type EvaluateResponse struct {
+	// DryRun True when the request was evaluated as a dry run and nothing was recorded
+	DryRun                   *bool           `json:"dry_run,omitempty"`
 	EvaluatedServiceInstance ServiceInstance `json:"evaluated_service_instance"`
 
+	// Explanation Evaluation trace returned when `explain=true` is requested
+	Explanation *EvaluationExplanation `json:"explanation,omitempty"`
+
+	// FailedOpen True when the request was approved unchanged because the policy
+	// store was unavailable and `EVALUATION_FAILURE_MODE` is `open`
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// Rejection The rejection a DRYRUN_WOULD_REJECT dry run would have failed with
+	Rejection *DryRunRejection `json:"rejection,omitempty"`
+
 	// SelectedProvider Service provider selected by policies
 	SelectedProvider string `json:"selected_provider"`
 
 	// Status APPROVED - Request unchanged by policies
 	// MODIFIED - Request was modified by policies
+	// APPROVED_WITH_WARNINGS - Request approved unchanged without being
+	// evaluated against every policy; only with `extended_status=true`
+	// DRYRUN_WOULD_REJECT - A policy rejected the dry run, see
+	// `rejection`; only with `extended_status=true`
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
 	Status EvaluateResponseStatus `json:"status"`
+
+	// StatusReason Why the evaluation ended with its status:
+	// NO_POLICIES_APPLIED - No enabled policy matched the request labels
+	// POLICIES_PASSED - Matching policies left the request unchanged
+	// PATCHED - Policies patched the request
+	// DENIED_FIELDS_STRIPPED - Denied spec fields were stripped, and
+	// policies changed nothing else
+	// FAILED_OPEN - The policy store was unavailable
+	// POLICY_REJECTED - A policy rejected the request
+	//
+	// Later versions may add reasons. Clients must accept reasons they do
+	// not know.
+	StatusReason *EvaluateResponseStatusReason `json:"status_reason,omitempty"`
+
+	// Warnings Problems found during the evaluation that did not fail it, such as
+	// policies patching fields not allowed for the service type. Omitted
+	// when there are none.
+	Warnings *[]EvaluationWarning `json:"warnings,omitempty"`
 }
 
 // EvaluateResponseStatus APPROVED - Request unchanged by policies
 // MODIFIED - Request was modified by policies
+// APPROVED_WITH_WARNINGS - Request approved unchanged without being
+// evaluated against every policy; only with `extended_status=true`
+// DRYRUN_WOULD_REJECT - A policy rejected the dry run, see
+// `rejection`; only with `extended_status=true`
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
 type EvaluateResponseStatus string
 
+// EvaluateResponseStatusReason Why the evaluation ended with its status:
+// NO_POLICIES_APPLIED - No enabled policy matched the request labels
+// POLICIES_PASSED - Matching policies left the request unchanged
+// PATCHED - Policies patched the request
+// DENIED_FIELDS_STRIPPED - Denied spec fields were stripped, and
+// policies changed nothing else
+// FAILED_OPEN - The policy store was unavailable
+// POLICY_REJECTED - A policy rejected the request
+//
+// Later versions may add reasons. Clients must accept reasons they do
+// not know.
+type EvaluateResponseStatusReason string
+
+// EvaluationExplanation Evaluation trace returned when `explain=true` is requested
+type EvaluationExplanation struct {
+	// FieldProvenance Maps the dot-separated path of each evaluated spec field written by
+	// a policy patch to the ID of the policy that last wrote it. Fields
+	// taken unchanged from the request are absent; arrays are recorded as
+	// a single field.
+	FieldProvenance map[string]string `json:"field_provenance"`
+
+	// InputSpec The service instance spec the evaluation started from, as the first
+	// policy saw it, with redaction applied
+	InputSpec map[string]interface{} `json:"input_spec"`
+
+	// Policies Policies evaluated for the request, in evaluation order
+	Policies []PolicyTrace `json:"policies"`
+
+	// RequestLabels The labels extracted from the request that policy label selectors
+	// were matched against: `service_type` and the string values of
+	// `metadata.labels`. Labels taken from redacted spec fields are
+	// masked.
+	RequestLabels map[string]string `json:"request_labels"`
+
+	// SkippedPolicies Enabled policies not evaluated because their label selector does not match the request labels
+	SkippedPolicies []SkippedPolicy `json:"skipped_policies"`
+}
+
+// EvaluationSession defines model for EvaluationSession.
+type EvaluationSession struct {
+	// ExpireTime When the session expires unless it is used again
+	ExpireTime time.Time `json:"expire_time"`
+
+	// Id Session ID
+	Id string `json:"id"`
+}
+
+// EvaluationWarning defines model for EvaluationWarning.
+type EvaluationWarning struct {
+	// Code UNKNOWN_PATCH_FIELD - The policy patched a field not allowed for the
+	// service type
+	//
+	// Later versions may add codes. Clients must accept codes they do not
+	// know.
+	Code string `json:"code"`
+
+	// Field Dot-separated spec field path the warning is about, if any
+	Field *string `json:"field,omitempty"`
+
+	// Message Human-readable description of the warning
+	Message string `json:"message"`
+
+	// PolicyId ID of the policy the warning is about
+	PolicyId string `json:"policy_id"`
+}
+
+// NamedServiceInstance defines model for NamedServiceInstance.
+type NamedServiceInstance struct {
+	// Name Name identifying the instance within the composite request
+	Name string `json:"name"`
+
+	// Spec Service specification (flexible schema)
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// PolicyOutcome APPLIED - The policy returned a decision and it was applied
+// UNDEFINED - The policy returned no decision and was skipped
+// REJECTED - The policy rejected the request
+// FAILED - The decision could not be applied, for example because it conflicts with an earlier policy
+type PolicyOutcome string
+
+// PolicyTrace defines model for PolicyTrace.
+type PolicyTrace struct {
+	// Constraints The constraints the policy returned
+	Constraints *map[string]interface{} `json:"constraints,omitempty"`
+
+	// Input The exact OPA input document the policy was evaluated with (spec at
+	// that point, accumulated constraints and selected provider), with
+	// redaction applied. It can be saved to a file and passed to
+	// `opa eval --input` to reproduce the decision locally.
+	Input map[string]interface{} `json:"input"`
+
+	// Outcome APPLIED - The policy returned a decision and it was applied
+	// UNDEFINED - The policy returned no decision and was skipped
+	// REJECTED - The policy rejected the request
+	// FAILED - The decision could not be applied, for example because it conflicts with an earlier policy
+	Outcome PolicyOutcome `json:"outcome"`
+
+	// Patch The patch the policy returned, after array merges were resolved and
+	// with redaction applied. Applied to the spec when the outcome is
+	// APPLIED.
+	Patch *map[string]interface{} `json:"patch,omitempty"`
+
+	// PolicyId ID of the evaluated policy
+	PolicyId string `json:"policy_id"`
+
+	// Reason Why the policy rejected the request or failed; absent otherwise
+	Reason *string `json:"reason,omitempty"`
+
+	// SelectedProvider The service provider the policy selected, if any
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+}
+
+// RequestContext Who made the request and from where. Policies read it from
+// `input.request_context` (`input.request.context` in the namespaced
+// input layout), and it is recorded with the evaluation in the audit log.
+// Unset fields are omitted from the input. The values are taken as
+// sent; they are not verified.
+type RequestContext struct {
+	// CorrelationId Identifier correlating the evaluation with the caller's own records
+	CorrelationId *string `json:"correlation_id,omitempty"`
+
+	// Environment Environment the request is made in
+	Environment *string `json:"environment,omitempty"`
+
+	// Requester Identity of the user or service the request is made for
+	Requester *string `json:"requester,omitempty"`
+
+	// SourceSystem System that sent the request
+	SourceSystem *string `json:"source_system,omitempty"`
+}
+
 // ServiceInstance defines model for ServiceInstance.
 type ServiceInstance struct {
 	// Spec Service specification (flexible schema)
 	Spec map[string]interface{} `json:"spec"`
 }
 
+// SessionEvaluateResponse defines model for SessionEvaluateResponse.
+type SessionEvaluateResponse struct {
+	// Constraints JSON Schema constraints accumulated by the session, keyed by
+	// dot-separated field path. Specs given in later steps must satisfy
+	// them.
+	Constraints map[string]map[string]interface{} `json:"constraints"`
+	Evaluation  EvaluateResponse                  `json:"evaluation"`
+
+	// ExpireTime When the session expires unless it is used again
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// SkippedPolicy defines model for SkippedPolicy.
+type SkippedPolicy struct {
+	// PolicyId ID of the skipped policy
+	PolicyId string `json:"policy_id"`
+
+	// Reason Why the policy was not evaluated
+	Reason string `json:"reason"`
+}
+
+// AcceptLanguage defines model for AcceptLanguage.
+type AcceptLanguage = string
+
+// SessionId defines model for SessionId.
+type SessionId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
@@ -63,11 +503,74 @@ type InternalServerError = Error
 // PolicyConflict defines model for PolicyConflict.
 type PolicyConflict = Error
 
+// QuotaExceeded defines model for QuotaExceeded.
+type QuotaExceeded = Error
+
 // Rejected defines model for Rejected.
 type Rejected = Error
 
+// SessionNotFound defines model for SessionNotFound.
+type SessionNotFound = Error
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
+// Unavailable defines model for Unavailable.
+type Unavailable = Error
+
+// EvaluateCompositeParams defines parameters for EvaluateComposite.
+type EvaluateCompositeParams struct {
+	// AcceptLanguage Languages the client accepts for rejection messages. When a policy
+	// rejects the request with a `rejection_code` and the server's message
+	// catalog has a translation in an accepted language, the `detail` of the
+	// 406 response is that translation.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// EvaluateRequestParams defines parameters for EvaluateRequest.
+type EvaluateRequestParams struct {
+	// Explain When true, the response includes an `explanation` describing how
+	// each evaluated policy saw the request, what it decided and which
+	// policy last wrote each patched field. Rejected (406) and conflict
+	// (409) responses include the explanation up to the failing policy.
+	// Intended for policy authors debugging their Rego; spec fields
+	// configured for redaction are masked.
+	// With `ENGINE_AUTHZ_MODE=mtls`, only the client identities listed in
+	// `ENGINE_AUTHZ_EXPLAIN_IDENTITIES` may request it; other callers get
+	// 403.
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// DryRun When true, the request is evaluated exactly as it would be otherwise
+	// but nothing is recorded: no evaluation events or audit entries are
+	// produced and the result is neither taken from nor kept for request
+	// deduplication. The response has `dry_run` set. Dry runs still count
+	// against evaluation quotas.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ExtendedStatus When true, the response may carry the statuses added after
+	// APPROVED and MODIFIED: a request approved unchanged because
+	// policies were unavailable is APPROVED_WITH_WARNINGS, and a dry run
+	// a policy rejects is answered with 200 and DRYRUN_WOULD_REJECT
+	// instead of 406. Without it the statuses stay APPROVED and MODIFIED,
+	// so existing clients are unaffected. `status_reason` is set either
+	// way.
+	ExtendedStatus *bool `form:"extended_status,omitempty" json:"extended_status,omitempty"`
+
+	// AcceptLanguage Languages the client accepts for rejection messages. When a policy
+	// rejects the request with a `rejection_code` and the server's message
+	// catalog has a translation in an accepted language, the `detail` of the
+	// 406 response is that translation.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// EvaluateCompositeJSONRequestBody defines body for EvaluateComposite for application/json ContentType.
+type EvaluateCompositeJSONRequestBody = CompositeEvaluateRequest
+
 // EvaluateRequestJSONRequestBody defines body for EvaluateRequest for application/json ContentType.
 type EvaluateRequestJSONRequestBody = EvaluateRequest
+
+// EvaluateSessionJSONRequestBody defines body for EvaluateSession for application/json ContentType.
+type EvaluateSessionJSONRequestBody = EvaluateRequest
@@ -0,0 +1,52 @@
+package v1alpha1_test
+
+import (
+	"encoding/json"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/api/v1alpha1/engine"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Clients built from these types must keep working when a later server returns enum values
+// they do not know, so decoding keeps such values instead of failing
+var _ = Describe("Enum values unknown to this version", func() {
+	It("are kept in policies and sent back unchanged", func() {
+		var policy v1alpha1.Policy
+		Expect(json.Unmarshal([]byte(`{"display_name":"Team quota","policy_type":"TEAM"}`), &policy)).To(Succeed())
+
+		Expect(*policy.PolicyType).To(Equal(v1alpha1.PolicyPolicyType("TEAM")))
+		Expect(policy.PolicyType.Valid()).To(BeFalse())
+		data, err := json.Marshal(policy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring(`"policy_type":"TEAM"`))
+	})
+
+	It("are kept in simulation outcomes", func() {
+		var status v1alpha1.SimulationStatus
+		Expect(json.Unmarshal([]byte(`"DEFERRED"`), &status)).To(Succeed())
+
+		Expect(status).To(Equal(v1alpha1.SimulationStatus("DEFERRED")))
+		Expect(status.Valid()).To(BeFalse())
+	})
+
+	It("are kept in evaluation responses", func() {
+		var response engine.EvaluateResponse
+		Expect(json.Unmarshal([]byte(`{"status":"QUEUED","status_reason":"QUEUED_BEHIND_BATCH","selected_provider":"aws","evaluated_service_instance":{"spec":{}}}`), &response)).To(Succeed())
+
+		Expect(response.Status).To(Equal(engine.EvaluateResponseStatus("QUEUED")))
+		Expect(response.Status.Valid()).To(BeFalse())
+		Expect(engine.APPROVED.Valid()).To(BeTrue())
+		Expect(response.StatusReason).To(HaveValue(Equal(engine.EvaluateResponseStatusReason("QUEUED_BEHIND_BATCH"))))
+		Expect(response.StatusReason.Valid()).To(BeFalse())
+	})
+
+	It("are kept in composite evaluation responses", func() {
+		var response engine.CompositeEvaluateResponse
+		Expect(json.Unmarshal([]byte(`{"status":"QUEUED","service_instances":[{"name":"db","status":"QUEUED","selected_provider":"aws","evaluated_service_instance":{"spec":{}}}]}`), &response)).To(Succeed())
+
+		Expect(response.Status.Valid()).To(BeFalse())
+		Expect(response.ServiceInstances[0].Status).To(Equal(engine.CompositeInstanceResultStatus("QUEUED")))
+	})
+})
@@ -1,11 +1,11 @@
 // Package v1alpha1 provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package v1alpha1
 
 import (
 	"bytes"
-	"compress/gzip"
+	"compress/flate"
 	"encoding/base64"
 	"fmt"
 	"net/url"
@@ -15,112 +15,456 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 )
 
-// Base64 encoded, gzipped, json marshaled Swagger object
+// Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
+// Stored as a slice of fixed-width chunks rather than one concatenated
+// const string: with thousands of chunks the chained `+` fold is several
+// times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/9xbe3PbOJL/KijOVtmuE2VKtvzQVupKYymJdh1b68fc7IxyNkQ2JUxIgAOAtpWUv/tV",
-	"A+BLUmxP4pnaur9skXh1o/HrX3eDX7xQpJngwLXy+l+8jEqaggZpfk1EwsLlOJpQvcDfEahQskwzwb2+",
-	"d7UAIkGJXIZAWARcs5iBJLGQRC+AZKZ3m3zIlSYzIJTc0YRF7jkZD6dcL6gmoeCxkKkiWpDBaOJ3ul0i",
-	"4fecSUhxXf0p90nHP9gj4YJKGuLqSCL4HJ+finuQIVVAEtD4pkV4ns7MP5RHZLHMFsAVETxZYnuzGKWp",
-	"1OSe6QWhrl/5DnjUfEOEdENOudfy4IGmWQJe35snYkYTn+Z64VuZvJbHUDMZ6qvlcZpiu8xp0Wt5TqzI",
-	"62uZQ8tT4QJSiqpN6cMp8Dnq+WCv5aWMFz87LRxPg8SR//dX6n8O/OOP2+4f/+OXoHXQeSye7/z337yW",
-	"p5cZzqy0ZHzuPT4+4tQqE1yB2dhBIoFGy9EDU3bfQ8E1cI3/0ixLWEhxk3d/U7jTXyqh0QY0ZYnXd8Zh",
-	"dTUekq11dWwRauchYCdC9ShNeYiLC8KDw4PgIPAP4fjAP+iF4MNRcORDhx4c7c3i/eOjmdfylKY6V15/",
-	"PzhueZppo/qLwuzWJnCSD04vRoPhv29GP48vry69x7qq/yYh9vreD7uV6e/at2p3JKWQVmFNY//ajI8t",
-	"70caXcDvOSj9jZp8yyCJyJaEubgJRQRbJEVL5MIcG0gzvWyq7vB4bz+K98Dfnx3s+fvd45k/C+KePzuK",
-	"9noBhJ2DHjRUF1SqG3N7CqVdMqmd+FJ747OfBqfj4c3g4t31h9HZ1Svo74lpH1veWyFnLIqAf6MG/y1y",
-	"EgmjsQW9A6LyOGYhA65JBjJlSjHBDcBkIBFsiF4wRUQG0gzeVO+sG+5F+9Dz4wN66B8dBx1/Fkbgx53u",
-	"3n7v4BCfNNS7V6l3Uk5HIuAMokqrk9HFh/Hl5fj87GY4OhuPhq+gVsRgPHEIviHVEJFcgSSRAFVpo1LB",
-	"Exp4bHljjihDk0uQdyDtnN+2HwNOcg4PGYS4JMCRiAjDXEqIyP2CJUAyKUJQivG5cRbOLpob0YkOj4Lg",
-	"MPCPYnroHx5EsR8fB8d+3J0dHu+HtBcch7WN6DXt3ApDlJHGLqJu4leji7PB6auY9qaZHlvemdBvRc6j",
-	"7wPYjcBabrCBoabWjme9gzjoUf8gOur5vf1Z5EeH9NCPgrh32KWwd3RIG+a7vwFYcezYLL5U2dn51c3b",
-	"8+uz4WvCaTXPY8u75iikkOwzfKvSfjIoUzsSaPWhBENPaKIIlVCwiwiPAw3RDO1pKNhMU5+0YwHBh158",
-	"4OPp9+ksjHyo4UFDn51Kn4PmQkr/Xyr1+mxwffV+dHY1PhlcvQokrExpxHLiznJN7qk1nEyKOxZBhPSG",
-	"KcIsPuP8RoWm8/dAQAH4FzAXRC25pg+E8YaXi9HvNXXdhaPjTuew4x/H9Mg/OowDP6Ad6nfD4+OgF84O",
-	"guOorutut9J1te7Vw/52MD4dDW8mF6OT87Ph+Gp8fvYKil6b77Ec03CqUnvNbuYxKegYiUWSiHuEwYu3",
-	"J+TwKDgkEylmCaRkaHSpDI81xPh4rz3lUz6xW6eI0jIPdS5LjGWGSds1IQ0fTMYkpizJJai2oa+ZRMjX",
-	"zPLAYrdW1/g+Tyn3kejQWQIEHrKEcjusyiBkMQvx7FgXYnGdh0BEbIm/XX97yi8XIk+iwtYIDXEIM+Tq",
-	"SiO4gwSX5tZZ0ex1dvScSa8T4LqNrcp6zdnv+YYAhqlK1oYH4yG0ybWCOE+w6ZRrScNPuIO4URHM8vmc",
-	"8fmqHC8kbVYtXt/LJfMlxGAm3CRScQjWNu/qakLsS4IKq6/CUMFyCsb1XrcamnENczCuy52pZ+xC5WlK",
-	"5XJl34kZri76SzhnJZd9sLZNF2NSqqPYrWVBH+pTt8kVbh5T5k1IueAspMmU211Elbi94Xnq9X9dp7ut",
-	"mq9rrcYSLe9idHl+fXEyuhn9/H5wfYmw3dqIMS1v8OP5hX1/fn11c/725mJw9m7ktbzrs/GHyekIpzOv",
-	"Sz6CrwY/Dcangx9PseFwNBiejs9wspPRaGgarzqN1gZu+bGxAesSvtTOHutR66+e21tne4WhfCy7idlv",
-	"EGrcxvdAE5s2aGJOtjGZcFJsE8H3hUXVHHIlzMIOjOui0TlPlkU0/fITYkYgpRCrYy+fVYPruiZ3y3vw",
-	"KWR+uXArMBJEhf3c2j+2vCzJJU3q4iAXTkDjCl12gPF5nlBZb+SmszzQTymnc5DtKEzbTOy6VrhYSx7X",
-	"Rb+ATIJCB0coJ+eTAdk+z4ATRzYHc+B6p0jRFFJYr4PPGCgSQcy4DTuQqjlmkyegSG74vHH5eMwMIIaU",
-	"YxirQpFBNOVakIjFxtw0SRD1Fdl+d3r+4+AUqcj15ehiB08wLA1VS6kOFxAROqcI4VPuMKSYK6EzQOad",
-	"QKiFtL4S7miSm2CIcZJJJiTTSyJkBNJIcq0gMiA/E3qB5JBqINuT88urHdM/zyL7ZHB18n6nTc65a9Qi",
-	"EVNZQpc3nKbQmnKrpxvcFJtuKr1Uk2duA7q7EP3V0hg2hgosBDP4lNsJWyZJZVmRIm6bUIRafERmInKK",
-	"ATnHkQ1r2Ds+2Nnk3+2ybzRLNyDqFUtBaZpm5H4BvJayMw7Vdo0cmJpFIaSKXGe59m06DSWmuRbox0Oa",
-	"JEuiQNdFrBSuyPjynBwdBB1ikccmjnBlnwU3MarlOPvBquPsBt0DP+j4wfFVJ+jvBf0g+KUOYKg734j4",
-	"AkhoqGBVI+fmH5oQy4sAvXn5vvRyNgRTJMtlJpQ18hks6B0TKO5lnmVCakVSKj9F4p47gfUGTjCyZqFW",
-	"A5Z6+pPQUAqlCE2SwmxUYRWZFFEeWgrK75gUHLt4rXo6sRvsH21SRM2Sn3X02Ggtr1t62WVW7P4CxWVo",
-	"0QqQjWqQMTXy8QjfIhmcQaXVO1jVyDsT7JKVIGZSpFfrcvV6q3nSNSHBkM3IyhfTPNGFYTTF/Z8F6AXU",
-	"xUPBLNvTydIQ1ztokyFTZkDbCMEQjyIXesor0IlyachgAx8jCJlJQq0I3DDTmRAJUJOLYdHLmerKlmw6",
-	"q7gBU87SNNdmQ2msQdozzgQ3eEvGwwKrhTsHuKWWAkNE7hid8t9zkMuKvxHBy0H+TljcoOGtGgyQOXCQ",
-	"VKPGyPX1eGhw4a2JfVQt6++yArgUwe9QznWVbU68v24C/VkcMX7npvA7JjCOImbVNmlg8NMQ5P0Tlj6a",
-	"DpCMMoluzeYljOOzYYizSOcCCeOhSNHCClfYnvKrhuFWtmj2nsUGPMySVTlw5VNMdP+g21M+xh1c8ak4",
-	"YHNLN02EloiT1NY05SciTQV3432CpS3l1JCqX0OwFvIxDJxaRTCILbADgskNi/o2t1mZP75ziNgv/jFQ",
-	"hS8kzJngfTIHMZc0WxhuaR/ia81AVp3wF9kOJTN+zKyER1RGLQI6bO807e+LV8favleJYAxnbvc1Vz5Q",
-	"pf2OIcmAJK4YvwpyanR5My0u82SGFTvUdw506hUotPulqDE9Tj1jDU/AwFd89FfPopn5idNYLmLjsayd",
-	"vLLhKx3BGvlaV9wl0s2myya11NUqVH4VGafGWiw/7ZMBjgCmkFA39sJFG5UulYYUOyGVbXQpm5vDUmUX",
-	"0KwbDBudSoPELhhIKkNrxIbI9onzlP40D4I9INeqKFC6qNauGaPFy9FFMxwsX63r1LHlhsc0efWmeicF",
-	"q7bQhQI5Jbt1G8JkGLct0RZVWVORmPIFm6O/Lcm5YZINqWMmlTbqtyldSfkc+qTjd4IgsBXhThD0yYk7",
-	"VLtW8aVnNk2Cjt/DRpfuPDfe9gI7WB9X6JdLqZrUzbyzMXGS0geWorpxHON03M9NOZUyNthcSsdYzERO",
-	"TpEmT2HN1AQUCLcPEObalFAahH3K61hcVdzXMrBGnziZGdERsiKeIxkNP9E5uDSW5Ss2sGsTB+VFLGuA",
-	"fFh0dJaCZ0Lc70bATal9jJpDjET0KHwjScSchWRGlfFOhPEsNyB/UeZ2IqopiaVI62cX+JxxKJZfRZhM",
-	"WSmdtyuiuVoYV5a41pGrkDfXi884dEMO8obENFFmTvvgy5QTu+A2Htl2s/D25g1BoFppI0UC+Grq0Shl",
-	"fOpN+aNZRp2v9Hp7B89yWSvON8VyCVXaqeOPBnSuV9NhoKIpX5JURAhgFVK+XqDX6+/3viPQe/yjiZlV",
-	"V3rDosdGmqZo4DXyMqWfezIv41pVeZlTZi8LrDl7WxNIQSk0TITVhCmMHEtYMnouj5bz13tdgkOWvIWk",
-	"oBciasSRm/IDHB70TUbncKPFJ9gQEV/hY7MOCVoyuCsSrtiTYE/0sRJUnmjVJuPYXlcwd2YwKnJZDMMb",
-	"JbhoiaTCJEdsJ3umMY40c5miNMU4pxYzuqsQGZXK2mKYsEqmyoJg+Y+7X9JfPv/y87/Y+W/X9/G/3rzx",
-	"vkYdyhpIXWCjRhGvZsAcbV6pVhNkdCAZ9Voe05Cq52pJLo6trJNKSZdr2cVyeet51UdTy4hFUZWjIRrS",
-	"ehFwNPFx/oRRrsnF6PLK1oKEJMY2UZAnk3+sSjIMTz4ULT44uy73zA5qmSO2xd8jvqA8tMiPoZBQNFFk",
-	"ezCa7KwaqLIFlELLvpC4rTbvwua85QIPXO3JxfWwhuVGlMnKJpl1/fAD+ScsyVugOpfW07/Nk2TjAG6X",
-	"7XEtwg2XwDENrJ35VRRsCTSCoF+EtBEZD+00CTww5JAxS9AsXEUoQ3WbSbHRhErNaOKAVbksI9m1Cb0d",
-	"bNLcPFu1WFAeJYzPjcEnLASuDIa5O2aDjIYLIN124LW8XJq8staZ6u/u3t/ft6l53RZyvuv6qt3T8cno",
-	"7HLkd9tBe6HTpFb28Zrbjbvqtbw7kMpa112HJtmCdrCLyIDTjHl9b68dtPcs5V+Yk1Dko/tfvDnor6bh",
-	"wwWEn4y21y3NTV1u2zhC+gr6fVUDqF1r6wbBC6rVLyv7vi9y6Wtn69IFjUyRolxgKr+2GLYil3m1W8eb",
-	"japA2FHVyTMuU9XssLKhVmVdNuVsWLbNLRrjf1u8Nu7YnMpb2+W2lrgxM5yMTn2ll7bCK8HekjLU7rYW",
-	"XL3ZsvHC1q1543Jqb9Dv3q63xWhjiwzOhmSloVncuVtsbW1m/TezZW11bgllcKDCW7LtyNlO8x2q0a6i",
-	"ntA0XczTWga8aLtmT6j8SeXe63dgf/1Oh3gK9M5d4XO1YozfMbQxbU0d2aphxRXf1hiwhDsmcmVw0hIE",
-	"LcgcdHPeF/pCc0HVpPFqN1QrBlC/FbHGqVaV8cGGPy62K91mGe7qXHJD+Kys9l4ZSenSvZvyGDA2rPOH",
-	"nJdg2yoCCzNcL2iTYkIbdTJFMO5qb4jUNkmZ0gerYMU+Q0PQWqT7fUHeuorsaaydL5M7yLJkaS9QgCF4",
-	"bVJWDSrfMVuuHcXbPmmWyuon8rZvAhB8VQYuI6uV7z3Uru2mY72eC3qu01eM0Ar+xwwQo1LqK8Aji444",
-	"cezN1dC0sOhIZss2GdFw4cIem+aecstcLKPcoircQt1t4RRbbYxuS9vbqmPRlkFat2EmU4GTGQ0XzfD/",
-	"Oh7h7xoSbdiZOtatw1mFcqt41lrp2dyO2ruvaL1A383nYXWE1Q35+Cc64FqktMEJN3g64vZjy9u3s28a",
-	"tFzlbu22tunSeb5L41qi6bT3fKfqSvNjy+u9ZGWbrt82iYURuhaIajo3wWvpvD6a2GZTbHli7EYRSjjc",
-	"r9X3Tf0HA34Hz2sFoCWhU+7IMFXIzQ3tJXeMWvfFoluyUhwycL5WEJpyl0O4Z0lSloWqqtCad7Yrn1QJ",
-	"5Se8c1nAXePt4+FasexbVjflF/XS7HaZzeh2d578TuWy+uQkWflkBV+fYCjHuK3XJC/7pgX7jYqvVb75",
-	"W5UVPGCR962fpfzBb1I+2pgXlP5RRMtXho3im5f65zaPa2DV+VNmXcmUu2yuvU5BVG7uG8d5kqDWF0Aj",
-	"97HVqbAzb7765ioZxTC1i1HVAmvXmFzsRzPWdk/boUh37zq7T5dr6rfCNn1H9B+NsvvB8fM9mp8+Ya9u",
-	"9/leq3eiXw/TT1zCuobLm5G9HkfWCn7WXBLQG7LCQ/McQb9xQaDEV1f5gogVOfOQcpdry3kkODjIQ7Ku",
-	"SDfYJ2fCYBVwTQSvWTMxayjvElRTOHhVU660FHxOQsEVUxp4uCQ+QQhJM5PhNCEDjRoX/6rlJUtbmpvy",
-	"YiaL0S7K2Ddr08R8cLHJjVhdfM2NbNrFqslu40PIDaxnf1337tzbrWmee7LNBXGws/OXno/953uUH628",
-	"nolb1ZdW+DXisjExcmGjbGPE9oJkQV5mS8K0Is4tG8N2WW+2mh3vkHewlhzfZCTvQP9JFhL8da7GJSZW",
-	"nc3/fzvDTX7OyDKqww33LK5dNpZy+0VVWXFZunumWSNtS7ZttvZ509sndug16yNjTXIFipj875QbFveP",
-	"y/Mz8gGHJhNzTQf5ZXHDs03OkR2WH+64IJdKKKp0f59ykTKtmy8TiDXJebigfA6RTTnd8jxJbhFywwSo",
-	"LAm/61fkEotktZNh+4PLUV8Cd3eLbD7LzLUUObmnXJtRzWTWFzhGbTRmqwRmE6ZccJcCKVVeBSTOyfhX",
-	"ywzs57AYsN/Wj40Z0Ddj/Rceodti1ePyMof5dkTZmnH1oY1bb83XuSLnNptzISEiLCYKwdkG9VQv8C+L",
-	"zK8qxUG2qyGcdleuj+ysBf4+qdVxNyCQ1fTrgdBLCPaqIv8csv0XImCxn+v4959LXf8gZH4b130loHVw",
-	"8DTWmi5mCGu7tiqFIcluVT/6WHZdD+UblbpG1bKWAnFhaznv48fH/wsAAP//rIYEBNRCAAA=",
+	"7L17c9s4sjj6VVDaX1WSeyRFfuTl1NQ9GlvJaMdxfG1nZmdXc02IhCxsKFBLUHa0U/nuv+oHQJCiHs5j",
+	"Zvac/LE7sUgCjUaj0e/+rRVns3lmlCls6+i31lTJROX4z2MZT9VxZoo8S+HvRNk41/NCZ6Z11IpM1onh",
+	"jUgsTKqsFcVUCavyW5ULqworpJjJD3q2mAl5o9pCG3E31fFUxNKqkYlm8kNH3qjvRote7yC2Ks5MYvEP",
+	"FY1Mq92y8VTNJMxcLOeqddSyRa7NTevjx3ZrcCVvVmEamEIXS1HIG5FNEJ5cFYvcqETkap4rq0wh8d3N",
+	"o59KW7zJEj3RKlmd5Yerq3ORyEK5SVJpCxFPpblRosiq886zVMda2Y0zfmy35jKXM1Uw6k/y5cXCrE79",
+	"81QZUeQL1eZZ/rVQthDailuZaoApEeqDjIt0KaQVuhB32SJNxFiJrJiq/E5b1R4ZbeJ0kWhzg6NcqJtM",
+	"ABXoVIl4quL3QpoEHy2M/tdCGdhdXuvwpC0SbeepXI6MkTOF785zneW6WLbFeFEIkxVTGFxbYYssV0lX",
+	"XCG0dp4Zq+B3GCozCv47Mm4ZBOuNKtriThdTXqLNFnmsasvpIoVowMm/FipfttotAKZ11Ery5XW+qO5w",
+	"oiZykRato4lMrWo7/I+zLFXS4JYPJ27DL7WJ1ZZdlwJJv05WL3nfrTjoHYo73KxgDSMzlRaww8SSCAtz",
+	"dcXwxgCa6IvhpHOWGdV5I4t4ijhUpqD1qg9yNk8B9Fe5boveC/FXacR+b/+p2HtydPjkqNcTr99cOczQ",
+	"WS5RM5x03CI7tMrNx2A4AUAQjk1nDWmjER/2JTIBWEeAmMpCRq0nyeHeYW9fjuPD8b589nT84tnei+TF",
+	"3l5v71n85MX+qLVhPSWmtqzlHM7hcpicy6JhMVchpelEmQKwlItJluMO4iledsWbhS3gMEk6b/y7GJ6M",
+	"TDGVhYgzM8nymQU20B+cd/b29/GQ6lzNgMMejUxH7HWeHgAF5DKG8y7SzNzA76fZncqBOYpUFfCkLcxi",
+	"NsZ/wCGbLudTZazITLqE9xEYW8i8oOMi+Tv/TJmk+kRkOQ9ZI6ebNBvLtCMXxbRDa3I4nwO+PMbnjMVW",
+	"u8XLSlpHyI8C5M/kh1NlbgDPTw/arZk27s894HMACIz8//9Ddv7d67z49SH/o/Prb732072P7vdH/+//",
+	"abUbtvJK2WLTRgb7x0yrUMCgAbOADm2ELqzw6yzRoBadXN3ozHRy9U8VFyppRkOBEPyBSPjYbjluivdF",
+	"P82VTJaDD9rSNR5nplCmgH/K+TzVMZ7Hx/+0Gd4qfsmAv0LqtHXEJ4QIZngiHqzSxAMhaR6haCJAji0k",
+	"8stWL3767Gnvaa/zTL142nn6JFYd9bz3vKP25NPnB+PJ4YvnYzikhSwWtnV02HvRbhW6QMRfeC5fn4BX",
+	"3j+9GPRPfrke/G14eXXZ+hii+v/katI6av3lcSnJPKan9vEgz7OcEFYllHUzfmy3vpfJBd1In4jJV1ql",
+	"iXiQq5vsOs4S9UDM4DgC4x8roWbzYllF3bMXB4fJ5EB1DsdPDzqH+y/GnXFv8qQzfp4cPOmpeO/pE1VB",
+	"Xa9E3dAQK3KXaCBIeOwNz37qnw5PrvsXr9+9GZxdfQH8bZj2Y7v1KsvHOkmU+UQM/pItRJIhxqbyVgm7",
+	"mEx0rJUpxFzlM20t3C7AZecqB44riqm2Ipur3Ml3AXrH+/FBcqiedCZP5bPO8xe9vc44TlRnsrd/cPjk",
+	"6TP4pYLegxK95346kSijVVJi9Xxw8WZ4eTl8e3Z9MjgbDk6+AFqBf8GJU6YAPKlELKzKRZIpW2KjRMEG",
+	"DMD9bYDLyPQShXKa89P2o2/EwqgPc+SJQsFIIovjRU5Si06VmOdZrKx1QiXTRXUj9pJnz3u9Z73O84l8",
+	"1nn2NJl0Ji96LzqT/fGzF4exfNJ7EQcb8aRK57QYp2IgECGJXw0uzvqnX4S0m2YCtSCL36vkE1HI7LWR",
+	"rWoQAmBsMV6KBzLVsfpvHqMbZ7MHYmEKnaKg1+ntdXovrvZ6Rwcg7v29iuCDyQu5P96LO73kUHUOJ09k",
+	"5/n4adx5ljxXLyY9uTfej9fxYAaQAPmKnPfKy1PVdUuDKorI7oxCdJ9lxatsYb4Gwv15Qq5fxeGL8ZOn",
+	"k94T2XmaPH/SeXI4TjrJM/msk/QmT57tS3Xw/Jms4PCw4R6DsScIvEfk2dur61dv352dfMnbq5yHELZe",
+	"awW0NwrpsAt4kg0goq7/dwIDQBOo/P7jirEgUNA3fYPv4OqusuyNNEu+dj9VgrnKMjGTZumYjxWTPGPG",
+	"GKdamQIVtHwp5KRQJNmTOAyCIlsghDbiAl7q9OGlcKf3Q4lFFkqkeqaBhmKlkvDMXAwu3767OB5cD/72",
+	"Q//d5dUXuxpoFX7GihEgB4isKuAsRRf9q8H16fDN8Or6/N33p8Pj64vzy6i6v+EqV8jlkrFBnKco546l",
+	"ETP5XvnzWnL6Ff1Lm0LdKFzNx3brnYEzmOX635/MRH9CmSO4IIF+41yhxC9TK2SunMKVwOUo45hMU9p6",
+	"Ba963JEnHiSHHfVk8rQDskBHjuOkowLpoHLc90oi6FcBcROXhPDurP/u6ofB2dXwuP9lqKA2pbZ+VjS9",
+	"3LGBYZ5ntxooJMvhHU3SGsyPKMSPP0cgcOIf2o7s0hTyAxycUOadaJUmVVzvq+cv9vae7XVeTOTzzvNn",
+	"k16nJ/dkZz9+8aL3JB4/7b1IKgduv8R1CXf96n/VH54OTq7PLwbHb89OhlfDt2dfANEr8330Y5LKtUh0",
+	"MTBFvlw9PG+NEgoeOQV0Ku20E0+lNgrIN9GFSLObVrs1z0FiKzSpcYksEGCZJBqGkul58JxUzJoV5hbO",
+	"JG1LIPBnY1BfAQsw5HWib1ibqdm01Adx+UO/s//kqaB3HMCqeVyngLZbsKLmAX940z/uXP7Qh0EfutHR",
+	"smUyYfWNARHxvcLbH+wl+maRq+SRyG6JJY8Mou6BFRY4i4lVWxR6Bv+/nKu2sAtcXFvA0hzYZIRUtzpb",
+	"WMQ2GjhWoIZXrteALu3Urd6PhJC0SWXzxqCJztG4UuTLpjlylUg0HTRZb5FjwiCMWnGnciVsnC/GYyAN",
+	"vJdyFWc5mGe7Igr2LxoZW+g0FTGgim2oub7RIKvyeG1hM/ooAnSDaUnlZIJTVmi2I9btn+2WQ/Uq0OeZ",
+	"RVr0lIF0rcmymWY3bTJBwabKQuyF9pTD/XYLVBNZ0F3w9LDVXrka2i3e0NWphyd+Q0iWK+ePMxOr3Nhw",
+	"b+QcuJ5KhLqV6YIMkCE4LbbtKLDRxWiQa9o/oLUG8UnPVDA/8FnaJpVU5qjJ6D2W0T0aElmoDk7RNPVy",
+	"3jA1nXE3G1zvHo7K1CTwHudKFipZHf5jaK76R7njvGJ+v9wO4h2tKgsJjxAzgYDif21gQCWfPNXEg6o8",
+	"D9bB/9SFmtltDLscr8RYS+a5xL+N+lBcz+WNui6y96rBg3IFPyO55AomvnUKK3wp4EuguVzZRVrYrhhO",
+	"mMDAcpoVI8OSM/pfcoXyhsnELMuV/2gN6wGgrP63ahbNcWZ4DAp/wrxGW/y9zXwBLuelg5d9Gsj52M8W",
+	"UsOTXvXsHew3nL0aSbitCIFdu6WXwLMCO1nt6DobLLEpZNVMWCifpnKs0uv3ahnhAvhvOLcqagOyx8uR",
+	"icD2ERE+axclO3kafDt1RWme5QXCgEwQsMfLREtntmBnDKN11sgdPbSrC2UMCHzFmZ7LTQrWXTmrhTIS",
+	"2U/VMLxCNQFeVuf+CX4unZAAgLuj1k4s45naPu0sS1QFua2LwUn/GDxLtVszu1tFrAyuNJjcLGZAXn6I",
+	"k8Hp4GrQ+rU+cbv1oQMvd25lDpZ2C1+FxAZsphXS34lKVaFav35st4BWGm6vXJtYz2UqMq+pqHzjPrVp",
+	"QSoZGXkjQXqtqFkoLH8oRORHi2ALyjunRINJwC2kRBQTT74eO3JfzBP/w9yBiH40vul4jPbIOAcyfshP",
+	"QTxBC2jNjfPPJFP/DTvcdb9t2emPW063XaQNLFtOJmgIvA54dxXtZ16rdshw9ICHG31l3jPtHglthBRJ",
+	"vhTkv/XrOthJjPBQOYxtAstj9W6aWRXuQTFliMjPi+bXXM1TGTPwBDf/tBbmvfvB7Hd0K9DB7n814A93",
+	"Aj5gwqss03GQL3f4gRYbWAA9CBiBE2xsk1GDHzmm6fDvzxuixMbKYHQECFx5q10KJjtgpSqR1G5YxEo7",
+	"iFFYOUoh/E003Ugzay/pn1SuJ6zPN91dgDrAxa3KA27oFVTUpATqrStXMAN8jZ82WhxXmQAGl4AIqyZZ",
+	"rgJlaiJ1usgVkqg2osgKmZLOSHaL+ysVOO412z2u16s3jiQcRQTKHbEpAE0l4jbE5E4QgKVtB10zlX4+",
+	"PLMIMCC/Ky7ULLsNL1Y2Z6KKnPgBMlCn1dzyNTWTGlVs3DYa7iVrDyRSojQxAnkX3RzpUhSZSFSh4qKu",
+	"IYZarbRZY0TSMsAb45vX04y68rpC6vKmeIoT8epdgRFpDZDgDOuVa3Wr8iUP42mzMeQnPJiezOpU3XS0",
+	"vl/oNBmaSbZ6NY7h0TVc7qsQ4mdoykAb86tjcXBw8EIQKTlFFol+Yd6b7M6sKpZ7vU5v72pv/6jnFMsV",
+	"9MRyLsc61f6ybrQlNbHsKrTHwTguOgyuaA5wG2sjq/rnby01G6skUcl1NpfOXKVMnC95TJbQb/J5zH80",
+	"yR8TJYtFrq4nqbz5rBW8ndNXgkckEequNMIsUVJVRo5paXQ8EjVPsyVbB8LVeQnvOlHJYu5X+KG4Bov0",
+	"vzes6UYX13YqV2nitQaJcjbTRYBVtC4AJRV44reSxsH4yd6e2o9fyMNJL9lTz8fP4qfyyeRQHST78d64",
+	"J19MnqtnSRO53GRA67bxfnidiSLLUmIkjeCBClUNJMr2uvtPuk+apipUqmaKDaabVPwr9+IlGYLh0K+D",
+	"8UKlSlol+AW8QaJE3UaoCqVZLFOENamagm573YNub6uRxE1b7mA7POIV9NUpt3YUw/U3MxWTpGo4A311",
+	"WKjZKm9xxvdVFABrZi6cKtwe+17P52T+Jy5cWX3fiZI+2AcJmIOfHqyNwin3Eia6njeGYEFgVnmbpkpo",
+	"Y3VCt/0YF8k6gBLHaP59I+ekgIFReJ6rif5QmrnKVzD2qqLKAsyPCeYuOB6aAKWFXutkR/Oix+BDLy1j",
+	"zMVYKfNIaNwelQhpV0Fh9DVB4TwadRCOLwbgFxIdUW6JtIL1RX/fI1Qjc/nj8Pwc377yuKW7UxoGDViZ",
+	"G+lhoawzk2e5mKlC4r/hw0cjQ26TcLAYl8thSm6pL4VVzlxN6iZL9Ax7q91iuFptdsW0ft12rkry8bjZ",
+	"diZKZbTG5RdFnM04KpfoC1Zb0g0tZEV+ZWvdJufNXOWEGPSwO5v3Yp5mMkFNYY6kXlcSNrG2lVO+TWNw",
+	"YDahh9Z4oQCeVXaR4+/Xzebsn11ssvPAkOmCzNrwHXKM3azWVhVg/t/lri4/aoq/pXmFG68NPECZW51n",
+	"Bq5jcStzjQReZwW/tQY/9U/f9cH1d33+9nR4/Mv11fDN4O27q9ZR60mvN4Pjevr29fXp4KfBKSxIjReB",
+	"yd0jdQX7JQ6DhTZtxomaK5MoE7tba2VDZspaeaPWy9CJH8Kda2TDL4UcW1g/CgC6ELrKfOYg7ROXP4L9",
+	"NCpmd/QEbMlNW4YIbLRDlzAckR9pLC2YZCNmpApMpSrCa5Z/sksTR11xKjH2mK9DK2ZyydkEYB2vB7C7",
+	"oe/DLaPsPU1Mi40qA2bvt97mTDYbGM5JLicF+VGYrzTtliRhLYGXHbNNdILB3VH//Px0ODiJjjBdQVpn",
+	"GYRLA5hIYUWiYlTc0aCvVdLFD9+dnQxeDc/cpz6DxGT+A3rxYvDXwfFV+R5FKodGSnqPmHF0VJ2zwuQZ",
+	"AFS7i+ojDzYNxjyeRyNjs1Wpiossr+twK5BA6NPFoH/8Aw4gjVAyT7XKS2unC2YJzKhsJIAlStOtXD2M",
+	"41a75ZHWarccXsp7KLyaAhh2ND4hMfQJQy2mjXcmURNtyh8uykBx/PuVk7bwr0sSw9yfZ1lxoTAGCy1V",
+	"AbWtM7XGmbFFLrUpNrDXpsCA4/LDgFgdUTVFCmQlwW+6wRqOCHq3OElkdwjPPa1sh22b9cGNsHoUKhJw",
+	"w9WVsn2N4mUaA6HyWx0rF1GTB/O5r7cyHofaJpYzQIban+sfm9xLfSP650MKlwh8FnCE8Dt8Gss0RRGk",
+	"RjsoRXoBoPEyhwvmrUmXbotWzQnedbHJuULJL/QqIui9WrbpvlpVV1fiW3eBgwT4cpAsj6cKKLzI8g7Y",
+	"RnYbRGHW0jp/ZeEDdgilVix8QDOuiD1Dgh1DIxMNzl4PzwbXEOb192uWQgbXwxOI+boaDi7Z4VN97W/n",
+	"p/3hWfhW7XYMV9dqh8kk+0+ebvXeNToqr2gNLzF9qLxdSpnC7V9Vtpi9v/7pQy95OvtlvP//qb++f2FP",
+	"pwdXk5/Ns/hC7i3+Pj/MBvr5zffLJ//6m32zyya8V8tr0vKagSRLYpAflU3KDSgyUag0hT+skHOZF2vA",
+	"3QWSZv3Vx9bCYyf00+ERoxYdu46c6w7A8Pi392o5TD6OWhU46m99ArXWWIgn3W08pDnKAqBYXeqPamnb",
+	"IksTZQvCe9t7xRnleHvMrEpvld1VyQnB+Rak8YWDNHAnt0Vo0A78oGTaROGgoHiHhzPalrIYfLp6mbAF",
+	"+H7e1VW7sR++9JTu74CEdgv8JNe5gsAvMB4oF7zaLBDA28K9rUmgJDFgRZuyi7gevx3GX1Rm3aJM850M",
+	"Un8FAJWUCc2YEe2B8OHVI0N8rwoz+Wxh+NpatBWJtmi4HpkdNfUaIa3u6HpKulBo9WyQT0ROjxw5vT3v",
+	"C4v05Y1XuOJKJvxGOWUNbt08ZKKg0IvdbRTKJLtO4OmhIjruOM1mqnRTrBdIG+2UK/msPE7VPzR5ER+O",
+	"91TnqTxIOofq+aTzYrwPqT978onqTQ7ip4dNM7ptuXb08FkHmzL9/WsyVyPj3y2yG/S9YJAqyRy98gTU",
+	"N8C6KgRmZCIPJbqGo5o14XA3FlIdZNM6ayQrxgo9qryQlyHYS845kMknQIQnpcHQcfHu7Gx49joKsFMH",
+	"CWZ1AOFFZ0R0+e74eDA4GZxE7ZEBQwmbAEruEyGFRr42wbKq9MMygOzdwBWtm4ECrdrNs8Hk26hSV7jJ",
+	"xcIYerPy82XAjysPWLX+dVU0ajlMruxxE323Kxynkek1H2P8uSxjMcnSNLsDwgBf7rPnvWfiPM/GqZqJ",
+	"E47uBgrGOgAvDrojMzLnpERaYYt8EReL3GdTakPkghdVlqNex1ERtjECco0z6IfFTEL6ukzQUqo+zFNp",
+	"aFg7VzE44qlGibYugzOIfpgT/N2RuZwiXbDWKySaFHHIOqSJulUpgGZXilSs5EFvS1dpZIk+f6S+1ndY",
+	"nWS1XoO25VoruapYbOOdVZMFRkqOTJHL+D0p1IlAmzAEgtbXsWN6tj/vi1x3cjVRuYuv3tXAiTVG6KGI",
+	"KVSo5Ci93k4shfNlttCFXcxmMl/W9l1wCHi59F2yy7fFr7+7GAqPjpXQy3BquD20dfVhYmkyo2OZjgzt",
+	"IqCkaghcSWxvB2mW7XruarspMa/dmD/UbvW/f3tBz9++u7p+++r6on/2eoAmx+Gb89MBTIePfeYxPOr/",
+	"1B+e9r8/HWCkW//kFLT+wd88t6wnhLUbssh/rWzA6gp3pbMam+S9ZdpzhNLI/rwR9m3ONrEq81mvA5zz",
+	"E6FNaMu9l4+sNv3aWH/P2dnZvTkCjYI8anGfG+3YGyIi15w9PiXXOOzn+8OCyHKX7ZOonDzE2Wy+KEgH",
+	"bW33YlXAKjHXakDiDgThs95qVxKVhbp2zqWSiC/QMS4GmxNv6ia+3dJ1KELebeG9cL6yTnZoNbOxyzib",
+	"q2rEAPnGI7KGd4OvnTtMhN6wkZGJV7PhLdt2FdEwtdZSmRAZx2peVPnc69O33xOHuRxc7CprVTftNabB",
+	"t1Y2851VOXoj5pzotSEFjI1h9YO9IQVsbzfBnMuHVfZ/r/cJ1hG/iDaJhRWarG5wMG0Tzb+SsSp+cokX",
+	"dWPIwhS7hZeT4O2iLj/B7uFzP/yHJTVsCV7iGQnapjW+loWC0FGVH2fOcTu0dqE2Oq9LQLSZL4ouxCGr",
+	"u64vPeKDUm6lTlHaQMenFTK9k0tIKS99aA0emVvlSKGmRPcvQP2ox8rM8yxZxCxPgs95rDBgJ9ETvBmL",
+	"FAN5kXjL9Y7M4OLi7YXoiLOscTSXSFPWbguOI4MChwlGaYh3abfos4agOg+DHxsn0oB3dshaUWRtIa2I",
+	"qDjie20S/Jd6TD8AOdMPVSd46fW7UrN5Kgv1+P1z64jC8/8tmYEuS97vRdtv/65UtC5a57w0IMCrPsip",
+	"ASte/xexH1bkqjGYZ71Acuznce+0KapCFBlovKXTYyfZhFh9kzTCkDUBQMqeZS9ZqG8HWJC6mE4Wabrc",
+	"FZT1h3dbTFFw/TPUTdtaWo/rgf9owd3JB8BjrPW1HDsRn0OpJpUzVw0Wp6F2cOus1a5wBNavjgREk7hw",
+	"0ZtcJiopzSKN9uNsQulcFUN5zbDLpr3Vgpf7vZ5QupgC67mTy5ehvZii96HakFeKvBhdlKY2gKmumu4Q",
+	"9bIu3AWEBqnmHY/to99cYTqUHxjhv7Zb83SRyzTcA/CKpqrIjNsE+GGRyjx8iacjdHVm0sgblXeTeNbV",
+	"2WN+i6qtjlV6yQLcj2qJN+9nXbpNsv1UchkQ8I4GKHy20y3MLtXQw+fj0tYkKSya2BEKFNZHDpQGC/Ru",
+	"aiNkOp/KsSrwUNxLbQoElm0MgFBACPWwNvGAU32L1V9Xd2PdIevTHQ+H60sRqq/e2eSrZVcfXBboe3j4",
+	"dq6MoPdF/0aZ4lGZN0eETpY4RywkiwhXmoaOer5IlXP+c3nchMSYWBrKWczmYB4tslLQEClYwqx4SPIZ",
+	"sBYQ1x8hMyAz8Ur8gK9URHNVaZZuwDJ+TBtfaJdIA1fyzrkix1kx5ftMPDx/e3n1CL+nXFPx8Lx/dfzD",
+	"o654a/iltgilY5dqStIxVRz1lrtqXZ2HrJL5IG1LMTI4+MjQhG0KNMAqMFbwNjkNgpctxlnCiFH5DYyM",
+	"ltSDF08fNdk8pTEZF5bdpOcF0RJ7vf3D9jZl+1WuVAd4ABzCDp6GMlhajp0znLDTBvfEVEhSyAslZ1z6",
+	"NbszyK5Zbix0/F4VdOVrUzixUhddcarfKxGVljQw1gdLQ3zMpbVUwaic+oElWpR2ZCISvelBN/i67hr5",
+	"rUX11I5aIA7CKjsANNp/AEIIeD6/7ECto0aVeKNzDopi2ELO5uXFuRrFzkY9JAS4CbNFMV8UHapiC1Qm",
+	"F0UG9uQYc9G4glVZTNwRuRXDy7fi+dPenovGoKtXz9S/M6MwWhtt7Ye9+i15n6IcXysiirdqPTK6YliM",
+	"jAb9CHDgMUpY4Aw+0KzKhF86pXD23d03Mm5ODp+cl8nBWGqGhlFJHUWfFJRVWfxv69KvyFWhEhE8r9pR",
+	"HlgxX+RzuLVhQajB6Qx2/nIxBwEV7Cf5+yS7M7z3RYOZns1Ltl4fLCzALGScZxZU0dRxLeuYEul++En1",
+	"dg/Dr3qHz5sQUTN9bbS9w0srlaW94Xs5dwdhCsvVwFCtyoGJqHwinV5kpy593s91uxJkTTYfUasZdu4K",
+	"H1fCyp5sDStLsngx8xX0d1KUTiqfoB8e7bCV2hJNwaFhqaSyWCSXKUyX6I+6VV1xwkEQVb8oBgGV92ay",
+	"yNE2VbniXUC07QoMAchmuoCXM389krRfpgM+sGU8D6npR1iL3/U/KItZlcX2aztSOUdB4qJOdvdu7cBK",
+	"qPSEns0WlBBEkUm4LIgiR+VkeOJkmYwParp0bjNI2tVyZLC0funz8bjBUvd6UnHdtUNmdaOMymUB2yHe",
+	"vRueIA9/hf5SGxRGZ/MHgAIqrMEbrLtLbfIvW157K6P7DCPzSsQdSxhzqTG+lG55FzivXV1yJyIKDfET",
+	"QL5OVOyOTLWEaknouPd6gtyN3QYrEfmugEl3ZIYTrBFW1ZO0rW1p00RAiTBJANPIHGezWWZ4PAhTw4SI",
+	"gJUeBSwWbcbgbG07BzK8AR8At7vWyZEgtufJH54xyz5y/0BeCg/IV3AkblR2k8v5FNUn+hEeF1rl5Ufw",
+	"l3gY5xplDoTEJDJP2kIVcfdRXYAKVtA6apVLQMK5oX1d2I6StujsoWCF8pYbv1GsgrK3u7FQKDrcWmkQ",
+	"cq9EgCDBi3S96u3bDqRNXawVMkOBVdgM23fgq7MsWaTKcRPil5giOTJoQQTrjuPOKBaRxsTUinevzgPP",
+	"sgC6x0CUycgkCy5IaW4c8NqQJ1j0CzHLbCGeHoof9fewqL9evj1bEYAlsB2sl3FDqsM/WmrRuVO8XWrR",
+	"iZUpcpl29kD5ZB5/zdvoiKPZYfQJEcQOF49/c90UPo5aeKg3cPM1YvFaloozb2CqHohG7howUP/iF+Kk",
+	"93WxiaAiaf3GW3vBjfDQkxp+JPrepl7hWU4URJQubaFm8BFo7JVP/OvI88rAEuBOFUOCzFVVV59qlcs8",
+	"npamnCPBElmHjPfiHQ+y6iwUdV9hVxyvegnpESxhOTJcMB8SLpBkwKwIj2ZiYVzDmTLuyyoTKiZiLOP3",
+	"W5yO2z1QVVeel/CwkPuKpoTv0aEudZjlinexS41RXC8UTMcemam+AfnQTYcLru4AxtMiKVDV4BwwcCT2",
+	"Onu9Xo/6sOz1ekfimPn0YyKCQJXqiL3eXucJvHTJXKDy9EmPBjsCCDselPKVkVkjWoq1kqUfBbMF1M1y",
+	"ZOY6fm+FLo7Ek14PmAC/WzvTzf5SF3UOj3soKPGfzfELbO9pDn8H+xpyfN4p4sJ4JuGfKCJ8UPGiCAiN",
+	"30XFtkwp9I10VqoI44ZdodMnUU7LcTY6MZfxe3mjOFyLMxrRWNcVLH44EzYKHyfuQy+4A798nCiDHXSG",
+	"gLkZVrXOjLtQoaCOjgUkp+KW4SUIb1/4GCZKo3fxzRV/gAO/tBpWmnN5EghNc95zusqm3XqhvgAMXVmH",
+	"+E5gxRF4QD/8NjKCAO4Cf+pWW0l89x3qK7V38ixV8GjUkslMm1FrZD6OTE3GfvLkYHveEeXfQYEUdhfe",
+	"z0y3ZfQqPb6hGUTBfk60oJNF0oPBBPpzzTZFz62QQecx6pIUlSuATyM6p24K1r/Qh5coMVZxNoNTTsK1",
+	"m5OXzi3Mot9AQv0YCSy4Ns3SBFiYK8nGN/rIOFp+YEMYUCSykXiIKWS/+VIWH6NHXdF3M4lYFjLNbiDQ",
+	"ulRAK7ajQr5XaIONVYIE7DTJVJqbBWxUtREbXS52RZIiCeraZMU1C1VlcM9vyMg/euc/PX8p4mmWWWr2",
+	"lk3Eb/z7x0Zxis7DJxkb0WFH39/X4shfVcUrwKA0SxBuffGqL2yJ5I5tn26JLKtIbrVEziRXYGnoVdho",
+	"ThiZugRaMUtKP4ivSxwaJuvLHmfj+9oW72RuXFWJ+tIwYtRyWY7xUqTaFM51GvlLLDoKjIWh5mFHBpl3",
+	"lM2lmMyKqM0LRG2c6oXYti83QQa3ycLQAZf5Ddq04Hj8zEAKA4qOGINaJySjZouBhvu4AVJ1KRg45wmm",
+	"keFreZamIJx5dzKfy3vEKzCYrY9r8V76Ce/nJK4rM8CdKi7jsP9l6SP2msZGHzG/VbbvOyZO1yydrIZ4",
+	"smde56JiumyvCU6tOpucCWtrARWCCZmQ+yb55DO/Nj1n5/rYFUytiZ2tOV6rSw3mXO+KrYzfUKmq4hfY",
+	"ai6/b6Tol7BEf4s2/aLRpkwQPsqU/y6jSz89xHNFLvgiR6spiWi3aNEqPBvOCBS/sYvZ6uaeqELloIrZ",
+	"Qse1zhthnhd6Q1fzJdeOu76fRzlcuyyxRhKqncr9J0+PquEa/OOLyfOnSe/53vPnh/Gz5OmTF3J/oqTs",
+	"xU+eyKS390RCX8fJ3nh/3Bs/39+Pk70nydN478m4N+n1ZO/5hopsu4fzIJflNXPvi3vH0dZ226OwBs6G",
+	"zczMJNVxsXtZvpNVb2fsBmkqi6BNshsrc6D8qKnt14YSd5cVYuKkmgR1UQ8MXZRtL1XnqLJmpjlzCZF+",
+	"rU2iGso3DOFnT8j4amXdeEmS1AYeJKsK1ESiyz5k1EQlSK1PyIvX2GyNd2P7RuLpDPoXNASULnePBt2p",
+	"zKDdsBuVwtwv0WQkdCGKbGS4Jbcw6s7J6jXxuvHu+twms26zm+po4wPvT3EZemgvdUae6voelBWogLZ2",
+	"FV8v9WyRykIlXIVnyDPtFuK63IEMfuRjt6bGV9NxIdKFsv6wMdXhbBTco+cXw7cXw6tfIBdseHl+2v/l",
+	"+qz/ZtBqt877xz/2MZvs+O2b8yGmi9Eh2PW65fnOy0vJ/XRCl9gZ3WH+RbIkBb8cU1xp8AttK17X1VVd",
+	"+NjmlepUfF6bwp7pEZ33l2EN2xpiyW/k4lZ8V46AF9xH8OXP1iaMrT2ktfMoy3LlC0MKwroDcs0vbswo",
+	"p1dLG+hL/xNHLJSPmrDkU9Niv233z41xy2+3QuzWV7H+0JzU5e6NcS4VKZ3qc3qH42KcajtVvqimsxKz",
+	"NtUVAyzjXerOHKNAcdMEl9CBJU1aISHwF+qkZobV6KYgQgyHuwYnuGzqOXWlJBZcljoFF0yurMWE+Kk0",
+	"RqXA4PjTlcjAquE2DLarmT7q1ZxW7aiSFruhVKSzOWOB3q7YGCZVAexEFlLkymqqM0mBrkwlYQQUmriL",
+	"TNhC+m5f7y67VfAPey8a4VczlVDQ/PUibxCNpkUxB6zCf614d3EK1KE5TwKvCBALJvoDxT1wVTfniaus",
+	"B4c4evw4yWLbDfD82FsmGi/HMId2pwgvTphuLBfbSbWppFTfuevDuwZw7irkFwpGBw4ZoP0uy9+nmUzI",
+	"W+kamjnP9Vba+bj26GJ0eAOfPtG20Cb2dSzpxLGn3ec2mFWpHEL0zI1QMp6unLGqlnzdXHzqtBp3Ai8B",
+	"oS2s+sxA+OZ8gvX3Afy8LpWZVecvA9imCP1Sv7yealtAFMtsLUyorFi0M7uvyL25rX/JxvuTR/p+gXHB",
+	"u0lYjLx245Y3rmn97YLlIo7RdLoqaiBdrulqzL5tfKUtVBf6JrqZo5f1ACcArQ2+jprHxrZFEEfdDgNS",
+	"0N0Y3mYjw3HtFBfvi4K7RmZusi7n76klZ+u1RyZadZPxa3GWuKy+togCYBqHKeHrriQFUtHBCsj8EsLN",
+	"b9W0iBroytw2Vn7Ps1nzPpAnn++KCN6LfK+iatUtKmmuuJoTSDVk5yhh4eAwpMJsl+mK7NMnK/lvQ6Fy",
+	"ILv1NEuVvNeYQb+sEllkLCNXXF+6mL5sjrzMjCIEcPjB76Exou9lopskl1c6VUChThmeLdJCz2VeUIl1",
+	"/Bf4RyspcMsHdmQCj05ZnhBrGuscSXUJeSdY1FJyOqkshx+ZmTR6olZz5zZ2DrivPvmZjRvChjlbWjWs",
+	"bt0uDRvWWGw2dndlgatNOO2FcPV2L7h1j+YLZW/Vxu4LTkWUn9LWdXsLhqvG9JVaowTXnyhM4qUXq40S",
+	"uHxM5SsXCVIpfcRft8VYxXJhy77qZU8oI2SRzXTMrkkmkzXNGHzpa4ZgR4NCSMVlC9nwV1/ZOvyxP8ar",
+	"r6EoF9Jbe3tSn+vusMkQ15jZXSk055kjSkD3k3tWGPlHlLOH9PEeh025P3fNrd6+4h36WTA5fl4Li0on",
+	"cn+mPwdNn925gkObdVPr2AuXNe3iW7CRD6opN37DMRTDh29xAOzBvoAhfTy3mKlimiWVBKAmk8Cfox4t",
+	"RwAjCGTykJD/EST7ML+Zy9xSuAp78uoXm1r+9Xb4z2zvzfEdbNnT4T//eiD3/l6c7c+/H+o7/ffL4dM3",
+	"V/H+25P+3Rv43w+9bryfmvHsVS/521/T/5TKuO0N3AEJIeyZ6YpK+G61lZyIXBcq1/Jzy0CsL7SwuV5v",
+	"EOrfVAJeJrfaZvlSYHxJZkrOMFUpUoI0QiUa1BuqGKkLoT7MNRUPfBsEnmgTmOHuyNuDljQOML9RtCdw",
+	"4MBfTB6ckcGZtcUJm84QzbYtWAJHYcBc2hRNrz4U2AViU97m/SInOO91FZYM5UzrAcJKKzeQ6U7yMvxG",
+	"9+Tm9MgtNf9x+nYFMZu3fu0duGElFNlXrqRMH4TfMIMG6KIqVG9d2T0TA4sC1EYIobWVsO+DXq+pX3Sa",
+	"mRsPsqMpFjJNdhdC93RzTPPB020xzY2bsn4b3gBzuFK2WNvYPKzMVmSCWmwR8VB5DyGty73K8A+54icb",
+	"GTtXMfk/JeewcHxkMVWzpuP12RXlLmrV5Ah01hzZMsIpZztnP/G6OIKlxTXpGmNdYMH3y1u6rCENMy/w",
+	"qKJq76oOl2Fc/fOhK7uCSx0ZXitct4nK9a0rNsTNFu9kJdmAXqF699jlZmSicIWRr0dEOA5yqSJXLqBL",
+	"U0aV8vJBVb7tZNfcBqaSNrs9T5Zfp36XLk+4lr1XXoSMr8bO96RqXBcqnzX2U2bKweeVG5VxzzkpVhba",
+	"TpZtEjlJeKEiJLu5gXmeK5XPXlFR3CYL6hfL0rwKM9Or5sOm7jSISWU37051GMHfNOCscR++dmVJDxeo",
+	"pYWyRVmkZGt5Sbf8Mrt7ZSvaqzUoK5S1niNfsE2vUS4qE8CskXM7zYqK2sTxFiAe1eJrRcbi6CcWo+fY",
+	"Z0DWTCbqZbWWQRAexqKvLr5gROiXyTl87NuHP/7N/bPexmRDlmDw+cHueX+7y9X52n0nCqan7Jgi/NPW",
+	"kVeYH1dqUR7s0La7LsBLLjnGwFTrobS3mwUd+Z7oyaQhfgLJqMkoFbo0qCs9/pP4Z6NL4/NcU6semAb+",
+	"ut707xEOQ8ucL9oQ+bt1bUdLb8K4WimHgIUJ4KkzZvgkq9XwEs688pmPFbrudDolyPsj81//9V/l3wcj",
+	"89//LToH4r8OxH//98h0ZlIbcfSd+G3Ucr7oUeuIUq8+jsx/rXkOB+Fjc5uZdS6NVTQW2WdSMO8DjuPI",
+	"LcTzdtJtbij0rYfP/SwVnl02sW5+1IYQP9+K6X5H1w2yg1nOAbKbUeKC00/Waqc7cmpMpcYbeRtbuIc+",
+	"5+deDz+HEDKAuzpmar5h1wpmkTsLP2lvyG8isnFHwG/K5pwi6L/RmMMALQTv0WyRVYOP7ZYX5K+dehK2",
+	"SPjUcEqWxza4bFxAxFRua7X42cjxVcn9Wps8SNU6FjqMrmtvK0C/Lj3jHsGCuIPUbd3hdUOo4Cd0mnTf",
+	"uMzEwJy4wb21AwHozLjW+htiBHnIxt1w9LvLufudgqyLzO9DsD24IZwDrAusF4MmmXoo9sjQ+xxQyJVK",
+	"XOJNGY5dzvFHBGR/sfPeuO2thhnWb/BVo32sT3alWNqKC+uowQzm7Sk+k199mJP4ZIK2lJ7oGsxiNW3t",
+	"K1X825SuFhQH8UVlOCrINwbeGvDp1r3bUQDED/CLtclsatFhEgzA+Cr9MJs0S6AA+/g3+M9qa8wNcZn8",
+	"4acB/9XOxsrAwX5tPh3hJjWnrPI4ZYPvyn3J9fKdMsCxz2hRIsUQQp3TZVDUBQjPquI+DaOuKtULqNFy",
+	"KO6wBNTA3sI8/yZu9XXkFL4EPqtb9Re6LLfGNgARfFOfvoT6hJyhASJ319Ta2N5Pd4JRtupNBYee76Iz",
+	"bTLlf+Y5LPP0vNhNHTFZHt/Y7vzraQ6LvMmMBd6+ygXP9RfK2jmeA2Zm1YhTUMcxa6vmG3fwgEX2z88v",
+	"3v40OGmXIzklo/VrQATbxX2a5ui3BuP7H8lyiPSvd7rgt/T/4nH8WusSfrnCYE+3EPmiQatm+tuQDOVF",
+	"RFerPyCQpLkB146NhPwu3n9qV5u9TpYbDHC7hD3eN05xbVAXMRSqVlyK2Pc38gbMaccY/yrRePayPcDr",
+	"HYaNfEpcQzVC48sGLNwzJsAVT2lQdVzvwpVCNLJWsp2bOdazJ5sKrUFCKgYu0dhYxiyipoYXb/pXYD05",
+	"KivXsHvXFfRhy3cXP3p39u5ycHI9fHP+9uIqOhLSuABS7QrW+O6BSeWTn/oXQ2hiCB/BBsjUF8OBb6W1",
+	"+sZwyyUaaGFrQ7h+hTjESu2cEoLyw8uri+ExwUkybuw65/C6MO8xf4DNU3VciFmWuCvTVgtYVNCFXRcD",
+	"TJR/u2WWvwRdFgmcatnD+jg7xNUy9ZxlxSvKyMOjw7++w4pDQxfdWfn1J0Z4/fc+o7D8/RLRgWm7cZYu",
+	"Zg3S5F6HytnR81rXz5e+QKHTuqcYm4w7z6Ee9+TDKXcIaoYCnn4ODLtx4eaOZXQAKFZDj7tTlc5Vbrn2",
+	"0w490/Ecb+qG5ZhGEU8Ht8o0mkhc/UQ6tbbIlZyVZr47+FYok8wzbdb0uvrSpQlcDpUzeFGxr8yAzfby",
+	"l7PjanUQtcBgwjXXF1oLrrkWTVNfAIhrbQuNrQqw7Qm/WwXmJSoqMxXghKN3KrDsPY/3x08nPbmXHKgX",
+	"k/3u4f7urWlB6H7PDJdmPRIRx9JDDta78xP3z5PB6YD+meUeK+2R4Zo6Ms81Zmi7zUV9R0ljgyVZYbXh",
+	"fm++h/3IOJx5PNR6coPxabVjA8O2a9/XlY1pJN5qDuDnNj6UZXqiSykBNHzCWZYNuSs/6JspaghNc4iH",
+	"2sTpwupb9Wh7ldSGGXUD6UIh2ntPeP9EfZib1rypeeOFkolubs6UqLkyiTLrEydcaYPwTUoeFVQb6F6C",
+	"5YkbZRmoLTUFa13+TYSZS9x6TWHWvwdqScEcMlm2RQRVJ/ld316hZpWSyXLXLlPtKpKaENwUdbaCahkX",
+	"C5muafRVS7qgsI3V7ET8GZY609bWI48h4rK1xWbcNHUlqosRYNelPTbVI1LLDQF/vsh/ZcQtTdHIqLG+",
+	"qEGh8hlbL0jsxcbYZ6+jowoWnWSAIJQNVt+rZdd99QZ6XdU+o/enGJFb9uzCIM6q9MizttotN9KOOVQh",
+	"wbzxW1n7lTTbX5tbsvlN9chqJMx1xpgV6vx9wm63RggiGBtWUto7NqVDIUW7pQcgEK04S1B0tGIc83UU",
+	"fDQQE8rbk+Gr4eZPkL7oK0tfeSf2URlp2OQOp7fZyR2860qvuMGloFrmtQLiy7a41RmtVYrYN3ZF+aMi",
+	"IJRltTeUkmfTzpoy8u4peRGSbGRcJfl2PQGpyBUVt5gJjyeZ1hQwtxlwhhjJ2H2fMFdmKe54rjyV9Hlb",
+	"WiHpvMESvbUfL0qzWPkjpzCCpnSlUjVTRb4s22EfY1WFVRIs3yjx5G5+TP0Xc5XrLHkpkhwqqpiypA/e",
+	"3ghEXY4Cjsnce6e4uH+qeNfXV3QWN1cwTtNh9ChxlZSa0RHUNAqc6JzltC5qfXVJFFLa/KzIinWPFlbl",
+	"TU9qi6YRwohkno9H2Lj+izWtfMllJ+PCF4OoMMXCfb9edyuZ1laZaj2BgsldycKZ3LcEggd27DX92dxg",
+	"lC5QhqyTO5ExiFeANiLit68nqbyxUVM4vLs6GlXOC2mSbAaJ7L5YrpDYRSpW1lIjeWwvQ0cLQ3wyo+BY",
+	"uUphN3m2mAeVwrhFUOBxcn0VGv1beFar+UoVGR/tht48gW/X7jxupceHW0BFytZO4a1huuJOe185iXAw",
+	"NoelMztyYek7h5Sv1dUvVKqkVXUFfayNzLeL2iEhlJPwKlZ2oh2mS4YHJSD3jcd2rQgxLzqg2ZjMLGfZ",
+	"wooFdw7g7zg6qCR0UeMEWA4dGqpSj6TIHe8IKBcpdjF3wSxOh4lg2/NbmUZdQaPYkSGTJxYQ08bJA8MT",
+	"26a4pDYai9tB+lilZ5dp9OtvTQ1yB4liA0zxkiKXXfpldDU4HbwZXF38cj04A2voScTpoI2JKG7tDQHa",
+	"F6crc9Xil321rhL3Ycmu273HPEBzBQtCaHNnADFWxZ1SrkO8bVPq3etMJNz7qpqBcTjtzXq2ucSzLa5V",
+	"nmf5ek2F24MjcVRkMUIwK3jsDRd2EQNzmyzSsn9987RlE/md2ANfVPVz50ji1ya53Kp4AVLmJQxGJDRW",
+	"Mlc5dIks/3rlGMdff75qNSUTam4zQeTuW5gI9YEaE02VoB7fZGuo9jPVsSJBCXj4yPTPh9f9d1c/XL95",
+	"ezL47p93xUuhb0zW0EkRUYAkiVCWiATKan38iHQyybg4JZf4W7n6oFoAikVamkJcDC6vQI3BYAYsQQ83",
+	"ycZm0roUAU+O37g33nD5ep/LT4NSiy54F/4emCnwRJQe4MLOrISe0f3B+aN64QIMKyvNg50s18pQuBA4",
+	"YNocKQrQHl+8Oyk3AT90ULnPqcbAX/4iflRL8Yo5KugorxZp2jgAMyhEiXLt+bjUIL5A9Qc6ZddI1BWw",
+	"EFGnvN6HJzRNqj5o8CBNdFoo6shpEggc0YaLanXEucwLLVNOobLctVo8pgbRj+CV6ubhQRVTaZJUmxte",
+	"YbXh6ciceInAohDBh0VI8defrwSREhdeeGgV2U7KQyHckRFEfY8EjLmV7tsi5e7p3EubzXV0HixcCxw8",
+	"6AQZCX18UCFTCYNzowpx2Nsj4k91rIxFAYBCEVv9uYynSux3e612C0stehZ7d3fXlfi4m+U3j/lb+/h0",
+	"eDw4uxx09ru97rSYUXtCXSBXrNIxq/ZeOGjd7mGC0R58ks2VkXPdOmoddHvdAwpvnSI7eYx9hB7LRaKR",
+	"ld2oork+gxX4DjRcwiIlwalC8yMJodW2xWLAL8ocG9bQzyG5ui5wPtePmkWlijyQ3DAZt883iQ10Ow5b",
+	"7787GV7Vb0Q8QQOJ3hksA8RWf9x0aUupEfISQNKg12BODaLsneHXbqHtG/zEF7tl8UEW5bfwZhtgnZFH",
+	"OZ5KbbrQMm5koluV68myD+g7zW4irH+DbJadDdoQyXj6HCaMdPyGkdiqtpX8x2cGoZ0qeas4iAc5F5Ve",
+	"yy29i7Dj51Et/C0Kmmnx8pHtUR2YIsNjUJmXVqcBSGxS22q7I1GO6q4L2RAA9LFdX+sbik0LSl06ksTM",
+	"lWKRGyq4iCu5RCaH1hV6NjITdcfFdjSYWU4o7s067ZDYYtjpzUfSPXzSY2ks7OH06KXLMJfj7FZVB+FI",
+	"unAQaPDWNAwKYxCkmbsWn5QmX4vk05ZXwq07Ix/oFq1H9kx+uPbvVfC9WrRhU1bPr+2W225kIfu9nrvC",
+	"2YUatIF8/E+2IpezbZKWPMFTvSGUEWo2z1A+IyiAxR32euvG9sA+/l4mzMfpk73tn7wzrhO1Suijg+0f",
+	"vcrysU4ShUL44f6L7V9cZdkbaZbuloHvnuyyoqEpVG5kSiQ+QEH4Y1gBF9nIKututVuFvEGDHaKcbNzh",
+	"ZXBk43wxJh92U+7AJTy2rMMXUqeWUgiwFrFdUNHnInPVBOh3x9l99zJL5O3e19YX5qi6Alyl0EIZaYrv",
+	"ZDzDupouDMz1X24oagC8obw3ePlol5UiAvPStnG4BbOI+AeVR82DUrsyZJlcJ+56vOTqnmX3ryjs8l/W",
+	"POIxjthqfdI/vorK/BaSXyorYzmlCuMtO7KgayAA4stGNKHAQQtIeGBDsKCPvSg/roLomuX9g6AcnPwa",
+	"tbF9aVmnCas5MQw6d/RBNnZyzcPaZtmtoub+AQFVoWb+7JtzIk2O6YoFsLnp9SpCNVvIvMmf8yhHhrcG",
+	"CKDWSw4GdDViqU75ykIRM0yhM4rdGhk8CWOV+DvI9XLVOXUSdHISihN43/PmgWyOJTMTGRcqoTRksEGA",
+	"DgKQuMAvQFXS9gEJ0MSOKoXkSr6njCga10tLIkXXAso0fMYATKELq9IJXSAsjoHubUYmCoUNt6aIRqA5",
+	"UxQDYSVyMiEXigUCxG4STE0jU+llo3PeM5W4XW5TJFpWBO5UpluMMMtfNohM3OQQ2QIhiwErcyrsIgb/",
+	"TLDukSGIdVEWxy6hw6KkTJniZ9zpJF9e5wsTVahwZFQpxZb1g33rA3A3MenPmuQ4RGVNkONz+32WLL/s",
+	"1YmT+TuuamvAHPevfXczABSx2nB7w2PhvRxYOhSlSHWHQpRT6DyBufvq2xW//oq/QP5Qu3sfeOou5XUv",
+	"Buxw/dMJXKsSXiiuIFVVqIj30DxcJpfCwFa0JzUyTn2gNx+QFhWwRhs6OJhxaridChmTXDwypLEETMr3",
+	"3qAFcN8JPLNHVThAyB45J3Mbq/LhMGM1yajSHiVrIPMlAy9XRELxQfuysCPDIbaey5QSvVNOL4evz4Zn",
+	"r69/HPwSNXGJnyoMr/W1jylOx983ndPweXlcuZUO1sWN/vecL9qb6gkKLvONh2m80GnirJxrThIocnSM",
+	"2HzTFje6gOZweBhwCEGVkDhEaWEglphdO206Tex3Eejr81XFUPTQNvCzebkvlnM51qkutLIuYjsZGW1Y",
+	"7xwaqvLoGxAfnw7xY8tWxSLLwI7XRM6vVfE9gD2ElX9FYi4naSBifCi0IfU2cIk5/JVIqe34ypetYEvp",
+	"cD/OVZrJZL2CdEqdQQJuQDCgjlHy5CAMq9wZ/ABrrXMPZECQssi/8oUp9Ex1ioWhaloKO8ew7YLb7OhY",
+	"slPlcvj6h3fnIwN88YiTNG5Eqm5VStJzDoSV6pkubNu5UTur7f1hzmxREDxEPjFKSR8EGlkGgccV1pfq",
+	"m2nhYyvQH5Qv5hT4cpYVKN9ryyvjSHJplm45xOiR03TFW7wEyoXK94oagqM/j+xp2MS3gRIvcJuOEaNf",
+	"kxJpBpqtiRiPK0RAxOP44Te5Zo1cA0hyJ7ZC9JUjFR5OauzfkXPdcZ1sNpi0g0Z/9CF6feDDaopq2coA",
+	"voDn8I+ZVemtst1G0+0Ax+vP9Y9q+c12u9F2S/i8j+EWvvhmtf3TWG1DWv9mt/2CdtsaUwokzUH5BBkM",
+	"tm1ulEOoQQSoXW6cShAWqj1+rFimKZVFjuilYgm0SeaRwdnr4dkAnf9/J++/nGvoHhXxdxh3nDgeyWav",
+	"kYn+1umfDzs/wotTJROVl8ZHNwvKAn7HRKrfq8rzkUHrMjmxRazygvQTxQ58ntGSQcgfMzTt4bkjzL+k",
+	"59SQBi1BEC5EVwAwlFRxQSIYDVnSbfZewYFuEC0IsyHxb+PzPoxvxRk/PPFi9nu1bHMkQrUUuBiejFgk",
+	"xSISIFhx/amkHTAvcafT1IfsCSnevRue1DOSsjyeKlvkssjyDlTgWcN/qLrUWvb+69cxZlWQupMda+8r",
+	"zl1L+lVL392mjBZKly9FhGdhhQinKv+z87reDryuT32LBtS26I/nkHT8agyMUjPWc8m1kuLj394rKIhE",
+	"DDRVhWrqEg6/ByXJqhNj8CAQHUfyU7i+pvCbxRz7Q1eZKMRS/Tj45fq4f/zD4Prq6jTyNYjRuESAJE3M",
+	"h2C5D/O58ux0orm+AnGb3fgCl9dltoDIatWPZcgpgjT/pwf1LP/PLa/WIM4cNqTnq6VDYeWk/q4n63D7",
+	"F5hwvjDJn+BQXdCF96mHKpvLx7lCi9V6y8iJtrHME+fW9Kk2ZIESaIAC0oSoPoYim7h7HA8YiA8jw1+4",
+	"caplAtHMgiE5QQ07PFtkdcFh0wxaD+gCY9mp0jgaImYZaWAZFw6k17vinUHBJFdxZmKdao5dQimHh0Qg",
+	"AQGFUL5LiS7A0KFkjroKepZK3dNLMIw4CrZknyFUc4UYepOQ8aaMYQu0iZHRM+riq9IygmmeZzfYEpnz",
+	"MtmdeaNYN72g6SLSVYy6GxnCQlBWUYEajDH9shAZ4NCtx20Xxv8HEeAjg+7PhXWyCwxA486UNNilhsQ/",
+	"fE8XQru8S1r9hH3FTNzk7HUP8XOER1tvxssDC6r7LTNrrEE4DiFg1Ry0/4WFCJ6uSYrgRxSSzi4F1xmQ",
+	"F/G/ydJDyHCnnujF2ypWc5eqvGgdC3r8G/+D7/Wt9neaFwMH3OFBtSOvbhaoFVEeklLU5VhDx6CAuMle",
+	"i+Hnez03hi2bPrH90q4xoFdJ6JMu99x/HETTT17Eh+M91XkqD5LOoXo+6bwY78edZ8mefKJ6k4P46WHz",
+	"ne+RufHe335T936/U7bW6vDt8m84hq/Z+lchfoMnsqSkxqNHUdRrz9gPYXIBXMmrgfgcwLxyCujTr2m5",
+	"5xmaqMflV1sOE1/WEBauCx89hjDyf6/FQ9/YO5Vbsd/rYeupDC4363BOSXWwO9b3z28HgSYKW52RvFKt",
+	"BWEzIcsIdijTo5wOSixGSD8BeMnR5DGfY7d6wCqkJZDVOHqMV9C/MUqZHC2epwEUYIbOXJPqRrZ1ymB8",
+	"zS3zczRs2jmvE2uy6lu1YldjLAWbFqb6bfAWVOul2CC3osyLaJcZEyTlYMgAeUkx3eGVezwy3kpPn0Rl",
+	"F3Oa4Xhw2rHFMsUSd3AedWY4QjBo6P7dg9enb7/vnz6I8Ak7fr/DULLVd99dDi4eiP7ZiWh4kXPaqeP4",
+	"d3u9Hr5Y+Tl+0uvR20HjHP7gwX5v/xALOe9d9aCKMxRyfhC+DQGQ3z1YKQr3IGLkvM2TOm4QfxQ36bBz",
+	"VIFWSBtH4iHb/B9Vn8E2EgBhXWgh3a9BTerg3WBp9Kt4iH3fchUrU6TLoIt9botHdSzD8O06CLi+Y8kh",
+	"Za5vqsW0BGwJGQ2u5E2E6QZ8d7P5CL0IGEUKn6vOcWaKPEtB5OiXdRCwPmI0nHTOMqM62KQsqjSuihd5",
+	"rgw7RWhw8EEc9A7FWVYIl5gfdUV0Km3R8T8ITQOksoCZQuxEImMbL4z6Uugg/iZXk1TFhVfA3elBbgWA",
+	"ugk6l9rEKkJLLHw4zUyGoTOul6xdlx5xHrbs/E91r40Mgsd5mBRIBLtNvSi7oh90mTWpMysF0UYjY+Us",
+	"4CJIKuWxYVOStnZBOH0poopTKYL4Z0fTPjUTHtuu6Bvnhm8zRBgKNePaKC73aWR0UNeWFM3DXi/6Ct1u",
+	"v64vMmxSvbs/0pPO/ziXZLUW19d0UK5sDt2Uwd0H68By1Q67wByOqBtnTuWyqfy6tmiI+Gem2QcU9c9O",
+	"oq5Lh7TlHS3Gy5ULFUp2fhcJKf61yIqg78RyrjgNwd24MC3dqC8x2n05R16rrQvkKrkhlGdhw4/JSirL",
+	"Jcc3Sgy5HyuyZ3BjquA+Z6Dgsqay0alVlauOXmiLiK7p8l/lj4paufBtHWGNUUJ//S6M2lU2f3SvYRlx",
+	"sKCLV8fi4ODgBYYP2ULO5sFUmA7RriRH1FHvIvuBTVb2qF1ipr0l3wJt8Wh9Gxk0YSkZTyk7kIj7c2Uq",
+	"fvfrSFWbhKpy2WuEqtVmDttgXsNg6cDcj7keZ7OZ7FgFV3OhuEt1NuF2CHCGKXF0vOwKtJ3gAy4cMjLk",
+	"AaXz+0DamE4bTPGgymcfhJLgA0rJoIPum/LhBms8UKEcCH8HWME/3baYjsML/DM4G/BnuEsuy5SgL4kN",
+	"zaVdcV5RGtS/FjL1HD5XrsfqyACX0knkKz3ohD2JPuqTltJEuKEkvCrslnJtXdptr365IvrWiSj4Yg2t",
+	"ONGjQi2+z3R9hAYyatL8Svnu8XACIi5KuK2val3ibt9rAloqTetJCKXgBoSjIqqvm4jff4wvu3c/tlug",
+	"CWz7Bt/52G5VZPVtH8HL/l1c08GOJqryq28xO5tjdhqs1F5P2SFKJ2hU5atBkOjGsTnAYTKOI0mXgkJI",
+	"lhAPy0ElvuL38ETcakmaCrATPKelGt0cQzIym4NIXDBQZq5dcbzvSIS8VhAZoM1N1Oa8/qC4ulNQdRKN",
+	"jPNz4Ae24r9xY7jPHu73eo+c08knb6CuSObuWKZOHGRdGBXMcZYVtsjlXBCWLd8rIledfGGElROVQg3K",
+	"E19d0o1tC1i5A+qw9yJYNWeI0fVea+SNWhIlFHKCG9/PR4RXrwhqMgD6bJF50KvPdwDnb739b2QqAloo",
+	"5tAv3cqeRK7ypsJMKgGyKGTEgW/xJbp3HaKRNKqrXRPpdO4aj31+jBN3YvtECuSSNlQj5OEcjMeD887e",
+	"/v4jvBD3Ok8PQJ3NZQwwopkVfr/EaHFEO2pG2JQhVUVBAvAxl3pCLbv+gm2zksiJs9PlfKoM1koZGNZ4",
+	"6U3s6oOv1q7O1b7Mu4Vc3SeQ4p5RFCsy2/dqKm+1c4OXh9eR6g1YT4lkqoeYJBHw3Qa2uCOnQh/2XuDz",
+	"OqPwLzQdfZwUDoqeUId/ERx/sf70H/ZelJWIgFx+qJwEZ0+HRawPuw2O0ho5BtYaVNHkP2sr3LFo5ltz",
+	"zJO9omHKH8g9OfDj7SIhneRLaPbytYLzXLn63ze9NJx1tbm8pwYX/lG9Yh5uuK4egQTwJQMI10N6HlS9",
+	"qcckVcTHU9dJoaFy29CX3udhnJhQIdTVOm5yrusV3Db0jA/rES5y3cA8Pv6PDGnc3/7VT3TR68ywEPin",
+	"CYUMhMdm8TP0dgVdIHcLenSXNpZLqwQi+fAjvBtjabjC3sIkFIgDdzZJGPvobxDHzMkzE5wCXx+EBN5y",
+	"CpYPMFQ7B6dpnBmrbYFl5ztCFoWazQsO2YIt5RuBzoWoREdN8Lp3M5GQ4a8p8oWgP35HqQ9Wyi6OI1wc",
+	"9ZHQhWM9GKrmxbqtEZ3rhKwtLP+ct/Jcgiv7nlfEtjhKGrwxlFI8NJm7lh99C61Ye0Jpc4XceDrbayOU",
+	"wGmFZxDC6lJPf2NKZWCxGM8lVSMUGtg8CcgoGx/sCYjuqBUt7O7sl2yveAUflufgAXgOQl3kUaO/Umxx",
+	"V7o6PFV/pZs/y0vRrfodjTYyzU5FrvaJOf0VINsbvaDNwQ2/0+msmLh2ed3Bjav+PaxiG6Qb9rFulG/+",
+	"Z5vHvnHANcFl29jfHCl+VeblAqOh2YIG4jjjeaUSqXhIBUi3M8VDQUOv8EUxLMTCKiuwpOnIoC7618u3",
+	"Z+INDC3OAVD0DIOH69nBi6ddAR2vvSXDeTlkrhiq5OXIuL5pwcNUYeN71+uCgrDMIk2pTmSKngRf/b10",
+	"AfzlL77+Kq/h4Rsuu3qpTEJWjNJtIJbZQtxJE2bLU1ko9mnDtxyJBmsDVxkr1h7lpTmSpbfO1XKuqDcF",
+	"eGyikKnggB0c67+AwUQO6uFstigwifsV98UGMMp8N4Y3ECIJfeKhKzOsJ8KCuIE2BwhRLb07YRTOw3II",
+	"xi4nALiio4+2e3b+8hdxki/FxWKTLIi00GgApMDldlnyJrQBBnKkpCJVTkgs8xQInKbLiDb9j5AWdzEo",
+	"1Hf/P9e4cM58holwNY3oz6sA3/d++E9MAmTet/ViWTTK1Zznss7O6Rne1ovkmehDYAqxVCfkjrNk6Q66",
+	"q6EEmqcFmvaDH1XdtSBph4EOJEnn6ia7jrME/qZaynQyOMaUr5XaPcEF66xyXU+wFiM5zkdGG1uAppxN",
+	"OOzkvZoX3drkyNvLfKXa7fsSAJEJ9pcM53SM2pWRX/IQVNrLNT8PisWTEkJGDD1xrdUcy5VwNVzzj8x4",
+	"ZcVr4sNsEEDAubONUc16gH14EkTnyWIKrqW9R+gsSlScSuCtt8oVNUJ3UZyZW7zuXZSj2zvKE+GOUKyS",
+	"gXuOCglin5OiLaRbSOnPY33jsHfYxNORhr4US2/yL4Z3jkvkquJujTm8sgXNBnEMSVrtQvG/xQjtlR+u",
+	"HVq/KH5XA7PrzE7HAVsFSX8mvl1bf/y1hSddyE+x1T72xWx3KG7k36U8Nteszqg7X9sISnep3DklXCH+",
+	"su4YJ9ZaaknuZHc3MDZ/r5WpYGkW4klLSZZEcOdFVQkx472VHgL8IrdtmsnEmY/dQuCCgavK3y46D8yx",
+	"zkaJ0OeKnfmppua9C5OonE1mL8s7xF0WVJqNhQBZwJUB51WbBdVeg+uOl03L2ByZvvRAf2lu/r+7XlRJ",
+	"1PcM0ubPvlWO+tNUjqqek/85taP+w2xz1CTAW/PLo/KJN9Pj39w/N+Zd170a7qPwsnI+Djr+3fVGeUdE",
+	"X5zXBtcoQ9GmRHCMbyvE3rpsaQ/P+mTplT6Ef5KT2FyxgJ6tM/B/O5FfyVouAlK6x2ksEKTtMiK8J2Jp",
+	"VU1IDAtgdjdIOVc4zzcJ5wtKOMGW3EvEKb/7JuP8yWQcOCXf5Js/iXxTnpP7RelfsiZcDnAkpG+SWdaZ",
+	"4uKWiYrpxlQf5pwZO8VcWep05FvBg3/Js+IKF+ZU2nxhAl5LrrSrkkfAAXOKrzNhe614Wzw3jPOlufdO",
+	"MeAeh7VqlyPjy12KL1XtUi06ubrRmenkZa/5P0+5y2Anfudil/WZa7ew26LmkNVvhsQ/3pCYJCE7opJ1",
+	"n2JVhCHs49/gPysBoevjFL8M89jy/hXCRG/vFK9Yku236o+fE6VY0tWWeMU1KvmfgDp6vzun3KQdfyO6",
+	"baruForbyL2O8oVZX3WUG5Qo62WHiszmm1eVvNQ1CMZZQKXMs8UNO8LLxihzPVepNor7rSQ51m/kdO8P",
+	"81Rqg70C22XRUpkrWxUQfeCBlxQzwwFapW/DC4wjIwsu8elyqWcZ68eu8Y/DUrUKKXhItMU32iNjy8vC",
+	"pUfC6lXi4mXpCx6SMs/LhcPAhfUtXuaLcartFMRZSGJCYa4qoro+XBB74AL0Sc8OymAgFg21Cm+s4FkR",
+	"hT+Tu/w+/AJDpzawDKxkSr21oPcOYo0tG9/YxvqaoQtzH5VuDec4SvRkstZIduwP613W7EvtiogdllHQ",
+	"H5NjYTgunbpbJpho7NqnKhlPufmlVamKiwy7mnABGzz/rg05Mw6KAYJRMKpdioUhlQp+crzqQt1kIkZu",
+	"Q8Yw5yTFHzFtOog1jcD2RQVSxlgB2J3BqMiil9wvc4IjG2Gn2Z31vUZhxcIolZBN5ybDSsWNaS16Mvna",
+	"7tDQRF9kDolo2ltX0IQefSnL/M4gFdkagIrsC4Lz+zkKYHe/mbT+CFEdD+ZdttLi+H7sL83i9+tlpiv5",
+	"ngI/ZHKrbQZthbP4vchKzovWnCi7MyqPuLJxVBTptVVxZhIbjcwULPNzaS3XCse4P6ESXWS5r8pwJ9Hu",
+	"i1EX1fw6jE0cGXgfWNbPWACc2tjF7wVWZk0TFlQC+7GI4HkE3sMbVRAHBf7cFacZllEtV0BRH1yGFUvp",
+	"4XKE+lAowy38YDBfp93N7JByxDEzpSyUqwnatKlcuS4cnJBXQCKgCx9FnwRXfpwgqJ4I2vB5PBWFSlPc",
+	"BMLZyIRdUZ30xgHu1CHaty6Vyctwhji7VTnzcFhEmwGsp3a5kEASQHG5iAknRVIhQNseGf7FMsoWOGwY",
+	"2t72NfWr27ohDxI26IsEQX5VYx1A+Qc1Wi4B2BACCBvxvzPW77Rc+R/sdYCDU2GUcIIpgPYeLBpjp9Hu",
+	"sJZPH09V9dg9sHXhErkcekF8DqYrLOOUWWCKZokyJHJq963n0okaL25uSvXQNVsAC0c5DEZYhXHw2nI8",
+	"vWSoLHU7sA2+k5GxcxWTU5bZn+sS7/wcub4lFq9NoIe3hTZxuki8EyHioTmSHYdwKU+ME5duivyJm4jw",
+	"sxHs7kw8hJZOR+QHjR7BSua5ssoULm6RIfP6O94f+PpLCKFkKXxlRpqMEYQXC9ZOUck1TOt1iSpMAkEK",
+	"Zk0yyjKShbaTZXtkuJoc3HrYj+iEdf3SGoA3FAxbWhPavs12llM7a27cXwP6ZVly0TforlCcy9jF6Pgm",
+	"zg5ETOyJUlv/xOz9jTt0fyiPD6BY11EfX2Ht1/Xp/iZpf0k2fkUJ63RE5FoG6462Z7D34/J5lqagQq9n",
+	"8heKA7Hh0Lk4bLY0hC7jh7V8opGJgoEgvwghv3aQwy++imw7zDVqo9UB/KU6M9czZa0EQ0dbRJhZSbwe",
+	"P/dnlPOVHKN4NDLIyrmMJfavLsNwr5zFopI86bzn1FBpZHLlW0NJKzLD0nbZQ9KhDobhVjGSK+mVEdt4",
+	"o1VD4svg9ELmN1QdwXWtFFMNQzV6zy94vj+/kOog/UOZ2KZsmSyFbUW6/+ZM/uNNqlmaBoG3cKTInwzc",
+	"4dOiEI9IJdzE1VJFgW5O1XTWBMgssaGtAfuv1bV3amfCZT5I6WGdM7M84FRaV7u+TU45lTRmbtDwf/5j",
+	"TXDe61Cvr+pDG/RNVfxj05hxE+4RunEUy0Km2c1Obc1WfIJBkFiSxYuZMoVXosp0rFClo6aExVTNqKci",
+	"e/u45FZlEAGqgUzpfgfFBk3XYXcbkjxchhprVPgtlZGEQhvRkZAiIho9prVGIhuDMNLFl970L348efsz",
+	"vTiT+fskuzMeEp+cS8ILBc+ujYfzEQs807YapxcVoH09Pv9xo8cB0bCmmCSsOCgmyX+6Je5YRZKBf4UT",
+	"8RCV394wloCWvr6LwOES6LZQH4rHbpOqA62UFfzWOf5z4yl8KCRTpCNQGWOK++YK1VUuA4YlV4rUbjVA",
+	"wYwmQTm+Yl23RVMfR1/PC37nzmZtLhJA5gWyf7jCnWICzF0EhQsmHGhA+fkU8H8E1pSOiM4vhm8vhle/",
+	"AH8w5HRgkLJJaaoB6kMBgQ4wA/8Aq806pQhVB1/R2hdqgcmJFZ0ML89P+79cn/XfDD59OtbaBJznrVOe",
+	"949/7L9umI1qGaiVGUjRmsv4vbzB4WFKeAd8SDS6naKHEq+FfJEqS1Mdv31zPjyFqSpDloUDWD0TRXZD",
+	"SnJpDcMNR1zyntOQl/035zgiXCVBiwMyE1qM2l0xDVquDi0qy/IhNLc6ww5VQC62yKU2hRVWFWAMm+qb",
+	"qco7ZXMHEbSjykCrx/wE/4K32UqdhnpiufhcQv87IS4RVrKreYsaGycjq2eL1AdaU9j2z1NlfJENqKOM",
+	"7LtsKe7F2nA2ze3qeGjKRMZCA3jXkSIsi1pZSh9LgPvh0i7KUWcLW4zMWAlJ2ndoqUbag45e1PzJq+mS",
+	"+jpRfE4bp5Ej405oY6w5AM43gmckX1M6drPgxH+o5lvWcAZ21nS3IYyl4Y6CgGKPpm/X3Iaat4i6hhsH",
+	"ydehsM6L7n352cVsq4wtRaIKlUNIhi10LBJ9o6g5SENncmTtlIzAxWEhIs8WmKEeZ3zjzbUitwsCQUV0",
+	"QCEurxJ/i5IzZmT8ZXirylKZ6gOQHjtFZsQBGDz2BmMkUlnHc3jSdp3+fPyKNMwA4ixRR+zPBkAuf+h3",
+	"9p88hZVmRolUGwxhcxkcqE4MT0ibaPs+Nlk+c12kdIL/VYL+dDPWfrzJru1U7j95Sr+PRiYi33OuRBQ8",
+	"9q0Kp+pDCFvFSxHYN7sjc3WXOWxXfTjcDo/NgAKjGIKHRBmbdQl+qfX1+Yyb6c/XAfnPEFrsdqtKCsKq",
+	"YhcmgDWgiteyUO+Vmqt8gxBMr1psmFx+4Ct9WaFNkZWC2kQbveouHRlXN0yKX/pvTsXDLMfSk4+ELXIl",
+	"cRnHXsa5UrN5ytUxk+B3iM5QmnwWVkSdTicSZS8upyZb74iNIEeORJS3JozUmOdZsoiBfak8GL8Nt9ZY",
+	"G5eXWzAcxCjKslvlF6UBwGJXS/eBY1UB7G5SC8JFteQxvM1e1XC8qwAEOOQkDYK/g0TZkalKaDhMpM18",
+	"UXSB66i7LhkXIjFGLaPaVQLb7HAgpvTJZgl00tQ8BVc2s5XPqI2GWQoPD8Zg5lJjONQ/sxKB5RvO0ULk",
+	"Ukzd0Bpz76XF1ssjQ+RmaUwxVrboqMkky4suo3JB0MgiKKbpzS0qoUpyVBpH+IhsuC6ORDS4uHh7EQll",
+	"ilwri0XNfePCJUYXebrw+daOztsi+rl/cTY8e10bIDh8FHOKXDVxHXiwH8/InGUF2pWA9mB9FgWjuCxg",
+	"VnbGrdizuD8OC9uu9hBtrr8AmwTUlRO+q3S6lLO0yqt9mOZYG4nWnwbzxu8nhpZrKollvSu5fMc1C3cS",
+	"qUd0qZx/k003yaZEUuElsAPHDq6H3QTU0jyLjbN3sgX7ray2myo54wT14TBIiNRu0s1d/E6lbFcJBw+Y",
+	"Uz7zrL0xyIhLJq9ap8l1U3GvV2vDB/FAFPUDoaGDmrE6oh6XkR94ZEhUFhH0bI0qVE2QakPiMPV6RZtA",
+	"mWNzi8UQRoYKYtSSYRx0ZcxUaPhibMLFVI1KknZk7lSaslovopkqZCIL2aUlRi/dAoWsf0sIKjJhlRqZ",
+	"cjdoA2m7+Atc0BphdVAjoq2mbyIMtwVWQIjUdxQh9VLkaq5cDK8bBiEqEx7CxOx/tMI1fQfHbVEoeMPc",
+	"6jwzM2WK7+imwfl/hW/naZYoFyLfZGon2Cqmdl2omW0wN3sGLfNcYpYedtxne/3XzRCqY/6b7fvLFHqo",
+	"sLmAla3yOj6jyM4ypv6tTBc16u8XJknVWo57iZK6bbR6o7h98289n6sEVYUxjiUKmY9lmrJHO9IzmIek",
+	"GZotwohuS455Enkcu7hGNcXA7N9dDk8Gx/2LqMuNaUtR/i7XRaFMTS2HI1zVxrugIkci6MA7MvVXPKMC",
+	"IShqI/dywUhlMl+lcLErgp4tivmioKrBmVHWB9bLPJ6Cg6IElQPUqzuaK0REW0iqISMLIbHBdJuqVk7S",
+	"hZ36au+BfQNNL7R/FDJllr4AjVfuR2amZhn2Pkm4foUVuYpV6DrhdIElAsOwOi2OqBU5ck73DbdijFzZ",
+	"fOrfF0E2g3K97wCoir1ZFyODZcZcuYs4M4a1AI01efIwWirAXZEvTIz0TXmVAApWM2YrI+K7T82/83wx",
+	"hzcZAEyisIuZ15AQgmuEKBJlJWkknwndOqm0Ra3LZ406OBsBUYiqBgQMN3TFpP5VbiUrlUUR74ybsrHy",
+	"yPhWmS4LIst94j/oHURDsFO0SpVUtw9rsvg/gbSarsrBh/p53NoJE6UbQqxfANogYJPg2llTR6qswF25",
+	"w5x3mOSaVrsFYsyOvuEQeuja8BobvrXaKw/eWZVzl8EdVkP7PTwRFs+U85RQ4+o2Fa8CchKyidzqJVqA",
+	"I6zBSUCHm5pjNtZr2fn6Bqb8CfpcFU+vVzl7aHfS6ptZf9MNPqhRGKWDl8jc4YaeyFgVdidtKMF69nHh",
+	"Kp1VWq177k/d7wOLO8Ta473ibcZl7baqgoUWDk09iXmYmDoZoXkf3W/jhU7LvqQpl9gn1wHxAu7zXvIF",
+	"Gx1VXiDHsjZwk5GLtRrje/1eLctvVvOO2+VKHErAu8lYoTdZN9Qcf+P0oms0kd/kchYdOWjibIFWvFB9",
+	"ytGenk3EXq8HYz/c6+z1em2x19vr7MM/ut1uW7zo4c+9R10xmM3dZzVVb5PV/RVt/le3ufM83yzuDQK4",
+	"P1XOIwfHyRET0BCdhJ0yoY5IDt5gdefO6ZXjwOfY9wQuo8tT/V6JzPhy5zYDG/NM3xAxiQJTXjNDDR3C",
+	"YJOMnFtBG0zlW3yQpZ2OaZ2ywki2IS6G8R7RQZot0kLPZV48htumA2ITfTKTRk/gPexv5eUhCaencTxg",
+	"ltikKsvRdcDdQYyiAbBdlbpBRqQweqFv0EBbusXgiQtJIEaY0sdtV4cXfqEIFdJTBMfpwtuhtM/R/j7m",
+	"bmQGbAqGo+zKiTm96yWJ6Q6YbFHE2UyROMoimzZVgbErvl/6Wpa0+fh52P1Dm0TNlUnQuEx7bUaGXkP2",
+	"TAK3j2SxRTbHlaAKY6mdh4hkkc10XLZ8oiHqa/HhL5RGhWoJiLneB0Dz4pRtFO/D5YEW9qo/PB2cRO0A",
+	"BPy9//3biyt8APtpvH18ZEi3bGKIw1J9JGPiRlGVFBEwqPByKhjFBfkonXUlFQhLf6LmIJXDgXyq4ahV",
+	"B53ngMVC060BtG6bBAg+QxZPCqbbleRPR4gZENMJYMwZoLaKlKsmKccIGhL0YdomVtCu84JWe/vMFd/E",
+	"P8ppSxWDfGR/TDSNW+A69wU9X3FdqLxDVFwpqfNN+m68vhmHFk6/a6au0ROJ18jOmW18a5dWss13d1Z6",
+	"nOcqF5EzQMHt44I+uR9emslEJc5IsD3GfJrdVTyy7sYGNZ/u67fn/evv352dYEDiRsvcwyibSxTXk0iQ",
+	"FeuRi5E8ezV8/aZ/jkP8uBir3ChY2THWQX4j5yJZzOZt4VzyruR9+Rx8HMI74tnJT8/wFvD+1vFSRO8X",
+	"YxUXKRaMoFLLMzkXnUygvQXFazz8MCndUjKO1ZwvGvA4UHfMc1dptZpcjTl7fPcXU3tUNs3SltwisbRs",
+	"lxoZt11YMMP5o5M8QzTCjcXZzguMlI+nMpdxofLQUDgyqSrwN3g/0Te6AJc2CAFBf4Dpcg731MMITtm/",
+	"H1MQ1fXtPs0/Mu4Deu4qvt7uR4+64opqmafKiofR/3NdKFvQZ4/4UjUd4OsjQ+8ANux7pIQQUZXGajIB",
+	"rGZ5wlkQTdbYiGisf3b29qp/NXx7dhk5bGIkbsfGmaO26M3gqn/Sv+pHYowFR0RU6CIl0ykKfWEep4Ad",
+	"h1kr2Z6Ufhm+91JE8cIWXOgJhrGqEJFO6p3mammgPtcbR6yljHIYL1uaUVol+zAA0WghxjCWqIsC8SOi",
+	"LSypXmQo+uHyVobADWpzk1fAmu+yxZ3dSbOALziy+OztGRzjoPtpWlLuwvJuorhjskrIdVmmoPk7gcZ0",
+	"/B0kL2JwdRmTyq3AnYMvrpdjvWq4RXbb0dRI+R01tQd53adko5QcMchJqfzo+d2q+bHBdPgzRuq51vzz",
+	"ylEiVuMchB6rgL41oDecsjXrCE5dsJDqr0zDrXYLSGen5ZwHJheA3ANdNf1wdQYOyBeZua+9t1yIt/h+",
+	"jgl4OKtaer0JeDjb0QR8nquJ/iDmORE8Bkmx2i2LacfdHr58d8W+m6obGS87a+tuX89x9HXW3YP99qdX",
+	"487iQhUdCp/7Uwfs0Gn/dEkXuc43QXdnQdefWGRDFUOzyPKa9LaD2OsyPnYpQ9vUOMAKTSw8yeWk9Kqp",
+	"nF9KqXBrLXHK53bQVxj9srVCrDMeBJkwTsi2C5mSXeZoNYpGVIJoRsb/fp8oGtd68Gf0ve2WDsOLc017",
+	"0ahO6KagopGhwhAvy9ayHKouE+wZEL7NZo4QbXCrBwGiU0V1cgCLzaU+XuKzUlAiYQSG4Qa45GgNojcp",
+	"M8HFw4IlaJGz59MBlxk+v5xcY0ZGAf0eoS+2WNhq8R0nYZDYB+uIxJ2rohcSESTXWZVOMGEK46XClW8w",
+	"kMLIkl4cGTuVTHABaVGqLaehVfdtJfdNY+oSVcfzLUld1Bj+jsR8lgnVWGm4rDLcIDddVvKtvmqS0aXf",
+	"rj80w6gEo9ET4Z/WU4yIlEjZgp39syf//wcW03DE6A5PU0Jsqm/vmY5wB6xna9hRpSpkNVglzmbULbyN",
+	"wYf/oCYqHatMwefs14fTopjbo8ePp8Us7dq5irvAUu5uull+85htqDfqcfBphz7twhePqLJ4LKkckkm4",
+	"ko+wOlGx5HJynFgICNKzmUq0LFS6DPwteLOktdayVMITNTFanKBQAwLdhZ/gH8yP0cpe9pXlS4lfhAtH",
+	"Wy5LxCqlC4HGrslUwDlCnwynP9LB+xn2YUDzkJkVLaOYfBxF0cjo5EjsPY/3x08nPbmXHKgXk/3u4T5c",
+	"McoUR+Ld+Un/anAyMjD2kfhthILnqHU0arlHrfao5cC6ZrDwhaZx4WV/jeJbHFARPBm1jn7rdrsfPzKM",
+	"0Fa3umpistlc/mtBd4rG6BrLzc45HxsxCTkc5CDH+KICs9cwQilIpcLYoBXU6sJHA7W9XyeqLzW05nHL",
+	"ICpUimjvDE8iMVWS2gZjxBz+foljRMIqk9g294Dj2WylwbAusD87VZXlRvUjE1OiQ5qZG5VzxkQqlwDp",
+	"WMUSI8WyTMzAoeNGmsr5XBlfftXlQ9D5gOWHYVycxIO/MaakiC4Gl7+cHUdMxz5UjhAs7BQvyHQ1uhGY",
+	"Sdl1Xq7iGknAV+yeyUQF+czOUCBzBxdgo49MAubFhD5tAZkYwg27f9ATXNxXFJmA6HahQVwuw9OsyObK",
+	"cCpRuhSVycMuCoBaHbMczAed0YMQKWVelrC7L0nO4G+tcCIJfAxnaQzUyoV4m4QEPLm7+sUuVtA5Kema",
+	"OYoj5ZdMKlUqcwFra/TeOtHfr3PgJWwS2IMrpI8iZLkdLwXXIlYYj1k/ZJwsbpUHkU5VCWPl0G1vj7VR",
+	"4MFCIEjkjer49mIgPgWuese12gw2znsMd0/nmAJt1l3m/P5jfNm9+/Hj7yjW/LECCh6EVTSukUBQuPr3",
+	"+k4JZQVaZw7l7iO+KCxytep0wiiVcCBoeXaNvcNGCcG9zxIEXydxZmKsB1hN7i71YtSYfGjsStkRlStX",
+	"tCIRsiBevJh3RZ+mHpn9Xi+sCktJ2FjEnJbxpHdQapntEA6Uq7MJG7U9LnxdQfQ/sRfoTq6rRnShZKKN",
+	"sl81kKmcpOGgDXDhIfzkM1sSBR78PlD0C5Eq2PTMqBowVGcdAaoWsXPj0a7RqHQVE4df5GnrqPVYzvXj",
+	"2z2ZzqdyD/M8mPBXexny3lDo0kwaeQP3DViKglwt5pXnZYznajH9GTqjoYOhITLOk1VOxh4zr/yyhh/M",
+	"0V8kumiYoH8+hFg+K3ACPVkCmBDFhL62SVBUU/TPh+V4A/+b+FEtm0B3qypPjU9NVbOxAvMK4oNGr4/c",
+	"+vjrx/87AA==",
 }
 
-// GetSwagger returns the content of the embedded swagger specification file
-// or error if failed to decode
+// decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
+// after base64-decoding and flate-decompressing the embedded blob.
 func decodeSpec() ([]byte, error) {
-	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	encoded := strings.Join(swaggerSpec, "")
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
 		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
 	}
-	zr, err := gzip.NewReader(bytes.NewReader(zipped))
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
-	}
+	zr := flate.NewReader(bytes.NewReader(compressed))
 	var buf bytes.Buffer
-	_, err = buf.ReadFrom(zr)
-	if err != nil {
-		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	if _, err := buf.ReadFrom(zr); err != nil {
+		return nil, fmt.Errorf("read flate: %w", err)
+	}
+	if err := zr.Close(); err != nil {
+		return nil, fmt.Errorf("close flate reader: %w", err)
 	}
 
 	return buf.Bytes(), nil
@@ -128,7 +472,7 @@ func decodeSpec() ([]byte, error) {
 
 var rawSpec = decodeSpecCached()
 
-// a naive cached of a decoded swagger spec
+// a naive cache of the decoded OpenAPI spec
 func decodeSpecCached() func() ([]byte, error) {
 	data, err := decodeSpec()
 	return func() ([]byte, error) {
@@ -146,12 +490,12 @@ func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
 	return res
 }
 
-// GetSwagger returns the Swagger specification corresponding to the generated code
-// in this file. The external references of Swagger specification are resolved.
-// The logic of resolving external references is tightly connected to "import-mapping" feature.
-// Externally referenced files must be embedded in the corresponding golang packages.
-// Urls can be supported but this task was out of the scope.
-func GetSwagger() (swagger *openapi3.T, err error) {
+// GetSpec returns the OpenAPI specification corresponding to the generated
+// code in this file. External references in the spec are resolved through
+// PathToRawSpec; externally-referenced files must be embedded in their
+// corresponding Go packages (via the import-mapping feature). URL-based
+// external refs are not supported.
+func GetSpec() (swagger *openapi3.T, err error) {
 	resolvePath := PathToRawSpec("")
 
 	loader := openapi3.NewLoader()
@@ -177,3 +521,22 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
+
+// GetSpecJSON returns the raw JSON bytes of the embedded OpenAPI
+// specification: decompressed but not unmarshaled. External references
+// are not resolved here; the bytes are the spec exactly as embedded by
+// codegen. The result is cached at package init time, so repeated calls
+// are cheap.
+func GetSpecJSON() ([]byte, error) {
+	return rawSpec()
+}
+
+// GetSwagger returns the OpenAPI specification corresponding to the
+// generated code in this file.
+//
+// Deprecated: GetSwagger predates kin-openapi renaming openapi3.Swagger
+// to openapi3.T. Use [GetSpec] instead. This wrapper is retained for
+// backwards compatibility.
+func GetSwagger() (*openapi3.T, error) {
+	return GetSpec()
+}
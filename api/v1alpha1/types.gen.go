@@ -1,12 +1,122 @@
 // Package v1alpha1 provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package v1alpha1
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditScrubRequestMode.
+const (
+	AuditScrubDelete AuditScrubRequestMode = "DELETE"
+	AuditScrubRedact AuditScrubRequestMode = "REDACT"
+)
+
+// Valid indicates whether the value is a known member of the AuditScrubRequestMode enum.
+func (e AuditScrubRequestMode) Valid() bool {
+	switch e {
+	case AuditScrubDelete:
+		return true
+	case AuditScrubRedact:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditScrubResultMode.
+const (
+	AuditScrubResultDelete AuditScrubResultMode = "DELETE"
+	AuditScrubResultRedact AuditScrubResultMode = "REDACT"
+)
+
+// Valid indicates whether the value is a known member of the AuditScrubResultMode enum.
+func (e AuditScrubResultMode) Valid() bool {
+	switch e {
+	case AuditScrubResultDelete:
+		return true
+	case AuditScrubResultRedact:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BundleImportItemStatus.
+const (
+	CREATED BundleImportItemStatus = "CREATED"
+	FAILED  BundleImportItemStatus = "FAILED"
+	SKIPPED BundleImportItemStatus = "SKIPPED"
+)
+
+// Valid indicates whether the value is a known member of the BundleImportItemStatus enum.
+func (e BundleImportItemStatus) Valid() bool {
+	switch e {
+	case CREATED:
+		return true
+	case FAILED:
+		return true
+	case SKIPPED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for DraftPolicyOutcome.
+const (
+	DraftApplied    DraftPolicyOutcome = "APPLIED"
+	DraftFailed     DraftPolicyOutcome = "FAILED"
+	DraftNotReached DraftPolicyOutcome = "NOT_REACHED"
+	DraftRejected   DraftPolicyOutcome = "REJECTED"
+	DraftSkipped    DraftPolicyOutcome = "SKIPPED"
+	DraftUndefined  DraftPolicyOutcome = "UNDEFINED"
+)
+
+// Valid indicates whether the value is a known member of the DraftPolicyOutcome enum.
+func (e DraftPolicyOutcome) Valid() bool {
+	switch e {
+	case DraftApplied:
+		return true
+	case DraftFailed:
+		return true
+	case DraftNotReached:
+		return true
+	case DraftRejected:
+		return true
+	case DraftSkipped:
+		return true
+	case DraftUndefined:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EngineRebuildState.
+const (
+	EngineRebuildFailed    EngineRebuildState = "FAILED"
+	EngineRebuildRunning   EngineRebuildState = "RUNNING"
+	EngineRebuildSucceeded EngineRebuildState = "SUCCEEDED"
 )
 
+// Valid indicates whether the value is a known member of the EngineRebuildState enum.
+func (e EngineRebuildState) Valid() bool {
+	switch e {
+	case EngineRebuildFailed:
+		return true
+	case EngineRebuildRunning:
+		return true
+	case EngineRebuildSucceeded:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ErrorType.
 const (
 	ABORTED            ErrorType = "ABORTED"
@@ -24,12 +134,637 @@ const (
 	UNIMPLEMENTED      ErrorType = "UNIMPLEMENTED"
 )
 
+// Valid indicates whether the value is a known member of the ErrorType enum.
+func (e ErrorType) Valid() bool {
+	switch e {
+	case ABORTED:
+		return true
+	case ALREADYEXISTS:
+		return true
+	case DEADLINEEXCEEDED:
+		return true
+	case FAILEDPRECONDITION:
+		return true
+	case INTERNAL:
+		return true
+	case INVALIDARGUMENT:
+		return true
+	case NOTFOUND:
+		return true
+	case OUTOFRANGE:
+		return true
+	case PERMISSIONDENIED:
+		return true
+	case RESOURCEEXHAUSTED:
+		return true
+	case UNAUTHENTICATED:
+		return true
+	case UNAVAILABLE:
+		return true
+	case UNIMPLEMENTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EvaluationOrderEntryPolicyType.
+const (
+	EvaluationOrderGlobal EvaluationOrderEntryPolicyType = "GLOBAL"
+	EvaluationOrderUser   EvaluationOrderEntryPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the EvaluationOrderEntryPolicyType enum.
+func (e EvaluationOrderEntryPolicyType) Valid() bool {
+	switch e {
+	case EvaluationOrderGlobal:
+		return true
+	case EvaluationOrderUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GatekeeperConversionIssueSeverity.
+const (
+	ERROR   GatekeeperConversionIssueSeverity = "ERROR"
+	WARNING GatekeeperConversionIssueSeverity = "WARNING"
+)
+
+// Valid indicates whether the value is a known member of the GatekeeperConversionIssueSeverity enum.
+func (e GatekeeperConversionIssueSeverity) Valid() bool {
+	switch e {
+	case ERROR:
+		return true
+	case WARNING:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PolicyPolicyType.
 const (
 	GLOBAL PolicyPolicyType = "GLOBAL"
 	USER   PolicyPolicyType = "USER"
 )
 
+// Valid indicates whether the value is a known member of the PolicyPolicyType enum.
+func (e PolicyPolicyType) Valid() bool {
+	switch e {
+	case GLOBAL:
+		return true
+	case USER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyCatalogEntryPolicyType.
+const (
+	CatalogGlobal PolicyCatalogEntryPolicyType = "GLOBAL"
+	CatalogUser   PolicyCatalogEntryPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the PolicyCatalogEntryPolicyType enum.
+func (e PolicyCatalogEntryPolicyType) Valid() bool {
+	switch e {
+	case CatalogGlobal:
+		return true
+	case CatalogUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyConflictKind.
+const (
+	ConflictCompile     PolicyConflictKind = "COMPILE"
+	ConflictDisplayName PolicyConflictKind = "DISPLAY_NAME"
+	ConflictPackage     PolicyConflictKind = "PACKAGE"
+	ConflictPriority    PolicyConflictKind = "PRIORITY"
+	ConflictSample      PolicyConflictKind = "SAMPLE"
+)
+
+// Valid indicates whether the value is a known member of the PolicyConflictKind enum.
+func (e PolicyConflictKind) Valid() bool {
+	switch e {
+	case ConflictCompile:
+		return true
+	case ConflictDisplayName:
+		return true
+	case ConflictPackage:
+		return true
+	case ConflictPriority:
+		return true
+	case ConflictSample:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyImportItemStatus.
+const (
+	PolicyImportAborted PolicyImportItemStatus = "ABORTED"
+	PolicyImportCreated PolicyImportItemStatus = "CREATED"
+	PolicyImportFailed  PolicyImportItemStatus = "FAILED"
+)
+
+// Valid indicates whether the value is a known member of the PolicyImportItemStatus enum.
+func (e PolicyImportItemStatus) Valid() bool {
+	switch e {
+	case PolicyImportAborted:
+		return true
+	case PolicyImportCreated:
+		return true
+	case PolicyImportFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyWarningCode.
+const (
+	WarningNotFormatted   PolicyWarningCode = "NOT_FORMATTED"
+	WarningStrict         PolicyWarningCode = "STRICT"
+	WarningUnusedArgument PolicyWarningCode = "UNUSED_ARGUMENT"
+	WarningUnusedImport   PolicyWarningCode = "UNUSED_IMPORT"
+	WarningUnusedVariable PolicyWarningCode = "UNUSED_VARIABLE"
+)
+
+// Valid indicates whether the value is a known member of the PolicyWarningCode enum.
+func (e PolicyWarningCode) Valid() bool {
+	switch e {
+	case WarningNotFormatted:
+		return true
+	case WarningStrict:
+		return true
+	case WarningUnusedArgument:
+		return true
+	case WarningUnusedImport:
+		return true
+	case WarningUnusedVariable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SelectorTermFailureReason.
+const (
+	SelectorTermMismatch SelectorTermFailureReason = "MISMATCH"
+	SelectorTermMissing  SelectorTermFailureReason = "MISSING"
+)
+
+// Valid indicates whether the value is a known member of the SelectorTermFailureReason enum.
+func (e SelectorTermFailureReason) Valid() bool {
+	switch e {
+	case SelectorTermMismatch:
+		return true
+	case SelectorTermMissing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SimulationStatus.
+const (
+	SimulationApproved SimulationStatus = "APPROVED"
+	SimulationFailed   SimulationStatus = "FAILED"
+	SimulationModified SimulationStatus = "MODIFIED"
+	SimulationRejected SimulationStatus = "REJECTED"
+)
+
+// Valid indicates whether the value is a known member of the SimulationStatus enum.
+func (e SimulationStatus) Valid() bool {
+	switch e {
+	case SimulationApproved:
+		return true
+	case SimulationFailed:
+		return true
+	case SimulationModified:
+		return true
+	case SimulationRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CreatePolicyParamsOnConflict.
+const (
+	OnConflictFail           CreatePolicyParamsOnConflict = "fail"
+	OnConflictReturnExisting CreatePolicyParamsOnConflict = "return_existing"
+)
+
+// Valid indicates whether the value is a known member of the CreatePolicyParamsOnConflict enum.
+func (e CreatePolicyParamsOnConflict) Valid() bool {
+	switch e {
+	case OnConflictFail:
+		return true
+	case OnConflictReturnExisting:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetPolicyCatalogParamsFormat.
+const (
+	CatalogFormatJSON     GetPolicyCatalogParamsFormat = "JSON"
+	CatalogFormatMarkdown GetPolicyCatalogParamsFormat = "MARKDOWN"
+)
+
+// Valid indicates whether the value is a known member of the GetPolicyCatalogParamsFormat enum.
+func (e GetPolicyCatalogParamsFormat) Valid() bool {
+	switch e {
+	case CatalogFormatJSON:
+		return true
+	case CatalogFormatMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportPolicyBundleParamsPolicyType.
+const (
+	ExportPolicyTypeGlobal ExportPolicyBundleParamsPolicyType = "GLOBAL"
+	ExportPolicyTypeUser   ExportPolicyBundleParamsPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the ExportPolicyBundleParamsPolicyType enum.
+func (e ExportPolicyBundleParamsPolicyType) Valid() bool {
+	switch e {
+	case ExportPolicyTypeGlobal:
+		return true
+	case ExportPolicyTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsFormat.
+const (
+	CONFIGMAP ImportPolicyBundleParamsFormat = "CONFIGMAP"
+	OPABUNDLE ImportPolicyBundleParamsFormat = "OPA_BUNDLE"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsFormat enum.
+func (e ImportPolicyBundleParamsFormat) Valid() bool {
+	switch e {
+	case CONFIGMAP:
+		return true
+	case OPABUNDLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsMetadataConvention.
+const (
+	ANNOTATIONS ImportPolicyBundleParamsMetadataConvention = "ANNOTATIONS"
+	NONE        ImportPolicyBundleParamsMetadataConvention = "NONE"
+	SIDECAR     ImportPolicyBundleParamsMetadataConvention = "SIDECAR"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsMetadataConvention enum.
+func (e ImportPolicyBundleParamsMetadataConvention) Valid() bool {
+	switch e {
+	case ANNOTATIONS:
+		return true
+	case NONE:
+		return true
+	case SIDECAR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsPolicyType.
+const (
+	ImportPolicyTypeGlobal ImportPolicyBundleParamsPolicyType = "GLOBAL"
+	ImportPolicyTypeUser   ImportPolicyBundleParamsPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsPolicyType enum.
+func (e ImportPolicyBundleParamsPolicyType) Valid() bool {
+	switch e {
+	case ImportPolicyTypeGlobal:
+		return true
+	case ImportPolicyTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuditEntry One entry of the hash-chained audit log
+type AuditEntry struct {
+	// Data Event details
+	Data map[string]interface{} `json:"data"`
+
+	// DataDigest Hex SHA-256 digest of the event details
+	DataDigest string `json:"data_digest"`
+
+	// Hash Hex HMAC-SHA256 (SHA-256 when no signing key is configured) over the
+	// entry's sequence, time, type, subject, data digest and previous hash
+	Hash string `json:"hash"`
+
+	// PrevHash Hash of the previous entry, empty for the first entry
+	PrevHash string `json:"prev_hash"`
+
+	// Redacted Whether the details were scrubbed after recording. `data_digest`
+	// still covers the original details, so `data` no longer matches it.
+	Redacted bool `json:"redacted"`
+
+	// Sequence Position of the entry in the log, starting at 1
+	Sequence int64 `json:"sequence"`
+
+	// Subject ID of the policy the entry concerns, empty for approved evaluations
+	Subject string `json:"subject"`
+
+	// Time Time the entry was recorded
+	Time time.Time `json:"time"`
+
+	// Type Event recorded by the entry
+	Type string `json:"type"`
+}
+
+// AuditEntryList defines model for AuditEntryList.
+type AuditEntryList struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+}
+
+// AuditScrubRequest Identifies the data subject by `label_key` and `label_value`, or by
+// `user`.
+type AuditScrubRequest struct {
+	// DryRun Report the matching entries without changing them
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// LabelKey Request label identifying the data subject
+	LabelKey *string `json:"label_key,omitempty"`
+
+	// LabelValue Value of the label for the data subject
+	LabelValue *string `json:"label_value,omitempty"`
+
+	// Mode How matching entries are scrubbed
+	Mode *AuditScrubRequestMode `json:"mode,omitempty"`
+
+	// User Principal or requester identifying the data subject, matched
+	// against the request context `requester` of evaluation entries and
+	// the `created_by` and `updated_by` principals of policy entries,
+	// policies and policy revisions
+	User *string `json:"user,omitempty"`
+}
+
+// AuditScrubRequestMode How matching entries are scrubbed
+type AuditScrubRequestMode string
+
+// AuditScrubResult defines model for AuditScrubResult.
+type AuditScrubResult struct {
+	// AffectedEntries Number of entries scrubbed, or that would be scrubbed in a dry run
+	AffectedEntries int64 `json:"affected_entries"`
+
+	// AffectedPolicies Number of policies whose principals the scrub of a user replaced, or would replace in a dry run
+	AffectedPolicies int64 `json:"affected_policies"`
+
+	// AffectedRevisions Number of policy revisions whose principals the scrub of a user replaced, or would replace in a dry run
+	AffectedRevisions int64                `json:"affected_revisions"`
+	DryRun            bool                 `json:"dry_run"`
+	Mode              AuditScrubResultMode `json:"mode"`
+
+	// Sequences Sequences of the affected entries, in ascending order
+	Sequences []int64 `json:"sequences"`
+}
+
+// AuditScrubResultMode defines model for AuditScrubResult.Mode.
+type AuditScrubResultMode string
+
+// AuditVerification Result of verifying the audit log hash chain
+type AuditVerification struct {
+	// EntriesVerified Number of entries checked before the first failure, or in total when valid
+	EntriesVerified int64 `json:"entries_verified"`
+
+	// FirstInvalidSequence Sequence of the first entry that failed verification
+	FirstInvalidSequence *int64 `json:"first_invalid_sequence,omitempty"`
+
+	// HeadHash Hash of the last entry of a valid log. Removing entries from the
+	// end of the log keeps the remaining chain valid; record this value
+	// externally to detect it.
+	HeadHash *string `json:"head_hash,omitempty"`
+
+	// Reason Why the first invalid entry failed verification
+	Reason *string `json:"reason,omitempty"`
+
+	// Valid Whether every entry verified
+	Valid bool `json:"valid"`
+}
+
+// BuildInfo defines model for BuildInfo.
+type BuildInfo struct {
+	// BuildDate Build time in RFC 3339 format, empty when unknown
+	BuildDate string `json:"build_date"`
+
+	// Capabilities Capabilities compiled into the binary
+	Capabilities map[string]bool `json:"capabilities"`
+
+	// FeatureFlags Optional features and whether they are enabled in this deployment
+	FeatureFlags map[string]bool `json:"feature_flags"`
+
+	// GitSha Git commit the binary was built from, empty when unknown
+	GitSha string `json:"git_sha"`
+
+	// GoVersion Go toolchain the binary was built with
+	GoVersion string `json:"go_version"`
+
+	// Telemetry Opt-in anonymous usage telemetry. When enabled, a TelemetryReport is
+	// POSTed to `endpoint` at startup and then every `interval`. Reports
+	// never contain policy IDs, names, Rego, labels or request content.
+	Telemetry TelemetryStatus `json:"telemetry"`
+
+	// Version Release version, or `dev` for local builds
+	Version string `json:"version"`
+}
+
+// BundleImportItem defines model for BundleImportItem.
+type BundleImportItem struct {
+	// Detail Reason the file was skipped or failed
+	Detail *string `json:"detail,omitempty"`
+
+	// FilePath Path of the file inside the bundle, or the ConfigMap data key prefixed by the ConfigMap name
+	FilePath string `json:"file_path"`
+
+	// PolicyId ID of the policy the file was (or would have been) imported as
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Status CREATED - A policy was created from the file
+	// SKIPPED - The file is not an importable policy (test, data or metadata file)
+	// FAILED - The file could not be imported; see detail
+	Status BundleImportItemStatus `json:"status"`
+}
+
+// BundleImportItemStatus CREATED - A policy was created from the file
+// SKIPPED - The file is not an importable policy (test, data or metadata file)
+// FAILED - The file could not be imported; see detail
+type BundleImportItemStatus string
+
+// BundleImportResult Outcome of a bundle or ConfigMap import
+type BundleImportResult struct {
+	// Results One entry per file found in the upload, in path order
+	Results []BundleImportItem `json:"results"`
+}
+
+// ConfigReload defines model for ConfigReload.
+type ConfigReload struct {
+	// ReloadTime When the configuration was reloaded
+	ReloadTime time.Time `json:"reload_time"`
+
+	// Settings The reloaded settings, by environment variable name
+	Settings map[string]string `json:"settings"`
+}
+
+// DependencyStatus defines model for DependencyStatus.
+type DependencyStatus struct {
+	// Message Why the dependency is not ready; absent when it is
+	Message *string `json:"message,omitempty"`
+
+	// Name The dependency: `database`, `policy_engine` or `policy_sync`. Later
+	// versions may check more.
+	Name string `json:"name"`
+
+	// Status `ok` or `failed`
+	Status string `json:"status"`
+}
+
+// DraftPolicyOutcome What the draft policy did:
+// - `APPLIED`: it was evaluated and its decision applied.
+// - `UNDEFINED`: it returned no decision.
+// - `REJECTED`: it rejected the request.
+// - `FAILED`: its decision could not be applied or it could not be evaluated.
+// - `SKIPPED`: its label selector does not match the request.
+// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+type DraftPolicyOutcome string
+
+// DraftPolicyResult defines model for DraftPolicyResult.
+type DraftPolicyResult struct {
+	// Constraints Constraints the draft returned
+	Constraints *map[string]interface{} `json:"constraints,omitempty"`
+
+	// Outcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	Outcome DraftPolicyOutcome `json:"outcome"`
+
+	// Patch Patch the draft returned
+	Patch *map[string]interface{} `json:"patch,omitempty"`
+
+	// Reason Why the draft rejected the request or failed
+	Reason *string `json:"reason,omitempty"`
+
+	// SelectedProvider Service provider the draft selected
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+}
+
+// EngineApiKey An API key identifying an engine API caller
+type EngineApiKey struct {
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// CreatedBy Principal that created the key, when known
+	CreatedBy *string `json:"created_by,omitempty"`
+	Id        *string `json:"id,omitempty"`
+
+	// Identity Identity of the callers using the key, matched against
+	// `ENGINE_AUTHZ_EVALUATE_IDENTITIES` and `ENGINE_AUTHZ_EXPLAIN_IDENTITIES`
+	Identity string `json:"identity"`
+
+	// Key The key; only returned when it is created
+	Key *string `json:"key,omitempty"`
+
+	// KeyPrefix The first characters of the key, to tell keys apart
+	KeyPrefix *string `json:"key_prefix,omitempty"`
+
+	// Path Resource path in the format "engine-api-keys/{keyId}"
+	Path *string `json:"path,omitempty"`
+}
+
+// EngineApiKeyList defines model for EngineApiKeyList.
+type EngineApiKeyList struct {
+	// Keys Keys, oldest first, without the keys themselves
+	Keys []EngineApiKey `json:"keys"`
+
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+}
+
+// EngineHealth State of the embedded policy engine
+type EngineHealth struct {
+	// CompiledPolicies Number of policies compiled into the engine
+	CompiledPolicies int32 `json:"compiled_policies"`
+
+	// LastReconcileError Why the last reconciliation failed; absent when it succeeded
+	LastReconcileError *string `json:"last_reconcile_error,omitempty"`
+
+	// LastReconcileTime When the engine was last reconciled with the store; absent until the
+	// first reconciliation, or when reconciliation is disabled
+	LastReconcileTime *time.Time `json:"last_reconcile_time,omitempty"`
+}
+
+// EngineRebuild A rebuild of the OPA state from the stored policies
+type EngineRebuild struct {
+	// CreateTime When the rebuild was requested
+	CreateTime time.Time `json:"create_time"`
+
+	// EndTime When the rebuild succeeded or failed
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// Error Why the rebuild failed
+	Error *string `json:"error,omitempty"`
+
+	// Id Identifier of the rebuild
+	Id string `json:"id"`
+
+	// PoliciesCompiled Number of policies compiled into the engine. The policies are
+	// compiled together, so it is 0 until the rebuild succeeds and then
+	// `policies_total`.
+	PoliciesCompiled int32 `json:"policies_compiled"`
+
+	// PoliciesTotal Number of stored policies being compiled; 0 until they are read
+	PoliciesTotal int32 `json:"policies_total"`
+
+	// State `RUNNING` until the stored policies are compiled, then `SUCCEEDED`,
+	// or `FAILED` with the `error` when they could not be read or compiled
+	State EngineRebuildState `json:"state"`
+}
+
+// EngineRebuildState `RUNNING` until the stored policies are compiled, then `SUCCEEDED`,
+// or `FAILED` with the `error` when they could not be read or compiled
+type EngineRebuildState string
+
 // Error Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
@@ -57,12 +792,96 @@ type Error struct {
 // error code.
 type ErrorType string
 
+// EvaluationOrder defines model for EvaluationOrder.
+type EvaluationOrder struct {
+	// Policies Policies in evaluation order
+	Policies []EvaluationOrderEntry `json:"policies"`
+
+	// PoliciesSkipped Number of enabled policies whose label selector does not match
+	PoliciesSkipped int32 `json:"policies_skipped"`
+
+	// RequestLabels The request labels the order was computed for
+	RequestLabels map[string]string `json:"request_labels"`
+}
+
+// EvaluationOrderEntry defines model for EvaluationOrderEntry.
+type EvaluationOrderEntry struct {
+	DisplayName   string             `json:"display_name"`
+	Id            string             `json:"id"`
+	LabelSelector *map[string]string `json:"label_selector,omitempty"`
+
+	// PolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+	// add policy types, which clients must accept.
+	PolicyType EvaluationOrderEntryPolicyType `json:"policy_type"`
+
+	// Position Position in the evaluation order, starting at 1
+	Position int32 `json:"position"`
+	Priority int32 `json:"priority"`
+}
+
+// EvaluationOrderEntryPolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+// add policy types, which clients must accept.
+type EvaluationOrderEntryPolicyType string
+
+// FacetValue defines model for FacetValue.
+type FacetValue struct {
+	// Count Number of policies with this value
+	Count int32  `json:"count"`
+	Value string `json:"value"`
+}
+
+// GatekeeperConversionIssue defines model for GatekeeperConversionIssue.
+type GatekeeperConversionIssue struct {
+	Message string `json:"message"`
+
+	// Severity WARNING - A policy was produced but may behave differently than in Gatekeeper
+	// ERROR - No policy was produced for the resource
+	Severity GatekeeperConversionIssueSeverity `json:"severity"`
+
+	// Source Gatekeeper resource the issue applies to, as `<kind>/<name>`
+	Source string `json:"source"`
+}
+
+// GatekeeperConversionIssueSeverity WARNING - A policy was produced but may behave differently than in Gatekeeper
+// ERROR - No policy was produced for the resource
+type GatekeeperConversionIssueSeverity string
+
+// GatekeeperConversionResult Policies converted from Gatekeeper resources and the conversion report
+type GatekeeperConversionResult struct {
+	// Policies Converted policies, ready to be created
+	Policies []Policy `json:"policies"`
+
+	// Report Constructs that could not be converted faithfully
+	Report []GatekeeperConversionIssue `json:"report"`
+}
+
 // Health defines model for Health.
 type Health struct {
+	// Engine State of the embedded policy engine
+	Engine *EngineHealth `json:"engine,omitempty"`
+
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
-	// Status Health status
+	// Status Health status: `ok`, or `degraded` when the last reconciliation of
+	// the policy engine with the store failed. The response is 200 either
+	// way; the engine keeps serving the policies it compiled last.
+	Status string `json:"status"`
+}
+
+// LabelSelectorKeyFacet defines model for LabelSelectorKeyFacet.
+type LabelSelectorKeyFacet struct {
+	// Count Number of policies whose label selector has this key
+	Count int32  `json:"count"`
+	Key   string `json:"key"`
+
+	// Values Values selected for this key, in alphabetical order
+	Values []FacetValue `json:"values"`
+}
+
+// Liveness defines model for Liveness.
+type Liveness struct {
+	// Status Always `ok`
 	Status string `json:"status"`
 }
 
@@ -76,12 +895,23 @@ type Health struct {
 // policy_type, and rego_code are required (enforced by the service). On
 // update, only fields present in the request body are merged (RFC 7396).
 type Policy struct {
+	// Annotations Free-form key-value metadata about the policy, such as the team
+	// that owns it or the ticket that introduced it. Like `parameters`,
+	// annotations are passed to the policy's Rego as
+	// `input.policy.annotations`.
+	Annotations *map[string]string `json:"annotations,omitempty"`
+
 	// CreateTime Timestamp when the policy was created. This field is output-only
 	// and automatically set by the server.
 	//
 	// Uses ISO 8601 format with timezone per AEP-140.
 	CreateTime *time.Time `json:"create_time,omitempty"`
 
+	// CreatedBy Principal that created the policy. This field is output-only. It
+	// is unset when the server records no principal, and for policies
+	// created before principals were recorded.
+	CreatedBy *string `json:"created_by,omitempty"`
+
 	// Description Optional detailed description of the policy's purpose and behavior.
 	// Supports markdown formatting.
 	Description *string `json:"description,omitempty"`
@@ -90,8 +920,14 @@ type Policy struct {
 	// user interfaces and should be descriptive.
 	DisplayName *string `json:"display_name,omitempty"`
 
+	// Documentation Human-readable documentation of a policy, published in the policy
+	// catalog. Every field is optional; the object is replaced as a whole
+	// on update.
+	Documentation *PolicyDocumentation `json:"documentation,omitempty"`
+
 	// Enabled Whether the policy is currently active. Disabled policies are not
-	// evaluated during authorization decisions.
+	// evaluated during authorization decisions. When omitted on create,
+	// the deployment's default applies: true unless configured otherwise.
 	Enabled *bool `json:"enabled,omitempty"`
 
 	// Id Unique identifier for the policy. This field is output-only and
@@ -113,6 +949,17 @@ type Policy struct {
 	// - tier: service tier (critical, standard, etc.)
 	LabelSelector *map[string]string `json:"label_selector,omitempty"`
 
+	// Lock An advisory lock on a policy held by an editor until it expires.
+	// Output-only in a policy, where it is set by get and list while the
+	// lock is held.
+	Lock *PolicyLock `json:"lock,omitempty"`
+
+	// Parameters Configuration values of the policy, passed to its Rego as
+	// `input.policy.parameters`, so one Rego module can be deployed as
+	// several policies that differ only in their parameters instead of
+	// duplicating values in code. At most 64 KiB of JSON.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
 	// Path Resource path in the format "policies/{policyId}".
 	// This field is output-only and set by the server.
 	//
@@ -125,6 +972,9 @@ type Policy struct {
 	// - USER: Applies to requests for a specific user
 	//
 	// Policies are evaluated in hierarchical order: Global -> User
+	//
+	// Later versions may add policy types. Clients must accept types they
+	// do not know and keep them unchanged when they send the policy back.
 	PolicyType *PolicyPolicyType `json:"policy_type,omitempty"`
 
 	// Priority Priority value for policy evaluation order. Lower numbers have
@@ -134,6 +984,9 @@ type Policy struct {
 	// - 1-100: Critical/system policies
 	// - 101-500: Standard policies
 	// - 501-1000: Low-priority policies
+	//
+	// When omitted on create, the deployment's default priority strategy
+	// picks it: 500 by default.
 	Priority *int32 `json:"priority,omitempty"`
 
 	// RegoCode The OPA Rego policy code. This code is executed when the policy
@@ -147,11 +1000,29 @@ type Policy struct {
 	// The Rego code is validated on create and update operations.
 	RegoCode *string `json:"rego_code,omitempty"`
 
+	// RejectionMessages Message templates keyed by rejection code. When the policy rejects a
+	// request with a `rejection_code`, the template for that code becomes
+	// the rejection message, with `{name}` placeholders replaced by the
+	// decision's `rejection_params` (and `{policy_id}`). A message catalog
+	// configured on the server takes precedence for the languages the
+	// request accepts.
+	RejectionMessages *map[string]string `json:"rejection_messages,omitempty"`
+
 	// UpdateTime Timestamp when the policy was last updated. This field is output-only
 	// and automatically updated by the server on any modification.
 	//
 	// Uses ISO 8601 format with timezone per AEP-140.
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// UpdatedBy Principal that made the last change to the policy. This field is
+	// output-only and unset when that change recorded no principal.
+	UpdatedBy *string `json:"updated_by,omitempty"`
+
+	// Warnings Problems found by linting the `rego_code`: formatting that differs
+	// from `opa fmt`, and unused imports, variables and function arguments.
+	// Warnings never block a change. This field is output-only and only
+	// set in create, update, apply and rollback responses.
+	Warnings *[]PolicyWarning `json:"warnings,omitempty"`
 }
 
 // PolicyPolicyType Scope of the policy application. This field is immutable after creation.
@@ -160,31 +1031,670 @@ type Policy struct {
 // - USER: Applies to requests for a specific user
 //
 // Policies are evaluated in hierarchical order: Global -> User
-type PolicyPolicyType string
-
-// PolicyList Response message for listing policies.
 //
-// Implements AEP-132 List standard method requirements.
-type PolicyList struct {
-	// NextPageToken Token for retrieving the next page of results. If empty or not
-	// present, there are no more results.
-	//
-	// This token is opaque and should not be parsed by clients.
-	NextPageToken *string `json:"next_page_token,omitempty"`
+// Later versions may add policy types. Clients must accept types they
+// do not know and keep them unchanged when they send the policy back.
+type PolicyPolicyType string
 
-	// Policies List of policy resources matching the request criteria
-	Policies []Policy `json:"policies"`
+// PolicyCatalog The enabled policies with their documentation, in evaluation order
+type PolicyCatalog struct {
+	// GenerateTime When the catalog was generated
+	GenerateTime time.Time            `json:"generate_time"`
+	Policies     []PolicyCatalogEntry `json:"policies"`
 }
 
-// PolicyIdPath defines model for PolicyIdPath.
-type PolicyIdPath = string
+// PolicyCatalogEntry defines model for PolicyCatalogEntry.
+type PolicyCatalogEntry struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"display_name"`
 
-// AlreadyExists Error response following RFC 7807 Problem Details and AEP-193.
-//
-// Provides structured error information for API failures.
-type AlreadyExists = Error
+	// Documentation Human-readable documentation of a policy, published in the policy
+	// catalog. Every field is optional; the object is replaced as a whole
+	// on update.
+	Documentation *PolicyDocumentation `json:"documentation,omitempty"`
+	Id            string               `json:"id"`
+	LabelSelector *map[string]string   `json:"label_selector,omitempty"`
 
-// BadRequest Error response following RFC 7807 Problem Details and AEP-193.
+	// PolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+	// add policy types, which clients must accept.
+	PolicyType PolicyCatalogEntryPolicyType `json:"policy_type"`
+	Priority   int32                        `json:"priority"`
+	UpdateTime time.Time                    `json:"update_time"`
+}
+
+// PolicyCatalogEntryPolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+// add policy types, which clients must accept.
+type PolicyCatalogEntryPolicyType string
+
+// PolicyChecksum Deterministic digest of the stored policy set
+type PolicyChecksum struct {
+	// Checksum Hex SHA-256 digest of the policy set, prefixed with `sha256:`
+	Checksum string `json:"checksum"`
+
+	// PolicyCount Number of policies the checksum covers
+	PolicyCount int32 `json:"policy_count"`
+}
+
+// PolicyConflict defines model for PolicyConflict.
+type PolicyConflict struct {
+	// Detail Description of the conflict
+	Detail string `json:"detail"`
+
+	// Kind What the candidate conflicts on; see `checkPolicyConflicts`
+	Kind PolicyConflictKind `json:"kind"`
+
+	// PolicyId Stored policy the candidate conflicts with, when there is one
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// SampleIndex Index of the sample the conflict was found on; set for `SAMPLE` conflicts
+	SampleIndex *int32 `json:"sample_index,omitempty"`
+}
+
+// PolicyConflictCheckRequest defines model for PolicyConflictCheckRequest.
+type PolicyConflictCheckRequest struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID of the stored policy the candidate would replace; omit it to
+	// check a new policy.
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Samples Sample service instances to evaluate the candidate's decisions on
+	Samples *[]SimulatedServiceInstance `json:"samples,omitempty"`
+}
+
+// PolicyConflictKind What the candidate conflicts on; see `checkPolicyConflicts`
+type PolicyConflictKind string
+
+// PolicyConflictReport defines model for PolicyConflictReport.
+type PolicyConflictReport struct {
+	// Conflicts Conflicts found; empty when the candidate can be created without conflicts
+	Conflicts []PolicyConflict `json:"conflicts"`
+
+	// PolicyId ID the candidate was checked under
+	PolicyId string `json:"policy_id"`
+
+	// SamplesChecked Number of samples evaluated; samples are not evaluated when the candidate does not compile
+	SamplesChecked int32 `json:"samples_checked"`
+}
+
+// PolicyDocumentation Human-readable documentation of a policy, published in the policy
+// catalog. Every field is optional; the object is replaced as a whole
+// on update.
+type PolicyDocumentation struct {
+	// OwnerContact Team, email address or channel to contact about the policy
+	OwnerContact *string `json:"owner_contact,omitempty"`
+
+	// Rationale Why the policy exists. Supports markdown formatting.
+	Rationale *string `json:"rationale,omitempty"`
+
+	// RemediationUrl http or https URL of instructions for fixing rejected requests
+	RemediationUrl *string `json:"remediation_url,omitempty"`
+
+	// Summary One-line summary of what the policy enforces
+	Summary *string `json:"summary,omitempty"`
+}
+
+// PolicyFacets Distinct policy field values with the number of policies having each
+type PolicyFacets struct {
+	// LabelSelectorKeys Label selector keys in use, in alphabetical order
+	LabelSelectorKeys []LabelSelectorKeyFacet `json:"label_selector_keys"`
+
+	// PolicyTypes Policy types in use, in alphabetical order
+	PolicyTypes []FacetValue `json:"policy_types"`
+
+	// PriorityHistogram Policy counts per priority range, in ascending order
+	PriorityHistogram []PriorityBucket `json:"priority_histogram"`
+}
+
+// PolicyFieldChange defines model for PolicyFieldChange.
+type PolicyFieldChange struct {
+	// Field The changed field, e.g. `priority`; label selector keys,
+	// rejection messages, annotations, parameters and documentation
+	// fields are reported as `label_selector.<key>`,
+	// `rejection_messages.<code>`, `annotations.<key>`,
+	// `parameters.<name>` and `documentation.<field>`
+	Field string `json:"field"`
+
+	// From The value in the `from` revision; absent when the field was not set
+	From interface{} `json:"from,omitempty"`
+
+	// To The value in the `to` revision; absent when the field was not set
+	To interface{} `json:"to,omitempty"`
+}
+
+// PolicyImportEntry defines model for PolicyImportEntry.
+type PolicyImportEntry struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID to create the policy with; the server generates one when omitted
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// RegoFile Filename of the multipart file part holding the policy's
+	// `rego_code`, without its directory. Only read from a multipart
+	// manifest.
+	RegoFile *string `json:"rego_file,omitempty"`
+}
+
+// PolicyImportItem defines model for PolicyImportItem.
+type PolicyImportItem struct {
+	// Detail Reason the entry failed
+	Detail *string `json:"detail,omitempty"`
+
+	// Index Position of the entry in the request, from 0
+	Index int32 `json:"index"`
+
+	// PolicyId ID of the policy the entry was (or would have been) created as
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Status CREATED - The policy was created
+	// FAILED - The entry could not be created; see detail
+	// ABORTED - The entry is valid but was not created, because another entry of an atomic import failed
+	Status PolicyImportItemStatus `json:"status"`
+}
+
+// PolicyImportItemStatus CREATED - The policy was created
+// FAILED - The entry could not be created; see detail
+// ABORTED - The entry is valid but was not created, because another entry of an atomic import failed
+type PolicyImportItemStatus string
+
+// PolicyImportRequest defines model for PolicyImportRequest.
+type PolicyImportRequest struct {
+	// Policies The policies to create, in order
+	Policies []PolicyImportEntry `json:"policies"`
+}
+
+// PolicyImportResult Outcome of a policy import
+type PolicyImportResult struct {
+	// Results One entry per entry of the request, in order
+	Results []PolicyImportItem `json:"results"`
+}
+
+// PolicyList Response message for listing policies.
+//
+// Implements AEP-132 List standard method requirements.
+type PolicyList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	//
+	// This token is opaque and should not be parsed by clients.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
+	// Policies List of policy resources matching the request criteria
+	Policies []Policy `json:"policies"`
+}
+
+// PolicyLock An advisory lock on a policy held by an editor until it expires.
+// Output-only in a policy, where it is set by get and list while the
+// lock is held.
+type PolicyLock struct {
+	// ExpireTime When the lock expires unless it is extended
+	ExpireTime time.Time `json:"expire_time"`
+
+	// Owner Who holds the lock, as given when locking
+	Owner string `json:"owner"`
+}
+
+// PolicyLockRequest defines model for PolicyLockRequest.
+type PolicyLockRequest struct {
+	// Owner Who takes the lock, typically the user editing the policy
+	Owner string `json:"owner"`
+
+	// TtlSeconds How long the lock is held, from now
+	TtlSeconds *int32 `json:"ttl_seconds,omitempty"`
+}
+
+// PolicyMatchTestRequest The request to test, given either as labels or as a service instance
+// spec; set at most one of them.
+type PolicyMatchTestRequest struct {
+	// Labels Request labels to test the selector against
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Spec Service instance spec, as sent to the evaluation API. The request
+	// labels are derived from it the way evaluation derives them: its
+	// `service_type` and the string values of `metadata.labels`.
+	Spec *map[string]interface{} `json:"spec,omitempty"`
+}
+
+// PolicyMatchTestResult defines model for PolicyMatchTestResult.
+type PolicyMatchTestResult struct {
+	// Enabled Whether the policy is enabled and would be evaluated for a matching request
+	Enabled bool `json:"enabled"`
+
+	// FailedTerms Selector terms the request labels do not satisfy, ordered by key
+	FailedTerms []SelectorTermFailure `json:"failed_terms"`
+
+	// LabelSelector The policy's label selector
+	LabelSelector map[string]string `json:"label_selector"`
+
+	// Matches Whether the label selector matches the request labels
+	Matches bool `json:"matches"`
+
+	// RequestLabels The request labels the selector was tested against
+	RequestLabels map[string]string `json:"request_labels"`
+}
+
+// PolicyRevision An immutable snapshot of a policy stored by a create, update or apply
+type PolicyRevision struct {
+	// CreateTime When the change was made; the policy's update_time after it
+	CreateTime time.Time `json:"create_time"`
+
+	// Path Resource path in the format "policies/{policyId}/revisions/{revision}"
+	Path *string `json:"path,omitempty"`
+
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// Revision The revision number; the create is revision 1
+	Revision int64 `json:"revision"`
+}
+
+// PolicyRevisionDiff defines model for PolicyRevisionDiff.
+type PolicyRevisionDiff struct {
+	// Changes Changed fields, in field order; label selector keys in alphabetical order
+	Changes []PolicyFieldChange `json:"changes"`
+
+	// From The revision compared from
+	From int64 `json:"from"`
+
+	// RegoDiff Unified diff of the Rego code; empty when the code is unchanged
+	RegoDiff string `json:"rego_diff"`
+
+	// To The revision compared to
+	To int64 `json:"to"`
+}
+
+// PolicyRevisionList defines model for PolicyRevisionList.
+type PolicyRevisionList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
+	// Revisions Revisions, newest first
+	Revisions []PolicyRevision `json:"revisions"`
+}
+
+// PolicyRollbackRequest defines model for PolicyRollbackRequest.
+type PolicyRollbackRequest struct {
+	// Revision The revision to restore
+	Revision int64 `json:"revision"`
+}
+
+// PolicySimulation defines model for PolicySimulation.
+type PolicySimulation struct {
+	// Detail Rejection message or failure detail; set when `status` is `REJECTED` or `FAILED`
+	Detail                   *string                   `json:"detail,omitempty"`
+	Draft                    DraftPolicyResult         `json:"draft"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// FailedPolicyId Policy that rejected the request or failed; set when `status` is `REJECTED` or `FAILED`
+	FailedPolicyId *string `json:"failed_policy_id,omitempty"`
+
+	// PoliciesEvaluated IDs of the policies evaluated, in evaluation order
+	PoliciesEvaluated []string `json:"policies_evaluated"`
+
+	// PolicyId ID the draft was simulated under
+	PolicyId string `json:"policy_id"`
+
+	// SelectedProvider Service provider selected by the policies
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+}
+
+// PolicySimulationRequest defines model for PolicySimulationRequest.
+type PolicySimulationRequest struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID to simulate the draft under. When it names a stored policy the
+	// draft replaces that policy; omit it to simulate a new policy.
+	PolicyId        *string                  `json:"policy_id,omitempty"`
+	ServiceInstance SimulatedServiceInstance `json:"service_instance"`
+}
+
+// PolicyTest A test case of a policy: a service instance and the decision expected
+// when it is evaluated
+type PolicyTest struct {
+	CreateTime  *time.Time `json:"create_time,omitempty"`
+	DisplayName *string    `json:"display_name,omitempty"`
+
+	// Expected The expected decision. `status` is always compared; the other fields
+	// only when they are set.
+	Expected PolicyTestExpectation `json:"expected"`
+	Id       *string               `json:"id,omitempty"`
+
+	// Path Resource path in the format "policies/{policyId}/tests/{testId}"
+	Path            *string                  `json:"path,omitempty"`
+	ServiceInstance SimulatedServiceInstance `json:"service_instance"`
+}
+
+// PolicyTestExpectation The expected decision. `status` is always compared; the other fields
+// only when they are set.
+type PolicyTestExpectation struct {
+	// Detail The rejection reason or failure message
+	Detail                   *string                   `json:"detail,omitempty"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// PolicyOutcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	PolicyOutcome *DraftPolicyOutcome `json:"policy_outcome,omitempty"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+}
+
+// PolicyTestList defines model for PolicyTestList.
+type PolicyTestList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
+	// Tests Test cases, oldest first
+	Tests []PolicyTest `json:"tests"`
+}
+
+// PolicyTestResult defines model for PolicyTestResult.
+type PolicyTestResult struct {
+	// Detail The rejection reason or failure message, when the request was rejected or failed
+	Detail                   *string                   `json:"detail,omitempty"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// Failures How the decision differs from the expected one; empty when the test passed
+	Failures []string `json:"failures"`
+	Passed   bool     `json:"passed"`
+
+	// PolicyOutcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	PolicyOutcome DraftPolicyOutcome `json:"policy_outcome"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+	TestId string           `json:"test_id"`
+}
+
+// PolicyTestRun defines model for PolicyTestRun.
+type PolicyTestRun struct {
+	// Failed Number of test cases whose decision did not match
+	Failed int32 `json:"failed"`
+
+	// Passed Number of test cases whose decision matched the expected one
+	Passed   int32  `json:"passed"`
+	PolicyId string `json:"policy_id"`
+
+	// Results Results in test case order
+	Results []PolicyTestResult `json:"results"`
+}
+
+// PolicyUnlockRequest defines model for PolicyUnlockRequest.
+type PolicyUnlockRequest struct {
+	// Owner Who holds the lock
+	Owner string `json:"owner"`
+}
+
+// PolicyWarning A problem found by linting a policy's Rego code
+type PolicyWarning struct {
+	// Code Kind of problem:
+	// - `NOT_FORMATTED`: `opa fmt` would change the code.
+	// - `UNUSED_IMPORT`: an import is never referenced.
+	// - `UNUSED_VARIABLE`: a local variable is assigned but never used.
+	// - `UNUSED_ARGUMENT`: a function argument is never used.
+	// - `STRICT`: another construct the compiler's strict mode rejects.
+	Code PolicyWarningCode `json:"code"`
+
+	// Column 1-based column of the problem; omitted when it has no location
+	Column *int32 `json:"column,omitempty"`
+
+	// Line 1-based line of the problem; omitted when it has no location
+	Line    *int32 `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// PolicyWarningCode Kind of problem:
+// - `NOT_FORMATTED`: `opa fmt` would change the code.
+// - `UNUSED_IMPORT`: an import is never referenced.
+// - `UNUSED_VARIABLE`: a local variable is assigned but never used.
+// - `UNUSED_ARGUMENT`: a function argument is never used.
+// - `STRICT`: another construct the compiler's strict mode rejects.
+type PolicyWarningCode string
+
+// PolicyWatchEvent A policy change streamed by the watch endpoint
+type PolicyWatchEvent struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy *Policy `json:"policy,omitempty"`
+
+	// PolicyId ID of the changed policy; unset on `RESYNC`
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// ResourceVersion Opaque, increasing version of the change; resume the watch from it
+	ResourceVersion string `json:"resource_version"`
+
+	// Type The kind of change: `CREATED`, `UPDATED`, `DELETED`, or `RESYNC`,
+	// which carries no policy and means the changes since the requested
+	// resource version could not be replayed.
+	Type string `json:"type"`
+}
+
+// PriorityBucket defines model for PriorityBucket.
+type PriorityBucket struct {
+	// Count Number of policies with a priority in the range
+	Count int32 `json:"count"`
+
+	// Max Highest priority in the range (inclusive)
+	Max int32 `json:"max"`
+
+	// Min Lowest priority in the range (inclusive)
+	Min int32 `json:"min"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Dependencies The checked dependencies, in a fixed order
+	Dependencies []DependencyStatus `json:"dependencies"`
+
+	// Status `ready` when every dependency is ready, `not_ready` otherwise
+	Status string `json:"status"`
+}
+
+// SelectorTermFailure defines model for SelectorTermFailure.
+type SelectorTermFailure struct {
+	// Actual Value of the request label; absent when the label is missing
+	Actual *string `json:"actual,omitempty"`
+
+	// Expected Value the selector requires
+	Expected string `json:"expected"`
+
+	// Key Selector label key
+	Key string `json:"key"`
+
+	// Reason Why the term failed:
+	// - `MISSING`: the request has no label with this key.
+	// - `MISMATCH`: the request label has a different value.
+	Reason SelectorTermFailureReason `json:"reason"`
+}
+
+// SelectorTermFailureReason Why the term failed:
+// - `MISSING`: the request has no label with this key.
+// - `MISMATCH`: the request label has a different value.
+type SelectorTermFailureReason string
+
+// SimulatedServiceInstance defines model for SimulatedServiceInstance.
+type SimulatedServiceInstance struct {
+	// Spec Service instance spec, as sent to the evaluation API
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// SimulationStatus Outcome of the simulated evaluation:
+// - `APPROVED`: the request was approved unchanged.
+// - `MODIFIED`: the request was approved with changes.
+// - `REJECTED`: a policy rejected the request.
+// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+//
+// Later versions may add outcomes. Clients must accept outcomes they do
+// not know, and should not treat them as approvals.
+type SimulationStatus string
+
+// TelemetryEvaluationCounts Evaluation outcomes in the report period; dry runs are not counted
+type TelemetryEvaluationCounts struct {
+	Completed int64 `json:"completed"`
+	Rejected  int64 `json:"rejected"`
+}
+
+// TelemetryPolicyCounts Number of stored policies
+type TelemetryPolicyCounts struct {
+	Enabled int `json:"enabled"`
+	Global  int `json:"global"`
+	Total   int `json:"total"`
+	User    int `json:"user"`
+}
+
+// TelemetryReport The exact document sent to the telemetry endpoint
+type TelemetryReport struct {
+	// Evaluations Evaluation outcomes in the report period; dry runs are not counted
+	Evaluations TelemetryEvaluationCounts `json:"evaluations"`
+
+	// Features Optional features and whether they are enabled, as in `feature_flags`
+	Features map[string]bool `json:"features"`
+
+	// InstanceId Random ID generated at process start, so reports of one run can be grouped without identifying the deployment
+	InstanceId string `json:"instance_id"`
+
+	// PeriodSeconds Length of the period the evaluations were counted over
+	PeriodSeconds int64 `json:"period_seconds"`
+
+	// Policies Number of stored policies
+	Policies TelemetryPolicyCounts `json:"policies"`
+
+	// Time When the report was made
+	Time time.Time `json:"time"`
+
+	// Version Release version of the binary
+	Version string `json:"version"`
+}
+
+// TelemetryStatus Opt-in anonymous usage telemetry. When enabled, a TelemetryReport is
+// POSTed to `endpoint` at startup and then every `interval`. Reports
+// never contain policy IDs, names, Rego, labels or request content.
+type TelemetryStatus struct {
+	// Enabled Whether reports are sent; false unless `TELEMETRY_ENABLED` is set
+	Enabled bool `json:"enabled"`
+
+	// Endpoint URL reports are sent to
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// Interval Time between reports, as a Go duration
+	Interval *string `json:"interval,omitempty"`
+
+	// LastError Why the last report could not be sent; absent after a successful report
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastReport The exact document sent to the telemetry endpoint
+	LastReport *TelemetryReport `json:"last_report,omitempty"`
+}
+
+// DryRun defines model for DryRun.
+type DryRun = bool
+
+// IfModifiedSince defines model for IfModifiedSince.
+type IfModifiedSince = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// PolicyIdPath defines model for PolicyIdPath.
+type PolicyIdPath = string
+
+// TestIdPath defines model for TestIdPath.
+type TestIdPath = string
+
+// AlreadyExists Error response following RFC 7807 Problem Details and AEP-193.
+//
+// Provides structured error information for API failures.
+type AlreadyExists = Error
+
+// BadRequest Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
 type BadRequest = Error
@@ -199,11 +1709,21 @@ type Forbidden = Error
 // Provides structured error information for API failures.
 type InternalServerError = Error
 
+// Locked Error response following RFC 7807 Problem Details and AEP-193.
+//
+// Provides structured error information for API failures.
+type Locked = Error
+
 // NotFound Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
 type NotFound = Error
 
+// TooManyRequests Error response following RFC 7807 Problem Details and AEP-193.
+//
+// Provides structured error information for API failures.
+type TooManyRequests = Error
+
 // Unauthorized Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
@@ -214,40 +1734,112 @@ type Unauthorized = Error
 // Provides structured error information for API failures.
 type ValidationError = Error
 
+// bearerAuthContextKey is the context key for bearerAuth security scheme
+type bearerAuthContextKey string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of entries to return per page. Server may return
+	// fewer entries. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// ListEngineApiKeysParams defines parameters for ListEngineApiKeys.
+type ListEngineApiKeysParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of keys to return per page. Server may return
+	// fewer keys. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// CreateEngineApiKeyParams defines parameters for CreateEngineApiKey.
+type CreateEngineApiKeyParams struct {
+	// Id Optional client-specified ID for the key, following the policy ID
+	// format. If not provided, the server will generate a UUID.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
 // ListPoliciesParams defines parameters for ListPolicies.
 type ListPoliciesParams struct {
 	// PageToken Token for retrieving the next page of results. Leave empty for
 	// the first page. Use the `next_page_token` from the previous
 	// response to get the next page.
+	//
+	// Tokens are signed and expire. A token is only accepted with the
+	// same `filter` and `order_by` it was issued for; `max_page_size`
+	// may change between pages. An invalid, expired or mismatched token
+	// is rejected with `400`.
 	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
 
 	// MaxPageSize Maximum number of policies to return per page. Server may return
-	// fewer results. If unspecified, defaults to 50. Maximum value is 1000.
+	// fewer results. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
 	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 
-	// Filter Filter expression to apply to the list. Supports filtering by:
-	// - `policy_type`: GLOBAL or USER
-	// - `enabled`: true or false
+	// Filter Filter expression to apply to the list: one or more comparisons
+	// joined by `AND`. Supports filtering by:
+	// - `policy_type`: `=` a quoted policy type, e.g. 'GLOBAL' or 'USER';
+	//   types this version does not know match no policies rather than
+	//   being rejected
+	// - `enabled`: `=` true or false
+	// - `priority`: `=`, `<`, `<=`, `>` or `>=` an integer
+	// - `create_time`, `update_time`: `<`, `<=`, `>` or `>=` a quoted
+	//   RFC 3339 timestamp
+	// - `created_by`, `updated_by`: `=` a quoted principal
+	//
+	// `policy_type`, `enabled`, `created_by` and `updated_by` may appear
+	// once each.
 	//
 	// Examples:
 	// - `policy_type='GLOBAL'`
 	// - `enabled=true`
 	// - `policy_type='GLOBAL' AND enabled=true`
+	// - `priority>=100 AND priority<500`
+	// - `update_time>'2024-01-01T00:00:00Z'`
+	// - `created_by='alice@example.com'`
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// OrderBy Comma-separated list of fields to order by. Each field can be
 	// followed by 'asc' or 'desc'. Defaults to 'priority asc'.
 	//
 	// Supported fields:
-	// - priority
+	// - id
 	// - display_name
+	// - policy_type
+	// - priority
+	// - enabled
 	// - create_time
+	// - update_time
+	//
+	// Each field may appear once. Policies with equal values are ordered
+	// by `id` unless `id` is one of the fields.
 	//
 	// Examples:
 	// - `priority asc`
 	// - `display_name desc`
 	// - `create_time desc,priority asc`
+	// - `update_time desc`
 	OrderBy *string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// IfNoneMatch Entity tags of cached representations; a match returns 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
 // CreatePolicyParams defines parameters for CreatePolicy.
@@ -261,10 +1853,218 @@ type CreatePolicyParams struct {
 	// - Contain only lowercase letters, numbers, and hyphens
 	// - End with letter or number
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
+
+	// OnConflict Behavior when a policy with the given `id` already exists:
+	// - `fail` (default): return 409
+	// - `return_existing`: return the existing policy with 200 if its
+	//   content is identical to the request, 409 otherwise
+	//
+	// Has no effect without `id`.
+	OnConflict *CreatePolicyParamsOnConflict `form:"on_conflict,omitempty" json:"on_conflict,omitempty"`
+
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// CreatePolicyParamsOnConflict defines parameters for CreatePolicy.
+type CreatePolicyParamsOnConflict string
+
+// DeletePolicyParams defines parameters for DeletePolicy.
+type DeletePolicyParams struct {
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetPolicyParams defines parameters for GetPolicy.
+type GetPolicyParams struct {
+	// IfNoneMatch Entity tags of cached representations; a match returns 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+
+	// IfModifiedSince HTTP date of a cached representation; returns 304 when the resource
+	// has not changed since. Ignored when If-None-Match is sent.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
+
+// UpdatePolicyParams defines parameters for UpdatePolicy.
+type UpdatePolicyParams struct {
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ApplyPolicyParams defines parameters for ApplyPolicy.
+type ApplyPolicyParams struct {
+	// AllowMissing Create the policy when it does not exist
+	AllowMissing *bool `form:"allow_missing,omitempty" json:"allow_missing,omitempty"`
 }
 
+// ListPolicyRevisionsParams defines parameters for ListPolicyRevisions.
+type ListPolicyRevisionsParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of revisions to return per page. Server may return
+	// fewer revisions. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// ListPolicyTestsParams defines parameters for ListPolicyTests.
+type ListPolicyTestsParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of test cases to return per page. Server may return
+	// fewer test cases. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// CreatePolicyTestParams defines parameters for CreatePolicyTest.
+type CreatePolicyTestParams struct {
+	// Id Optional client-specified ID for the test case, following the
+	// policy ID format. If not provided, the server will generate a UUID.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
+// DiffPolicyRevisionsParams defines parameters for DiffPolicyRevisions.
+type DiffPolicyRevisionsParams struct {
+	// From The revision to compare from
+	From int64 `form:"from" json:"from"`
+
+	// To The revision to compare to
+	To int64 `form:"to" json:"to"`
+}
+
+// GetPolicyCatalogParams defines parameters for GetPolicyCatalog.
+type GetPolicyCatalogParams struct {
+	// Format Representation of the catalog
+	Format *GetPolicyCatalogParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetPolicyCatalogParamsFormat defines parameters for GetPolicyCatalog.
+type GetPolicyCatalogParamsFormat string
+
+// GetEvaluationOrderParams defines parameters for GetEvaluationOrder.
+type GetEvaluationOrderParams struct {
+	// Labels Request labels as `key=value`; repeat the parameter for each label
+	Labels *[]string `form:"labels,omitempty" json:"labels,omitempty"`
+}
+
+// ExportPolicyBundleParams defines parameters for ExportPolicyBundle.
+type ExportPolicyBundleParams struct {
+	// PolicyType Only export policies of this type
+	PolicyType *ExportPolicyBundleParamsPolicyType `form:"policy_type,omitempty" json:"policy_type,omitempty"`
+
+	// StartAfter Only export policies whose ID sorts after this one, to resume an interrupted export
+	StartAfter *string `form:"start_after,omitempty" json:"start_after,omitempty"`
+}
+
+// ExportPolicyBundleParamsPolicyType defines parameters for ExportPolicyBundle.
+type ExportPolicyBundleParamsPolicyType string
+
+// ImportPoliciesMultipartBody defines parameters for ImportPolicies.
+type ImportPoliciesMultipartBody struct {
+	// Files Rego files named by the `rego_file` of the entries
+	Files *[]openapi_types.File `json:"files,omitempty"`
+
+	// Manifest The `PolicyImportRequest`, as JSON or YAML
+	Manifest openapi_types.File `json:"manifest"`
+}
+
+// ImportPoliciesParams defines parameters for ImportPolicies.
+type ImportPoliciesParams struct {
+	// Atomic When true, create every entry or none of them
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
+}
+
+// ImportPolicyBundleParams defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParams struct {
+	// Format Format of the request body
+	Format *ImportPolicyBundleParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// MetadataConvention Where to read policy fields from for each imported file
+	MetadataConvention *ImportPolicyBundleParamsMetadataConvention `form:"metadata_convention,omitempty" json:"metadata_convention,omitempty"`
+
+	// PolicyType Policy type for imported policies that do not declare one
+	PolicyType *ImportPolicyBundleParamsPolicyType `form:"policy_type,omitempty" json:"policy_type,omitempty"`
+
+	// IdPrefix Prefix prepended to every path-derived policy ID
+	IdPrefix *string `form:"id_prefix,omitempty" json:"id_prefix,omitempty"`
+}
+
+// ImportPolicyBundleParamsFormat defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsFormat string
+
+// ImportPolicyBundleParamsMetadataConvention defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsMetadataConvention string
+
+// ImportPolicyBundleParamsPolicyType defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsPolicyType string
+
+// WatchPoliciesParams defines parameters for WatchPolicies.
+type WatchPoliciesParams struct {
+	// ResourceVersion Resource version of the last change received; replays the changes after it
+	ResourceVersion *string `form:"resource_version,omitempty" json:"resource_version,omitempty"`
+
+	// LastEventID Sent by `EventSource` on reconnection; used when `resource_version` is not set
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// ScrubAuditEntriesJSONRequestBody defines body for ScrubAuditEntries for application/json ContentType.
+type ScrubAuditEntriesJSONRequestBody = AuditScrubRequest
+
+// CreateEngineApiKeyJSONRequestBody defines body for CreateEngineApiKey for application/json ContentType.
+type CreateEngineApiKeyJSONRequestBody = EngineApiKey
+
 // CreatePolicyJSONRequestBody defines body for CreatePolicy for application/json ContentType.
 type CreatePolicyJSONRequestBody = Policy
 
 // UpdatePolicyApplicationMergePatchPlusJSONRequestBody defines body for UpdatePolicy for application/merge-patch+json ContentType.
 type UpdatePolicyApplicationMergePatchPlusJSONRequestBody = Policy
+
+// ApplyPolicyJSONRequestBody defines body for ApplyPolicy for application/json ContentType.
+type ApplyPolicyJSONRequestBody = Policy
+
+// CreatePolicyTestJSONRequestBody defines body for CreatePolicyTest for application/json ContentType.
+type CreatePolicyTestJSONRequestBody = PolicyTest
+
+// LockPolicyJSONRequestBody defines body for LockPolicy for application/json ContentType.
+type LockPolicyJSONRequestBody = PolicyLockRequest
+
+// TestPolicyMatchJSONRequestBody defines body for TestPolicyMatch for application/json ContentType.
+type TestPolicyMatchJSONRequestBody = PolicyMatchTestRequest
+
+// RollbackPolicyJSONRequestBody defines body for RollbackPolicy for application/json ContentType.
+type RollbackPolicyJSONRequestBody = PolicyRollbackRequest
+
+// UnlockPolicyJSONRequestBody defines body for UnlockPolicy for application/json ContentType.
+type UnlockPolicyJSONRequestBody = PolicyUnlockRequest
+
+// CheckPolicyConflictsJSONRequestBody defines body for CheckPolicyConflicts for application/json ContentType.
+type CheckPolicyConflictsJSONRequestBody = PolicyConflictCheckRequest
+
+// ImportPoliciesJSONRequestBody defines body for ImportPolicies for application/json ContentType.
+type ImportPoliciesJSONRequestBody = PolicyImportRequest
+
+// ImportPoliciesMultipartRequestBody defines body for ImportPolicies for multipart/form-data ContentType.
+type ImportPoliciesMultipartRequestBody ImportPoliciesMultipartBody
+
+// SimulatePolicyJSONRequestBody defines body for SimulatePolicy for application/json ContentType.
+type SimulatePolicyJSONRequestBody = PolicySimulationRequest
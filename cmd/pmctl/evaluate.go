@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1/engine"
+)
+
+func runEvaluate(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("evaluate", "evaluate -f <file> [-dry-run] [-explain]")
+	file := flags.String("f", "", "JSON or YAML evaluation request, with service_instance.spec, - for standard input (required)")
+	dryRun := flags.Bool("dry-run", false, "evaluate without recording the evaluation")
+	explain := flags.Bool("explain", false, "include what each policy saw and decided; shown with -o json")
+	if _, err := c.parseFlags(flags, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		flags.Usage()
+		return errUsage
+	}
+	body, err := readDocument(*file)
+	if err != nil {
+		return err
+	}
+
+	params := &engine.EvaluateRequestParams{}
+	if *dryRun {
+		params.DryRun = dryRun
+	}
+	if *explain {
+		params.Explain = explain
+	}
+	response, err := c.engine.EvaluateRequestWithBodyWithResponse(ctx, params, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return apiError(response.HTTPResponse, response.Body)
+	}
+	result := response.JSON200
+	if c.output == outputJSON {
+		return c.printJSON(result)
+	}
+
+	reason := ""
+	if result.StatusReason != nil {
+		reason = string(*result.StatusReason)
+	}
+	if err := c.printTable([]string{"STATUS", "REASON", "PROVIDER"},
+		[][]string{{string(result.Status), reason, result.SelectedProvider}}); err != nil {
+		return err
+	}
+	if result.Rejection != nil {
+		fmt.Fprintf(c.stdout, "Policy %s would reject the request: %s\n", result.Rejection.PolicyId, result.Rejection.Reason)
+	}
+	if result.Warnings != nil {
+		for _, warning := range *result.Warnings {
+			fmt.Fprintf(c.stdout, "Warning: %s\n", warning.Message)
+		}
+	}
+	return nil
+}
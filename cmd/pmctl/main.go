@@ -0,0 +1,247 @@
+// Command pmctl manages the policies of a policy manager and evaluates requests against it
+// from the command line:
+//
+//	pmctl [global flags] <command> [flags] [args]
+//
+// Commands: create, get, list, update, delete, apply, evaluate and export. Run
+// "pmctl <command> -h" for the flags of a command. The public API is reached at -server and
+// the engine API, used by evaluate, at -engine-server; both default to PMCTL_SERVER and
+// PMCTL_ENGINE_SERVER, then to the local ports. Results are printed as a table, or as the
+// API's JSON with -o json. The exit status is 1 when a request fails and 2 on a usage error.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/pkg/client"
+	"github.com/dcm-project/policy-manager/pkg/engineclient"
+)
+
+// apiPath is the path both APIs are served under
+const apiPath = "/api/v1alpha1"
+
+// Output formats selected with -o
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// cli holds what every command uses: the API clients, where to print and in which format
+type cli struct {
+	policies *client.ClientWithResponses
+	engine   *engineclient.ClientWithResponses
+	stdout   io.Writer
+	stderr   io.Writer
+	output   string
+}
+
+// command is a pmctl command; run gets the arguments following the command name
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, c *cli, args []string) error
+}
+
+var commands = []command{
+	{"create", "Create a policy from a JSON or YAML file", runCreate},
+	{"get", "Show a policy", runGet},
+	{"list", "List policies", runList},
+	{"update", "Update fields of a policy with a JSON merge patch", runUpdate},
+	{"delete", "Delete a policy", runDelete},
+	{"apply", "Create or replace the policies of a file by ID", runApply},
+	{"evaluate", "Evaluate a request against the policies", runEvaluate},
+	{"export", "Write the policies as an OPA bundle to standard output", runExport},
+}
+
+// errUsage reports a usage error, already described to the user
+var errUsage = errors.New("usage error")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run runs the pmctl command line args and returns the exit status
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("pmctl", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	server := flags.String("server", envOrDefault("PMCTL_SERVER", "http://localhost:8080"), "address of the public API")
+	engineServer := flags.String("engine-server", envOrDefault("PMCTL_ENGINE_SERVER", "http://localhost:8081"), "address of the engine API")
+	token := flags.String("token", os.Getenv("PMCTL_TOKEN"), "bearer token sent to the public API")
+	apiKey := flags.String("api-key", os.Getenv("PMCTL_API_KEY"), "API key sent to the engine API as X-API-Key")
+	caFile := flags.String("ca-file", "", "PEM bundle verifying the servers' certificates instead of the system roots")
+	certFile := flags.String("cert-file", "", "client certificate presented to the servers, for mTLS")
+	keyFile := flags.String("key-file", "", "private key of -cert-file")
+	output := flags.String("o", outputTable, "output format: table or json")
+	flags.Usage = func() { printUsage(flags) }
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	cmd, ok := findCommand(flags.Arg(0))
+	if !ok {
+		fmt.Fprintf(stderr, "pmctl: unknown command '%s'\n", flags.Arg(0))
+		flags.Usage()
+		return 2
+	}
+
+	httpClient, err := newHTTPClient(*caFile, *certFile, *keyFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "pmctl: %v\n", err)
+		return 2
+	}
+	c := &cli{stdout: stdout, stderr: stderr, output: *output}
+	c.policies, err = client.NewClientWithResponses(apiURL(*server),
+		client.WithHTTPClient(httpClient), client.WithRequestEditorFn(setHeader("Authorization", "Bearer ", *token)))
+	if err != nil {
+		fmt.Fprintf(stderr, "pmctl: invalid -server: %v\n", err)
+		return 2
+	}
+	c.engine, err = engineclient.NewClientWithResponses(apiURL(*engineServer),
+		engineclient.WithHTTPClient(httpClient), engineclient.WithRequestEditorFn(setHeader("X-API-Key", "", *apiKey)))
+	if err != nil {
+		fmt.Fprintf(stderr, "pmctl: invalid -engine-server: %v\n", err)
+		return 2
+	}
+
+	if err := cmd.run(ctx, c, flags.Args()[1:]); err != nil {
+		if !errors.Is(err, errUsage) && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(stderr, "pmctl %s: %v\n", cmd.name, err)
+		}
+		return exitStatus(err)
+	}
+	return 0
+}
+
+// exitStatus returns the exit status of a command that failed with err
+func exitStatus(err error) int {
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if errors.Is(err, errUsage) {
+		return 2
+	}
+	return 1
+}
+
+func printUsage(flags *flag.FlagSet) {
+	out := flags.Output()
+	fmt.Fprintln(out, "Usage: pmctl [global flags] <command> [flags] [args]")
+	fmt.Fprintln(out, "\nCommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(out, "  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(out, "\nGlobal flags:")
+	flags.PrintDefaults()
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+// parseFlags parses the flags of a command, which may come before or after its positional
+// arguments, and returns the positional arguments. It fails unless there are exactly
+// positional of them and the output format is known.
+func (c *cli) parseFlags(flags *flag.FlagSet, args []string, positional int) ([]string, error) {
+	var rest []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		rest = append(rest, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+	if len(rest) != positional {
+		fmt.Fprintf(flags.Output(), "%s takes %d argument(s), got %d\n", flags.Name(), positional, len(rest))
+		flags.Usage()
+		return nil, errUsage
+	}
+	if c.output != outputTable && c.output != outputJSON {
+		fmt.Fprintf(flags.Output(), "-o must be one of: table, json (got '%s')\n", c.output)
+		return nil, errUsage
+	}
+	return rest, nil
+}
+
+func envOrDefault(name, fallback string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// apiURL returns the base URL of the API served at server
+func apiURL(server string) string {
+	return strings.TrimSuffix(server, "/") + apiPath
+}
+
+// setHeader returns a request editor setting header to prefix+value, or nothing when value is empty
+func setHeader(header, prefix, value string) func(context.Context, *http.Request) error {
+	return func(_ context.Context, req *http.Request) error {
+		if value != "" {
+			req.Header.Set(header, prefix+value)
+		}
+		return nil
+	}
+}
+
+// newHTTPClient returns the HTTP client of both APIs, verifying servers against the PEM
+// bundle at caFile when set and presenting the client certificate at certFile when set
+func newHTTPClient(caFile, certFile, keyFile string) (*http.Client, error) {
+	if caFile == "" && certFile == "" && keyFile == "" {
+		return http.DefaultClient, nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read -ca-file: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("-ca-file %s has no PEM certificates", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		if certFile == "" || keyFile == "" {
+			return nil, errors.New("-cert-file and -key-file must be set together")
+		}
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, nil
+}
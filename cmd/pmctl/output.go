@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+// printJSON prints value as indented JSON
+func (c *cli) printJSON(value any) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// printTable prints rows under header as aligned columns
+func (c *cli) printTable(header []string, rows [][]string) error {
+	writer := tabwriter.NewWriter(c.stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(writer, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	return writer.Flush()
+}
+
+// printPolicies prints policies in the output format; as JSON, a single policy is printed
+// as an object and several as a policy list
+func (c *cli) printPolicies(policies ...v1alpha1.Policy) error {
+	if c.output == outputJSON {
+		if len(policies) == 1 {
+			return c.printJSON(policies[0])
+		}
+		return c.printJSON(v1alpha1.PolicyList{Policies: policies, PageSize: int32(len(policies))})
+	}
+	rows := make([][]string, len(policies))
+	for i, policy := range policies {
+		rows[i] = []string{
+			value(policy.Id),
+			value(policy.DisplayName),
+			value((*string)(policy.PolicyType)),
+			number(policy.Priority),
+			boolean(policy.Enabled),
+			timestamp(policy.UpdateTime),
+		}
+	}
+	return c.printTable([]string{"ID", "DISPLAY NAME", "TYPE", "PRIORITY", "ENABLED", "UPDATED"}, rows)
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func number(n *int32) string {
+	if n == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*n))
+}
+
+func boolean(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+func timestamp(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// apiError returns the error of an unsuccessful response, from the title and detail of its
+// problem body when it has one
+func apiError(response *http.Response, body []byte) error {
+	var problem struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	}
+	if json.Unmarshal(body, &problem) != nil || problem.Title == "" {
+		return fmt.Errorf("unexpected response: %s", response.Status)
+	}
+	if problem.Detail == "" {
+		return fmt.Errorf("%s: %s", response.Status, problem.Title)
+	}
+	return fmt.Errorf("%s: %s: %s", response.Status, problem.Title, problem.Detail)
+}
+
+// readDocument reads the JSON or YAML file at path, or standard input for "-", and returns
+// it as JSON
+func readDocument(path string) ([]byte, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	document, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return document, nil
+}
+
+// readObject reads the JSON or YAML object in the file at path, or standard input for "-"
+func readObject(path string) (map[string]any, error) {
+	document, err := readDocument(path)
+	if err != nil {
+		return nil, err
+	}
+	var object map[string]any
+	if err := json.Unmarshal(document, &object); err != nil || object == nil {
+		return nil, fmt.Errorf("%s must hold an object", path)
+	}
+	return object, nil
+}
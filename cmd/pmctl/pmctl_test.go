@@ -0,0 +1,161 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pmctl", func() {
+	var (
+		server   *httptest.Server
+		mux      *http.ServeMux
+		stdout   *bytes.Buffer
+		stderr   *bytes.Buffer
+		requests []*http.Request
+	)
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			mux.ServeHTTP(w, r)
+		}))
+		stdout = &bytes.Buffer{}
+		stderr = &bytes.Buffer{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	pmctl := func(args ...string) int {
+		global := []string{"-server", server.URL, "-engine-server", server.URL, "-token", "secret"}
+		return run(context.Background(), append(global, args...), stdout, stderr)
+	}
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	respond := func(w http.ResponseWriter, status int, body string) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}
+
+	Describe("list", func() {
+		BeforeEach(func() {
+			mux.HandleFunc("GET /api/v1alpha1/policies", func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("page_token") == "" {
+					respond(w, http.StatusOK, `{"policies": [{"id": "a", "display_name": "A", "policy_type": "GLOBAL", "priority": 100, "enabled": true}], "next_page_token": "2"}`)
+					return
+				}
+				respond(w, http.StatusOK, `{"policies": [{"id": "b", "display_name": "B", "policy_type": "USER", "priority": 200, "enabled": false}]}`)
+			})
+		})
+
+		It("prints every page as a table", func() {
+			Expect(pmctl("list")).To(Equal(0))
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].Header.Get("Authorization")).To(Equal("Bearer secret"))
+			Expect(stdout.String()).To(MatchRegexp(`ID\s+DISPLAY NAME\s+TYPE\s+PRIORITY\s+ENABLED\s+UPDATED\n`))
+			Expect(stdout.String()).To(MatchRegexp(`a\s+A\s+GLOBAL\s+100\s+true`))
+			Expect(stdout.String()).To(MatchRegexp(`b\s+B\s+USER\s+200\s+false`))
+		})
+
+		It("prints a policy list with -o json after the command", func() {
+			Expect(pmctl("list", "-o", "json")).To(Equal(0))
+			var list struct {
+				Policies []map[string]any `json:"policies"`
+			}
+			Expect(json.Unmarshal(stdout.Bytes(), &list)).To(Succeed())
+			Expect(list.Policies).To(HaveLen(2))
+		})
+	})
+
+	Describe("apply", func() {
+		var bodies map[string]map[string]any
+
+		BeforeEach(func() {
+			bodies = map[string]map[string]any{}
+			mux.HandleFunc("PUT /api/v1alpha1/policies/{id}", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("allow_missing")).To(Equal("true"))
+				body := map[string]any{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				bodies[r.PathValue("id")] = body
+				status := http.StatusOK
+				if r.PathValue("id") == "new" {
+					status = http.StatusCreated
+				}
+				respond(w, status, `{"id": "`+r.PathValue("id")+`", "policy_type": "GLOBAL", "rego_code": "package x"}`)
+			})
+		})
+
+		It("creates or replaces each policy of a YAML list without the server-set fields", func() {
+			file := writeFile("policies.yaml", `
+policies:
+  - id: new
+    policy_type: GLOBAL
+    rego_code: package x
+  - id: old
+    policy_type: GLOBAL
+    rego_code: package x
+    create_time: "2026-01-01T00:00:00Z"
+    path: policies/old
+`)
+			Expect(pmctl("apply", "-f", file)).To(Equal(0))
+			Expect(stdout.String()).To(MatchRegexp(`new\s+created`))
+			Expect(stdout.String()).To(MatchRegexp(`old\s+replaced`))
+			Expect(bodies["old"]).NotTo(HaveKey("create_time"))
+			Expect(bodies["old"]).NotTo(HaveKey("path"))
+		})
+
+		It("fails before sending anything when a policy has no ID", func() {
+			file := writeFile("policy.json", `{"policy_type": "GLOBAL", "rego_code": "package x"}`)
+			Expect(pmctl("apply", "-f", file)).To(Equal(1))
+			Expect(requests).To(BeEmpty())
+			Expect(stderr.String()).To(ContainSubstring("policy 1 has no id"))
+		})
+	})
+
+	Describe("evaluate", func() {
+		It("prints the outcome and sends the API key", func() {
+			mux.HandleFunc("POST /api/v1alpha1/policies:evaluateRequest", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("X-API-Key")).To(Equal("key"))
+				respond(w, http.StatusOK, `{"status": "APPROVED", "status_reason": "POLICIES_PASSED", "selected_provider": "aws", "evaluated_service_instance": {"spec": {}}}`)
+			})
+			file := writeFile("request.yaml", "service_instance:\n  spec:\n    service_type: vm\n")
+			Expect(pmctl("-api-key", "key", "evaluate", "-f", file)).To(Equal(0))
+			Expect(stdout.String()).To(MatchRegexp(`APPROVED\s+POLICIES_PASSED\s+aws`))
+		})
+	})
+
+	Describe("errors", func() {
+		It("prints the problem of a failed request and exits with 1", func() {
+			mux.HandleFunc("GET /api/v1alpha1/policies/{id}", func(w http.ResponseWriter, r *http.Request) {
+				respond(w, http.StatusNotFound, `{"type": "about:blank", "title": "Not found", "status": 404, "detail": "Policy missing not found"}`)
+			})
+			Expect(pmctl("get", "missing")).To(Equal(1))
+			Expect(stderr.String()).To(ContainSubstring("pmctl get: 404 Not Found: Not found: Policy missing not found"))
+		})
+
+		It("exits with 2 on a usage error", func() {
+			Expect(pmctl("get")).To(Equal(2))
+			Expect(pmctl("unknown")).To(Equal(2))
+			Expect(pmctl("list", "-o", "yaml")).To(Equal(2))
+			Expect(requests).To(BeEmpty())
+		})
+	})
+})
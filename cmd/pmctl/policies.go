@@ -0,0 +1,321 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+)
+
+// mergePatchContentType is the content type of policy updates
+const mergePatchContentType = "application/merge-patch+json"
+
+// newFlagSet returns the flag set of a command, printing its usage line before its flags.
+// Commands accept -o after their name too.
+func (c *cli) newFlagSet(name, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.StringVar(&c.output, "o", c.output, "output format: table or json")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: pmctl %s\n", usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+func runCreate(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("create", "create -f <file> [-id <id>] [-rego <file>] [-dry-run]")
+	file := flags.String("f", "", "JSON or YAML file of the policy, - for standard input (required)")
+	id := flags.String("id", "", "ID of the policy; generated by the server when empty")
+	regoFile := flags.String("rego", "", "file of the Rego code, instead of the rego_code field")
+	dryRun := flags.Bool("dry-run", false, "validate the policy without creating it")
+	if _, err := c.parseFlags(flags, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		flags.Usage()
+		return errUsage
+	}
+	policy, err := readObject(*file)
+	if err != nil {
+		return err
+	}
+	body, err := withRegoFile(policy, *regoFile)
+	if err != nil {
+		return err
+	}
+
+	params := &v1alpha1.CreatePolicyParams{}
+	if *id != "" {
+		params.Id = id
+	}
+	if *dryRun {
+		params.DryRun = dryRun
+	}
+	response, err := c.policies.CreatePolicyWithBodyWithResponse(ctx, params, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	switch {
+	case response.JSON201 != nil:
+		return c.printPolicies(*response.JSON201)
+	case response.JSON200 != nil:
+		return c.printPolicies(*response.JSON200)
+	}
+	return apiError(response.HTTPResponse, response.Body)
+}
+
+func runGet(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("get", "get <id>")
+	positional, err := c.parseFlags(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	response, err := c.policies.GetPolicyWithResponse(ctx, positional[0], nil)
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return apiError(response.HTTPResponse, response.Body)
+	}
+	return c.printPolicies(*response.JSON200)
+}
+
+func runList(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("list", "list [-filter <expression>] [-order-by <fields>]")
+	filter := flags.String("filter", "", "filter expression, e.g. \"policy_type='GLOBAL' AND enabled=true\"")
+	orderBy := flags.String("order-by", "", "fields to order by, e.g. \"update_time desc\"")
+	if _, err := c.parseFlags(flags, args, 0); err != nil {
+		return err
+	}
+
+	params := &v1alpha1.ListPoliciesParams{}
+	if *filter != "" {
+		params.Filter = filter
+	}
+	if *orderBy != "" {
+		params.OrderBy = orderBy
+	}
+	policies := []v1alpha1.Policy{}
+	for {
+		response, err := c.policies.ListPoliciesWithResponse(ctx, params)
+		if err != nil {
+			return err
+		}
+		if response.JSON200 == nil {
+			return apiError(response.HTTPResponse, response.Body)
+		}
+		policies = append(policies, response.JSON200.Policies...)
+		if response.JSON200.NextPageToken == nil || *response.JSON200.NextPageToken == "" {
+			break
+		}
+		params.PageToken = response.JSON200.NextPageToken
+	}
+	if c.output == outputJSON {
+		// A list is printed as one even when it has a single policy
+		return c.printJSON(v1alpha1.PolicyList{Policies: policies, PageSize: int32(len(policies))})
+	}
+	return c.printPolicies(policies...)
+}
+
+func runUpdate(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("update", "update <id> [-f <file>] [-rego <file>] [-dry-run]")
+	file := flags.String("f", "", "JSON or YAML merge patch of the policy fields, - for standard input")
+	regoFile := flags.String("rego", "", "file of the new Rego code, instead of the rego_code field")
+	dryRun := flags.Bool("dry-run", false, "validate the update without storing it")
+	positional, err := c.parseFlags(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	if *file == "" && *regoFile == "" {
+		fmt.Fprintln(flags.Output(), "update needs -f, -rego or both")
+		flags.Usage()
+		return errUsage
+	}
+	patch := map[string]any{}
+	if *file != "" {
+		if patch, err = readObject(*file); err != nil {
+			return err
+		}
+	}
+	body, err := withRegoFile(patch, *regoFile)
+	if err != nil {
+		return err
+	}
+
+	params := &v1alpha1.UpdatePolicyParams{}
+	if *dryRun {
+		params.DryRun = dryRun
+	}
+	response, err := c.policies.UpdatePolicyWithBodyWithResponse(ctx, positional[0], params, mergePatchContentType, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if response.JSON200 == nil {
+		return apiError(response.HTTPResponse, response.Body)
+	}
+	return c.printPolicies(*response.JSON200)
+}
+
+func runDelete(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("delete", "delete <id> [-dry-run]")
+	dryRun := flags.Bool("dry-run", false, "check the policy can be deleted without deleting it")
+	positional, err := c.parseFlags(flags, args, 1)
+	if err != nil {
+		return err
+	}
+	params := &v1alpha1.DeletePolicyParams{}
+	if *dryRun {
+		params.DryRun = dryRun
+	}
+	response, err := c.policies.DeletePolicyWithResponse(ctx, positional[0], params)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode() != http.StatusNoContent {
+		return apiError(response.HTTPResponse, response.Body)
+	}
+	if c.output == outputTable {
+		fmt.Fprintf(c.stdout, "Deleted policy %s\n", positional[0])
+	}
+	return nil
+}
+
+func runApply(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("apply", "apply -f <file>")
+	file := flags.String("f", "", "JSON or YAML file of a policy, a list of policies or the output of list -o json, - for standard input (required)")
+	if _, err := c.parseFlags(flags, args, 0); err != nil {
+		return err
+	}
+	if *file == "" {
+		flags.Usage()
+		return errUsage
+	}
+	document, err := readDocument(*file)
+	if err != nil {
+		return err
+	}
+	policies, err := decodePolicies(document)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *file, err)
+	}
+
+	allowMissing := true
+	applied := make([]v1alpha1.Policy, 0, len(policies))
+	var rows [][]string
+	for _, policy := range policies {
+		id := *policy.Id
+		response, err := c.policies.ApplyPolicyWithResponse(ctx, id, &v1alpha1.ApplyPolicyParams{AllowMissing: &allowMissing}, desiredPolicy(policy))
+		if err != nil {
+			return fmt.Errorf("policy %s: %w", id, err)
+		}
+		result, action := response.JSON200, "replaced"
+		if response.JSON201 != nil {
+			result, action = response.JSON201, "created"
+		}
+		if result == nil {
+			return fmt.Errorf("policy %s: %w", id, apiError(response.HTTPResponse, response.Body))
+		}
+		applied = append(applied, *result)
+		rows = append(rows, []string{id, action})
+	}
+	if c.output == outputJSON {
+		return c.printJSON(v1alpha1.PolicyList{Policies: applied, PageSize: int32(len(applied))})
+	}
+	return c.printTable([]string{"ID", "ACTION"}, rows)
+}
+
+// decodePolicies decodes a policy, a list of policies or a policy list, each with an ID
+func decodePolicies(document []byte) ([]v1alpha1.Policy, error) {
+	var policies []v1alpha1.Policy
+	trimmed := bytes.TrimSpace(document)
+	if bytes.HasPrefix(trimmed, []byte("[")) {
+		if err := json.Unmarshal(trimmed, &policies); err != nil {
+			return nil, err
+		}
+	} else {
+		var probe map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &probe); err != nil {
+			return nil, errors.New("must hold a policy, a list of policies or a policy list")
+		}
+		if _, ok := probe["policies"]; ok {
+			var list v1alpha1.PolicyList
+			if err := json.Unmarshal(trimmed, &list); err != nil {
+				return nil, err
+			}
+			policies = list.Policies
+		} else {
+			var policy v1alpha1.Policy
+			if err := json.Unmarshal(trimmed, &policy); err != nil {
+				return nil, err
+			}
+			policies = []v1alpha1.Policy{policy}
+		}
+	}
+	for i, policy := range policies {
+		if policy.Id == nil || *policy.Id == "" {
+			return nil, fmt.Errorf("policy %d has no id; apply replaces policies by ID", i+1)
+		}
+	}
+	return policies, nil
+}
+
+// desiredPolicy returns policy without the fields the server sets, so the output of get
+// and list can be applied
+func desiredPolicy(policy v1alpha1.Policy) v1alpha1.Policy {
+	policy.Path = nil
+	policy.CreateTime = nil
+	policy.UpdateTime = nil
+	policy.CreatedBy = nil
+	policy.UpdatedBy = nil
+	policy.Lock = nil
+	policy.Warnings = nil
+	return policy
+}
+
+// withRegoFile returns policy as JSON, with its rego_code read from regoFile when set
+func withRegoFile(policy map[string]any, regoFile string) ([]byte, error) {
+	if regoFile != "" {
+		rego, err := os.ReadFile(regoFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", regoFile, err)
+		}
+		policy["rego_code"] = string(rego)
+	}
+	return json.Marshal(policy)
+}
+
+func runExport(ctx context.Context, c *cli, args []string) error {
+	flags := c.newFlagSet("export", "export [-type GLOBAL|USER] > bundle.tar.gz")
+	policyType := flags.String("type", "", "only export the policies of this type")
+	if _, err := c.parseFlags(flags, args, 0); err != nil {
+		return err
+	}
+	params := &v1alpha1.ExportPolicyBundleParams{}
+	if *policyType != "" {
+		exported := v1alpha1.ExportPolicyBundleParamsPolicyType(*policyType)
+		params.PolicyType = &exported
+	}
+
+	// The bundle is streamed rather than read into memory
+	response, err := c.policies.ExportPolicyBundle(ctx, params)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(response.Body)
+		return apiError(response, body)
+	}
+	if _, err := io.Copy(c.stdout, response.Body); err != nil {
+		return fmt.Errorf("failed to write the bundle: %w", err)
+	}
+	return nil
+}
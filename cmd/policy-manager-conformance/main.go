@@ -0,0 +1,111 @@
+// Command policy-manager-conformance runs the conformance suite of the policy evaluation
+// semantics against the central engine, or against an alternative engine given as a
+// command:
+//
+//	policy-manager-conformance [-suite dir] [-run regexp] [-json] [command [args...]]
+//
+// The command is run once per case with the case as JSON on its standard input and must
+// write the outcome as JSON to its standard output (see conformance.CommandEvaluator).
+// The exit status is 1 when a case fails.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/pkg/conformance"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	suiteDir := flag.String("suite", "", "directory of suite files to run instead of the published suite")
+	filter := flag.String("run", "", "run only the cases whose name matches this regular expression")
+	jsonOutput := flag.Bool("json", false, "print one JSON result per case")
+	flag.Parse()
+
+	// The central engine logs rejections and conflicts, which are expected outcomes here
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})))
+
+	cases, err := loadCases(*suiteDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load the conformance suite: %v\n", err)
+		return 2
+	}
+	if *filter != "" {
+		pattern, err := regexp.Compile(*filter)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid -run pattern: %v\n", err)
+			return 2
+		}
+		cases = slices.DeleteFunc(cases, func(c conformance.Case) bool { return !pattern.MatchString(c.Name) })
+	}
+
+	var evaluator conformance.Evaluator = service.NewConformanceEvaluator()
+	if flag.NArg() > 0 {
+		evaluator = conformance.NewCommandEvaluator(flag.Arg(0), flag.Args()[1:]...)
+	}
+
+	failed := 0
+	for _, result := range conformance.Run(context.Background(), evaluator, cases) {
+		if !result.Passed() {
+			failed++
+		}
+		if *jsonOutput {
+			printJSON(result)
+		} else {
+			printText(result)
+		}
+	}
+	if !*jsonOutput {
+		fmt.Printf("%d passed, %d failed\n", len(cases)-failed, failed)
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+func loadCases(dir string) ([]conformance.Case, error) {
+	if dir == "" {
+		return conformance.Suite()
+	}
+	return conformance.Load(os.DirFS(dir))
+}
+
+func printText(result conformance.Result) {
+	if result.Passed() {
+		fmt.Printf("ok   %s\n", result.Case)
+		return
+	}
+	fmt.Printf("FAIL %s\n", result.Case)
+	if result.Err != nil {
+		fmt.Printf("     error: %v\n", result.Err)
+	}
+	for _, difference := range result.Differences {
+		fmt.Printf("     %s\n", difference)
+	}
+}
+
+func printJSON(result conformance.Result) {
+	line := struct {
+		Case        string   `json:"case"`
+		Passed      bool     `json:"passed"`
+		Differences []string `json:"differences,omitempty"`
+		Error       string   `json:"error,omitempty"`
+	}{Case: result.Case, Passed: result.Passed(), Differences: result.Differences}
+	if result.Err != nil {
+		line.Error = result.Err.Error()
+	}
+	data, _ := json.Marshal(line)
+	fmt.Println(string(data))
+}
@@ -2,22 +2,40 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"fmt"
 	"log/slog"
+	"maps"
 	"net"
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
+	"time"
 
 	"github.com/dcm-project/policy-manager/internal/apiserver"
+	"github.com/dcm-project/policy-manager/internal/archive"
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/buildinfo"
 	"github.com/dcm-project/policy-manager/internal/config"
 	"github.com/dcm-project/policy-manager/internal/engineserver"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/featureflags"
 	"github.com/dcm-project/policy-manager/internal/handlers/engine"
 	"github.com/dcm-project/policy-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/instances"
+	"github.com/dcm-project/policy-manager/internal/lifecycle"
 	"github.com/dcm-project/policy-manager/internal/logging"
 	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+	"github.com/dcm-project/policy-manager/internal/quota"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
+	"github.com/dcm-project/policy-manager/internal/servertls"
 	"github.com/dcm-project/policy-manager/internal/service"
 	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/telemetry"
 )
 
 type Server interface {
@@ -29,8 +47,11 @@ func main() {
 }
 
 func run() int {
-	// Load configuration from environment
-	cfg, err := config.Load()
+	configFile := flag.String("config", "", "YAML, JSON or TOML config file; environment variables override its settings")
+	flag.Parse()
+
+	// Load configuration from the environment and the config file
+	cfg, err := config.Load(*configFile)
 	if err != nil {
 		slog.Error("Failed to load configuration", "error", err)
 		return 1
@@ -39,6 +60,9 @@ func run() int {
 	// Initialize structured logging
 	logging.Init(cfg.Service.LogLevel)
 
+	info := buildinfo.Get()
+	slog.Info("Policy manager starting", "version", info.Version, "git_sha", info.GitCommit, "build_date", info.BuildDate)
+
 	slog.Info("Configuration loaded",
 		"bind_address", cfg.Service.BindAddress,
 		"engine_bind_address", cfg.Service.EngineBindAddress,
@@ -47,104 +71,563 @@ func run() int {
 		"db_host", cfg.Database.Hostname,
 	)
 
-	// Initialize database
-	db, err := store.InitDB(cfg)
+	// Resolve feature flags before any feature consults them
+	flags, err := featureflags.Load(cfg.FeatureFlags)
 	if err != nil {
-		slog.Error("Failed to initialize database", "error", err)
+		slog.Error("Failed to load feature flags", "error", err)
 		return 1
 	}
-	slog.Info("Database initialized", "type", cfg.Database.Type)
+	featureflags.SetDefault(flags)
+	slog.Info("Feature flags resolved", "flags", flags.All())
 
-	// Create store
-	dataStore := store.NewStore(db)
-	defer func() {
-		if err := dataStore.Close(); err != nil {
-			slog.Error("Error closing database", "error", err)
+	// Validate the remaining configuration before any component starts
+	a := &app{cfg: cfg, flags: flags}
+	a.pageTokens, err = pagetoken.NewCodec(cfg.PageToken)
+	if err != nil {
+		slog.Error("Invalid page token configuration", "error", err)
+		return 1
+	}
+	if cfg.PageToken.Secret == "" {
+		slog.Warn("PAGE_TOKEN_SECRET is not set; page tokens are signed with a per-process key and are not valid across restarts or replicas")
+	}
+	a.pageSizes = service.PageSizeLimits{Default: cfg.PageSize.Default, Max: cfg.PageSize.Max}
+	if err := a.pageSizes.Validate(); err != nil {
+		slog.Error("Invalid page size configuration", "error", err)
+		return 1
+	}
+	a.policyDefaults.Enabled = cfg.PolicyDefaults.Enabled
+	a.policyDefaults.PriorityStrategy, err = service.ParsePriorityStrategy(cfg.PolicyDefaults.PriorityStrategy)
+	if err != nil {
+		slog.Error("Invalid policy defaults configuration", "error", err)
+		return 1
+	}
+	a.patchConflicts, err = service.ParsePatchConflictMode(cfg.Evaluation.PatchConflicts)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	a.execution, err = service.ParseExecutionStrategy(cfg.Evaluation.ExecutionStrategy)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	a.failureMode, err = service.ParseFailureMode(cfg.Evaluation.FailureMode)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	a.deniedSpecFieldMode, err = service.ParseDeniedSpecFieldMode(cfg.Evaluation.DeniedSpecFieldMode)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	a.inputLayout, err = service.ParseInputLayout(cfg.Evaluation.InputLayout)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	if cfg.Evaluation.RejectionMessageCatalog != "" {
+		a.messages, err = service.LoadMessageCatalog(cfg.Evaluation.RejectionMessageCatalog)
+		if err != nil {
+			slog.Error("Invalid evaluation configuration", "error", err)
+			return 1
 		}
-	}()
-
-	// Initialize embedded OPA engine
-	opaEngine := opa.NewEngine()
+	}
+	a.patchFieldMode, err = service.ParsePatchFieldMode(cfg.Evaluation.PatchFieldMode)
+	if err != nil {
+		slog.Error("Invalid evaluation configuration", "error", err)
+		return 1
+	}
+	if cfg.Evaluation.PatchFieldAllowlist != "" {
+		a.patchFields, err = service.LoadPatchFieldAllowlist(cfg.Evaluation.PatchFieldAllowlist)
+		if err != nil {
+			slog.Error("Invalid evaluation configuration", "error", err)
+			return 1
+		}
+	}
+	a.quotaLimiter, err = quota.NewLimiter(cfg.Quota)
+	if err != nil {
+		slog.Error("Invalid evaluation quota configuration", "error", err)
+		return 1
+	}
+	a.publicRateLimiter, err = ratelimit.NewPublicLimiter(cfg.RateLimit)
+	if err != nil {
+		slog.Error("Invalid rate limit configuration", "error", err)
+		return 1
+	}
+	// Stored API keys are looked up once the engine API serves, after the services started
+	a.authorizer, err = authz.NewAuthorizer(cfg.EngineAuthz, authz.WithAPIKeyLookup(func(ctx context.Context, digest string) (string, error) {
+		return a.engineAPIKeyService.Identity(ctx, digest)
+	}))
+	if err != nil {
+		slog.Error("Invalid engine authorization configuration", "error", err)
+		return 1
+	}
+	// Only keys the authorizer verifies get rate limit budgets of their own
+	var identifyAPIKey ratelimit.APIKeyIdentifier
+	if apiKeys, ok := a.authorizer.(*authz.APIKeyAuthorizer); ok {
+		identifyAPIKey = apiKeys.Identify
+	}
+	a.engineRateLimiter, err = ratelimit.NewEngineLimiter(cfg.RateLimit, identifyAPIKey)
+	if err != nil {
+		slog.Error("Invalid rate limit configuration", "error", err)
+		return 1
+	}
+	a.publicTLS, err = servertls.New(cfg.TLS)
+	if err != nil {
+		slog.Error("Invalid TLS configuration", "error", err)
+		return 1
+	}
+	a.engineTLS, err = authz.ServerTLSConfig(cfg.EngineAuthz, cfg.TLS)
+	if err != nil {
+		slog.Error("Invalid engine authorization configuration", "error", err)
+		return 1
+	}
+	a.apiAuthenticator, err = apiserver.NewAuthenticator(cfg.APIAuth)
+	if err != nil {
+		slog.Error("Invalid public API authentication configuration", "error", err)
+		return 1
+	}
+	if a.apiAuthenticator != nil && cfg.Service.PrincipalHeader != "" {
+		slog.Warn("API_PRINCIPAL_HEADER is ignored; principals are taken from bearer tokens", "mode", cfg.APIAuth.Mode)
+	}
+	a.telemetry, err = telemetry.New(cfg.Telemetry, info.Version, featureFlags(cfg, flags))
+	if err != nil {
+		slog.Error("Invalid telemetry configuration", "error", err)
+		return 1
+	}
+	if a.telemetry != nil {
+		slog.Info("Anonymous usage telemetry enabled", "endpoint", cfg.Telemetry.Endpoint, "interval", cfg.Telemetry.Interval)
+	}
+	// Fields hidden from explanations are hidden from the archive too
+	a.archive, err = archive.New(cfg.Archive, cfg.Evaluation.ExplainRedactedFields)
+	if err != nil {
+		slog.Error("Invalid evaluation archive configuration", "error", err)
+		return 1
+	}
+	if a.archive != nil {
+		slog.Info("Evaluation archive enabled", "sample_rate", cfg.Archive.SampleRate, "flush_interval", cfg.Archive.FlushInterval)
+	}
+	a.instances, err = instances.New(cfg.Instances)
+	if err != nil {
+		slog.Error("Invalid instance provider configuration", "error", err)
+		return 1
+	}
+	// The sinks writing events out consume them from queues, so they cannot slow down the
+	// evaluations and policy changes publishing them
+	if cfg.Audit.Enabled {
+		a.auditQueue, err = events.NewQueue("audit", cfg.Audit.QueueSize, cfg.Audit.QueueOverflow)
+		if err != nil {
+			slog.Error("Invalid audit configuration", "error", err)
+			return 1
+		}
+	}
+	if a.archive != nil {
+		a.archiveQueue, err = events.NewQueue("archive", cfg.Archive.QueueSize, cfg.Archive.QueueOverflow)
+		if err != nil {
+			slog.Error("Invalid evaluation archive configuration", "error", err)
+			return 1
+		}
+	}
 
-	// Create services
-	policyService := service.NewPolicyService(dataStore, opaEngine)
-	evaluationService := service.NewEvaluationService(dataStore.Policy(), opaEngine)
+	// Settings that can change at runtime are reloaded on SIGHUP, which is subscribed to
+	// before anything starts so it cannot terminate the process
+	a.policyTimeout = runtimeconfig.NewDuration(cfg.Evaluation.PolicyTimeout)
+	a.cacheMaxAge = runtimeconfig.NewDuration(cfg.Service.CacheMaxAge)
+	a.reloader = runtimeconfig.NewReloader(
+		func() (*config.Config, error) { return config.Load(*configFile) },
+		runtimeconfig.LogLevel(),
+		runtimeconfig.RateLimits(a.publicRateLimiter, a.engineRateLimiter),
+		runtimeconfig.DurationSetting("EVALUATION_POLICY_TIMEOUT", a.policyTimeout, func(cfg *config.Config) time.Duration {
+			return cfg.Evaluation.PolicyTimeout
+		}),
+		runtimeconfig.DurationSetting("API_CACHE_MAX_AGE", a.cacheMaxAge, func(cfg *config.Config) time.Duration {
+			return cfg.Service.CacheMaxAge
+		}),
+	)
+	a.reloadSignals = make(chan os.Signal, 1)
+	signal.Notify(a.reloadSignals, syscall.SIGHUP)
+	defer signal.Stop(a.reloadSignals)
 
-	// Load all policies from DB and compile into engine on startup
-	if err := policyService.CompileAll(context.Background()); err != nil {
-		slog.Error("Failed to compile policies on startup", "error", err)
+	manager := lifecycle.New()
+	if err := a.register(manager); err != nil {
+		slog.Error("Failed to register components", "error", err)
 		return 1
 	}
-	slog.Info("Embedded OPA engine initialized")
 
-	// Create public API handler
-	policyHandler := v1alpha1.NewPolicyHandler(policyService)
+	// Setup signal handling for graceful shutdown
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
 
-	// Create public API TCP listener
-	publicListener, err := net.Listen("tcp", cfg.Service.BindAddress)
-	if err != nil {
-		slog.Error("Failed to create public API listener", "error", err, "address", cfg.Service.BindAddress)
+	slog.Info("Starting components")
+	if err := manager.Run(ctx); err != nil {
+		slog.Error("Policy manager stopped", "error", err)
 		return 1
 	}
-	defer func() { _ = publicListener.Close() }()
+	return 0
+}
 
-	// Create public API server
-	publicSrv := apiserver.New(cfg, publicListener, policyHandler)
+// app holds the configuration and the components' shared dependencies, which are set as
+// the components providing them start
+type app struct {
+	cfg                 *config.Config
+	flags               *featureflags.Set
+	pageTokens          *pagetoken.Codec
+	pageSizes           service.PageSizeLimits
+	policyDefaults      service.PolicyDefaults
+	patchConflicts      service.PatchConflictMode
+	execution           service.ExecutionStrategy
+	failureMode         service.FailureMode
+	deniedSpecFieldMode service.DeniedSpecFieldMode
+	inputLayout         service.InputLayout
+	messages            *service.MessageCatalog
+	patchFieldMode      service.PatchFieldMode
+	patchFields         *service.PatchFieldAllowlist // nil without a patch field allowlist
+	quotaLimiter        *quota.Limiter
+	publicRateLimiter   *ratelimit.Limiter
+	engineRateLimiter   *ratelimit.Limiter
+	authorizer          authz.Authorizer
+	publicTLS           *tls.Config // nil when the public API is served over plain HTTP
+	engineTLS           *tls.Config
+	apiAuthenticator    *apiserver.Authenticator
+	telemetry           *telemetry.Reporter
+	archive             *archive.Archiver
+	instances           *instances.HTTPProvider // nil without an instance provider
+	policyTimeout       *runtimeconfig.Duration
+	cacheMaxAge         *runtimeconfig.Duration
+	reloader            *runtimeconfig.Reloader
+	reloadSignals       chan os.Signal
+	auditQueue          *events.Queue
+	archiveQueue        *events.Queue
 
-	// Create engine API handler
-	engineHandler := engine.NewHandler(evaluationService)
+	dataStore           store.Store
+	opaEngine           opa.Engine
+	policyService       *service.PolicyServiceImpl
+	evaluationService   service.EvaluationService
+	policySnapshot      *service.PolicySnapshot
+	policyWatcher       *service.PolicyWatcher
+	auditService        *service.AuditServiceImpl
+	engineAPIKeyService *service.EngineAPIKeyServiceImpl
+	stopAudit           func()
+	stopTelemetry       func()
+	stopArchive         func()
+}
+
+// register registers the service components: the database, the policy services and engine,
+// the optional warm-up and engine reconciler, and the two API servers, which open their listeners only once
+// everything before them is ready
+func (a *app) register(manager *lifecycle.Manager) error {
+	ready := []string{"services"}
+	components := []lifecycle.Component{
+		{
+			Name: "config-reloader",
+			Run: func(ctx context.Context) error {
+				return a.reloader.Run(ctx, a.reloadSignals)
+			},
+		},
+		{
+			Name:  "database",
+			Start: a.startDatabase,
+			Stop:  func(context.Context) error { return a.dataStore.Close() },
+		},
+		{
+			Name:      "services",
+			DependsOn: []string{"database"},
+			Start:     a.startServices,
+			Stop:      a.stopServices,
+		},
+	}
+	if a.cfg.Evaluation.WarmUp {
+		// Warm up before the listeners open so the first requests do not pay for cold paths
+		components = append(components, lifecycle.Component{
+			Name:      "warmup",
+			DependsOn: []string{"services"},
+			Start:     a.warmUp,
+		})
+		ready = append(ready, "warmup")
+	}
+	if interval := a.cfg.Evaluation.EngineReconcileInterval; interval > 0 {
+		components = append(components, lifecycle.Component{
+			Name:      "engine-reconciler",
+			DependsOn: []string{"services"},
+			Run: func(ctx context.Context) error {
+				return a.policyService.RunEngineReconciler(ctx, interval)
+			},
+		})
+	}
+	if interval := a.cfg.Evaluation.PolicySnapshotInterval; interval > 0 {
+		components = append(components, lifecycle.Component{
+			Name:      "policy-snapshot",
+			DependsOn: []string{"services"},
+			Run: func(ctx context.Context) error {
+				return a.policySnapshot.Run(ctx, interval)
+			},
+		})
+	}
+	if a.telemetry != nil {
+		components = append(components, lifecycle.Component{
+			Name:      "telemetry",
+			DependsOn: []string{"services"},
+			Run: func(ctx context.Context) error {
+				return a.telemetry.Run(ctx, a.dataStore.Policy())
+			},
+		})
+	}
+	if a.auditQueue != nil {
+		components = append(components, lifecycle.Component{
+			Name:      "audit-queue",
+			DependsOn: []string{"services"},
+			Run:       a.auditQueue.Run,
+		})
+	}
+	if a.archive != nil {
+		components = append(components, lifecycle.Component{
+			Name:      "evaluation-archive",
+			DependsOn: []string{"services"},
+			Run:       a.runArchive,
+		})
+	}
+	components = append(components,
+		serverComponent("public-api", a.cfg.Service.BindAddress, ready, a.publicServer),
+		serverComponent("engine-api", a.cfg.Service.EngineBindAddress, ready, a.engineServer),
+	)
 
-	// Create private engine API TCP listener
-	engineListener, err := net.Listen("tcp", cfg.Service.EngineBindAddress)
+	for _, component := range components {
+		if err := manager.Register(component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runArchive runs the evaluation archive and its queue until ctx is cancelled. The archive
+// writes its pending records only once the queue has delivered the evaluations it held.
+func (a *app) runArchive(ctx context.Context) error {
+	archiveCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancel()
+	archived := make(chan error, 1)
+	go func() { archived <- a.archive.Run(archiveCtx) }()
+
+	err := a.archiveQueue.Run(ctx)
+	cancel()
+	return errors.Join(err, <-archived)
+}
+
+func (a *app) startDatabase(context.Context) error {
+	db, err := store.InitDB(a.cfg)
 	if err != nil {
-		slog.Error("Failed to create engine API listener", "error", err, "address", cfg.Service.EngineBindAddress)
-		return 1
+		return err
 	}
-	defer func() { _ = engineListener.Close() }()
+	a.dataStore = store.NewStore(db)
+	slog.Info("Database initialized", "type", a.cfg.Database.Type)
+	return nil
+}
 
-	// Create private engine API server
-	engineSrv := engineserver.New(cfg, engineListener, engineHandler)
+// startServices creates the services and compiles every stored policy into the engine.
+// Reactions to policy changes and evaluations subscribe to the event bus.
+func (a *app) startServices(ctx context.Context) error {
+	a.opaEngine = opa.NewEngine()
+	eventBus := events.NewBus()
+	// Policy simulations evaluate requests the way the evaluation service does
+	evaluationOptions := []service.EvaluationOption{
+		service.WithExplainRedactedFields(a.cfg.Evaluation.ExplainRedactedFields),
+		service.WithPatchConflicts(a.patchConflicts, a.cfg.Evaluation.PatchConflictPriorityWindow),
+		service.WithProtectedFields(a.cfg.Evaluation.ProtectedFields),
+		service.WithDeniedSpecFields(a.cfg.Evaluation.DeniedSpecFields, a.deniedSpecFieldMode),
+		service.WithInputLayout(a.inputLayout),
+		service.WithMessageCatalog(a.messages),
+		service.WithPatchFieldAllowlist(a.patchFields, a.patchFieldMode),
+		service.WithExecutionStrategy(a.execution, a.cfg.Evaluation.PhaseConcurrency),
+		service.WithFailureMode(a.failureMode),
+		service.WithReloadablePolicyTimeout(a.policyTimeout),
+	}
+	a.policyWatcher = service.NewPolicyWatcher(eventBus, service.DefaultWatchHistory)
+	a.policyService = service.NewPolicyService(a.dataStore, a.opaEngine,
+		service.WithPolicyEvents(eventBus),
+		service.WithPageTokens(a.pageTokens),
+		service.WithPageSizeLimits(a.pageSizes),
+		service.WithPolicyDefaults(a.policyDefaults),
+		service.WithSimulationOptions(evaluationOptions...),
+	)
+	evaluationOptions = append(evaluationOptions,
+		service.WithEvaluationEvents(eventBus),
+		service.WithSessionLimits(a.cfg.Evaluation.SessionTTL, a.cfg.Evaluation.MaxSessions),
+	)
+	if a.instances != nil {
+		evaluationOptions = append(evaluationOptions, service.WithInstanceProvider(a.instances))
+	}
+	if a.cfg.Evaluation.PolicySnapshotInterval > 0 {
+		a.policySnapshot = service.NewPolicySnapshot(a.dataStore.Policy(), eventBus)
+		evaluationOptions = append(evaluationOptions, service.WithPolicySnapshot(a.policySnapshot))
+	}
+	a.evaluationService = service.NewDeduplicatingEvaluationService(
+		service.NewEvaluationService(a.dataStore.Policy(), a.opaEngine, evaluationOptions...),
+		a.cfg.Evaluation.DedupWindow,
+		eventBus,
+	)
 
-	slog.Info("Starting servers")
-	if err := runServers([]Server{publicSrv, engineSrv}); err != nil {
-		return 1
+	a.auditService = service.NewAuditService(a.dataStore.Audit(), a.dataStore.Policy(),
+		service.WithAuditSigningKey([]byte(a.cfg.Audit.SigningKey)),
+		service.WithAuditPageTokens(a.pageTokens),
+		service.WithAuditPageSizeLimits(a.pageSizes),
+	)
+	a.engineAPIKeyService = service.NewEngineAPIKeyService(a.dataStore.EngineAPIKey(),
+		service.WithEngineAPIKeyPageTokens(a.pageTokens),
+		service.WithEngineAPIKeyPageSizeLimits(a.pageSizes),
+	)
+	if a.cfg.Audit.Enabled {
+		eventBus.Subscribe(a.auditQueue.Enqueue)
+		a.stopAudit = a.auditService.RecordEvents(a.auditQueue)
+		if a.cfg.Audit.SigningKey == "" {
+			slog.Warn("AUDIT_SIGNING_KEY is not set; audit entries are hashed without a key and can be rewritten by anyone with database access")
+		}
 	}
 
-	return 0
+	if a.telemetry != nil {
+		a.stopTelemetry = a.telemetry.RecordEvents(eventBus)
+	}
+	if a.archive != nil {
+		eventBus.Subscribe(a.archiveQueue.Enqueue)
+		a.stopArchive = a.archive.RecordEvents(a.archiveQueue)
+	}
+
+	if err := a.policyService.CompileAll(ctx); err != nil {
+		return fmt.Errorf("failed to compile policies: %w", err)
+	}
+	slog.Info("Embedded OPA engine initialized")
+	return nil
 }
 
-func runServers(servers []Server) error {
-	// Setup signal handling for graceful shutdown
-	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
-	defer cancel()
+func (a *app) stopServices(context.Context) error {
+	if a.stopAudit != nil {
+		a.stopAudit()
+	}
+	if a.stopTelemetry != nil {
+		a.stopTelemetry()
+	}
+	if a.stopArchive != nil {
+		a.stopArchive()
+	}
+	return nil
+}
 
-	var wg sync.WaitGroup
-	errChan := make(chan error, len(servers))
-	for _, server := range servers {
-		wg.Add(1)
-		go func(server Server) {
-			defer wg.Done()
-			if err := server.Run(ctx); err != nil {
-				errChan <- err
-			}
-		}(server)
+func (a *app) warmUp(ctx context.Context) error {
+	start := time.Now()
+	warmUp, err := service.WarmUp(ctx, a.dataStore.Policy(), a.opaEngine, a.inputLayout)
+	if err != nil {
+		return err
+	}
+	slog.Info("Policy warm-up completed",
+		"policies", warmUp.PoliciesEvaluated,
+		"schemas", warmUp.SchemasCompiled,
+		"duration", time.Since(start))
+	return nil
+}
+
+func (a *app) publicServer(listener net.Listener) Server {
+	policyHandler := v1alpha1.NewPolicyHandler(a.policyService,
+		v1alpha1.WithFeatureFlags(featureFlags(a.cfg, a.flags)),
+		v1alpha1.WithAuditService(a.auditService),
+		v1alpha1.WithEngineAPIKeyService(a.engineAPIKeyService),
+		v1alpha1.WithReloadableCacheMaxAge(a.cacheMaxAge),
+		v1alpha1.WithConfigReloader(a.reloader),
+		v1alpha1.WithTelemetry(a.telemetry),
+		v1alpha1.WithPolicyWatcher(a.policyWatcher),
+	)
+	serverOpts := []apiserver.Option{apiserver.WithOnShutdown(a.policyWatcher.Close)}
+	if a.apiAuthenticator != nil {
+		serverOpts = append(serverOpts, apiserver.WithAuthenticator(a.apiAuthenticator))
+	}
+	if a.publicRateLimiter != nil {
+		serverOpts = append(serverOpts, apiserver.WithRateLimiter(a.publicRateLimiter))
+	}
+	if a.publicTLS != nil {
+		serverOpts = append(serverOpts, apiserver.WithTLSConfig(a.publicTLS))
 	}
+	return apiserver.New(a.cfg, listener, policyHandler, serverOpts...)
+}
 
-	go func() {
-		wg.Wait()
-		close(errChan)
-	}()
+func (a *app) engineServer(listener net.Listener) Server {
+	handlerOpts := []engine.Option{
+		engine.WithAuthorizer(a.authorizer),
+		engine.WithDefaultRequestLabels(a.cfg.Evaluation.DefaultRequestLabels),
+	}
+	if a.quotaLimiter != nil {
+		handlerOpts = append(handlerOpts, engine.WithQuotaLimiter(a.quotaLimiter))
+	}
+	engineHandler := engine.NewHandler(a.evaluationService, handlerOpts...)
 
-	var firstErr error
-	for err := range errChan {
-		if err != nil {
-			if firstErr == nil {
-				firstErr = err
-				cancel()
+	var engineOpts []engineserver.Option
+	if a.engineTLS != nil {
+		engineOpts = append(engineOpts, engineserver.WithTLSConfig(a.engineTLS))
+	}
+	if a.engineRateLimiter != nil {
+		engineOpts = append(engineOpts, engineserver.WithRateLimiter(a.engineRateLimiter))
+	}
+	if a.cfg.ExtAuthz.Enabled {
+		engineOpts = append(engineOpts, engineserver.WithExtAuthzHandler(
+			engine.NewExtAuthzHandler(a.evaluationService, a.cfg.ExtAuthz, handlerOpts...),
+		))
+	}
+	return engineserver.New(a.cfg, listener, engineHandler, engineOpts...)
+}
+
+// serverComponent opens a TCP listener on address when started and serves on it until shutdown
+func serverComponent(name, address string, dependsOn []string, newServer func(net.Listener) Server) lifecycle.Component {
+	var listener net.Listener
+	return lifecycle.Component{
+		Name:      name,
+		DependsOn: dependsOn,
+		Start: func(context.Context) error {
+			var err error
+			listener, err = net.Listen("tcp", address)
+			if err != nil {
+				return fmt.Errorf("failed to listen on %s: %w", address, err)
 			}
-			slog.Error("Server error", "error", err)
-		}
+			return nil
+		},
+		Run: func(ctx context.Context) error {
+			return newServer(listener).Run(ctx)
+		},
+		Stop: func(context.Context) error {
+			// Serving closes the listener on shutdown; this covers a server that never ran
+			_ = listener.Close()
+			return nil
+		},
 	}
+}
+
+// featureFlags reports the registered feature flags together with the optional features
+// the configuration enables
+func featureFlags(cfg *config.Config, flags *featureflags.Set) map[string]bool {
+	features := flags.All()
+	maps.Copy(features, map[string]bool{
+		"api_authentication":         cfg.APIAuth.Mode == apiserver.AuthModeJWT,
+		"audit_log":                  cfg.Audit.Enabled,
+		"ext_authz":                  cfg.ExtAuthz.Enabled,
+		"engine_authorization":       cfg.EngineAuthz.Mode == authz.ModeMTLS || cfg.EngineAuthz.Mode == authz.ModeAPIKey,
+		"engine_reconciliation":      cfg.Evaluation.EngineReconcileInterval > 0,
+		"evaluation_archive":         cfg.Archive.Enabled,
+		"evaluation_dedup":           cfg.Evaluation.DedupWindow > 0,
+		"evaluation_fail_open":       cfg.Evaluation.FailureMode == string(service.FailureModeOpen),
+		"evaluation_policy_snapshot": cfg.Evaluation.PolicySnapshotInterval > 0,
+		"evaluation_quota":           cfg.Quota.PerTenant > 0 || cfg.Quota.PerServiceType > 0 || len(cfg.Quota.Overrides) > 0,
+		"evaluation_warmup":          cfg.Evaluation.WarmUp,
+		"explain_redaction":          len(cfg.Evaluation.ExplainRedactedFields) > 0,
+		"instance_provider":          cfg.Instances.URL != "",
+		"patch_conflicts":            patchConflictsEnabled(cfg),
+		"patch_field_allowlist":      cfg.Evaluation.PatchFieldAllowlist != "",
+		"phased_execution":           cfg.Evaluation.ExecutionStrategy == string(service.ExecutionPhased),
+		"protected_fields":           len(cfg.Evaluation.ProtectedFields) > 0,
+		"rate_limiting":              cfg.RateLimit.PublicRPS > 0 || cfg.RateLimit.EngineRPS > 0,
+		"rejection_message_catalog":  cfg.Evaluation.RejectionMessageCatalog != "",
+		"telemetry":                  cfg.Telemetry.Enabled,
+	})
+	return features
+}
 
-	return firstErr
+// patchConflictsEnabled reports whether patch conflict detection is configured; the mode was validated at startup
+func patchConflictsEnabled(cfg *config.Config) bool {
+	mode, err := service.ParsePatchConflictMode(cfg.Evaluation.PatchConflicts)
+	return err == nil && mode != service.PatchConflictsAllow
 }
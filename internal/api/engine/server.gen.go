@@ -1,32 +1,246 @@
 // Package engineserver provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package engineserver
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
-	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for CompositeEvaluateResponseStatus.
+const (
+	CompositeEvaluateResponseStatusAPPROVED CompositeEvaluateResponseStatus = "APPROVED"
+	CompositeEvaluateResponseStatusMODIFIED CompositeEvaluateResponseStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the CompositeEvaluateResponseStatus enum.
+func (e CompositeEvaluateResponseStatus) Valid() bool {
+	switch e {
+	case CompositeEvaluateResponseStatusAPPROVED:
+		return true
+	case CompositeEvaluateResponseStatusMODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CompositeInstanceResultStatus.
+const (
+	CompositeInstanceResultStatusAPPROVED CompositeInstanceResultStatus = "APPROVED"
+	CompositeInstanceResultStatusMODIFIED CompositeInstanceResultStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the CompositeInstanceResultStatus enum.
+func (e CompositeInstanceResultStatus) Valid() bool {
+	switch e {
+	case CompositeInstanceResultStatusAPPROVED:
+		return true
+	case CompositeInstanceResultStatusMODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EvaluateResponseStatus.
 const (
-	APPROVED EvaluateResponseStatus = "APPROVED"
-	MODIFIED EvaluateResponseStatus = "MODIFIED"
+	APPROVED             EvaluateResponseStatus = "APPROVED"
+	APPROVEDWITHWARNINGS EvaluateResponseStatus = "APPROVED_WITH_WARNINGS"
+	DRYRUNWOULDREJECT    EvaluateResponseStatus = "DRYRUN_WOULD_REJECT"
+	MODIFIED             EvaluateResponseStatus = "MODIFIED"
+)
+
+// Valid indicates whether the value is a known member of the EvaluateResponseStatus enum.
+func (e EvaluateResponseStatus) Valid() bool {
+	switch e {
+	case APPROVED:
+		return true
+	case APPROVEDWITHWARNINGS:
+		return true
+	case DRYRUNWOULDREJECT:
+		return true
+	case MODIFIED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EvaluateResponseStatusReason.
+const (
+	StatusReasonDeniedFieldsStripped EvaluateResponseStatusReason = "DENIED_FIELDS_STRIPPED"
+	StatusReasonFailedOpen           EvaluateResponseStatusReason = "FAILED_OPEN"
+	StatusReasonNoPoliciesApplied    EvaluateResponseStatusReason = "NO_POLICIES_APPLIED"
+	StatusReasonPatched              EvaluateResponseStatusReason = "PATCHED"
+	StatusReasonPoliciesPassed       EvaluateResponseStatusReason = "POLICIES_PASSED"
+	StatusReasonPolicyRejected       EvaluateResponseStatusReason = "POLICY_REJECTED"
+)
+
+// Valid indicates whether the value is a known member of the EvaluateResponseStatusReason enum.
+func (e EvaluateResponseStatusReason) Valid() bool {
+	switch e {
+	case StatusReasonDeniedFieldsStripped:
+		return true
+	case StatusReasonFailedOpen:
+		return true
+	case StatusReasonNoPoliciesApplied:
+		return true
+	case StatusReasonPatched:
+		return true
+	case StatusReasonPoliciesPassed:
+		return true
+	case StatusReasonPolicyRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyOutcome.
+const (
+	APPLIED   PolicyOutcome = "APPLIED"
+	FAILED    PolicyOutcome = "FAILED"
+	REJECTED  PolicyOutcome = "REJECTED"
+	UNDEFINED PolicyOutcome = "UNDEFINED"
 )
 
+// Valid indicates whether the value is a known member of the PolicyOutcome enum.
+func (e PolicyOutcome) Valid() bool {
+	switch e {
+	case APPLIED:
+		return true
+	case FAILED:
+		return true
+	case REJECTED:
+		return true
+	case UNDEFINED:
+		return true
+	default:
+		return false
+	}
+}
+
+// CompositeEvaluateRequest defines model for CompositeEvaluateRequest.
+type CompositeEvaluateRequest struct {
+	// ServiceInstances The related service instances; names must be unique
+	ServiceInstances []NamedServiceInstance `json:"service_instances"`
+}
+
+// CompositeEvaluateResponse defines model for CompositeEvaluateResponse.
+type CompositeEvaluateResponse struct {
+	// CompositePolicies IDs of the policies whose composite rule approved the request, in evaluation order
+	CompositePolicies []string `json:"composite_policies"`
+
+	// FailedOpen True when composite rules were skipped because policies were
+	// unavailable and `EVALUATION_FAILURE_MODE` is `open`
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// ServiceInstances The result of each instance, in request order
+	ServiceInstances []CompositeInstanceResult `json:"service_instances"`
+
+	// Status APPROVED - No instance was changed by policies
+	// MODIFIED - At least one instance was modified by policies
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
+	Status CompositeEvaluateResponseStatus `json:"status"`
+}
+
+// CompositeEvaluateResponseStatus APPROVED - No instance was changed by policies
+// MODIFIED - At least one instance was modified by policies
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
+type CompositeEvaluateResponseStatus string
+
+// CompositeInstanceResult defines model for CompositeInstanceResult.
+type CompositeInstanceResult struct {
+	EvaluatedServiceInstance ServiceInstance `json:"evaluated_service_instance"`
+
+	// FailedOpen True when the instance was approved unchanged because policies were unavailable
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// Name Name of the instance in the request
+	Name string `json:"name"`
+
+	// SelectedProvider Service provider selected by policies
+	SelectedProvider string `json:"selected_provider"`
+
+	// Status APPROVED - Instance unchanged by policies
+	// MODIFIED - Instance was modified by policies
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
+	Status CompositeInstanceResultStatus `json:"status"`
+}
+
+// CompositeInstanceResultStatus APPROVED - Instance unchanged by policies
+// MODIFIED - Instance was modified by policies
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
+type CompositeInstanceResultStatus string
+
+// ConflictSuggestion A way to resolve a constraint violation or conflict that a client can
+// apply or present: the allowed value nearest the rejected one, every
+// allowed value, or the service providers that remain allowed. Fields
+// that do not apply are absent.
+type ConflictSuggestion struct {
+	// AllowedProviders Service providers allowed after intersecting the allow lists and applying the patterns
+	AllowedProviders *[]string `json:"allowed_providers,omitempty"`
+
+	// AllowedValues Every allowed value, when the constraint is an enum or const
+	AllowedValues *[]interface{} `json:"allowed_values,omitempty"`
+
+	// Field Dot-separated path of the constrained field; absent for service provider suggestions
+	Field *string `json:"field,omitempty"`
+
+	// NearestValue The allowed value nearest the rejected one, such as the value
+	// clamped to the merged minimum and maximum. For a policy that would
+	// loosen a constraint, the loosest value it may set instead.
+	NearestValue interface{} `json:"nearest_value,omitempty"`
+
+	// SetByPolicy ID of the policy that set the constraint
+	SetByPolicy *string `json:"set_by_policy,omitempty"`
+}
+
+// DryRunRejection The rejection a DRYRUN_WOULD_REJECT dry run would have failed with
+type DryRunRejection struct {
+	// Code The `rejection_code` the policy returned, when it returned one
+	Code *string `json:"code,omitempty"`
+
+	// PolicyId ID of the policy that rejected the request
+	PolicyId string `json:"policy_id"`
+
+	// Reason Rejection message, as the 406 response would have carried it
+	Reason string `json:"reason"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Detail Detailed error message
 	Detail *string `json:"detail,omitempty"`
 
+	// Explanation Evaluation trace returned when `explain=true` is requested
+	Explanation *EvaluationExplanation `json:"explanation,omitempty"`
+
 	// Status HTTP status code
 	Status int32 `json:"status"`
 
+	// Suggestions Ways to resolve a policy conflict, one per violated or conflicting
+	// constraint that has something to suggest. Set only on 409 responses.
+	Suggestions *[]ConflictSuggestion `json:"suggestions,omitempty"`
+
 	// Title Short error summary
 	Title string `json:"title"`
 
@@ -36,31 +250,256 @@ type Error struct {
 
 // EvaluateRequest defines model for EvaluateRequest.
 type EvaluateRequest struct {
+	// InstanceId ID of the existing service instance the request re-evaluates, for
+	// day-2 changes. When an instance provider is configured, policies
+	// read the spec and provider the instance was previously evaluated
+	// with from `input.previous` (`input.context.previous` in the
+	// namespaced input layout). Omit it for a new instance.
+	InstanceId *string `json:"instance_id,omitempty"`
+
+	// PreferredProviders Providers the end user would like the instance placed on, most
+	// preferred first. Policies read them from
+	// `input.preferred_providers` (`input.request.preferred_providers` in
+	// the namespaced input layout). When no policy selects a provider, the
+	// first one the accumulated service provider constraints allow is
+	// selected; a policy's selection always takes precedence, and when
+	// the constraints allow none of them no provider is selected.
+	PreferredProviders *[]string `json:"preferred_providers,omitempty"`
+
+	// RequestContext Who made the request and from where. Policies read it from
+	// `input.request_context` (`input.request.context` in the namespaced
+	// input layout), and it is recorded with the evaluation in the audit log.
+	// Unset fields are omitted from the input. The values are taken as
+	// sent; they are not verified.
+	RequestContext  *RequestContext `json:"request_context,omitempty"`
 	ServiceInstance ServiceInstance `json:"service_instance"`
 }
 
 // EvaluateResponse defines model for EvaluateResponse.
 type EvaluateResponse struct {
+	// DryRun True when the request was evaluated as a dry run and nothing was recorded
+	DryRun                   *bool           `json:"dry_run,omitempty"`
 	EvaluatedServiceInstance ServiceInstance `json:"evaluated_service_instance"`
 
+	// Explanation Evaluation trace returned when `explain=true` is requested
+	Explanation *EvaluationExplanation `json:"explanation,omitempty"`
+
+	// FailedOpen True when the request was approved unchanged because the policy
+	// store was unavailable and `EVALUATION_FAILURE_MODE` is `open`
+	FailedOpen *bool `json:"failed_open,omitempty"`
+
+	// Rejection The rejection a DRYRUN_WOULD_REJECT dry run would have failed with
+	Rejection *DryRunRejection `json:"rejection,omitempty"`
+
 	// SelectedProvider Service provider selected by policies
 	SelectedProvider string `json:"selected_provider"`
 
 	// Status APPROVED - Request unchanged by policies
 	// MODIFIED - Request was modified by policies
+	// APPROVED_WITH_WARNINGS - Request approved unchanged without being
+	// evaluated against every policy; only with `extended_status=true`
+	// DRYRUN_WOULD_REJECT - A policy rejected the dry run, see
+	// `rejection`; only with `extended_status=true`
+	//
+	// Later versions may add statuses. Clients must accept statuses they
+	// do not know, and should not act on the evaluated instance then.
 	Status EvaluateResponseStatus `json:"status"`
+
+	// StatusReason Why the evaluation ended with its status:
+	// NO_POLICIES_APPLIED - No enabled policy matched the request labels
+	// POLICIES_PASSED - Matching policies left the request unchanged
+	// PATCHED - Policies patched the request
+	// DENIED_FIELDS_STRIPPED - Denied spec fields were stripped, and
+	// policies changed nothing else
+	// FAILED_OPEN - The policy store was unavailable
+	// POLICY_REJECTED - A policy rejected the request
+	//
+	// Later versions may add reasons. Clients must accept reasons they do
+	// not know.
+	StatusReason *EvaluateResponseStatusReason `json:"status_reason,omitempty"`
+
+	// Warnings Problems found during the evaluation that did not fail it, such as
+	// policies patching fields not allowed for the service type. Omitted
+	// when there are none.
+	Warnings *[]EvaluationWarning `json:"warnings,omitempty"`
 }
 
 // EvaluateResponseStatus APPROVED - Request unchanged by policies
 // MODIFIED - Request was modified by policies
+// APPROVED_WITH_WARNINGS - Request approved unchanged without being
+// evaluated against every policy; only with `extended_status=true`
+// DRYRUN_WOULD_REJECT - A policy rejected the dry run, see
+// `rejection`; only with `extended_status=true`
+//
+// Later versions may add statuses. Clients must accept statuses they
+// do not know, and should not act on the evaluated instance then.
 type EvaluateResponseStatus string
 
+// EvaluateResponseStatusReason Why the evaluation ended with its status:
+// NO_POLICIES_APPLIED - No enabled policy matched the request labels
+// POLICIES_PASSED - Matching policies left the request unchanged
+// PATCHED - Policies patched the request
+// DENIED_FIELDS_STRIPPED - Denied spec fields were stripped, and
+// policies changed nothing else
+// FAILED_OPEN - The policy store was unavailable
+// POLICY_REJECTED - A policy rejected the request
+//
+// Later versions may add reasons. Clients must accept reasons they do
+// not know.
+type EvaluateResponseStatusReason string
+
+// EvaluationExplanation Evaluation trace returned when `explain=true` is requested
+type EvaluationExplanation struct {
+	// FieldProvenance Maps the dot-separated path of each evaluated spec field written by
+	// a policy patch to the ID of the policy that last wrote it. Fields
+	// taken unchanged from the request are absent; arrays are recorded as
+	// a single field.
+	FieldProvenance map[string]string `json:"field_provenance"`
+
+	// InputSpec The service instance spec the evaluation started from, as the first
+	// policy saw it, with redaction applied
+	InputSpec map[string]interface{} `json:"input_spec"`
+
+	// Policies Policies evaluated for the request, in evaluation order
+	Policies []PolicyTrace `json:"policies"`
+
+	// RequestLabels The labels extracted from the request that policy label selectors
+	// were matched against: `service_type` and the string values of
+	// `metadata.labels`. Labels taken from redacted spec fields are
+	// masked.
+	RequestLabels map[string]string `json:"request_labels"`
+
+	// SkippedPolicies Enabled policies not evaluated because their label selector does not match the request labels
+	SkippedPolicies []SkippedPolicy `json:"skipped_policies"`
+}
+
+// EvaluationSession defines model for EvaluationSession.
+type EvaluationSession struct {
+	// ExpireTime When the session expires unless it is used again
+	ExpireTime time.Time `json:"expire_time"`
+
+	// Id Session ID
+	Id string `json:"id"`
+}
+
+// EvaluationWarning defines model for EvaluationWarning.
+type EvaluationWarning struct {
+	// Code UNKNOWN_PATCH_FIELD - The policy patched a field not allowed for the
+	// service type
+	//
+	// Later versions may add codes. Clients must accept codes they do not
+	// know.
+	Code string `json:"code"`
+
+	// Field Dot-separated spec field path the warning is about, if any
+	Field *string `json:"field,omitempty"`
+
+	// Message Human-readable description of the warning
+	Message string `json:"message"`
+
+	// PolicyId ID of the policy the warning is about
+	PolicyId string `json:"policy_id"`
+}
+
+// NamedServiceInstance defines model for NamedServiceInstance.
+type NamedServiceInstance struct {
+	// Name Name identifying the instance within the composite request
+	Name string `json:"name"`
+
+	// Spec Service specification (flexible schema)
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// PolicyOutcome APPLIED - The policy returned a decision and it was applied
+// UNDEFINED - The policy returned no decision and was skipped
+// REJECTED - The policy rejected the request
+// FAILED - The decision could not be applied, for example because it conflicts with an earlier policy
+type PolicyOutcome string
+
+// PolicyTrace defines model for PolicyTrace.
+type PolicyTrace struct {
+	// Constraints The constraints the policy returned
+	Constraints *map[string]interface{} `json:"constraints,omitempty"`
+
+	// Input The exact OPA input document the policy was evaluated with (spec at
+	// that point, accumulated constraints and selected provider), with
+	// redaction applied. It can be saved to a file and passed to
+	// `opa eval --input` to reproduce the decision locally.
+	Input map[string]interface{} `json:"input"`
+
+	// Outcome APPLIED - The policy returned a decision and it was applied
+	// UNDEFINED - The policy returned no decision and was skipped
+	// REJECTED - The policy rejected the request
+	// FAILED - The decision could not be applied, for example because it conflicts with an earlier policy
+	Outcome PolicyOutcome `json:"outcome"`
+
+	// Patch The patch the policy returned, after array merges were resolved and
+	// with redaction applied. Applied to the spec when the outcome is
+	// APPLIED.
+	Patch *map[string]interface{} `json:"patch,omitempty"`
+
+	// PolicyId ID of the evaluated policy
+	PolicyId string `json:"policy_id"`
+
+	// Reason Why the policy rejected the request or failed; absent otherwise
+	Reason *string `json:"reason,omitempty"`
+
+	// SelectedProvider The service provider the policy selected, if any
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+}
+
+// RequestContext Who made the request and from where. Policies read it from
+// `input.request_context` (`input.request.context` in the namespaced
+// input layout), and it is recorded with the evaluation in the audit log.
+// Unset fields are omitted from the input. The values are taken as
+// sent; they are not verified.
+type RequestContext struct {
+	// CorrelationId Identifier correlating the evaluation with the caller's own records
+	CorrelationId *string `json:"correlation_id,omitempty"`
+
+	// Environment Environment the request is made in
+	Environment *string `json:"environment,omitempty"`
+
+	// Requester Identity of the user or service the request is made for
+	Requester *string `json:"requester,omitempty"`
+
+	// SourceSystem System that sent the request
+	SourceSystem *string `json:"source_system,omitempty"`
+}
+
 // ServiceInstance defines model for ServiceInstance.
 type ServiceInstance struct {
 	// Spec Service specification (flexible schema)
 	Spec map[string]interface{} `json:"spec"`
 }
 
+// SessionEvaluateResponse defines model for SessionEvaluateResponse.
+type SessionEvaluateResponse struct {
+	// Constraints JSON Schema constraints accumulated by the session, keyed by
+	// dot-separated field path. Specs given in later steps must satisfy
+	// them.
+	Constraints map[string]map[string]interface{} `json:"constraints"`
+	Evaluation  EvaluateResponse                  `json:"evaluation"`
+
+	// ExpireTime When the session expires unless it is used again
+	ExpireTime time.Time `json:"expire_time"`
+}
+
+// SkippedPolicy defines model for SkippedPolicy.
+type SkippedPolicy struct {
+	// PolicyId ID of the skipped policy
+	PolicyId string `json:"policy_id"`
+
+	// Reason Why the policy was not evaluated
+	Reason string `json:"reason"`
+}
+
+// AcceptLanguage defines model for AcceptLanguage.
+type AcceptLanguage = string
+
+// SessionId defines model for SessionId.
+type SessionId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
@@ -73,29 +512,128 @@ type InternalServerError = Error
 // PolicyConflict defines model for PolicyConflict.
 type PolicyConflict = Error
 
+// QuotaExceeded defines model for QuotaExceeded.
+type QuotaExceeded = Error
+
 // Rejected defines model for Rejected.
 type Rejected = Error
 
+// SessionNotFound defines model for SessionNotFound.
+type SessionNotFound = Error
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = Error
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
+// Unavailable defines model for Unavailable.
+type Unavailable = Error
+
+// EvaluateCompositeParams defines parameters for EvaluateComposite.
+type EvaluateCompositeParams struct {
+	// AcceptLanguage Languages the client accepts for rejection messages. When a policy
+	// rejects the request with a `rejection_code` and the server's message
+	// catalog has a translation in an accepted language, the `detail` of the
+	// 406 response is that translation.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// EvaluateRequestParams defines parameters for EvaluateRequest.
+type EvaluateRequestParams struct {
+	// Explain When true, the response includes an `explanation` describing how
+	// each evaluated policy saw the request, what it decided and which
+	// policy last wrote each patched field. Rejected (406) and conflict
+	// (409) responses include the explanation up to the failing policy.
+	// Intended for policy authors debugging their Rego; spec fields
+	// configured for redaction are masked.
+	// With `ENGINE_AUTHZ_MODE=mtls`, only the client identities listed in
+	// `ENGINE_AUTHZ_EXPLAIN_IDENTITIES` may request it; other callers get
+	// 403.
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// DryRun When true, the request is evaluated exactly as it would be otherwise
+	// but nothing is recorded: no evaluation events or audit entries are
+	// produced and the result is neither taken from nor kept for request
+	// deduplication. The response has `dry_run` set. Dry runs still count
+	// against evaluation quotas.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// ExtendedStatus When true, the response may carry the statuses added after
+	// APPROVED and MODIFIED: a request approved unchanged because
+	// policies were unavailable is APPROVED_WITH_WARNINGS, and a dry run
+	// a policy rejects is answered with 200 and DRYRUN_WOULD_REJECT
+	// instead of 406. Without it the statuses stay APPROVED and MODIFIED,
+	// so existing clients are unaffected. `status_reason` is set either
+	// way.
+	ExtendedStatus *bool `form:"extended_status,omitempty" json:"extended_status,omitempty"`
+
+	// AcceptLanguage Languages the client accepts for rejection messages. When a policy
+	// rejects the request with a `rejection_code` and the server's message
+	// catalog has a translation in an accepted language, the `detail` of the
+	// 406 response is that translation.
+	AcceptLanguage *AcceptLanguage `json:"Accept-Language,omitempty"`
+}
+
+// EvaluateCompositeJSONRequestBody defines body for EvaluateComposite for application/json ContentType.
+type EvaluateCompositeJSONRequestBody = CompositeEvaluateRequest
+
 // EvaluateRequestJSONRequestBody defines body for EvaluateRequest for application/json ContentType.
 type EvaluateRequestJSONRequestBody = EvaluateRequest
 
+// EvaluateSessionJSONRequestBody defines body for EvaluateSession for application/json ContentType.
+type EvaluateSessionJSONRequestBody = EvaluateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Evaluate related service instances together
+	// (POST /policies:evaluateComposite)
+	EvaluateComposite(w http.ResponseWriter, r *http.Request, params EvaluateCompositeParams)
 	// Evaluate request payload against policies
 	// (POST /policies:evaluateRequest)
-	EvaluateRequest(w http.ResponseWriter, r *http.Request)
+	EvaluateRequest(w http.ResponseWriter, r *http.Request, params EvaluateRequestParams)
+	// Open an evaluation session
+	// (POST /sessions)
+	CreateEvaluationSession(w http.ResponseWriter, r *http.Request)
+	// Evaluate the next step of a session
+	// (POST /sessions/{sessionId}:evaluate)
+	EvaluateSession(w http.ResponseWriter, r *http.Request, sessionId SessionId)
+	// Finalize a session
+	// (POST /sessions/{sessionId}:finalize)
+	FinalizeSession(w http.ResponseWriter, r *http.Request, sessionId SessionId)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Evaluate related service instances together
+// (POST /policies:evaluateComposite)
+func (_ Unimplemented) EvaluateComposite(w http.ResponseWriter, r *http.Request, params EvaluateCompositeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Evaluate request payload against policies
 // (POST /policies:evaluateRequest)
-func (_ Unimplemented) EvaluateRequest(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) EvaluateRequest(w http.ResponseWriter, r *http.Request, params EvaluateRequestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Open an evaluation session
+// (POST /sessions)
+func (_ Unimplemented) CreateEvaluationSession(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Evaluate the next step of a session
+// (POST /sessions/{sessionId}:evaluate)
+func (_ Unimplemented) EvaluateSession(w http.ResponseWriter, r *http.Request, sessionId SessionId) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Finalize a session
+// (POST /sessions/{sessionId}:finalize)
+func (_ Unimplemented) FinalizeSession(w http.ResponseWriter, r *http.Request, sessionId SessionId) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -108,11 +646,184 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// EvaluateComposite operation middleware
+func (siw *ServerInterfaceWrapper) EvaluateComposite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EvaluateCompositeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage AcceptLanguage
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluateComposite(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // EvaluateRequest operation middleware
 func (siw *ServerInterfaceWrapper) EvaluateRequest(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EvaluateRequestParams
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "explain", r.URL.Query(), &params.Explain, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "explain"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "explain", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "extended_status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "extended_status", r.URL.Query(), &params.ExtendedStatus, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "extended_status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "extended_status", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage AcceptLanguage
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Accept-Language", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Accept-Language", Err: err})
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluateRequest(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEvaluationSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvaluationSession(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvaluationSession(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EvaluateSession operation middleware
+func (siw *ServerInterfaceWrapper) EvaluateSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId SessionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluateSession(w, r, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinalizeSession operation middleware
+func (siw *ServerInterfaceWrapper) FinalizeSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId SessionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.EvaluateRequest(w, r)
+		siw.Handler.FinalizeSession(w, r, sessionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -235,9 +946,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:evaluateComposite", wrapper.EvaluateComposite)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/policies:evaluateRequest", wrapper.EvaluateRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sessions", wrapper.CreateEvaluationSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sessions/{sessionId}:evaluate", wrapper.EvaluateSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/sessions/{sessionId}:finalize", wrapper.FinalizeSession)
+	})
 
 	return r
 }
@@ -250,70 +973,280 @@ type InternalServerErrorJSONResponse Error
 
 type PolicyConflictJSONResponse Error
 
+type QuotaExceededResponseHeaders struct {
+	RetryAfter *int
+}
+type QuotaExceededJSONResponse struct {
+	Body Error
+
+	Headers QuotaExceededResponseHeaders
+}
+
 type RejectedJSONResponse Error
 
+type SessionNotFoundJSONResponse Error
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter *int
+}
+type TooManyRequestsJSONResponse struct {
+	Body Error
+
+	Headers TooManyRequestsResponseHeaders
+}
+
 type UnauthorizedJSONResponse Error
 
-type EvaluateRequestRequestObject struct {
-	Body *EvaluateRequestJSONRequestBody
+type UnavailableJSONResponse Error
+
+type EvaluateCompositeRequestObject struct {
+	Params EvaluateCompositeParams
+	Body   *EvaluateCompositeJSONRequestBody
 }
 
-type EvaluateRequestResponseObject interface {
-	VisitEvaluateRequestResponse(w http.ResponseWriter) error
+type EvaluateCompositeResponseObject interface {
+	VisitEvaluateCompositeResponse(w http.ResponseWriter) error
 }
 
-type EvaluateRequest200JSONResponse EvaluateResponse
+type EvaluateComposite200JSONResponse CompositeEvaluateResponse
 
-func (response EvaluateRequest200JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite200JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type EvaluateRequest400JSONResponse struct{ BadRequestJSONResponse }
+type EvaluateComposite400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response EvaluateRequest400JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite400JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type EvaluateRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+type EvaluateComposite401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response EvaluateRequest401JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite401JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type EvaluateRequest403JSONResponse struct{ ForbiddenJSONResponse }
+type EvaluateComposite403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response EvaluateRequest403JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite403JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type EvaluateRequest406JSONResponse struct{ RejectedJSONResponse }
+type EvaluateComposite406JSONResponse struct{ RejectedJSONResponse }
 
-func (response EvaluateRequest406JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite406JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(406)
-
-	return json.NewEncoder(w).Encode(response)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
-type EvaluateRequest409JSONResponse struct{ PolicyConflictJSONResponse }
+type EvaluateComposite409JSONResponse struct{ PolicyConflictJSONResponse }
 
-func (response EvaluateRequest409JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+func (response EvaluateComposite409JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateComposite429JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response EvaluateComposite429JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateComposite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response EvaluateComposite500JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateComposite503JSONResponse struct{ UnavailableJSONResponse }
+
+func (response EvaluateComposite503JSONResponse) VisitEvaluateCompositeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequestRequestObject struct {
+	Params EvaluateRequestParams
+	Body   *EvaluateRequestJSONRequestBody
+}
+
+type EvaluateRequestResponseObject interface {
+	VisitEvaluateRequestResponse(w http.ResponseWriter) error
+}
+
+type EvaluateRequest200JSONResponse EvaluateResponse
+
+func (response EvaluateRequest200JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EvaluateRequest400JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EvaluateRequest401JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response EvaluateRequest403JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest406JSONResponse struct{ RejectedJSONResponse }
+
+func (response EvaluateRequest406JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
 
-	return json.NewEncoder(w).Encode(response)
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest409JSONResponse struct{ PolicyConflictJSONResponse }
+
+func (response EvaluateRequest409JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest429JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response EvaluateRequest429JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
 type EvaluateRequest500JSONResponse struct {
@@ -321,21 +1254,438 @@ type EvaluateRequest500JSONResponse struct {
 }
 
 func (response EvaluateRequest500JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateRequest503JSONResponse struct{ UnavailableJSONResponse }
+
+func (response EvaluateRequest503JSONResponse) VisitEvaluateRequestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvaluationSessionRequestObject struct {
+}
+
+type CreateEvaluationSessionResponseObject interface {
+	VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error
+}
+
+type CreateEvaluationSession201JSONResponse EvaluationSession
+
+func (response CreateEvaluationSession201JSONResponse) VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvaluationSession401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEvaluationSession401JSONResponse) VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvaluationSession403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEvaluationSession403JSONResponse) VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvaluationSession429JSONResponse Error
+
+func (response CreateEvaluationSession429JSONResponse) VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEvaluationSession500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateEvaluationSession500JSONResponse) VisitCreateEvaluationSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSessionRequestObject struct {
+	SessionId SessionId `json:"sessionId"`
+	Body      *EvaluateSessionJSONRequestBody
+}
+
+type EvaluateSessionResponseObject interface {
+	VisitEvaluateSessionResponse(w http.ResponseWriter) error
+}
+
+type EvaluateSession200JSONResponse SessionEvaluateResponse
+
+func (response EvaluateSession200JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EvaluateSession400JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EvaluateSession401JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response EvaluateSession403JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession404JSONResponse struct{ SessionNotFoundJSONResponse }
 
-	return json.NewEncoder(w).Encode(response)
+func (response EvaluateSession404JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession406JSONResponse struct{ RejectedJSONResponse }
+
+func (response EvaluateSession406JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession409JSONResponse struct{ PolicyConflictJSONResponse }
+
+func (response EvaluateSession409JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response EvaluateSession429JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response EvaluateSession500JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type EvaluateSession503JSONResponse struct{ UnavailableJSONResponse }
+
+func (response EvaluateSession503JSONResponse) VisitEvaluateSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSessionRequestObject struct {
+	SessionId SessionId `json:"sessionId"`
+}
+
+type FinalizeSessionResponseObject interface {
+	VisitFinalizeSessionResponse(w http.ResponseWriter) error
+}
+
+type FinalizeSession200JSONResponse EvaluateResponse
+
+func (response FinalizeSession200JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response FinalizeSession400JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response FinalizeSession401JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response FinalizeSession403JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession404JSONResponse struct{ SessionNotFoundJSONResponse }
+
+func (response FinalizeSession404JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession406JSONResponse struct{ RejectedJSONResponse }
+
+func (response FinalizeSession406JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(406)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession409JSONResponse struct{ PolicyConflictJSONResponse }
+
+func (response FinalizeSession409JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response FinalizeSession429JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response FinalizeSession500JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type FinalizeSession503JSONResponse struct{ UnavailableJSONResponse }
+
+func (response FinalizeSession503JSONResponse) VisitFinalizeSessionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+	_, err := buf.WriteTo(w)
+	return err
 }
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Evaluate related service instances together
+	// (POST /policies:evaluateComposite)
+	EvaluateComposite(ctx context.Context, request EvaluateCompositeRequestObject) (EvaluateCompositeResponseObject, error)
 	// Evaluate request payload against policies
 	// (POST /policies:evaluateRequest)
 	EvaluateRequest(ctx context.Context, request EvaluateRequestRequestObject) (EvaluateRequestResponseObject, error)
+	// Open an evaluation session
+	// (POST /sessions)
+	CreateEvaluationSession(ctx context.Context, request CreateEvaluationSessionRequestObject) (CreateEvaluationSessionResponseObject, error)
+	// Evaluate the next step of a session
+	// (POST /sessions/{sessionId}:evaluate)
+	EvaluateSession(ctx context.Context, request EvaluateSessionRequestObject) (EvaluateSessionResponseObject, error)
+	// Finalize a session
+	// (POST /sessions/{sessionId}:finalize)
+	FinalizeSession(ctx context.Context, request FinalizeSessionRequestObject) (FinalizeSessionResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
 
 type StrictHTTPServerOptions struct {
 	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
@@ -363,10 +1713,45 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// EvaluateComposite operation middleware
+func (sh *strictHandler) EvaluateComposite(w http.ResponseWriter, r *http.Request, params EvaluateCompositeParams) {
+	var request EvaluateCompositeRequestObject
+
+	request.Params = params
+
+	var body EvaluateCompositeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EvaluateComposite(ctx, request.(EvaluateCompositeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EvaluateComposite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EvaluateCompositeResponseObject); ok {
+		if err := validResponse.VisitEvaluateCompositeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // EvaluateRequest operation middleware
-func (sh *strictHandler) EvaluateRequest(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) EvaluateRequest(w http.ResponseWriter, r *http.Request, params EvaluateRequestParams) {
 	var request EvaluateRequestRequestObject
 
+	request.Params = params
+
 	var body EvaluateRequestJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -393,3 +1778,86 @@ func (sh *strictHandler) EvaluateRequest(w http.ResponseWriter, r *http.Request)
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// CreateEvaluationSession operation middleware
+func (sh *strictHandler) CreateEvaluationSession(w http.ResponseWriter, r *http.Request) {
+	var request CreateEvaluationSessionRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEvaluationSession(ctx, request.(CreateEvaluationSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEvaluationSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEvaluationSessionResponseObject); ok {
+		if err := validResponse.VisitCreateEvaluationSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EvaluateSession operation middleware
+func (sh *strictHandler) EvaluateSession(w http.ResponseWriter, r *http.Request, sessionId SessionId) {
+	var request EvaluateSessionRequestObject
+
+	request.SessionId = sessionId
+
+	var body EvaluateSessionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EvaluateSession(ctx, request.(EvaluateSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EvaluateSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EvaluateSessionResponseObject); ok {
+		if err := validResponse.VisitEvaluateSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FinalizeSession operation middleware
+func (sh *strictHandler) FinalizeSession(w http.ResponseWriter, r *http.Request, sessionId SessionId) {
+	var request FinalizeSessionRequestObject
+
+	request.SessionId = sessionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.FinalizeSession(ctx, request.(FinalizeSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FinalizeSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(FinalizeSessionResponseObject); ok {
+		if err := validResponse.VisitFinalizeSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -1,20 +1,133 @@
 // Package server provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.0 DO NOT EDIT.
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
-	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AuditScrubRequestMode.
+const (
+	AuditScrubDelete AuditScrubRequestMode = "DELETE"
+	AuditScrubRedact AuditScrubRequestMode = "REDACT"
+)
+
+// Valid indicates whether the value is a known member of the AuditScrubRequestMode enum.
+func (e AuditScrubRequestMode) Valid() bool {
+	switch e {
+	case AuditScrubDelete:
+		return true
+	case AuditScrubRedact:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditScrubResultMode.
+const (
+	AuditScrubResultDelete AuditScrubResultMode = "DELETE"
+	AuditScrubResultRedact AuditScrubResultMode = "REDACT"
+)
+
+// Valid indicates whether the value is a known member of the AuditScrubResultMode enum.
+func (e AuditScrubResultMode) Valid() bool {
+	switch e {
+	case AuditScrubResultDelete:
+		return true
+	case AuditScrubResultRedact:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for BundleImportItemStatus.
+const (
+	CREATED BundleImportItemStatus = "CREATED"
+	FAILED  BundleImportItemStatus = "FAILED"
+	SKIPPED BundleImportItemStatus = "SKIPPED"
+)
+
+// Valid indicates whether the value is a known member of the BundleImportItemStatus enum.
+func (e BundleImportItemStatus) Valid() bool {
+	switch e {
+	case CREATED:
+		return true
+	case FAILED:
+		return true
+	case SKIPPED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for DraftPolicyOutcome.
+const (
+	DraftApplied    DraftPolicyOutcome = "APPLIED"
+	DraftFailed     DraftPolicyOutcome = "FAILED"
+	DraftNotReached DraftPolicyOutcome = "NOT_REACHED"
+	DraftRejected   DraftPolicyOutcome = "REJECTED"
+	DraftSkipped    DraftPolicyOutcome = "SKIPPED"
+	DraftUndefined  DraftPolicyOutcome = "UNDEFINED"
+)
+
+// Valid indicates whether the value is a known member of the DraftPolicyOutcome enum.
+func (e DraftPolicyOutcome) Valid() bool {
+	switch e {
+	case DraftApplied:
+		return true
+	case DraftFailed:
+		return true
+	case DraftNotReached:
+		return true
+	case DraftRejected:
+		return true
+	case DraftSkipped:
+		return true
+	case DraftUndefined:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EngineRebuildState.
+const (
+	EngineRebuildFailed    EngineRebuildState = "FAILED"
+	EngineRebuildRunning   EngineRebuildState = "RUNNING"
+	EngineRebuildSucceeded EngineRebuildState = "SUCCEEDED"
+)
+
+// Valid indicates whether the value is a known member of the EngineRebuildState enum.
+func (e EngineRebuildState) Valid() bool {
+	switch e {
+	case EngineRebuildFailed:
+		return true
+	case EngineRebuildRunning:
+		return true
+	case EngineRebuildSucceeded:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ErrorType.
 const (
 	ABORTED            ErrorType = "ABORTED"
@@ -32,12 +145,637 @@ const (
 	UNIMPLEMENTED      ErrorType = "UNIMPLEMENTED"
 )
 
+// Valid indicates whether the value is a known member of the ErrorType enum.
+func (e ErrorType) Valid() bool {
+	switch e {
+	case ABORTED:
+		return true
+	case ALREADYEXISTS:
+		return true
+	case DEADLINEEXCEEDED:
+		return true
+	case FAILEDPRECONDITION:
+		return true
+	case INTERNAL:
+		return true
+	case INVALIDARGUMENT:
+		return true
+	case NOTFOUND:
+		return true
+	case OUTOFRANGE:
+		return true
+	case PERMISSIONDENIED:
+		return true
+	case RESOURCEEXHAUSTED:
+		return true
+	case UNAUTHENTICATED:
+		return true
+	case UNAVAILABLE:
+		return true
+	case UNIMPLEMENTED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for EvaluationOrderEntryPolicyType.
+const (
+	EvaluationOrderGlobal EvaluationOrderEntryPolicyType = "GLOBAL"
+	EvaluationOrderUser   EvaluationOrderEntryPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the EvaluationOrderEntryPolicyType enum.
+func (e EvaluationOrderEntryPolicyType) Valid() bool {
+	switch e {
+	case EvaluationOrderGlobal:
+		return true
+	case EvaluationOrderUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GatekeeperConversionIssueSeverity.
+const (
+	ERROR   GatekeeperConversionIssueSeverity = "ERROR"
+	WARNING GatekeeperConversionIssueSeverity = "WARNING"
+)
+
+// Valid indicates whether the value is a known member of the GatekeeperConversionIssueSeverity enum.
+func (e GatekeeperConversionIssueSeverity) Valid() bool {
+	switch e {
+	case ERROR:
+		return true
+	case WARNING:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for PolicyPolicyType.
 const (
 	GLOBAL PolicyPolicyType = "GLOBAL"
 	USER   PolicyPolicyType = "USER"
 )
 
+// Valid indicates whether the value is a known member of the PolicyPolicyType enum.
+func (e PolicyPolicyType) Valid() bool {
+	switch e {
+	case GLOBAL:
+		return true
+	case USER:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyCatalogEntryPolicyType.
+const (
+	CatalogGlobal PolicyCatalogEntryPolicyType = "GLOBAL"
+	CatalogUser   PolicyCatalogEntryPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the PolicyCatalogEntryPolicyType enum.
+func (e PolicyCatalogEntryPolicyType) Valid() bool {
+	switch e {
+	case CatalogGlobal:
+		return true
+	case CatalogUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyConflictKind.
+const (
+	ConflictCompile     PolicyConflictKind = "COMPILE"
+	ConflictDisplayName PolicyConflictKind = "DISPLAY_NAME"
+	ConflictPackage     PolicyConflictKind = "PACKAGE"
+	ConflictPriority    PolicyConflictKind = "PRIORITY"
+	ConflictSample      PolicyConflictKind = "SAMPLE"
+)
+
+// Valid indicates whether the value is a known member of the PolicyConflictKind enum.
+func (e PolicyConflictKind) Valid() bool {
+	switch e {
+	case ConflictCompile:
+		return true
+	case ConflictDisplayName:
+		return true
+	case ConflictPackage:
+		return true
+	case ConflictPriority:
+		return true
+	case ConflictSample:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyImportItemStatus.
+const (
+	PolicyImportAborted PolicyImportItemStatus = "ABORTED"
+	PolicyImportCreated PolicyImportItemStatus = "CREATED"
+	PolicyImportFailed  PolicyImportItemStatus = "FAILED"
+)
+
+// Valid indicates whether the value is a known member of the PolicyImportItemStatus enum.
+func (e PolicyImportItemStatus) Valid() bool {
+	switch e {
+	case PolicyImportAborted:
+		return true
+	case PolicyImportCreated:
+		return true
+	case PolicyImportFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PolicyWarningCode.
+const (
+	WarningNotFormatted   PolicyWarningCode = "NOT_FORMATTED"
+	WarningStrict         PolicyWarningCode = "STRICT"
+	WarningUnusedArgument PolicyWarningCode = "UNUSED_ARGUMENT"
+	WarningUnusedImport   PolicyWarningCode = "UNUSED_IMPORT"
+	WarningUnusedVariable PolicyWarningCode = "UNUSED_VARIABLE"
+)
+
+// Valid indicates whether the value is a known member of the PolicyWarningCode enum.
+func (e PolicyWarningCode) Valid() bool {
+	switch e {
+	case WarningNotFormatted:
+		return true
+	case WarningStrict:
+		return true
+	case WarningUnusedArgument:
+		return true
+	case WarningUnusedImport:
+		return true
+	case WarningUnusedVariable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SelectorTermFailureReason.
+const (
+	SelectorTermMismatch SelectorTermFailureReason = "MISMATCH"
+	SelectorTermMissing  SelectorTermFailureReason = "MISSING"
+)
+
+// Valid indicates whether the value is a known member of the SelectorTermFailureReason enum.
+func (e SelectorTermFailureReason) Valid() bool {
+	switch e {
+	case SelectorTermMismatch:
+		return true
+	case SelectorTermMissing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for SimulationStatus.
+const (
+	SimulationApproved SimulationStatus = "APPROVED"
+	SimulationFailed   SimulationStatus = "FAILED"
+	SimulationModified SimulationStatus = "MODIFIED"
+	SimulationRejected SimulationStatus = "REJECTED"
+)
+
+// Valid indicates whether the value is a known member of the SimulationStatus enum.
+func (e SimulationStatus) Valid() bool {
+	switch e {
+	case SimulationApproved:
+		return true
+	case SimulationFailed:
+		return true
+	case SimulationModified:
+		return true
+	case SimulationRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CreatePolicyParamsOnConflict.
+const (
+	OnConflictFail           CreatePolicyParamsOnConflict = "fail"
+	OnConflictReturnExisting CreatePolicyParamsOnConflict = "return_existing"
+)
+
+// Valid indicates whether the value is a known member of the CreatePolicyParamsOnConflict enum.
+func (e CreatePolicyParamsOnConflict) Valid() bool {
+	switch e {
+	case OnConflictFail:
+		return true
+	case OnConflictReturnExisting:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetPolicyCatalogParamsFormat.
+const (
+	CatalogFormatJSON     GetPolicyCatalogParamsFormat = "JSON"
+	CatalogFormatMarkdown GetPolicyCatalogParamsFormat = "MARKDOWN"
+)
+
+// Valid indicates whether the value is a known member of the GetPolicyCatalogParamsFormat enum.
+func (e GetPolicyCatalogParamsFormat) Valid() bool {
+	switch e {
+	case CatalogFormatJSON:
+		return true
+	case CatalogFormatMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportPolicyBundleParamsPolicyType.
+const (
+	ExportPolicyTypeGlobal ExportPolicyBundleParamsPolicyType = "GLOBAL"
+	ExportPolicyTypeUser   ExportPolicyBundleParamsPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the ExportPolicyBundleParamsPolicyType enum.
+func (e ExportPolicyBundleParamsPolicyType) Valid() bool {
+	switch e {
+	case ExportPolicyTypeGlobal:
+		return true
+	case ExportPolicyTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsFormat.
+const (
+	CONFIGMAP ImportPolicyBundleParamsFormat = "CONFIGMAP"
+	OPABUNDLE ImportPolicyBundleParamsFormat = "OPA_BUNDLE"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsFormat enum.
+func (e ImportPolicyBundleParamsFormat) Valid() bool {
+	switch e {
+	case CONFIGMAP:
+		return true
+	case OPABUNDLE:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsMetadataConvention.
+const (
+	ANNOTATIONS ImportPolicyBundleParamsMetadataConvention = "ANNOTATIONS"
+	NONE        ImportPolicyBundleParamsMetadataConvention = "NONE"
+	SIDECAR     ImportPolicyBundleParamsMetadataConvention = "SIDECAR"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsMetadataConvention enum.
+func (e ImportPolicyBundleParamsMetadataConvention) Valid() bool {
+	switch e {
+	case ANNOTATIONS:
+		return true
+	case NONE:
+		return true
+	case SIDECAR:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ImportPolicyBundleParamsPolicyType.
+const (
+	ImportPolicyTypeGlobal ImportPolicyBundleParamsPolicyType = "GLOBAL"
+	ImportPolicyTypeUser   ImportPolicyBundleParamsPolicyType = "USER"
+)
+
+// Valid indicates whether the value is a known member of the ImportPolicyBundleParamsPolicyType enum.
+func (e ImportPolicyBundleParamsPolicyType) Valid() bool {
+	switch e {
+	case ImportPolicyTypeGlobal:
+		return true
+	case ImportPolicyTypeUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuditEntry One entry of the hash-chained audit log
+type AuditEntry struct {
+	// Data Event details
+	Data map[string]interface{} `json:"data"`
+
+	// DataDigest Hex SHA-256 digest of the event details
+	DataDigest string `json:"data_digest"`
+
+	// Hash Hex HMAC-SHA256 (SHA-256 when no signing key is configured) over the
+	// entry's sequence, time, type, subject, data digest and previous hash
+	Hash string `json:"hash"`
+
+	// PrevHash Hash of the previous entry, empty for the first entry
+	PrevHash string `json:"prev_hash"`
+
+	// Redacted Whether the details were scrubbed after recording. `data_digest`
+	// still covers the original details, so `data` no longer matches it.
+	Redacted bool `json:"redacted"`
+
+	// Sequence Position of the entry in the log, starting at 1
+	Sequence int64 `json:"sequence"`
+
+	// Subject ID of the policy the entry concerns, empty for approved evaluations
+	Subject string `json:"subject"`
+
+	// Time Time the entry was recorded
+	Time time.Time `json:"time"`
+
+	// Type Event recorded by the entry
+	Type string `json:"type"`
+}
+
+// AuditEntryList defines model for AuditEntryList.
+type AuditEntryList struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+}
+
+// AuditScrubRequest Identifies the data subject by `label_key` and `label_value`, or by
+// `user`.
+type AuditScrubRequest struct {
+	// DryRun Report the matching entries without changing them
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// LabelKey Request label identifying the data subject
+	LabelKey *string `json:"label_key,omitempty"`
+
+	// LabelValue Value of the label for the data subject
+	LabelValue *string `json:"label_value,omitempty"`
+
+	// Mode How matching entries are scrubbed
+	Mode *AuditScrubRequestMode `json:"mode,omitempty"`
+
+	// User Principal or requester identifying the data subject, matched
+	// against the request context `requester` of evaluation entries and
+	// the `created_by` and `updated_by` principals of policy entries,
+	// policies and policy revisions
+	User *string `json:"user,omitempty"`
+}
+
+// AuditScrubRequestMode How matching entries are scrubbed
+type AuditScrubRequestMode string
+
+// AuditScrubResult defines model for AuditScrubResult.
+type AuditScrubResult struct {
+	// AffectedEntries Number of entries scrubbed, or that would be scrubbed in a dry run
+	AffectedEntries int64 `json:"affected_entries"`
+
+	// AffectedPolicies Number of policies whose principals the scrub of a user replaced, or would replace in a dry run
+	AffectedPolicies int64 `json:"affected_policies"`
+
+	// AffectedRevisions Number of policy revisions whose principals the scrub of a user replaced, or would replace in a dry run
+	AffectedRevisions int64                `json:"affected_revisions"`
+	DryRun            bool                 `json:"dry_run"`
+	Mode              AuditScrubResultMode `json:"mode"`
+
+	// Sequences Sequences of the affected entries, in ascending order
+	Sequences []int64 `json:"sequences"`
+}
+
+// AuditScrubResultMode defines model for AuditScrubResult.Mode.
+type AuditScrubResultMode string
+
+// AuditVerification Result of verifying the audit log hash chain
+type AuditVerification struct {
+	// EntriesVerified Number of entries checked before the first failure, or in total when valid
+	EntriesVerified int64 `json:"entries_verified"`
+
+	// FirstInvalidSequence Sequence of the first entry that failed verification
+	FirstInvalidSequence *int64 `json:"first_invalid_sequence,omitempty"`
+
+	// HeadHash Hash of the last entry of a valid log. Removing entries from the
+	// end of the log keeps the remaining chain valid; record this value
+	// externally to detect it.
+	HeadHash *string `json:"head_hash,omitempty"`
+
+	// Reason Why the first invalid entry failed verification
+	Reason *string `json:"reason,omitempty"`
+
+	// Valid Whether every entry verified
+	Valid bool `json:"valid"`
+}
+
+// BuildInfo defines model for BuildInfo.
+type BuildInfo struct {
+	// BuildDate Build time in RFC 3339 format, empty when unknown
+	BuildDate string `json:"build_date"`
+
+	// Capabilities Capabilities compiled into the binary
+	Capabilities map[string]bool `json:"capabilities"`
+
+	// FeatureFlags Optional features and whether they are enabled in this deployment
+	FeatureFlags map[string]bool `json:"feature_flags"`
+
+	// GitSha Git commit the binary was built from, empty when unknown
+	GitSha string `json:"git_sha"`
+
+	// GoVersion Go toolchain the binary was built with
+	GoVersion string `json:"go_version"`
+
+	// Telemetry Opt-in anonymous usage telemetry. When enabled, a TelemetryReport is
+	// POSTed to `endpoint` at startup and then every `interval`. Reports
+	// never contain policy IDs, names, Rego, labels or request content.
+	Telemetry TelemetryStatus `json:"telemetry"`
+
+	// Version Release version, or `dev` for local builds
+	Version string `json:"version"`
+}
+
+// BundleImportItem defines model for BundleImportItem.
+type BundleImportItem struct {
+	// Detail Reason the file was skipped or failed
+	Detail *string `json:"detail,omitempty"`
+
+	// FilePath Path of the file inside the bundle, or the ConfigMap data key prefixed by the ConfigMap name
+	FilePath string `json:"file_path"`
+
+	// PolicyId ID of the policy the file was (or would have been) imported as
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Status CREATED - A policy was created from the file
+	// SKIPPED - The file is not an importable policy (test, data or metadata file)
+	// FAILED - The file could not be imported; see detail
+	Status BundleImportItemStatus `json:"status"`
+}
+
+// BundleImportItemStatus CREATED - A policy was created from the file
+// SKIPPED - The file is not an importable policy (test, data or metadata file)
+// FAILED - The file could not be imported; see detail
+type BundleImportItemStatus string
+
+// BundleImportResult Outcome of a bundle or ConfigMap import
+type BundleImportResult struct {
+	// Results One entry per file found in the upload, in path order
+	Results []BundleImportItem `json:"results"`
+}
+
+// ConfigReload defines model for ConfigReload.
+type ConfigReload struct {
+	// ReloadTime When the configuration was reloaded
+	ReloadTime time.Time `json:"reload_time"`
+
+	// Settings The reloaded settings, by environment variable name
+	Settings map[string]string `json:"settings"`
+}
+
+// DependencyStatus defines model for DependencyStatus.
+type DependencyStatus struct {
+	// Message Why the dependency is not ready; absent when it is
+	Message *string `json:"message,omitempty"`
+
+	// Name The dependency: `database`, `policy_engine` or `policy_sync`. Later
+	// versions may check more.
+	Name string `json:"name"`
+
+	// Status `ok` or `failed`
+	Status string `json:"status"`
+}
+
+// DraftPolicyOutcome What the draft policy did:
+// - `APPLIED`: it was evaluated and its decision applied.
+// - `UNDEFINED`: it returned no decision.
+// - `REJECTED`: it rejected the request.
+// - `FAILED`: its decision could not be applied or it could not be evaluated.
+// - `SKIPPED`: its label selector does not match the request.
+// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+type DraftPolicyOutcome string
+
+// DraftPolicyResult defines model for DraftPolicyResult.
+type DraftPolicyResult struct {
+	// Constraints Constraints the draft returned
+	Constraints *map[string]interface{} `json:"constraints,omitempty"`
+
+	// Outcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	Outcome DraftPolicyOutcome `json:"outcome"`
+
+	// Patch Patch the draft returned
+	Patch *map[string]interface{} `json:"patch,omitempty"`
+
+	// Reason Why the draft rejected the request or failed
+	Reason *string `json:"reason,omitempty"`
+
+	// SelectedProvider Service provider the draft selected
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+}
+
+// EngineApiKey An API key identifying an engine API caller
+type EngineApiKey struct {
+	CreateTime *time.Time `json:"create_time,omitempty"`
+
+	// CreatedBy Principal that created the key, when known
+	CreatedBy *string `json:"created_by,omitempty"`
+	Id        *string `json:"id,omitempty"`
+
+	// Identity Identity of the callers using the key, matched against
+	// `ENGINE_AUTHZ_EVALUATE_IDENTITIES` and `ENGINE_AUTHZ_EXPLAIN_IDENTITIES`
+	Identity string `json:"identity"`
+
+	// Key The key; only returned when it is created
+	Key *string `json:"key,omitempty"`
+
+	// KeyPrefix The first characters of the key, to tell keys apart
+	KeyPrefix *string `json:"key_prefix,omitempty"`
+
+	// Path Resource path in the format "engine-api-keys/{keyId}"
+	Path *string `json:"path,omitempty"`
+}
+
+// EngineApiKeyList defines model for EngineApiKeyList.
+type EngineApiKeyList struct {
+	// Keys Keys, oldest first, without the keys themselves
+	Keys []EngineApiKey `json:"keys"`
+
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+}
+
+// EngineHealth State of the embedded policy engine
+type EngineHealth struct {
+	// CompiledPolicies Number of policies compiled into the engine
+	CompiledPolicies int32 `json:"compiled_policies"`
+
+	// LastReconcileError Why the last reconciliation failed; absent when it succeeded
+	LastReconcileError *string `json:"last_reconcile_error,omitempty"`
+
+	// LastReconcileTime When the engine was last reconciled with the store; absent until the
+	// first reconciliation, or when reconciliation is disabled
+	LastReconcileTime *time.Time `json:"last_reconcile_time,omitempty"`
+}
+
+// EngineRebuild A rebuild of the OPA state from the stored policies
+type EngineRebuild struct {
+	// CreateTime When the rebuild was requested
+	CreateTime time.Time `json:"create_time"`
+
+	// EndTime When the rebuild succeeded or failed
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// Error Why the rebuild failed
+	Error *string `json:"error,omitempty"`
+
+	// Id Identifier of the rebuild
+	Id string `json:"id"`
+
+	// PoliciesCompiled Number of policies compiled into the engine. The policies are
+	// compiled together, so it is 0 until the rebuild succeeds and then
+	// `policies_total`.
+	PoliciesCompiled int32 `json:"policies_compiled"`
+
+	// PoliciesTotal Number of stored policies being compiled; 0 until they are read
+	PoliciesTotal int32 `json:"policies_total"`
+
+	// State `RUNNING` until the stored policies are compiled, then `SUCCEEDED`,
+	// or `FAILED` with the `error` when they could not be read or compiled
+	State EngineRebuildState `json:"state"`
+}
+
+// EngineRebuildState `RUNNING` until the stored policies are compiled, then `SUCCEEDED`,
+// or `FAILED` with the `error` when they could not be read or compiled
+type EngineRebuildState string
+
 // Error Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
@@ -65,12 +803,96 @@ type Error struct {
 // error code.
 type ErrorType string
 
+// EvaluationOrder defines model for EvaluationOrder.
+type EvaluationOrder struct {
+	// Policies Policies in evaluation order
+	Policies []EvaluationOrderEntry `json:"policies"`
+
+	// PoliciesSkipped Number of enabled policies whose label selector does not match
+	PoliciesSkipped int32 `json:"policies_skipped"`
+
+	// RequestLabels The request labels the order was computed for
+	RequestLabels map[string]string `json:"request_labels"`
+}
+
+// EvaluationOrderEntry defines model for EvaluationOrderEntry.
+type EvaluationOrderEntry struct {
+	DisplayName   string             `json:"display_name"`
+	Id            string             `json:"id"`
+	LabelSelector *map[string]string `json:"label_selector,omitempty"`
+
+	// PolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+	// add policy types, which clients must accept.
+	PolicyType EvaluationOrderEntryPolicyType `json:"policy_type"`
+
+	// Position Position in the evaluation order, starting at 1
+	Position int32 `json:"position"`
+	Priority int32 `json:"priority"`
+}
+
+// EvaluationOrderEntryPolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+// add policy types, which clients must accept.
+type EvaluationOrderEntryPolicyType string
+
+// FacetValue defines model for FacetValue.
+type FacetValue struct {
+	// Count Number of policies with this value
+	Count int32  `json:"count"`
+	Value string `json:"value"`
+}
+
+// GatekeeperConversionIssue defines model for GatekeeperConversionIssue.
+type GatekeeperConversionIssue struct {
+	Message string `json:"message"`
+
+	// Severity WARNING - A policy was produced but may behave differently than in Gatekeeper
+	// ERROR - No policy was produced for the resource
+	Severity GatekeeperConversionIssueSeverity `json:"severity"`
+
+	// Source Gatekeeper resource the issue applies to, as `<kind>/<name>`
+	Source string `json:"source"`
+}
+
+// GatekeeperConversionIssueSeverity WARNING - A policy was produced but may behave differently than in Gatekeeper
+// ERROR - No policy was produced for the resource
+type GatekeeperConversionIssueSeverity string
+
+// GatekeeperConversionResult Policies converted from Gatekeeper resources and the conversion report
+type GatekeeperConversionResult struct {
+	// Policies Converted policies, ready to be created
+	Policies []Policy `json:"policies"`
+
+	// Report Constructs that could not be converted faithfully
+	Report []GatekeeperConversionIssue `json:"report"`
+}
+
 // Health defines model for Health.
 type Health struct {
+	// Engine State of the embedded policy engine
+	Engine *EngineHealth `json:"engine,omitempty"`
+
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
-	// Status Health status
+	// Status Health status: `ok`, or `degraded` when the last reconciliation of
+	// the policy engine with the store failed. The response is 200 either
+	// way; the engine keeps serving the policies it compiled last.
+	Status string `json:"status"`
+}
+
+// LabelSelectorKeyFacet defines model for LabelSelectorKeyFacet.
+type LabelSelectorKeyFacet struct {
+	// Count Number of policies whose label selector has this key
+	Count int32  `json:"count"`
+	Key   string `json:"key"`
+
+	// Values Values selected for this key, in alphabetical order
+	Values []FacetValue `json:"values"`
+}
+
+// Liveness defines model for Liveness.
+type Liveness struct {
+	// Status Always `ok`
 	Status string `json:"status"`
 }
 
@@ -84,12 +906,23 @@ type Health struct {
 // policy_type, and rego_code are required (enforced by the service). On
 // update, only fields present in the request body are merged (RFC 7396).
 type Policy struct {
+	// Annotations Free-form key-value metadata about the policy, such as the team
+	// that owns it or the ticket that introduced it. Like `parameters`,
+	// annotations are passed to the policy's Rego as
+	// `input.policy.annotations`.
+	Annotations *map[string]string `json:"annotations,omitempty"`
+
 	// CreateTime Timestamp when the policy was created. This field is output-only
 	// and automatically set by the server.
 	//
 	// Uses ISO 8601 format with timezone per AEP-140.
 	CreateTime *time.Time `json:"create_time,omitempty"`
 
+	// CreatedBy Principal that created the policy. This field is output-only. It
+	// is unset when the server records no principal, and for policies
+	// created before principals were recorded.
+	CreatedBy *string `json:"created_by,omitempty"`
+
 	// Description Optional detailed description of the policy's purpose and behavior.
 	// Supports markdown formatting.
 	Description *string `json:"description,omitempty"`
@@ -98,8 +931,14 @@ type Policy struct {
 	// user interfaces and should be descriptive.
 	DisplayName *string `json:"display_name,omitempty"`
 
+	// Documentation Human-readable documentation of a policy, published in the policy
+	// catalog. Every field is optional; the object is replaced as a whole
+	// on update.
+	Documentation *PolicyDocumentation `json:"documentation,omitempty"`
+
 	// Enabled Whether the policy is currently active. Disabled policies are not
-	// evaluated during authorization decisions.
+	// evaluated during authorization decisions. When omitted on create,
+	// the deployment's default applies: true unless configured otherwise.
 	Enabled *bool `json:"enabled,omitempty"`
 
 	// Id Unique identifier for the policy. This field is output-only and
@@ -121,6 +960,17 @@ type Policy struct {
 	// - tier: service tier (critical, standard, etc.)
 	LabelSelector *map[string]string `json:"label_selector,omitempty"`
 
+	// Lock An advisory lock on a policy held by an editor until it expires.
+	// Output-only in a policy, where it is set by get and list while the
+	// lock is held.
+	Lock *PolicyLock `json:"lock,omitempty"`
+
+	// Parameters Configuration values of the policy, passed to its Rego as
+	// `input.policy.parameters`, so one Rego module can be deployed as
+	// several policies that differ only in their parameters instead of
+	// duplicating values in code. At most 64 KiB of JSON.
+	Parameters *map[string]interface{} `json:"parameters,omitempty"`
+
 	// Path Resource path in the format "policies/{policyId}".
 	// This field is output-only and set by the server.
 	//
@@ -133,6 +983,9 @@ type Policy struct {
 	// - USER: Applies to requests for a specific user
 	//
 	// Policies are evaluated in hierarchical order: Global -> User
+	//
+	// Later versions may add policy types. Clients must accept types they
+	// do not know and keep them unchanged when they send the policy back.
 	PolicyType *PolicyPolicyType `json:"policy_type,omitempty"`
 
 	// Priority Priority value for policy evaluation order. Lower numbers have
@@ -142,6 +995,9 @@ type Policy struct {
 	// - 1-100: Critical/system policies
 	// - 101-500: Standard policies
 	// - 501-1000: Low-priority policies
+	//
+	// When omitted on create, the deployment's default priority strategy
+	// picks it: 500 by default.
 	Priority *int32 `json:"priority,omitempty"`
 
 	// RegoCode The OPA Rego policy code. This code is executed when the policy
@@ -155,11 +1011,29 @@ type Policy struct {
 	// The Rego code is validated on create and update operations.
 	RegoCode *string `json:"rego_code,omitempty"`
 
+	// RejectionMessages Message templates keyed by rejection code. When the policy rejects a
+	// request with a `rejection_code`, the template for that code becomes
+	// the rejection message, with `{name}` placeholders replaced by the
+	// decision's `rejection_params` (and `{policy_id}`). A message catalog
+	// configured on the server takes precedence for the languages the
+	// request accepts.
+	RejectionMessages *map[string]string `json:"rejection_messages,omitempty"`
+
 	// UpdateTime Timestamp when the policy was last updated. This field is output-only
 	// and automatically updated by the server on any modification.
 	//
 	// Uses ISO 8601 format with timezone per AEP-140.
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// UpdatedBy Principal that made the last change to the policy. This field is
+	// output-only and unset when that change recorded no principal.
+	UpdatedBy *string `json:"updated_by,omitempty"`
+
+	// Warnings Problems found by linting the `rego_code`: formatting that differs
+	// from `opa fmt`, and unused imports, variables and function arguments.
+	// Warnings never block a change. This field is output-only and only
+	// set in create, update, apply and rollback responses.
+	Warnings *[]PolicyWarning `json:"warnings,omitempty"`
 }
 
 // PolicyPolicyType Scope of the policy application. This field is immutable after creation.
@@ -168,25 +1042,664 @@ type Policy struct {
 // - USER: Applies to requests for a specific user
 //
 // Policies are evaluated in hierarchical order: Global -> User
+//
+// Later versions may add policy types. Clients must accept types they
+// do not know and keep them unchanged when they send the policy back.
 type PolicyPolicyType string
 
-// PolicyList Response message for listing policies.
-//
-// Implements AEP-132 List standard method requirements.
-type PolicyList struct {
-	// NextPageToken Token for retrieving the next page of results. If empty or not
+// PolicyCatalog The enabled policies with their documentation, in evaluation order
+type PolicyCatalog struct {
+	// GenerateTime When the catalog was generated
+	GenerateTime time.Time            `json:"generate_time"`
+	Policies     []PolicyCatalogEntry `json:"policies"`
+}
+
+// PolicyCatalogEntry defines model for PolicyCatalogEntry.
+type PolicyCatalogEntry struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"display_name"`
+
+	// Documentation Human-readable documentation of a policy, published in the policy
+	// catalog. Every field is optional; the object is replaced as a whole
+	// on update.
+	Documentation *PolicyDocumentation `json:"documentation,omitempty"`
+	Id            string               `json:"id"`
+	LabelSelector *map[string]string   `json:"label_selector,omitempty"`
+
+	// PolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+	// add policy types, which clients must accept.
+	PolicyType PolicyCatalogEntryPolicyType `json:"policy_type"`
+	Priority   int32                        `json:"priority"`
+	UpdateTime time.Time                    `json:"update_time"`
+}
+
+// PolicyCatalogEntryPolicyType Scope of the policy; see `Policy.policy_type`. Later versions may
+// add policy types, which clients must accept.
+type PolicyCatalogEntryPolicyType string
+
+// PolicyChecksum Deterministic digest of the stored policy set
+type PolicyChecksum struct {
+	// Checksum Hex SHA-256 digest of the policy set, prefixed with `sha256:`
+	Checksum string `json:"checksum"`
+
+	// PolicyCount Number of policies the checksum covers
+	PolicyCount int32 `json:"policy_count"`
+}
+
+// PolicyConflict defines model for PolicyConflict.
+type PolicyConflict struct {
+	// Detail Description of the conflict
+	Detail string `json:"detail"`
+
+	// Kind What the candidate conflicts on; see `checkPolicyConflicts`
+	Kind PolicyConflictKind `json:"kind"`
+
+	// PolicyId Stored policy the candidate conflicts with, when there is one
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// SampleIndex Index of the sample the conflict was found on; set for `SAMPLE` conflicts
+	SampleIndex *int32 `json:"sample_index,omitempty"`
+}
+
+// PolicyConflictCheckRequest defines model for PolicyConflictCheckRequest.
+type PolicyConflictCheckRequest struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID of the stored policy the candidate would replace; omit it to
+	// check a new policy.
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Samples Sample service instances to evaluate the candidate's decisions on
+	Samples *[]SimulatedServiceInstance `json:"samples,omitempty"`
+}
+
+// PolicyConflictKind What the candidate conflicts on; see `checkPolicyConflicts`
+type PolicyConflictKind string
+
+// PolicyConflictReport defines model for PolicyConflictReport.
+type PolicyConflictReport struct {
+	// Conflicts Conflicts found; empty when the candidate can be created without conflicts
+	Conflicts []PolicyConflict `json:"conflicts"`
+
+	// PolicyId ID the candidate was checked under
+	PolicyId string `json:"policy_id"`
+
+	// SamplesChecked Number of samples evaluated; samples are not evaluated when the candidate does not compile
+	SamplesChecked int32 `json:"samples_checked"`
+}
+
+// PolicyDocumentation Human-readable documentation of a policy, published in the policy
+// catalog. Every field is optional; the object is replaced as a whole
+// on update.
+type PolicyDocumentation struct {
+	// OwnerContact Team, email address or channel to contact about the policy
+	OwnerContact *string `json:"owner_contact,omitempty"`
+
+	// Rationale Why the policy exists. Supports markdown formatting.
+	Rationale *string `json:"rationale,omitempty"`
+
+	// RemediationUrl http or https URL of instructions for fixing rejected requests
+	RemediationUrl *string `json:"remediation_url,omitempty"`
+
+	// Summary One-line summary of what the policy enforces
+	Summary *string `json:"summary,omitempty"`
+}
+
+// PolicyFacets Distinct policy field values with the number of policies having each
+type PolicyFacets struct {
+	// LabelSelectorKeys Label selector keys in use, in alphabetical order
+	LabelSelectorKeys []LabelSelectorKeyFacet `json:"label_selector_keys"`
+
+	// PolicyTypes Policy types in use, in alphabetical order
+	PolicyTypes []FacetValue `json:"policy_types"`
+
+	// PriorityHistogram Policy counts per priority range, in ascending order
+	PriorityHistogram []PriorityBucket `json:"priority_histogram"`
+}
+
+// PolicyFieldChange defines model for PolicyFieldChange.
+type PolicyFieldChange struct {
+	// Field The changed field, e.g. `priority`; label selector keys,
+	// rejection messages, annotations, parameters and documentation
+	// fields are reported as `label_selector.<key>`,
+	// `rejection_messages.<code>`, `annotations.<key>`,
+	// `parameters.<name>` and `documentation.<field>`
+	Field string `json:"field"`
+
+	// From The value in the `from` revision; absent when the field was not set
+	From interface{} `json:"from,omitempty"`
+
+	// To The value in the `to` revision; absent when the field was not set
+	To interface{} `json:"to,omitempty"`
+}
+
+// PolicyImportEntry defines model for PolicyImportEntry.
+type PolicyImportEntry struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID to create the policy with; the server generates one when omitted
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// RegoFile Filename of the multipart file part holding the policy's
+	// `rego_code`, without its directory. Only read from a multipart
+	// manifest.
+	RegoFile *string `json:"rego_file,omitempty"`
+}
+
+// PolicyImportItem defines model for PolicyImportItem.
+type PolicyImportItem struct {
+	// Detail Reason the entry failed
+	Detail *string `json:"detail,omitempty"`
+
+	// Index Position of the entry in the request, from 0
+	Index int32 `json:"index"`
+
+	// PolicyId ID of the policy the entry was (or would have been) created as
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// Status CREATED - The policy was created
+	// FAILED - The entry could not be created; see detail
+	// ABORTED - The entry is valid but was not created, because another entry of an atomic import failed
+	Status PolicyImportItemStatus `json:"status"`
+}
+
+// PolicyImportItemStatus CREATED - The policy was created
+// FAILED - The entry could not be created; see detail
+// ABORTED - The entry is valid but was not created, because another entry of an atomic import failed
+type PolicyImportItemStatus string
+
+// PolicyImportRequest defines model for PolicyImportRequest.
+type PolicyImportRequest struct {
+	// Policies The policies to create, in order
+	Policies []PolicyImportEntry `json:"policies"`
+}
+
+// PolicyImportResult Outcome of a policy import
+type PolicyImportResult struct {
+	// Results One entry per entry of the request, in order
+	Results []PolicyImportItem `json:"results"`
+}
+
+// PolicyList Response message for listing policies.
+//
+// Implements AEP-132 List standard method requirements.
+type PolicyList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
 	// present, there are no more results.
 	//
 	// This token is opaque and should not be parsed by clients.
 	NextPageToken *string `json:"next_page_token,omitempty"`
 
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
 	// Policies List of policy resources matching the request criteria
 	Policies []Policy `json:"policies"`
 }
 
+// PolicyLock An advisory lock on a policy held by an editor until it expires.
+// Output-only in a policy, where it is set by get and list while the
+// lock is held.
+type PolicyLock struct {
+	// ExpireTime When the lock expires unless it is extended
+	ExpireTime time.Time `json:"expire_time"`
+
+	// Owner Who holds the lock, as given when locking
+	Owner string `json:"owner"`
+}
+
+// PolicyLockRequest defines model for PolicyLockRequest.
+type PolicyLockRequest struct {
+	// Owner Who takes the lock, typically the user editing the policy
+	Owner string `json:"owner"`
+
+	// TtlSeconds How long the lock is held, from now
+	TtlSeconds *int32 `json:"ttl_seconds,omitempty"`
+}
+
+// PolicyMatchTestRequest The request to test, given either as labels or as a service instance
+// spec; set at most one of them.
+type PolicyMatchTestRequest struct {
+	// Labels Request labels to test the selector against
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Spec Service instance spec, as sent to the evaluation API. The request
+	// labels are derived from it the way evaluation derives them: its
+	// `service_type` and the string values of `metadata.labels`.
+	Spec *map[string]interface{} `json:"spec,omitempty"`
+}
+
+// PolicyMatchTestResult defines model for PolicyMatchTestResult.
+type PolicyMatchTestResult struct {
+	// Enabled Whether the policy is enabled and would be evaluated for a matching request
+	Enabled bool `json:"enabled"`
+
+	// FailedTerms Selector terms the request labels do not satisfy, ordered by key
+	FailedTerms []SelectorTermFailure `json:"failed_terms"`
+
+	// LabelSelector The policy's label selector
+	LabelSelector map[string]string `json:"label_selector"`
+
+	// Matches Whether the label selector matches the request labels
+	Matches bool `json:"matches"`
+
+	// RequestLabels The request labels the selector was tested against
+	RequestLabels map[string]string `json:"request_labels"`
+}
+
+// PolicyRevision An immutable snapshot of a policy stored by a create, update or apply
+type PolicyRevision struct {
+	// CreateTime When the change was made; the policy's update_time after it
+	CreateTime time.Time `json:"create_time"`
+
+	// Path Resource path in the format "policies/{policyId}/revisions/{revision}"
+	Path *string `json:"path,omitempty"`
+
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// Revision The revision number; the create is revision 1
+	Revision int64 `json:"revision"`
+}
+
+// PolicyRevisionDiff defines model for PolicyRevisionDiff.
+type PolicyRevisionDiff struct {
+	// Changes Changed fields, in field order; label selector keys in alphabetical order
+	Changes []PolicyFieldChange `json:"changes"`
+
+	// From The revision compared from
+	From int64 `json:"from"`
+
+	// RegoDiff Unified diff of the Rego code; empty when the code is unchanged
+	RegoDiff string `json:"rego_diff"`
+
+	// To The revision compared to
+	To int64 `json:"to"`
+}
+
+// PolicyRevisionList defines model for PolicyRevisionList.
+type PolicyRevisionList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
+	// Revisions Revisions, newest first
+	Revisions []PolicyRevision `json:"revisions"`
+}
+
+// PolicyRollbackRequest defines model for PolicyRollbackRequest.
+type PolicyRollbackRequest struct {
+	// Revision The revision to restore
+	Revision int64 `json:"revision"`
+}
+
+// PolicySimulation defines model for PolicySimulation.
+type PolicySimulation struct {
+	// Detail Rejection message or failure detail; set when `status` is `REJECTED` or `FAILED`
+	Detail                   *string                   `json:"detail,omitempty"`
+	Draft                    DraftPolicyResult         `json:"draft"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// FailedPolicyId Policy that rejected the request or failed; set when `status` is `REJECTED` or `FAILED`
+	FailedPolicyId *string `json:"failed_policy_id,omitempty"`
+
+	// PoliciesEvaluated IDs of the policies evaluated, in evaluation order
+	PoliciesEvaluated []string `json:"policies_evaluated"`
+
+	// PolicyId ID the draft was simulated under
+	PolicyId string `json:"policy_id"`
+
+	// SelectedProvider Service provider selected by the policies
+	SelectedProvider *string `json:"selected_provider,omitempty"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+}
+
+// PolicySimulationRequest defines model for PolicySimulationRequest.
+type PolicySimulationRequest struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy Policy `json:"policy"`
+
+	// PolicyId ID to simulate the draft under. When it names a stored policy the
+	// draft replaces that policy; omit it to simulate a new policy.
+	PolicyId        *string                  `json:"policy_id,omitempty"`
+	ServiceInstance SimulatedServiceInstance `json:"service_instance"`
+}
+
+// PolicyTest A test case of a policy: a service instance and the decision expected
+// when it is evaluated
+type PolicyTest struct {
+	CreateTime  *time.Time `json:"create_time,omitempty"`
+	DisplayName *string    `json:"display_name,omitempty"`
+
+	// Expected The expected decision. `status` is always compared; the other fields
+	// only when they are set.
+	Expected PolicyTestExpectation `json:"expected"`
+	Id       *string               `json:"id,omitempty"`
+
+	// Path Resource path in the format "policies/{policyId}/tests/{testId}"
+	Path            *string                  `json:"path,omitempty"`
+	ServiceInstance SimulatedServiceInstance `json:"service_instance"`
+}
+
+// PolicyTestExpectation The expected decision. `status` is always compared; the other fields
+// only when they are set.
+type PolicyTestExpectation struct {
+	// Detail The rejection reason or failure message
+	Detail                   *string                   `json:"detail,omitempty"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// PolicyOutcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	PolicyOutcome *DraftPolicyOutcome `json:"policy_outcome,omitempty"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+}
+
+// PolicyTestList defines model for PolicyTestList.
+type PolicyTestList struct {
+	// NextPageToken Token for retrieving the next page of results. If empty or not
+	// present, there are no more results.
+	NextPageToken *string `json:"next_page_token,omitempty"`
+
+	// PageSize The page size used for this page, after applying the default and maximum
+	PageSize int32 `json:"page_size"`
+
+	// Tests Test cases, oldest first
+	Tests []PolicyTest `json:"tests"`
+}
+
+// PolicyTestResult defines model for PolicyTestResult.
+type PolicyTestResult struct {
+	// Detail The rejection reason or failure message, when the request was rejected or failed
+	Detail                   *string                   `json:"detail,omitempty"`
+	EvaluatedServiceInstance *SimulatedServiceInstance `json:"evaluated_service_instance,omitempty"`
+
+	// Failures How the decision differs from the expected one; empty when the test passed
+	Failures []string `json:"failures"`
+	Passed   bool     `json:"passed"`
+
+	// PolicyOutcome What the draft policy did:
+	// - `APPLIED`: it was evaluated and its decision applied.
+	// - `UNDEFINED`: it returned no decision.
+	// - `REJECTED`: it rejected the request.
+	// - `FAILED`: its decision could not be applied or it could not be evaluated.
+	// - `SKIPPED`: its label selector does not match the request.
+	// - `NOT_REACHED`: an earlier policy ended the evaluation before it ran.
+	PolicyOutcome DraftPolicyOutcome `json:"policy_outcome"`
+
+	// Status Outcome of the simulated evaluation:
+	// - `APPROVED`: the request was approved unchanged.
+	// - `MODIFIED`: the request was approved with changes.
+	// - `REJECTED`: a policy rejected the request.
+	// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+	//
+	// Later versions may add outcomes. Clients must accept outcomes they do
+	// not know, and should not treat them as approvals.
+	Status SimulationStatus `json:"status"`
+	TestId string           `json:"test_id"`
+}
+
+// PolicyTestRun defines model for PolicyTestRun.
+type PolicyTestRun struct {
+	// Failed Number of test cases whose decision did not match
+	Failed int32 `json:"failed"`
+
+	// Passed Number of test cases whose decision matched the expected one
+	Passed   int32  `json:"passed"`
+	PolicyId string `json:"policy_id"`
+
+	// Results Results in test case order
+	Results []PolicyTestResult `json:"results"`
+}
+
+// PolicyUnlockRequest defines model for PolicyUnlockRequest.
+type PolicyUnlockRequest struct {
+	// Owner Who holds the lock
+	Owner string `json:"owner"`
+}
+
+// PolicyWarning A problem found by linting a policy's Rego code
+type PolicyWarning struct {
+	// Code Kind of problem:
+	// - `NOT_FORMATTED`: `opa fmt` would change the code.
+	// - `UNUSED_IMPORT`: an import is never referenced.
+	// - `UNUSED_VARIABLE`: a local variable is assigned but never used.
+	// - `UNUSED_ARGUMENT`: a function argument is never used.
+	// - `STRICT`: another construct the compiler's strict mode rejects.
+	Code PolicyWarningCode `json:"code"`
+
+	// Column 1-based column of the problem; omitted when it has no location
+	Column *int32 `json:"column,omitempty"`
+
+	// Line 1-based line of the problem; omitted when it has no location
+	Line    *int32 `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+// PolicyWarningCode Kind of problem:
+// - `NOT_FORMATTED`: `opa fmt` would change the code.
+// - `UNUSED_IMPORT`: an import is never referenced.
+// - `UNUSED_VARIABLE`: a local variable is assigned but never used.
+// - `UNUSED_ARGUMENT`: a function argument is never used.
+// - `STRICT`: another construct the compiler's strict mode rejects.
+type PolicyWarningCode string
+
+// PolicyWatchEvent A policy change streamed by the watch endpoint
+type PolicyWatchEvent struct {
+	// Policy Represents an OPA (Open Policy Agent) policy resource.
+	//
+	// Policies define authorization rules using Rego code and can be scoped
+	// to different levels (GLOBAL or USER). They are matched against
+	// requests using label selectors and evaluated in priority order.
+	//
+	// Used for both create (POST) and update (PATCH). On create, display_name,
+	// policy_type, and rego_code are required (enforced by the service). On
+	// update, only fields present in the request body are merged (RFC 7396).
+	Policy *Policy `json:"policy,omitempty"`
+
+	// PolicyId ID of the changed policy; unset on `RESYNC`
+	PolicyId *string `json:"policy_id,omitempty"`
+
+	// ResourceVersion Opaque, increasing version of the change; resume the watch from it
+	ResourceVersion string `json:"resource_version"`
+
+	// Type The kind of change: `CREATED`, `UPDATED`, `DELETED`, or `RESYNC`,
+	// which carries no policy and means the changes since the requested
+	// resource version could not be replayed.
+	Type string `json:"type"`
+}
+
+// PriorityBucket defines model for PriorityBucket.
+type PriorityBucket struct {
+	// Count Number of policies with a priority in the range
+	Count int32 `json:"count"`
+
+	// Max Highest priority in the range (inclusive)
+	Max int32 `json:"max"`
+
+	// Min Lowest priority in the range (inclusive)
+	Min int32 `json:"min"`
+}
+
+// Readiness defines model for Readiness.
+type Readiness struct {
+	// Dependencies The checked dependencies, in a fixed order
+	Dependencies []DependencyStatus `json:"dependencies"`
+
+	// Status `ready` when every dependency is ready, `not_ready` otherwise
+	Status string `json:"status"`
+}
+
+// SelectorTermFailure defines model for SelectorTermFailure.
+type SelectorTermFailure struct {
+	// Actual Value of the request label; absent when the label is missing
+	Actual *string `json:"actual,omitempty"`
+
+	// Expected Value the selector requires
+	Expected string `json:"expected"`
+
+	// Key Selector label key
+	Key string `json:"key"`
+
+	// Reason Why the term failed:
+	// - `MISSING`: the request has no label with this key.
+	// - `MISMATCH`: the request label has a different value.
+	Reason SelectorTermFailureReason `json:"reason"`
+}
+
+// SelectorTermFailureReason Why the term failed:
+// - `MISSING`: the request has no label with this key.
+// - `MISMATCH`: the request label has a different value.
+type SelectorTermFailureReason string
+
+// SimulatedServiceInstance defines model for SimulatedServiceInstance.
+type SimulatedServiceInstance struct {
+	// Spec Service instance spec, as sent to the evaluation API
+	Spec map[string]interface{} `json:"spec"`
+}
+
+// SimulationStatus Outcome of the simulated evaluation:
+// - `APPROVED`: the request was approved unchanged.
+// - `MODIFIED`: the request was approved with changes.
+// - `REJECTED`: a policy rejected the request.
+// - `FAILED`: a policy conflicted with a higher-priority policy, violated a constraint, or could not be evaluated.
+//
+// Later versions may add outcomes. Clients must accept outcomes they do
+// not know, and should not treat them as approvals.
+type SimulationStatus string
+
+// TelemetryEvaluationCounts Evaluation outcomes in the report period; dry runs are not counted
+type TelemetryEvaluationCounts struct {
+	Completed int64 `json:"completed"`
+	Rejected  int64 `json:"rejected"`
+}
+
+// TelemetryPolicyCounts Number of stored policies
+type TelemetryPolicyCounts struct {
+	Enabled int `json:"enabled"`
+	Global  int `json:"global"`
+	Total   int `json:"total"`
+	User    int `json:"user"`
+}
+
+// TelemetryReport The exact document sent to the telemetry endpoint
+type TelemetryReport struct {
+	// Evaluations Evaluation outcomes in the report period; dry runs are not counted
+	Evaluations TelemetryEvaluationCounts `json:"evaluations"`
+
+	// Features Optional features and whether they are enabled, as in `feature_flags`
+	Features map[string]bool `json:"features"`
+
+	// InstanceId Random ID generated at process start, so reports of one run can be grouped without identifying the deployment
+	InstanceId string `json:"instance_id"`
+
+	// PeriodSeconds Length of the period the evaluations were counted over
+	PeriodSeconds int64 `json:"period_seconds"`
+
+	// Policies Number of stored policies
+	Policies TelemetryPolicyCounts `json:"policies"`
+
+	// Time When the report was made
+	Time time.Time `json:"time"`
+
+	// Version Release version of the binary
+	Version string `json:"version"`
+}
+
+// TelemetryStatus Opt-in anonymous usage telemetry. When enabled, a TelemetryReport is
+// POSTed to `endpoint` at startup and then every `interval`. Reports
+// never contain policy IDs, names, Rego, labels or request content.
+type TelemetryStatus struct {
+	// Enabled Whether reports are sent; false unless `TELEMETRY_ENABLED` is set
+	Enabled bool `json:"enabled"`
+
+	// Endpoint URL reports are sent to
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// Interval Time between reports, as a Go duration
+	Interval *string `json:"interval,omitempty"`
+
+	// LastError Why the last report could not be sent; absent after a successful report
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastReport The exact document sent to the telemetry endpoint
+	LastReport *TelemetryReport `json:"last_report,omitempty"`
+}
+
+// DryRun defines model for DryRun.
+type DryRun = bool
+
+// IfModifiedSince defines model for IfModifiedSince.
+type IfModifiedSince = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
 // PolicyIdPath defines model for PolicyIdPath.
 type PolicyIdPath = string
 
+// TestIdPath defines model for TestIdPath.
+type TestIdPath = string
+
 // AlreadyExists Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
@@ -207,11 +1720,21 @@ type Forbidden = Error
 // Provides structured error information for API failures.
 type InternalServerError = Error
 
+// Locked Error response following RFC 7807 Problem Details and AEP-193.
+//
+// Provides structured error information for API failures.
+type Locked = Error
+
 // NotFound Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
 type NotFound = Error
 
+// TooManyRequests Error response following RFC 7807 Problem Details and AEP-193.
+//
+// Provides structured error information for API failures.
+type TooManyRequests = Error
+
 // Unauthorized Error response following RFC 7807 Problem Details and AEP-193.
 //
 // Provides structured error information for API failures.
@@ -222,40 +1745,112 @@ type Unauthorized = Error
 // Provides structured error information for API failures.
 type ValidationError = Error
 
+// bearerAuthContextKey is the context key for bearerAuth security scheme
+type bearerAuthContextKey string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of entries to return per page. Server may return
+	// fewer entries. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// ListEngineApiKeysParams defines parameters for ListEngineApiKeys.
+type ListEngineApiKeysParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of keys to return per page. Server may return
+	// fewer keys. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
+// CreateEngineApiKeyParams defines parameters for CreateEngineApiKey.
+type CreateEngineApiKeyParams struct {
+	// Id Optional client-specified ID for the key, following the policy ID
+	// format. If not provided, the server will generate a UUID.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
 // ListPoliciesParams defines parameters for ListPolicies.
 type ListPoliciesParams struct {
 	// PageToken Token for retrieving the next page of results. Leave empty for
 	// the first page. Use the `next_page_token` from the previous
 	// response to get the next page.
+	//
+	// Tokens are signed and expire. A token is only accepted with the
+	// same `filter` and `order_by` it was issued for; `max_page_size`
+	// may change between pages. An invalid, expired or mismatched token
+	// is rejected with `400`.
 	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
 
 	// MaxPageSize Maximum number of policies to return per page. Server may return
-	// fewer results. If unspecified, defaults to 50. Maximum value is 1000.
+	// fewer results. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
 	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 
-	// Filter Filter expression to apply to the list. Supports filtering by:
-	// - `policy_type`: GLOBAL or USER
-	// - `enabled`: true or false
+	// Filter Filter expression to apply to the list: one or more comparisons
+	// joined by `AND`. Supports filtering by:
+	// - `policy_type`: `=` a quoted policy type, e.g. 'GLOBAL' or 'USER';
+	//   types this version does not know match no policies rather than
+	//   being rejected
+	// - `enabled`: `=` true or false
+	// - `priority`: `=`, `<`, `<=`, `>` or `>=` an integer
+	// - `create_time`, `update_time`: `<`, `<=`, `>` or `>=` a quoted
+	//   RFC 3339 timestamp
+	// - `created_by`, `updated_by`: `=` a quoted principal
+	//
+	// `policy_type`, `enabled`, `created_by` and `updated_by` may appear
+	// once each.
 	//
 	// Examples:
 	// - `policy_type='GLOBAL'`
 	// - `enabled=true`
 	// - `policy_type='GLOBAL' AND enabled=true`
+	// - `priority>=100 AND priority<500`
+	// - `update_time>'2024-01-01T00:00:00Z'`
+	// - `created_by='alice@example.com'`
 	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// OrderBy Comma-separated list of fields to order by. Each field can be
 	// followed by 'asc' or 'desc'. Defaults to 'priority asc'.
 	//
 	// Supported fields:
-	// - priority
+	// - id
 	// - display_name
+	// - policy_type
+	// - priority
+	// - enabled
 	// - create_time
+	// - update_time
+	//
+	// Each field may appear once. Policies with equal values are ordered
+	// by `id` unless `id` is one of the fields.
 	//
 	// Examples:
 	// - `priority asc`
 	// - `display_name desc`
 	// - `create_time desc,priority asc`
+	// - `update_time desc`
 	OrderBy *string `form:"order_by,omitempty" json:"order_by,omitempty"`
+
+	// IfNoneMatch Entity tags of cached representations; a match returns 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 }
 
 // CreatePolicyParams defines parameters for CreatePolicy.
@@ -269,805 +1864,7659 @@ type CreatePolicyParams struct {
 	// - Contain only lowercase letters, numbers, and hyphens
 	// - End with letter or number
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
-}
 
-// CreatePolicyJSONRequestBody defines body for CreatePolicy for application/json ContentType.
-type CreatePolicyJSONRequestBody = Policy
+	// OnConflict Behavior when a policy with the given `id` already exists:
+	// - `fail` (default): return 409
+	// - `return_existing`: return the existing policy with 200 if its
+	//   content is identical to the request, 409 otherwise
+	//
+	// Has no effect without `id`.
+	OnConflict *CreatePolicyParamsOnConflict `form:"on_conflict,omitempty" json:"on_conflict,omitempty"`
+
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
 
-// UpdatePolicyApplicationMergePatchPlusJSONRequestBody defines body for UpdatePolicy for application/merge-patch+json ContentType.
-type UpdatePolicyApplicationMergePatchPlusJSONRequestBody = Policy
+// CreatePolicyParamsOnConflict defines parameters for CreatePolicy.
+type CreatePolicyParamsOnConflict string
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Health check
-	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// List policies
-	// (GET /policies)
-	ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams)
-	// Create a new policy
-	// (POST /policies)
-	CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams)
-	// Delete a policy
-	// (DELETE /policies/{policyId})
-	DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
-	// Get a policy
-	// (GET /policies/{policyId})
-	GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
-	// Update a policy
-	// (PATCH /policies/{policyId})
-	UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+// DeletePolicyParams defines parameters for DeletePolicy.
+type DeletePolicyParams struct {
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// GetPolicyParams defines parameters for GetPolicy.
+type GetPolicyParams struct {
+	// IfNoneMatch Entity tags of cached representations; a match returns 304
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
 
-type Unimplemented struct{}
+	// IfModifiedSince HTTP date of a cached representation; returns 304 when the resource
+	// has not changed since. Ignored when If-None-Match is sent.
+	IfModifiedSince *IfModifiedSince `json:"If-Modified-Since,omitempty"`
+}
 
-// Health check
-// (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+// UpdatePolicyParams defines parameters for UpdatePolicy.
+type UpdatePolicyParams struct {
+	// DryRun When true, the request is validated exactly as it would be otherwise,
+	// including the Rego compile check and the uniqueness of the ID, display
+	// name and priority, but nothing is stored. The response is the one the
+	// request would get, with the resource as it would be.
+	DryRun *DryRun `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// List policies
-// (GET /policies)
-func (_ Unimplemented) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ApplyPolicyParams defines parameters for ApplyPolicy.
+type ApplyPolicyParams struct {
+	// AllowMissing Create the policy when it does not exist
+	AllowMissing *bool `form:"allow_missing,omitempty" json:"allow_missing,omitempty"`
 }
 
-// Create a new policy
-// (POST /policies)
-func (_ Unimplemented) CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// ListPolicyRevisionsParams defines parameters for ListPolicyRevisions.
+type ListPolicyRevisionsParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of revisions to return per page. Server may return
+	// fewer revisions. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 }
 
-// Delete a policy
-// (DELETE /policies/{policyId})
-func (_ Unimplemented) DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// ListPolicyTestsParams defines parameters for ListPolicyTests.
+type ListPolicyTestsParams struct {
+	// PageToken Token for retrieving the next page of results. Leave empty for
+	// the first page. Use the `next_page_token` from the previous
+	// response to get the next page.
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
 
-// Get a policy
-// (GET /policies/{policyId})
-func (_ Unimplemented) GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// MaxPageSize Maximum number of test cases to return per page. Server may return
+	// fewer test cases. Defaults to the server's default page size (50 unless
+	// configured); values above the server's maximum page size (1000 unless
+	// configured) are lowered to it. The page size used is returned as
+	// `page_size`.
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
 }
 
-// Update a policy
-// (PATCH /policies/{policyId})
-func (_ Unimplemented) UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	w.WriteHeader(http.StatusNotImplemented)
+// CreatePolicyTestParams defines parameters for CreatePolicyTest.
+type CreatePolicyTestParams struct {
+	// Id Optional client-specified ID for the test case, following the
+	// policy ID format. If not provided, the server will generate a UUID.
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// DiffPolicyRevisionsParams defines parameters for DiffPolicyRevisions.
+type DiffPolicyRevisionsParams struct {
+	// From The revision to compare from
+	From int64 `form:"from" json:"from"`
+
+	// To The revision to compare to
+	To int64 `form:"to" json:"to"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// GetPolicyCatalogParams defines parameters for GetPolicyCatalog.
+type GetPolicyCatalogParams struct {
+	// Format Representation of the catalog
+	Format *GetPolicyCatalogParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetPolicyCatalogParamsFormat defines parameters for GetPolicyCatalog.
+type GetPolicyCatalogParamsFormat string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
+// GetEvaluationOrderParams defines parameters for GetEvaluationOrder.
+type GetEvaluationOrderParams struct {
+	// Labels Request labels as `key=value`; repeat the parameter for each label
+	Labels *[]string `form:"labels,omitempty" json:"labels,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ExportPolicyBundleParams defines parameters for ExportPolicyBundle.
+type ExportPolicyBundleParams struct {
+	// PolicyType Only export policies of this type
+	PolicyType *ExportPolicyBundleParamsPolicyType `form:"policy_type,omitempty" json:"policy_type,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// StartAfter Only export policies whose ID sorts after this one, to resume an interrupted export
+	StartAfter *string `form:"start_after,omitempty" json:"start_after,omitempty"`
 }
 
-// ListPolicies operation middleware
-func (siw *ServerInterfaceWrapper) ListPolicies(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ExportPolicyBundleParamsPolicyType defines parameters for ExportPolicyBundle.
+type ExportPolicyBundleParamsPolicyType string
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPoliciesParams
+// ImportPoliciesMultipartBody defines parameters for ImportPolicies.
+type ImportPoliciesMultipartBody struct {
+	// Files Rego files named by the `rego_file` of the entries
+	Files *[]openapi_types.File `json:"files,omitempty"`
 
-	// ------------- Optional query parameter "page_token" -------------
+	// Manifest The `PolicyImportRequest`, as JSON or YAML
+	Manifest openapi_types.File `json:"manifest"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
-		return
-	}
+// ImportPoliciesParams defines parameters for ImportPolicies.
+type ImportPoliciesParams struct {
+	// Atomic When true, create every entry or none of them
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
+}
 
-	// ------------- Optional query parameter "max_page_size" -------------
+// ImportPolicyBundleParams defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParams struct {
+	// Format Format of the request body
+	Format *ImportPolicyBundleParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
-		return
-	}
+	// MetadataConvention Where to read policy fields from for each imported file
+	MetadataConvention *ImportPolicyBundleParamsMetadataConvention `form:"metadata_convention,omitempty" json:"metadata_convention,omitempty"`
 
-	// ------------- Optional query parameter "filter" -------------
+	// PolicyType Policy type for imported policies that do not declare one
+	PolicyType *ImportPolicyBundleParamsPolicyType `form:"policy_type,omitempty" json:"policy_type,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		return
-	}
+	// IdPrefix Prefix prepended to every path-derived policy ID
+	IdPrefix *string `form:"id_prefix,omitempty" json:"id_prefix,omitempty"`
+}
 
-	// ------------- Optional query parameter "order_by" -------------
+// ImportPolicyBundleParamsFormat defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsFormat string
 
-	err = runtime.BindQueryParameter("form", true, false, "order_by", r.URL.Query(), &params.OrderBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
-		return
-	}
+// ImportPolicyBundleParamsMetadataConvention defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsMetadataConvention string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPolicies(w, r, params)
-	}))
+// ImportPolicyBundleParamsPolicyType defines parameters for ImportPolicyBundle.
+type ImportPolicyBundleParamsPolicyType string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// WatchPoliciesParams defines parameters for WatchPolicies.
+type WatchPoliciesParams struct {
+	// ResourceVersion Resource version of the last change received; replays the changes after it
+	ResourceVersion *string `form:"resource_version,omitempty" json:"resource_version,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// LastEventID Sent by `EventSource` on reconnection; used when `resource_version` is not set
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
-// CreatePolicy operation middleware
-func (siw *ServerInterfaceWrapper) CreatePolicy(w http.ResponseWriter, r *http.Request) {
+// ScrubAuditEntriesJSONRequestBody defines body for ScrubAuditEntries for application/json ContentType.
+type ScrubAuditEntriesJSONRequestBody = AuditScrubRequest
 
-	var err error
+// CreateEngineApiKeyJSONRequestBody defines body for CreateEngineApiKey for application/json ContentType.
+type CreateEngineApiKeyJSONRequestBody = EngineApiKey
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreatePolicyParams
+// CreatePolicyJSONRequestBody defines body for CreatePolicy for application/json ContentType.
+type CreatePolicyJSONRequestBody = Policy
 
-	// ------------- Optional query parameter "id" -------------
+// UpdatePolicyApplicationMergePatchPlusJSONRequestBody defines body for UpdatePolicy for application/merge-patch+json ContentType.
+type UpdatePolicyApplicationMergePatchPlusJSONRequestBody = Policy
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ApplyPolicyJSONRequestBody defines body for ApplyPolicy for application/json ContentType.
+type ApplyPolicyJSONRequestBody = Policy
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePolicy(w, r, params)
-	}))
+// CreatePolicyTestJSONRequestBody defines body for CreatePolicyTest for application/json ContentType.
+type CreatePolicyTestJSONRequestBody = PolicyTest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// LockPolicyJSONRequestBody defines body for LockPolicy for application/json ContentType.
+type LockPolicyJSONRequestBody = PolicyLockRequest
 
-	handler.ServeHTTP(w, r)
-}
+// TestPolicyMatchJSONRequestBody defines body for TestPolicyMatch for application/json ContentType.
+type TestPolicyMatchJSONRequestBody = PolicyMatchTestRequest
 
-// DeletePolicy operation middleware
-func (siw *ServerInterfaceWrapper) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+// RollbackPolicyJSONRequestBody defines body for RollbackPolicy for application/json ContentType.
+type RollbackPolicyJSONRequestBody = PolicyRollbackRequest
 
-	var err error
+// UnlockPolicyJSONRequestBody defines body for UnlockPolicy for application/json ContentType.
+type UnlockPolicyJSONRequestBody = PolicyUnlockRequest
 
-	// ------------- Path parameter "policyId" -------------
-	var policyId PolicyIdPath
+// CheckPolicyConflictsJSONRequestBody defines body for CheckPolicyConflicts for application/json ContentType.
+type CheckPolicyConflictsJSONRequestBody = PolicyConflictCheckRequest
 
-	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
-		return
-	}
+// ImportPoliciesJSONRequestBody defines body for ImportPolicies for application/json ContentType.
+type ImportPoliciesJSONRequestBody = PolicyImportRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePolicy(w, r, policyId)
-	}))
+// ImportPoliciesMultipartRequestBody defines body for ImportPolicies for multipart/form-data ContentType.
+type ImportPoliciesMultipartRequestBody ImportPoliciesMultipartBody
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SimulatePolicyJSONRequestBody defines body for SimulatePolicy for application/json ContentType.
+type SimulatePolicyJSONRequestBody = PolicySimulationRequest
 
-	handler.ServeHTTP(w, r)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List audit log entries
+	// (GET /admin/audit)
+	ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams)
+	// Remove a data subject's details from the audit log
+	// (POST /admin/audit:scrub)
+	ScrubAuditEntries(w http.ResponseWriter, r *http.Request)
+	// Verify the audit log hash chain
+	// (GET /admin/audit:verify)
+	VerifyAuditLog(w http.ResponseWriter, r *http.Request)
+	// Build information
+	// (GET /admin/buildinfo)
+	GetBuildInfo(w http.ResponseWriter, r *http.Request)
+	// Reload the runtime-tunable configuration
+	// (POST /admin/config/reload)
+	ReloadConfig(w http.ResponseWriter, r *http.Request)
+	// List engine API keys
+	// (GET /admin/engine-api-keys)
+	ListEngineApiKeys(w http.ResponseWriter, r *http.Request, params ListEngineApiKeysParams)
+	// Create an engine API key
+	// (POST /admin/engine-api-keys)
+	CreateEngineApiKey(w http.ResponseWriter, r *http.Request, params CreateEngineApiKeyParams)
+	// Revoke an engine API key
+	// (DELETE /admin/engine-api-keys/{keyId})
+	DeleteEngineApiKey(w http.ResponseWriter, r *http.Request, keyId string)
+	// Rebuild the OPA state from the stored policies
+	// (POST /admin/opa/rebuild)
+	RebuildEngine(w http.ResponseWriter, r *http.Request)
+	// Get the progress of an OPA rebuild
+	// (GET /admin/opa/rebuild/{rebuildId})
+	GetEngineRebuild(w http.ResponseWriter, r *http.Request, rebuildId string)
+	// Health check
+	// (GET /health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// Liveness check
+	// (GET /livez)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// List policies
+	// (GET /policies)
+	ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams)
+	// Create a new policy
+	// (POST /policies)
+	CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams)
+	// Delete a policy
+	// (DELETE /policies/{policyId})
+	DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DeletePolicyParams)
+	// Get a policy
+	// (GET /policies/{policyId})
+	GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params GetPolicyParams)
+	// Update a policy
+	// (PATCH /policies/{policyId})
+	UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params UpdatePolicyParams)
+	// Apply a policy
+	// (PUT /policies/{policyId})
+	ApplyPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ApplyPolicyParams)
+	// List a policy's revisions
+	// (GET /policies/{policyId}/revisions)
+	ListPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyRevisionsParams)
+	// Get a policy revision
+	// (GET /policies/{policyId}/revisions/{revision})
+	GetPolicyRevision(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, revision int64)
+	// List a policy's test cases
+	// (GET /policies/{policyId}/tests)
+	ListPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyTestsParams)
+	// Add a test case to a policy
+	// (POST /policies/{policyId}/tests)
+	CreatePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params CreatePolicyTestParams)
+	// Delete a policy test case
+	// (DELETE /policies/{policyId}/tests/{testId})
+	DeletePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath)
+	// Get a policy test case
+	// (GET /policies/{policyId}/tests/{testId})
+	GetPolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath)
+	// Run a policy's test cases
+	// (POST /policies/{policyId}/tests:run)
+	RunPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+	// Diff two policy revisions
+	// (GET /policies/{policyId}:diff)
+	DiffPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DiffPolicyRevisionsParams)
+	// Lock a policy for editing
+	// (POST /policies/{policyId}:lock)
+	LockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+	// Test whether a policy's label selector matches a request
+	// (POST /policies/{policyId}:matchTest)
+	TestPolicyMatch(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+	// Roll a policy back to a prior revision
+	// (POST /policies/{policyId}:rollback)
+	RollbackPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+	// Unlock a policy
+	// (POST /policies/{policyId}:unlock)
+	UnlockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath)
+	// Generate a catalog of the active policies
+	// (GET /policies:catalog)
+	GetPolicyCatalog(w http.ResponseWriter, r *http.Request, params GetPolicyCatalogParams)
+	// Check a candidate policy for conflicts with the stored policies
+	// (POST /policies:checkConflicts)
+	CheckPolicyConflicts(w http.ResponseWriter, r *http.Request)
+	// Get a checksum of the policy set
+	// (GET /policies:checksum)
+	GetPolicyChecksum(w http.ResponseWriter, r *http.Request)
+	// Convert Gatekeeper ConstraintTemplates and Constraints into policies
+	// (POST /policies:convertGatekeeper)
+	ConvertGatekeeper(w http.ResponseWriter, r *http.Request)
+	// List the policies a request would be evaluated against, in order
+	// (GET /policies:evaluationOrder)
+	GetEvaluationOrder(w http.ResponseWriter, r *http.Request, params GetEvaluationOrderParams)
+	// Export policies as an OPA bundle
+	// (GET /policies:exportBundle)
+	ExportPolicyBundle(w http.ResponseWriter, r *http.Request, params ExportPolicyBundleParams)
+	// List distinct policy field values for filtering
+	// (GET /policies:facets)
+	GetPolicyFacets(w http.ResponseWriter, r *http.Request)
+	// Import several policies in one request
+	// (POST /policies:import)
+	ImportPolicies(w http.ResponseWriter, r *http.Request, params ImportPoliciesParams)
+	// Import policies from an OPA bundle or ConfigMap dump
+	// (POST /policies:importBundle)
+	ImportPolicyBundle(w http.ResponseWriter, r *http.Request, params ImportPolicyBundleParams)
+	// Simulate a draft policy against the live policy set
+	// (POST /policies:simulate)
+	SimulatePolicy(w http.ResponseWriter, r *http.Request)
+	// Watch policy changes
+	// (GET /policies:watch)
+	WatchPolicies(w http.ResponseWriter, r *http.Request, params WatchPoliciesParams)
+	// Readiness check
+	// (GET /readyz)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
 }
 
-// GetPolicy operation middleware
-func (siw *ServerInterfaceWrapper) GetPolicy(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
-	// ------------- Path parameter "policyId" -------------
-	var policyId PolicyIdPath
+type Unimplemented struct{}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
-		return
-	}
+// List audit log entries
+// (GET /admin/audit)
+func (_ Unimplemented) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPolicy(w, r, policyId)
-	}))
+// Remove a data subject's details from the audit log
+// (POST /admin/audit:scrub)
+func (_ Unimplemented) ScrubAuditEntries(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Verify the audit log hash chain
+// (GET /admin/audit:verify)
+func (_ Unimplemented) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Build information
+// (GET /admin/buildinfo)
+func (_ Unimplemented) GetBuildInfo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// UpdatePolicy operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
+// Reload the runtime-tunable configuration
+// (POST /admin/config/reload)
+func (_ Unimplemented) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	var err error
+// List engine API keys
+// (GET /admin/engine-api-keys)
+func (_ Unimplemented) ListEngineApiKeys(w http.ResponseWriter, r *http.Request, params ListEngineApiKeysParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	// ------------- Path parameter "policyId" -------------
-	var policyId PolicyIdPath
+// Create an engine API key
+// (POST /admin/engine-api-keys)
+func (_ Unimplemented) CreateEngineApiKey(w http.ResponseWriter, r *http.Request, params CreateEngineApiKeyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
-		return
-	}
+// Revoke an engine API key
+// (DELETE /admin/engine-api-keys/{keyId})
+func (_ Unimplemented) DeleteEngineApiKey(w http.ResponseWriter, r *http.Request, keyId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePolicy(w, r, policyId)
-	}))
+// Rebuild the OPA state from the stored policies
+// (POST /admin/opa/rebuild)
+func (_ Unimplemented) RebuildEngine(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Get the progress of an OPA rebuild
+// (GET /admin/opa/rebuild/{rebuildId})
+func (_ Unimplemented) GetEngineRebuild(w http.ResponseWriter, r *http.Request, rebuildId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Health check
+// (GET /health)
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// Liveness check
+// (GET /livez)
+func (_ Unimplemented) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// List policies
+// (GET /policies)
+func (_ Unimplemented) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// Create a new policy
+// (POST /policies)
+func (_ Unimplemented) CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// Delete a policy
+// (DELETE /policies/{policyId})
+func (_ Unimplemented) DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DeletePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+// Get a policy
+// (GET /policies/{policyId})
+func (_ Unimplemented) GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params GetPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+// Update a policy
+// (PATCH /policies/{policyId})
+func (_ Unimplemented) UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params UpdatePolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RequiredParamError struct {
-	ParamName string
+// Apply a policy
+// (PUT /policies/{policyId})
+func (_ Unimplemented) ApplyPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ApplyPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+// List a policy's revisions
+// (GET /policies/{policyId}/revisions)
+func (_ Unimplemented) ListPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyRevisionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
+// Get a policy revision
+// (GET /policies/{policyId}/revisions/{revision})
+func (_ Unimplemented) GetPolicyRevision(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, revision int64) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+// List a policy's test cases
+// (GET /policies/{policyId}/tests)
+func (_ Unimplemented) ListPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyTestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// Add a test case to a policy
+// (POST /policies/{policyId}/tests)
+func (_ Unimplemented) CreatePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params CreatePolicyTestParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// Delete a policy test case
+// (DELETE /policies/{policyId}/tests/{testId})
+func (_ Unimplemented) DeletePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// Get a policy test case
+// (GET /policies/{policyId}/tests/{testId})
+func (_ Unimplemented) GetPolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// Run a policy's test cases
+// (POST /policies/{policyId}/tests:run)
+func (_ Unimplemented) RunPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// Diff two policy revisions
+// (GET /policies/{policyId}:diff)
+func (_ Unimplemented) DiffPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DiffPolicyRevisionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// Lock a policy for editing
+// (POST /policies/{policyId}:lock)
+func (_ Unimplemented) LockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// Test whether a policy's label selector matches a request
+// (POST /policies/{policyId}:matchTest)
+func (_ Unimplemented) TestPolicyMatch(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// Roll a policy back to a prior revision
+// (POST /policies/{policyId}:rollback)
+func (_ Unimplemented) RollbackPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// Unlock a policy
+// (POST /policies/{policyId}:unlock)
+func (_ Unimplemented) UnlockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// Generate a catalog of the active policies
+// (GET /policies:catalog)
+func (_ Unimplemented) GetPolicyCatalog(w http.ResponseWriter, r *http.Request, params GetPolicyCatalogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
-
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+// Check a candidate policy for conflicts with the stored policies
+// (POST /policies:checkConflicts)
+func (_ Unimplemented) CheckPolicyConflicts(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/policies", wrapper.ListPolicies)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/policies", wrapper.CreatePolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/policies/{policyId}", wrapper.DeletePolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/policies/{policyId}", wrapper.GetPolicy)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/policies/{policyId}", wrapper.UpdatePolicy)
-	})
+// Get a checksum of the policy set
+// (GET /policies:checksum)
+func (_ Unimplemented) GetPolicyChecksum(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	return r
+// Convert Gatekeeper ConstraintTemplates and Constraints into policies
+// (POST /policies:convertGatekeeper)
+func (_ Unimplemented) ConvertGatekeeper(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type AlreadyExistsJSONResponse Error
+// List the policies a request would be evaluated against, in order
+// (GET /policies:evaluationOrder)
+func (_ Unimplemented) GetEvaluationOrder(w http.ResponseWriter, r *http.Request, params GetEvaluationOrderParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type BadRequestJSONResponse Error
+// Export policies as an OPA bundle
+// (GET /policies:exportBundle)
+func (_ Unimplemented) ExportPolicyBundle(w http.ResponseWriter, r *http.Request, params ExportPolicyBundleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type ForbiddenJSONResponse Error
+// List distinct policy field values for filtering
+// (GET /policies:facets)
+func (_ Unimplemented) GetPolicyFacets(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type InternalServerErrorJSONResponse Error
+// Import several policies in one request
+// (POST /policies:import)
+func (_ Unimplemented) ImportPolicies(w http.ResponseWriter, r *http.Request, params ImportPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type NotFoundJSONResponse Error
+// Import policies from an OPA bundle or ConfigMap dump
+// (POST /policies:importBundle)
+func (_ Unimplemented) ImportPolicyBundle(w http.ResponseWriter, r *http.Request, params ImportPolicyBundleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type UnauthorizedJSONResponse Error
+// Simulate a draft policy against the live policy set
+// (POST /policies:simulate)
+func (_ Unimplemented) SimulatePolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type ValidationErrorJSONResponse Error
+// Watch policy changes
+// (GET /policies:watch)
+func (_ Unimplemented) WatchPolicies(w http.ResponseWriter, r *http.Request, params WatchPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-type GetHealthRequestObject struct {
+// Readiness check
+// (GET /readyz)
+func (_ Unimplemented) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type GetHealth200JSONResponse Health
+type MiddlewareFunc func(http.Handler) http.Handler
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
+	_ = err
 
-type ListPoliciesRequestObject struct {
-	Params ListPoliciesParams
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
 
-type ListPoliciesResponseObject interface {
-	VisitListPoliciesResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "page_token" -------------
 
-type ListPolicies200JSONResponse PolicyList
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_token", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_token"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		}
+		return
+	}
 
-func (response ListPolicies200JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "max_page_size" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		}
+		return
+	}
 
-type ListPolicies400JSONResponse struct{ BadRequestJSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEntries(w, r, params)
+	}))
 
-func (response ListPolicies400JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+// ScrubAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ScrubAuditEntries(w http.ResponseWriter, r *http.Request) {
 
-func (response ListPolicies401JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScrubAuditEntries(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListPolicies403JSONResponse struct{ ForbiddenJSONResponse }
+// VerifyAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
 
-func (response ListPolicies403JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyAuditLog(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListPolicies500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	handler.ServeHTTP(w, r)
 }
 
-func (response ListPolicies500JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetBuildInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetBuildInfo(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuildInfo(w, r)
+	}))
 
-type CreatePolicyRequestObject struct {
-	Params CreatePolicyParams
-	Body   *CreatePolicyJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreatePolicyResponseObject interface {
-	VisitCreatePolicyResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreatePolicy201ResponseHeaders struct {
-	Location string
-}
+// ReloadConfig operation middleware
+func (siw *ServerInterfaceWrapper) ReloadConfig(w http.ResponseWriter, r *http.Request) {
 
-type CreatePolicy201JSONResponse struct {
-	Body    Policy
-	Headers CreatePolicy201ResponseHeaders
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReloadConfig(w, r)
+	}))
 
-func (response CreatePolicy201JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response.Body)
+	handler.ServeHTTP(w, r)
 }
 
-type CreatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+// ListEngineApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListEngineApiKeys(w http.ResponseWriter, r *http.Request) {
 
-func (response CreatePolicy400JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
+	_ = err
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEngineApiKeysParams
 
-type CreatePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+	// ------------- Optional query parameter "page_token" -------------
 
-func (response CreatePolicy401JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_token", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_token"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		}
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "max_page_size" -------------
 
-type CreatePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		}
+		return
+	}
 
-func (response CreatePolicy403JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEngineApiKeys(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreatePolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+// CreateEngineApiKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateEngineApiKey(w http.ResponseWriter, r *http.Request) {
 
-func (response CreatePolicy409JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
+	_ = err
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEngineApiKeyParams
 
-type CreatePolicy422JSONResponse struct{ ValidationErrorJSONResponse }
+	// ------------- Optional query parameter "id" -------------
 
-func (response CreatePolicy422JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEngineApiKey(w, r, params)
+	}))
 
-type CreatePolicy500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response CreatePolicy500JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// DeleteEngineApiKey operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEngineApiKey(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
+	_ = err
 
-type DeletePolicyRequestObject struct {
-	PolicyId PolicyIdPath `json:"policyId"`
-}
+	// ------------- Path parameter "keyId" -------------
+	var keyId string
 
-type DeletePolicyResponseObject interface {
-	VisitDeletePolicyResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", chi.URLParam(r, "keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyId", Err: err})
+		return
+	}
 
-type DeletePolicy204Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEngineApiKey(w, r, keyId)
+	}))
 
-func (response DeletePolicy204Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeletePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+// RebuildEngine operation middleware
+func (siw *ServerInterfaceWrapper) RebuildEngine(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RebuildEngine(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEngineRebuild operation middleware
+func (siw *ServerInterfaceWrapper) GetEngineRebuild(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "rebuildId" -------------
+	var rebuildId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rebuildId", chi.URLParam(r, "rebuildId"), &rebuildId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rebuildId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEngineRebuild(w, r, rebuildId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPoliciesParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_token", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_token"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order_by" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order_by", r.URL.Query(), &params.OrderBy, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order_by"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_by", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPolicies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreatePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePolicyParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "on_conflict" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "on_conflict", r.URL.Query(), &params.OnConflict, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "on_conflict"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "on_conflict", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePolicy(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePolicyParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePolicy(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPolicyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince IfModifiedSince
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicy(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePolicyParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePolicy(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ApplyPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApplyPolicyParams
+
+	// ------------- Optional query parameter "allow_missing" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "allow_missing", r.URL.Query(), &params.AllowMissing, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "allow_missing"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "allow_missing", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyPolicy(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPolicyRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListPolicyRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPolicyRevisionsParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_token", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_token"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPolicyRevisions(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicyRevision operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyRevision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", chi.URLParam(r, "revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "integer", Format: "int64"})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyRevision(w, r, policyId, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPolicyTests operation middleware
+func (siw *ServerInterfaceWrapper) ListPolicyTests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPolicyTestsParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_token", r.URL.Query(), &params.PageToken, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_token"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: "int32"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "max_page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPolicyTests(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePolicyTest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePolicyTest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePolicyTestParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id", r.URL.Query(), &params.Id, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePolicyTest(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePolicyTest operation middleware
+func (siw *ServerInterfaceWrapper) DeletePolicyTest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "testId" -------------
+	var testId TestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testId", chi.URLParam(r, "testId"), &testId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "testId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePolicyTest(w, r, policyId, testId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicyTest operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyTest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "testId" -------------
+	var testId TestIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "testId", chi.URLParam(r, "testId"), &testId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "testId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyTest(w, r, policyId, testId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunPolicyTests operation middleware
+func (siw *ServerInterfaceWrapper) RunPolicyTests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunPolicyTests(w, r, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DiffPolicyRevisions operation middleware
+func (siw *ServerInterfaceWrapper) DiffPolicyRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffPolicyRevisionsParams
+
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DiffPolicyRevisions(w, r, policyId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LockPolicy operation middleware
+func (siw *ServerInterfaceWrapper) LockPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LockPolicy(w, r, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestPolicyMatch operation middleware
+func (siw *ServerInterfaceWrapper) TestPolicyMatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestPolicyMatch(w, r, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackPolicy operation middleware
+func (siw *ServerInterfaceWrapper) RollbackPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackPolicy(w, r, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UnlockPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UnlockPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "policyId" -------------
+	var policyId PolicyIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policyId", chi.URLParam(r, "policyId"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnlockPolicy(w, r, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicyCatalog operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyCatalog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPolicyCatalogParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyCatalog(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckPolicyConflicts operation middleware
+func (siw *ServerInterfaceWrapper) CheckPolicyConflicts(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckPolicyConflicts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicyChecksum operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyChecksum(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyChecksum(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConvertGatekeeper operation middleware
+func (siw *ServerInterfaceWrapper) ConvertGatekeeper(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertGatekeeper(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEvaluationOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetEvaluationOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEvaluationOrderParams
+
+	// ------------- Optional query parameter "labels" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "labels", r.URL.Query(), &params.Labels, runtime.BindQueryParameterOptions{Type: "array", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "labels"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labels", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEvaluationOrder(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportPolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) ExportPolicyBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportPolicyBundleParams
+
+	// ------------- Optional query parameter "policy_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "policy_type", r.URL.Query(), &params.PolicyType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "policy_type"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy_type", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "start_after" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "start_after", r.URL.Query(), &params.StartAfter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "start_after"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start_after", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportPolicyBundle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPolicyFacets operation middleware
+func (siw *ServerInterfaceWrapper) GetPolicyFacets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPolicyFacets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ImportPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportPoliciesParams
+
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "atomic", r.URL.Query(), &params.Atomic, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "atomic"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "atomic", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPolicies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportPolicyBundle operation middleware
+func (siw *ServerInterfaceWrapper) ImportPolicyBundle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportPolicyBundleParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "metadata_convention" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "metadata_convention", r.URL.Query(), &params.MetadataConvention, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "metadata_convention"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metadata_convention", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "policy_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "policy_type", r.URL.Query(), &params.PolicyType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "policy_type"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy_type", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "id_prefix" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "id_prefix", r.URL.Query(), &params.IdPrefix, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id_prefix"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id_prefix", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPolicyBundle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SimulatePolicy operation middleware
+func (siw *ServerInterfaceWrapper) SimulatePolicy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SimulatePolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WatchPolicies operation middleware
+func (siw *ServerInterfaceWrapper) WatchPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchPoliciesParams
+
+	// ------------- Optional query parameter "resource_version" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "resource_version", r.URL.Query(), &params.ResourceVersion, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "resource_version"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resource_version", Err: err})
+		}
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WatchPolicies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/audit", wrapper.ListAuditEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/audit:scrub", wrapper.ScrubAuditEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/audit:verify", wrapper.VerifyAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/buildinfo", wrapper.GetBuildInfo)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/config/reload", wrapper.ReloadConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/engine-api-keys", wrapper.ListEngineApiKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/engine-api-keys", wrapper.CreateEngineApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/admin/engine-api-keys/{keyId}", wrapper.DeleteEngineApiKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/opa/rebuild", wrapper.RebuildEngine)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/opa/rebuild/{rebuildId}", wrapper.GetEngineRebuild)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/livez", wrapper.GetLiveness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies", wrapper.ListPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies", wrapper.CreatePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/policies/{policyId}", wrapper.DeletePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}", wrapper.GetPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/policies/{policyId}", wrapper.UpdatePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/policies/{policyId}", wrapper.ApplyPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}/revisions", wrapper.ListPolicyRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}/revisions/{revision}", wrapper.GetPolicyRevision)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}/tests", wrapper.ListPolicyTests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}/tests", wrapper.CreatePolicyTest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/policies/{policyId}/tests/{testId}", wrapper.DeletePolicyTest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}/tests/{testId}", wrapper.GetPolicyTest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}/tests:run", wrapper.RunPolicyTests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies/{policyId}:diff", wrapper.DiffPolicyRevisions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}:lock", wrapper.LockPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}:matchTest", wrapper.TestPolicyMatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}:rollback", wrapper.RollbackPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies/{policyId}:unlock", wrapper.UnlockPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:catalog", wrapper.GetPolicyCatalog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:checkConflicts", wrapper.CheckPolicyConflicts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:checksum", wrapper.GetPolicyChecksum)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:convertGatekeeper", wrapper.ConvertGatekeeper)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:evaluationOrder", wrapper.GetEvaluationOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:exportBundle", wrapper.ExportPolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:facets", wrapper.GetPolicyFacets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:import", wrapper.ImportPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:importBundle", wrapper.ImportPolicyBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/policies:simulate", wrapper.SimulatePolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/policies:watch", wrapper.WatchPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/readyz", wrapper.GetReadiness)
+	})
+
+	return r
+}
+
+type AlreadyExistsJSONResponse Error
+
+type BadRequestJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type InternalServerErrorJSONResponse Error
+
+type LockedJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type NotModifiedResponseHeaders struct {
+	CacheControl *string
+	ETag         *string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type TooManyRequestsResponseHeaders struct {
+	RetryAfter *int
+}
+type TooManyRequestsJSONResponse struct {
+	Body Error
+
+	Headers TooManyRequestsResponseHeaders
+}
+
+type UnauthorizedJSONResponse Error
+
+type ValidationErrorJSONResponse Error
+
+type ListAuditEntriesRequestObject struct {
+	Params ListAuditEntriesParams
+}
+
+type ListAuditEntriesResponseObject interface {
+	VisitListAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEntries200JSONResponse AuditEntryList
+
+func (response ListAuditEntries200JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAuditEntries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListAuditEntries400JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAuditEntries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListAuditEntries401JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAuditEntries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListAuditEntries403JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAuditEntries429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListAuditEntries429JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListAuditEntries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListAuditEntries500JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntriesRequestObject struct {
+	Body *ScrubAuditEntriesJSONRequestBody
+}
+
+type ScrubAuditEntriesResponseObject interface {
+	VisitScrubAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ScrubAuditEntries200JSONResponse AuditScrubResult
+
+func (response ScrubAuditEntries200JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntries400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ScrubAuditEntries400JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ScrubAuditEntries401JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntries403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ScrubAuditEntries403JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntries429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ScrubAuditEntries429JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ScrubAuditEntries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ScrubAuditEntries500JSONResponse) VisitScrubAuditEntriesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyAuditLogRequestObject struct {
+}
+
+type VerifyAuditLogResponseObject interface {
+	VisitVerifyAuditLogResponse(w http.ResponseWriter) error
+}
+
+type VerifyAuditLog200JSONResponse AuditVerification
+
+func (response VerifyAuditLog200JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyAuditLog401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response VerifyAuditLog401JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyAuditLog403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response VerifyAuditLog403JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyAuditLog429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response VerifyAuditLog429JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type VerifyAuditLog500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response VerifyAuditLog500JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetBuildInfoRequestObject struct {
+}
+
+type GetBuildInfoResponseObject interface {
+	VisitGetBuildInfoResponse(w http.ResponseWriter) error
+}
+
+type GetBuildInfo200JSONResponse BuildInfo
+
+func (response GetBuildInfo200JSONResponse) VisitGetBuildInfoResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfigRequestObject struct {
+}
+
+type ReloadConfigResponseObject interface {
+	VisitReloadConfigResponse(w http.ResponseWriter) error
+}
+
+type ReloadConfig200JSONResponse ConfigReload
+
+func (response ReloadConfig200JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ReloadConfig400JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfig401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ReloadConfig401JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfig403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ReloadConfig403JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfig429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ReloadConfig429JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ReloadConfig500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ReloadConfig500JSONResponse) VisitReloadConfigResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeysRequestObject struct {
+	Params ListEngineApiKeysParams
+}
+
+type ListEngineApiKeysResponseObject interface {
+	VisitListEngineApiKeysResponse(w http.ResponseWriter) error
+}
+
+type ListEngineApiKeys200JSONResponse EngineApiKeyList
+
+func (response ListEngineApiKeys200JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeys400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListEngineApiKeys400JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeys401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListEngineApiKeys401JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeys403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListEngineApiKeys403JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeys429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListEngineApiKeys429JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListEngineApiKeys500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListEngineApiKeys500JSONResponse) VisitListEngineApiKeysResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKeyRequestObject struct {
+	Params CreateEngineApiKeyParams
+	Body   *CreateEngineApiKeyJSONRequestBody
+}
+
+type CreateEngineApiKeyResponseObject interface {
+	VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateEngineApiKey201JSONResponse EngineApiKey
+
+func (response CreateEngineApiKey201JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateEngineApiKey400JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateEngineApiKey401JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateEngineApiKey403JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateEngineApiKey409JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreateEngineApiKey429JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreateEngineApiKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateEngineApiKey500JSONResponse) VisitCreateEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEngineApiKeyRequestObject struct {
+	KeyId string `json:"keyId"`
+}
+
+type DeleteEngineApiKeyResponseObject interface {
+	VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteEngineApiKey204Response struct {
+}
+
+func (response DeleteEngineApiKey204Response) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteEngineApiKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteEngineApiKey401JSONResponse) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEngineApiKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteEngineApiKey403JSONResponse) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEngineApiKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteEngineApiKey404JSONResponse) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEngineApiKey429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeleteEngineApiKey429JSONResponse) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeleteEngineApiKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteEngineApiKey500JSONResponse) VisitDeleteEngineApiKeyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RebuildEngineRequestObject struct {
+}
+
+type RebuildEngineResponseObject interface {
+	VisitRebuildEngineResponse(w http.ResponseWriter) error
+}
+
+type RebuildEngine202JSONResponse EngineRebuild
+
+func (response RebuildEngine202JSONResponse) VisitRebuildEngineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RebuildEngine401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RebuildEngine401JSONResponse) VisitRebuildEngineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RebuildEngine403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RebuildEngine403JSONResponse) VisitRebuildEngineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RebuildEngine429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RebuildEngine429JSONResponse) VisitRebuildEngineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RebuildEngine500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RebuildEngine500JSONResponse) VisitRebuildEngineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuildRequestObject struct {
+	RebuildId string `json:"rebuildId"`
+}
+
+type GetEngineRebuildResponseObject interface {
+	VisitGetEngineRebuildResponse(w http.ResponseWriter) error
+}
+
+type GetEngineRebuild200JSONResponse EngineRebuild
+
+func (response GetEngineRebuild200JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuild401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEngineRebuild401JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuild403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEngineRebuild403JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuild404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetEngineRebuild404JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuild429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetEngineRebuild429JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEngineRebuild500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEngineRebuild500JSONResponse) VisitGetEngineRebuildResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse Health
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetLivenessRequestObject struct {
+}
+
+type GetLivenessResponseObject interface {
+	VisitGetLivenessResponse(w http.ResponseWriter) error
+}
+
+type GetLiveness200JSONResponse Liveness
+
+func (response GetLiveness200JSONResponse) VisitGetLivenessResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPoliciesRequestObject struct {
+	Params ListPoliciesParams
+}
+
+type ListPoliciesResponseObject interface {
+	VisitListPoliciesResponse(w http.ResponseWriter) error
+}
+
+type ListPolicies200ResponseHeaders struct {
+	CacheControl *string
+	ETag         *string
+	LastModified *string
+}
+
+type ListPolicies200JSONResponse struct {
+	Body    PolicyList
+	Headers ListPolicies200ResponseHeaders
+}
+
+func (response ListPolicies200JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.CacheControl != nil {
+		w.Header().Set("Cache-Control", fmt.Sprint(*response.Headers.CacheControl))
+	}
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	if response.Headers.LastModified != nil {
+		w.Header().Set("Last-Modified", fmt.Sprint(*response.Headers.LastModified))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicies304Response = NotModifiedResponse
+
+func (response ListPolicies304Response) VisitListPoliciesResponse(w http.ResponseWriter) error {
+	if response.Headers.CacheControl != nil {
+		w.Header().Set("Cache-Control", fmt.Sprint(*response.Headers.CacheControl))
+	}
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(304)
+	return nil
+}
+
+type ListPolicies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPolicies400JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPolicies401JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicies403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListPolicies403JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicies429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListPolicies429JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPolicies500JSONResponse) VisitListPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyRequestObject struct {
+	Params CreatePolicyParams
+	Body   *CreatePolicyJSONRequestBody
+}
+
+type CreatePolicyResponseObject interface {
+	VisitCreatePolicyResponse(w http.ResponseWriter) error
+}
+
+type CreatePolicy200JSONResponse Policy
+
+func (response CreatePolicy200JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy201ResponseHeaders struct {
+	Location *string
+}
+
+type CreatePolicy201JSONResponse struct {
+	Body    Policy
+	Headers CreatePolicy201ResponseHeaders
+}
+
+func (response CreatePolicy201JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.Location != nil {
+		w.Header().Set("Location", fmt.Sprint(*response.Headers.Location))
+	}
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePolicy400JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreatePolicy401JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePolicy403JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreatePolicy409JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy422JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response CreatePolicy422JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreatePolicy429JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreatePolicy500JSONResponse) VisitCreatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   DeletePolicyParams
+}
+
+type DeletePolicyResponseObject interface {
+	VisitDeletePolicyResponse(w http.ResponseWriter) error
+}
+
+type DeletePolicy204Response struct {
+}
+
+func (response DeletePolicy204Response) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
 
 func (response DeletePolicy401JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePolicy403JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeletePolicy404JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeletePolicy429JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeletePolicy500JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   GetPolicyParams
+}
+
+type GetPolicyResponseObject interface {
+	VisitGetPolicyResponse(w http.ResponseWriter) error
+}
+
+type GetPolicy200ResponseHeaders struct {
+	CacheControl *string
+	ETag         *string
+	LastModified *string
+}
+
+type GetPolicy200JSONResponse struct {
+	Body    Policy
+	Headers GetPolicy200ResponseHeaders
+}
+
+func (response GetPolicy200JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.CacheControl != nil {
+		w.Header().Set("Cache-Control", fmt.Sprint(*response.Headers.CacheControl))
+	}
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	if response.Headers.LastModified != nil {
+		w.Header().Set("Last-Modified", fmt.Sprint(*response.Headers.LastModified))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicy304Response = NotModifiedResponse
+
+func (response GetPolicy304Response) VisitGetPolicyResponse(w http.ResponseWriter) error {
+	if response.Headers.CacheControl != nil {
+		w.Header().Set("Cache-Control", fmt.Sprint(*response.Headers.CacheControl))
+	}
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicy401JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicy403JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPolicy404JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicy429JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicy500JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   UpdatePolicyParams
+	Body     *UpdatePolicyApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdatePolicyResponseObject interface {
+	VisitUpdatePolicyResponse(w http.ResponseWriter) error
+}
+
+type UpdatePolicy200JSONResponse Policy
+
+func (response UpdatePolicy200JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdatePolicy400JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdatePolicy401JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdatePolicy403JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdatePolicy404JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response UpdatePolicy409JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response UpdatePolicy429JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UpdatePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdatePolicy500JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   ApplyPolicyParams
+	Body     *ApplyPolicyJSONRequestBody
+}
+
+type ApplyPolicyResponseObject interface {
+	VisitApplyPolicyResponse(w http.ResponseWriter) error
+}
+
+type ApplyPolicy200JSONResponse Policy
+
+func (response ApplyPolicy200JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy201JSONResponse Policy
+
+func (response ApplyPolicy201JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ApplyPolicy400JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApplyPolicy401JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ApplyPolicy403JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApplyPolicy404JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response ApplyPolicy409JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ApplyPolicy429JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ApplyPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApplyPolicy500JSONResponse) VisitApplyPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisionsRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   ListPolicyRevisionsParams
+}
+
+type ListPolicyRevisionsResponseObject interface {
+	VisitListPolicyRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ListPolicyRevisions200JSONResponse PolicyRevisionList
+
+func (response ListPolicyRevisions200JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPolicyRevisions400JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPolicyRevisions401JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListPolicyRevisions403JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListPolicyRevisions404JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListPolicyRevisions429JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyRevisions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPolicyRevisions500JSONResponse) VisitListPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevisionRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Revision int64        `json:"revision"`
+}
+
+type GetPolicyRevisionResponseObject interface {
+	VisitGetPolicyRevisionResponse(w http.ResponseWriter) error
+}
+
+type GetPolicyRevision200JSONResponse PolicyRevision
+
+func (response GetPolicyRevision200JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPolicyRevision400JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicyRevision401JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicyRevision403JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPolicyRevision404JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicyRevision429JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyRevision500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicyRevision500JSONResponse) VisitGetPolicyRevisionResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTestsRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   ListPolicyTestsParams
+}
+
+type ListPolicyTestsResponseObject interface {
+	VisitListPolicyTestsResponse(w http.ResponseWriter) error
+}
+
+type ListPolicyTests200JSONResponse PolicyTestList
+
+func (response ListPolicyTests200JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListPolicyTests400JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListPolicyTests401JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListPolicyTests403JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListPolicyTests404JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ListPolicyTests429JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ListPolicyTests500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListPolicyTests500JSONResponse) VisitListPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTestRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   CreatePolicyTestParams
+	Body     *CreatePolicyTestJSONRequestBody
+}
+
+type CreatePolicyTestResponseObject interface {
+	VisitCreatePolicyTestResponse(w http.ResponseWriter) error
+}
+
+type CreatePolicyTest201JSONResponse PolicyTest
+
+func (response CreatePolicyTest201JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreatePolicyTest400JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreatePolicyTest401JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreatePolicyTest403JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreatePolicyTest404JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreatePolicyTest409JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreatePolicyTest429JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CreatePolicyTest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreatePolicyTest500JSONResponse) VisitCreatePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyTestRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	TestId   TestIdPath   `json:"testId"`
+}
+
+type DeletePolicyTestResponseObject interface {
+	VisitDeletePolicyTestResponse(w http.ResponseWriter) error
+}
+
+type DeletePolicyTest204Response struct {
+}
+
+func (response DeletePolicyTest204Response) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePolicyTest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeletePolicyTest401JSONResponse) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyTest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeletePolicyTest403JSONResponse) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyTest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeletePolicyTest404JSONResponse) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyTest429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DeletePolicyTest429JSONResponse) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DeletePolicyTest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeletePolicyTest500JSONResponse) VisitDeletePolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTestRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	TestId   TestIdPath   `json:"testId"`
+}
+
+type GetPolicyTestResponseObject interface {
+	VisitGetPolicyTestResponse(w http.ResponseWriter) error
+}
+
+type GetPolicyTest200JSONResponse PolicyTest
+
+func (response GetPolicyTest200JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTest401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicyTest401JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTest403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicyTest403JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTest404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPolicyTest404JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTest429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicyTest429JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyTest500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicyTest500JSONResponse) VisitGetPolicyTestResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTestsRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+}
+
+type RunPolicyTestsResponseObject interface {
+	VisitRunPolicyTestsResponse(w http.ResponseWriter) error
+}
+
+type RunPolicyTests200JSONResponse PolicyTestRun
+
+func (response RunPolicyTests200JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTests401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RunPolicyTests401JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTests403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RunPolicyTests403JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTests404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RunPolicyTests404JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTests429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RunPolicyTests429JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RunPolicyTests500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RunPolicyTests500JSONResponse) VisitRunPolicyTestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisionsRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Params   DiffPolicyRevisionsParams
+}
+
+type DiffPolicyRevisionsResponseObject interface {
+	VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error
+}
+
+type DiffPolicyRevisions200JSONResponse PolicyRevisionDiff
+
+func (response DiffPolicyRevisions200JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DiffPolicyRevisions400JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DiffPolicyRevisions401JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DiffPolicyRevisions403JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DiffPolicyRevisions404JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response DiffPolicyRevisions429JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type DiffPolicyRevisions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DiffPolicyRevisions500JSONResponse) VisitDiffPolicyRevisionsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Body     *LockPolicyJSONRequestBody
+}
+
+type LockPolicyResponseObject interface {
+	VisitLockPolicyResponse(w http.ResponseWriter) error
+}
+
+type LockPolicy200JSONResponse PolicyLock
+
+func (response LockPolicy200JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LockPolicy400JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response LockPolicy401JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response LockPolicy403JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LockPolicy404JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy409JSONResponse struct{ LockedJSONResponse }
+
+func (response LockPolicy409JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response LockPolicy429JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type LockPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response LockPolicy500JSONResponse) VisitLockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatchRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Body     *TestPolicyMatchJSONRequestBody
+}
+
+type TestPolicyMatchResponseObject interface {
+	VisitTestPolicyMatchResponse(w http.ResponseWriter) error
+}
+
+type TestPolicyMatch200JSONResponse PolicyMatchTestResult
+
+func (response TestPolicyMatch200JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response TestPolicyMatch400JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response TestPolicyMatch401JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response TestPolicyMatch403JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response TestPolicyMatch404JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response TestPolicyMatch429JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type TestPolicyMatch500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response TestPolicyMatch500JSONResponse) VisitTestPolicyMatchResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Body     *RollbackPolicyJSONRequestBody
+}
+
+type RollbackPolicyResponseObject interface {
+	VisitRollbackPolicyResponse(w http.ResponseWriter) error
+}
+
+type RollbackPolicy200JSONResponse Policy
+
+func (response RollbackPolicy200JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RollbackPolicy400JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RollbackPolicy401JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RollbackPolicy403JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RollbackPolicy404JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response RollbackPolicy409JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response RollbackPolicy429JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type RollbackPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RollbackPolicy500JSONResponse) VisitRollbackPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicyRequestObject struct {
+	PolicyId PolicyIdPath `json:"policyId"`
+	Body     *UnlockPolicyJSONRequestBody
+}
+
+type UnlockPolicyResponseObject interface {
+	VisitUnlockPolicyResponse(w http.ResponseWriter) error
+}
+
+type UnlockPolicy204Response struct {
+}
+
+func (response UnlockPolicy204Response) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnlockPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UnlockPolicy400JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UnlockPolicy401JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UnlockPolicy403JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UnlockPolicy404JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy409JSONResponse struct{ LockedJSONResponse }
+
+func (response UnlockPolicy409JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response UnlockPolicy429JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type UnlockPolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UnlockPolicy500JSONResponse) VisitUnlockPolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalogRequestObject struct {
+	Params GetPolicyCatalogParams
+}
+
+type GetPolicyCatalogResponseObject interface {
+	VisitGetPolicyCatalogResponse(w http.ResponseWriter) error
+}
+
+type GetPolicyCatalog200JSONResponse PolicyCatalog
+
+func (response GetPolicyCatalog200JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalog200TextmarkdownResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetPolicyCatalog200TextmarkdownResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPolicyCatalog400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPolicyCatalog400JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalog401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicyCatalog401JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalog403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicyCatalog403JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalog429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicyCatalog429JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyCatalog500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicyCatalog500JSONResponse) VisitGetPolicyCatalogResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflictsRequestObject struct {
+	Body *CheckPolicyConflictsJSONRequestBody
+}
+
+type CheckPolicyConflictsResponseObject interface {
+	VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error
+}
+
+type CheckPolicyConflicts200JSONResponse PolicyConflictReport
+
+func (response CheckPolicyConflicts200JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflicts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CheckPolicyConflicts400JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflicts401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CheckPolicyConflicts401JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflicts403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CheckPolicyConflicts403JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflicts429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CheckPolicyConflicts429JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type CheckPolicyConflicts500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CheckPolicyConflicts500JSONResponse) VisitCheckPolicyConflictsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyChecksumRequestObject struct {
+}
+
+type GetPolicyChecksumResponseObject interface {
+	VisitGetPolicyChecksumResponse(w http.ResponseWriter) error
+}
+
+type GetPolicyChecksum200JSONResponse PolicyChecksum
+
+func (response GetPolicyChecksum200JSONResponse) VisitGetPolicyChecksumResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyChecksum401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicyChecksum401JSONResponse) VisitGetPolicyChecksumResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyChecksum403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicyChecksum403JSONResponse) VisitGetPolicyChecksumResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyChecksum429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicyChecksum429JSONResponse) VisitGetPolicyChecksumResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyChecksum500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicyChecksum500JSONResponse) VisitGetPolicyChecksumResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeperRequestObject struct {
+	Body io.Reader
+}
+
+type ConvertGatekeeperResponseObject interface {
+	VisitConvertGatekeeperResponse(w http.ResponseWriter) error
+}
+
+type ConvertGatekeeper200JSONResponse GatekeeperConversionResult
+
+func (response ConvertGatekeeper200JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeper400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConvertGatekeeper400JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeper401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ConvertGatekeeper401JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeper403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ConvertGatekeeper403JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeper429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ConvertGatekeeper429JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ConvertGatekeeper500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ConvertGatekeeper500JSONResponse) VisitConvertGatekeeperResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrderRequestObject struct {
+	Params GetEvaluationOrderParams
+}
+
+type GetEvaluationOrderResponseObject interface {
+	VisitGetEvaluationOrderResponse(w http.ResponseWriter) error
+}
+
+type GetEvaluationOrder200JSONResponse EvaluationOrder
+
+func (response GetEvaluationOrder200JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrder400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetEvaluationOrder400JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrder401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEvaluationOrder401JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrder403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEvaluationOrder403JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrder429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetEvaluationOrder429JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetEvaluationOrder500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetEvaluationOrder500JSONResponse) VisitGetEvaluationOrderResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPolicyBundleRequestObject struct {
+	Params ExportPolicyBundleParams
+}
+
+type ExportPolicyBundleResponseObject interface {
+	VisitExportPolicyBundleResponse(w http.ResponseWriter) error
+}
+
+type ExportPolicyBundle200ApplicationgzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportPolicyBundle200ApplicationgzipResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportPolicyBundle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportPolicyBundle400JSONResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPolicyBundle401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ExportPolicyBundle401JSONResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPolicyBundle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ExportPolicyBundle403JSONResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPolicyBundle429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ExportPolicyBundle429JSONResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ExportPolicyBundle500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ExportPolicyBundle500JSONResponse) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyFacetsRequestObject struct {
+}
+
+type GetPolicyFacetsResponseObject interface {
+	VisitGetPolicyFacetsResponse(w http.ResponseWriter) error
+}
+
+type GetPolicyFacets200JSONResponse PolicyFacets
+
+func (response GetPolicyFacets200JSONResponse) VisitGetPolicyFacetsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyFacets401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPolicyFacets401JSONResponse) VisitGetPolicyFacetsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyFacets403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPolicyFacets403JSONResponse) VisitGetPolicyFacetsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyFacets429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response GetPolicyFacets429JSONResponse) VisitGetPolicyFacetsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPolicyFacets500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetPolicyFacets500JSONResponse) VisitGetPolicyFacetsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPoliciesRequestObject struct {
+	Params        ImportPoliciesParams
+	JSONBody      *ImportPoliciesJSONRequestBody
+	MultipartBody *multipart.Reader
+}
+
+type ImportPoliciesResponseObject interface {
+	VisitImportPoliciesResponse(w http.ResponseWriter) error
+}
+
+type ImportPolicies200JSONResponse PolicyImportResult
+
+func (response ImportPolicies200JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportPolicies400JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportPolicies401JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicies403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportPolicies403JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicies429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ImportPolicies429JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ImportPolicies500JSONResponse) VisitImportPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundleRequestObject struct {
+	Params ImportPolicyBundleParams
+	Body   io.Reader
+}
+
+type ImportPolicyBundleResponseObject interface {
+	VisitImportPolicyBundleResponse(w http.ResponseWriter) error
+}
+
+type ImportPolicyBundle200JSONResponse BundleImportResult
+
+func (response ImportPolicyBundle200JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportPolicyBundle400JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundle401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ImportPolicyBundle401JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundle403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ImportPolicyBundle403JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundle429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response ImportPolicyBundle429JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type ImportPolicyBundle500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ImportPolicyBundle500JSONResponse) VisitImportPolicyBundleResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicyRequestObject struct {
+	Body *SimulatePolicyJSONRequestBody
+}
+
+type SimulatePolicyResponseObject interface {
+	VisitSimulatePolicyResponse(w http.ResponseWriter) error
+}
+
+type SimulatePolicy200JSONResponse PolicySimulation
+
+func (response SimulatePolicy200JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SimulatePolicy400JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SimulatePolicy401JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SimulatePolicy403JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response SimulatePolicy409JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response SimulatePolicy429JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type SimulatePolicy500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SimulatePolicy500JSONResponse) VisitSimulatePolicyResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type WatchPoliciesRequestObject struct {
+	Params WatchPoliciesParams
+}
+
+type WatchPoliciesResponseObject interface {
+	VisitWatchPoliciesResponse(w http.ResponseWriter) error
+}
+
+type WatchPolicies200ResponseHeaders struct {
+	CacheControl *string
+}
+
+type WatchPolicies200TexteventStreamResponse struct {
+	Body          io.Reader
+	Headers       WatchPolicies200ResponseHeaders
+	ContentLength int64
+}
+
+func (response WatchPolicies200TexteventStreamResponse) VisitWatchPoliciesResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.CacheControl != nil {
+		w.Header().Set("Cache-Control", fmt.Sprint(*response.Headers.CacheControl))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, fall back to io.Copy.
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+	// text/event-stream messages are typically small; use a
+	// modest buffer and flush after each chunk so clients see
+	// events immediately instead of waiting on OS buffering.
+	buf := make([]byte, 4096)
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+				return writeErr
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+type WatchPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response WatchPolicies401JSONResponse) VisitWatchPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type WatchPolicies403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response WatchPolicies403JSONResponse) VisitWatchPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type WatchPolicies429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response WatchPolicies429JSONResponse) VisitWatchPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.RetryAfter != nil {
+		w.Header().Set("Retry-After", fmt.Sprint(*response.Headers.RetryAfter))
+	}
+	w.WriteHeader(429)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type WatchPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response WatchPolicies500JSONResponse) VisitWatchPoliciesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetReadinessRequestObject struct {
+}
+
+type GetReadinessResponseObject interface {
+	VisitGetReadinessResponse(w http.ResponseWriter) error
+}
+
+type GetReadiness200JSONResponse Readiness
+
+func (response GetReadiness200JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetReadiness503JSONResponse Readiness
+
+func (response GetReadiness503JSONResponse) VisitGetReadinessResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List audit log entries
+	// (GET /admin/audit)
+	ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error)
+	// Remove a data subject's details from the audit log
+	// (POST /admin/audit:scrub)
+	ScrubAuditEntries(ctx context.Context, request ScrubAuditEntriesRequestObject) (ScrubAuditEntriesResponseObject, error)
+	// Verify the audit log hash chain
+	// (GET /admin/audit:verify)
+	VerifyAuditLog(ctx context.Context, request VerifyAuditLogRequestObject) (VerifyAuditLogResponseObject, error)
+	// Build information
+	// (GET /admin/buildinfo)
+	GetBuildInfo(ctx context.Context, request GetBuildInfoRequestObject) (GetBuildInfoResponseObject, error)
+	// Reload the runtime-tunable configuration
+	// (POST /admin/config/reload)
+	ReloadConfig(ctx context.Context, request ReloadConfigRequestObject) (ReloadConfigResponseObject, error)
+	// List engine API keys
+	// (GET /admin/engine-api-keys)
+	ListEngineApiKeys(ctx context.Context, request ListEngineApiKeysRequestObject) (ListEngineApiKeysResponseObject, error)
+	// Create an engine API key
+	// (POST /admin/engine-api-keys)
+	CreateEngineApiKey(ctx context.Context, request CreateEngineApiKeyRequestObject) (CreateEngineApiKeyResponseObject, error)
+	// Revoke an engine API key
+	// (DELETE /admin/engine-api-keys/{keyId})
+	DeleteEngineApiKey(ctx context.Context, request DeleteEngineApiKeyRequestObject) (DeleteEngineApiKeyResponseObject, error)
+	// Rebuild the OPA state from the stored policies
+	// (POST /admin/opa/rebuild)
+	RebuildEngine(ctx context.Context, request RebuildEngineRequestObject) (RebuildEngineResponseObject, error)
+	// Get the progress of an OPA rebuild
+	// (GET /admin/opa/rebuild/{rebuildId})
+	GetEngineRebuild(ctx context.Context, request GetEngineRebuildRequestObject) (GetEngineRebuildResponseObject, error)
+	// Health check
+	// (GET /health)
+	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Liveness check
+	// (GET /livez)
+	GetLiveness(ctx context.Context, request GetLivenessRequestObject) (GetLivenessResponseObject, error)
+	// List policies
+	// (GET /policies)
+	ListPolicies(ctx context.Context, request ListPoliciesRequestObject) (ListPoliciesResponseObject, error)
+	// Create a new policy
+	// (POST /policies)
+	CreatePolicy(ctx context.Context, request CreatePolicyRequestObject) (CreatePolicyResponseObject, error)
+	// Delete a policy
+	// (DELETE /policies/{policyId})
+	DeletePolicy(ctx context.Context, request DeletePolicyRequestObject) (DeletePolicyResponseObject, error)
+	// Get a policy
+	// (GET /policies/{policyId})
+	GetPolicy(ctx context.Context, request GetPolicyRequestObject) (GetPolicyResponseObject, error)
+	// Update a policy
+	// (PATCH /policies/{policyId})
+	UpdatePolicy(ctx context.Context, request UpdatePolicyRequestObject) (UpdatePolicyResponseObject, error)
+	// Apply a policy
+	// (PUT /policies/{policyId})
+	ApplyPolicy(ctx context.Context, request ApplyPolicyRequestObject) (ApplyPolicyResponseObject, error)
+	// List a policy's revisions
+	// (GET /policies/{policyId}/revisions)
+	ListPolicyRevisions(ctx context.Context, request ListPolicyRevisionsRequestObject) (ListPolicyRevisionsResponseObject, error)
+	// Get a policy revision
+	// (GET /policies/{policyId}/revisions/{revision})
+	GetPolicyRevision(ctx context.Context, request GetPolicyRevisionRequestObject) (GetPolicyRevisionResponseObject, error)
+	// List a policy's test cases
+	// (GET /policies/{policyId}/tests)
+	ListPolicyTests(ctx context.Context, request ListPolicyTestsRequestObject) (ListPolicyTestsResponseObject, error)
+	// Add a test case to a policy
+	// (POST /policies/{policyId}/tests)
+	CreatePolicyTest(ctx context.Context, request CreatePolicyTestRequestObject) (CreatePolicyTestResponseObject, error)
+	// Delete a policy test case
+	// (DELETE /policies/{policyId}/tests/{testId})
+	DeletePolicyTest(ctx context.Context, request DeletePolicyTestRequestObject) (DeletePolicyTestResponseObject, error)
+	// Get a policy test case
+	// (GET /policies/{policyId}/tests/{testId})
+	GetPolicyTest(ctx context.Context, request GetPolicyTestRequestObject) (GetPolicyTestResponseObject, error)
+	// Run a policy's test cases
+	// (POST /policies/{policyId}/tests:run)
+	RunPolicyTests(ctx context.Context, request RunPolicyTestsRequestObject) (RunPolicyTestsResponseObject, error)
+	// Diff two policy revisions
+	// (GET /policies/{policyId}:diff)
+	DiffPolicyRevisions(ctx context.Context, request DiffPolicyRevisionsRequestObject) (DiffPolicyRevisionsResponseObject, error)
+	// Lock a policy for editing
+	// (POST /policies/{policyId}:lock)
+	LockPolicy(ctx context.Context, request LockPolicyRequestObject) (LockPolicyResponseObject, error)
+	// Test whether a policy's label selector matches a request
+	// (POST /policies/{policyId}:matchTest)
+	TestPolicyMatch(ctx context.Context, request TestPolicyMatchRequestObject) (TestPolicyMatchResponseObject, error)
+	// Roll a policy back to a prior revision
+	// (POST /policies/{policyId}:rollback)
+	RollbackPolicy(ctx context.Context, request RollbackPolicyRequestObject) (RollbackPolicyResponseObject, error)
+	// Unlock a policy
+	// (POST /policies/{policyId}:unlock)
+	UnlockPolicy(ctx context.Context, request UnlockPolicyRequestObject) (UnlockPolicyResponseObject, error)
+	// Generate a catalog of the active policies
+	// (GET /policies:catalog)
+	GetPolicyCatalog(ctx context.Context, request GetPolicyCatalogRequestObject) (GetPolicyCatalogResponseObject, error)
+	// Check a candidate policy for conflicts with the stored policies
+	// (POST /policies:checkConflicts)
+	CheckPolicyConflicts(ctx context.Context, request CheckPolicyConflictsRequestObject) (CheckPolicyConflictsResponseObject, error)
+	// Get a checksum of the policy set
+	// (GET /policies:checksum)
+	GetPolicyChecksum(ctx context.Context, request GetPolicyChecksumRequestObject) (GetPolicyChecksumResponseObject, error)
+	// Convert Gatekeeper ConstraintTemplates and Constraints into policies
+	// (POST /policies:convertGatekeeper)
+	ConvertGatekeeper(ctx context.Context, request ConvertGatekeeperRequestObject) (ConvertGatekeeperResponseObject, error)
+	// List the policies a request would be evaluated against, in order
+	// (GET /policies:evaluationOrder)
+	GetEvaluationOrder(ctx context.Context, request GetEvaluationOrderRequestObject) (GetEvaluationOrderResponseObject, error)
+	// Export policies as an OPA bundle
+	// (GET /policies:exportBundle)
+	ExportPolicyBundle(ctx context.Context, request ExportPolicyBundleRequestObject) (ExportPolicyBundleResponseObject, error)
+	// List distinct policy field values for filtering
+	// (GET /policies:facets)
+	GetPolicyFacets(ctx context.Context, request GetPolicyFacetsRequestObject) (GetPolicyFacetsResponseObject, error)
+	// Import several policies in one request
+	// (POST /policies:import)
+	ImportPolicies(ctx context.Context, request ImportPoliciesRequestObject) (ImportPoliciesResponseObject, error)
+	// Import policies from an OPA bundle or ConfigMap dump
+	// (POST /policies:importBundle)
+	ImportPolicyBundle(ctx context.Context, request ImportPolicyBundleRequestObject) (ImportPolicyBundleResponseObject, error)
+	// Simulate a draft policy against the live policy set
+	// (POST /policies:simulate)
+	SimulatePolicy(ctx context.Context, request SimulatePolicyRequestObject) (SimulatePolicyResponseObject, error)
+	// Watch policy changes
+	// (GET /policies:watch)
+	WatchPolicies(ctx context.Context, request WatchPoliciesRequestObject) (WatchPoliciesResponseObject, error)
+	// Readiness check
+	// (GET /readyz)
+	GetReadiness(ctx context.Context, request GetReadinessRequestObject) (GetReadinessResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAuditEntries operation middleware
+func (sh *strictHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	var request ListAuditEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEntries(ctx, request.(ListAuditEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEntries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitListAuditEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ScrubAuditEntries operation middleware
+func (sh *strictHandler) ScrubAuditEntries(w http.ResponseWriter, r *http.Request) {
+	var request ScrubAuditEntriesRequestObject
+
+	var body ScrubAuditEntriesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ScrubAuditEntries(ctx, request.(ScrubAuditEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScrubAuditEntries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ScrubAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitScrubAuditEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyAuditLog operation middleware
+func (sh *strictHandler) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
+	var request VerifyAuditLogRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyAuditLog(ctx, request.(VerifyAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyAuditLog")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyAuditLogResponseObject); ok {
+		if err := validResponse.VisitVerifyAuditLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBuildInfo operation middleware
+func (sh *strictHandler) GetBuildInfo(w http.ResponseWriter, r *http.Request) {
+	var request GetBuildInfoRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBuildInfo(ctx, request.(GetBuildInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBuildInfo")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBuildInfoResponseObject); ok {
+		if err := validResponse.VisitGetBuildInfoResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReloadConfig operation middleware
+func (sh *strictHandler) ReloadConfig(w http.ResponseWriter, r *http.Request) {
+	var request ReloadConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReloadConfig(ctx, request.(ReloadConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReloadConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReloadConfigResponseObject); ok {
+		if err := validResponse.VisitReloadConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEngineApiKeys operation middleware
+func (sh *strictHandler) ListEngineApiKeys(w http.ResponseWriter, r *http.Request, params ListEngineApiKeysParams) {
+	var request ListEngineApiKeysRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEngineApiKeys(ctx, request.(ListEngineApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEngineApiKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEngineApiKeysResponseObject); ok {
+		if err := validResponse.VisitListEngineApiKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateEngineApiKey operation middleware
+func (sh *strictHandler) CreateEngineApiKey(w http.ResponseWriter, r *http.Request, params CreateEngineApiKeyParams) {
+	var request CreateEngineApiKeyRequestObject
+
+	request.Params = params
+
+	var body CreateEngineApiKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateEngineApiKey(ctx, request.(CreateEngineApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateEngineApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateEngineApiKeyResponseObject); ok {
+		if err := validResponse.VisitCreateEngineApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteEngineApiKey operation middleware
+func (sh *strictHandler) DeleteEngineApiKey(w http.ResponseWriter, r *http.Request, keyId string) {
+	var request DeleteEngineApiKeyRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteEngineApiKey(ctx, request.(DeleteEngineApiKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteEngineApiKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteEngineApiKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteEngineApiKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RebuildEngine operation middleware
+func (sh *strictHandler) RebuildEngine(w http.ResponseWriter, r *http.Request) {
+	var request RebuildEngineRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RebuildEngine(ctx, request.(RebuildEngineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RebuildEngine")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RebuildEngineResponseObject); ok {
+		if err := validResponse.VisitRebuildEngineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetEngineRebuild operation middleware
+func (sh *strictHandler) GetEngineRebuild(w http.ResponseWriter, r *http.Request, rebuildId string) {
+	var request GetEngineRebuildRequestObject
+
+	request.RebuildId = rebuildId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEngineRebuild(ctx, request.(GetEngineRebuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEngineRebuild")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEngineRebuildResponseObject); ok {
+		if err := validResponse.VisitGetEngineRebuildResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLiveness operation middleware
+func (sh *strictHandler) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	var request GetLivenessRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLiveness(ctx, request.(GetLivenessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLiveness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLivenessResponseObject); ok {
+		if err := validResponse.VisitGetLivenessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPolicies operation middleware
+func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
+	var request ListPoliciesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPolicies(ctx, request.(ListPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPoliciesResponseObject); ok {
+		if err := validResponse.VisitListPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreatePolicy operation middleware
+func (sh *strictHandler) CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams) {
+	var request CreatePolicyRequestObject
+
+	request.Params = params
+
+	var body CreatePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePolicy(ctx, request.(CreatePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePolicyResponseObject); ok {
+		if err := validResponse.VisitCreatePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePolicy operation middleware
+func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DeletePolicyParams) {
+	var request DeletePolicyRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePolicy(ctx, request.(DeletePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePolicyResponseObject); ok {
+		if err := validResponse.VisitDeletePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPolicy operation middleware
+func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params GetPolicyParams) {
+	var request GetPolicyRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPolicy(ctx, request.(GetPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPolicyResponseObject); ok {
+		if err := validResponse.VisitGetPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdatePolicy operation middleware
+func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params UpdatePolicyParams) {
+	var request UpdatePolicyRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	var body UpdatePolicyApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePolicy(ctx, request.(UpdatePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePolicyResponseObject); ok {
+		if err := validResponse.VisitUpdatePolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyPolicy operation middleware
+func (sh *strictHandler) ApplyPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ApplyPolicyParams) {
+	var request ApplyPolicyRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	var body ApplyPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyPolicy(ctx, request.(ApplyPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyPolicyResponseObject); ok {
+		if err := validResponse.VisitApplyPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeletePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+// ListPolicyRevisions operation middleware
+func (sh *strictHandler) ListPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyRevisionsParams) {
+	var request ListPolicyRevisionsRequestObject
 
-func (response DeletePolicy403JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.PolicyId = policyId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPolicyRevisions(ctx, request.(ListPolicyRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPolicyRevisions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPolicyRevisionsResponseObject); ok {
+		if err := validResponse.VisitListPolicyRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeletePolicy404JSONResponse struct{ NotFoundJSONResponse }
+// GetPolicyRevision operation middleware
+func (sh *strictHandler) GetPolicyRevision(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, revision int64) {
+	var request GetPolicyRevisionRequestObject
 
-func (response DeletePolicy404JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.PolicyId = policyId
+	request.Revision = revision
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPolicyRevision(ctx, request.(GetPolicyRevisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPolicyRevision")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPolicyRevisionResponseObject); ok {
+		if err := validResponse.VisitGetPolicyRevisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type DeletePolicy500JSONResponse struct {
-	InternalServerErrorJSONResponse
+// ListPolicyTests operation middleware
+func (sh *strictHandler) ListPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params ListPolicyTestsParams) {
+	var request ListPolicyTestsRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPolicyTests(ctx, request.(ListPolicyTestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPolicyTests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPolicyTestsResponseObject); ok {
+		if err := validResponse.VisitListPolicyTestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response DeletePolicy500JSONResponse) VisitDeletePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreatePolicyTest operation middleware
+func (sh *strictHandler) CreatePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params CreatePolicyTestParams) {
+	var request CreatePolicyTestRequestObject
+
+	request.PolicyId = policyId
+	request.Params = params
+
+	var body CreatePolicyTestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePolicyTest(ctx, request.(CreatePolicyTestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePolicyTest")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePolicyTestResponseObject); ok {
+		if err := validResponse.VisitCreatePolicyTestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicyRequestObject struct {
-	PolicyId PolicyIdPath `json:"policyId"`
+// DeletePolicyTest operation middleware
+func (sh *strictHandler) DeletePolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath) {
+	var request DeletePolicyTestRequestObject
+
+	request.PolicyId = policyId
+	request.TestId = testId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePolicyTest(ctx, request.(DeletePolicyTestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePolicyTest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePolicyTestResponseObject); ok {
+		if err := validResponse.VisitDeletePolicyTestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicyResponseObject interface {
-	VisitGetPolicyResponse(w http.ResponseWriter) error
+// GetPolicyTest operation middleware
+func (sh *strictHandler) GetPolicyTest(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, testId TestIdPath) {
+	var request GetPolicyTestRequestObject
+
+	request.PolicyId = policyId
+	request.TestId = testId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPolicyTest(ctx, request.(GetPolicyTestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPolicyTest")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPolicyTestResponseObject); ok {
+		if err := validResponse.VisitGetPolicyTestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicy200JSONResponse Policy
+// RunPolicyTests operation middleware
+func (sh *strictHandler) RunPolicyTests(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	var request RunPolicyTestsRequestObject
 
-func (response GetPolicy200JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.PolicyId = policyId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunPolicyTests(ctx, request.(RunPolicyTestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunPolicyTests")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunPolicyTestsResponseObject); ok {
+		if err := validResponse.VisitRunPolicyTestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+// DiffPolicyRevisions operation middleware
+func (sh *strictHandler) DiffPolicyRevisions(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath, params DiffPolicyRevisionsParams) {
+	var request DiffPolicyRevisionsRequestObject
 
-func (response GetPolicy401JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.PolicyId = policyId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffPolicyRevisions(ctx, request.(DiffPolicyRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffPolicyRevisions")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffPolicyRevisionsResponseObject); ok {
+		if err := validResponse.VisitDiffPolicyRevisionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicy403JSONResponse struct{ ForbiddenJSONResponse }
+// LockPolicy operation middleware
+func (sh *strictHandler) LockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	var request LockPolicyRequestObject
 
-func (response GetPolicy403JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.PolicyId = policyId
+
+	var body LockPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LockPolicy(ctx, request.(LockPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LockPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LockPolicyResponseObject); ok {
+		if err := validResponse.VisitLockPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicy404JSONResponse struct{ NotFoundJSONResponse }
+// TestPolicyMatch operation middleware
+func (sh *strictHandler) TestPolicyMatch(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	var request TestPolicyMatchRequestObject
 
-func (response GetPolicy404JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.PolicyId = policyId
+
+	var body TestPolicyMatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestPolicyMatch(ctx, request.(TestPolicyMatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestPolicyMatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestPolicyMatchResponseObject); ok {
+		if err := validResponse.VisitTestPolicyMatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackPolicy operation middleware
+func (sh *strictHandler) RollbackPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	var request RollbackPolicyRequestObject
+
+	request.PolicyId = policyId
+
+	var body RollbackPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackPolicy(ctx, request.(RollbackPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackPolicy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackPolicyResponseObject); ok {
+		if err := validResponse.VisitRollbackPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPolicy500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// UnlockPolicy operation middleware
+func (sh *strictHandler) UnlockPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
+	var request UnlockPolicyRequestObject
+
+	request.PolicyId = policyId
+
+	var body UnlockPolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UnlockPolicy(ctx, request.(UnlockPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UnlockPolicy")
+	}
 
-func (response GetPolicy500JSONResponse) VisitGetPolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UnlockPolicyResponseObject); ok {
+		if err := validResponse.VisitUnlockPolicyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePolicyRequestObject struct {
-	PolicyId PolicyIdPath `json:"policyId"`
-	Body     *UpdatePolicyApplicationMergePatchPlusJSONRequestBody
-}
+// GetPolicyCatalog operation middleware
+func (sh *strictHandler) GetPolicyCatalog(w http.ResponseWriter, r *http.Request, params GetPolicyCatalogParams) {
+	var request GetPolicyCatalogRequestObject
 
-type UpdatePolicyResponseObject interface {
-	VisitUpdatePolicyResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type UpdatePolicy200JSONResponse Policy
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPolicyCatalog(ctx, request.(GetPolicyCatalogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPolicyCatalog")
+	}
 
-func (response UpdatePolicy200JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPolicyCatalogResponseObject); ok {
+		if err := validResponse.VisitGetPolicyCatalogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePolicy400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response UpdatePolicy400JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CheckPolicyConflicts operation middleware
+func (sh *strictHandler) CheckPolicyConflicts(w http.ResponseWriter, r *http.Request) {
+	var request CheckPolicyConflictsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CheckPolicyConflictsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdatePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckPolicyConflicts(ctx, request.(CheckPolicyConflictsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckPolicyConflicts")
+	}
 
-func (response UpdatePolicy401JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CheckPolicyConflictsResponseObject); ok {
+		if err := validResponse.VisitCheckPolicyConflictsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+// GetPolicyChecksum operation middleware
+func (sh *strictHandler) GetPolicyChecksum(w http.ResponseWriter, r *http.Request) {
+	var request GetPolicyChecksumRequestObject
 
-func (response UpdatePolicy403JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPolicyChecksum(ctx, request.(GetPolicyChecksumRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPolicyChecksum")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdatePolicy404JSONResponse struct{ NotFoundJSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPolicyChecksumResponseObject); ok {
+		if err := validResponse.VisitGetPolicyChecksumResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response UpdatePolicy404JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ConvertGatekeeper operation middleware
+func (sh *strictHandler) ConvertGatekeeper(w http.ResponseWriter, r *http.Request) {
+	var request ConvertGatekeeperRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type UpdatePolicy409JSONResponse struct{ AlreadyExistsJSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConvertGatekeeper(ctx, request.(ConvertGatekeeperRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConvertGatekeeper")
+	}
 
-func (response UpdatePolicy409JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConvertGatekeeperResponseObject); ok {
+		if err := validResponse.VisitConvertGatekeeperResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePolicy500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// GetEvaluationOrder operation middleware
+func (sh *strictHandler) GetEvaluationOrder(w http.ResponseWriter, r *http.Request, params GetEvaluationOrderParams) {
+	var request GetEvaluationOrderRequestObject
 
-func (response UpdatePolicy500JSONResponse) VisitUpdatePolicyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvaluationOrder(ctx, request.(GetEvaluationOrderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvaluationOrder")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Health check
-	// (GET /health)
-	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
-	// List policies
-	// (GET /policies)
-	ListPolicies(ctx context.Context, request ListPoliciesRequestObject) (ListPoliciesResponseObject, error)
-	// Create a new policy
-	// (POST /policies)
-	CreatePolicy(ctx context.Context, request CreatePolicyRequestObject) (CreatePolicyResponseObject, error)
-	// Delete a policy
-	// (DELETE /policies/{policyId})
-	DeletePolicy(ctx context.Context, request DeletePolicyRequestObject) (DeletePolicyResponseObject, error)
-	// Get a policy
-	// (GET /policies/{policyId})
-	GetPolicy(ctx context.Context, request GetPolicyRequestObject) (GetPolicyResponseObject, error)
-	// Update a policy
-	// (PATCH /policies/{policyId})
-	UpdatePolicy(ctx context.Context, request UpdatePolicyRequestObject) (UpdatePolicyResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetEvaluationOrderResponseObject); ok {
+		if err := validResponse.VisitGetEvaluationOrderResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// ExportPolicyBundle operation middleware
+func (sh *strictHandler) ExportPolicyBundle(w http.ResponseWriter, r *http.Request, params ExportPolicyBundleParams) {
+	var request ExportPolicyBundleRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportPolicyBundle(ctx, request.(ExportPolicyBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportPolicyBundle")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportPolicyBundleResponseObject); ok {
+		if err := validResponse.VisitExportPolicyBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// GetPolicyFacets operation middleware
+func (sh *strictHandler) GetPolicyFacets(w http.ResponseWriter, r *http.Request) {
+	var request GetPolicyFacetsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetPolicyFacets(ctx, request.(GetPolicyFacetsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetPolicyFacets")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPolicyFacetsResponseObject); ok {
+		if err := validResponse.VisitGetPolicyFacetsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1075,25 +9524,43 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListPolicies operation middleware
-func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, params ListPoliciesParams) {
-	var request ListPoliciesRequestObject
+// ImportPolicies operation middleware
+func (sh *strictHandler) ImportPolicies(w http.ResponseWriter, r *http.Request, params ImportPoliciesParams) {
+	var request ImportPoliciesRequestObject
 
 	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body ImportPoliciesJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if reader, err := r.MultipartReader(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+			return
+		} else {
+			request.MultipartBody = reader
+		}
+	}
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPolicies(ctx, request.(ListPoliciesRequestObject))
+		return sh.ssi.ImportPolicies(ctx, request.(ImportPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPolicies")
+		handler = middleware(handler, "ImportPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPoliciesResponseObject); ok {
-		if err := validResponse.VisitListPoliciesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportPoliciesResponseObject); ok {
+		if err := validResponse.VisitImportPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1101,32 +9568,27 @@ func (sh *strictHandler) ListPolicies(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreatePolicy operation middleware
-func (sh *strictHandler) CreatePolicy(w http.ResponseWriter, r *http.Request, params CreatePolicyParams) {
-	var request CreatePolicyRequestObject
+// ImportPolicyBundle operation middleware
+func (sh *strictHandler) ImportPolicyBundle(w http.ResponseWriter, r *http.Request, params ImportPolicyBundleParams) {
+	var request ImportPolicyBundleRequestObject
 
 	request.Params = params
 
-	var body CreatePolicyJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Body = r.Body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePolicy(ctx, request.(CreatePolicyRequestObject))
+		return sh.ssi.ImportPolicyBundle(ctx, request.(ImportPolicyBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePolicy")
+		handler = middleware(handler, "ImportPolicyBundle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePolicyResponseObject); ok {
-		if err := validResponse.VisitCreatePolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportPolicyBundleResponseObject); ok {
+		if err := validResponse.VisitImportPolicyBundleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1134,25 +9596,30 @@ func (sh *strictHandler) CreatePolicy(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// DeletePolicy operation middleware
-func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	var request DeletePolicyRequestObject
+// SimulatePolicy operation middleware
+func (sh *strictHandler) SimulatePolicy(w http.ResponseWriter, r *http.Request) {
+	var request SimulatePolicyRequestObject
 
-	request.PolicyId = policyId
+	var body SimulatePolicyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePolicy(ctx, request.(DeletePolicyRequestObject))
+		return sh.ssi.SimulatePolicy(ctx, request.(SimulatePolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePolicy")
+		handler = middleware(handler, "SimulatePolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePolicyResponseObject); ok {
-		if err := validResponse.VisitDeletePolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(SimulatePolicyResponseObject); ok {
+		if err := validResponse.VisitSimulatePolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1160,25 +9627,25 @@ func (sh *strictHandler) DeletePolicy(w http.ResponseWriter, r *http.Request, po
 	}
 }
 
-// GetPolicy operation middleware
-func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	var request GetPolicyRequestObject
+// WatchPolicies operation middleware
+func (sh *strictHandler) WatchPolicies(w http.ResponseWriter, r *http.Request, params WatchPoliciesParams) {
+	var request WatchPoliciesRequestObject
 
-	request.PolicyId = policyId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPolicy(ctx, request.(GetPolicyRequestObject))
+		return sh.ssi.WatchPolicies(ctx, request.(WatchPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPolicy")
+		handler = middleware(handler, "WatchPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPolicyResponseObject); ok {
-		if err := validResponse.VisitGetPolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(WatchPoliciesResponseObject); ok {
+		if err := validResponse.VisitWatchPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1186,32 +9653,23 @@ func (sh *strictHandler) GetPolicy(w http.ResponseWriter, r *http.Request, polic
 	}
 }
 
-// UpdatePolicy operation middleware
-func (sh *strictHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request, policyId PolicyIdPath) {
-	var request UpdatePolicyRequestObject
-
-	request.PolicyId = policyId
-
-	var body UpdatePolicyApplicationMergePatchPlusJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetReadiness operation middleware
+func (sh *strictHandler) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	var request GetReadinessRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePolicy(ctx, request.(UpdatePolicyRequestObject))
+		return sh.ssi.GetReadiness(ctx, request.(GetReadinessRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePolicy")
+		handler = middleware(handler, "GetReadiness")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePolicyResponseObject); ok {
-		if err := validResponse.VisitUpdatePolicyResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReadinessResponseObject); ok {
+		if err := validResponse.VisitGetReadinessResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
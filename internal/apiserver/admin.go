@@ -0,0 +1,49 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+// adminRoutes matches the routes of the admin API under a base URL: every path under /admin
+// but the build information, which is no more sensitive than the health checks
+type adminRoutes struct {
+	prefix    string
+	buildInfo string
+}
+
+func newAdminRoutes(baseURL string) adminRoutes {
+	return adminRoutes{prefix: baseURL + "/admin/", buildInfo: baseURL + "/admin/buildinfo"}
+}
+
+func (a adminRoutes) match(path string) bool {
+	return strings.HasPrefix(path, a.prefix) && path != a.buildInfo
+}
+
+// refuseAdmin returns a middleware refusing every request for the admin API, which mints
+// engine API keys and rewrites the audit log: without authenticated callers, no caller can be
+// told apart as an administrator
+func refuseAdmin(admin adminRoutes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !admin.match(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			logging.FromContext(r.Context()).Warn("Admin API request refused without authentication", "path", r.URL.Path)
+			detail := "the admin API requires API_AUTH_MODE=jwt and API_AUTH_ADMIN_ROLE"
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(v1alpha1.Error{
+				Status: http.StatusForbidden,
+				Type:   v1alpha1.PERMISSIONDENIED,
+				Title:  "Permission denied",
+				Detail: &detail,
+			})
+		})
+	}
+}
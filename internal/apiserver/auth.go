@@ -0,0 +1,219 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/lestrrat-go/httprc/v3"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+)
+
+// Authentication modes of the public API
+const (
+	// AuthModeNone serves every request without authentication
+	AuthModeNone = "none"
+	// AuthModeJWT requires a bearer token issued by the configured issuer
+	AuthModeJWT = "jwt"
+)
+
+// jwksWaitTimeout is how long a request waits for the key set to be fetched while no fetch
+// has succeeded yet
+const jwksWaitTimeout = 5 * time.Second
+
+var authenticationFailuresTotal = metrics.NewCounterVec(
+	"policy_manager_api_authentication_failures_total",
+	"Public API requests refused by authentication, by reason: missing_token, invalid_token, keys_unavailable or not_admin",
+	"reason",
+)
+
+// authError is a refused authentication, with the reason it is counted under
+type authError struct {
+	reason string
+	status int
+	err    error
+}
+
+// Authenticator validates the bearer tokens of public API requests against the issuer,
+// audience and key set of its configuration
+type Authenticator struct {
+	cfg config.APIAuthConfig
+
+	// keys returns the key set verifying token signatures; start sets it
+	keys func(ctx context.Context) (jwk.Set, error)
+}
+
+// NewAuthenticator creates the Authenticator selected by the configuration, or nil when
+// public API callers are not authenticated
+func NewAuthenticator(cfg config.APIAuthConfig) (*Authenticator, error) {
+	switch cfg.Mode {
+	case "", AuthModeNone:
+		return nil, nil
+	case AuthModeJWT:
+		if cfg.Issuer == "" || cfg.Audience == "" || cfg.JWKSURL == "" {
+			return nil, fmt.Errorf("public API authentication mode '%s' requires API_AUTH_ISSUER, API_AUTH_AUDIENCE and API_AUTH_JWKS_URL", AuthModeJWT)
+		}
+		if u, err := url.Parse(cfg.JWKSURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("API_AUTH_JWKS_URL must be an http or https URL (got '%s')", cfg.JWKSURL)
+		}
+		if cfg.JWKSRefreshInterval <= 0 {
+			return nil, fmt.Errorf("API_AUTH_JWKS_REFRESH_INTERVAL must be positive (got %s)", cfg.JWKSRefreshInterval)
+		}
+		if cfg.PrincipalClaim == "" {
+			return nil, errors.New("API_AUTH_PRINCIPAL_CLAIM must not be empty")
+		}
+		if cfg.AdminRole != "" && cfg.RolesClaim == "" {
+			return nil, errors.New("API_AUTH_ROLES_CLAIM must not be empty when API_AUTH_ADMIN_ROLE is set")
+		}
+		return &Authenticator{cfg: cfg}, nil
+	}
+	return nil, fmt.Errorf("public API authentication mode must be one of: %s, %s (got '%s')", AuthModeNone, AuthModeJWT, cfg.Mode)
+}
+
+// start fetches the key set in the background, and again every refresh interval, until
+// ctx is done. A fetch that fails is retried by the next request needing the keys.
+func (a *Authenticator) start(ctx context.Context) error {
+	cache, err := jwk.NewCache(ctx, httprc.NewClient())
+	if err != nil {
+		return fmt.Errorf("failed to start the JWKS cache: %w", err)
+	}
+	if err := cache.Register(ctx, a.cfg.JWKSURL,
+		jwk.WithConstantInterval(a.cfg.JWKSRefreshInterval),
+		jwk.WithWaitReady(false),
+	); err != nil {
+		return fmt.Errorf("failed to register the JWKS URL: %w", err)
+	}
+	resource, err := cache.LookupResource(ctx, a.cfg.JWKSURL)
+	if err != nil {
+		return fmt.Errorf("failed to register the JWKS URL: %w", err)
+	}
+
+	var refresh sync.Mutex
+	a.keys = func(ctx context.Context) (jwk.Set, error) {
+		if keys := resource.Resource(); keys != nil {
+			return keys, nil
+		}
+		// Only one request fetches at a time; the others use the keys it fetched
+		refresh.Lock()
+		defer refresh.Unlock()
+		if keys := resource.Resource(); keys != nil {
+			return keys, nil
+		}
+		ctx, cancel := context.WithTimeout(ctx, jwksWaitTimeout)
+		defer cancel()
+		return cache.Refresh(ctx, a.cfg.JWKSURL)
+	}
+	return nil
+}
+
+// middleware refuses requests to paths other than exemptPaths without a valid bearer token,
+// and requests to admin routes without the admin role, and records the principal of the
+// token in the context of the others
+func (a *Authenticator) middleware(admin adminRoutes, exemptPaths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(exemptPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			principal, token, authErr := a.authenticate(r)
+			if authErr == nil && admin.match(r.URL.Path) {
+				authErr = a.authorizeAdmin(token)
+			}
+			if authErr != nil {
+				refuse(w, r, authErr)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(service.ContextWithPrincipal(r.Context(), principal)))
+		})
+	}
+}
+
+// authenticate returns the principal and the bearer token of r
+func (a *Authenticator) authenticate(r *http.Request) (string, jwt.Token, *authError) {
+	if r.Header.Get("Authorization") == "" {
+		return "", nil, &authError{reason: "missing_token", status: http.StatusUnauthorized, err: errors.New("a bearer token is required")}
+	}
+	keys, err := a.keys(r.Context())
+	if err != nil {
+		return "", nil, &authError{reason: "keys_unavailable", status: http.StatusServiceUnavailable, err: fmt.Errorf("the token signing keys are not available: %w", err)}
+	}
+	token, err := jwt.ParseHeader(r.Header, "Authorization",
+		jwt.WithKeySet(keys, jws.WithInferAlgorithmFromKey(true)),
+		jwt.WithIssuer(a.cfg.Issuer),
+		jwt.WithAudience(a.cfg.Audience),
+		jwt.WithAcceptableSkew(a.cfg.ClockSkew),
+	)
+	if err != nil {
+		return "", nil, &authError{reason: "invalid_token", status: http.StatusUnauthorized, err: fmt.Errorf("invalid bearer token: %w", err)}
+	}
+	var principal string
+	if err := token.Get(a.cfg.PrincipalClaim, &principal); err != nil || principal == "" {
+		return "", nil, &authError{reason: "invalid_token", status: http.StatusUnauthorized, err: fmt.Errorf("the token has no string claim '%s'", a.cfg.PrincipalClaim)}
+	}
+	return principal, token, nil
+}
+
+// authorizeAdmin refuses a token whose roles claim does not include the admin role
+func (a *Authenticator) authorizeAdmin(token jwt.Token) *authError {
+	if a.cfg.AdminRole == "" {
+		return &authError{reason: "not_admin", status: http.StatusForbidden, err: errors.New("the admin API is disabled until API_AUTH_ADMIN_ROLE is set")}
+	}
+	var roles any
+	if err := token.Get(a.cfg.RolesClaim, &roles); err == nil {
+		switch roles := roles.(type) {
+		case string:
+			if roles == a.cfg.AdminRole {
+				return nil
+			}
+		case []any:
+			if slices.Contains(roles, any(a.cfg.AdminRole)) {
+				return nil
+			}
+		}
+	}
+	return &authError{reason: "not_admin", status: http.StatusForbidden, err: fmt.Errorf("the admin API requires the role '%s' in the claim '%s'", a.cfg.AdminRole, a.cfg.RolesClaim)}
+}
+
+// refuse writes the error response of a refused authentication
+func refuse(w http.ResponseWriter, r *http.Request, authErr *authError) {
+	authenticationFailuresTotal.Inc(authErr.reason)
+	logging.FromContext(r.Context()).Warn("Public API request refused", "reason", authErr.reason, "error", authErr.err)
+
+	response := v1alpha1.Error{
+		Status: int32(authErr.status),
+		Type:   v1alpha1.UNAUTHENTICATED,
+		Title:  "Authentication required",
+	}
+	switch authErr.reason {
+	case "keys_unavailable":
+		response.Type = v1alpha1.UNAVAILABLE
+		response.Title = "Authentication unavailable"
+	case "not_admin":
+		response.Type = v1alpha1.PERMISSIONDENIED
+		response.Title = "Permission denied"
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+	case "missing_token":
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	default:
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	}
+	detail := authErr.err.Error()
+	response.Detail = &detail
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(authErr.status)
+	_ = json.NewEncoder(w).Encode(response)
+}
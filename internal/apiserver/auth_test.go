@@ -0,0 +1,260 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/lestrrat-go/jwx/v3/jwa"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jwt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewAuthenticator", func() {
+	valid := config.APIAuthConfig{
+		Mode:                AuthModeJWT,
+		Issuer:              "https://idp.example.com",
+		Audience:            "policy-manager",
+		JWKSURL:             "https://idp.example.com/jwks.json",
+		JWKSRefreshInterval: time.Minute,
+		PrincipalClaim:      "sub",
+	}
+
+	It("returns no authenticator when callers are not authenticated", func() {
+		Expect(NewAuthenticator(config.APIAuthConfig{Mode: AuthModeNone})).To(BeNil())
+		Expect(NewAuthenticator(valid)).NotTo(BeNil())
+	})
+
+	DescribeTable("rejects invalid configurations",
+		func(change func(*config.APIAuthConfig), message string) {
+			cfg := valid
+			change(&cfg)
+			_, err := NewAuthenticator(cfg)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("an unknown mode", func(c *config.APIAuthConfig) { c.Mode = "oidc" }, "none, jwt"),
+		Entry("no issuer", func(c *config.APIAuthConfig) { c.Issuer = "" }, "requires API_AUTH_ISSUER"),
+		Entry("a JWKS URL that is not http", func(c *config.APIAuthConfig) { c.JWKSURL = "file:///jwks.json" }, "http or https URL"),
+		Entry("no refresh interval", func(c *config.APIAuthConfig) { c.JWKSRefreshInterval = 0 }, "must be positive"),
+		Entry("an admin role without a roles claim", func(c *config.APIAuthConfig) { c.AdminRole = "policy-admin" }, "API_AUTH_ROLES_CLAIM"),
+	)
+})
+
+var _ = Describe("refuseAdmin", func() {
+	It("refuses the admin API but the build information", func() {
+		handler := refuseAdmin(newAdminRoutes("/api/v1alpha1"))(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		serve := func(method, path string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(method, path, nil))
+			return recorder
+		}
+
+		for _, path := range []string{
+			"/api/v1alpha1/admin/engine-api-keys",
+			"/api/v1alpha1/admin/audit:scrub",
+			"/api/v1alpha1/admin/config/reload",
+			"/api/v1alpha1/admin/opa/rebuild",
+		} {
+			recorder := serve(http.MethodPost, path)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden), path)
+			Expect(recorder.Body.String()).To(ContainSubstring(`"type":"PERMISSION_DENIED"`))
+		}
+		Expect(serve(http.MethodGet, "/api/v1alpha1/admin/buildinfo").Code).To(Equal(http.StatusOK))
+		Expect(serve(http.MethodGet, "/api/v1alpha1/policies").Code).To(Equal(http.StatusOK))
+	})
+})
+
+var _ = Describe("Authenticator", func() {
+	const (
+		healthPath    = "/api/v1alpha1/health"
+		readinessPath = "/api/v1alpha1/readyz"
+	)
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		signingKey jwk.Key
+		jwks       *httptest.Server
+		handler    http.Handler
+		principal  string
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		signingKey, err = jwk.Import(rsaKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signingKey.Set(jwk.KeyIDKey, "key-1")).To(Succeed())
+		Expect(signingKey.Set(jwk.AlgorithmKey, jwa.RS256())).To(Succeed())
+		publicKey, err := signingKey.PublicKey()
+		Expect(err).NotTo(HaveOccurred())
+		keySet := jwk.NewSet()
+		Expect(keySet.AddKey(publicKey)).To(Succeed())
+		jwks = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(keySet)
+		}))
+	})
+
+	AfterEach(func() {
+		cancel()
+		jwks.Close()
+	})
+
+	startAuthenticatorWithAdminRole := func(jwksURL, adminRole string) {
+		authenticator, err := NewAuthenticator(config.APIAuthConfig{
+			Mode:                AuthModeJWT,
+			Issuer:              "https://idp.example.com",
+			Audience:            "policy-manager",
+			JWKSURL:             jwksURL,
+			JWKSRefreshInterval: time.Minute,
+			PrincipalClaim:      "sub",
+			AdminRole:           adminRole,
+			RolesClaim:          "roles",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authenticator.start(ctx)).To(Succeed())
+
+		principal = ""
+		handler = authenticator.middleware(newAdminRoutes("/api/v1alpha1"), healthPath, readinessPath)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal = service.PrincipalFromContext(r.Context())
+			w.WriteHeader(http.StatusOK)
+		}))
+	}
+
+	startAuthenticator := func(jwksURL string) {
+		startAuthenticatorWithAdminRole(jwksURL, "policy-admin")
+	}
+
+	token := func(build func(*jwt.Builder) *jwt.Builder) string {
+		t, err := build(jwt.NewBuilder().
+			Issuer("https://idp.example.com").
+			Audience([]string{"policy-manager"}).
+			Subject("alice@example.com").
+			Expiration(time.Now().Add(time.Hour))).Build()
+		Expect(err).NotTo(HaveOccurred())
+		signed, err := jwt.Sign(t, jwt.WithKey(jwa.RS256(), signingKey))
+		Expect(err).NotTo(HaveOccurred())
+		return string(signed)
+	}
+	unchanged := func(b *jwt.Builder) *jwt.Builder { return b }
+
+	serve := func(path, authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	It("records the subject of a valid token as the principal", func() {
+		startAuthenticator(jwks.URL)
+
+		recorder := serve("/api/v1alpha1/policies", "Bearer "+token(unchanged))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(principal).To(Equal("alice@example.com"))
+	})
+
+	It("refuses a request without a token", func() {
+		startAuthenticator(jwks.URL)
+
+		recorder := serve("/api/v1alpha1/policies", "")
+
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		Expect(recorder.Header().Get("WWW-Authenticate")).To(Equal("Bearer"))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"type":"UNAUTHENTICATED"`))
+	})
+
+	DescribeTable("refuses invalid tokens",
+		func(build func(*jwt.Builder) *jwt.Builder) {
+			startAuthenticator(jwks.URL)
+
+			recorder := serve("/api/v1alpha1/policies", "Bearer "+token(build))
+
+			Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+			Expect(recorder.Header().Get("WWW-Authenticate")).To(ContainSubstring("invalid_token"))
+			Expect(principal).To(BeEmpty())
+		},
+		Entry("another issuer", func(b *jwt.Builder) *jwt.Builder { return b.Issuer("https://other.example.com") }),
+		Entry("another audience", func(b *jwt.Builder) *jwt.Builder { return b.Audience([]string{"other"}) }),
+		Entry("an expired token", func(b *jwt.Builder) *jwt.Builder { return b.Expiration(time.Now().Add(-time.Hour)) }),
+		Entry("no subject", func(b *jwt.Builder) *jwt.Builder { return b.Subject("") }),
+	)
+
+	It("refuses a token signed by another key", func() {
+		startAuthenticator(jwks.URL)
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		signingKey, err = jwk.Import(otherKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signingKey.Set(jwk.KeyIDKey, "key-1")).To(Succeed())
+
+		recorder := serve("/api/v1alpha1/policies", "Bearer "+token(unchanged))
+
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+	})
+
+	It("serves the health checks without a token", func() {
+		startAuthenticator(jwks.URL)
+
+		Expect(serve(healthPath, "").Code).To(Equal(http.StatusOK))
+		Expect(serve(readinessPath, "").Code).To(Equal(http.StatusOK))
+	})
+
+	It("serves the admin API only to tokens with the admin role", func() {
+		startAuthenticator(jwks.URL)
+		const keysPath = "/api/v1alpha1/admin/engine-api-keys"
+
+		refused := serve(keysPath, "Bearer "+token(unchanged))
+		Expect(refused.Code).To(Equal(http.StatusForbidden))
+		Expect(refused.Header().Get("WWW-Authenticate")).To(ContainSubstring("insufficient_scope"))
+		Expect(refused.Body.String()).To(ContainSubstring(`"type":"PERMISSION_DENIED"`))
+		Expect(serve(keysPath, "Bearer "+token(func(b *jwt.Builder) *jwt.Builder {
+			return b.Claim("roles", []string{"viewer"})
+		})).Code).To(Equal(http.StatusForbidden))
+
+		Expect(serve(keysPath, "Bearer "+token(func(b *jwt.Builder) *jwt.Builder {
+			return b.Claim("roles", []string{"viewer", "policy-admin"})
+		})).Code).To(Equal(http.StatusOK))
+		Expect(serve(keysPath, "Bearer "+token(func(b *jwt.Builder) *jwt.Builder {
+			return b.Claim("roles", "policy-admin")
+		})).Code).To(Equal(http.StatusOK))
+		Expect(serve("/api/v1alpha1/admin/buildinfo", "Bearer "+token(unchanged)).Code).To(Equal(http.StatusOK))
+	})
+
+	It("refuses the admin API to every token without an admin role", func() {
+		startAuthenticatorWithAdminRole(jwks.URL, "")
+
+		recorder := serve("/api/v1alpha1/admin/config/reload", "Bearer "+token(func(b *jwt.Builder) *jwt.Builder {
+			return b.Claim("roles", []string{"policy-admin"})
+		}))
+
+		Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		Expect(recorder.Body.String()).To(ContainSubstring("API_AUTH_ADMIN_ROLE"))
+	})
+
+	It("answers 503 while the signing keys cannot be fetched", func() {
+		jwks.Close()
+		startAuthenticator(jwks.URL)
+
+		recorder := serve("/api/v1alpha1/policies", "Bearer "+token(unchanged))
+
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(recorder.Body.String()).To(ContainSubstring(`"type":"UNAVAILABLE"`))
+	})
+})
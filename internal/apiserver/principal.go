@@ -0,0 +1,22 @@
+package apiserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// principalFromHeader returns a middleware taking the principal of each request from the
+// header, until the public API authenticates its callers. A request without the header has
+// no principal.
+func principalFromHeader(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if principal := strings.TrimSpace(r.Header.Get(header)); principal != "" {
+				r = r.WithContext(service.ContextWithPrincipal(r.Context(), principal))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
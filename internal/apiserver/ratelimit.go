@@ -0,0 +1,21 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+)
+
+// refuseRateLimited writes the response to a request over its client's rate limit
+func refuseRateLimited(w http.ResponseWriter, _ *http.Request) {
+	detail := "Too many requests from this client; retry after the number of seconds in Retry-After"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(v1alpha1.Error{
+		Type:   v1alpha1.RESOURCEEXHAUSTED,
+		Status: http.StatusTooManyRequests,
+		Title:  "Rate limit exceeded",
+		Detail: &detail,
+	})
+}
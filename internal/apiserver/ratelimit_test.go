@@ -0,0 +1,38 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rate limiting", func() {
+	It("refuses a client over its rate with a RESOURCE_EXHAUSTED error, but not the health check", func() {
+		limiter, err := ratelimit.NewPublicLimiter(config.RateLimitConfig{PublicRPS: 1})
+		Expect(err).NotTo(HaveOccurred())
+		handler := limiter.Middleware(refuseRateLimited, "/health")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		serve := func(path string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			return recorder
+		}
+
+		Expect(serve("/policies").Code).To(Equal(http.StatusOK))
+		refused := serve("/policies")
+		Expect(refused.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(refused.Header().Get("Retry-After")).To(Equal("1"))
+		var body v1alpha1.Error
+		Expect(json.Unmarshal(refused.Body.Bytes(), &body)).To(Succeed())
+		Expect(body.Type).To(Equal(v1alpha1.RESOURCEEXHAUSTED))
+		Expect(body.Status).To(Equal(int32(http.StatusTooManyRequests)))
+		Expect(serve("/health").Code).To(Equal(http.StatusOK))
+	})
+})
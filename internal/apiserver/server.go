@@ -3,6 +3,7 @@ package apiserver
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -14,6 +15,7 @@ import (
 	"github.com/dcm-project/policy-manager/internal/api/server"
 	"github.com/dcm-project/policy-manager/internal/config"
 	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
@@ -22,18 +24,60 @@ const gracefulShutdownTimeout = 5 * time.Second
 
 // Server wraps the HTTP server with configuration and lifecycle management
 type Server struct {
-	config   *config.Config
-	listener net.Listener
-	handler  server.StrictServerInterface
+	config        *config.Config
+	listener      net.Listener
+	handler       server.StrictServerInterface
+	authenticator *Authenticator
+	rateLimiter   *ratelimit.Limiter
+	tlsConfig     *tls.Config
+	onShutdown    []func()
+}
+
+// Option configures optional public API server features
+type Option func(*Server)
+
+// WithOnShutdown calls fn when the server starts shutting down, to end long-lived responses
+// such as watch streams that graceful shutdown would otherwise wait for
+func WithOnShutdown(fn func()) Option {
+	return func(s *Server) {
+		s.onShutdown = append(s.onShutdown, fn)
+	}
+}
+
+// WithAuthenticator requires a valid bearer token on every request but the health checks,
+// and takes the principal of each request from its token. Without it the admin API is refused.
+func WithAuthenticator(authenticator *Authenticator) Option {
+	return func(s *Server) {
+		s.authenticator = authenticator
+	}
+}
+
+// WithRateLimiter refuses the requests of clients over their rate, other than the health
+// checks, before they are authenticated
+func WithRateLimiter(limiter *ratelimit.Limiter) Option {
+	return func(s *Server) {
+		s.rateLimiter = limiter
+	}
+}
+
+// WithTLSConfig serves the public API over TLS
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(s *Server) {
+		s.tlsConfig = tlsConfig
+	}
 }
 
 // New creates a new Server instance
-func New(cfg *config.Config, listener net.Listener, handler server.StrictServerInterface) *Server {
-	return &Server{
+func New(cfg *config.Config, listener net.Listener, handler server.StrictServerInterface, opts ...Option) *Server {
+	s := &Server{
 		config:   cfg,
 		listener: listener,
 		handler:  handler,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Run starts the HTTP server and blocks until shutdown
@@ -54,6 +98,24 @@ func (s *Server) Run(ctx context.Context) error {
 		baseURL = swagger.Servers[0].URL
 	}
 
+	// Probes must not be refused, or an orchestrator would restart a replica that is only busy
+	probePaths := []string{baseURL + "/health", baseURL + "/livez", baseURL + "/readyz"}
+	if s.rateLimiter != nil {
+		router.Use(s.rateLimiter.Middleware(refuseRateLimited, probePaths...))
+	}
+	admin := newAdminRoutes(baseURL)
+	if s.authenticator != nil {
+		if err := s.authenticator.start(ctx); err != nil {
+			return err
+		}
+		router.Use(s.authenticator.middleware(admin, probePaths...))
+	} else {
+		router.Use(refuseAdmin(admin))
+		if s.config.Service.PrincipalHeader != "" {
+			router.Use(principalFromHeader(s.config.Service.PrincipalHeader))
+		}
+	}
+
 	// Mount the generated handler with base URL from OpenAPI spec
 	server.HandlerFromMuxWithBaseURL(
 		server.NewStrictHandler(s.handler, nil),
@@ -63,6 +125,9 @@ func (s *Server) Run(ctx context.Context) error {
 
 	// Create HTTP server
 	srv := &http.Server{Handler: router}
+	for _, fn := range s.onShutdown {
+		srv.RegisterOnShutdown(fn)
+	}
 
 	go func() {
 		<-ctx.Done()
@@ -75,8 +140,13 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}()
 
-	slog.Info("Public API server started", "address", s.listener.Addr().String())
-	if err := srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	listener := s.listener
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	slog.Info("Public API server started", "address", s.listener.Addr().String(), "tls", s.tlsConfig != nil)
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("failed to serve policies API server: %w", err)
 	}
 
@@ -0,0 +1,279 @@
+// Package archive writes a sample of evaluation inputs and their outcomes to object storage,
+// for offline analysis such as suggesting policies from common request patterns. It is off
+// unless explicitly enabled. Records are anonymized before they leave the process: they carry
+// no request or tenant identifiers, their time is truncated to the hour, and configured spec
+// fields and request labels are redacted.
+package archive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/google/uuid"
+)
+
+const (
+	// SchemaVersion is the version of the Record schema, incremented on incompatible changes
+	SchemaVersion = 1
+	// RedactedValue replaces the value of redacted spec fields and request labels
+	RedactedValue = "[REDACTED]"
+	// StatusRejected is the outcome status of rejected evaluations
+	StatusRejected = "REJECTED"
+
+	// maxPendingBatches bounds the records kept while the sink is unavailable, in batches
+	maxPendingBatches = 10
+	// finalFlushTimeout bounds the flush of the pending records on shutdown
+	finalFlushTimeout = 10 * time.Second
+)
+
+var recordsTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_archive_records_total",
+	"Sampled evaluation records, by result: archived, dropped because too many were pending, or failed to write and kept for the next flush",
+	"result",
+)
+
+// Record is one archived evaluation, written as a line of a JSON Lines object
+type Record struct {
+	SchemaVersion int               `json:"schema_version"`
+	Time          time.Time         `json:"time"`           // when the evaluation completed, truncated to the hour
+	RequestLabels map[string]string `json:"request_labels"` // labels policy selectors were matched against, redacted
+	Spec          map[string]any    `json:"spec"`           // service instance spec as submitted, redacted
+	Outcome       Outcome           `json:"outcome"`
+}
+
+// Outcome is what the evaluation decided
+type Outcome struct {
+	Status           string `json:"status"`                      // APPROVED, MODIFIED or REJECTED
+	SelectedProvider string `json:"selected_provider,omitempty"` // unset unless a policy selected one
+	RejectedBy       string `json:"rejected_by,omitempty"`       // ID of the policy that rejected the request
+}
+
+// Archiver samples the evaluations published on an event bus and writes them to a Sink in
+// batches
+type Archiver struct {
+	sink           Sink
+	sampleRate     float64
+	batchSize      int
+	flushInterval  time.Duration
+	redactedFields [][]string
+	redactedLabels map[string]bool
+	instanceID     string
+	now            func() time.Time
+	sample         func() float64
+	full           chan struct{} // signalled when a batch is ready to write
+
+	// flushMu serializes flushes, so objects are written in order
+	flushMu sync.Mutex
+
+	mu      sync.Mutex
+	pending []Record
+	seq     int
+}
+
+// New creates an Archiver from the archive configuration, or returns nil when archiving is
+// disabled. redactedFields are dot-separated spec field paths redacted in addition to
+// ARCHIVE_REDACTED_FIELDS.
+func New(cfg config.ArchiveConfig, redactedFields []string) (*Archiver, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	sink, err := NewSink(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SampleRate <= 0 || cfg.SampleRate > 1 {
+		return nil, fmt.Errorf("ARCHIVE_SAMPLE_RATE must be greater than 0 and at most 1 (got %g)", cfg.SampleRate)
+	}
+	if cfg.BatchSize <= 0 {
+		return nil, fmt.Errorf("ARCHIVE_BATCH_SIZE must be positive (got %d)", cfg.BatchSize)
+	}
+	if cfg.FlushInterval <= 0 {
+		return nil, fmt.Errorf("ARCHIVE_FLUSH_INTERVAL must be positive (got %s)", cfg.FlushInterval)
+	}
+
+	a := &Archiver{
+		sink:           sink,
+		sampleRate:     cfg.SampleRate,
+		batchSize:      cfg.BatchSize,
+		flushInterval:  cfg.FlushInterval,
+		redactedLabels: make(map[string]bool, len(cfg.RedactedLabels)),
+		instanceID:     uuid.NewString(),
+		now:            time.Now,
+		sample:         rand.Float64,
+		full:           make(chan struct{}, 1),
+	}
+	for _, fieldPath := range append(append([]string{}, redactedFields...), cfg.RedactedFields...) {
+		a.redactedFields = append(a.redactedFields, strings.Split(fieldPath, "."))
+		// Request labels are derived from the spec's metadata labels
+		if key, ok := strings.CutPrefix(fieldPath, "metadata.labels."); ok {
+			a.redactedLabels[key] = true
+		}
+	}
+	for _, key := range cfg.RedactedLabels {
+		a.redactedLabels[key] = true
+	}
+	return a, nil
+}
+
+// RecordEvents samples the evaluation outcomes delivered by bus, an event bus or a queue in
+// front of one. Dry runs are not published, and approvals made because policies were
+// unavailable are not sampled.
+func (a *Archiver) RecordEvents(bus events.Subscriber) (unsubscribe func()) {
+	return bus.Subscribe(func(ctx context.Context, event events.Event) {
+		switch e := event.(type) {
+		case events.EvaluationCompleted:
+			if e.FailedOpen {
+				return
+			}
+			a.add(ctx, e.RequestLabels, e.InputSpec, Outcome{Status: e.Status, SelectedProvider: e.SelectedProvider})
+		case events.EvaluationRejected:
+			a.add(ctx, e.RequestLabels, e.InputSpec, Outcome{Status: StatusRejected, RejectedBy: e.PolicyID})
+		}
+	})
+}
+
+// Run writes the pending records every flush interval, and as soon as a batch is full, until
+// ctx is cancelled; the records still pending are then written before it returns. Failed
+// writes are logged and their records retried on the next flush.
+func (a *Archiver) Run(ctx context.Context) error {
+	ticker := time.NewTicker(a.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), finalFlushTimeout)
+			defer cancel()
+			if err := a.Flush(flushCtx); err != nil {
+				logging.FromContext(ctx).Warn("Failed to write the pending evaluation archive records", "error", err)
+			}
+			return nil
+		case <-ticker.C:
+		case <-a.full:
+		}
+		if err := a.Flush(ctx); err != nil && ctx.Err() == nil {
+			logging.FromContext(ctx).Warn("Failed to write evaluation archive records", "error", err)
+		}
+	}
+}
+
+// Flush writes the pending records to the sink, one object per batch. Records of a batch that
+// fails to write stay pending.
+func (a *Archiver) Flush(ctx context.Context) error {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+	for {
+		a.mu.Lock()
+		batch := a.pending[:min(len(a.pending), a.batchSize)]
+		if len(batch) == 0 {
+			a.mu.Unlock()
+			return nil
+		}
+		a.seq++
+		seq := a.seq
+		a.mu.Unlock()
+
+		if err := a.write(ctx, batch, seq); err != nil {
+			recordsTotal.Add(float64(len(batch)), "failed")
+			return err
+		}
+		recordsTotal.Add(float64(len(batch)), "archived")
+		a.mu.Lock()
+		a.pending = a.pending[len(batch):]
+		a.mu.Unlock()
+	}
+}
+
+func (a *Archiver) add(ctx context.Context, labels map[string]string, spec map[string]any, outcome Outcome) {
+	if a.sample() >= a.sampleRate {
+		return
+	}
+	record, err := a.record(labels, spec, outcome)
+	if err != nil {
+		logging.FromContext(ctx).Warn("Failed to record evaluation for the archive", "error", err)
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.pending) >= a.batchSize*maxPendingBatches {
+		recordsTotal.Inc("dropped")
+		return
+	}
+	a.pending = append(a.pending, record)
+	if len(a.pending)%a.batchSize == 0 {
+		select {
+		case a.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// record returns the anonymized record of an evaluation
+func (a *Archiver) record(labels map[string]string, spec map[string]any, outcome Outcome) (Record, error) {
+	copied, err := deep.Copy(spec)
+	if err != nil {
+		return Record{}, err
+	}
+	if copied == nil {
+		copied = map[string]any{}
+	}
+	for _, segments := range a.redactedFields {
+		redactField(copied, segments)
+	}
+
+	redactedLabels := make(map[string]string, len(labels))
+	for key, value := range labels {
+		if a.redactedLabels[key] {
+			value = RedactedValue
+		}
+		redactedLabels[key] = value
+	}
+	return Record{
+		SchemaVersion: SchemaVersion,
+		Time:          a.now().UTC().Truncate(time.Hour),
+		RequestLabels: redactedLabels,
+		Spec:          copied,
+		Outcome:       outcome,
+	}, nil
+}
+
+// write writes batch as the JSON Lines object of sequence number seq
+func (a *Archiver) write(ctx context.Context, batch []Record, seq int) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, record := range batch {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	now := a.now().UTC()
+	key := fmt.Sprintf("evaluations/dt=%s/%s-%s-%06d.jsonl",
+		now.Format(time.DateOnly), now.Format("20060102T150405Z"), a.instanceID, seq)
+	return a.sink.Put(ctx, key, body.Bytes())
+}
+
+// redactField walks the path segments into m and masks the leaf value if present
+func redactField(m map[string]any, segments []string) {
+	value, exists := m[segments[0]]
+	if !exists {
+		return
+	}
+	if len(segments) == 1 {
+		m[segments[0]] = RedactedValue
+		return
+	}
+	if nested, ok := value.(map[string]any); ok {
+		redactField(nested, segments[1:])
+	}
+}
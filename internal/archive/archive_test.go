@@ -0,0 +1,214 @@
+package archive_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/archive"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// readRecords decodes the records of a JSON Lines object
+func readRecords(body []byte) []map[string]any {
+	var records []map[string]any
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		var record map[string]any
+		Expect(json.Unmarshal(scanner.Bytes(), &record)).To(Succeed())
+		records = append(records, record)
+	}
+	return records
+}
+
+var _ = Describe("Archiver", func() {
+	var (
+		ctx context.Context
+		cfg config.ArchiveConfig
+		dir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		cfg = config.ArchiveConfig{
+			Enabled:        true,
+			URL:            "file://" + dir,
+			SampleRate:     1,
+			BatchSize:      10,
+			FlushInterval:  time.Hour,
+			RedactedFields: []string{"owner.email"},
+			RedactedLabels: []string{"user_id"},
+		}
+	})
+
+	// objects returns the contents of the objects written under dir, by key
+	objects := func() map[string][]byte {
+		written := map[string][]byte{}
+		Expect(filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			body, err := os.ReadFile(path)
+			key, _ := filepath.Rel(dir, path)
+			written[filepath.ToSlash(key)] = body
+			return err
+		})).To(Succeed())
+		return written
+	}
+
+	It("is disabled by default", func() {
+		archiver, err := archive.New(config.ArchiveConfig{URL: "file://" + dir, SampleRate: 1}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archiver).To(BeNil())
+	})
+
+	It("rejects an invalid configuration", func() {
+		invalid := cfg
+		invalid.URL = "s3://bucket/prefix"
+		_, err := archive.New(invalid, nil)
+		Expect(err).To(MatchError(ContainSubstring("ARCHIVE_URL must be a file, http or https URL")))
+
+		invalid = cfg
+		invalid.SampleRate = 1.5
+		_, err = archive.New(invalid, nil)
+		Expect(err).To(MatchError(ContainSubstring("ARCHIVE_SAMPLE_RATE")))
+
+		invalid = cfg
+		invalid.BatchSize = 0
+		_, err = archive.New(invalid, nil)
+		Expect(err).To(MatchError(ContainSubstring("ARCHIVE_BATCH_SIZE must be positive")))
+
+		invalid = cfg
+		invalid.FlushInterval = 0
+		_, err = archive.New(invalid, nil)
+		Expect(err).To(MatchError(ContainSubstring("ARCHIVE_FLUSH_INTERVAL must be positive")))
+	})
+
+	It("archives anonymized inputs and outcomes in the documented schema", func() {
+		archiver, err := archive.New(cfg, []string{"password"})
+		Expect(err).NotTo(HaveOccurred())
+		bus := events.NewBus()
+		archiver.RecordEvents(bus)
+		spec := map[string]any{
+			"region":   "eu-west-1",
+			"password": "hunter2",
+			"owner":    map[string]any{"email": "jane@example.com", "team": "payments"},
+		}
+		labels := map[string]string{"service_type": "vm", "user_id": "jane"}
+
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "MODIFIED", SelectedProvider: "aws", RequestLabels: labels, InputSpec: spec})
+		bus.Publish(ctx, events.EvaluationRejected{PolicyID: "no-gpus", Reason: "no", RequestLabels: labels, InputSpec: spec})
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED", FailedOpen: true, InputSpec: spec})
+		Expect(archiver.Flush(ctx)).To(Succeed())
+
+		written := objects()
+		Expect(written).To(HaveLen(1))
+		for key, body := range written {
+			Expect(key).To(MatchRegexp(`^evaluations/dt=\d{4}-\d{2}-\d{2}/\d{8}T\d{6}Z-[0-9a-f-]+-000001\.jsonl$`))
+			records := readRecords(body)
+			Expect(records).To(HaveLen(2))
+			Expect(records[0]).To(Equal(map[string]any{
+				"schema_version": float64(archive.SchemaVersion),
+				"time":           time.Now().UTC().Truncate(time.Hour).Format(time.RFC3339),
+				"request_labels": map[string]any{"service_type": "vm", "user_id": archive.RedactedValue},
+				"spec": map[string]any{
+					"region":   "eu-west-1",
+					"password": archive.RedactedValue,
+					"owner":    map[string]any{"email": archive.RedactedValue, "team": "payments"},
+				},
+				"outcome": map[string]any{"status": "MODIFIED", "selected_provider": "aws"},
+			}))
+			Expect(records[1]).To(HaveKeyWithValue("outcome", map[string]any{"status": archive.StatusRejected, "rejected_by": "no-gpus"}))
+		}
+		Expect(spec).To(HaveKeyWithValue("password", "hunter2"), "the published spec is not modified")
+	})
+
+	It("samples evaluations at the configured rate", func() {
+		cfg.SampleRate = 0.000001
+		archiver, err := archive.New(cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		bus := events.NewBus()
+		archiver.RecordEvents(bus)
+		for range 100 {
+			bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+		}
+
+		Expect(archiver.Flush(ctx)).To(Succeed())
+		Expect(objects()).To(BeEmpty())
+	})
+
+	It("writes full batches as they fill and the rest on shutdown", func() {
+		cfg.BatchSize = 2
+		archiver, err := archive.New(cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		bus := events.NewBus()
+		archiver.RecordEvents(bus)
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- archiver.Run(runCtx) }()
+
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+		Eventually(objects).Should(HaveLen(1))
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+
+		var counts []int
+		for _, body := range objects() {
+			counts = append(counts, len(readRecords(body)))
+		}
+		Expect(counts).To(ConsistOf(2, 1))
+	})
+
+	It("PUTs objects under an http URL and keeps records whose write failed", func() {
+		var (
+			mu       sync.Mutex
+			status   = http.StatusServiceUnavailable
+			received = map[string][]byte{}
+		)
+		endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPut))
+			Expect(r.URL.Query().Get("sig")).To(Equal("token"))
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			mu.Lock()
+			defer mu.Unlock()
+			if status == http.StatusCreated {
+				received[r.URL.Path] = body
+			}
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(endpoint.Close)
+		cfg.URL = endpoint.URL + "/bucket/policy-manager/?sig=token"
+		archiver, err := archive.New(cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		bus := events.NewBus()
+		archiver.RecordEvents(bus)
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+
+		Expect(archiver.Flush(ctx)).To(MatchError(ContainSubstring("503")))
+
+		mu.Lock()
+		status = http.StatusCreated
+		mu.Unlock()
+		Expect(archiver.Flush(ctx)).To(Succeed())
+		Expect(received).To(HaveLen(1))
+		for path, body := range received {
+			Expect(path).To(HavePrefix("/bucket/policy-manager/evaluations/dt="))
+			Expect(readRecords(body)).To(HaveLen(1))
+		}
+	})
+})
@@ -0,0 +1,95 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const putTimeout = 30 * time.Second
+
+// Sink stores archive objects
+type Sink interface {
+	// Put stores body as the object named key, a slash-separated relative path
+	Put(ctx context.Context, key string, body []byte) error
+}
+
+// NewSink creates the Sink for an archive URL: a file:// URL writes objects under a directory,
+// such as a mounted bucket, and an http or https URL PUTs them under its path, keeping its query
+// so a pre-signed or SAS token prefix can be used
+func NewSink(rawURL string) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("ARCHIVE_URL must be a file, http or https URL (got '%s')", rawURL)
+	}
+	switch {
+	case u.Scheme == "file" && u.Path != "":
+		return &dirSink{dir: filepath.FromSlash(u.Path)}, nil
+	case (u.Scheme == "http" || u.Scheme == "https") && u.Host != "":
+		return &httpSink{base: u, client: &http.Client{Timeout: putTimeout}}, nil
+	}
+	return nil, fmt.Errorf("ARCHIVE_URL must be a file, http or https URL (got '%s')", rawURL)
+}
+
+// dirSink writes objects as files under a directory
+type dirSink struct {
+	dir string
+}
+
+func (s *dirSink) Put(_ context.Context, key string, body []byte) error {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	// Write to a temporary file first so readers never see a partial object
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".archive-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err := tmp.Write(body); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// httpSink PUTs objects under a base URL
+type httpSink struct {
+	base   *url.URL
+	client *http.Client
+}
+
+func (s *httpSink) Put(ctx context.Context, key string, body []byte) error {
+	u := *s.base
+	u.Path = path.Join("/", strings.TrimSuffix(s.base.Path, "/"), key)
+	u.RawPath = ""
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	// Azure Blob Storage requires the blob type; other stores ignore it
+	req.Header.Set("x-ms-blob-type", "BlockBlob")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("archive endpoint answered %s for %s", resp.Status, key)
+	}
+	return nil
+}
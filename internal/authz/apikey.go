@@ -0,0 +1,141 @@
+package authz
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIKeyHeader is the request header engine API callers send their API key in
+const APIKeyHeader = "X-API-Key"
+
+// digestPrefix marks a static key given as the hex SHA-256 digest of the key
+const digestPrefix = "sha256:"
+
+// ErrAPIKeyRequired is returned for callers without a valid API key; it matches ErrUnauthenticated
+var ErrAPIKeyRequired error = unauthenticatedError("a valid API key is required in the " + APIKeyHeader + " header")
+
+type unauthenticatedError string
+
+func (e unauthenticatedError) Error() string {
+	return string(e)
+}
+
+func (e unauthenticatedError) Is(target error) bool {
+	return target == ErrUnauthenticated
+}
+
+// APIKeyDigest returns the hex SHA-256 digest API keys are stored and looked up by
+func APIKeyDigest(key string) string {
+	digest := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(digest[:])
+}
+
+// APIKeyLookup returns the identity of the stored API key with the digest, or "" when there
+// is none
+type APIKeyLookup func(ctx context.Context, digest string) (string, error)
+
+// APIKeyAuthorizer identifies callers by the API key they send, then authorizes their
+// identity like an IdentityAuthorizer does the identities of a client certificate. Keys are
+// looked up in the static keys first, then with the lookup. Found keys are cached for the
+// TTL, so a deleted key is accepted until its cached lookup expires.
+type APIKeyAuthorizer struct {
+	static     map[string]string // identities by key digest
+	lookup     APIKeyLookup
+	ttl        time.Duration
+	identities *IdentityAuthorizer
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedIdentity
+}
+
+type cachedIdentity struct {
+	identity  string
+	expiresAt time.Time
+}
+
+var _ Authorizer = (*APIKeyAuthorizer)(nil)
+
+// NewAPIKeyAuthorizer creates an APIKeyAuthorizer. keys maps identities to their static key,
+// or to sha256:<hex digest> of the key; lookup, which may be nil, finds the other keys.
+func NewAPIKeyAuthorizer(keys map[string]string, lookup APIKeyLookup, ttl time.Duration, rules map[Action][]string) (*APIKeyAuthorizer, error) {
+	static := make(map[string]string, len(keys))
+	for identity, key := range keys {
+		if identity == "" || key == "" {
+			return nil, errors.New("ENGINE_AUTHZ_API_KEYS must map identities to non-empty keys")
+		}
+		digest := APIKeyDigest(key)
+		if hexDigest, ok := strings.CutPrefix(key, digestPrefix); ok {
+			if decoded, err := hex.DecodeString(hexDigest); err != nil || len(decoded) != sha256.Size {
+				return nil, fmt.Errorf("the API key of '%s' must be a key or sha256: followed by a hex SHA-256 digest", identity)
+			}
+			digest = strings.ToLower(hexDigest)
+		}
+		static[digest] = identity
+	}
+	return &APIKeyAuthorizer{
+		static:     static,
+		lookup:     lookup,
+		ttl:        ttl,
+		identities: NewIdentityAuthorizer(rules),
+		now:        time.Now,
+		cache:      map[string]cachedIdentity{},
+	}, nil
+}
+
+// Authorize implements Authorizer
+func (a *APIKeyAuthorizer) Authorize(ctx context.Context, caller Caller, action Action) error {
+	if caller.APIKey == "" {
+		return ErrAPIKeyRequired
+	}
+	identity, err := a.identity(ctx, APIKeyDigest(caller.APIKey))
+	if err != nil {
+		return fmt.Errorf("%w: the API key could not be verified: %v", ErrPermissionDenied, err)
+	}
+	if identity == "" {
+		return ErrAPIKeyRequired
+	}
+	return a.identities.Authorize(ctx, Caller{Authenticated: true, Identities: []string{identity}}, action)
+}
+
+// Identify returns the identity of key, or "" when it is not a known key. Like Authorize, it
+// uses the cached lookups.
+func (a *APIKeyAuthorizer) Identify(ctx context.Context, key string) (string, error) {
+	return a.identity(ctx, APIKeyDigest(key))
+}
+
+// identity returns the identity of the key with the digest, or "" when there is none
+func (a *APIKeyAuthorizer) identity(ctx context.Context, digest string) (string, error) {
+	if identity, ok := a.static[digest]; ok {
+		return identity, nil
+	}
+	if a.lookup == nil {
+		return "", nil
+	}
+	now := a.now()
+	a.mu.Lock()
+	cached, ok := a.cache[digest]
+	a.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.identity, nil
+	}
+
+	identity, err := a.lookup(ctx, digest)
+	if err != nil {
+		return "", err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if identity == "" || a.ttl <= 0 {
+		delete(a.cache, digest)
+		return identity, nil
+	}
+	a.cache[digest] = cachedIdentity{identity: identity, expiresAt: now.Add(a.ttl)}
+	return identity, nil
+}
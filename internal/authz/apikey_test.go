@@ -0,0 +1,100 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APIKeyAuthorizer", func() {
+	var (
+		ctx     context.Context
+		lookups int
+		stored  map[string]string
+		lookup  APIKeyLookup
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lookups = 0
+		stored = map[string]string{APIKeyDigest("pmk_stored"): "archiver"}
+		lookup = func(_ context.Context, digest string) (string, error) {
+			lookups++
+			return stored[digest], nil
+		}
+	})
+
+	newAuthorizer := func(rules map[Action][]string) *APIKeyAuthorizer {
+		authorizer, err := NewAPIKeyAuthorizer(map[string]string{
+			"orchestrator": "pmk_static",
+			"policy-admin": "sha256:" + APIKeyDigest("pmk_admin"),
+		}, lookup, time.Minute, rules)
+		Expect(err).NotTo(HaveOccurred())
+		return authorizer
+	}
+
+	It("requires a known API key", func() {
+		authorizer := newAuthorizer(nil)
+
+		Expect(authorizer.Authorize(ctx, Caller{}, ActionEvaluate)).To(MatchError(ErrUnauthenticated))
+		err := authorizer.Authorize(ctx, Caller{APIKey: "pmk_unknown"}, ActionEvaluate)
+		Expect(err).To(MatchError(ErrUnauthenticated))
+		Expect(err).To(MatchError(ContainSubstring("X-API-Key")))
+	})
+
+	It("identifies callers by static, digested and stored keys", func() {
+		authorizer := newAuthorizer(map[Action][]string{ActionExplain: {"policy-admin"}})
+
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_static"}, ActionEvaluate)).To(Succeed())
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_admin"}, ActionExplain)).To(Succeed())
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_stored"}, ActionEvaluate)).To(Succeed())
+		err := authorizer.Authorize(ctx, Caller{APIKey: "pmk_static"}, ActionExplain)
+		Expect(err).To(MatchError(ErrPermissionDenied))
+		Expect(err).To(MatchError(ContainSubstring("client 'orchestrator' may not explain")))
+	})
+
+	It("identifies keys without authorizing an action", func() {
+		authorizer := newAuthorizer(nil)
+
+		Expect(authorizer.Identify(ctx, "pmk_admin")).To(Equal("policy-admin"))
+		Expect(authorizer.Identify(ctx, "pmk_stored")).To(Equal("archiver"))
+		Expect(authorizer.Identify(ctx, "pmk_unknown")).To(BeEmpty())
+	})
+
+	It("caches stored keys for the TTL", func() {
+		authorizer := newAuthorizer(nil)
+		now := time.Now()
+		authorizer.now = func() time.Time { return now }
+
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_stored"}, ActionEvaluate)).To(Succeed())
+		delete(stored, APIKeyDigest("pmk_stored"))
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_stored"}, ActionEvaluate)).To(Succeed())
+		Expect(lookups).To(Equal(1))
+
+		now = now.Add(time.Minute)
+		Expect(authorizer.Authorize(ctx, Caller{APIKey: "pmk_stored"}, ActionEvaluate)).To(MatchError(ErrUnauthenticated))
+		Expect(lookups).To(Equal(2))
+	})
+
+	It("denies callers whose key cannot be looked up", func() {
+		lookup = func(context.Context, string) (string, error) {
+			return "", errors.New("database unavailable")
+		}
+		authorizer := newAuthorizer(nil)
+
+		err := authorizer.Authorize(ctx, Caller{APIKey: "pmk_stored"}, ActionEvaluate)
+		Expect(err).To(MatchError(ErrPermissionDenied))
+		Expect(err).To(MatchError(ContainSubstring("database unavailable")))
+	})
+
+	It("rejects malformed static keys", func() {
+		_, err := NewAPIKeyAuthorizer(map[string]string{"orchestrator": "sha256:abc"}, nil, 0, nil)
+		Expect(err).To(MatchError(ContainSubstring("hex SHA-256 digest")))
+
+		_, err = NewAPIKeyAuthorizer(map[string]string{"orchestrator": ""}, nil, 0, nil)
+		Expect(err).To(MatchError(ContainSubstring("non-empty keys")))
+	})
+})
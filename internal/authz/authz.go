@@ -0,0 +1,156 @@
+// Package authz authorizes engine API callers per request.
+package authz
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+const (
+	ModeAllowAll = "allow_all"
+	ModeMTLS     = "mtls"
+	ModeAPIKey   = "api_key"
+)
+
+var deniedTotal = metrics.NewCounterVec(
+	"policy_manager_engine_authorization_denied_total",
+	"Engine API requests denied by the authorizer, by action",
+	"action",
+)
+
+var (
+	// ErrUnauthenticated is returned for callers the authorizer cannot identify
+	ErrUnauthenticated = errors.New("a verified client certificate is required")
+	// ErrPermissionDenied is returned for identified callers that may not perform an action
+	ErrPermissionDenied = errors.New("permission denied")
+)
+
+// Action is an engine API capability a caller is authorized for
+type Action string
+
+const (
+	// ActionEvaluate covers evaluation requests, composite requests and evaluation sessions
+	ActionEvaluate Action = "evaluate"
+	// ActionExplain covers requesting an explanation of an evaluation
+	ActionExplain Action = "explain"
+)
+
+// Caller is the client of an engine API request
+type Caller struct {
+	// Authenticated is true when the client presented a certificate the server verified
+	Authenticated bool
+	// Identities are the URI and DNS subject alternative names and the subject common name of
+	// the verified client certificate
+	Identities []string
+	// APIKey is the key sent in the X-API-Key header, identified by an APIKeyAuthorizer
+	APIKey string
+}
+
+// Authorizer decides whether the caller of a request may perform an action. It returns nil
+// when the action is allowed, and an error wrapping ErrUnauthenticated or ErrPermissionDenied
+// otherwise.
+type Authorizer interface {
+	Authorize(ctx context.Context, caller Caller, action Action) error
+}
+
+// AllowAll authorizes every caller for every action
+type AllowAll struct{}
+
+// Authorize implements Authorizer
+func (AllowAll) Authorize(context.Context, Caller, Action) error {
+	return nil
+}
+
+// Option configures the Authorizer created by NewAuthorizer
+type Option func(*options)
+
+type options struct {
+	apiKeyLookup APIKeyLookup
+}
+
+// WithAPIKeyLookup looks up the API keys that are not configured statically in the api_key mode
+func WithAPIKeyLookup(lookup APIKeyLookup) Option {
+	return func(o *options) {
+		o.apiKeyLookup = lookup
+	}
+}
+
+// NewAuthorizer creates the Authorizer selected by the configuration
+func NewAuthorizer(cfg config.EngineAuthzConfig, opts ...Option) (Authorizer, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	rules := map[Action][]string{
+		ActionEvaluate: cfg.EvaluateIdentities,
+		ActionExplain:  cfg.ExplainIdentities,
+	}
+	switch cfg.Mode {
+	case "", ModeAllowAll:
+		return AllowAll{}, nil
+	case ModeMTLS:
+		if cfg.TLSClientCAFile == "" {
+			return nil, fmt.Errorf("engine authorization mode '%s' requires ENGINE_TLS_CLIENT_CA_FILE", ModeMTLS)
+		}
+		return NewIdentityAuthorizer(rules), nil
+	case ModeAPIKey:
+		return NewAPIKeyAuthorizer(cfg.APIKeys, o.apiKeyLookup, cfg.APIKeyCacheTTL, rules)
+	}
+	return nil, fmt.Errorf("engine authorization mode must be one of: %s, %s, %s (got '%s')", ModeAllowAll, ModeMTLS, ModeAPIKey, cfg.Mode)
+}
+
+// Authorize checks the caller of ctx against authorizer, counting and describing denials
+func Authorize(ctx context.Context, authorizer Authorizer, action Action) error {
+	if err := authorizer.Authorize(ctx, CallerFromContext(ctx), action); err != nil {
+		deniedTotal.Inc(string(action))
+		return err
+	}
+	return nil
+}
+
+type callerKey struct{}
+
+// CallerFromContext returns the caller stored in ctx by Middleware; it is unauthenticated
+// when there is none
+func CallerFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+// ContextWithCaller returns a copy of ctx carrying caller
+func ContextWithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// Middleware stores the caller identified by the verified client certificate or the API
+// key of the request in its context
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		caller := callerFromTLS(r.TLS)
+		caller.APIKey = strings.TrimSpace(r.Header.Get(APIKeyHeader))
+		next.ServeHTTP(w, r.WithContext(ContextWithCaller(r.Context(), caller)))
+	})
+}
+
+func callerFromTLS(state *tls.ConnectionState) Caller {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return Caller{}
+	}
+	cert := state.VerifiedChains[0][0]
+	identities := make([]string, 0, len(cert.URIs)+len(cert.DNSNames)+1)
+	for _, uri := range cert.URIs {
+		identities = append(identities, uri.String())
+	}
+	identities = append(identities, cert.DNSNames...)
+	if cert.Subject.CommonName != "" {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	return Caller{Authenticated: true, Identities: identities}
+}
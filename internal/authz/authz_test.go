@@ -0,0 +1,228 @@
+package authz
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewAuthorizer", func() {
+	It("allows every caller by default", func() {
+		authorizer, err := NewAuthorizer(config.EngineAuthzConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorizer.Authorize(context.Background(), Caller{}, ActionExplain)).To(Succeed())
+	})
+
+	It("requires a client CA for mTLS", func() {
+		_, err := NewAuthorizer(config.EngineAuthzConfig{Mode: ModeMTLS})
+		Expect(err).To(MatchError(ContainSubstring("ENGINE_TLS_CLIENT_CA_FILE")))
+
+		authorizer, err := NewAuthorizer(config.EngineAuthzConfig{Mode: ModeMTLS, TLSClientCAFile: "ca.pem"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorizer).To(BeAssignableToTypeOf(&IdentityAuthorizer{}))
+	})
+
+	It("identifies callers by API key in the api_key mode", func() {
+		authorizer, err := NewAuthorizer(config.EngineAuthzConfig{
+			Mode:    ModeAPIKey,
+			APIKeys: map[string]string{"orchestrator": "secret"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(authorizer).To(BeAssignableToTypeOf(&APIKeyAuthorizer{}))
+		Expect(authorizer.Authorize(context.Background(), Caller{APIKey: "secret"}, ActionEvaluate)).To(Succeed())
+	})
+
+	It("rejects unknown modes", func() {
+		_, err := NewAuthorizer(config.EngineAuthzConfig{Mode: "oidc"})
+		Expect(err).To(MatchError(ContainSubstring("allow_all, mtls, api_key")))
+	})
+})
+
+var _ = Describe("IdentityAuthorizer", func() {
+	ctx := context.Background()
+	orchestrator := Caller{Authenticated: true, Identities: []string{"spiffe://dcm/orchestrator", "orchestrator"}}
+	admin := Caller{Authenticated: true, Identities: []string{"policy-admin"}}
+
+	It("requires a verified client certificate", func() {
+		authorizer := NewIdentityAuthorizer(nil)
+		Expect(authorizer.Authorize(ctx, Caller{}, ActionEvaluate)).To(MatchError(ErrUnauthenticated))
+	})
+
+	It("allows any authenticated caller to evaluate unless identities are listed", func() {
+		authorizer := NewIdentityAuthorizer(nil)
+		Expect(authorizer.Authorize(ctx, admin, ActionEvaluate)).To(Succeed())
+
+		authorizer = NewIdentityAuthorizer(map[Action][]string{ActionEvaluate: {"spiffe://dcm/orchestrator"}})
+		Expect(authorizer.Authorize(ctx, orchestrator, ActionEvaluate)).To(Succeed())
+		err := authorizer.Authorize(ctx, admin, ActionEvaluate)
+		Expect(err).To(MatchError(ErrPermissionDenied))
+		Expect(err).To(MatchError(ContainSubstring("client 'policy-admin' may not evaluate")))
+	})
+
+	It("allows explanations only for the listed identities", func() {
+		authorizer := NewIdentityAuthorizer(map[Action][]string{ActionExplain: nil})
+		Expect(authorizer.Authorize(ctx, admin, ActionExplain)).To(MatchError(ErrPermissionDenied))
+
+		authorizer = NewIdentityAuthorizer(map[Action][]string{ActionExplain: {"policy-admin"}})
+		Expect(authorizer.Authorize(ctx, admin, ActionExplain)).To(Succeed())
+		Expect(authorizer.Authorize(ctx, orchestrator, ActionExplain)).To(MatchError(ErrPermissionDenied))
+	})
+})
+
+var _ = Describe("Middleware", func() {
+	serveWithKey := func(state *tls.ConnectionState, apiKey string) Caller {
+		var caller Caller
+		handler := Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			caller = CallerFromContext(r.Context())
+		}))
+		request := httptest.NewRequest(http.MethodPost, "/", nil)
+		request.TLS = state
+		if apiKey != "" {
+			request.Header.Set(APIKeyHeader, apiKey)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+		return caller
+	}
+	serve := func(state *tls.ConnectionState) Caller {
+		return serveWithKey(state, "")
+	}
+
+	It("identifies the caller by its verified client certificate", func() {
+		cert := &x509.Certificate{
+			Subject:  pkix.Name{CommonName: "orchestrator"},
+			DNSNames: []string{"orchestrator.dcm.svc"},
+			URIs:     []*url.URL{{Scheme: "spiffe", Host: "dcm", Path: "/orchestrator"}},
+		}
+
+		caller := serve(&tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}})
+
+		Expect(caller.Authenticated).To(BeTrue())
+		Expect(caller.Identities).To(Equal([]string{"spiffe://dcm/orchestrator", "orchestrator.dcm.svc", "orchestrator"}))
+	})
+
+	It("leaves callers without a verified certificate unauthenticated", func() {
+		Expect(serve(nil)).To(Equal(Caller{}))
+		Expect(serve(&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{}}})).To(Equal(Caller{}))
+	})
+
+	It("records the API key of the request", func() {
+		Expect(serveWithKey(nil, " pmk_secret ")).To(Equal(Caller{APIKey: "pmk_secret"}))
+	})
+})
+
+var _ = Describe("ServerTLSConfig", func() {
+	var certFile, keyFile string
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		der, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "policy-manager"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			IPAddresses:  []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)},
+			IsCA:         true,
+		}, &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "policy-manager"}}, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		certFile = filepath.Join(dir, "tls.crt")
+		keyFile = filepath.Join(dir, "tls.key")
+		Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)).To(Succeed())
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)).To(Succeed())
+	})
+
+	It("serves plain HTTP when no certificate is configured", func() {
+		tlsConfig, err := ServerTLSConfig(config.EngineAuthzConfig{}, config.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig).To(BeNil())
+	})
+
+	It("verifies client certificates when a client CA is configured", func() {
+		tlsConfig, err := ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile, TLSKeyFile: keyFile}, config.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.Certificates).To(HaveLen(1))
+		Expect(tlsConfig.ClientAuth).To(Equal(tls.NoClientCert))
+
+		tlsConfig, err = ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: certFile}, config.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+		Expect(tlsConfig.ClientCAs).NotTo(BeNil())
+	})
+
+	It("falls back to the certificate and settings of both servers", func() {
+		tlsConfig, err := ServerTLSConfig(config.EngineAuthzConfig{TLSClientCAFile: certFile},
+			config.TLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.3"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.Certificates).To(HaveLen(1))
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+		Expect(tlsConfig.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+
+		_, err = ServerTLSConfig(config.EngineAuthzConfig{}, config.TLSConfig{CertFile: certFile})
+		Expect(err).To(MatchError(ContainSubstring("TLS_CERT_FILE and TLS_KEY_FILE must be set together")))
+	})
+
+	It("refuses connections without a verified client certificate when they are required", func() {
+		tlsConfig, err := ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: certFile, TLSRequireClientCert: true}, config.TLSConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.ClientAuth).To(Equal(tls.RequireAndVerifyClientCert))
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		server.TLS = tlsConfig
+		server.StartTLS()
+		defer server.Close()
+
+		roots := x509.NewCertPool()
+		roots.AddCert(server.Certificate())
+		get := func(certificates ...tls.Certificate) (*http.Response, error) {
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: certificates}}}
+			return client.Get(server.URL)
+		}
+
+		_, err = get()
+		Expect(err).To(MatchError(ContainSubstring("certificate required")))
+
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		Expect(err).NotTo(HaveOccurred())
+		response, err := get(cert)
+		Expect(err).NotTo(HaveOccurred())
+		response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+	})
+
+	It("rejects incomplete configurations", func() {
+		_, err := ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile}, config.TLSConfig{})
+		Expect(err).To(MatchError(ContainSubstring("must be set together")))
+
+		_, err = ServerTLSConfig(config.EngineAuthzConfig{TLSClientCAFile: certFile}, config.TLSConfig{})
+		Expect(err).To(MatchError(ContainSubstring("require ENGINE_TLS_CERT_FILE")))
+
+		_, err = ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSRequireClientCert: true}, config.TLSConfig{})
+		Expect(err).To(MatchError(ContainSubstring("requires ENGINE_TLS_CLIENT_CA_FILE")))
+
+		_, err = ServerTLSConfig(config.EngineAuthzConfig{TLSCertFile: certFile, TLSKeyFile: keyFile, TLSClientCAFile: keyFile}, config.TLSConfig{})
+		Expect(err).To(MatchError(ContainSubstring("contains no PEM certificates")))
+	})
+})
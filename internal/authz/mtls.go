@@ -0,0 +1,52 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// IdentityAuthorizer authorizes callers by the identities of their verified client
+// certificate. Callers without one are unauthenticated. An evaluate rule without identities
+// allows every authenticated caller; any other action is only allowed for the identities it
+// lists, so debug capabilities stay off until granted.
+type IdentityAuthorizer struct {
+	allowed map[Action]map[string]bool
+}
+
+var _ Authorizer = (*IdentityAuthorizer)(nil)
+
+// NewIdentityAuthorizer creates an IdentityAuthorizer from the identities allowed per action
+func NewIdentityAuthorizer(rules map[Action][]string) *IdentityAuthorizer {
+	allowed := make(map[Action]map[string]bool, len(rules))
+	for action, identities := range rules {
+		if len(identities) == 0 {
+			continue
+		}
+		allowed[action] = make(map[string]bool, len(identities))
+		for _, identity := range identities {
+			allowed[action][identity] = true
+		}
+	}
+	return &IdentityAuthorizer{allowed: allowed}
+}
+
+// Authorize implements Authorizer
+func (a *IdentityAuthorizer) Authorize(_ context.Context, caller Caller, action Action) error {
+	if !caller.Authenticated {
+		return ErrUnauthenticated
+	}
+	identities, ok := a.allowed[action]
+	if !ok {
+		if action == ActionEvaluate {
+			return nil
+		}
+		return fmt.Errorf("%w: no client may %s", ErrPermissionDenied, action)
+	}
+	for _, identity := range caller.Identities {
+		if identities[identity] {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: client '%s' may not %s", ErrPermissionDenied, strings.Join(caller.Identities, ", "), action)
+}
@@ -0,0 +1,55 @@
+package authz
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/servertls"
+)
+
+// ServerTLSConfig returns the TLS configuration of the engine API listener, or nil when the
+// engine API is served over plain HTTP. It presents the ENGINE_TLS_* certificate, or the
+// TLS_* certificate of both servers when none is set, with the TLS_* minimum version and
+// cipher suites. With a client CA, client certificates are verified when presented, so
+// endpoints such as /metrics stay reachable without one, unless the configuration requires them.
+func ServerTLSConfig(cfg config.EngineAuthzConfig, serverTLS config.TLSConfig) (*tls.Config, error) {
+	certFile, keyFile, certSettings := cfg.TLSCertFile, cfg.TLSKeyFile, "ENGINE_TLS_CERT_FILE and ENGINE_TLS_KEY_FILE"
+	if certFile == "" && keyFile == "" {
+		certFile, keyFile, certSettings = serverTLS.CertFile, serverTLS.KeyFile, "TLS_CERT_FILE and TLS_KEY_FILE"
+	}
+	if certFile == "" && keyFile == "" {
+		if cfg.TLSClientCAFile != "" || cfg.TLSRequireClientCert {
+			return nil, fmt.Errorf("ENGINE_TLS_CLIENT_CA_FILE and ENGINE_TLS_REQUIRE_CLIENT_CERT require ENGINE_TLS_CERT_FILE and ENGINE_TLS_KEY_FILE, or TLS_CERT_FILE and TLS_KEY_FILE")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("%s must be set together", certSettings)
+	}
+	if cfg.TLSRequireClientCert && cfg.TLSClientCAFile == "" {
+		return nil, fmt.Errorf("ENGINE_TLS_REQUIRE_CLIENT_CERT requires ENGINE_TLS_CLIENT_CA_FILE")
+	}
+	tlsConfig, err := servertls.WithCertificate(serverTLS, certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("engine TLS: %w", err)
+	}
+	if cfg.TLSClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.TLSClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read engine TLS client CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("engine TLS client CA file '%s' contains no PEM certificates", cfg.TLSClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if cfg.TLSRequireClientCert {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return tlsConfig, nil
+}
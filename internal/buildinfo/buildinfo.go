@@ -0,0 +1,67 @@
+// Package buildinfo describes the running binary: its version, the commit and time it was
+// built from, and the capabilities compiled into it.
+package buildinfo
+
+import (
+	"maps"
+	"runtime"
+	"runtime/debug"
+)
+
+// Set at build time with -ldflags "-X github.com/dcm-project/policy-manager/internal/buildinfo.Version=..."
+var (
+	Version   = "dev"
+	GitCommit = ""
+	BuildDate = ""
+)
+
+// capabilities lists what this binary can do regardless of configuration
+var capabilities = map[string]bool{
+	"embedded_opa":          true,
+	"bundle_import":         true,
+	"gatekeeper_conversion": true,
+	"ext_authz":             true,
+	"metrics":               true,
+	"grpc":                  false,
+	"encryption":            false,
+}
+
+// Info describes the running binary
+type Info struct {
+	Version      string
+	GitCommit    string
+	BuildDate    string
+	GoVersion    string
+	Capabilities map[string]bool
+}
+
+// Get returns the build information. The commit and build date fall back to the VCS
+// stamp Go records in the binary when they were not set with -ldflags.
+func Get() Info {
+	info := Info{
+		Version:      Version,
+		GitCommit:    GitCommit,
+		BuildDate:    BuildDate,
+		GoVersion:    runtime.Version(),
+		Capabilities: maps.Clone(capabilities),
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		applyVCSSettings(&info, bi.Settings)
+	}
+	return info
+}
+
+func applyVCSSettings(info *Info, settings []debug.BuildSetting) {
+	for _, setting := range settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if info.GitCommit == "" {
+				info.GitCommit = setting.Value
+			}
+		case "vcs.time":
+			if info.BuildDate == "" {
+				info.BuildDate = setting.Value
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package buildinfo
+
+import (
+	"runtime"
+	"runtime/debug"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Get", func() {
+	It("reports the Go version and compiled capabilities", func() {
+		info := Get()
+		Expect(info.Version).To(Equal(Version))
+		Expect(info.GoVersion).To(Equal(runtime.Version()))
+		Expect(info.Capabilities).To(HaveKeyWithValue("embedded_opa", true))
+		Expect(info.Capabilities).To(HaveKeyWithValue("grpc", false))
+	})
+
+	It("returns a copy of the capabilities", func() {
+		Get().Capabilities["embedded_opa"] = false
+		Expect(Get().Capabilities).To(HaveKeyWithValue("embedded_opa", true))
+	})
+})
+
+var _ = Describe("applyVCSSettings", func() {
+	settings := []debug.BuildSetting{
+		{Key: "vcs.revision", Value: "abc123"},
+		{Key: "vcs.time", Value: "2026-10-01T12:00:00Z"},
+	}
+
+	It("fills the commit and build date from the VCS stamp", func() {
+		info := Info{}
+		applyVCSSettings(&info, settings)
+		Expect(info.GitCommit).To(Equal("abc123"))
+		Expect(info.BuildDate).To(Equal("2026-10-01T12:00:00Z"))
+	})
+
+	It("keeps values set with ldflags", func() {
+		info := Info{GitCommit: "def456", BuildDate: "2026-10-02T08:00:00Z"}
+		applyVCSSettings(&info, settings)
+		Expect(info.GitCommit).To(Equal("def456"))
+		Expect(info.BuildDate).To(Equal("2026-10-02T08:00:00Z"))
+	})
+})
@@ -1,13 +1,49 @@
-// Package config provides application configuration loaded from environment variables.
+// Package config provides application configuration loaded from environment variables and
+// an optional config file.
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"os"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 // ServiceConfig holds service-level configuration
 type ServiceConfig struct {
 	BindAddress       string `envconfig:"BIND_ADDRESS" default:"0.0.0.0:8080"`
 	EngineBindAddress string `envconfig:"ENGINE_BIND_ADDRESS" default:"0.0.0.0:8081"`
 	LogLevel          string `envconfig:"LOG_LEVEL" default:"info"`
+	// CacheMaxAge is how long policy GET and list responses may be reused without revalidation
+	CacheMaxAge time.Duration `envconfig:"API_CACHE_MAX_AGE" default:"0s"`
+	// PrincipalHeader names the request header recorded as the creator or updater of the
+	// policies a request changes; no principal is recorded when it is empty. It is ignored
+	// when public API callers are authenticated.
+	PrincipalHeader string `envconfig:"API_PRINCIPAL_HEADER"`
+}
+
+// APIAuthConfig holds configuration for authenticating public API callers
+type APIAuthConfig struct {
+	// Mode is none or jwt: whether public API requests must carry a bearer token signed by
+	// one of the keys at JWKSURL
+	Mode string `envconfig:"API_AUTH_MODE" default:"none"`
+	// Issuer is the iss claim tokens must have
+	Issuer string `envconfig:"API_AUTH_ISSUER"`
+	// Audience is the value the aud claim of tokens must include
+	Audience string `envconfig:"API_AUTH_AUDIENCE"`
+	// JWKSURL is the http or https URL of the JSON Web Key Set verifying token signatures
+	JWKSURL string `envconfig:"API_AUTH_JWKS_URL"`
+	// JWKSRefreshInterval is how often the key set is fetched again, so rotated keys are picked up
+	JWKSRefreshInterval time.Duration `envconfig:"API_AUTH_JWKS_REFRESH_INTERVAL" default:"15m"`
+	// PrincipalClaim is the string claim recorded as the principal of the request
+	PrincipalClaim string `envconfig:"API_AUTH_PRINCIPAL_CLAIM" default:"sub"`
+	// ClockSkew is how far the expiry and not-before times of tokens may be off
+	ClockSkew time.Duration `envconfig:"API_AUTH_CLOCK_SKEW" default:"30s"`
+	// AdminRole is the role a token must have to call the admin API; the admin API is
+	// refused to every caller when it is empty
+	AdminRole string `envconfig:"API_AUTH_ADMIN_ROLE"`
+	// RolesClaim is the string or string array claim holding the roles of a token
+	RolesClaim string `envconfig:"API_AUTH_ROLES_CLAIM" default:"roles"`
 }
 
 // DBConfig holds database configuration
@@ -20,22 +56,314 @@ type DBConfig struct {
 	Password string `envconfig:"DB_PASSWORD" default:"adminpass"`
 }
 
+// EvaluationConfig holds policy evaluation configuration
+type EvaluationConfig struct {
+	// ExplainRedactedFields lists dot-separated spec field paths masked in explain output
+	ExplainRedactedFields []string `envconfig:"EVALUATION_EXPLAIN_REDACTED_FIELDS"`
+	// ProtectedFields lists dot-separated spec field paths no policy patch may change
+	ProtectedFields []string `envconfig:"EVALUATION_PROTECTED_FIELDS"`
+	// DefaultRequestLabels are added to the request labels of every evaluation that does not
+	// set them, e.g. cluster:prod-east,environment:production
+	DefaultRequestLabels map[string]string `envconfig:"EVALUATION_DEFAULT_REQUEST_LABELS"`
+	// RequestLabelHeaders maps engine API request header names to request label keys added to
+	// evaluations that do not set them; they take precedence over DefaultRequestLabels
+	RequestLabelHeaders map[string]string `envconfig:"EVALUATION_REQUEST_LABEL_HEADERS"`
+	// DeniedSpecFields lists top-level spec field names, or name prefixes ending in *, not
+	// allowed in evaluation requests
+	DeniedSpecFields []string `envconfig:"EVALUATION_DENIED_SPEC_FIELDS" default:"__*,constraints,service_provider_constraints"`
+	// DeniedSpecFieldMode is strip or reject: whether denied spec fields are removed before
+	// evaluation or fail the request with 400
+	DeniedSpecFieldMode string `envconfig:"EVALUATION_DENIED_SPEC_FIELD_MODE" default:"strip"`
+	// InputLayout is flat or namespaced: whether policies get the request spec and the
+	// engine's fields at the top level of the input as before, or the spec in
+	// input.request.spec and the engine's fields in input.context
+	InputLayout string `envconfig:"EVALUATION_INPUT_LAYOUT" default:"flat"`
+	// DedupWindow coalesces identical evaluation requests arriving within the window; zero disables it
+	DedupWindow time.Duration `envconfig:"EVALUATION_DEDUP_WINDOW" default:"0s"`
+	// WarmUp evaluates every enabled policy and compiles its constraint schemas before serving
+	WarmUp bool `envconfig:"EVALUATION_WARMUP" default:"true"`
+	// PatchConflicts is allow, warn or deny: how to handle a policy overwriting a field set by a
+	// policy of comparable priority in the same evaluation
+	PatchConflicts string `envconfig:"EVALUATION_PATCH_CONFLICTS" default:"allow"`
+	// PatchConflictPriorityWindow is the largest priority difference at which policies are comparable
+	PatchConflictPriorityWindow int32 `envconfig:"EVALUATION_PATCH_CONFLICT_PRIORITY_WINDOW" default:"0"`
+	// ExecutionStrategy is sequential or phased: whether the policies of a type are evaluated
+	// concurrently, GLOBAL policies completing before USER policies start
+	ExecutionStrategy string `envconfig:"EVALUATION_EXECUTION_STRATEGY" default:"sequential"`
+	// PhaseConcurrency is the number of policies evaluated at once by the phased strategy
+	PhaseConcurrency int `envconfig:"EVALUATION_PHASE_CONCURRENCY" default:"4"`
+	// FailureMode is closed or open: whether an evaluation request fails with 503 or is approved
+	// unchanged when the policy store is unavailable
+	FailureMode string `envconfig:"EVALUATION_FAILURE_MODE" default:"closed"`
+	// PolicyTimeout is how long the Rego of a single policy may run before the request fails;
+	// zero disables the deadline
+	PolicyTimeout time.Duration `envconfig:"EVALUATION_POLICY_TIMEOUT" default:"1s"`
+	// EngineReconcileInterval is how often the compiled policies are compared with the store and
+	// recompiled when they differ; zero disables reconciliation
+	EngineReconcileInterval time.Duration `envconfig:"EVALUATION_ENGINE_RECONCILE_INTERVAL" default:"1m"`
+	// PolicySnapshotInterval is how often the in-memory snapshot of enabled policies that
+	// evaluations run against is reloaded from the store; zero disables the snapshot, so each
+	// evaluation reads the store
+	PolicySnapshotInterval time.Duration `envconfig:"EVALUATION_POLICY_SNAPSHOT_INTERVAL" default:"30s"`
+	// SessionTTL is how long an unused evaluation session stays open
+	SessionTTL time.Duration `envconfig:"EVALUATION_SESSION_TTL" default:"15m"`
+	// MaxSessions is the number of evaluation sessions that can be open at once
+	MaxSessions int `envconfig:"EVALUATION_MAX_SESSIONS" default:"1000"`
+	// RejectionMessageCatalog is a YAML or JSON file of rejection message templates by
+	// language and rejection code; empty disables translation
+	RejectionMessageCatalog string `envconfig:"EVALUATION_REJECTION_MESSAGE_CATALOG"`
+	// PatchFieldAllowlist is a YAML or JSON file mapping service types to the dot-separated
+	// spec field paths policies may patch; empty disables the check
+	PatchFieldAllowlist string `envconfig:"EVALUATION_PATCH_FIELD_ALLOWLIST"`
+	// PatchFieldMode is warn or strict: whether a policy patching a field not allowed for the
+	// service type adds a warning to the response or fails the request with 409
+	PatchFieldMode string `envconfig:"EVALUATION_PATCH_FIELD_MODE" default:"warn"`
+}
+
+// PolicyDefaultsConfig holds the values given to the optional fields a created policy omits
+type PolicyDefaultsConfig struct {
+	// Enabled is the enabled state of policies created without one; false leaves new policies
+	// disabled until they are reviewed and enabled
+	Enabled bool `envconfig:"POLICY_DEFAULT_ENABLED" default:"true"`
+	// PriorityStrategy is fixed, next_available or last: whether policies created without a
+	// priority get 500, the first priority from 500 no policy of their type has, or one more
+	// than the largest priority of their type
+	PriorityStrategy string `envconfig:"POLICY_DEFAULT_PRIORITY_STRATEGY" default:"fixed"`
+}
+
+// QuotaConfig holds evaluation quota configuration. Limits are evaluations per
+// minute; zero disables the quota.
+type QuotaConfig struct {
+	PerTenant      int `envconfig:"EVALUATION_QUOTA_PER_TENANT" default:"0"`
+	PerServiceType int `envconfig:"EVALUATION_QUOTA_PER_SERVICE_TYPE" default:"0"`
+	// TenantLabel is the request label identifying the tenant
+	TenantLabel string `envconfig:"EVALUATION_QUOTA_TENANT_LABEL" default:"tenant"`
+	// Overrides maps "tenant=<name>" or "service_type=<name>" to a specific limit
+	Overrides map[string]int `envconfig:"EVALUATION_QUOTA_OVERRIDES"`
+}
+
+// RateLimitConfig holds the per-client request rate limits of the public and engine APIs.
+// Rates are requests per second; zero disables the limit.
+type RateLimitConfig struct {
+	PublicRPS float64 `envconfig:"RATE_LIMIT_PUBLIC_RPS" default:"0"`
+	// PublicBurst is the number of requests a public API client can make at once; zero is
+	// PublicRPS rounded up
+	PublicBurst int     `envconfig:"RATE_LIMIT_PUBLIC_BURST" default:"0"`
+	EngineRPS   float64 `envconfig:"RATE_LIMIT_ENGINE_RPS" default:"0"`
+	// EngineBurst is the number of requests an engine API client can make at once; zero is
+	// EngineRPS rounded up
+	EngineBurst int `envconfig:"RATE_LIMIT_ENGINE_BURST" default:"0"`
+	// EngineClientKey is ip or api_key: whether engine API clients are told apart by their
+	// address or by the identity of the X-API-Key they send, which requires API key authorization
+	EngineClientKey string `envconfig:"RATE_LIMIT_ENGINE_CLIENT_KEY" default:"ip"`
+	// ClientIPHeader names the header whose last address is the client's, as added by a
+	// trusted proxy, such as X-Forwarded-For; empty uses the address of the connection
+	ClientIPHeader string `envconfig:"RATE_LIMIT_CLIENT_IP_HEADER"`
+}
+
+// ExtAuthzConfig holds configuration for the Envoy ext_authz adapter on the engine API
+type ExtAuthzConfig struct {
+	Enabled    bool   `envconfig:"EXT_AUTHZ_ENABLED" default:"false"`
+	PathPrefix string `envconfig:"EXT_AUTHZ_PATH_PREFIX" default:"/ext_authz"`
+	// ServiceType is the service_type of the evaluated spec, used for label selector matching
+	ServiceType string `envconfig:"EXT_AUTHZ_SERVICE_TYPE" default:"http_request"`
+	// LabelHeaders maps request header names to request label keys
+	LabelHeaders map[string]string `envconfig:"EXT_AUTHZ_LABEL_HEADERS"`
+	// SpecHeaders maps request header names (or @method and @path) to dot-separated spec field paths
+	SpecHeaders map[string]string `envconfig:"EXT_AUTHZ_SPEC_HEADERS"`
+	// BodyField is the spec field path that receives the JSON request body; empty ignores the body
+	BodyField string `envconfig:"EXT_AUTHZ_BODY_FIELD"`
+}
+
+// EngineAuthzConfig holds configuration for authenticating and authorizing engine API callers
+type EngineAuthzConfig struct {
+	// Mode is allow_all, mtls or api_key: whether callers are authorized by the identity of
+	// their client certificate or of their API key
+	Mode string `envconfig:"ENGINE_AUTHZ_MODE" default:"allow_all"`
+	// APIKeys maps identities to their API key, or to sha256:<hex digest> of it, in addition
+	// to the keys stored through the public API
+	APIKeys map[string]string `envconfig:"ENGINE_AUTHZ_API_KEYS"`
+	// APIKeyCacheTTL is how long a stored API key is trusted without looking it up again;
+	// zero looks it up on every request
+	APIKeyCacheTTL time.Duration `envconfig:"ENGINE_AUTHZ_API_KEY_CACHE_TTL" default:"1m"`
+	// EvaluateIdentities lists the client identities allowed to evaluate; empty allows any
+	// client with a verified certificate
+	EvaluateIdentities []string `envconfig:"ENGINE_AUTHZ_EVALUATE_IDENTITIES"`
+	// ExplainIdentities lists the client identities allowed to request explanations; empty allows none
+	ExplainIdentities []string `envconfig:"ENGINE_AUTHZ_EXPLAIN_IDENTITIES"`
+	// TLSCertFile and TLSKeyFile serve the engine API over TLS; empty serves plain HTTP
+	TLSCertFile string `envconfig:"ENGINE_TLS_CERT_FILE"`
+	TLSKeyFile  string `envconfig:"ENGINE_TLS_KEY_FILE"`
+	// TLSClientCAFile is the PEM bundle client certificates are verified against
+	TLSClientCAFile string `envconfig:"ENGINE_TLS_CLIENT_CA_FILE"`
+	// TLSRequireClientCert refuses TLS connections without a client certificate verified
+	// against TLSClientCAFile
+	TLSRequireClientCert bool `envconfig:"ENGINE_TLS_REQUIRE_CLIENT_CERT" default:"false"`
+}
+
+// TLSConfig holds configuration for serving both APIs over TLS
+type TLSConfig struct {
+	// CertFile and KeyFile serve the public API, and the engine API unless ENGINE_TLS_CERT_FILE
+	// is set, over TLS; empty serves plain HTTP
+	CertFile string `envconfig:"TLS_CERT_FILE"`
+	KeyFile  string `envconfig:"TLS_KEY_FILE"`
+	// MinVersion is the oldest TLS version accepted, 1.2 or 1.3
+	MinVersion string `envconfig:"TLS_MIN_VERSION" default:"1.2"`
+	// CipherSuites restricts the TLS 1.2 cipher suites to these names; empty allows Go's
+	// secure defaults
+	CipherSuites []string `envconfig:"TLS_CIPHER_SUITES"`
+}
+
+// FeatureFlagsConfig holds feature flag overrides. Values in Flags take precedence over File.
+type FeatureFlagsConfig struct {
+	// Flags maps flag names to enabled state, e.g. "flag_a:true,flag_b:false"
+	Flags map[string]bool `envconfig:"FEATURE_FLAGS"`
+	// File is a YAML or JSON file mapping flag names to enabled state
+	File string `envconfig:"FEATURE_FLAGS_FILE"`
+}
+
+// PageTokenConfig holds configuration for the signed page tokens returned by list endpoints
+type PageTokenConfig struct {
+	// Secret is the HMAC key signing page tokens; empty generates a per-process key
+	Secret string `envconfig:"PAGE_TOKEN_SECRET"`
+	// TTL is how long a page token stays valid after it is issued
+	TTL time.Duration `envconfig:"PAGE_TOKEN_TTL" default:"1h"`
+}
+
+// PageSizeConfig holds the page size limits of list endpoints
+type PageSizeConfig struct {
+	// Default is the page size used when a list request does not set max_page_size
+	Default int `envconfig:"PAGE_SIZE_DEFAULT" default:"50"`
+	// Max is the largest page size returned; larger max_page_size values are lowered to it
+	Max int `envconfig:"PAGE_SIZE_MAX" default:"1000"`
+}
+
+// AuditConfig holds configuration for the hash-chained audit log
+type AuditConfig struct {
+	// Enabled records policy changes and evaluation outcomes in the audit log
+	Enabled bool `envconfig:"AUDIT_ENABLED" default:"false"`
+	// SigningKey is the HMAC key signing entry hashes; empty falls back to unkeyed SHA-256
+	SigningKey string `envconfig:"AUDIT_SIGNING_KEY"`
+	// QueueSize is the number of events waiting to be recorded beyond which QueueOverflow applies
+	QueueSize int `envconfig:"AUDIT_QUEUE_SIZE" default:"10000"`
+	// QueueOverflow is drop, sample or block: what happens to events while the queue is full
+	QueueOverflow string `envconfig:"AUDIT_QUEUE_OVERFLOW" default:"block"`
+}
+
+// TelemetryConfig holds configuration for opt-in anonymous usage telemetry
+type TelemetryConfig struct {
+	// Enabled sends anonymous usage reports to Endpoint; it is off unless explicitly enabled
+	Enabled bool `envconfig:"TELEMETRY_ENABLED" default:"false"`
+	// Endpoint is the http or https URL reports are POSTed to
+	Endpoint string `envconfig:"TELEMETRY_ENDPOINT"`
+	// Interval is the time between reports
+	Interval time.Duration `envconfig:"TELEMETRY_INTERVAL" default:"24h"`
+}
+
+// ArchiveConfig holds configuration for the opt-in archive of sampled evaluation inputs
+type ArchiveConfig struct {
+	// Enabled archives a sample of evaluation inputs and outcomes to URL; it is off unless
+	// explicitly enabled
+	Enabled bool `envconfig:"ARCHIVE_ENABLED" default:"false"`
+	// URL is where archive objects are written: a file:// directory, or an http or https
+	// prefix objects are PUT under
+	URL string `envconfig:"ARCHIVE_URL"`
+	// SampleRate is the fraction of evaluations archived, from 0 to 1
+	SampleRate float64 `envconfig:"ARCHIVE_SAMPLE_RATE" default:"0.01"`
+	// BatchSize is the number of records written per object
+	BatchSize int `envconfig:"ARCHIVE_BATCH_SIZE" default:"1000"`
+	// FlushInterval is the longest records wait before they are written
+	FlushInterval time.Duration `envconfig:"ARCHIVE_FLUSH_INTERVAL" default:"5m"`
+	// RedactedFields are dot-separated spec field paths whose values are replaced before
+	// archiving, in addition to EVALUATION_EXPLAIN_REDACTED_FIELDS
+	RedactedFields []string `envconfig:"ARCHIVE_REDACTED_FIELDS"`
+	// RedactedLabels are request label keys whose values are replaced before archiving
+	RedactedLabels []string `envconfig:"ARCHIVE_REDACTED_LABELS" default:"user_id"`
+	// QueueSize is the number of evaluations waiting to be sampled beyond which QueueOverflow applies
+	QueueSize int `envconfig:"ARCHIVE_QUEUE_SIZE" default:"10000"`
+	// QueueOverflow is drop, sample or block: what happens to evaluations while the queue is full
+	QueueOverflow string `envconfig:"ARCHIVE_QUEUE_OVERFLOW" default:"sample"`
+}
+
+// InstanceProviderConfig holds configuration for looking up the previous evaluation of the
+// service instances re-evaluated by day-2 requests
+type InstanceProviderConfig struct {
+	// URL is the http or https URL instances are looked up under, as URL/{instance_id};
+	// empty disables the lookup
+	URL string `envconfig:"INSTANCE_PROVIDER_URL"`
+	// Timeout bounds one lookup
+	Timeout time.Duration `envconfig:"INSTANCE_PROVIDER_TIMEOUT" default:"2s"`
+}
+
 // Config is the root configuration structure
 type Config struct {
-	Service  ServiceConfig
-	Database *DBConfig
+	Service        ServiceConfig
+	APIAuth        APIAuthConfig
+	Database       *DBConfig
+	PolicyDefaults PolicyDefaultsConfig
+	Evaluation     EvaluationConfig
+	Quota          QuotaConfig
+	RateLimit      RateLimitConfig
+	ExtAuthz       ExtAuthzConfig
+	EngineAuthz    EngineAuthzConfig
+	TLS            TLSConfig
+	FeatureFlags   FeatureFlagsConfig
+	PageToken      PageTokenConfig
+	PageSize       PageSizeConfig
+	Audit          AuditConfig
+	Telemetry      TelemetryConfig
+	Archive        ArchiveConfig
+	Instances      InstanceProviderConfig
 }
 
-// Load reads configuration from environment variables
-func Load() (*Config, error) {
+// Load reads configuration from environment variables and, when configFile or the
+// CONFIG_FILE environment variable names one, a YAML, JSON or TOML config file setting the
+// same variables. Environment variables override the file: its settings are set as the
+// environment variables that are not already set. Every unknown setting and value that
+// cannot be parsed is reported in a single ValidationError.
+func Load(configFile string) (*Config, error) {
 	cfg := &Config{
 		Database: &DBConfig{},
 	}
-	if err := envconfig.Process("", &cfg.Service); err != nil {
-		return nil, err
+	sections := []any{
+		&cfg.Service,
+		&cfg.APIAuth,
+		cfg.Database,
+		&cfg.PolicyDefaults,
+		&cfg.Evaluation,
+		&cfg.Quota,
+		&cfg.RateLimit,
+		&cfg.ExtAuthz,
+		&cfg.EngineAuthz,
+		&cfg.TLS,
+		&cfg.FeatureFlags,
+		&cfg.PageToken,
+		&cfg.PageSize,
+		&cfg.Audit,
+		&cfg.Telemetry,
+		&cfg.Archive,
+		&cfg.Instances,
 	}
-	if err := envconfig.Process("", cfg.Database); err != nil {
-		return nil, err
+
+	if configFile == "" {
+		configFile = os.Getenv(FileEnv)
+	}
+	var problems []error
+	if configFile != "" {
+		settings, err := readFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		problems = applyFile(configFile, settings, variables(sections))
+	}
+	if problems = append(problems, validate(sections)...); len(problems) > 0 {
+		return nil, &ValidationError{Errors: problems}
+	}
+
+	for _, section := range sections {
+		if err := envconfig.Process("", section); err != nil {
+			return nil, err
+		}
 	}
 	return cfg, nil
 }
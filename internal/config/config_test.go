@@ -0,0 +1,121 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Load", func() {
+	// writeFile writes a config file and unsets the variables it sets once the test is done,
+	// as Load sets them in the environment
+	writeFile := func(name, content string, variables ...string) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		for _, variable := range variables {
+			DeferCleanup(os.Unsetenv, variable)
+		}
+		return path
+	}
+
+	It("uses the defaults without a config file", func() {
+		cfg, err := config.Load("")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Service.LogLevel).To(Equal("info"))
+		Expect(cfg.Evaluation.PolicyTimeout).To(Equal(time.Second))
+	})
+
+	It("reads settings from a YAML file", func() {
+		path := writeFile("policy-manager.yaml", `
+LOG_LEVEL: debug
+EVALUATION_POLICY_TIMEOUT: 250ms
+EVALUATION_PHASE_CONCURRENCY: 8
+EVALUATION_PROTECTED_FIELDS: [metadata.owner, billing_account]
+EVALUATION_DEFAULT_REQUEST_LABELS:
+  cluster: prod-east
+  environment: production
+`, "LOG_LEVEL", "EVALUATION_POLICY_TIMEOUT", "EVALUATION_PHASE_CONCURRENCY", "EVALUATION_PROTECTED_FIELDS", "EVALUATION_DEFAULT_REQUEST_LABELS")
+
+		cfg, err := config.Load(path)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Service.LogLevel).To(Equal("debug"))
+		Expect(cfg.Evaluation.PolicyTimeout).To(Equal(250 * time.Millisecond))
+		Expect(cfg.Evaluation.PhaseConcurrency).To(Equal(8))
+		Expect(cfg.Evaluation.ProtectedFields).To(Equal([]string{"metadata.owner", "billing_account"}))
+		Expect(cfg.Evaluation.DefaultRequestLabels).To(Equal(map[string]string{"cluster": "prod-east", "environment": "production"}))
+	})
+
+	It("reads settings from a TOML file named by CONFIG_FILE", func() {
+		path := writeFile("policy-manager.toml", `
+LOG_LEVEL = "warn"
+RATE_LIMIT_PUBLIC_RPS = 2.5
+AUDIT_ENABLED = true
+`, "LOG_LEVEL", "RATE_LIMIT_PUBLIC_RPS", "AUDIT_ENABLED")
+		GinkgoT().Setenv(config.FileEnv, path)
+
+		cfg, err := config.Load("")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Service.LogLevel).To(Equal("warn"))
+		Expect(cfg.RateLimit.PublicRPS).To(Equal(2.5))
+		Expect(cfg.Audit.Enabled).To(BeTrue())
+	})
+
+	It("lets environment variables override the file", func() {
+		path := writeFile("policy-manager.yaml", "LOG_LEVEL: debug\nEVALUATION_SESSION_TTL: 5m\n", "EVALUATION_SESSION_TTL")
+		GinkgoT().Setenv("LOG_LEVEL", "error")
+
+		cfg, err := config.Load(path)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Service.LogLevel).To(Equal("error"))
+		Expect(cfg.Evaluation.SessionTTL).To(Equal(5 * time.Minute))
+	})
+
+	It("reads the changes of the file when loaded again", func() {
+		path := writeFile("policy-manager.yaml", "LOG_LEVEL: debug\nAPI_CACHE_MAX_AGE: 1m\n", "LOG_LEVEL", "API_CACHE_MAX_AGE")
+		_, err := config.Load(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(path, []byte("LOG_LEVEL: warn\n"), 0o600)).To(Succeed())
+
+		cfg, err := config.Load(path)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Service.LogLevel).To(Equal("warn"))
+		Expect(cfg.Service.CacheMaxAge).To(BeZero())
+	})
+
+	It("lists every unknown setting and invalid value", func() {
+		path := writeFile("policy-manager.yaml", `
+EVALUATON_POLICY_TIMEOUT: 1s
+EVALUATION_SESSION_TTL: soon
+EVALUATION_MAX_SESSIONS: many
+`, "EVALUATION_SESSION_TTL", "EVALUATION_MAX_SESSIONS")
+		GinkgoT().Setenv("EVALUATION_WARMUP", "maybe")
+
+		_, err := config.Load(path)
+
+		var validationErr *config.ValidationError
+		Expect(errors.As(err, &validationErr)).To(BeTrue())
+		Expect(validationErr.Errors).To(HaveLen(4))
+		Expect(err.Error()).To(ContainSubstring(path + ": unknown setting EVALUATON_POLICY_TIMEOUT"))
+		Expect(err.Error()).To(ContainSubstring("EVALUATION_SESSION_TTL: cannot parse 'soon' as time.Duration"))
+		Expect(err.Error()).To(ContainSubstring("EVALUATION_MAX_SESSIONS: cannot parse 'many' as int"))
+		Expect(err.Error()).To(ContainSubstring("EVALUATION_WARMUP: cannot parse 'maybe' as bool"))
+	})
+
+	It("rejects config files of other formats", func() {
+		path := writeFile("policy-manager.ini", "LOG_LEVEL=debug\n")
+
+		_, err := config.Load(path)
+
+		Expect(err).To(MatchError(ContainSubstring("must be a .yaml, .yml, .json or .toml file")))
+	})
+})
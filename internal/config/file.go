@@ -0,0 +1,179 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/kelseyhightower/envconfig"
+	"sigs.k8s.io/yaml"
+)
+
+// FileEnv names the environment variable with the path of the config file read when none is
+// given to Load
+const FileEnv = "CONFIG_FILE"
+
+// ValidationError lists every setting of the environment or the config file that is not valid
+type ValidationError struct {
+	Errors []error
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return "invalid configuration: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return e.Errors
+}
+
+// readFile reads the settings of a YAML, JSON or TOML config file, keyed by environment
+// variable name
+func readFile(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var settings map[string]any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		err = yaml.Unmarshal(data, &settings)
+	case ".toml":
+		err = toml.Unmarshal(data, &settings)
+	default:
+		return nil, fmt.Errorf("config file %s must be a .yaml, .yml, .json or .toml file", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return settings, nil
+}
+
+// fileVariables are the values of the environment variables the last config file applied
+// set, which the next one applied replaces when the configuration is loaded again
+var (
+	fileVariablesMu sync.Mutex
+	fileVariables   = map[string]string{}
+)
+
+// applyFile sets the environment variables of the settings read from the config file at path
+// that the environment does not set, so environment variables override the file. It returns
+// an error for each setting that is not a known variable or has a value of the wrong shape.
+func applyFile(path string, settings map[string]any, known []string) []error {
+	fileVariablesMu.Lock()
+	defer fileVariablesMu.Unlock()
+	for name, value := range fileVariables {
+		// Variables set since by someone else are theirs
+		if current, set := os.LookupEnv(name); set && current == value {
+			_ = os.Unsetenv(name)
+		}
+	}
+	clear(fileVariables)
+
+	var problems []error
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		if !slices.Contains(known, name) {
+			problems = append(problems, fmt.Errorf("%s: unknown setting %s", path, name))
+			continue
+		}
+		value, err := settingValue(settings[name])
+		if err != nil {
+			problems = append(problems, fmt.Errorf("%s: %s: %w", path, name, err))
+			continue
+		}
+		if _, set := os.LookupEnv(name); set {
+			continue
+		}
+		if err := os.Setenv(name, value); err != nil {
+			problems = append(problems, fmt.Errorf("%s: %s: %w", path, name, err))
+			continue
+		}
+		fileVariables[name] = value
+	}
+	return problems
+}
+
+// settingValue returns a config file value in the format of its environment variable: lists
+// are comma-separated and maps are comma-separated key:value pairs sorted by key
+func settingValue(value any) (string, error) {
+	switch value := value.(type) {
+	case string:
+		return value, nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case int64:
+		return strconv.FormatInt(value, 10), nil
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64), nil
+	case []any:
+		items := make([]string, len(value))
+		for i, item := range value {
+			var err error
+			if items[i], err = settingValue(item); err != nil {
+				return "", err
+			}
+		}
+		return strings.Join(items, ","), nil
+	case map[string]any:
+		pairs := make([]string, 0, len(value))
+		for _, key := range slices.Sorted(maps.Keys(value)) {
+			item, err := settingValue(value[key])
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, key+":"+item)
+		}
+		return strings.Join(pairs, ","), nil
+	case nil:
+		return "", errors.New("value must not be null")
+	}
+	return "", fmt.Errorf("unsupported value of type %T", value)
+}
+
+// variables returns the names of the environment variables of sections
+func variables(sections []any) []string {
+	var names []string
+	for _, section := range sections {
+		sectionType := reflect.TypeOf(section).Elem()
+		for i := range sectionType.NumField() {
+			if name := sectionType.Field(i).Tag.Get("envconfig"); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// validate returns an error for each environment variable of sections whose value cannot be
+// parsed. envconfig stops at the first one, so each field is processed on its own.
+func validate(sections []any) []error {
+	var problems []error
+	for _, section := range sections {
+		sectionType := reflect.TypeOf(section).Elem()
+		for i := range sectionType.NumField() {
+			field := sectionType.Field(i)
+			single := reflect.StructOf([]reflect.StructField{{Name: field.Name, Type: field.Type, Tag: field.Tag}})
+			err := envconfig.Process("", reflect.New(single).Interface())
+			var parseErr *envconfig.ParseError
+			switch {
+			case errors.As(err, &parseErr):
+				problems = append(problems, fmt.Errorf("%s: cannot parse '%s' as %s: %w",
+					parseErr.KeyName, parseErr.Value, parseErr.TypeName, parseErr.Err))
+			case err != nil:
+				problems = append(problems, err)
+			}
+		}
+	}
+	return problems
+}
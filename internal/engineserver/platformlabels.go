@@ -0,0 +1,27 @@
+package engineserver
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/handlers/engine"
+)
+
+// platformLabelsFromHeaders returns a middleware reading request labels from the headers of
+// each request; headers maps header names to label keys. Empty headers are ignored.
+func platformLabelsFromHeaders(headers map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			labels := map[string]string{}
+			for header, label := range headers {
+				if value := strings.TrimSpace(r.Header.Get(header)); value != "" {
+					labels[label] = value
+				}
+			}
+			if len(labels) > 0 {
+				r = r.WithContext(engine.ContextWithPlatformLabels(r.Context(), labels))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
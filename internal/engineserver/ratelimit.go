@@ -0,0 +1,21 @@
+package engineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
+)
+
+// refuseRateLimited writes the response to a request over its client's rate limit
+func refuseRateLimited(w http.ResponseWriter, _ *http.Request) {
+	detail := "Too many requests from this client; retry after the number of seconds in Retry-After"
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(engineserver.Error{
+		Type:   "about:blank",
+		Status: http.StatusTooManyRequests,
+		Title:  "Rate limit exceeded",
+		Detail: &detail,
+	})
+}
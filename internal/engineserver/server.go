@@ -3,17 +3,22 @@ package engineserver
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	engineserverapi "github.com/dcm-project/policy-manager/api/v1alpha1/engine"
 	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
+	"github.com/dcm-project/policy-manager/internal/authz"
 	"github.com/dcm-project/policy-manager/internal/config"
 	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
@@ -22,18 +27,50 @@ const gracefulShutdownTimeout = 5 * time.Second
 
 // Server wraps the HTTP server for the engine API
 type Server struct {
-	config   *config.Config
-	listener net.Listener
-	handler  engineserver.StrictServerInterface
+	config          *config.Config
+	listener        net.Listener
+	handler         engineserver.StrictServerInterface
+	extAuthzHandler http.Handler
+	tlsConfig       *tls.Config
+	rateLimiter     *ratelimit.Limiter
+}
+
+// Option configures optional engine server features
+type Option func(*Server)
+
+// WithExtAuthzHandler mounts an Envoy ext_authz adapter under the configured EXT_AUTHZ_PATH_PREFIX
+func WithExtAuthzHandler(handler http.Handler) Option {
+	return func(s *Server) {
+		s.extAuthzHandler = handler
+	}
+}
+
+// WithTLSConfig serves the engine API over TLS, verifying or requiring client certificates
+// as the configuration's ClientAuth selects
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(s *Server) {
+		s.tlsConfig = tlsConfig
+	}
+}
+
+// WithRateLimiter refuses the requests of clients over their rate, other than metrics scrapes
+func WithRateLimiter(limiter *ratelimit.Limiter) Option {
+	return func(s *Server) {
+		s.rateLimiter = limiter
+	}
 }
 
 // New creates a new engine server instance
-func New(cfg *config.Config, listener net.Listener, handler engineserver.StrictServerInterface) *Server {
-	return &Server{
+func New(cfg *config.Config, listener net.Listener, handler engineserver.StrictServerInterface, opts ...Option) *Server {
+	s := &Server{
 		config:   cfg,
 		listener: listener,
 		handler:  handler,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Run starts the HTTP server and blocks until shutdown
@@ -43,6 +80,10 @@ func (s *Server) Run(ctx context.Context) error {
 	router.Use(logging.RequestLogger)
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
+	if s.rateLimiter != nil {
+		router.Use(s.rateLimiter.Middleware(refuseRateLimited, "/metrics"))
+	}
+	router.Use(authz.Middleware)
 
 	swagger, err := engineserverapi.GetSwagger()
 	if err != nil {
@@ -54,11 +95,29 @@ func (s *Server) Run(ctx context.Context) error {
 		baseURL = swagger.Servers[0].URL
 	}
 
-	engineserver.HandlerFromMuxWithBaseURL(
-		engineserver.NewStrictHandler(s.handler, nil),
-		router,
-		baseURL,
-	)
+	router.Group(func(api chi.Router) {
+		// Only the engine API reads labels from headers; the headers ext_authz sees are the
+		// forwarded request's
+		if len(s.config.Evaluation.RequestLabelHeaders) > 0 {
+			api.Use(platformLabelsFromHeaders(s.config.Evaluation.RequestLabelHeaders))
+		}
+		engineserver.HandlerFromMuxWithBaseURL(
+			engineserver.NewStrictHandler(s.handler, nil),
+			api,
+			baseURL,
+		)
+	})
+
+	router.Handle("/metrics", metrics.Handler())
+
+	if s.extAuthzHandler != nil {
+		// Envoy appends the original request path to the prefix; strip it so the adapter sees that path
+		prefix := strings.TrimSuffix(s.config.ExtAuthz.PathPrefix, "/")
+		extAuthz := http.StripPrefix(prefix, s.extAuthzHandler)
+		router.Handle(prefix, extAuthz)
+		router.Handle(prefix+"/*", extAuthz)
+		slog.Info("Envoy ext_authz adapter enabled", "path_prefix", prefix)
+	}
 
 	srv := &http.Server{Handler: router}
 
@@ -73,8 +132,13 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	}()
 
-	slog.Info("Engine API server started", "address", s.listener.Addr().String())
-	if err := srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	listener := s.listener
+	if s.tlsConfig != nil {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+
+	slog.Info("Engine API server started", "address", s.listener.Addr().String(), "tls", s.tlsConfig != nil)
+	if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("failed to serve engine API server: %w", err)
 	}
 
@@ -0,0 +1,162 @@
+// Package events provides typed domain events and an in-process bus for reacting to them.
+//
+// Services publish an event after a change has been committed; subscribers implement the
+// reactions (cache invalidation, audit, notifications) so new integrations are added by
+// subscribing rather than by extending the service call chains. Steps that can veto a
+// change, such as compiling policies into the engine, stay part of the operation itself.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+// Event is a domain event published on a Bus
+type Event interface {
+	// EventType names the event for logging, e.g. "PolicyCreated"
+	EventType() string
+}
+
+// PolicyCreated is published after a policy has been stored and compiled
+type PolicyCreated struct {
+	Policy v1alpha1.Policy
+}
+
+// PolicyUpdated is published after a policy update has been stored and compiled
+type PolicyUpdated struct {
+	Previous v1alpha1.Policy
+	Policy   v1alpha1.Policy
+}
+
+// PolicyDeleted is published after a policy has been deleted
+type PolicyDeleted struct {
+	PolicyID string
+}
+
+// EvaluationRejected is published when a policy rejects an evaluation request
+type EvaluationRejected struct {
+	PolicyID      string
+	Reason        string
+	RequestLabels map[string]string
+	// RequestContext is the caller-supplied context of the request by field name, nil when
+	// the request had none
+	RequestContext map[string]string
+	// InputSpec is the service instance spec submitted for evaluation, without the denied
+	// spec fields that were stripped; subscribers must not modify it
+	InputSpec map[string]any
+}
+
+// EvaluationCompleted is published when an evaluation request is approved, with or without changes
+type EvaluationCompleted struct {
+	Status            string
+	SelectedProvider  string
+	PoliciesEvaluated int
+	RequestLabels     map[string]string
+	// RequestContext is the caller-supplied context of the request by field name, nil when
+	// the request had none
+	RequestContext map[string]string
+	// InputSpec is the service instance spec submitted for evaluation, without the denied
+	// spec fields that were stripped; subscribers must not modify it
+	InputSpec map[string]any
+	// FailedOpen is set when the request was approved unchanged because policies were unavailable
+	FailedOpen bool
+}
+
+func (PolicyCreated) EventType() string       { return "PolicyCreated" }
+func (PolicyUpdated) EventType() string       { return "PolicyUpdated" }
+func (PolicyDeleted) EventType() string       { return "PolicyDeleted" }
+func (EvaluationRejected) EventType() string  { return "EvaluationRejected" }
+func (EvaluationCompleted) EventType() string { return "EvaluationCompleted" }
+
+// Handler reacts to a published event
+type Handler func(ctx context.Context, event Event)
+
+// Subscriber delivers events to the handlers subscribed to it: a Bus, or a Queue
+type Subscriber interface {
+	Subscribe(handler Handler) (unsubscribe func())
+}
+
+var (
+	_ Subscriber = (*Bus)(nil)
+	_ Subscriber = (*Queue)(nil)
+)
+
+// Bus delivers published events to its subscribers. Delivery is synchronous and in
+// subscription order, so subscribers should return quickly and hand long-running work
+// off to their own goroutines, or subscribe through a Queue. A nil *Bus accepts
+// subscriptions and publications as no-ops.
+type Bus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers []subscriber
+}
+
+type subscriber struct {
+	id      int
+	handler Handler
+}
+
+// NewBus creates a bus without subscribers
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe registers handler for every event and returns a function that removes it
+func (b *Bus) Subscribe(handler Handler) (unsubscribe func()) {
+	if b == nil {
+		return func() {}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.nextID++
+	id := b.nextID
+	b.subscribers = append(b.subscribers, subscriber{id: id, handler: handler})
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for i, s := range b.subscribers {
+			if s.id == id {
+				b.subscribers = append(b.subscribers[:i:i], b.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Subscribe registers handler for events of type E only
+func Subscribe[E Event](b *Bus, handler func(ctx context.Context, event E)) (unsubscribe func()) {
+	return b.Subscribe(func(ctx context.Context, event Event) {
+		if typed, ok := event.(E); ok {
+			handler(ctx, typed)
+		}
+	})
+}
+
+// Publish delivers event to every subscriber. A panicking subscriber is logged and does
+// not prevent delivery to the others or affect the publisher.
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+	b.mu.RLock()
+	subscribers := b.subscribers
+	b.mu.RUnlock()
+	for _, s := range subscribers {
+		deliver(ctx, s.handler, event)
+	}
+}
+
+func deliver(ctx context.Context, handler Handler, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			logging.FromContext(ctx).Error("Event subscriber panicked",
+				"event", event.EventType(),
+				"error", fmt.Sprint(r))
+		}
+	}()
+	handler(ctx, event)
+}
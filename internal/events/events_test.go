@@ -0,0 +1,76 @@
+package events_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bus", func() {
+	var (
+		bus *events.Bus
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		bus = events.NewBus()
+		ctx = context.Background()
+	})
+
+	It("delivers events to subscribers in subscription order", func() {
+		var received []string
+		bus.Subscribe(func(_ context.Context, event events.Event) {
+			received = append(received, "first:"+event.EventType())
+		})
+		bus.Subscribe(func(_ context.Context, event events.Event) {
+			received = append(received, "second:"+event.EventType())
+		})
+
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+
+		Expect(received).To(Equal([]string{"first:PolicyDeleted", "second:PolicyDeleted"}))
+	})
+
+	It("delivers only the subscribed type to typed subscribers", func() {
+		var rejected []events.EvaluationRejected
+		events.Subscribe(bus, func(_ context.Context, event events.EvaluationRejected) {
+			rejected = append(rejected, event)
+		})
+
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+		bus.Publish(ctx, events.EvaluationRejected{PolicyID: "p2", Reason: "region not allowed"})
+
+		Expect(rejected).To(ConsistOf(events.EvaluationRejected{PolicyID: "p2", Reason: "region not allowed"}))
+	})
+
+	It("stops delivering after unsubscribe", func() {
+		count := 0
+		unsubscribe := bus.Subscribe(func(context.Context, events.Event) { count++ })
+
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+		unsubscribe()
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+
+		Expect(count).To(Equal(1))
+	})
+
+	It("keeps delivering when a subscriber panics", func() {
+		delivered := false
+		bus.Subscribe(func(context.Context, events.Event) { panic("boom") })
+		bus.Subscribe(func(context.Context, events.Event) { delivered = true })
+
+		Expect(func() { bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"}) }).NotTo(Panic())
+		Expect(delivered).To(BeTrue())
+	})
+
+	It("treats a nil bus as a no-op", func() {
+		var nilBus *events.Bus
+		unsubscribe := nilBus.Subscribe(func(context.Context, events.Event) {})
+		Expect(func() {
+			nilBus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+			unsubscribe()
+		}).NotTo(Panic())
+	})
+})
@@ -0,0 +1,177 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+// Overflow selects what a Queue does with an event published while it is full
+type Overflow string
+
+const (
+	// OverflowDrop drops the events published while the queue is full
+	OverflowDrop Overflow = "drop"
+	// OverflowSample drops a growing share of the events published once the queue is half
+	// full, and every event while it is full, so a sample keeps flowing as the sink slows down
+	OverflowSample Overflow = "sample"
+	// OverflowBlock makes the publisher wait for room, giving up when its context is done;
+	// no event is dropped while publishers can wait, at the cost of their latency
+	OverflowBlock Overflow = "block"
+)
+
+// ParseOverflow parses an overflow strategy
+func ParseOverflow(overflow string) (Overflow, error) {
+	switch Overflow(overflow) {
+	case OverflowDrop, OverflowSample, OverflowBlock:
+		return Overflow(overflow), nil
+	}
+	return "", fmt.Errorf("queue overflow strategy must be one of: drop, sample, block (got '%s')", overflow)
+}
+
+var (
+	queueDroppedTotal = metrics.NewCounterVec(
+		"policy_manager_event_queue_dropped_total",
+		"Events a sink's queue dropped, by sink, event and reason: full, sampled, or cancelled when the publisher stopped waiting",
+		"sink", "event", "reason",
+	)
+	queueDepth = metrics.NewGaugeVec(
+		"policy_manager_event_queue_depth",
+		"Events waiting in a sink's queue",
+		"sink",
+	)
+)
+
+// queued is an event waiting in a Queue, with the context it was published with
+type queued struct {
+	ctx   context.Context
+	event Event
+}
+
+// Queue decouples a slow sink, such as the audit log, from the publishers of the events it
+// consumes. Enqueue, subscribed to a Bus, holds published events in a bounded queue and
+// returns at once; Run delivers them in order to the queue's subscribers from its own
+// goroutine. Events are delivered after their publisher returned, so publishers must not
+// modify them afterwards.
+type Queue struct {
+	sink     string
+	overflow Overflow
+	items    chan queued
+	sample   func() float64
+	out      *Bus
+
+	stopping chan struct{} // closed when Run stops, waking publishers waiting for room
+	mu       sync.RWMutex
+	stopped  bool // set once Run no longer delivers; later events are delivered by Enqueue
+}
+
+// NewQueue creates a queue of size events for sink, named in its metrics, handling
+// overflow as overflow says
+func NewQueue(sink string, size int, overflow string) (*Queue, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("queue size must be positive (got %d)", size)
+	}
+	strategy, err := ParseOverflow(overflow)
+	if err != nil {
+		return nil, err
+	}
+	return &Queue{
+		sink:     sink,
+		overflow: strategy,
+		items:    make(chan queued, size),
+		sample:   rand.Float64,
+		out:      NewBus(),
+		stopping: make(chan struct{}),
+	}, nil
+}
+
+// Subscribe registers handler for the events the queue delivers and returns a function that
+// removes it
+func (q *Queue) Subscribe(handler Handler) (unsubscribe func()) {
+	return q.out.Subscribe(handler)
+}
+
+// Enqueue queues event for delivery. It only waits with the block strategy, while the
+// queue is full. The event is delivered with ctx's values but not its cancellation, since
+// its publisher may be done by then.
+func (q *Queue) Enqueue(ctx context.Context, event Event) {
+	item := queued{ctx: context.WithoutCancel(ctx), event: event}
+	if reason, deliverNow := q.enqueue(ctx, item); deliverNow {
+		q.out.Publish(item.ctx, event)
+	} else if reason != "" {
+		queueDroppedTotal.Inc(q.sink, event.EventType(), reason)
+	}
+}
+
+// enqueue queues item, or returns why it was dropped, or that Run has stopped and the caller
+// delivers it
+func (q *Queue) enqueue(ctx context.Context, item queued) (reason string, deliverNow bool) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.stopped {
+		return "", true
+	}
+	defer q.updateDepth()
+
+	if q.overflow == OverflowSample {
+		// Past half full, keep a share of the events falling from all to none as it fills
+		half := cap(q.items) / 2
+		if depth := len(q.items); depth >= half && depth < cap(q.items) &&
+			q.sample() >= float64(cap(q.items)-depth)/float64(cap(q.items)-half) {
+			return "sampled", false
+		}
+	}
+	select {
+	case q.items <- item:
+		return "", false
+	default:
+	}
+	if q.overflow != OverflowBlock {
+		return "full", false
+	}
+	select {
+	case q.items <- item:
+		return "", false
+	case <-ctx.Done():
+		return "cancelled", false
+	case <-q.stopping:
+		return "", true
+	}
+}
+
+// Run delivers the queued events until ctx is cancelled, then delivers those still queued
+// before it returns. Events queued afterwards are delivered by Enqueue itself.
+func (q *Queue) Run(ctx context.Context) error {
+	for {
+		select {
+		case item := <-q.items:
+			q.deliver(item)
+		case <-ctx.Done():
+			close(q.stopping)
+			// Wait for the publishers queueing; the ones that come later deliver themselves
+			q.mu.Lock()
+			q.stopped = true
+			q.mu.Unlock()
+			for {
+				select {
+				case item := <-q.items:
+					q.deliver(item)
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+func (q *Queue) deliver(item queued) {
+	q.updateDepth()
+	q.out.Publish(item.ctx, item.event)
+}
+
+func (q *Queue) updateDepth() {
+	queueDepth.Set(float64(len(q.items)), q.sink)
+}
@@ -0,0 +1,148 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue", func() {
+	var (
+		ctx      context.Context
+		mu       sync.Mutex
+		received []string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		received = nil
+	})
+
+	newQueue := func(size int, overflow Overflow) *Queue {
+		q, err := NewQueue("test", size, string(overflow))
+		Expect(err).NotTo(HaveOccurred())
+		q.Subscribe(func(_ context.Context, event Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, event.(PolicyDeleted).PolicyID)
+		})
+		return q
+	}
+	// drain runs q until it has delivered what it holds
+	drain := func(q *Queue) {
+		stopped, cancel := context.WithCancel(ctx)
+		cancel()
+		Expect(q.Run(stopped)).To(Succeed())
+	}
+	delivered := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, received...)
+	}
+
+	It("rejects invalid configurations", func() {
+		_, err := NewQueue("test", 0, "drop")
+		Expect(err).To(MatchError(ContainSubstring("must be positive")))
+		_, err = NewQueue("test", 10, "wait")
+		Expect(err).To(MatchError(ContainSubstring("drop, sample, block")))
+	})
+
+	It("delivers events in order without making publishers wait for the subscribers", func() {
+		q := newQueue(10, OverflowDrop)
+		release := make(chan struct{})
+		q.Subscribe(func(context.Context, Event) { <-release })
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- q.Run(runCtx) }()
+
+		for _, id := range []string{"p1", "p2", "p3"} {
+			q.Enqueue(ctx, PolicyDeleted{PolicyID: id})
+		}
+		close(release)
+		Eventually(delivered).Should(Equal([]string{"p1", "p2", "p3"}))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("delivers with the values but not the cancellation of the publisher's context", func() {
+		q, err := NewQueue("test", 1, "drop")
+		Expect(err).NotTo(HaveOccurred())
+		var deliveredCtx context.Context
+		q.Subscribe(func(ctx context.Context, _ Event) { deliveredCtx = ctx })
+
+		type key struct{}
+		publisherCtx, cancel := context.WithCancel(context.WithValue(ctx, key{}, "request"))
+		q.Enqueue(publisherCtx, PolicyDeleted{PolicyID: "p1"})
+		cancel()
+		drain(q)
+
+		Expect(deliveredCtx.Value(key{})).To(Equal("request"))
+		Expect(deliveredCtx.Err()).NotTo(HaveOccurred())
+	})
+
+	It("drops the events published while it is full", func() {
+		q := newQueue(2, OverflowDrop)
+		for _, id := range []string{"p1", "p2", "p3"} {
+			q.Enqueue(ctx, PolicyDeleted{PolicyID: id})
+		}
+		drain(q)
+		Expect(delivered()).To(Equal([]string{"p1", "p2"}))
+	})
+
+	It("samples the events published past half full", func() {
+		q := newQueue(4, OverflowSample)
+		// An event is kept when the draw is below the share of the room left past half full:
+		// at 2 of 4 every event, at 3 of 4 half of them
+		draws := []float64{0.9, 0.6, 0.4}
+		q.sample = func() float64 {
+			draw := draws[0]
+			draws = draws[1:]
+			return draw
+		}
+		for _, id := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+			q.Enqueue(ctx, PolicyDeleted{PolicyID: id})
+		}
+		drain(q)
+		// p4 is sampled out and p6 dropped because the queue is full
+		Expect(delivered()).To(Equal([]string{"p1", "p2", "p3", "p5"}))
+		Expect(draws).To(BeEmpty())
+	})
+
+	It("makes publishers wait for room with the block strategy", func() {
+		q := newQueue(1, OverflowBlock)
+		q.Enqueue(ctx, PolicyDeleted{PolicyID: "p1"})
+		published := make(chan struct{})
+		go func() {
+			defer close(published)
+			q.Enqueue(ctx, PolicyDeleted{PolicyID: "p2"})
+		}()
+		Consistently(published, 50*time.Millisecond).ShouldNot(BeClosed())
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() { _ = q.Run(runCtx) }()
+		Eventually(published).Should(BeClosed())
+		Eventually(delivered).Should(Equal([]string{"p1", "p2"}))
+	})
+
+	It("gives up waiting for room when the publisher's context is done", func() {
+		q := newQueue(1, OverflowBlock)
+		q.Enqueue(ctx, PolicyDeleted{PolicyID: "p1"})
+		publisherCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		q.Enqueue(publisherCtx, PolicyDeleted{PolicyID: "p2"})
+		drain(q)
+		Expect(delivered()).To(Equal([]string{"p1"}))
+	})
+
+	It("delivers the events published after it stopped itself", func() {
+		q := newQueue(1, OverflowBlock)
+		q.Enqueue(ctx, PolicyDeleted{PolicyID: "p1"})
+		drain(q)
+		q.Enqueue(ctx, PolicyDeleted{PolicyID: "p2"})
+		Expect(delivered()).To(Equal([]string{"p1", "p2"}))
+	})
+})
@@ -0,0 +1,144 @@
+// Package featureflags gates features that ship dark until a deployment enables them.
+//
+// Packages declare their flags with Register at init time. The enabled state is resolved
+// once at startup from the registered defaults, the FEATURE_FLAGS_FILE file and the
+// FEATURE_FLAGS environment variable, in increasing precedence, and queried per request
+// through the context.
+package featureflags
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"sigs.k8s.io/yaml"
+)
+
+// Flag names a feature flag
+type Flag string
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[Flag]bool{} // flag -> default state
+
+	defaultSet atomic.Pointer[Set]
+)
+
+var enabledGauge = metrics.NewGaugeVec(
+	"policy_manager_feature_flag_enabled",
+	"Whether a feature flag is enabled (1) or disabled (0)",
+	"flag",
+)
+
+type contextKey struct{}
+
+// Register declares a flag with its default state. It panics when the name is already registered.
+func Register(name string, defaultValue bool) Flag {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	flag := Flag(name)
+	if _, ok := registry[flag]; ok {
+		panic(fmt.Sprintf("featureflags: duplicate flag %q", name))
+	}
+	registry[flag] = defaultValue
+	return flag
+}
+
+// Set is a resolved, immutable set of flag states
+type Set struct {
+	values map[Flag]bool
+}
+
+// Load resolves every registered flag from its default, the flags file and the explicit
+// overrides. Names that are not registered are logged and ignored, so configuration
+// naming a retired flag does not prevent startup.
+func Load(cfg config.FeatureFlagsConfig) (*Set, error) {
+	overrides := map[string]bool{}
+	if cfg.File != "" {
+		data, err := os.ReadFile(cfg.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read feature flags file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &overrides); err != nil {
+			return nil, fmt.Errorf("failed to parse feature flags file %s: %w", cfg.File, err)
+		}
+	}
+	maps.Copy(overrides, cfg.Flags)
+	return newSet(overrides), nil
+}
+
+func newSet(overrides map[string]bool) *Set {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	values := make(map[Flag]bool, len(registry))
+	maps.Copy(values, registry)
+	for name, enabled := range overrides {
+		if _, ok := registry[Flag(name)]; !ok {
+			slog.Warn("Ignoring unknown feature flag", "flag", name)
+			continue
+		}
+		values[Flag(name)] = enabled
+	}
+	return &Set{values: values}
+}
+
+// Enabled reports whether flag is enabled. A nil Set or an unresolved flag falls back to the registered default.
+func (s *Set) Enabled(flag Flag) bool {
+	if s != nil {
+		if enabled, ok := s.values[flag]; ok {
+			return enabled
+		}
+	}
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return registry[flag]
+}
+
+// All returns the state of every flag in the set, keyed by name
+func (s *Set) All() map[string]bool {
+	all := map[string]bool{}
+	if s == nil {
+		return all
+	}
+	for flag, enabled := range s.values {
+		all[string(flag)] = enabled
+	}
+	return all
+}
+
+// SetDefault makes s the set returned by FromContext for contexts without one, and
+// exports its state as the policy_manager_feature_flag_enabled gauge
+func SetDefault(s *Set) {
+	defaultSet.Store(s)
+	for name, enabled := range s.All() {
+		value := 0.0
+		if enabled {
+			value = 1
+		}
+		enabledGauge.Set(value, name)
+	}
+}
+
+// WithFlags returns a new context carrying s
+func WithFlags(ctx context.Context, s *Set) context.Context {
+	return context.WithValue(ctx, contextKey{}, s)
+}
+
+// FromContext returns the set stored in ctx, or the default set if none is present
+func FromContext(ctx context.Context) *Set {
+	if s, ok := ctx.Value(contextKey{}).(*Set); ok {
+		return s
+	}
+	return defaultSet.Load()
+}
+
+// Enabled reports whether flag is enabled for ctx
+func Enabled(ctx context.Context, flag Flag) bool {
+	return FromContext(ctx).Enabled(flag)
+}
@@ -0,0 +1,13 @@
+package featureflags
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFeatureFlags(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "FeatureFlags Suite")
+}
@@ -0,0 +1,92 @@
+package featureflags
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	darkFlag = Register("test_dark_feature", false)
+	liveFlag = Register("test_live_feature", true)
+)
+
+var _ = Describe("Load", func() {
+	It("uses the registered defaults without configuration", func() {
+		set, err := Load(config.FeatureFlagsConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(set.Enabled(darkFlag)).To(BeFalse())
+		Expect(set.Enabled(liveFlag)).To(BeTrue())
+	})
+
+	It("applies the file and lets explicit flags take precedence", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "flags.yaml")
+		Expect(os.WriteFile(file, []byte("test_dark_feature: true\ntest_live_feature: false\n"), 0o600)).To(Succeed())
+
+		set, err := Load(config.FeatureFlagsConfig{
+			File:  file,
+			Flags: map[string]bool{"test_live_feature": true},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(set.Enabled(darkFlag)).To(BeTrue())
+		Expect(set.Enabled(liveFlag)).To(BeTrue())
+	})
+
+	It("ignores unknown flags", func() {
+		set, err := Load(config.FeatureFlagsConfig{Flags: map[string]bool{"retired_feature": true}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(set.All()).NotTo(HaveKey("retired_feature"))
+	})
+
+	It("returns an error for an unreadable file", func() {
+		_, err := Load(config.FeatureFlagsConfig{File: filepath.Join(GinkgoT().TempDir(), "missing.yaml")})
+		Expect(err).To(MatchError(ContainSubstring("failed to read feature flags file")))
+	})
+
+	It("returns an error for a malformed file", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "flags.yaml")
+		Expect(os.WriteFile(file, []byte("test_dark_feature: [not, a, bool]\n"), 0o600)).To(Succeed())
+
+		_, err := Load(config.FeatureFlagsConfig{File: file})
+		Expect(err).To(MatchError(ContainSubstring("failed to parse feature flags file")))
+	})
+})
+
+var _ = Describe("Register", func() {
+	It("panics on duplicate names", func() {
+		Expect(func() { Register("test_dark_feature", true) }).To(Panic())
+	})
+})
+
+var _ = Describe("context lookup", func() {
+	It("prefers the set stored in the context over the default", func() {
+		defaultFlags, err := Load(config.FeatureFlagsConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		SetDefault(defaultFlags)
+		DeferCleanup(func() { defaultSet.Store(nil) })
+
+		override, err := Load(config.FeatureFlagsConfig{Flags: map[string]bool{"test_dark_feature": true}})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(Enabled(context.Background(), darkFlag)).To(BeFalse())
+		Expect(Enabled(WithFlags(context.Background(), override), darkFlag)).To(BeTrue())
+	})
+
+	It("falls back to the registered default without any set", func() {
+		Expect(Enabled(context.Background(), liveFlag)).To(BeTrue())
+	})
+
+	It("exports the default set as a gauge", func() {
+		set, err := Load(config.FeatureFlagsConfig{Flags: map[string]bool{"test_dark_feature": true}})
+		Expect(err).NotTo(HaveOccurred())
+		SetDefault(set)
+		DeferCleanup(func() { defaultSet.Store(nil) })
+
+		Expect(enabledGauge.Value("test_dark_feature")).To(Equal(float64(1)))
+		Expect(enabledGauge.Value("test_live_feature")).To(Equal(float64(1)))
+	})
+})
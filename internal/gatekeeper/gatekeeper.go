@@ -0,0 +1,479 @@
+// Package gatekeeper converts OPA Gatekeeper ConstraintTemplates and Constraints
+// into policy-manager policies.
+//
+// Conversion is best-effort: the template Rego is rewritten to Rego v1 and wrapped
+// in a generated main rule that evaluates the template's violation rules with
+// input.review.object bound to the request spec and input.parameters bound to the
+// Constraint parameters. Constructs that cannot be represented are reported as Issues.
+package gatekeeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/format"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	admissionTarget     = "admission.k8s.gatekeeper.sh"
+	constraintGroup     = "constraints.gatekeeper.sh/"
+	templateKind        = "ConstraintTemplate"
+	maxPolicyIDLength   = 63
+	generatedRulePrefix = "_gatekeeper_"
+)
+
+// Severity classifies a conversion issue
+type Severity string
+
+const (
+	// SeverityWarning means a policy was produced but may behave differently than in Gatekeeper
+	SeverityWarning Severity = "WARNING"
+	// SeverityError means no policy was produced for the resource
+	SeverityError Severity = "ERROR"
+)
+
+// Issue reports a construct that could not be converted faithfully
+type Issue struct {
+	Source   string // <kind>/<name> of the Gatekeeper resource
+	Severity Severity
+	Message  string
+}
+
+// Policy is a policy converted from a Gatekeeper resource
+type Policy struct {
+	ID            string
+	DisplayName   string
+	Description   string
+	LabelSelector map[string]string
+	Enabled       bool
+	RegoCode      string
+}
+
+// Result holds the converted policies and the conversion report
+type Result struct {
+	Policies []Policy
+	Issues   []Issue
+}
+
+type objectMeta struct {
+	Name        string            `json:"name"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type resource struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   objectMeta        `json:"metadata"`
+	Spec       json.RawMessage   `json:"spec"`
+	Items      []json.RawMessage `json:"items"`
+}
+
+type templateSpec struct {
+	CRD struct {
+		Spec struct {
+			Names struct {
+				Kind string `json:"kind"`
+			} `json:"names"`
+		} `json:"spec"`
+	} `json:"crd"`
+	Targets []struct {
+		Target string   `json:"target"`
+		Rego   string   `json:"rego"`
+		Libs   []string `json:"libs"`
+		Code   []struct {
+			Engine string `json:"engine"`
+		} `json:"code"`
+	} `json:"targets"`
+}
+
+type constraintSpec struct {
+	EnforcementAction string         `json:"enforcementAction"`
+	Parameters        map[string]any `json:"parameters"`
+	Match             struct {
+		Kinds              []any          `json:"kinds"`
+		Namespaces         []string       `json:"namespaces"`
+		ExcludedNamespaces []string       `json:"excludedNamespaces"`
+		Scope              string         `json:"scope"`
+		Name               string         `json:"name"`
+		NamespaceSelector  map[string]any `json:"namespaceSelector"`
+		LabelSelector      *struct {
+			MatchLabels      map[string]string `json:"matchLabels"`
+			MatchExpressions []any             `json:"matchExpressions"`
+		} `json:"labelSelector"`
+	} `json:"match"`
+}
+
+// template is a parsed ConstraintTemplate ready to be combined with Constraints
+type template struct {
+	name        string
+	kind        string
+	description string
+	module      *ast.Module // nil when the template cannot be converted
+	used        bool
+}
+
+type converter struct {
+	result    Result
+	templates map[string]*template
+	order     []*template
+}
+
+// Convert converts a YAML or JSON stream of ConstraintTemplates and Constraints.
+// An error is returned only when the input cannot be parsed or contains no Gatekeeper resources.
+func Convert(data []byte) (*Result, error) {
+	resources, err := parseResources(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &converter{templates: map[string]*template{}}
+	var constraints []resource
+	for _, r := range resources {
+		switch {
+		case r.Kind == templateKind:
+			if err := c.addTemplate(r); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(r.APIVersion, constraintGroup):
+			constraints = append(constraints, r)
+		default:
+			c.issue(r.Kind, r.Metadata.Name, SeverityWarning, "not a Gatekeeper ConstraintTemplate or Constraint; ignored")
+		}
+	}
+	if len(c.order) == 0 && len(constraints) == 0 {
+		return nil, fmt.Errorf("no ConstraintTemplates or Constraints found")
+	}
+
+	for _, r := range constraints {
+		if err := c.convertConstraint(r); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range c.order {
+		if t.used || t.module == nil {
+			continue
+		}
+		source := templateKind + "/" + t.name
+		c.issue(templateKind, t.name, SeverityWarning, "no Constraint found for this template; converted with empty parameters")
+		c.addPolicy(source, t, pathSafeID(t.kind), t.kind, map[string]any{}, nil, true)
+	}
+
+	return &c.result, nil
+}
+
+func (c *converter) issue(kind, name string, severity Severity, format string, args ...any) {
+	c.result.Issues = append(c.result.Issues, Issue{
+		Source:   kind + "/" + name,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// addTemplate parses a ConstraintTemplate and checks its Rego for unsupported constructs
+func (c *converter) addTemplate(r resource) error {
+	var spec templateSpec
+	if err := json.Unmarshal(r.Spec, &spec); err != nil {
+		return fmt.Errorf("invalid ConstraintTemplate '%s': %w", r.Metadata.Name, err)
+	}
+
+	t := &template{
+		name:        r.Metadata.Name,
+		kind:        spec.CRD.Spec.Names.Kind,
+		description: r.Metadata.Annotations["description"],
+	}
+	if t.kind == "" {
+		c.issue(templateKind, t.name, SeverityError, "spec.crd.spec.names.kind is required")
+		return nil
+	}
+	c.templates[t.kind] = t
+	c.order = append(c.order, t)
+
+	var regoCode string
+	for _, target := range spec.Targets {
+		if target.Target != admissionTarget {
+			c.issue(templateKind, t.name, SeverityWarning, "target '%s' is not supported; ignored", target.Target)
+			continue
+		}
+		if len(target.Code) > 0 {
+			c.issue(templateKind, t.name, SeverityWarning, "code engines (e.g. '%s') are not supported; only the Rego source is converted", target.Code[0].Engine)
+		}
+		if len(target.Libs) > 0 {
+			c.issue(templateKind, t.name, SeverityError, "template libs are not supported")
+			return nil
+		}
+		regoCode = target.Rego
+	}
+	if strings.TrimSpace(regoCode) == "" {
+		c.issue(templateKind, t.name, SeverityError, "no Rego source for target '%s'", admissionTarget)
+		return nil
+	}
+
+	module, err := parseTemplateRego(t.name, regoCode)
+	if err != nil {
+		c.issue(templateKind, t.name, SeverityError, "invalid Rego: %v", err)
+		return nil
+	}
+	fatal := false
+	for _, issue := range checkModule(module) {
+		c.issue(templateKind, t.name, issue.Severity, "%s", issue.Message)
+		fatal = fatal || issue.Severity == SeverityError
+	}
+	if !fatal {
+		t.module = module
+	}
+	return nil
+}
+
+// convertConstraint converts a Constraint using the template of the same kind
+func (c *converter) convertConstraint(r resource) error {
+	var spec constraintSpec
+	if len(r.Spec) > 0 {
+		if err := json.Unmarshal(r.Spec, &spec); err != nil {
+			return fmt.Errorf("invalid Constraint '%s/%s': %w", r.Kind, r.Metadata.Name, err)
+		}
+	}
+
+	t, ok := c.templates[r.Kind]
+	if !ok {
+		c.issue(r.Kind, r.Metadata.Name, SeverityError, "no ConstraintTemplate defines kind '%s'", r.Kind)
+		return nil
+	}
+	t.used = true
+	if t.module == nil {
+		c.issue(r.Kind, r.Metadata.Name, SeverityError, "ConstraintTemplate '%s' could not be converted", t.name)
+		return nil
+	}
+
+	enabled := true
+	switch spec.EnforcementAction {
+	case "", "deny":
+	case "dryrun", "warn":
+		enabled = false
+		c.issue(r.Kind, r.Metadata.Name, SeverityWarning, "enforcementAction '%s' has no equivalent; the policy is created disabled", spec.EnforcementAction)
+	default:
+		c.issue(r.Kind, r.Metadata.Name, SeverityWarning, "enforcementAction '%s' is not supported; treated as deny", spec.EnforcementAction)
+	}
+
+	match := spec.Match
+	var labelSelector map[string]string
+	if match.LabelSelector != nil {
+		labelSelector = match.LabelSelector.MatchLabels
+		if len(match.LabelSelector.MatchExpressions) > 0 {
+			c.issue(r.Kind, r.Metadata.Name, SeverityWarning, "match.labelSelector.matchExpressions is not supported; only matchLabels is converted")
+		}
+	}
+	ignored := map[string]bool{
+		"kinds":              len(match.Kinds) > 0,
+		"namespaces":         len(match.Namespaces) > 0,
+		"excludedNamespaces": len(match.ExcludedNamespaces) > 0,
+		"scope":              match.Scope != "",
+		"name":               match.Name != "",
+		"namespaceSelector":  len(match.NamespaceSelector) > 0,
+	}
+	for _, field := range sortedKeys(ignored) {
+		if ignored[field] {
+			c.issue(r.Kind, r.Metadata.Name, SeverityWarning, "match.%s has no equivalent; ignored", field)
+		}
+	}
+
+	parameters := spec.Parameters
+	if parameters == nil {
+		parameters = map[string]any{}
+	}
+	source := r.Kind + "/" + r.Metadata.Name
+	c.addPolicy(source, t, pathSafeID(t.kind+"-"+r.Metadata.Name), source, parameters, labelSelector, enabled)
+	return nil
+}
+
+// addPolicy generates the policy Rego for a template and parameter set
+func (c *converter) addPolicy(source string, t *template, id, displayName string, parameters map[string]any, labelSelector map[string]string, enabled bool) {
+	kind, name, _ := strings.Cut(source, "/")
+	regoCode, err := generateRego(source, t.module, id, parameters)
+	if err != nil {
+		c.issue(kind, name, SeverityError, "generated Rego does not compile: %v", err)
+		return
+	}
+
+	description := t.description
+	if description == "" {
+		description = fmt.Sprintf("Converted from Gatekeeper ConstraintTemplate %s", t.name)
+	}
+	c.result.Policies = append(c.result.Policies, Policy{
+		ID:            id,
+		DisplayName:   displayName,
+		Description:   description,
+		LabelSelector: labelSelector,
+		Enabled:       enabled,
+		RegoCode:      regoCode,
+	})
+}
+
+// parseTemplateRego parses template Rego, which Gatekeeper writes as Rego v0 unless it already uses v1 syntax
+func parseTemplateRego(filename, regoCode string) (*ast.Module, error) {
+	module, err := ast.ParseModuleWithOpts(filename, regoCode, ast.ParserOptions{RegoVersion: ast.RegoV0})
+	if err == nil {
+		return module, nil
+	}
+	if module, v1Err := ast.ParseModuleWithOpts(filename, regoCode, ast.ParserOptions{RegoVersion: ast.RegoV1}); v1Err == nil {
+		return module, nil
+	}
+	return nil, err
+}
+
+// checkModule reports template constructs that depend on Gatekeeper-only input or data
+func checkModule(module *ast.Module) []Issue {
+	seen := map[string]bool{}
+	var issues []Issue
+	report := func(severity Severity, message string) {
+		if !seen[message] {
+			seen[message] = true
+			issues = append(issues, Issue{Severity: severity, Message: message})
+		}
+	}
+
+	hasViolation := false
+	for _, rule := range module.Rules {
+		name := rule.Head.Ref()[0].String()
+		switch {
+		case name == "violation":
+			hasViolation = true
+		case name == "main" || strings.HasPrefix(name, generatedRulePrefix):
+			report(SeverityError, fmt.Sprintf("rule '%s' conflicts with the generated adapter", name))
+		}
+	}
+	if !hasViolation {
+		report(SeverityError, "template does not define a violation rule")
+	}
+
+	ast.WalkRefs(module, func(ref ast.Ref) bool {
+		if len(ref) < 2 {
+			return false
+		}
+		head := ref[0].String()
+		var key ast.String
+		if s, ok := ref[1].Value.(ast.String); ok {
+			key = s
+		}
+		switch {
+		case head == "data" && key == "inventory":
+			report(SeverityError, "referential data (data.inventory) is not available")
+		case head == "data" && key == "lib":
+			report(SeverityError, "template libs (data.lib) are not supported")
+		case head == "input" && key == "review" && len(ref) > 2:
+			if field, ok := ref[2].Value.(ast.String); ok && field != "object" {
+				report(SeverityWarning, fmt.Sprintf("input.review.%s is not available and is always undefined", string(field)))
+			}
+		case head == "input" && key != "" && key != "review" && key != "parameters":
+			report(SeverityWarning, fmt.Sprintf("input.%s is not available and is always undefined", string(key)))
+		}
+		return false
+	})
+	ast.WalkExprs(module, func(expr *ast.Expr) bool {
+		if expr.IsCall() && expr.Operator().String() == "external_data" {
+			report(SeverityError, "external_data calls are not supported")
+		}
+		return false
+	})
+	return issues
+}
+
+// generateRego renames the template package, rewrites it to Rego v1 and appends the adapter rules
+func generateRego(source string, templateModule *ast.Module, id string, parameters map[string]any) (string, error) {
+	module := templateModule.Copy()
+	module.Package.Path = ast.Ref{ast.DefaultRootDocument, ast.StringTerm("gatekeeper"), ast.StringTerm(strings.ReplaceAll(id, "-", "_"))}
+	converted, err := format.AstWithOpts(module, format.Opts{RegoVersion: ast.RegoV1, DropV0Imports: true})
+	if err != nil {
+		return "", err
+	}
+	params, err := json.Marshal(parameters)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Write(converted)
+	fmt.Fprintf(&b, `
+# Adapter generated from Gatekeeper %s: input.review.object is bound to
+# the request spec and input.parameters to the Constraint parameters.
+%sparameters := %s
+
+# The request spec in either evaluation input layout
+%sspec := object.get(input, ["request", "spec"], object.get(input, "spec", {}))
+
+%smessages contains msg if {
+	results := violation with input as {"review": {"object": %sspec}, "parameters": %sparameters}
+	some result in results
+	msg := object.get(result, "msg", "violation")
+}
+
+main := {
+	"rejected": count(%smessages) > 0,
+	"rejection_reason": concat("; ", sort(%smessages)),
+}
+`, source, generatedRulePrefix, params, generatedRulePrefix, generatedRulePrefix, generatedRulePrefix, generatedRulePrefix, generatedRulePrefix, generatedRulePrefix)
+
+	formatted, err := format.SourceWithOpts(id+".rego", []byte(b.String()), format.Opts{RegoVersion: ast.RegoV1})
+	if err != nil {
+		return "", err
+	}
+	if _, err := ast.CompileModulesWithOpt(map[string]string{id: string(formatted)}, ast.CompileOpts{
+		ParserOptions: ast.ParserOptions{RegoVersion: ast.RegoV1},
+	}); err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+var idSeparatorPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// pathSafeID derives an AEP-122 style policy ID from Gatekeeper names
+func pathSafeID(name string) string {
+	id := strings.Trim(idSeparatorPattern.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(id) > maxPolicyIDLength {
+		id = strings.TrimRight(id[:maxPolicyIDLength], "-")
+	}
+	return id
+}
+
+// parseResources splits a YAML/JSON stream into resources, flattening Lists
+func parseResources(data []byte) ([]resource, error) {
+	var resources []resource
+	for i, doc := range regexp.MustCompile(`(?m)^---[ \t]*$`).Split(string(data), -1) {
+		if strings.TrimSpace(doc) == "" {
+			continue
+		}
+		var r resource
+		if err := yaml.Unmarshal([]byte(doc), &r); err != nil {
+			return nil, fmt.Errorf("document %d is not valid YAML or JSON: %w", i+1, err)
+		}
+		if r.Kind == "" {
+			continue
+		}
+		if r.Kind != "List" {
+			resources = append(resources, r)
+			continue
+		}
+		for _, raw := range r.Items {
+			var item resource
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, fmt.Errorf("invalid List item in document %d: %w", i+1, err)
+			}
+			resources = append(resources, item)
+		}
+	}
+	return resources, nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
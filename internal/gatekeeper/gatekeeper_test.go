@@ -0,0 +1,192 @@
+package gatekeeper_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/gatekeeper"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const requiredLabelsTemplate = `apiVersion: templates.gatekeeper.sh/v1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredlabels
+  annotations:
+    description: Requires resources to carry the given labels
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredLabels
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8srequiredlabels
+
+        violation[{"msg": msg}] {
+          provided := {label | input.review.object.metadata.labels[label]}
+          required := {label | label := input.parameters.labels[_]}
+          missing := required - provided
+          count(missing) > 0
+          msg := sprintf("missing labels: %v", [missing])
+        }
+`
+
+const requiredLabelsConstraint = `apiVersion: constraints.gatekeeper.sh/v1beta1
+kind: K8sRequiredLabels
+metadata:
+  name: must-have-owner
+spec:
+  enforcementAction: deny
+  match:
+    kinds:
+      - apiGroups: [""]
+        kinds: ["Namespace"]
+    labelSelector:
+      matchLabels:
+        env: prod
+  parameters:
+    labels: ["owner"]
+`
+
+func evaluate(policy gatekeeper.Policy, input map[string]any) map[string]any {
+	engine := opa.NewEngine()
+	ctx := context.Background()
+	Expect(engine.Compile(ctx, []opa.PolicyModule{{ID: policy.ID, RegoCode: policy.RegoCode}})).To(Succeed())
+	result, err := engine.EvaluatePolicy(ctx, policy.ID, input)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(result.Defined).To(BeTrue())
+	return result.Result
+}
+
+func issueMessages(result *gatekeeper.Result, severity gatekeeper.Severity) []string {
+	var messages []string
+	for _, issue := range result.Issues {
+		if issue.Severity == severity {
+			messages = append(messages, issue.Source+": "+issue.Message)
+		}
+	}
+	return messages
+}
+
+var _ = Describe("Convert", func() {
+	It("converts a template and constraint into an evaluable policy", func() {
+		result, err := gatekeeper.Convert([]byte(requiredLabelsTemplate + "---\n" + requiredLabelsConstraint))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueMessages(result, gatekeeper.SeverityError)).To(BeEmpty())
+		Expect(issueMessages(result, gatekeeper.SeverityWarning)).To(ConsistOf(
+			"K8sRequiredLabels/must-have-owner: match.kinds has no equivalent; ignored",
+		))
+		Expect(result.Policies).To(HaveLen(1))
+
+		policy := result.Policies[0]
+		Expect(policy.ID).To(Equal("k8srequiredlabels-must-have-owner"))
+		Expect(policy.DisplayName).To(Equal("K8sRequiredLabels/must-have-owner"))
+		Expect(policy.Description).To(Equal("Requires resources to carry the given labels"))
+		Expect(policy.LabelSelector).To(Equal(map[string]string{"env": "prod"}))
+		Expect(policy.Enabled).To(BeTrue())
+		Expect(policy.RegoCode).To(ContainSubstring("package gatekeeper.k8srequiredlabels_must_have_owner"))
+		Expect(policy.RegoCode).To(ContainSubstring("violation contains"))
+
+		unlabeled := map[string]any{"metadata": map[string]any{"labels": map[string]any{}}}
+		labeled := map[string]any{"metadata": map[string]any{"labels": map[string]any{"owner": "team-a"}}}
+		for _, input := range []map[string]any{
+			{"request": map[string]any{"spec": unlabeled}},
+			{"spec": unlabeled}, // flat input layout
+		} {
+			rejected := evaluate(policy, input)
+			Expect(rejected).To(HaveKeyWithValue("rejected", true))
+			Expect(rejected).To(HaveKeyWithValue("rejection_reason", ContainSubstring("missing labels")))
+		}
+
+		allowed := evaluate(policy, map[string]any{"request": map[string]any{"spec": labeled}})
+		Expect(allowed).To(HaveKeyWithValue("rejected", false))
+		allowed = evaluate(policy, map[string]any{"spec": labeled})
+		Expect(allowed).To(HaveKeyWithValue("rejected", false))
+	})
+
+	It("converts a template without constraints using empty parameters", func() {
+		result, err := gatekeeper.Convert([]byte(requiredLabelsTemplate))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Policies).To(HaveLen(1))
+		Expect(result.Policies[0].ID).To(Equal("k8srequiredlabels"))
+		Expect(issueMessages(result, gatekeeper.SeverityWarning)).To(ConsistOf(
+			"ConstraintTemplate/k8srequiredlabels: no Constraint found for this template; converted with empty parameters",
+		))
+	})
+
+	It("accepts a List of resources", func() {
+		list := `{"apiVersion": "v1", "kind": "List", "items": [
+  {"apiVersion": "templates.gatekeeper.sh/v1", "kind": "ConstraintTemplate", "metadata": {"name": "deny"},
+   "spec": {"crd": {"spec": {"names": {"kind": "Deny"}}},
+            "targets": [{"target": "admission.k8s.gatekeeper.sh", "rego": "package deny\nviolation[{\"msg\": \"denied\"}] { true }"}]}},
+  {"apiVersion": "constraints.gatekeeper.sh/v1beta1", "kind": "Deny", "metadata": {"name": "all"}, "spec": {"enforcementAction": "dryrun"}}
+]}`
+		result, err := gatekeeper.Convert([]byte(list))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Policies).To(HaveLen(1))
+		Expect(result.Policies[0].Enabled).To(BeFalse())
+		Expect(issueMessages(result, gatekeeper.SeverityWarning)).To(ConsistOf(
+			"Deny/all: enforcementAction 'dryrun' has no equivalent; the policy is created disabled",
+		))
+	})
+
+	It("reports templates that depend on Gatekeeper-only data as errors", func() {
+		template := `apiVersion: templates.gatekeeper.sh/v1
+kind: ConstraintTemplate
+metadata:
+  name: uniqueingress
+spec:
+  crd:
+    spec:
+      names:
+        kind: UniqueIngress
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package uniqueingress
+
+        violation[{"msg": "duplicate"}] {
+          input.review.operation == "CREATE"
+          other := data.inventory.namespace[_][_]["Ingress"][_]
+          other.spec.rules[_].host == input.review.object.spec.rules[_].host
+        }
+---
+apiVersion: constraints.gatekeeper.sh/v1beta1
+kind: UniqueIngress
+metadata:
+  name: unique-hosts
+`
+		result, err := gatekeeper.Convert([]byte(template))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Policies).To(BeEmpty())
+		Expect(issueMessages(result, gatekeeper.SeverityError)).To(ConsistOf(
+			"ConstraintTemplate/uniqueingress: referential data (data.inventory) is not available",
+			"UniqueIngress/unique-hosts: ConstraintTemplate 'uniqueingress' could not be converted",
+		))
+		Expect(issueMessages(result, gatekeeper.SeverityWarning)).To(ConsistOf(
+			"ConstraintTemplate/uniqueingress: input.review.operation is not available and is always undefined",
+		))
+	})
+
+	It("reports constraints without a matching template", func() {
+		result, err := gatekeeper.Convert([]byte(requiredLabelsConstraint))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Policies).To(BeEmpty())
+		Expect(issueMessages(result, gatekeeper.SeverityError)).To(ConsistOf(
+			"K8sRequiredLabels/must-have-owner: no ConstraintTemplate defines kind 'K8sRequiredLabels'",
+		))
+	})
+
+	It("returns an error when the input has no Gatekeeper resources", func() {
+		_, err := gatekeeper.Convert([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: x\n"))
+		Expect(err).To(MatchError(ContainSubstring("no ConstraintTemplates or Constraints found")))
+	})
+
+	It("returns an error for invalid YAML", func() {
+		_, err := gatekeeper.Convert([]byte("kind: [unterminated"))
+		Expect(err).To(HaveOccurred())
+	})
+})
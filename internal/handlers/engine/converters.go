@@ -1,23 +1,71 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 
 	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
 	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
 )
 
 func toServiceEvaluationRequest(request engineserver.EvaluateRequestRequestObject) (*service.EvaluationRequest, error) {
-	requestLabels, err := extractRequestLabels(request.Body.ServiceInstance.Spec)
+	requestLabels, err := service.ExtractRequestLabels(request.Body.ServiceInstance.Spec)
 	if err != nil {
 		return nil, err
 	}
 	return &service.EvaluationRequest{
-		ServiceInstance: request.Body.ServiceInstance.Spec,
-		RequestLabels:   requestLabels,
+		ServiceInstance:    request.Body.ServiceInstance.Spec,
+		RequestLabels:      requestLabels,
+		Explain:            request.Params.Explain != nil && *request.Params.Explain,
+		DryRun:             request.Params.DryRun != nil && *request.Params.DryRun,
+		AcceptLanguage:     acceptLanguage(request.Params.AcceptLanguage),
+		RequestContext:     toServiceRequestContext(request.Body.RequestContext),
+		ExtendedStatus:     request.Params.ExtendedStatus != nil && *request.Params.ExtendedStatus,
+		InstanceID:         instanceID(request.Body.InstanceId),
+		PreferredProviders: preferredProviders(request.Body.PreferredProviders),
 	}, nil
 }
 
+func toServiceRequestContext(requestContext *engineserver.RequestContext) service.RequestContext {
+	if requestContext == nil {
+		return service.RequestContext{}
+	}
+	value := func(field *string) string {
+		if field == nil {
+			return ""
+		}
+		return *field
+	}
+	return service.RequestContext{
+		Requester:     value(requestContext.Requester),
+		SourceSystem:  value(requestContext.SourceSystem),
+		CorrelationID: value(requestContext.CorrelationId),
+		Environment:   value(requestContext.Environment),
+	}
+}
+
+func acceptLanguage(header *engineserver.AcceptLanguage) string {
+	if header == nil {
+		return ""
+	}
+	return *header
+}
+
+func instanceID(id *string) string {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
+
+func preferredProviders(providers *[]string) []string {
+	if providers == nil {
+		return nil
+	}
+	return *providers
+}
+
 func toEngineEvaluationResponse(response *service.EvaluationResponse) engineserver.EvaluateResponse {
 	return engineserver.EvaluateResponse{
 		EvaluatedServiceInstance: engineserver.ServiceInstance{
@@ -25,27 +73,180 @@ func toEngineEvaluationResponse(response *service.EvaluationResponse) engineserv
 		},
 		SelectedProvider: response.SelectedProvider,
 		Status:           engineserver.EvaluateResponseStatus(response.Status),
+		StatusReason:     toEngineStatusReason(response.StatusReason),
+		Rejection:        toEngineRejection(response.Rejection),
+		Explanation:      toEngineExplanation(response.Explanation),
+		DryRun:           toEngineFlag(response.DryRun),
+		FailedOpen:       toEngineFlag(response.FailedOpen),
+		Warnings:         toEngineWarnings(response.Warnings),
 	}
 }
 
-// extractRequestLabels extracts labels from spec.metadata.labels
-func extractRequestLabels(spec map[string]any) (map[string]string, error) {
-	serviceType, ok := spec["service_type"].(string)
-	if !ok {
-		return nil, fmt.Errorf("service type is required")
+// toEngineStatusReason returns nil for an unset reason so the field is omitted
+func toEngineStatusReason(reason service.StatusReason) *engineserver.EvaluateResponseStatusReason {
+	if reason == "" {
+		return nil
 	}
-	result := make(map[string]string)
-	result["service_type"] = serviceType
+	engineReason := engineserver.EvaluateResponseStatusReason(reason)
+	return &engineReason
+}
 
-	if metadata, ok := spec["metadata"].(map[string]any); ok {
-		if labels, ok := metadata["labels"].(map[string]any); ok {
-			for k, v := range labels {
-				if strVal, ok := v.(string); ok {
-					result[k] = strVal
-				}
-			}
+func toEngineRejection(rejection *service.Rejection) *engineserver.DryRunRejection {
+	if rejection == nil {
+		return nil
+	}
+	engineRejection := &engineserver.DryRunRejection{
+		PolicyId: rejection.PolicyID,
+		Reason:   rejection.Reason,
+	}
+	if rejection.Code != "" {
+		engineRejection.Code = &rejection.Code
+	}
+	return engineRejection
+}
+
+// toEngineWarnings returns nil without warnings so the field is omitted
+func toEngineWarnings(warnings []service.EvaluationWarning) *[]engineserver.EvaluationWarning {
+	if len(warnings) == 0 {
+		return nil
+	}
+	engineWarnings := make([]engineserver.EvaluationWarning, len(warnings))
+	for i, warning := range warnings {
+		engineWarnings[i] = engineserver.EvaluationWarning{
+			Code:     warning.Code,
+			PolicyId: warning.PolicyID,
+			Message:  warning.Message,
+		}
+		if warning.Field != "" {
+			engineWarnings[i].Field = &warnings[i].Field
 		}
 	}
+	return &engineWarnings
+}
 
-	return result, nil
+// toEngineFlag returns nil for false so optional boolean fields are omitted unless set
+func toEngineFlag(flag bool) *bool {
+	if !flag {
+		return nil
+	}
+	return &flag
+}
+
+func toEngineExplanation(explanation *service.Explanation) *engineserver.EvaluationExplanation {
+	if explanation == nil {
+		return nil
+	}
+	policies := make([]engineserver.PolicyTrace, len(explanation.Policies))
+	for i, trace := range explanation.Policies {
+		policies[i] = engineserver.PolicyTrace{
+			PolicyId: trace.PolicyID,
+			Input:    trace.Input,
+			Outcome:  engineserver.PolicyOutcome(trace.Outcome),
+		}
+		if trace.Reason != "" {
+			policies[i].Reason = &trace.Reason
+		}
+		if trace.Patch != nil {
+			policies[i].Patch = &trace.Patch
+		}
+		if trace.Constraints != nil {
+			policies[i].Constraints = &trace.Constraints
+		}
+		if trace.SelectedProvider != "" {
+			policies[i].SelectedProvider = &trace.SelectedProvider
+		}
+	}
+	skipped := make([]engineserver.SkippedPolicy, len(explanation.SkippedPolicies))
+	for i, policy := range explanation.SkippedPolicies {
+		skipped[i] = engineserver.SkippedPolicy{PolicyId: policy.PolicyID, Reason: policy.Reason}
+	}
+	return &engineserver.EvaluationExplanation{
+		InputSpec:       explanation.InputSpec,
+		RequestLabels:   explanation.RequestLabels,
+		Policies:        policies,
+		SkippedPolicies: skipped,
+		FieldProvenance: explanation.FieldProvenance,
+	}
+}
+
+// toEngineSuggestions converts conflict suggestions, returning nil when there are none so
+// the field is omitted
+func toEngineSuggestions(suggestions []constraints.Suggestion) *[]engineserver.ConflictSuggestion {
+	if len(suggestions) == 0 {
+		return nil
+	}
+	result := make([]engineserver.ConflictSuggestion, len(suggestions))
+	for i, suggestion := range suggestions {
+		result[i] = engineserver.ConflictSuggestion{NearestValue: suggestion.NearestValue}
+		if suggestion.FieldPath != "" {
+			result[i].Field = &suggestion.FieldPath
+		}
+		if suggestion.SetByPolicy != "" {
+			result[i].SetByPolicy = &suggestion.SetByPolicy
+		}
+		if len(suggestion.AllowedValues) > 0 {
+			result[i].AllowedValues = &suggestion.AllowedValues
+		}
+		if len(suggestion.AllowedProviders) > 0 {
+			result[i].AllowedProviders = &suggestion.AllowedProviders
+		}
+	}
+	return &result
+}
+
+func toServiceCompositeRequest(request engineserver.EvaluateCompositeRequestObject) (*service.CompositeEvaluationRequest, error) {
+	if request.Body == nil {
+		return nil, errors.New("request body is required")
+	}
+	instances := make([]service.CompositeInstance, len(request.Body.ServiceInstances))
+	for i, instance := range request.Body.ServiceInstances {
+		requestLabels, err := service.ExtractRequestLabels(instance.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("service instance '%s': %w", instance.Name, err)
+		}
+		instances[i] = service.CompositeInstance{
+			Name:            instance.Name,
+			ServiceInstance: instance.Spec,
+			RequestLabels:   requestLabels,
+		}
+	}
+	return &service.CompositeEvaluationRequest{
+		Instances:      instances,
+		AcceptLanguage: acceptLanguage(request.Params.AcceptLanguage),
+	}, nil
+}
+
+func toEngineCompositeResponse(response *service.CompositeEvaluationResponse) engineserver.CompositeEvaluateResponse {
+	instances := make([]engineserver.CompositeInstanceResult, len(response.Instances))
+	for i, instance := range response.Instances {
+		instances[i] = engineserver.CompositeInstanceResult{
+			Name: instance.Name,
+			EvaluatedServiceInstance: engineserver.ServiceInstance{
+				Spec: instance.EvaluatedServiceInstance,
+			},
+			SelectedProvider: instance.SelectedProvider,
+			Status:           engineserver.CompositeInstanceResultStatus(instance.Status),
+			FailedOpen:       toEngineFlag(instance.FailedOpen),
+		}
+	}
+	return engineserver.CompositeEvaluateResponse{
+		ServiceInstances:  instances,
+		Status:            engineserver.CompositeEvaluateResponseStatus(response.Status),
+		CompositePolicies: response.CompositePolicies,
+		FailedOpen:        toEngineFlag(response.FailedOpen),
+	}
+}
+
+func toEngineSessionResponse(response *service.SessionEvaluationResponse) engineserver.SessionEvaluateResponse {
+	constraints := make(map[string]map[string]any, len(response.Constraints))
+	for fieldPath, constraint := range response.Constraints {
+		if schema, ok := constraint.(map[string]any); ok {
+			constraints[fieldPath] = schema
+		}
+	}
+	return engineserver.SessionEvaluateResponse{
+		Evaluation:  toEngineEvaluationResponse(&response.EvaluationResponse),
+		Constraints: constraints,
+		ExpireTime:  response.ExpireTime,
+	}
 }
@@ -14,112 +14,108 @@ func TestEngine(t *testing.T) {
 	RunSpecs(t, "Engine Handlers Suite")
 }
 
-var _ = Describe("extractRequestLabels", func() {
-	It("returns error when service_type is missing", func() {
-		spec := map[string]any{}
-		labels, err := extractRequestLabels(spec)
-		Expect(err).To(MatchError("service type is required"))
-		Expect(labels).To(BeNil())
-	})
-
-	It("returns error when service_type is not a string", func() {
-		spec := map[string]any{"service_type": 123}
-		labels, err := extractRequestLabels(spec)
-		Expect(err).To(MatchError("service type is required"))
-		Expect(labels).To(BeNil())
-	})
-
-	It("returns only service_type when no metadata or labels", func() {
-		spec := map[string]any{"service_type": "compute"}
-		labels, err := extractRequestLabels(spec)
-		Expect(err).NotTo(HaveOccurred())
-		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
-	})
-
-	It("includes metadata.labels when present", func() {
+var _ = Describe("toServiceEvaluationRequest", func() {
+	It("converts valid request to service evaluation request", func() {
 		spec := map[string]any{
-			"service_type": "storage",
+			"service_type": "compute",
 			"metadata": map[string]any{
 				"labels": map[string]any{
-					"env":    "prod",
-					"region": "us-east-1",
+					"env": "prod",
 				},
 			},
 		}
-		labels, err := extractRequestLabels(spec)
+		req := engineserver.EvaluateRequestRequestObject{
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance: engineserver.ServiceInstance{Spec: spec},
+			},
+		}
+		got, err := toServiceEvaluationRequest(req)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(labels).To(Equal(map[string]string{
-			"service_type": "storage",
+		Expect(got).NotTo(BeNil())
+		Expect(got.ServiceInstance).To(Equal(spec))
+		Expect(got.RequestLabels).To(Equal(map[string]string{
+			"service_type": "compute",
 			"env":          "prod",
-			"region":       "us-east-1",
 		}))
+		Expect(got.Explain).To(BeFalse())
 	})
 
-	It("skips non-string label values", func() {
-		spec := map[string]any{
-			"service_type": "compute",
-			"metadata": map[string]any{
-				"labels": map[string]any{
-					"env":  "prod",
-					"num":  42,
-					"flag": true,
-				},
+	It("enables explain mode when requested", func() {
+		explain := true
+		req := engineserver.EvaluateRequestRequestObject{
+			Params: engineserver.EvaluateRequestParams{Explain: &explain},
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
 			},
 		}
-		labels, err := extractRequestLabels(spec)
+		got, err := toServiceEvaluationRequest(req)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(labels).To(Equal(map[string]string{
-			"service_type": "compute",
-			"env":          "prod",
-		}))
+		Expect(got.Explain).To(BeTrue())
 	})
 
-	It("returns only service_type when metadata is not a map", func() {
-		spec := map[string]any{
-			"service_type": "compute",
-			"metadata":     "not-a-map",
+	It("asks for extended statuses when requested", func() {
+		extendedStatus := true
+		req := engineserver.EvaluateRequestRequestObject{
+			Params: engineserver.EvaluateRequestParams{ExtendedStatus: &extendedStatus},
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
+			},
 		}
-		labels, err := extractRequestLabels(spec)
+		got, err := toServiceEvaluationRequest(req)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
+		Expect(got.ExtendedStatus).To(BeTrue())
 	})
 
-	It("returns only service_type when labels is not a map", func() {
-		spec := map[string]any{
-			"service_type": "compute",
-			"metadata": map[string]any{
-				"labels": []string{"a", "b"},
+	It("passes the Accept-Language header on", func() {
+		acceptLanguage := engineserver.AcceptLanguage("de-CH, en;q=0.5")
+		req := engineserver.EvaluateRequestRequestObject{
+			Params: engineserver.EvaluateRequestParams{AcceptLanguage: &acceptLanguage},
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
 			},
 		}
-		labels, err := extractRequestLabels(spec)
+		got, err := toServiceEvaluationRequest(req)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
+		Expect(got.AcceptLanguage).To(Equal("de-CH, en;q=0.5"))
 	})
-})
 
-var _ = Describe("toServiceEvaluationRequest", func() {
-	It("converts valid request to service evaluation request", func() {
-		spec := map[string]any{
-			"service_type": "compute",
-			"metadata": map[string]any{
-				"labels": map[string]any{
-					"env": "prod",
-				},
+	It("passes the request context on", func() {
+		requester, correlationID := "alice@example.com", "req-42"
+		req := engineserver.EvaluateRequestRequestObject{
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
+				RequestContext:  &engineserver.RequestContext{Requester: &requester, CorrelationId: &correlationID},
 			},
 		}
+		got, err := toServiceEvaluationRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.RequestContext).To(Equal(service.RequestContext{Requester: requester, CorrelationID: correlationID}))
+	})
+
+	It("passes the instance ID on", func() {
+		instanceID := "vm-7f3c2a10"
 		req := engineserver.EvaluateRequestRequestObject{
 			Body: &engineserver.EvaluateRequest{
-				ServiceInstance: engineserver.ServiceInstance{Spec: spec},
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
+				InstanceId:      &instanceID,
 			},
 		}
 		got, err := toServiceEvaluationRequest(req)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(got).NotTo(BeNil())
-		Expect(got.ServiceInstance).To(Equal(spec))
-		Expect(got.RequestLabels).To(Equal(map[string]string{
-			"service_type": "compute",
-			"env":          "prod",
-		}))
+		Expect(got.InstanceID).To(Equal(instanceID))
+	})
+
+	It("passes the preferred providers on", func() {
+		preferred := []string{"gcp", "aws"}
+		req := engineserver.EvaluateRequestRequestObject{
+			Body: &engineserver.EvaluateRequest{
+				ServiceInstance:    engineserver.ServiceInstance{Spec: map[string]any{"service_type": "compute"}},
+				PreferredProviders: &preferred,
+			},
+		}
+		got, err := toServiceEvaluationRequest(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.PreferredProviders).To(Equal(preferred))
 	})
 
 	It("returns error when spec has no service_type", func() {
@@ -158,5 +154,76 @@ var _ = Describe("toEngineEvaluationResponse", func() {
 		got := toEngineEvaluationResponse(resp)
 		Expect(got.Status).To(Equal(engineserver.MODIFIED))
 		Expect(got.SelectedProvider).To(Equal("other"))
+		Expect(got.Explanation).To(BeNil())
+		Expect(got.StatusReason).To(BeNil())
+		Expect(got.Rejection).To(BeNil())
+		Expect(got.Warnings).To(BeNil())
+	})
+
+	It("converts the warnings of the evaluation", func() {
+		resp := &service.EvaluationResponse{
+			EvaluatedServiceInstance: map[string]any{"service_type": "vm"},
+			Status:                   service.EvaluationStatusModified,
+			Warnings: []service.EvaluationWarning{
+				{Code: service.WarningCodeUnknownPatchField, PolicyID: "sizing", Field: "instance_typ", Message: "not allowed"},
+			},
+		}
+		got := toEngineEvaluationResponse(resp)
+		field := "instance_typ"
+		Expect(got.Warnings).To(HaveValue(Equal([]engineserver.EvaluationWarning{
+			{Code: "UNKNOWN_PATCH_FIELD", PolicyId: "sizing", Field: &field, Message: "not allowed"},
+		})))
+	})
+
+	It("converts the status reason and the rejection a dry run would get", func() {
+		resp := &service.EvaluationResponse{
+			EvaluatedServiceInstance: map[string]any{"service_type": "compute"},
+			Status:                   service.EvaluationStatusDryRunWouldReject,
+			StatusReason:             service.StatusReasonPolicyRejected,
+			Rejection:                &service.Rejection{PolicyID: "deny-all", Reason: "tenant is suspended"},
+			DryRun:                   true,
+		}
+		got := toEngineEvaluationResponse(resp)
+		Expect(got.Status).To(Equal(engineserver.DRYRUNWOULDREJECT))
+		Expect(got.StatusReason).To(HaveValue(Equal(engineserver.StatusReasonPolicyRejected)))
+		Expect(got.Rejection).To(Equal(&engineserver.DryRunRejection{PolicyId: "deny-all", Reason: "tenant is suspended"}))
+	})
+
+	It("converts the explanation when present", func() {
+		input := map[string]any{"spec": map[string]any{"service_type": "compute"}, "provider": ""}
+		resp := &service.EvaluationResponse{
+			EvaluatedServiceInstance: map[string]any{"service_type": "compute"},
+			Status:                   service.EvaluationStatusApproved,
+			Explanation: &service.Explanation{
+				Policies: []service.PolicyTrace{
+					{
+						PolicyID:         "policy-1",
+						Input:            input,
+						Outcome:          service.PolicyOutcomeApplied,
+						Patch:            map[string]any{"region": "us-east-1"},
+						SelectedProvider: "aws",
+					},
+					{PolicyID: "policy-2", Input: input, Outcome: service.PolicyOutcomeUndefined},
+				},
+				SkippedPolicies: []service.SkippedPolicy{{PolicyID: "prod-only", Reason: "label selector does not match the request labels"}},
+				FieldProvenance: map[string]string{"region": "policy-1"},
+			},
+		}
+		got := toEngineEvaluationResponse(resp)
+		Expect(got.Explanation).NotTo(BeNil())
+		Expect(got.Explanation.FieldProvenance).To(Equal(map[string]string{"region": "policy-1"}))
+		Expect(got.Explanation.Policies).To(HaveLen(2))
+		Expect(got.Explanation.Policies[0].PolicyId).To(Equal("policy-1"))
+		Expect(got.Explanation.Policies[0].Input).To(Equal(input))
+		Expect(got.Explanation.Policies[0].Outcome).To(Equal(engineserver.APPLIED))
+		Expect(got.Explanation.Policies[0].Patch).To(HaveValue(Equal(map[string]any{"region": "us-east-1"})))
+		Expect(got.Explanation.Policies[0].SelectedProvider).To(HaveValue(Equal("aws")))
+		Expect(got.Explanation.Policies[0].Constraints).To(BeNil())
+		Expect(got.Explanation.Policies[1].Outcome).To(Equal(engineserver.UNDEFINED))
+		Expect(got.Explanation.Policies[1].Patch).To(BeNil())
+		Expect(got.Explanation.Policies[1].Reason).To(BeNil())
+		Expect(got.Explanation.SkippedPolicies).To(Equal([]engineserver.SkippedPolicy{
+			{PolicyId: "prod-only", Reason: "label selector does not match the request labels"},
+		}))
 	})
 })
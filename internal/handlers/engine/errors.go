@@ -2,10 +2,14 @@ package engine
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
 	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/quota"
 	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
 )
 
 // logServiceError logs at Warn level for client errors (4xx) and Error level
@@ -27,7 +31,8 @@ func isClientError(err error) bool {
 	}
 	switch serviceErr.Type {
 	case service.ErrorTypeInvalidArgument, service.ErrorTypeRejected,
-		service.ErrorTypePolicyConflict:
+		service.ErrorTypePolicyConflict, service.ErrorTypeNotFound,
+		service.ErrorTypeResourceExhausted:
 		return true
 	default:
 		return false
@@ -39,11 +44,13 @@ func (h *Handler) handleError(err error) engineserver.EvaluateRequestResponseObj
 	if serviceErr, ok := err.(*service.ServiceError); ok {
 		switch serviceErr.Type {
 		case service.ErrorTypeRejected:
-			return h.rejected(serviceErr.Message, serviceErr.Detail)
+			return h.rejected(serviceErr.Message, serviceErr.Detail, serviceErr.Explanation)
 		case service.ErrorTypePolicyConflict:
-			return h.conflict(serviceErr.Message, serviceErr.Detail)
+			return h.conflict(serviceErr.Message, serviceErr.Detail, serviceErr.Explanation, serviceErr.Suggestions)
 		case service.ErrorTypeInvalidArgument:
 			return h.badRequest(serviceErr.Message)
+		case service.ErrorTypeUnavailable:
+			return h.unavailable(serviceErr.Message, serviceErr.Detail)
 		}
 	}
 
@@ -63,30 +70,80 @@ func (h *Handler) badRequest(message string) engineserver.EvaluateRequestRespons
 	}
 }
 
-// rejected creates a 406 Not Acceptable response
-func (h *Handler) rejected(title, detail string) engineserver.EvaluateRequestResponseObject {
-	return engineserver.EvaluateRequest406JSONResponse{
-		RejectedJSONResponse: engineserver.RejectedJSONResponse{
+// unauthenticated creates a 401 Unauthorized response
+func unauthenticated(detail string) engineserver.EvaluateRequestResponseObject {
+	return engineserver.EvaluateRequest401JSONResponse{
+		UnauthorizedJSONResponse: engineserver.UnauthorizedJSONResponse{
 			Type:   "about:blank",
-			Status: 406,
-			Title:  title,
+			Status: 401,
+			Title:  "Authentication required",
 			Detail: &detail,
 		},
 	}
 }
 
-// conflict creates a 409 Conflict response
-func (h *Handler) conflict(title, detail string) engineserver.EvaluateRequestResponseObject {
-	return engineserver.EvaluateRequest409JSONResponse{
-		PolicyConflictJSONResponse: engineserver.PolicyConflictJSONResponse{
+// forbidden creates a 403 Forbidden response
+func forbidden(detail string) engineserver.EvaluateRequestResponseObject {
+	return engineserver.EvaluateRequest403JSONResponse{
+		ForbiddenJSONResponse: engineserver.ForbiddenJSONResponse{
 			Type:   "about:blank",
-			Status: 409,
-			Title:  title,
+			Status: 403,
+			Title:  "Permission denied",
 			Detail: &detail,
 		},
 	}
 }
 
+// rejected creates a 406 Not Acceptable response, with the evaluation trace in explain mode
+func (h *Handler) rejected(title, detail string, explanation *service.Explanation) engineserver.EvaluateRequestResponseObject {
+	return engineserver.EvaluateRequest406JSONResponse{
+		RejectedJSONResponse: engineserver.RejectedJSONResponse{
+			Type:        "about:blank",
+			Status:      406,
+			Title:       title,
+			Detail:      &detail,
+			Explanation: toEngineExplanation(explanation),
+		},
+	}
+}
+
+// conflict creates a 409 Conflict response, with the suggestions resolving the conflict and
+// the evaluation trace in explain mode
+func (h *Handler) conflict(title, detail string, explanation *service.Explanation, suggestions []constraints.Suggestion) engineserver.EvaluateRequestResponseObject {
+	return engineserver.EvaluateRequest409JSONResponse{
+		PolicyConflictJSONResponse: engineserver.PolicyConflictJSONResponse{
+			Type:        "about:blank",
+			Status:      409,
+			Title:       title,
+			Detail:      &detail,
+			Explanation: toEngineExplanation(explanation),
+			Suggestions: toEngineSuggestions(suggestions),
+		},
+	}
+}
+
+// quotaExceeded creates a 429 Too Many Requests response
+func (h *Handler) quotaExceeded(decision quota.Decision) engineserver.EvaluateRequestResponseObject {
+	detail := decision.Message()
+	retryAfter := retryAfterSeconds(decision.RetryAfter)
+	return engineserver.EvaluateRequest429JSONResponse{
+		QuotaExceededJSONResponse: engineserver.QuotaExceededJSONResponse{
+			Body: engineserver.Error{
+				Type:   "about:blank",
+				Status: 429,
+				Title:  "Evaluation quota exceeded",
+				Detail: &detail,
+			},
+			Headers: engineserver.QuotaExceededResponseHeaders{RetryAfter: &retryAfter},
+		},
+	}
+}
+
+// retryAfterSeconds rounds a retry delay up to whole seconds for the Retry-After header
+func retryAfterSeconds(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
 // internalError creates a 500 Internal Server Error response
 func (h *Handler) internalError(title, detail string) engineserver.EvaluateRequestResponseObject {
 	return engineserver.EvaluateRequest500JSONResponse{
@@ -98,3 +155,121 @@ func (h *Handler) internalError(title, detail string) engineserver.EvaluateReque
 		},
 	}
 }
+
+// unavailable creates a 503 Service Unavailable response
+func (h *Handler) unavailable(title, detail string) engineserver.EvaluateRequestResponseObject {
+	return engineserver.EvaluateRequest503JSONResponse{
+		UnavailableJSONResponse: engineserver.UnavailableJSONResponse{
+			Type:   "about:blank",
+			Status: 503,
+			Title:  title,
+			Detail: &detail,
+		},
+	}
+}
+
+// compositeResponse converts an EvaluateRequest error response into the matching
+// EvaluateComposite response, so both operations map errors the same way
+func compositeResponse(response engineserver.EvaluateRequestResponseObject) engineserver.EvaluateCompositeResponseObject {
+	switch response := response.(type) {
+	case engineserver.EvaluateRequest400JSONResponse:
+		return engineserver.EvaluateComposite400JSONResponse{BadRequestJSONResponse: response.BadRequestJSONResponse}
+	case engineserver.EvaluateRequest401JSONResponse:
+		return engineserver.EvaluateComposite401JSONResponse{UnauthorizedJSONResponse: response.UnauthorizedJSONResponse}
+	case engineserver.EvaluateRequest403JSONResponse:
+		return engineserver.EvaluateComposite403JSONResponse{ForbiddenJSONResponse: response.ForbiddenJSONResponse}
+	case engineserver.EvaluateRequest406JSONResponse:
+		return engineserver.EvaluateComposite406JSONResponse{RejectedJSONResponse: response.RejectedJSONResponse}
+	case engineserver.EvaluateRequest409JSONResponse:
+		return engineserver.EvaluateComposite409JSONResponse{PolicyConflictJSONResponse: response.PolicyConflictJSONResponse}
+	case engineserver.EvaluateRequest429JSONResponse:
+		return engineserver.EvaluateComposite429JSONResponse{QuotaExceededJSONResponse: response.QuotaExceededJSONResponse}
+	case engineserver.EvaluateRequest500JSONResponse:
+		return engineserver.EvaluateComposite500JSONResponse{InternalServerErrorJSONResponse: response.InternalServerErrorJSONResponse}
+	case engineserver.EvaluateRequest503JSONResponse:
+		return engineserver.EvaluateComposite503JSONResponse{UnavailableJSONResponse: response.UnavailableJSONResponse}
+	}
+	detail := "An unexpected error occurred"
+	return engineserver.EvaluateComposite500JSONResponse{
+		InternalServerErrorJSONResponse: engineserver.InternalServerErrorJSONResponse{
+			Type:   "about:blank",
+			Status: 500,
+			Title:  "Internal server error",
+			Detail: &detail,
+		},
+	}
+}
+
+// sessionNotFound returns the 404 body for a missing or expired session
+func sessionNotFound(err error) (engineserver.SessionNotFoundJSONResponse, bool) {
+	var serviceErr *service.ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Type != service.ErrorTypeNotFound {
+		return engineserver.SessionNotFoundJSONResponse{}, false
+	}
+	return engineserver.SessionNotFoundJSONResponse{
+		Type:   "about:blank",
+		Status: 404,
+		Title:  serviceErr.Message,
+		Detail: &serviceErr.Detail,
+	}, true
+}
+
+// evaluateSessionResponse converts an EvaluateRequest error response into the matching
+// EvaluateSession response
+func evaluateSessionResponse(response engineserver.EvaluateRequestResponseObject) engineserver.EvaluateSessionResponseObject {
+	switch response := response.(type) {
+	case engineserver.EvaluateRequest400JSONResponse:
+		return engineserver.EvaluateSession400JSONResponse{BadRequestJSONResponse: response.BadRequestJSONResponse}
+	case engineserver.EvaluateRequest401JSONResponse:
+		return engineserver.EvaluateSession401JSONResponse{UnauthorizedJSONResponse: response.UnauthorizedJSONResponse}
+	case engineserver.EvaluateRequest403JSONResponse:
+		return engineserver.EvaluateSession403JSONResponse{ForbiddenJSONResponse: response.ForbiddenJSONResponse}
+	case engineserver.EvaluateRequest406JSONResponse:
+		return engineserver.EvaluateSession406JSONResponse{RejectedJSONResponse: response.RejectedJSONResponse}
+	case engineserver.EvaluateRequest409JSONResponse:
+		return engineserver.EvaluateSession409JSONResponse{PolicyConflictJSONResponse: response.PolicyConflictJSONResponse}
+	case engineserver.EvaluateRequest500JSONResponse:
+		return engineserver.EvaluateSession500JSONResponse{InternalServerErrorJSONResponse: response.InternalServerErrorJSONResponse}
+	case engineserver.EvaluateRequest503JSONResponse:
+		return engineserver.EvaluateSession503JSONResponse{UnavailableJSONResponse: response.UnavailableJSONResponse}
+	}
+	detail := "An unexpected error occurred"
+	return engineserver.EvaluateSession500JSONResponse{
+		InternalServerErrorJSONResponse: engineserver.InternalServerErrorJSONResponse{
+			Type:   "about:blank",
+			Status: 500,
+			Title:  "Internal server error",
+			Detail: &detail,
+		},
+	}
+}
+
+// finalizeSessionResponse converts an EvaluateRequest error response into the matching
+// FinalizeSession response
+func finalizeSessionResponse(response engineserver.EvaluateRequestResponseObject) engineserver.FinalizeSessionResponseObject {
+	switch response := response.(type) {
+	case engineserver.EvaluateRequest400JSONResponse:
+		return engineserver.FinalizeSession400JSONResponse{BadRequestJSONResponse: response.BadRequestJSONResponse}
+	case engineserver.EvaluateRequest401JSONResponse:
+		return engineserver.FinalizeSession401JSONResponse{UnauthorizedJSONResponse: response.UnauthorizedJSONResponse}
+	case engineserver.EvaluateRequest403JSONResponse:
+		return engineserver.FinalizeSession403JSONResponse{ForbiddenJSONResponse: response.ForbiddenJSONResponse}
+	case engineserver.EvaluateRequest406JSONResponse:
+		return engineserver.FinalizeSession406JSONResponse{RejectedJSONResponse: response.RejectedJSONResponse}
+	case engineserver.EvaluateRequest409JSONResponse:
+		return engineserver.FinalizeSession409JSONResponse{PolicyConflictJSONResponse: response.PolicyConflictJSONResponse}
+	case engineserver.EvaluateRequest500JSONResponse:
+		return engineserver.FinalizeSession500JSONResponse{InternalServerErrorJSONResponse: response.InternalServerErrorJSONResponse}
+	case engineserver.EvaluateRequest503JSONResponse:
+		return engineserver.FinalizeSession503JSONResponse{UnavailableJSONResponse: response.UnavailableJSONResponse}
+	}
+	detail := "An unexpected error occurred"
+	return engineserver.FinalizeSession500JSONResponse{
+		InternalServerErrorJSONResponse: engineserver.InternalServerErrorJSONResponse{
+			Type:   "about:blank",
+			Status: 500,
+			Title:  "Internal server error",
+			Detail: &detail,
+		},
+	}
+}
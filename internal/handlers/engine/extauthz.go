@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+const (
+	// ExtAuthzStatusHeader carries the evaluation status (APPROVED or MODIFIED) on allowed requests
+	ExtAuthzStatusHeader = "X-Dcm-Policy-Status"
+	// ExtAuthzStatusReasonHeader carries the reason for the evaluation status on allowed requests
+	ExtAuthzStatusReasonHeader = "X-Dcm-Policy-Status-Reason"
+	// ExtAuthzProviderHeader carries the selected provider, when a policy selected one
+	ExtAuthzProviderHeader = "X-Dcm-Selected-Provider"
+
+	// Pseudo-header sources available in EXT_AUTHZ_SPEC_HEADERS
+	methodPseudoHeader = "@method"
+	pathPseudoHeader   = "@path"
+
+	maxExtAuthzBodySize = 1 << 20
+)
+
+// ExtAuthzHandler implements Envoy's ext_authz HTTP service contract on top of the
+// EvaluationService. Envoy forwards the original request (path appended to the
+// configured prefix); a 200 response allows it and any other status denies it.
+type ExtAuthzHandler struct {
+	evaluationService service.EvaluationService
+	config            config.ExtAuthzConfig
+	options
+}
+
+var _ http.Handler = (*ExtAuthzHandler)(nil)
+
+// NewExtAuthzHandler creates an ext_authz adapter using the given header mapping
+func NewExtAuthzHandler(evaluationService service.EvaluationService, cfg config.ExtAuthzConfig, opts ...Option) *ExtAuthzHandler {
+	return &ExtAuthzHandler{
+		evaluationService: evaluationService,
+		config:            cfg,
+		options:           applyOptions(opts),
+	}
+}
+
+// ServeHTTP evaluates the forwarded request and answers with an allow or deny decision
+func (h *ExtAuthzHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logging.FromContext(ctx)
+
+	evaluationRequest, err := h.toEvaluationRequest(r)
+	if err != nil {
+		log.Warn("ext_authz request invalid", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.addPlatformLabels(ctx, evaluationRequest.RequestLabels)
+
+	if err := authz.Authorize(ctx, h.authorizer, authz.ActionEvaluate); err != nil {
+		log.Warn("ext_authz caller not authorized", "error", err)
+		status := http.StatusForbidden
+		if errors.Is(err, authz.ErrUnauthenticated) {
+			status = http.StatusUnauthorized
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	if decision := h.checkQuota(ctx, evaluationRequest.RequestLabels); decision != nil {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(decision.RetryAfter)))
+		http.Error(w, decision.Message(), http.StatusTooManyRequests)
+		return
+	}
+
+	response, err := h.evaluationService.EvaluateRequest(ctx, evaluationRequest)
+	if err != nil {
+		logServiceError(ctx, "ext_authz evaluation failed", err)
+		serviceErr, ok := err.(*service.ServiceError)
+		switch {
+		case ok && (serviceErr.Type == service.ErrorTypeRejected || serviceErr.Type == service.ErrorTypePolicyConflict):
+			http.Error(w, serviceErr.Detail, http.StatusForbidden)
+		case ok && serviceErr.Type == service.ErrorTypeInvalidArgument:
+			http.Error(w, serviceErr.Message, http.StatusBadRequest)
+		case ok && serviceErr.Type == service.ErrorTypeUnavailable:
+			http.Error(w, serviceErr.Message, http.StatusServiceUnavailable)
+		default:
+			http.Error(w, "Internal server error", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	log.Debug("ext_authz request allowed", "status", response.Status, "selected_provider", response.SelectedProvider)
+	w.Header().Set(ExtAuthzStatusHeader, string(response.Status))
+	w.Header().Set(ExtAuthzStatusReasonHeader, string(response.StatusReason))
+	if response.SelectedProvider != "" {
+		w.Header().Set(ExtAuthzProviderHeader, response.SelectedProvider)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// toEvaluationRequest builds the service instance spec and request labels from the
+// forwarded request according to the configured header and body mapping
+func (h *ExtAuthzHandler) toEvaluationRequest(r *http.Request) (*service.EvaluationRequest, error) {
+	spec := map[string]any{"service_type": h.config.ServiceType}
+	labels := map[string]string{"service_type": h.config.ServiceType}
+
+	if h.config.BodyField != "" {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxExtAuthzBodySize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		if len(body) > maxExtAuthzBodySize {
+			return nil, fmt.Errorf("request body exceeds the maximum size of %d bytes", maxExtAuthzBodySize)
+		}
+		if len(body) > 0 {
+			var parsed any
+			if err := json.Unmarshal(body, &parsed); err != nil {
+				return nil, fmt.Errorf("request body is not valid JSON: %w", err)
+			}
+			setSpecField(spec, h.config.BodyField, parsed)
+		}
+	}
+
+	for source, field := range h.config.SpecHeaders {
+		if value, ok := headerValue(r, source); ok {
+			setSpecField(spec, field, value)
+		}
+	}
+
+	specLabels := map[string]any{}
+	for header, label := range h.config.LabelHeaders {
+		if value, ok := headerValue(r, header); ok {
+			labels[label] = value
+			specLabels[label] = value
+		}
+	}
+	if len(specLabels) > 0 {
+		setSpecField(spec, "metadata.labels", specLabels)
+	}
+
+	return &service.EvaluationRequest{
+		ServiceInstance: spec,
+		RequestLabels:   labels,
+	}, nil
+}
+
+// headerValue returns a request header, or the method/path for the pseudo-header sources
+func headerValue(r *http.Request, name string) (string, bool) {
+	switch name {
+	case methodPseudoHeader:
+		return r.Method, true
+	case pathPseudoHeader:
+		return r.URL.RequestURI(), true
+	}
+	values := r.Header.Values(name)
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.Join(values, ","), true
+}
+
+// setSpecField sets a dot-separated field path in spec, creating intermediate objects
+func setSpecField(spec map[string]any, fieldPath string, value any) {
+	segments := strings.Split(fieldPath, ".")
+	current := spec
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+	current[segments[len(segments)-1]] = value
+}
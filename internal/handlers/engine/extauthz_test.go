@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type mockEvaluationService struct {
+	request           *service.EvaluationRequest
+	response          *service.EvaluationResponse
+	compositeRequest  *service.CompositeEvaluationRequest
+	compositeResponse *service.CompositeEvaluationResponse
+	session           *service.EvaluationSession
+	sessionID         string
+	sessionSpec       map[string]any
+	sessionResponse   *service.SessionEvaluationResponse
+	err               error
+}
+
+func (m *mockEvaluationService) EvaluateRequest(_ context.Context, req *service.EvaluationRequest) (*service.EvaluationResponse, error) {
+	m.request = req
+	return m.response, m.err
+}
+
+func (m *mockEvaluationService) EvaluateComposite(_ context.Context, req *service.CompositeEvaluationRequest) (*service.CompositeEvaluationResponse, error) {
+	m.compositeRequest = req
+	return m.compositeResponse, m.err
+}
+
+func (m *mockEvaluationService) CreateSession(_ context.Context) (*service.EvaluationSession, error) {
+	return m.session, m.err
+}
+
+func (m *mockEvaluationService) EvaluateSession(_ context.Context, id string, spec map[string]any) (*service.SessionEvaluationResponse, error) {
+	m.sessionID = id
+	m.sessionSpec = spec
+	return m.sessionResponse, m.err
+}
+
+func (m *mockEvaluationService) FinalizeSession(_ context.Context, id string) (*service.EvaluationResponse, error) {
+	m.sessionID = id
+	return m.response, m.err
+}
+
+var _ = Describe("ExtAuthzHandler", func() {
+	var (
+		evaluationService *mockEvaluationService
+		handler           *ExtAuthzHandler
+	)
+
+	BeforeEach(func() {
+		evaluationService = &mockEvaluationService{
+			response: &service.EvaluationResponse{Status: service.EvaluationStatusApproved},
+		}
+		handler = NewExtAuthzHandler(evaluationService, config.ExtAuthzConfig{
+			ServiceType:  "http_request",
+			LabelHeaders: map[string]string{"x-tenant": "tenant"},
+			SpecHeaders: map[string]string{
+				"@method":  "request.method",
+				"@path":    "request.path",
+				"x-region": "region",
+			},
+			BodyField: "request.body",
+		})
+	})
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, r)
+		return recorder
+	}
+
+	It("maps headers and body to the spec and request labels", func() {
+		r := httptest.NewRequest(http.MethodPost, "/vms?dry=1", strings.NewReader(`{"cpu": 4}`))
+		r.Header.Set("X-Tenant", "team-a")
+		r.Header.Set("X-Region", "eu-west-1")
+
+		recorder := serve(r)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(evaluationService.request.RequestLabels).To(Equal(map[string]string{
+			"service_type": "http_request",
+			"tenant":       "team-a",
+		}))
+		Expect(evaluationService.request.ServiceInstance).To(Equal(map[string]any{
+			"service_type": "http_request",
+			"region":       "eu-west-1",
+			"request": map[string]any{
+				"method": "POST",
+				"path":   "/vms?dry=1",
+				"body":   map[string]any{"cpu": float64(4)},
+			},
+			"metadata": map[string]any{
+				"labels": map[string]any{"tenant": "team-a"},
+			},
+		}))
+	})
+
+	It("returns the status, status reason and selected provider headers when allowed", func() {
+		evaluationService.response = &service.EvaluationResponse{
+			Status:           service.EvaluationStatusModified,
+			StatusReason:     service.StatusReasonPatched,
+			SelectedProvider: "aws",
+		}
+
+		recorder := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get(ExtAuthzStatusHeader)).To(Equal("MODIFIED"))
+		Expect(recorder.Header().Get(ExtAuthzStatusReasonHeader)).To(Equal("PATCHED"))
+		Expect(recorder.Header().Get(ExtAuthzProviderHeader)).To(Equal("aws"))
+	})
+
+	It("denies with 403 and the rejection reason when a policy rejects", func() {
+		evaluationService.err = service.NewPolicyRejectedError("deny-all", "tenant is suspended")
+
+		recorder := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		Expect(recorder.Body.String()).To(ContainSubstring("tenant is suspended"))
+	})
+
+	It("returns 400 when the body is not JSON", func() {
+		recorder := serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader("not json")))
+
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(evaluationService.request).To(BeNil())
+	})
+
+	It("returns 500 on internal evaluation errors", func() {
+		evaluationService.err = service.NewInternalError("Evaluation failed", "engine unavailable", nil)
+
+		recorder := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("returns 401 to callers the authorizer cannot identify", func() {
+		handler := NewExtAuthzHandler(evaluationService, config.ExtAuthzConfig{},
+			WithAuthorizer(authz.NewIdentityAuthorizer(nil)))
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusUnauthorized))
+		Expect(evaluationService.request).To(BeNil())
+	})
+
+	It("returns 503 when policies are unavailable", func() {
+		evaluationService.err = service.NewUnavailableError("Failed to retrieve policies", "database unavailable", nil)
+
+		recorder := serve(httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+	})
+})
@@ -3,24 +3,104 @@ package engine
 
 import (
 	"context"
+	"errors"
 
 	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
+	"github.com/dcm-project/policy-manager/internal/authz"
 	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/quota"
 	"github.com/dcm-project/policy-manager/internal/service"
 )
 
 // Handler implements the engine API
 type Handler struct {
 	evaluationService service.EvaluationService
+	options
 }
 
 var _ engineserver.StrictServerInterface = (*Handler)(nil)
 
+// Option configures optional features shared by the engine handlers
+type Option func(*options)
+
+type options struct {
+	limiter       *quota.Limiter
+	authorizer    authz.Authorizer
+	defaultLabels map[string]string
+}
+
+// WithQuotaLimiter rejects evaluations with 429 once a tenant or service type exceeds its quota
+func WithQuotaLimiter(limiter *quota.Limiter) Option {
+	return func(o *options) {
+		o.limiter = limiter
+	}
+}
+
+// WithAuthorizer authorizes every caller before it evaluates, and again before it gets an
+// explanation. Without it every caller is allowed.
+func WithAuthorizer(authorizer authz.Authorizer) Option {
+	return func(o *options) {
+		o.authorizer = authorizer
+	}
+}
+
+func applyOptions(opts []Option) options {
+	o := options{authorizer: authz.AllowAll{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 // NewHandler creates a new engine handler
-func NewHandler(evaluationService service.EvaluationService) *Handler {
+func NewHandler(evaluationService service.EvaluationService, opts ...Option) *Handler {
 	return &Handler{
 		evaluationService: evaluationService,
+		options:           applyOptions(opts),
+	}
+}
+
+// checkQuota returns the rejected quota decision, or nil when the evaluation may proceed
+func (o options) checkQuota(ctx context.Context, labels map[string]string) *quota.Decision {
+	if o.limiter == nil {
+		return nil
+	}
+	decision := o.limiter.Allow(labels)
+	if decision.Allowed {
+		return nil
+	}
+	logging.FromContext(ctx).Warn("Evaluation quota exceeded",
+		"scope", decision.Scope,
+		"key", decision.Key,
+		"limit", decision.Limit,
+	)
+	return &decision
+}
+
+// authorize returns the 401 or 403 response denying the caller of ctx one of the actions,
+// or nil when all of them are allowed
+func (o options) authorize(ctx context.Context, actions ...authz.Action) engineserver.EvaluateRequestResponseObject {
+	for _, action := range actions {
+		err := authz.Authorize(ctx, o.authorizer, action)
+		if err == nil {
+			continue
+		}
+		logging.FromContext(ctx).Warn("Engine API caller not authorized", "action", action, "error", err)
+		if errors.Is(err, authz.ErrUnauthenticated) {
+			return unauthenticated(err.Error())
+		}
+		return forbidden(err.Error())
+	}
+	return nil
+}
+
+// evaluationActions returns the actions a caller must be authorized for to evaluate with
+// or without an explanation
+func evaluationActions(explain bool) []authz.Action {
+	if explain {
+		return []authz.Action{authz.ActionEvaluate, authz.ActionExplain}
 	}
+	return []authz.Action{authz.ActionEvaluate}
 }
 
 // EvaluateRequest evaluates a service instance request against policies
@@ -34,6 +114,15 @@ func (h *Handler) EvaluateRequest(ctx context.Context, request engineserver.Eval
 		log.Warn("EvaluateRequest invalid input", "error", err)
 		return h.badRequest(err.Error()), nil
 	}
+	h.addPlatformLabels(ctx, evaluationRequest.RequestLabels)
+
+	if denied := h.authorize(ctx, evaluationActions(evaluationRequest.Explain)...); denied != nil {
+		return denied, nil
+	}
+
+	if decision := h.checkQuota(ctx, evaluationRequest.RequestLabels); decision != nil {
+		return h.quotaExceeded(*decision), nil
+	}
 
 	// Call evaluation service
 	response, err := h.evaluationService.EvaluateRequest(ctx, evaluationRequest)
@@ -44,9 +133,137 @@ func (h *Handler) EvaluateRequest(ctx context.Context, request engineserver.Eval
 
 	log.Info("EvaluateRequest completed",
 		"status", response.Status,
+		"status_reason", response.StatusReason,
 		"selected_provider", response.SelectedProvider,
+		"dry_run", response.DryRun,
 	)
 
 	// Map service response to API response
 	return engineserver.EvaluateRequest200JSONResponse(toEngineEvaluationResponse(response)), nil
 }
+
+// EvaluateComposite evaluates related service instances together against policies
+func (h *Handler) EvaluateComposite(ctx context.Context, request engineserver.EvaluateCompositeRequestObject) (engineserver.EvaluateCompositeResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("EvaluateComposite received")
+
+	compositeRequest, err := toServiceCompositeRequest(request)
+	if err != nil {
+		log.Warn("EvaluateComposite invalid input", "error", err)
+		return compositeResponse(h.badRequest(err.Error())), nil
+	}
+	for _, instance := range compositeRequest.Instances {
+		h.addPlatformLabels(ctx, instance.RequestLabels)
+	}
+
+	if denied := h.authorize(ctx, authz.ActionEvaluate); denied != nil {
+		return compositeResponse(denied), nil
+	}
+
+	// Every instance is an evaluation as far as quotas are concerned
+	for _, instance := range compositeRequest.Instances {
+		if decision := h.checkQuota(ctx, instance.RequestLabels); decision != nil {
+			return compositeResponse(h.quotaExceeded(*decision)), nil
+		}
+	}
+
+	response, err := h.evaluationService.EvaluateComposite(ctx, compositeRequest)
+	if err != nil {
+		logServiceError(ctx, "EvaluateComposite failed", err)
+		return compositeResponse(h.handleError(err)), nil
+	}
+
+	log.Info("EvaluateComposite completed",
+		"status", response.Status,
+		"instances", len(response.Instances),
+	)
+	return engineserver.EvaluateComposite200JSONResponse(toEngineCompositeResponse(response)), nil
+}
+
+// CreateEvaluationSession opens an evaluation session
+func (h *Handler) CreateEvaluationSession(ctx context.Context, _ engineserver.CreateEvaluationSessionRequestObject) (engineserver.CreateEvaluationSessionResponseObject, error) {
+	switch denied := h.authorize(ctx, authz.ActionEvaluate).(type) {
+	case engineserver.EvaluateRequest401JSONResponse:
+		return engineserver.CreateEvaluationSession401JSONResponse{UnauthorizedJSONResponse: denied.UnauthorizedJSONResponse}, nil
+	case engineserver.EvaluateRequest403JSONResponse:
+		return engineserver.CreateEvaluationSession403JSONResponse{ForbiddenJSONResponse: denied.ForbiddenJSONResponse}, nil
+	}
+
+	session, err := h.evaluationService.CreateSession(ctx)
+	if err != nil {
+		logServiceError(ctx, "CreateEvaluationSession failed", err)
+		var serviceErr *service.ServiceError
+		if errors.As(err, &serviceErr) && serviceErr.Type == service.ErrorTypeResourceExhausted {
+			return engineserver.CreateEvaluationSession429JSONResponse{
+				Type:   "about:blank",
+				Status: 429,
+				Title:  serviceErr.Message,
+				Detail: &serviceErr.Detail,
+			}, nil
+		}
+		detail := "An unexpected error occurred"
+		return engineserver.CreateEvaluationSession500JSONResponse{
+			InternalServerErrorJSONResponse: engineserver.InternalServerErrorJSONResponse{
+				Type:   "about:blank",
+				Status: 500,
+				Title:  "Internal server error",
+				Detail: &detail,
+			},
+		}, nil
+	}
+
+	logging.FromContext(ctx).Info("Evaluation session opened", "session_id", session.ID)
+	return engineserver.CreateEvaluationSession201JSONResponse{
+		Id:         session.ID,
+		ExpireTime: session.ExpireTime,
+	}, nil
+}
+
+// EvaluateSession evaluates the next step of an evaluation session
+func (h *Handler) EvaluateSession(ctx context.Context, request engineserver.EvaluateSessionRequestObject) (engineserver.EvaluateSessionResponseObject, error) {
+	log := logging.FromContext(ctx)
+	if denied := h.authorize(ctx, authz.ActionEvaluate); denied != nil {
+		return evaluateSessionResponse(denied), nil
+	}
+	if request.Body == nil {
+		return evaluateSessionResponse(h.badRequest("request body is required")), nil
+	}
+
+	response, err := h.evaluationService.EvaluateSession(ctx, request.SessionId, request.Body.ServiceInstance.Spec)
+	if err != nil {
+		logServiceError(ctx, "EvaluateSession failed", err, "session_id", request.SessionId)
+		if notFound, ok := sessionNotFound(err); ok {
+			return engineserver.EvaluateSession404JSONResponse{SessionNotFoundJSONResponse: notFound}, nil
+		}
+		return evaluateSessionResponse(h.handleError(err)), nil
+	}
+
+	log.Info("EvaluateSession completed",
+		"session_id", request.SessionId,
+		"status", response.Status,
+		"constraints", len(response.Constraints),
+	)
+	return engineserver.EvaluateSession200JSONResponse(toEngineSessionResponse(response)), nil
+}
+
+// FinalizeSession evaluates the accumulated spec of a session and closes it
+func (h *Handler) FinalizeSession(ctx context.Context, request engineserver.FinalizeSessionRequestObject) (engineserver.FinalizeSessionResponseObject, error) {
+	if denied := h.authorize(ctx, authz.ActionEvaluate); denied != nil {
+		return finalizeSessionResponse(denied), nil
+	}
+	response, err := h.evaluationService.FinalizeSession(ctx, request.SessionId)
+	if err != nil {
+		logServiceError(ctx, "FinalizeSession failed", err, "session_id", request.SessionId)
+		if notFound, ok := sessionNotFound(err); ok {
+			return engineserver.FinalizeSession404JSONResponse{SessionNotFoundJSONResponse: notFound}, nil
+		}
+		return finalizeSessionResponse(h.handleError(err)), nil
+	}
+
+	logging.FromContext(ctx).Info("FinalizeSession completed",
+		"session_id", request.SessionId,
+		"status", response.Status,
+		"selected_provider", response.SelectedProvider,
+	)
+	return engineserver.FinalizeSession200JSONResponse(toEngineEvaluationResponse(response)), nil
+}
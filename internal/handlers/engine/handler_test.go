@@ -0,0 +1,495 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	engineserver "github.com/dcm-project/policy-manager/internal/api/engine"
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/quota"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	Describe("EvaluateRequest with a quota limiter", func() {
+		var (
+			evaluationService *mockEvaluationService
+			handler           *Handler
+		)
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{
+				response: &service.EvaluationResponse{Status: service.EvaluationStatusApproved},
+			}
+			limiter, err := quota.NewLimiter(config.QuotaConfig{TenantLabel: "tenant", PerTenant: 1})
+			Expect(err).NotTo(HaveOccurred())
+			handler = NewHandler(evaluationService, WithQuotaLimiter(limiter))
+		})
+
+		request := func(tenant string) engineserver.EvaluateRequestRequestObject {
+			return engineserver.EvaluateRequestRequestObject{
+				Body: &engineserver.EvaluateRequestJSONRequestBody{
+					ServiceInstance: engineserver.ServiceInstance{
+						Spec: map[string]any{
+							"service_type": "vm",
+							"metadata":     map[string]any{"labels": map[string]any{"tenant": tenant}},
+						},
+					},
+				},
+			}
+		}
+
+		It("returns 429 with Retry-After once the tenant quota is exhausted", func() {
+			response, err := handler.EvaluateRequest(context.Background(), request("handler-quota-tenant"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(engineserver.EvaluateRequest200JSONResponse{}))
+
+			response, err = handler.EvaluateRequest(context.Background(), request("handler-quota-tenant"))
+			Expect(err).NotTo(HaveOccurred())
+			exceeded, ok := response.(engineserver.EvaluateRequest429JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest429JSONResponse")
+			Expect(exceeded.Body.Status).To(Equal(int32(429)))
+			Expect(*exceeded.Body.Detail).To(ContainSubstring("handler-quota-tenant"))
+			Expect(*exceeded.Headers.RetryAfter).To(Equal(60))
+		})
+
+		It("does not call the evaluation service when the quota is exhausted", func() {
+			_, _ = handler.EvaluateRequest(context.Background(), request("handler-quota-skip"))
+			evaluationService.request = nil
+
+			_, err := handler.EvaluateRequest(context.Background(), request("handler-quota-skip"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.request).To(BeNil())
+		})
+	})
+
+	Describe("EvaluateRequest as a dry run", func() {
+		var evaluationService *mockEvaluationService
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{}
+		})
+
+		request := func(dryRun *bool) engineserver.EvaluateRequestRequestObject {
+			return engineserver.EvaluateRequestRequestObject{
+				Params: engineserver.EvaluateRequestParams{DryRun: dryRun},
+				Body: &engineserver.EvaluateRequestJSONRequestBody{
+					ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+				},
+			}
+		}
+
+		It("passes the flag to the service and annotates the response", func() {
+			dryRun := true
+			evaluationService.response = &service.EvaluationResponse{Status: service.EvaluationStatusApproved, DryRun: true}
+
+			response, err := NewHandler(evaluationService).EvaluateRequest(context.Background(), request(&dryRun))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.request.DryRun).To(BeTrue())
+			result, ok := response.(engineserver.EvaluateRequest200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest200JSONResponse")
+			Expect(result.DryRun).To(HaveValue(BeTrue()))
+		})
+
+		It("leaves dry_run out of regular responses", func() {
+			evaluationService.response = &service.EvaluationResponse{Status: service.EvaluationStatusApproved}
+
+			response, err := NewHandler(evaluationService).EvaluateRequest(context.Background(), request(nil))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.request.DryRun).To(BeFalse())
+			Expect(response.(engineserver.EvaluateRequest200JSONResponse).DryRun).To(BeNil())
+		})
+	})
+
+	Describe("EvaluateRequest with platform labels", func() {
+		var (
+			evaluationService *mockEvaluationService
+			handler           *Handler
+		)
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{
+				response: &service.EvaluationResponse{Status: service.EvaluationStatusApproved},
+			}
+			handler = NewHandler(evaluationService, WithDefaultRequestLabels(map[string]string{
+				"cluster":     "prod-east",
+				"environment": "production",
+			}))
+		})
+
+		request := func(labels map[string]any) engineserver.EvaluateRequestRequestObject {
+			return engineserver.EvaluateRequestRequestObject{
+				Body: &engineserver.EvaluateRequestJSONRequestBody{
+					ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{
+						"service_type": "vm",
+						"metadata":     map[string]any{"labels": labels},
+					}},
+				},
+			}
+		}
+
+		It("adds the default labels the spec does not set", func() {
+			_, err := handler.EvaluateRequest(context.Background(), request(map[string]any{"environment": "staging"}))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.request.RequestLabels).To(Equal(map[string]string{
+				"service_type": "vm",
+				"cluster":      "prod-east",
+				"environment":  "staging",
+			}))
+		})
+
+		It("prefers the labels read from request headers to the defaults", func() {
+			ctx := ContextWithPlatformLabels(context.Background(), map[string]string{"cluster": "prod-west"})
+
+			_, err := handler.EvaluateRequest(ctx, request(nil))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.request.RequestLabels).To(HaveKeyWithValue("cluster", "prod-west"))
+			Expect(evaluationService.request.RequestLabels).To(HaveKeyWithValue("environment", "production"))
+		})
+
+		It("adds them to every instance of a composite request", func() {
+			evaluationService.compositeResponse = &service.CompositeEvaluationResponse{Status: service.EvaluationStatusApproved}
+
+			_, err := handler.EvaluateComposite(context.Background(), engineserver.EvaluateCompositeRequestObject{
+				Body: &engineserver.EvaluateCompositeJSONRequestBody{ServiceInstances: []engineserver.NamedServiceInstance{
+					{Name: "database", Spec: map[string]any{"service_type": "db"}},
+					{Name: "app", Spec: map[string]any{"service_type": "vm"}},
+				}},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			for _, instance := range evaluationService.compositeRequest.Instances {
+				Expect(instance.RequestLabels).To(HaveKeyWithValue("cluster", "prod-east"))
+			}
+		})
+	})
+
+	Describe("EvaluateRequest when policies are unavailable", func() {
+		var evaluationService *mockEvaluationService
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{}
+		})
+
+		request := engineserver.EvaluateRequestRequestObject{
+			Body: &engineserver.EvaluateRequestJSONRequestBody{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+			},
+		}
+
+		It("returns 503 when the request fails closed", func() {
+			evaluationService.err = service.NewUnavailableError("Failed to retrieve policies", "database unavailable", nil)
+
+			response, err := NewHandler(evaluationService).EvaluateRequest(context.Background(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			unavailable, ok := response.(engineserver.EvaluateRequest503JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest503JSONResponse")
+			Expect(unavailable.Status).To(Equal(int32(503)))
+			Expect(unavailable.Title).To(Equal("Failed to retrieve policies"))
+		})
+
+		It("flags approvals that failed open", func() {
+			evaluationService.response = &service.EvaluationResponse{Status: service.EvaluationStatusApproved, FailedOpen: true}
+
+			response, err := NewHandler(evaluationService).EvaluateRequest(context.Background(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.(engineserver.EvaluateRequest200JSONResponse).FailedOpen).To(HaveValue(BeTrue()))
+		})
+	})
+
+	Describe("EvaluateRequest in explain mode", func() {
+		request := engineserver.EvaluateRequestRequestObject{
+			Body: &engineserver.EvaluateRequestJSONRequestBody{
+				ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+			},
+		}
+
+		It("includes the trace in a 409 response", func() {
+			conflict := service.NewConstraintViolationError("sizes", []constraints.Violation{
+				{FieldPath: "size", Reason: "value large violates constraint", SetByPolicy: "limits"},
+			})
+			conflict.Explanation = &service.Explanation{
+				InputSpec:     map[string]any{"service_type": "vm"},
+				RequestLabels: map[string]string{"service_type": "vm"},
+				Policies: []service.PolicyTrace{
+					{PolicyID: "limits", Outcome: service.PolicyOutcomeApplied},
+					{PolicyID: "sizes", Outcome: service.PolicyOutcomeFailed, Reason: conflict.Message},
+				},
+				SkippedPolicies: []service.SkippedPolicy{},
+				FieldProvenance: map[string]string{},
+			}
+
+			response, err := NewHandler(&mockEvaluationService{err: conflict}).EvaluateRequest(context.Background(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(engineserver.EvaluateRequest409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest409JSONResponse")
+			Expect(result.Explanation).NotTo(BeNil())
+			Expect(result.Explanation.Policies).To(HaveLen(2))
+			Expect(result.Explanation.Policies[1].Outcome).To(Equal(engineserver.FAILED))
+			Expect(result.Explanation.Policies[1].Reason).To(HaveValue(Equal(conflict.Message)))
+			Expect(result.Explanation.InputSpec).To(Equal(map[string]any{"service_type": "vm"}))
+			Expect(result.Explanation.RequestLabels).To(Equal(map[string]string{"service_type": "vm"}))
+		})
+
+		It("leaves the trace out of errors outside explain mode", func() {
+			rejected := service.NewPolicyRejectedError("regions", "region not allowed")
+
+			response, err := NewHandler(&mockEvaluationService{err: rejected}).EvaluateRequest(context.Background(), request)
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(engineserver.EvaluateRequest406JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest406JSONResponse")
+			Expect(result.Explanation).To(BeNil())
+		})
+	})
+
+	Describe("with an authorizer", func() {
+		var (
+			evaluationService *mockEvaluationService
+			handler           *Handler
+		)
+
+		admin := authz.ContextWithCaller(context.Background(), authz.Caller{Authenticated: true, Identities: []string{"policy-admin"}})
+		orchestrator := authz.ContextWithCaller(context.Background(), authz.Caller{Authenticated: true, Identities: []string{"orchestrator"}})
+
+		request := func(explain bool) engineserver.EvaluateRequestRequestObject {
+			return engineserver.EvaluateRequestRequestObject{
+				Params: engineserver.EvaluateRequestParams{Explain: &explain},
+				Body: &engineserver.EvaluateRequestJSONRequestBody{
+					ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{
+				response: &service.EvaluationResponse{Status: service.EvaluationStatusApproved},
+				session:  &service.EvaluationSession{ID: "session-1"},
+			}
+			handler = NewHandler(evaluationService, WithAuthorizer(authz.NewIdentityAuthorizer(map[authz.Action][]string{
+				authz.ActionExplain: {"policy-admin"},
+			})))
+		})
+
+		It("returns 401 to callers without a client certificate", func() {
+			response, err := handler.EvaluateRequest(context.Background(), request(false))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(engineserver.EvaluateRequest401JSONResponse{}))
+			Expect(evaluationService.request).To(BeNil())
+
+			session, err := handler.CreateEvaluationSession(context.Background(), engineserver.CreateEvaluationSessionRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(session).To(BeAssignableToTypeOf(engineserver.CreateEvaluationSession401JSONResponse{}))
+		})
+
+		It("keeps explanations to the identities allowed them", func() {
+			response, err := handler.EvaluateRequest(orchestrator, request(false))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(engineserver.EvaluateRequest200JSONResponse{}))
+
+			response, err = handler.EvaluateRequest(orchestrator, request(true))
+			Expect(err).NotTo(HaveOccurred())
+			denied, ok := response.(engineserver.EvaluateRequest403JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateRequest403JSONResponse")
+			Expect(*denied.Detail).To(ContainSubstring("client 'orchestrator' may not explain"))
+
+			response, err = handler.EvaluateRequest(admin, request(true))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(engineserver.EvaluateRequest200JSONResponse{}))
+		})
+	})
+
+	Describe("EvaluateComposite", func() {
+		var (
+			evaluationService *mockEvaluationService
+			handler           *Handler
+		)
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{}
+			handler = NewHandler(evaluationService)
+		})
+
+		request := func(instances ...engineserver.NamedServiceInstance) engineserver.EvaluateCompositeRequestObject {
+			return engineserver.EvaluateCompositeRequestObject{
+				Body: &engineserver.EvaluateCompositeJSONRequestBody{ServiceInstances: instances},
+			}
+		}
+
+		It("returns the result of every instance", func() {
+			evaluationService.compositeResponse = &service.CompositeEvaluationResponse{
+				Instances: []service.CompositeInstanceResult{{
+					Name: "database",
+					EvaluationResponse: service.EvaluationResponse{
+						EvaluatedServiceInstance: map[string]any{"service_type": "db", "region": "us-east-1"},
+						SelectedProvider:         "aws",
+						Status:                   service.EvaluationStatusModified,
+					},
+				}},
+				Status:            service.EvaluationStatusModified,
+				CompositePolicies: []string{"same-region"},
+			}
+
+			response, err := handler.EvaluateComposite(context.Background(), request(
+				engineserver.NamedServiceInstance{Name: "database", Spec: map[string]any{"service_type": "db"}},
+			))
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(engineserver.EvaluateComposite200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateComposite200JSONResponse")
+			Expect(result.Status).To(Equal(engineserver.CompositeEvaluateResponseStatusMODIFIED))
+			Expect(result.CompositePolicies).To(Equal([]string{"same-region"}))
+			Expect(result.ServiceInstances).To(HaveLen(1))
+			Expect(result.ServiceInstances[0].SelectedProvider).To(Equal("aws"))
+			Expect(evaluationService.compositeRequest.Instances[0].RequestLabels).To(Equal(map[string]string{"service_type": "db"}))
+		})
+
+		It("returns 400 naming an instance without a service type", func() {
+			response, err := handler.EvaluateComposite(context.Background(), request(
+				engineserver.NamedServiceInstance{Name: "cache", Spec: map[string]any{}},
+			))
+
+			Expect(err).NotTo(HaveOccurred())
+			badRequest, ok := response.(engineserver.EvaluateComposite400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateComposite400JSONResponse")
+			Expect(*badRequest.Detail).To(Equal("service instance 'cache': service type is required"))
+			Expect(evaluationService.compositeRequest).To(BeNil())
+		})
+
+		It("maps a rejection to 406", func() {
+			evaluationService.err = service.NewPolicyRejectedError("same-region", "all tiers must share one region")
+
+			response, err := handler.EvaluateComposite(context.Background(), request(
+				engineserver.NamedServiceInstance{Name: "database", Spec: map[string]any{"service_type": "db"}},
+			))
+
+			Expect(err).NotTo(HaveOccurred())
+			rejected, ok := response.(engineserver.EvaluateComposite406JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateComposite406JSONResponse")
+			Expect(*rejected.Detail).To(Equal("all tiers must share one region"))
+		})
+	})
+
+	Describe("evaluation sessions", func() {
+		var (
+			evaluationService *mockEvaluationService
+			handler           *Handler
+			expireTime        time.Time
+		)
+
+		BeforeEach(func() {
+			evaluationService = &mockEvaluationService{}
+			handler = NewHandler(evaluationService)
+			expireTime = time.Date(2026, 1, 1, 0, 15, 0, 0, time.UTC)
+		})
+
+		It("opens a session", func() {
+			evaluationService.session = &service.EvaluationSession{ID: "session-1", ExpireTime: expireTime}
+
+			response, err := handler.CreateEvaluationSession(context.Background(), engineserver.CreateEvaluationSessionRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(engineserver.CreateEvaluationSession201JSONResponse{Id: "session-1", ExpireTime: expireTime}))
+		})
+
+		It("returns 429 when too many sessions are open", func() {
+			evaluationService.err = service.NewTooManySessionsError(2)
+
+			response, err := handler.CreateEvaluationSession(context.Background(), engineserver.CreateEvaluationSessionRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			exhausted, ok := response.(engineserver.CreateEvaluationSession429JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreateEvaluationSession429JSONResponse")
+			Expect(*exhausted.Detail).To(ContainSubstring("At most 2 evaluation sessions"))
+		})
+
+		It("evaluates a step and returns the accumulated constraints", func() {
+			evaluationService.sessionResponse = &service.SessionEvaluationResponse{
+				EvaluationResponse: service.EvaluationResponse{
+					EvaluatedServiceInstance: map[string]any{"service_type": "vm", "region": "us-east-1"},
+					Status:                   service.EvaluationStatusModified,
+					DryRun:                   true,
+				},
+				Constraints: map[string]any{"region": map[string]any{"enum": []any{"us-east-1", "us-west-2"}}},
+				ExpireTime:  expireTime,
+			}
+
+			response, err := handler.EvaluateSession(context.Background(), engineserver.EvaluateSessionRequestObject{
+				SessionId: "session-1",
+				Body: &engineserver.EvaluateSessionJSONRequestBody{
+					ServiceInstance: engineserver.ServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(evaluationService.sessionID).To(Equal("session-1"))
+			Expect(evaluationService.sessionSpec).To(Equal(map[string]any{"service_type": "vm"}))
+			result, ok := response.(engineserver.EvaluateSession200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateSession200JSONResponse")
+			Expect(result.Evaluation.Status).To(Equal(engineserver.MODIFIED))
+			Expect(result.Evaluation.DryRun).To(HaveValue(BeTrue()))
+			Expect(result.Constraints).To(Equal(map[string]map[string]any{"region": {"enum": []any{"us-east-1", "us-west-2"}}}))
+			Expect(result.ExpireTime).To(Equal(expireTime))
+		})
+
+		It("returns 404 for an unknown session", func() {
+			evaluationService.err = service.NewSessionNotFoundError("missing")
+
+			response, err := handler.EvaluateSession(context.Background(), engineserver.EvaluateSessionRequestObject{
+				SessionId: "missing",
+				Body:      &engineserver.EvaluateSessionJSONRequestBody{},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			notFound, ok := response.(engineserver.EvaluateSession404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be EvaluateSession404JSONResponse")
+			Expect(*notFound.Detail).To(Equal("Evaluation session 'missing' does not exist or has expired"))
+		})
+
+		It("maps a finalize conflict to 409", func() {
+			evaluationService.err = service.NewSessionConstraintViolationError([]constraints.Violation{
+				{FieldPath: "region", Reason: "value eu-west-1 violates constraint", SetByPolicy: "regions"},
+			})
+
+			response, err := handler.FinalizeSession(context.Background(), engineserver.FinalizeSessionRequestObject{SessionId: "session-1"})
+
+			Expect(err).NotTo(HaveOccurred())
+			conflict, ok := response.(engineserver.FinalizeSession409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be FinalizeSession409JSONResponse")
+			Expect(*conflict.Detail).To(ContainSubstring("field 'region'"))
+		})
+
+		It("includes the suggestions of a conflict in the 409 response", func() {
+			evaluationService.err = service.NewSessionConstraintViolationError([]constraints.Violation{
+				{FieldPath: "region", Reason: "value eu-west-1 violates constraint", SetByPolicy: "regions",
+					Suggestion: &constraints.Suggestion{FieldPath: "region", SetByPolicy: "regions", AllowedValues: []any{"us-east-1"}}},
+				{FieldPath: "name", Reason: "value db-1 violates constraint", SetByPolicy: "names"},
+			})
+
+			response, err := handler.FinalizeSession(context.Background(), engineserver.FinalizeSessionRequestObject{SessionId: "session-1"})
+
+			Expect(err).NotTo(HaveOccurred())
+			conflict, ok := response.(engineserver.FinalizeSession409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be FinalizeSession409JSONResponse")
+			Expect(conflict.Suggestions).To(HaveValue(HaveLen(1)))
+			suggestion := (*conflict.Suggestions)[0]
+			Expect(suggestion.Field).To(HaveValue(Equal("region")))
+			Expect(suggestion.SetByPolicy).To(HaveValue(Equal("regions")))
+			Expect(suggestion.AllowedValues).To(HaveValue(Equal([]any{"us-east-1"})))
+			Expect(suggestion.NearestValue).To(BeNil())
+			Expect(suggestion.AllowedProviders).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package engine
+
+import "context"
+
+// platformLabelsKey is the context key of the request labels read from engine API request headers
+type platformLabelsKey struct{}
+
+// ContextWithPlatformLabels returns ctx carrying request labels read from the headers of an
+// engine API request. Evaluations add them to the request labels their spec does not set.
+func ContextWithPlatformLabels(ctx context.Context, labels map[string]string) context.Context {
+	return context.WithValue(ctx, platformLabelsKey{}, labels)
+}
+
+// WithDefaultRequestLabels adds labels to the request labels of every evaluation that does
+// not set them, so callers need not repeat deployment labels such as the cluster in every spec
+func WithDefaultRequestLabels(labels map[string]string) Option {
+	return func(o *options) {
+		o.defaultLabels = labels
+	}
+}
+
+// addPlatformLabels adds the header labels of ctx, then the default labels, to the request
+// labels that are not set yet; the labels of the spec itself always win
+func (o options) addPlatformLabels(ctx context.Context, requestLabels map[string]string) {
+	headerLabels, _ := ctx.Value(platformLabelsKey{}).(map[string]string)
+	for _, labels := range []map[string]string{headerLabels, o.defaultLabels} {
+		for key, value := range labels {
+			if _, ok := requestLabels[key]; !ok {
+				requestLabels[key] = value
+			}
+		}
+	}
+}
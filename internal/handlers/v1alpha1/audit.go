@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// errAuditNotConfigured is returned by the audit endpoints of a handler created without WithAuditService
+var errAuditNotConfigured = service.NewInternalError("Audit log is not available", "No audit service is configured", nil)
+
+// ListAuditEntries handles listing audit log entries.
+func (h *PolicyHandler) ListAuditEntries(ctx context.Context, request server.ListAuditEntriesRequestObject) (server.ListAuditEntriesResponseObject, error) {
+	logging.FromContext(ctx).Debug("ListAuditEntries request received")
+
+	if h.audit == nil {
+		return h.handleListAuditEntriesError(errAuditNotConfigured, request), nil
+	}
+	result, err := h.audit.ListAuditEntries(ctx, request.Params.PageToken, request.Params.MaxPageSize)
+	if err != nil {
+		logServiceError(ctx, "ListAuditEntries failed", err)
+		return h.handleListAuditEntriesError(err, request), nil
+	}
+
+	return server.ListAuditEntries200JSONResponse(auditEntryListV1Alpha1ToServer(*result)), nil
+}
+
+// VerifyAuditLog handles verifying the audit log hash chain.
+func (h *PolicyHandler) VerifyAuditLog(ctx context.Context, request server.VerifyAuditLogRequestObject) (server.VerifyAuditLogResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("VerifyAuditLog request received")
+
+	if h.audit == nil {
+		return h.handleVerifyAuditLogError(errAuditNotConfigured, request), nil
+	}
+	result, err := h.audit.VerifyAuditLog(ctx)
+	if err != nil {
+		logServiceError(ctx, "VerifyAuditLog failed", err)
+		return h.handleVerifyAuditLogError(err, request), nil
+	}
+
+	if !result.Valid {
+		log.Warn("Audit log verification failed", "sequence", *result.FirstInvalidSequence, "reason", *result.Reason)
+	}
+	return server.VerifyAuditLog200JSONResponse{
+		Valid:                result.Valid,
+		EntriesVerified:      result.EntriesVerified,
+		FirstInvalidSequence: result.FirstInvalidSequence,
+		Reason:               result.Reason,
+		HeadHash:             result.HeadHash,
+	}, nil
+}
+
+// ScrubAuditEntries handles removing a data subject's details from the audit log.
+func (h *PolicyHandler) ScrubAuditEntries(ctx context.Context, request server.ScrubAuditEntriesRequestObject) (server.ScrubAuditEntriesResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("ScrubAuditEntries called with nil body")
+		return h.handleScrubAuditEntriesError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	// The label value and user are the personal data being removed, so they are not logged
+	log.Debug("ScrubAuditEntries request received", "by_label", request.Body.LabelKey != nil, "by_user", request.Body.User != nil)
+
+	if h.audit == nil {
+		return h.handleScrubAuditEntriesError(errAuditNotConfigured, request), nil
+	}
+	result, err := h.audit.ScrubAuditEntries(ctx, auditScrubRequestServerToV1Alpha1(*request.Body))
+	if err != nil {
+		logServiceError(ctx, "ScrubAuditEntries failed", err)
+		return h.handleScrubAuditEntriesError(err, request), nil
+	}
+
+	return server.ScrubAuditEntries200JSONResponse{
+		Mode:              server.AuditScrubResultMode(result.Mode),
+		DryRun:            result.DryRun,
+		AffectedEntries:   result.AffectedEntries,
+		Sequences:         result.Sequences,
+		AffectedPolicies:  result.AffectedPolicies,
+		AffectedRevisions: result.AffectedRevisions,
+	}, nil
+}
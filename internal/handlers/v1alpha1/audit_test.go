@@ -0,0 +1,193 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// MockAuditService is a mock implementation of AuditService for testing
+type MockAuditService struct {
+	ListAuditEntriesFn  func(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.AuditEntryList, error)
+	VerifyAuditLogFn    func(ctx context.Context) (*v1alpha1.AuditVerification, error)
+	ScrubAuditEntriesFn func(ctx context.Context, req v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error)
+}
+
+func (m *MockAuditService) ListAuditEntries(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.AuditEntryList, error) {
+	if m.ListAuditEntriesFn != nil {
+		return m.ListAuditEntriesFn(ctx, pageToken, pageSize)
+	}
+	return &v1alpha1.AuditEntryList{}, nil
+}
+
+func (m *MockAuditService) VerifyAuditLog(ctx context.Context) (*v1alpha1.AuditVerification, error) {
+	if m.VerifyAuditLogFn != nil {
+		return m.VerifyAuditLogFn(ctx)
+	}
+	return &v1alpha1.AuditVerification{Valid: true}, nil
+}
+
+func (m *MockAuditService) ScrubAuditEntries(ctx context.Context, req v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error) {
+	if m.ScrubAuditEntriesFn != nil {
+		return m.ScrubAuditEntriesFn(ctx, req)
+	}
+	return &v1alpha1.AuditScrubResult{Sequences: []int64{}}, nil
+}
+
+var _ = Describe("Audit handlers", func() {
+	var (
+		mockAudit *MockAuditService
+		handler   *PolicyHandler
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		mockAudit = &MockAuditService{}
+		handler = NewPolicyHandler(&MockPolicyService{}, WithAuditService(mockAudit))
+		ctx = context.Background()
+	})
+
+	Describe("ListAuditEntries", func() {
+		It("should return the entries and pass paging parameters", func() {
+			var receivedToken *string
+			var receivedSize *int32
+			next := "next"
+			mockAudit.ListAuditEntriesFn = func(_ context.Context, pageToken *string, pageSize *int32) (*v1alpha1.AuditEntryList, error) {
+				receivedToken, receivedSize = pageToken, pageSize
+				return &v1alpha1.AuditEntryList{
+					Entries:       []v1alpha1.AuditEntry{{Sequence: 1, Type: "PolicyCreated", Subject: "policy-a", Hash: "abc"}},
+					NextPageToken: &next,
+				}, nil
+			}
+
+			token := "token"
+			size := int32(10)
+			response, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{
+				Params: server.ListAuditEntriesParams{PageToken: &token, MaxPageSize: &size},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			list, ok := response.(server.ListAuditEntries200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListAuditEntries200JSONResponse")
+			Expect(list.Entries).To(HaveLen(1))
+			Expect(list.Entries[0].Subject).To(Equal("policy-a"))
+			Expect(*list.NextPageToken).To(Equal("next"))
+			Expect(*receivedToken).To(Equal("token"))
+			Expect(*receivedSize).To(Equal(int32(10)))
+		})
+
+		It("should return 400 for an invalid page token", func() {
+			mockAudit.ListAuditEntriesFn = func(context.Context, *string, *int32) (*v1alpha1.AuditEntryList, error) {
+				return nil, service.NewInvalidArgumentError("Invalid page token", "page token is invalid")
+			}
+
+			response, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ListAuditEntries400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListAuditEntries400JSONResponse")
+		})
+
+		It("should return 500 without an audit service", func() {
+			handler = NewPolicyHandler(&MockPolicyService{})
+
+			response, err := handler.ListAuditEntries(ctx, server.ListAuditEntriesRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ListAuditEntries500JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListAuditEntries500JSONResponse")
+		})
+	})
+
+	Describe("VerifyAuditLog", func() {
+		It("should report a broken chain", func() {
+			sequence := int64(7)
+			reason := "hash does not match entry contents"
+			mockAudit.VerifyAuditLogFn = func(context.Context) (*v1alpha1.AuditVerification, error) {
+				return &v1alpha1.AuditVerification{
+					Valid:                false,
+					EntriesVerified:      6,
+					FirstInvalidSequence: &sequence,
+					Reason:               &reason,
+				}, nil
+			}
+
+			response, err := handler.VerifyAuditLog(ctx, server.VerifyAuditLogRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.VerifyAuditLog200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be VerifyAuditLog200JSONResponse")
+			Expect(result.Valid).To(BeFalse())
+			Expect(*result.FirstInvalidSequence).To(Equal(int64(7)))
+			Expect(*result.Reason).To(Equal(reason))
+		})
+
+		It("should return 500 when the store fails", func() {
+			mockAudit.VerifyAuditLogFn = func(context.Context) (*v1alpha1.AuditVerification, error) {
+				return nil, service.NewInternalError("Failed to verify audit log", "database unavailable", nil)
+			}
+
+			response, err := handler.VerifyAuditLog(ctx, server.VerifyAuditLogRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.VerifyAuditLog500JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be VerifyAuditLog500JSONResponse")
+		})
+	})
+
+	Describe("ScrubAuditEntries", func() {
+		It("should pass the request and return the report", func() {
+			var received v1alpha1.AuditScrubRequest
+			mockAudit.ScrubAuditEntriesFn = func(_ context.Context, req v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error) {
+				received = req
+				return &v1alpha1.AuditScrubResult{
+					Mode:              v1alpha1.AuditScrubResultDelete,
+					AffectedEntries:   2,
+					Sequences:         []int64{3, 5},
+					AffectedRevisions: 4,
+				}, nil
+			}
+
+			mode := server.AuditScrubDelete
+			user := "jdoe@acme.example"
+			response, err := handler.ScrubAuditEntries(ctx, server.ScrubAuditEntriesRequestObject{
+				Body: &server.AuditScrubRequest{User: &user, Mode: &mode},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.ScrubAuditEntries200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ScrubAuditEntries200JSONResponse")
+			Expect(result.AffectedEntries).To(Equal(int64(2)))
+			Expect(result.Sequences).To(Equal([]int64{3, 5}))
+			Expect(result.AffectedRevisions).To(Equal(int64(4)))
+			Expect(*received.User).To(Equal("jdoe@acme.example"))
+			Expect(*received.Mode).To(Equal(v1alpha1.AuditScrubDelete))
+		})
+
+		It("should return 400 when body is nil", func() {
+			response, err := handler.ScrubAuditEntries(ctx, server.ScrubAuditEntriesRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ScrubAuditEntries400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ScrubAuditEntries400JSONResponse")
+		})
+
+		It("should return 400 for an invalid request", func() {
+			mockAudit.ScrubAuditEntriesFn = func(context.Context, v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error) {
+				return nil, service.NewInvalidArgumentError("label_key and label_value are required", "empty label")
+			}
+
+			response, err := handler.ScrubAuditEntries(ctx, server.ScrubAuditEntriesRequestObject{
+				Body: &server.AuditScrubRequest{},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ScrubAuditEntries400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ScrubAuditEntries400JSONResponse")
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
+)
+
+// WithCacheMaxAge lets clients and gateways reuse policy GET and list responses for maxAge
+// without revalidating. Without it, or with a non-positive maxAge, responses are sent with
+// no-cache so every reuse is revalidated with the ETag.
+func WithCacheMaxAge(maxAge time.Duration) Option {
+	return WithReloadableCacheMaxAge(runtimeconfig.NewDuration(maxAge))
+}
+
+// WithReloadableCacheMaxAge is WithCacheMaxAge with a max age that can change while requests
+// are served
+func WithReloadableCacheMaxAge(maxAge *runtimeconfig.Duration) Option {
+	return func(h *PolicyHandler) {
+		h.cacheMaxAge = maxAge
+	}
+}
+
+func (h *PolicyHandler) cacheControl() string {
+	if seconds := int64(h.cacheMaxAge.Load() / time.Second); seconds > 0 {
+		return fmt.Sprintf("max-age=%d", seconds)
+	}
+	return "no-cache"
+}
+
+// entityTag returns a strong entity tag over the JSON encoding of body
+func entityTag(body any) (string, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// etagMatches reports whether an If-None-Match header matches etag using the weak
+// comparison RFC 9110 requires for If-None-Match
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// notModified reports whether a conditional GET can be answered with 304. If-None-Match takes
+// precedence; If-Modified-Since is only checked when it is absent and modified is set.
+func notModified(ifNoneMatch, ifModifiedSince *string, etag string, modified *time.Time) bool {
+	if ifNoneMatch != nil {
+		return etagMatches(*ifNoneMatch, etag)
+	}
+	if ifModifiedSince == nil || modified == nil {
+		return false
+	}
+	since, err := http.ParseTime(*ifModifiedSince)
+	if err != nil {
+		return false
+	}
+	// HTTP dates have second precision
+	return !modified.Truncate(time.Second).After(since)
+}
+
+// httpDate formats t as an HTTP date
+func httpDate(t time.Time) string {
+	return t.UTC().Format(http.TimeFormat)
+}
@@ -0,0 +1,37 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+var errConfigReloadNotConfigured = service.NewInternalError("Configuration reload is not available", "No configuration reloader is configured", nil)
+
+// WithConfigReloader serves the configuration reload endpoint from reloader
+func WithConfigReloader(reloader *runtimeconfig.Reloader) Option {
+	return func(h *PolicyHandler) {
+		h.reloader = reloader
+	}
+}
+
+// ReloadConfig handles reloading the runtime-tunable configuration.
+func (h *PolicyHandler) ReloadConfig(ctx context.Context, request server.ReloadConfigRequestObject) (server.ReloadConfigResponseObject, error) {
+	logging.FromContext(ctx).Debug("ReloadConfig request received")
+
+	if h.reloader == nil {
+		return h.handleReloadConfigError(errConfigReloadNotConfigured, request), nil
+	}
+	reload, err := h.reloader.Reload(ctx)
+	if err != nil {
+		// The configuration is loaded from scratch, so every failure is a configuration error
+		err = service.NewInvalidArgumentError("Invalid configuration", err.Error())
+		logServiceError(ctx, "ReloadConfig failed", err)
+		return h.handleReloadConfigError(err, request), nil
+	}
+
+	return server.ReloadConfig200JSONResponse{ReloadTime: reload.Time, Settings: reload.Settings}, nil
+}
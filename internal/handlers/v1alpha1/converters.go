@@ -3,22 +3,30 @@ package v1alpha1
 import (
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
 	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/telemetry"
 )
 
 // Type conversion helpers between server and v1alpha1 packages
 
 func policyServerToV1Alpha1(p server.Policy) v1alpha1.Policy {
 	out := v1alpha1.Policy{
-		CreateTime:    p.CreateTime,
-		Description:   p.Description,
-		DisplayName:   p.DisplayName,
-		Enabled:       p.Enabled,
-		Id:            p.Id,
-		LabelSelector: p.LabelSelector,
-		Path:          p.Path,
-		Priority:      p.Priority,
-		RegoCode:      p.RegoCode,
-		UpdateTime:    p.UpdateTime,
+		CreateTime:        p.CreateTime,
+		Description:       p.Description,
+		DisplayName:       p.DisplayName,
+		Enabled:           p.Enabled,
+		Id:                p.Id,
+		LabelSelector:     p.LabelSelector,
+		Path:              p.Path,
+		Priority:          p.Priority,
+		RegoCode:          p.RegoCode,
+		UpdateTime:        p.UpdateTime,
+		CreatedBy:         p.CreatedBy,
+		UpdatedBy:         p.UpdatedBy,
+		RejectionMessages: p.RejectionMessages,
+		Annotations:       p.Annotations,
+		Parameters:        p.Parameters,
+		Documentation:     (*v1alpha1.PolicyDocumentation)(p.Documentation),
 	}
 	if p.PolicyType != nil {
 		t := v1alpha1.PolicyPolicyType(*p.PolicyType)
@@ -29,21 +37,40 @@ func policyServerToV1Alpha1(p server.Policy) v1alpha1.Policy {
 
 func policyV1Alpha1ToServer(p v1alpha1.Policy) server.Policy {
 	out := server.Policy{
-		CreateTime:    p.CreateTime,
-		Description:   p.Description,
-		DisplayName:   p.DisplayName,
-		Enabled:       p.Enabled,
-		Id:            p.Id,
-		LabelSelector: p.LabelSelector,
-		Path:          p.Path,
-		Priority:      p.Priority,
-		RegoCode:      p.RegoCode,
-		UpdateTime:    p.UpdateTime,
+		CreateTime:        p.CreateTime,
+		Description:       p.Description,
+		DisplayName:       p.DisplayName,
+		Enabled:           p.Enabled,
+		Id:                p.Id,
+		LabelSelector:     p.LabelSelector,
+		Path:              p.Path,
+		Priority:          p.Priority,
+		RegoCode:          p.RegoCode,
+		UpdateTime:        p.UpdateTime,
+		CreatedBy:         p.CreatedBy,
+		UpdatedBy:         p.UpdatedBy,
+		RejectionMessages: p.RejectionMessages,
+		Annotations:       p.Annotations,
+		Parameters:        p.Parameters,
+		Documentation:     (*server.PolicyDocumentation)(p.Documentation),
+		Lock:              (*server.PolicyLock)(p.Lock),
 	}
 	if p.PolicyType != nil {
 		t := server.PolicyPolicyType(*p.PolicyType)
 		out.PolicyType = &t
 	}
+	if p.Warnings != nil {
+		warnings := make([]server.PolicyWarning, len(*p.Warnings))
+		for i, w := range *p.Warnings {
+			warnings[i] = server.PolicyWarning{
+				Code:    server.PolicyWarningCode(w.Code),
+				Message: w.Message,
+				Line:    w.Line,
+				Column:  w.Column,
+			}
+		}
+		out.Warnings = &warnings
+	}
 	return out
 }
 
@@ -54,6 +81,465 @@ func listResponseV1Alpha1ToServer(r v1alpha1.PolicyList) server.PolicyList {
 	}
 	return server.PolicyList{
 		NextPageToken: r.NextPageToken,
+		PageSize:      r.PageSize,
 		Policies:      policies,
 	}
 }
+
+func policyRevisionV1Alpha1ToServer(r v1alpha1.PolicyRevision) server.PolicyRevision {
+	return server.PolicyRevision{
+		Path:       r.Path,
+		Revision:   r.Revision,
+		CreateTime: r.CreateTime,
+		Policy:     policyV1Alpha1ToServer(r.Policy),
+	}
+}
+
+func policyRevisionListV1Alpha1ToServer(l v1alpha1.PolicyRevisionList) server.PolicyRevisionList {
+	revisions := make([]server.PolicyRevision, len(l.Revisions))
+	for i, revision := range l.Revisions {
+		revisions[i] = policyRevisionV1Alpha1ToServer(revision)
+	}
+	return server.PolicyRevisionList{Revisions: revisions, NextPageToken: l.NextPageToken, PageSize: l.PageSize}
+}
+
+func policyRevisionDiffV1Alpha1ToServer(d v1alpha1.PolicyRevisionDiff) server.PolicyRevisionDiff {
+	changes := make([]server.PolicyFieldChange, len(d.Changes))
+	for i, change := range d.Changes {
+		changes[i] = server.PolicyFieldChange{Field: change.Field, From: change.From, To: change.To}
+	}
+	return server.PolicyRevisionDiff{From: d.From, To: d.To, Changes: changes, RegoDiff: d.RegoDiff}
+}
+
+func bundleImportOptionsFromParams(p server.ImportPolicyBundleParams) service.BundleImportOptions {
+	opts := service.BundleImportOptions{}
+	if p.Format != nil {
+		opts.Format = service.BundleFormat(*p.Format)
+	}
+	if p.MetadataConvention != nil {
+		opts.MetadataConvention = service.MetadataConvention(*p.MetadataConvention)
+	}
+	if p.PolicyType != nil {
+		opts.DefaultPolicyType = v1alpha1.PolicyPolicyType(*p.PolicyType)
+	}
+	if p.IdPrefix != nil {
+		opts.IDPrefix = *p.IdPrefix
+	}
+	return opts
+}
+
+func bundleExportOptionsFromParams(p server.ExportPolicyBundleParams) service.BundleExportOptions {
+	opts := service.BundleExportOptions{}
+	if p.PolicyType != nil {
+		opts.PolicyType = v1alpha1.PolicyPolicyType(*p.PolicyType)
+	}
+	if p.StartAfter != nil {
+		opts.StartAfter = *p.StartAfter
+	}
+	return opts
+}
+
+func bundleImportResultV1Alpha1ToServer(r v1alpha1.BundleImportResult) server.BundleImportResult {
+	results := make([]server.BundleImportItem, len(r.Results))
+	for i, item := range r.Results {
+		results[i] = server.BundleImportItem{
+			Detail:   item.Detail,
+			FilePath: item.FilePath,
+			PolicyId: item.PolicyId,
+			Status:   server.BundleImportItemStatus(item.Status),
+		}
+	}
+	return server.BundleImportResult{Results: results}
+}
+
+func policyImportRequestServerToV1Alpha1(r server.PolicyImportRequest) v1alpha1.PolicyImportRequest {
+	entries := make([]v1alpha1.PolicyImportEntry, len(r.Policies))
+	for i, entry := range r.Policies {
+		// rego_file is only read from a multipart manifest
+		entries[i] = v1alpha1.PolicyImportEntry{Policy: policyServerToV1Alpha1(entry.Policy), PolicyId: entry.PolicyId}
+	}
+	return v1alpha1.PolicyImportRequest{Policies: entries}
+}
+
+func policyImportResultV1Alpha1ToServer(r v1alpha1.PolicyImportResult) server.PolicyImportResult {
+	results := make([]server.PolicyImportItem, len(r.Results))
+	for i, item := range r.Results {
+		results[i] = server.PolicyImportItem{
+			Detail:   item.Detail,
+			Index:    item.Index,
+			PolicyId: item.PolicyId,
+			Status:   server.PolicyImportItemStatus(item.Status),
+		}
+	}
+	return server.PolicyImportResult{Results: results}
+}
+
+func gatekeeperConversionV1Alpha1ToServer(r v1alpha1.GatekeeperConversionResult) server.GatekeeperConversionResult {
+	policies := make([]server.Policy, len(r.Policies))
+	for i, p := range r.Policies {
+		policies[i] = policyV1Alpha1ToServer(p)
+	}
+	report := make([]server.GatekeeperConversionIssue, len(r.Report))
+	for i, issue := range r.Report {
+		report[i] = server.GatekeeperConversionIssue{
+			Message:  issue.Message,
+			Severity: server.GatekeeperConversionIssueSeverity(issue.Severity),
+			Source:   issue.Source,
+		}
+	}
+	return server.GatekeeperConversionResult{Policies: policies, Report: report}
+}
+
+func policyFacetsV1Alpha1ToServer(f v1alpha1.PolicyFacets) server.PolicyFacets {
+	labelKeys := make([]server.LabelSelectorKeyFacet, len(f.LabelSelectorKeys))
+	for i, key := range f.LabelSelectorKeys {
+		labelKeys[i] = server.LabelSelectorKeyFacet{
+			Key:    key.Key,
+			Count:  key.Count,
+			Values: facetValuesV1Alpha1ToServer(key.Values),
+		}
+	}
+	histogram := make([]server.PriorityBucket, len(f.PriorityHistogram))
+	for i, bucket := range f.PriorityHistogram {
+		histogram[i] = server.PriorityBucket{Min: bucket.Min, Max: bucket.Max, Count: bucket.Count}
+	}
+	return server.PolicyFacets{
+		PolicyTypes:       facetValuesV1Alpha1ToServer(f.PolicyTypes),
+		LabelSelectorKeys: labelKeys,
+		PriorityHistogram: histogram,
+	}
+}
+
+func facetValuesV1Alpha1ToServer(values []v1alpha1.FacetValue) []server.FacetValue {
+	out := make([]server.FacetValue, len(values))
+	for i, value := range values {
+		out[i] = server.FacetValue{Value: value.Value, Count: value.Count}
+	}
+	return out
+}
+
+func evaluationOrderV1Alpha1ToServer(o v1alpha1.EvaluationOrder) server.EvaluationOrder {
+	policies := make([]server.EvaluationOrderEntry, len(o.Policies))
+	for i, entry := range o.Policies {
+		policies[i] = server.EvaluationOrderEntry{
+			Position:      entry.Position,
+			Id:            entry.Id,
+			DisplayName:   entry.DisplayName,
+			PolicyType:    server.EvaluationOrderEntryPolicyType(entry.PolicyType),
+			Priority:      entry.Priority,
+			LabelSelector: entry.LabelSelector,
+		}
+	}
+	return server.EvaluationOrder{
+		RequestLabels:   o.RequestLabels,
+		Policies:        policies,
+		PoliciesSkipped: o.PoliciesSkipped,
+	}
+}
+
+func policyCatalogV1Alpha1ToServer(c v1alpha1.PolicyCatalog) server.PolicyCatalog {
+	policies := make([]server.PolicyCatalogEntry, len(c.Policies))
+	for i, entry := range c.Policies {
+		policies[i] = server.PolicyCatalogEntry{
+			Id:            entry.Id,
+			DisplayName:   entry.DisplayName,
+			Description:   entry.Description,
+			PolicyType:    server.PolicyCatalogEntryPolicyType(entry.PolicyType),
+			Priority:      entry.Priority,
+			LabelSelector: entry.LabelSelector,
+			Documentation: (*server.PolicyDocumentation)(entry.Documentation),
+			UpdateTime:    entry.UpdateTime,
+		}
+	}
+	return server.PolicyCatalog{GenerateTime: c.GenerateTime, Policies: policies}
+}
+
+func policyMatchTestRequestServerToV1Alpha1(r server.PolicyMatchTestRequest) v1alpha1.PolicyMatchTestRequest {
+	return v1alpha1.PolicyMatchTestRequest{Labels: r.Labels, Spec: r.Spec}
+}
+
+func policyMatchTestResultV1Alpha1ToServer(r v1alpha1.PolicyMatchTestResult) server.PolicyMatchTestResult {
+	failed := make([]server.SelectorTermFailure, len(r.FailedTerms))
+	for i, term := range r.FailedTerms {
+		failed[i] = server.SelectorTermFailure{
+			Key:      term.Key,
+			Expected: term.Expected,
+			Actual:   term.Actual,
+			Reason:   server.SelectorTermFailureReason(term.Reason),
+		}
+	}
+	return server.PolicyMatchTestResult{
+		Matches:       r.Matches,
+		Enabled:       r.Enabled,
+		LabelSelector: r.LabelSelector,
+		RequestLabels: r.RequestLabels,
+		FailedTerms:   failed,
+	}
+}
+
+func policySimulationRequestServerToV1Alpha1(r server.PolicySimulationRequest) v1alpha1.PolicySimulationRequest {
+	return v1alpha1.PolicySimulationRequest{
+		PolicyId:        r.PolicyId,
+		Policy:          policyServerToV1Alpha1(r.Policy),
+		ServiceInstance: v1alpha1.SimulatedServiceInstance{Spec: r.ServiceInstance.Spec},
+	}
+}
+
+func policySimulationV1Alpha1ToServer(r v1alpha1.PolicySimulation) server.PolicySimulation {
+	out := server.PolicySimulation{
+		PolicyId:          r.PolicyId,
+		Status:            server.SimulationStatus(r.Status),
+		SelectedProvider:  r.SelectedProvider,
+		FailedPolicyId:    r.FailedPolicyId,
+		Detail:            r.Detail,
+		PoliciesEvaluated: r.PoliciesEvaluated,
+		Draft: server.DraftPolicyResult{
+			Outcome:          server.DraftPolicyOutcome(r.Draft.Outcome),
+			Reason:           r.Draft.Reason,
+			Patch:            r.Draft.Patch,
+			Constraints:      r.Draft.Constraints,
+			SelectedProvider: r.Draft.SelectedProvider,
+		},
+	}
+	if r.EvaluatedServiceInstance != nil {
+		out.EvaluatedServiceInstance = &server.SimulatedServiceInstance{Spec: r.EvaluatedServiceInstance.Spec}
+	}
+	return out
+}
+
+func policyConflictCheckRequestServerToV1Alpha1(r server.PolicyConflictCheckRequest) v1alpha1.PolicyConflictCheckRequest {
+	out := v1alpha1.PolicyConflictCheckRequest{
+		PolicyId: r.PolicyId,
+		Policy:   policyServerToV1Alpha1(r.Policy),
+	}
+	if r.Samples != nil {
+		samples := make([]v1alpha1.SimulatedServiceInstance, len(*r.Samples))
+		for i, sample := range *r.Samples {
+			samples[i] = v1alpha1.SimulatedServiceInstance{Spec: sample.Spec}
+		}
+		out.Samples = &samples
+	}
+	return out
+}
+
+func policyConflictReportV1Alpha1ToServer(r v1alpha1.PolicyConflictReport) server.PolicyConflictReport {
+	conflicts := make([]server.PolicyConflict, len(r.Conflicts))
+	for i, conflict := range r.Conflicts {
+		conflicts[i] = server.PolicyConflict{
+			Kind:        server.PolicyConflictKind(conflict.Kind),
+			PolicyId:    conflict.PolicyId,
+			SampleIndex: conflict.SampleIndex,
+			Detail:      conflict.Detail,
+		}
+	}
+	return server.PolicyConflictReport{
+		PolicyId:       r.PolicyId,
+		Conflicts:      conflicts,
+		SamplesChecked: r.SamplesChecked,
+	}
+}
+
+func policyTestServerToV1Alpha1(t server.PolicyTest) v1alpha1.PolicyTest {
+	out := v1alpha1.PolicyTest{
+		Path:            t.Path,
+		Id:              t.Id,
+		DisplayName:     t.DisplayName,
+		ServiceInstance: v1alpha1.SimulatedServiceInstance{Spec: t.ServiceInstance.Spec},
+		Expected: v1alpha1.PolicyTestExpectation{
+			Status: v1alpha1.SimulationStatus(t.Expected.Status),
+			Detail: t.Expected.Detail,
+		},
+		CreateTime: t.CreateTime,
+	}
+	if t.Expected.PolicyOutcome != nil {
+		outcome := v1alpha1.DraftPolicyOutcome(*t.Expected.PolicyOutcome)
+		out.Expected.PolicyOutcome = &outcome
+	}
+	if t.Expected.EvaluatedServiceInstance != nil {
+		out.Expected.EvaluatedServiceInstance = &v1alpha1.SimulatedServiceInstance{Spec: t.Expected.EvaluatedServiceInstance.Spec}
+	}
+	return out
+}
+
+func policyTestV1Alpha1ToServer(t v1alpha1.PolicyTest) server.PolicyTest {
+	out := server.PolicyTest{
+		Path:            t.Path,
+		Id:              t.Id,
+		DisplayName:     t.DisplayName,
+		ServiceInstance: server.SimulatedServiceInstance{Spec: t.ServiceInstance.Spec},
+		Expected: server.PolicyTestExpectation{
+			Status: server.SimulationStatus(t.Expected.Status),
+			Detail: t.Expected.Detail,
+		},
+		CreateTime: t.CreateTime,
+	}
+	if t.Expected.PolicyOutcome != nil {
+		outcome := server.DraftPolicyOutcome(*t.Expected.PolicyOutcome)
+		out.Expected.PolicyOutcome = &outcome
+	}
+	if t.Expected.EvaluatedServiceInstance != nil {
+		out.Expected.EvaluatedServiceInstance = &server.SimulatedServiceInstance{Spec: t.Expected.EvaluatedServiceInstance.Spec}
+	}
+	return out
+}
+
+func policyTestListV1Alpha1ToServer(l v1alpha1.PolicyTestList) server.PolicyTestList {
+	tests := make([]server.PolicyTest, len(l.Tests))
+	for i, test := range l.Tests {
+		tests[i] = policyTestV1Alpha1ToServer(test)
+	}
+	return server.PolicyTestList{Tests: tests, NextPageToken: l.NextPageToken, PageSize: l.PageSize}
+}
+
+func policyTestRunV1Alpha1ToServer(r v1alpha1.PolicyTestRun) server.PolicyTestRun {
+	results := make([]server.PolicyTestResult, len(r.Results))
+	for i, result := range r.Results {
+		results[i] = server.PolicyTestResult{
+			TestId:        result.TestId,
+			Passed:        result.Passed,
+			Status:        server.SimulationStatus(result.Status),
+			PolicyOutcome: server.DraftPolicyOutcome(result.PolicyOutcome),
+			Detail:        result.Detail,
+			Failures:      result.Failures,
+		}
+		if result.EvaluatedServiceInstance != nil {
+			results[i].EvaluatedServiceInstance = &server.SimulatedServiceInstance{Spec: result.EvaluatedServiceInstance.Spec}
+		}
+	}
+	return server.PolicyTestRun{
+		PolicyId: r.PolicyId,
+		Passed:   r.Passed,
+		Failed:   r.Failed,
+		Results:  results,
+	}
+}
+
+func auditEntryListV1Alpha1ToServer(l v1alpha1.AuditEntryList) server.AuditEntryList {
+	entries := make([]server.AuditEntry, len(l.Entries))
+	for i, entry := range l.Entries {
+		entries[i] = server.AuditEntry{
+			Sequence:   entry.Sequence,
+			Time:       entry.Time,
+			Type:       entry.Type,
+			Subject:    entry.Subject,
+			Data:       entry.Data,
+			DataDigest: entry.DataDigest,
+			PrevHash:   entry.PrevHash,
+			Hash:       entry.Hash,
+			Redacted:   entry.Redacted,
+		}
+	}
+	return server.AuditEntryList{Entries: entries, NextPageToken: l.NextPageToken, PageSize: l.PageSize}
+}
+
+func auditScrubRequestServerToV1Alpha1(r server.AuditScrubRequest) v1alpha1.AuditScrubRequest {
+	req := v1alpha1.AuditScrubRequest{
+		LabelKey:   r.LabelKey,
+		LabelValue: r.LabelValue,
+		User:       r.User,
+		DryRun:     r.DryRun,
+	}
+	if r.Mode != nil {
+		mode := v1alpha1.AuditScrubRequestMode(*r.Mode)
+		req.Mode = &mode
+	}
+	return req
+}
+
+func engineStatusToServer(status service.EngineStatus) *server.EngineHealth {
+	result := &server.EngineHealth{CompiledPolicies: int32(status.CompiledPolicies)}
+	if !status.LastReconcileTime.IsZero() {
+		result.LastReconcileTime = &status.LastReconcileTime
+	}
+	if status.LastReconcileError != "" {
+		result.LastReconcileError = &status.LastReconcileError
+	}
+	return result
+}
+
+func readinessToServer(readiness service.Readiness) server.Readiness {
+	result := server.Readiness{
+		Status:       "ready",
+		Dependencies: make([]server.DependencyStatus, len(readiness.Dependencies)),
+	}
+	if !readiness.Ready() {
+		result.Status = "not_ready"
+	}
+	for i, dependency := range readiness.Dependencies {
+		result.Dependencies[i] = server.DependencyStatus{Name: dependency.Name, Status: "ok"}
+		if !dependency.Ready {
+			result.Dependencies[i].Status = "failed"
+			result.Dependencies[i].Message = &dependency.Message
+		}
+	}
+	return result
+}
+
+func telemetryStatusToServer(status telemetry.Status) server.TelemetryStatus {
+	result := server.TelemetryStatus{Enabled: status.Enabled}
+	if !status.Enabled {
+		return result
+	}
+	interval := status.Interval.String()
+	result.Endpoint = &status.Endpoint
+	result.Interval = &interval
+	if status.LastError != "" {
+		result.LastError = &status.LastError
+	}
+	if report := status.LastReport; report != nil {
+		result.LastReport = &server.TelemetryReport{
+			InstanceId:    report.InstanceID,
+			Version:       report.Version,
+			Time:          report.Time,
+			PeriodSeconds: report.PeriodSeconds,
+			Policies: server.TelemetryPolicyCounts{
+				Total:   report.Policies.Total,
+				Enabled: report.Policies.Enabled,
+				Global:  report.Policies.Global,
+				User:    report.Policies.User,
+			},
+			Evaluations: server.TelemetryEvaluationCounts{
+				Completed: report.Evaluations.Completed,
+				Rejected:  report.Evaluations.Rejected,
+			},
+			Features: report.Features,
+		}
+	}
+	return result
+}
+
+func engineAPIKeyServerToV1Alpha1(k server.EngineApiKey) v1alpha1.EngineApiKey {
+	return v1alpha1.EngineApiKey{Identity: k.Identity}
+}
+
+func engineAPIKeyV1Alpha1ToServer(k v1alpha1.EngineApiKey) server.EngineApiKey {
+	return server.EngineApiKey{
+		Path:       k.Path,
+		Id:         k.Id,
+		Identity:   k.Identity,
+		Key:        k.Key,
+		KeyPrefix:  k.KeyPrefix,
+		CreateTime: k.CreateTime,
+		CreatedBy:  k.CreatedBy,
+	}
+}
+
+func engineRebuildV1Alpha1ToServer(r v1alpha1.EngineRebuild) server.EngineRebuild {
+	return server.EngineRebuild{
+		Id:               r.Id,
+		State:            server.EngineRebuildState(r.State),
+		PoliciesTotal:    r.PoliciesTotal,
+		PoliciesCompiled: r.PoliciesCompiled,
+		Error:            r.Error,
+		CreateTime:       r.CreateTime,
+		EndTime:          r.EndTime,
+	}
+}
+
+func engineAPIKeyListV1Alpha1ToServer(l v1alpha1.EngineApiKeyList) server.EngineApiKeyList {
+	keys := make([]server.EngineApiKey, len(l.Keys))
+	for i, key := range l.Keys {
+		keys[i] = engineAPIKeyV1Alpha1ToServer(key)
+	}
+	return server.EngineApiKeyList{Keys: keys, NextPageToken: l.NextPageToken, PageSize: l.PageSize}
+}
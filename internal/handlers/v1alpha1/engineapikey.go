@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// errEngineAPIKeysNotConfigured is returned by the engine API key endpoints of a handler
+// created without WithEngineAPIKeyService
+var errEngineAPIKeysNotConfigured = service.NewInternalError("Engine API keys are not available", "No engine API key service is configured", nil)
+
+// CreateEngineApiKey handles creating an engine API key.
+func (h *PolicyHandler) CreateEngineApiKey(ctx context.Context, request server.CreateEngineApiKeyRequestObject) (server.CreateEngineApiKeyResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("CreateEngineApiKey called with nil body")
+		return h.handleCreateEngineApiKeyError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	log.Debug("CreateEngineApiKey request received", "key_id", request.Params.Id)
+
+	if h.engineAPIKeys == nil {
+		return h.handleCreateEngineApiKeyError(errEngineAPIKeysNotConfigured, request), nil
+	}
+	key, err := h.engineAPIKeys.CreateEngineAPIKey(ctx, engineAPIKeyServerToV1Alpha1(*request.Body), request.Params.Id)
+	if err != nil {
+		logServiceError(ctx, "CreateEngineApiKey failed", err)
+		return h.handleCreateEngineApiKeyError(err, request), nil
+	}
+
+	return server.CreateEngineApiKey201JSONResponse(engineAPIKeyV1Alpha1ToServer(*key)), nil
+}
+
+// ListEngineApiKeys handles listing engine API keys.
+func (h *PolicyHandler) ListEngineApiKeys(ctx context.Context, request server.ListEngineApiKeysRequestObject) (server.ListEngineApiKeysResponseObject, error) {
+	logging.FromContext(ctx).Debug("ListEngineApiKeys request received")
+
+	if h.engineAPIKeys == nil {
+		return h.handleListEngineApiKeysError(errEngineAPIKeysNotConfigured, request), nil
+	}
+	result, err := h.engineAPIKeys.ListEngineAPIKeys(ctx, request.Params.PageToken, request.Params.MaxPageSize)
+	if err != nil {
+		logServiceError(ctx, "ListEngineApiKeys failed", err)
+		return h.handleListEngineApiKeysError(err, request), nil
+	}
+
+	return server.ListEngineApiKeys200JSONResponse(engineAPIKeyListV1Alpha1ToServer(*result)), nil
+}
+
+// DeleteEngineApiKey handles revoking an engine API key.
+func (h *PolicyHandler) DeleteEngineApiKey(ctx context.Context, request server.DeleteEngineApiKeyRequestObject) (server.DeleteEngineApiKeyResponseObject, error) {
+	logging.FromContext(ctx).Debug("DeleteEngineApiKey request received", "key_id", request.KeyId)
+
+	if h.engineAPIKeys == nil {
+		return h.handleDeleteEngineApiKeyError(errEngineAPIKeysNotConfigured, request), nil
+	}
+	if err := h.engineAPIKeys.DeleteEngineAPIKey(ctx, request.KeyId); err != nil {
+		logServiceError(ctx, "DeleteEngineApiKey failed", err, "key_id", request.KeyId)
+		return h.handleDeleteEngineApiKeyError(err, request), nil
+	}
+
+	return server.DeleteEngineApiKey204Response{}, nil
+}
@@ -204,6 +204,59 @@ func (h *PolicyHandler) handleUpdatePolicyError(err error, _ server.UpdatePolicy
 	}
 }
 
+func (h *PolicyHandler) handleApplyPolicyError(err error, _ server.ApplyPolicyRequestObject) server.ApplyPolicyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if !ok {
+		return server.ApplyPolicy500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(err.Error()),
+			)),
+		}
+	}
+
+	switch serviceErr.Type {
+	case service.ErrorTypeInvalidArgument, service.ErrorTypeFailedPrecondition:
+		return server.ApplyPolicy400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	case service.ErrorTypeNotFound:
+		return server.ApplyPolicy404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	case service.ErrorTypeAlreadyExists:
+		return server.ApplyPolicy409JSONResponse{
+			AlreadyExistsJSONResponse: alreadyExistsResponse(buildErrorResponse(
+				409,
+				v1alpha1.ALREADYEXISTS,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	default:
+		return server.ApplyPolicy500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+}
+
 func (h *PolicyHandler) handleDeletePolicyError(err error, _ server.DeletePolicyRequestObject) server.DeletePolicyResponseObject {
 	serviceErr, ok := err.(*service.ServiceError)
 	if !ok {
@@ -277,6 +330,864 @@ func alreadyExistsResponse(e v1alpha1.Error) server.AlreadyExistsJSONResponse {
 	return server.AlreadyExistsJSONResponse(serverErrorFromV1Alpha1(e))
 }
 
+func lockedResponse(e v1alpha1.Error) server.LockedJSONResponse {
+	return server.LockedJSONResponse(serverErrorFromV1Alpha1(e))
+}
+
 func internalErrorResponse(e v1alpha1.Error) server.InternalServerErrorJSONResponse {
 	return server.InternalServerErrorJSONResponse(serverErrorFromV1Alpha1(e))
 }
+
+func (h *PolicyHandler) handleImportPolicyBundleError(err error, _ server.ImportPolicyBundleRequestObject) server.ImportPolicyBundleResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if !ok {
+		return server.ImportPolicyBundle500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(err.Error()),
+			)),
+		}
+	}
+
+	switch serviceErr.Type {
+	case service.ErrorTypeInvalidArgument:
+		return server.ImportPolicyBundle400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	default:
+		return server.ImportPolicyBundle500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+}
+
+func (h *PolicyHandler) handleImportPoliciesError(err error, _ server.ImportPoliciesRequestObject) server.ImportPoliciesResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if !ok {
+		return server.ImportPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(err.Error()),
+			)),
+		}
+	}
+
+	switch serviceErr.Type {
+	case service.ErrorTypeInvalidArgument:
+		return server.ImportPolicies400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	default:
+		return server.ImportPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+}
+
+func (h *PolicyHandler) handleExportPolicyBundleError(err error, _ server.ExportPolicyBundleRequestObject) server.ExportPolicyBundleResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if !ok {
+		return server.ExportPolicyBundle500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(err.Error()),
+			)),
+		}
+	}
+
+	switch serviceErr.Type {
+	case service.ErrorTypeInvalidArgument:
+		return server.ExportPolicyBundle400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	default:
+		return server.ExportPolicyBundle500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+}
+
+func (h *PolicyHandler) handleConvertGatekeeperError(err error, _ server.ConvertGatekeeperRequestObject) server.ConvertGatekeeperResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if !ok {
+		return server.ConvertGatekeeper500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(err.Error()),
+			)),
+		}
+	}
+
+	switch serviceErr.Type {
+	case service.ErrorTypeInvalidArgument:
+		return server.ConvertGatekeeper400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	default:
+		return server.ConvertGatekeeper500JSONResponse{
+			InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+				500,
+				v1alpha1.INTERNAL,
+				"Internal server error",
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+}
+
+func (h *PolicyHandler) handleGetPolicyFacetsError(err error, _ server.GetPolicyFacetsRequestObject) server.GetPolicyFacetsResponseObject {
+	return server.GetPolicyFacets500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetPolicyChecksumError(err error, _ server.GetPolicyChecksumRequestObject) server.GetPolicyChecksumResponseObject {
+	return server.GetPolicyChecksum500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleWatchPoliciesError(err error, _ server.WatchPoliciesRequestObject) server.WatchPoliciesResponseObject {
+	return server.WatchPolicies500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetPolicyCatalogError(err error, _ server.GetPolicyCatalogRequestObject) server.GetPolicyCatalogResponseObject {
+	return server.GetPolicyCatalog500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetEvaluationOrderError(err error, _ server.GetEvaluationOrderRequestObject) server.GetEvaluationOrderResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.GetEvaluationOrder400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.GetEvaluationOrder500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleTestPolicyMatchError(err error, _ server.TestPolicyMatchRequestObject) server.TestPolicyMatchResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.TestPolicyMatch400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.TestPolicyMatch404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.TestPolicyMatch500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleSimulatePolicyError(err error, _ server.SimulatePolicyRequestObject) server.SimulatePolicyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.SimulatePolicy400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.SimulatePolicy409JSONResponse{
+				AlreadyExistsJSONResponse: alreadyExistsResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.SimulatePolicy500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleCheckPolicyConflictsError(err error, _ server.CheckPolicyConflictsRequestObject) server.CheckPolicyConflictsResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.CheckPolicyConflicts400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.CheckPolicyConflicts500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleListPolicyRevisionsError(err error, _ server.ListPolicyRevisionsRequestObject) server.ListPolicyRevisionsResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.ListPolicyRevisions400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.ListPolicyRevisions404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.ListPolicyRevisions500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetPolicyRevisionError(err error, _ server.GetPolicyRevisionRequestObject) server.GetPolicyRevisionResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.GetPolicyRevision404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.GetPolicyRevision500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleDiffPolicyRevisionsError(err error, _ server.DiffPolicyRevisionsRequestObject) server.DiffPolicyRevisionsResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.DiffPolicyRevisions400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.DiffPolicyRevisions404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.DiffPolicyRevisions500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleRollbackPolicyError(err error, _ server.RollbackPolicyRequestObject) server.RollbackPolicyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument, service.ErrorTypeFailedPrecondition:
+			return server.RollbackPolicy400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.RollbackPolicy404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.RollbackPolicy409JSONResponse{
+				AlreadyExistsJSONResponse: alreadyExistsResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.RollbackPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleLockPolicyError(err error, _ server.LockPolicyRequestObject) server.LockPolicyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.LockPolicy400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.LockPolicy404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.LockPolicy409JSONResponse{
+				LockedJSONResponse: lockedResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.LockPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleUnlockPolicyError(err error, _ server.UnlockPolicyRequestObject) server.UnlockPolicyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.UnlockPolicy400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.UnlockPolicy404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.UnlockPolicy409JSONResponse{
+				LockedJSONResponse: lockedResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.UnlockPolicy500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleListAuditEntriesError(err error, _ server.ListAuditEntriesRequestObject) server.ListAuditEntriesResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.ListAuditEntries400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.ListAuditEntries500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleVerifyAuditLogError(err error, _ server.VerifyAuditLogRequestObject) server.VerifyAuditLogResponseObject {
+	return server.VerifyAuditLog500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleReloadConfigError(err error, _ server.ReloadConfigRequestObject) server.ReloadConfigResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.ReloadConfig400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.ReloadConfig500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleRebuildEngineError(err error, _ server.RebuildEngineRequestObject) server.RebuildEngineResponseObject {
+	return server.RebuildEngine500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetEngineRebuildError(err error, _ server.GetEngineRebuildRequestObject) server.GetEngineRebuildResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.GetEngineRebuild404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.GetEngineRebuild500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+// errorDetail returns the detail of a service error, or the error text of any other error
+func errorDetail(err error) string {
+	if serviceErr, ok := err.(*service.ServiceError); ok {
+		return serviceErr.Detail
+	}
+	return err.Error()
+}
+
+func (h *PolicyHandler) handleScrubAuditEntriesError(err error, _ server.ScrubAuditEntriesRequestObject) server.ScrubAuditEntriesResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.ScrubAuditEntries400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.ScrubAuditEntries500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleCreatePolicyTestError(err error, _ server.CreatePolicyTestRequestObject) server.CreatePolicyTestResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.CreatePolicyTest400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.CreatePolicyTest404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.CreatePolicyTest409JSONResponse{
+				AlreadyExistsJSONResponse: alreadyExistsResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.CreatePolicyTest500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleListPolicyTestsError(err error, _ server.ListPolicyTestsRequestObject) server.ListPolicyTestsResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.ListPolicyTests400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeNotFound:
+			return server.ListPolicyTests404JSONResponse{
+				NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+					404,
+					v1alpha1.NOTFOUND,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.ListPolicyTests500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleGetPolicyTestError(err error, _ server.GetPolicyTestRequestObject) server.GetPolicyTestResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.GetPolicyTest404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.GetPolicyTest500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleDeletePolicyTestError(err error, _ server.DeletePolicyTestRequestObject) server.DeletePolicyTestResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.DeletePolicyTest404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.DeletePolicyTest500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleRunPolicyTestsError(err error, _ server.RunPolicyTestsRequestObject) server.RunPolicyTestsResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.RunPolicyTests404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.RunPolicyTests500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleCreateEngineApiKeyError(err error, _ server.CreateEngineApiKeyRequestObject) server.CreateEngineApiKeyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok {
+		switch serviceErr.Type {
+		case service.ErrorTypeInvalidArgument:
+			return server.CreateEngineApiKey400JSONResponse{
+				BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+					400,
+					v1alpha1.INVALIDARGUMENT,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		case service.ErrorTypeAlreadyExists:
+			return server.CreateEngineApiKey409JSONResponse{
+				AlreadyExistsJSONResponse: alreadyExistsResponse(buildErrorResponse(
+					409,
+					v1alpha1.ALREADYEXISTS,
+					serviceErr.Message,
+					strPtr(serviceErr.Detail),
+				)),
+			}
+		}
+	}
+	return server.CreateEngineApiKey500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleListEngineApiKeysError(err error, _ server.ListEngineApiKeysRequestObject) server.ListEngineApiKeysResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeInvalidArgument {
+		return server.ListEngineApiKeys400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.ListEngineApiKeys500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
+
+func (h *PolicyHandler) handleDeleteEngineApiKeyError(err error, _ server.DeleteEngineApiKeyRequestObject) server.DeleteEngineApiKeyResponseObject {
+	serviceErr, ok := err.(*service.ServiceError)
+	if ok && serviceErr.Type == service.ErrorTypeNotFound {
+		return server.DeleteEngineApiKey404JSONResponse{
+			NotFoundJSONResponse: notFoundResponse(buildErrorResponse(
+				404,
+				v1alpha1.NOTFOUND,
+				serviceErr.Message,
+				strPtr(serviceErr.Detail),
+			)),
+		}
+	}
+	return server.DeleteEngineApiKey500JSONResponse{
+		InternalServerErrorJSONResponse: internalErrorResponse(buildErrorResponse(
+			500,
+			v1alpha1.INTERNAL,
+			"Internal server error",
+			strPtr(errorDetail(err)),
+		)),
+	}
+}
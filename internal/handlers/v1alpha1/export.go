@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// ExportPolicyBundle handles streaming the stored policies as an OPA bundle.
+func (h *PolicyHandler) ExportPolicyBundle(ctx context.Context, request server.ExportPolicyBundleRequestObject) (server.ExportPolicyBundleResponseObject, error) {
+	opts := bundleExportOptionsFromParams(request.Params)
+	logging.FromContext(ctx).Debug("ExportPolicyBundle request received",
+		"policy_type", opts.PolicyType,
+		"start_after", opts.StartAfter,
+	)
+	return policyBundleExport{ctx: ctx, handler: h, request: request, opts: opts}, nil
+}
+
+// policyBundleExport runs the export while the response is written, instead of handing the
+// generated response a reader, so each policy is flushed to the client once it is written
+type policyBundleExport struct {
+	ctx     context.Context
+	handler *PolicyHandler
+	request server.ExportPolicyBundleRequestObject
+	opts    service.BundleExportOptions
+}
+
+func (e policyBundleExport) VisitExportPolicyBundleResponse(w http.ResponseWriter) error {
+	body := &exportBody{w: w}
+	controller := http.NewResponseController(w)
+	exported, err := e.handler.service.ExportBundle(e.ctx, body, e.opts, func() error {
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	})
+	if err == nil {
+		logging.FromContext(e.ctx).Info("Policy bundle exported", "policy_count", exported)
+		return nil
+	}
+	logServiceError(e.ctx, "ExportPolicyBundle failed", err, "policy_count", exported)
+	if !body.started {
+		return e.handler.handleExportPolicyBundleError(err, e.request).VisitExportPolicyBundleResponse(w)
+	}
+	// The 200 is already sent; aborting the connection leaves the client a truncated archive
+	// instead of one that looks complete
+	panic(http.ErrAbortHandler)
+}
+
+// exportBody sends the response headers with the first bytes of the archive, so an export
+// failing before it wrote any can still be answered with an error
+type exportBody struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (b *exportBody) Write(p []byte) (int, error) {
+	if !b.started {
+		b.started = true
+		b.w.Header().Set("Content-Type", "application/gzip")
+		b.w.WriteHeader(http.StatusOK)
+	}
+	return b.w.Write(p)
+}
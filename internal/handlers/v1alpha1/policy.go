@@ -3,33 +3,145 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"maps"
 
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
 	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/buildinfo"
 	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
 	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/telemetry"
 )
 
 type PolicyHandler struct {
-	service service.PolicyService
+	service       service.PolicyService
+	audit         service.AuditService
+	engineAPIKeys service.EngineAPIKeyService
+	featureFlags  map[string]bool
+	cacheMaxAge   *runtimeconfig.Duration // nil sends no-cache
+	telemetry     *telemetry.Reporter
+	watcher       *service.PolicyWatcher
+	reloader      *runtimeconfig.Reloader
 }
 
 // Ensure PolicyHandler implements StrictServerInterface
 var _ server.StrictServerInterface = (*PolicyHandler)(nil)
 
-func NewPolicyHandler(service service.PolicyService) *PolicyHandler {
-	return &PolicyHandler{
-		service: service,
+// Option configures optional PolicyHandler behaviour
+type Option func(*PolicyHandler)
+
+// WithFeatureFlags sets the deployment feature flags reported by the build info endpoint
+func WithFeatureFlags(flags map[string]bool) Option {
+	return func(h *PolicyHandler) {
+		h.featureFlags = maps.Clone(flags)
+	}
+}
+
+// WithTelemetry reports the telemetry status of reporter, which is nil when telemetry is
+// disabled, in the build info endpoint
+func WithTelemetry(reporter *telemetry.Reporter) Option {
+	return func(h *PolicyHandler) {
+		h.telemetry = reporter
+	}
+}
+
+// WithAuditService serves the audit log endpoints from audit
+func WithAuditService(audit service.AuditService) Option {
+	return func(h *PolicyHandler) {
+		h.audit = audit
+	}
+}
+
+// WithEngineAPIKeyService serves the engine API key endpoints from keys
+func WithEngineAPIKeyService(keys service.EngineAPIKeyService) Option {
+	return func(h *PolicyHandler) {
+		h.engineAPIKeys = keys
 	}
 }
 
+func NewPolicyHandler(service service.PolicyService, opts ...Option) *PolicyHandler {
+	h := &PolicyHandler{
+		service:      service,
+		featureFlags: map[string]bool{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
 // GetHealth handles health check requests.
 func (h *PolicyHandler) GetHealth(_ context.Context, _ server.GetHealthRequestObject) (server.GetHealthResponseObject, error) {
+	engine := h.service.EngineStatus()
 	status := "ok"
+	if !engine.Healthy() {
+		status = "degraded"
+	}
 	path := "health"
 	return server.GetHealth200JSONResponse{
 		Status: status,
 		Path:   &path,
+		Engine: engineStatusToServer(engine),
+	}, nil
+}
+
+// GetLiveness handles liveness probes; it checks no dependency.
+func (h *PolicyHandler) GetLiveness(_ context.Context, _ server.GetLivenessRequestObject) (server.GetLivenessResponseObject, error) {
+	return server.GetLiveness200JSONResponse{Status: "ok"}, nil
+}
+
+// GetReadiness handles readiness probes.
+func (h *PolicyHandler) GetReadiness(ctx context.Context, _ server.GetReadinessRequestObject) (server.GetReadinessResponseObject, error) {
+	readiness := h.service.CheckReadiness(ctx)
+	if !readiness.Ready() {
+		logging.FromContext(ctx).Warn("Readiness check failed", "dependencies", readiness.Dependencies)
+		return server.GetReadiness503JSONResponse(readinessToServer(readiness)), nil
+	}
+	return server.GetReadiness200JSONResponse(readinessToServer(readiness)), nil
+}
+
+// RebuildEngine handles starting a rebuild of the engine from the stored policies.
+func (h *PolicyHandler) RebuildEngine(ctx context.Context, request server.RebuildEngineRequestObject) (server.RebuildEngineResponseObject, error) {
+	logging.FromContext(ctx).Debug("RebuildEngine request received")
+
+	rebuild, err := h.service.RebuildEngine(ctx)
+	if err != nil {
+		logServiceError(ctx, "RebuildEngine failed", err)
+		return h.handleRebuildEngineError(err, request), nil
+	}
+
+	return server.RebuildEngine202JSONResponse(engineRebuildV1Alpha1ToServer(*rebuild)), nil
+}
+
+// GetEngineRebuild handles reading the progress of an engine rebuild.
+func (h *PolicyHandler) GetEngineRebuild(ctx context.Context, request server.GetEngineRebuildRequestObject) (server.GetEngineRebuildResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetEngineRebuild request received", "rebuild_id", request.RebuildId)
+
+	rebuild, err := h.service.GetEngineRebuild(ctx, request.RebuildId)
+	if err != nil {
+		logServiceError(ctx, "GetEngineRebuild failed", err, "rebuild_id", request.RebuildId)
+		return h.handleGetEngineRebuildError(err, request), nil
+	}
+
+	return server.GetEngineRebuild200JSONResponse(engineRebuildV1Alpha1ToServer(*rebuild)), nil
+}
+
+// GetBuildInfo handles build information requests.
+func (h *PolicyHandler) GetBuildInfo(_ context.Context, _ server.GetBuildInfoRequestObject) (server.GetBuildInfoResponseObject, error) {
+	info := buildinfo.Get()
+	return server.GetBuildInfo200JSONResponse{
+		Version:      info.Version,
+		GitSha:       info.GitCommit,
+		BuildDate:    info.BuildDate,
+		GoVersion:    info.GoVersion,
+		FeatureFlags: maps.Clone(h.featureFlags),
+		Capabilities: info.Capabilities,
+		Telemetry:    telemetryStatusToServer(h.telemetry.Status()),
 	}, nil
 }
 
@@ -54,13 +166,51 @@ func (h *PolicyHandler) CreatePolicy(ctx context.Context, request server.CreateP
 	// Convert server.Policy to v1alpha1.Policy
 	v1Alpha1Policy := policyServerToV1Alpha1(*request.Body)
 
+	onConflict := server.OnConflictFail
+	if request.Params.OnConflict != nil {
+		onConflict = *request.Params.OnConflict
+	}
+	if !onConflict.Valid() {
+		return server.CreatePolicy400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid on_conflict",
+				strPtr(fmt.Sprintf("on_conflict must be one of: fail, return_existing (got '%s')", onConflict)),
+			)),
+		}, nil
+	}
+
+	if isDryRun(request.Params.DryRun) {
+		policy, err := h.service.DryRunCreatePolicy(ctx, v1Alpha1Policy, request.Params.Id)
+		if err != nil {
+			logServiceError(ctx, "CreatePolicy dry run failed", err)
+			return h.handleCreatePolicyError(err, request), nil
+		}
+		log.Info("Policy create dry run succeeded", "policy_id", *policy.Id)
+		return server.CreatePolicy200JSONResponse(policyV1Alpha1ToServer(*policy)), nil
+	}
+
 	// Call service to create policy
-	created, err := h.service.CreatePolicy(ctx, v1Alpha1Policy, request.Params.Id)
+	var created *v1alpha1.Policy
+	var err error
+	existed := false
+	if onConflict == server.OnConflictReturnExisting && request.Params.Id != nil && *request.Params.Id != "" {
+		var wasCreated bool
+		created, wasCreated, err = h.service.CreateOrGetPolicy(ctx, v1Alpha1Policy, *request.Params.Id)
+		existed = !wasCreated
+	} else {
+		created, err = h.service.CreatePolicy(ctx, v1Alpha1Policy, request.Params.Id)
+	}
 	if err != nil {
 		logServiceError(ctx, "CreatePolicy failed", err)
 		return h.handleCreatePolicyError(err, request), nil
 	}
 
+	if existed {
+		log.Info("Identical policy already exists", "policy_id", *created.Id)
+		return server.CreatePolicy200JSONResponse(policyV1Alpha1ToServer(*created)), nil
+	}
 	log.Info("Policy created", "policy_id", *created.Id)
 	// Convert back to server.Policy
 	return server.CreatePolicy201JSONResponse{
@@ -80,9 +230,27 @@ func (h *PolicyHandler) GetPolicy(ctx context.Context, request server.GetPolicyR
 		return h.handleGetPolicyError(err, request), nil
 	}
 
+	body := policyV1Alpha1ToServer(*policy)
+	etag, err := entityTag(body)
+	if err != nil {
+		return h.handleGetPolicyError(err, request), nil
+	}
+	cacheControl := h.cacheControl()
+	if notModified(request.Params.IfNoneMatch, request.Params.IfModifiedSince, etag, policy.UpdateTime) {
+		log.Debug("GetPolicy not modified", "policy_id", request.PolicyId)
+		return server.GetPolicy304Response{
+			Headers: server.NotModifiedResponseHeaders{CacheControl: &cacheControl, ETag: &etag},
+		}, nil
+	}
+
 	log.Debug("GetPolicy request completed", "policy_id", request.PolicyId)
 
-	return server.GetPolicy200JSONResponse(policyV1Alpha1ToServer(*policy)), nil
+	headers := server.GetPolicy200ResponseHeaders{CacheControl: &cacheControl, ETag: &etag}
+	if policy.UpdateTime != nil {
+		lastModified := httpDate(*policy.UpdateTime)
+		headers.LastModified = &lastModified
+	}
+	return server.GetPolicy200JSONResponse{Body: body, Headers: headers}, nil
 }
 
 // ListPolicies handles listing policies with optional filtering and pagination.
@@ -107,8 +275,34 @@ func (h *PolicyHandler) ListPolicies(ctx context.Context, request server.ListPol
 		return h.handleListPoliciesError(err, request), nil
 	}
 
+	body := listResponseV1Alpha1ToServer(*result)
+	etag, err := entityTag(body)
+	if err != nil {
+		return h.handleListPoliciesError(err, request), nil
+	}
+	cacheControl := h.cacheControl()
+	// A list's latest update_time does not change when a policy is deleted, so only the ETag validates it
+	if notModified(request.Params.IfNoneMatch, nil, etag, nil) {
+		log.Debug("ListPolicies not modified")
+		return server.ListPolicies304Response{
+			Headers: server.NotModifiedResponseHeaders{CacheControl: &cacheControl, ETag: &etag},
+		}, nil
+	}
+
 	log.Debug("ListPolicies completed", "count", len(result.Policies))
-	return server.ListPolicies200JSONResponse(listResponseV1Alpha1ToServer(*result)), nil
+
+	headers := server.ListPolicies200ResponseHeaders{CacheControl: &cacheControl, ETag: &etag}
+	var latest *time.Time
+	for _, policy := range result.Policies {
+		if policy.UpdateTime != nil && (latest == nil || policy.UpdateTime.After(*latest)) {
+			latest = policy.UpdateTime
+		}
+	}
+	if latest != nil {
+		lastModified := httpDate(*latest)
+		headers.LastModified = &lastModified
+	}
+	return server.ListPolicies200JSONResponse{Body: body, Headers: headers}, nil
 }
 
 // UpdatePolicy handles updating an existing policy resource.
@@ -132,6 +326,16 @@ func (h *PolicyHandler) UpdatePolicy(ctx context.Context, request server.UpdateP
 	// Convert server Policy (PATCH body) to api/v1alpha1 Policy
 	patch := policyServerToV1Alpha1(*request.Body)
 
+	if isDryRun(request.Params.DryRun) {
+		policy, err := h.service.DryRunUpdatePolicy(ctx, request.PolicyId, &patch)
+		if err != nil {
+			logServiceError(ctx, "UpdatePolicy dry run failed", err, "policy_id", request.PolicyId)
+			return h.handleUpdatePolicyError(err, request), nil
+		}
+		log.Info("Policy update dry run succeeded", "policy_id", request.PolicyId)
+		return server.UpdatePolicy200JSONResponse(policyV1Alpha1ToServer(*policy)), nil
+	}
+
 	// Call service to update policy (merge patch onto existing)
 	updated, err := h.service.UpdatePolicy(ctx, request.PolicyId, &patch)
 	if err != nil {
@@ -143,11 +347,54 @@ func (h *PolicyHandler) UpdatePolicy(ctx context.Context, request server.UpdateP
 	return server.UpdatePolicy200JSONResponse(policyV1Alpha1ToServer(*updated)), nil
 }
 
+// ApplyPolicy handles replacing a policy, creating it when allow_missing is set.
+func (h *PolicyHandler) ApplyPolicy(ctx context.Context, request server.ApplyPolicyRequestObject) (server.ApplyPolicyResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("ApplyPolicy called with nil body", "policy_id", request.PolicyId)
+		return server.ApplyPolicy400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid request body",
+				strPtr("Request body is required"),
+			)),
+		}, nil
+	}
+
+	allowMissing := request.Params.AllowMissing != nil && *request.Params.AllowMissing
+	log.Debug("ApplyPolicy request received", "policy_id", request.PolicyId, "allow_missing", allowMissing)
+
+	policy := policyServerToV1Alpha1(*request.Body)
+	applied, created, err := h.service.ApplyPolicy(ctx, request.PolicyId, policy, allowMissing)
+	if err != nil {
+		logServiceError(ctx, "ApplyPolicy failed", err, "policy_id", request.PolicyId)
+		return h.handleApplyPolicyError(err, request), nil
+	}
+
+	if created {
+		log.Info("Policy created by apply", "policy_id", request.PolicyId)
+		return server.ApplyPolicy201JSONResponse(policyV1Alpha1ToServer(*applied)), nil
+	}
+	log.Info("Policy replaced", "policy_id", request.PolicyId)
+	return server.ApplyPolicy200JSONResponse(policyV1Alpha1ToServer(*applied)), nil
+}
+
 // DeletePolicy handles deleting a policy by ID.
 func (h *PolicyHandler) DeletePolicy(ctx context.Context, request server.DeletePolicyRequestObject) (server.DeletePolicyResponseObject, error) {
 	log := logging.FromContext(ctx)
 	log.Debug("DeletePolicy request received", "policy_id", request.PolicyId)
 
+	if isDryRun(request.Params.DryRun) {
+		if err := h.service.DryRunDeletePolicy(ctx, request.PolicyId); err != nil {
+			logServiceError(ctx, "DeletePolicy dry run failed", err, "policy_id", request.PolicyId)
+			return h.handleDeletePolicyError(err, request), nil
+		}
+		log.Info("Policy delete dry run succeeded", "policy_id", request.PolicyId)
+		return server.DeletePolicy204Response{}, nil
+	}
+
 	// Call service to delete policy
 	err := h.service.DeletePolicy(ctx, request.PolicyId)
 	if err != nil {
@@ -158,3 +405,408 @@ func (h *PolicyHandler) DeletePolicy(ctx context.Context, request server.DeleteP
 	log.Info("Policy deleted", "policy_id", request.PolicyId)
 	return server.DeletePolicy204Response{}, nil
 }
+
+// isDryRun reports whether the dry_run query parameter is set
+func isDryRun(dryRun *server.DryRun) bool {
+	return dryRun != nil && *dryRun
+}
+
+// ImportPolicyBundle handles importing policies from an OPA bundle or ConfigMap dump.
+func (h *PolicyHandler) ImportPolicyBundle(ctx context.Context, request server.ImportPolicyBundleRequestObject) (server.ImportPolicyBundleResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("ImportPolicyBundle called with nil body")
+		return server.ImportPolicyBundle400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid request body",
+				strPtr("Request body is required"),
+			)),
+		}, nil
+	}
+
+	opts := bundleImportOptionsFromParams(request.Params)
+	log.Debug("ImportPolicyBundle request received",
+		"format", opts.Format,
+		"metadata_convention", opts.MetadataConvention,
+	)
+
+	result, err := h.service.ImportBundle(ctx, request.Body, opts)
+	if err != nil {
+		logServiceError(ctx, "ImportPolicyBundle failed", err)
+		return h.handleImportPolicyBundleError(err, request), nil
+	}
+
+	log.Info("Policy bundle imported", "file_count", len(result.Results))
+	return server.ImportPolicyBundle200JSONResponse(bundleImportResultV1Alpha1ToServer(*result)), nil
+}
+
+// ImportPolicies handles creating the policies of a JSON or multipart import request.
+func (h *PolicyHandler) ImportPolicies(ctx context.Context, request server.ImportPoliciesRequestObject) (server.ImportPoliciesResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	var importRequest v1alpha1.PolicyImportRequest
+	switch {
+	case request.JSONBody != nil:
+		importRequest = policyImportRequestServerToV1Alpha1(*request.JSONBody)
+	case request.MultipartBody != nil:
+		var err error
+		if importRequest, err = service.ReadPolicyImportForm(request.MultipartBody); err != nil {
+			logServiceError(ctx, "ImportPolicies failed", err)
+			return h.handleImportPoliciesError(err, request), nil
+		}
+	default:
+		log.Warn("ImportPolicies called with nil body")
+		return server.ImportPolicies400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid request body",
+				strPtr("Request body is required"),
+			)),
+		}, nil
+	}
+
+	atomic := request.Params.Atomic != nil && *request.Params.Atomic
+	log.Debug("ImportPolicies request received", "policy_count", len(importRequest.Policies), "atomic", atomic)
+
+	result, err := h.service.ImportPolicies(ctx, importRequest, atomic)
+	if err != nil {
+		logServiceError(ctx, "ImportPolicies failed", err)
+		return h.handleImportPoliciesError(err, request), nil
+	}
+
+	log.Info("Policies imported", "policy_count", len(result.Results), "atomic", atomic)
+	return server.ImportPolicies200JSONResponse(policyImportResultV1Alpha1ToServer(*result)), nil
+}
+
+// ConvertGatekeeper handles converting Gatekeeper ConstraintTemplates and Constraints into policies.
+func (h *PolicyHandler) ConvertGatekeeper(ctx context.Context, request server.ConvertGatekeeperRequestObject) (server.ConvertGatekeeperResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("ConvertGatekeeper called with nil body")
+		return server.ConvertGatekeeper400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid request body",
+				strPtr("Request body is required"),
+			)),
+		}, nil
+	}
+
+	log.Debug("ConvertGatekeeper request received")
+
+	result, err := h.service.ConvertGatekeeper(ctx, request.Body)
+	if err != nil {
+		logServiceError(ctx, "ConvertGatekeeper failed", err)
+		return h.handleConvertGatekeeperError(err, request), nil
+	}
+
+	log.Debug("ConvertGatekeeper completed", "policy_count", len(result.Policies), "issue_count", len(result.Report))
+	return server.ConvertGatekeeper200JSONResponse(gatekeeperConversionV1Alpha1ToServer(*result)), nil
+}
+
+// GetPolicyFacets handles listing the distinct values of policy filter fields.
+func (h *PolicyHandler) GetPolicyFacets(ctx context.Context, request server.GetPolicyFacetsRequestObject) (server.GetPolicyFacetsResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetPolicyFacets request received")
+
+	facets, err := h.service.GetPolicyFacets(ctx)
+	if err != nil {
+		logServiceError(ctx, "GetPolicyFacets failed", err)
+		return h.handleGetPolicyFacetsError(err, request), nil
+	}
+
+	return server.GetPolicyFacets200JSONResponse(policyFacetsV1Alpha1ToServer(*facets)), nil
+}
+
+// GetPolicyChecksum handles computing the digest of the stored policy set.
+func (h *PolicyHandler) GetPolicyChecksum(ctx context.Context, request server.GetPolicyChecksumRequestObject) (server.GetPolicyChecksumResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetPolicyChecksum request received")
+
+	checksum, err := h.service.GetPolicyChecksum(ctx)
+	if err != nil {
+		logServiceError(ctx, "GetPolicyChecksum failed", err)
+		return h.handleGetPolicyChecksumError(err, request), nil
+	}
+
+	return server.GetPolicyChecksum200JSONResponse(*checksum), nil
+}
+
+// GetPolicyCatalog handles generating the catalog of enabled policies as JSON or markdown.
+func (h *PolicyHandler) GetPolicyCatalog(ctx context.Context, request server.GetPolicyCatalogRequestObject) (server.GetPolicyCatalogResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	format := server.CatalogFormatJSON
+	if request.Params.Format != nil {
+		format = *request.Params.Format
+	}
+	log.Debug("GetPolicyCatalog request received", "format", format)
+	if !format.Valid() {
+		return server.GetPolicyCatalog400JSONResponse{
+			BadRequestJSONResponse: badRequestResponse(buildErrorResponse(
+				400,
+				v1alpha1.INVALIDARGUMENT,
+				"Invalid format",
+				strPtr(fmt.Sprintf("format must be JSON or MARKDOWN (got '%s')", format)),
+			)),
+		}, nil
+	}
+
+	catalog, err := h.service.GetPolicyCatalog(ctx)
+	if err != nil {
+		logServiceError(ctx, "GetPolicyCatalog failed", err)
+		return h.handleGetPolicyCatalogError(err, request), nil
+	}
+
+	if format == server.CatalogFormatMarkdown {
+		markdown := service.PolicyCatalogMarkdown(catalog)
+		return server.GetPolicyCatalog200TextmarkdownResponse{
+			Body:          strings.NewReader(markdown),
+			ContentLength: int64(len(markdown)),
+		}, nil
+	}
+	return server.GetPolicyCatalog200JSONResponse(policyCatalogV1Alpha1ToServer(*catalog)), nil
+}
+
+// GetEvaluationOrder handles listing the policies a request would be evaluated against.
+func (h *PolicyHandler) GetEvaluationOrder(ctx context.Context, request server.GetEvaluationOrderRequestObject) (server.GetEvaluationOrderResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetEvaluationOrder request received")
+
+	var labels []string
+	if request.Params.Labels != nil {
+		labels = *request.Params.Labels
+	}
+	order, err := h.service.GetEvaluationOrder(ctx, labels)
+	if err != nil {
+		logServiceError(ctx, "GetEvaluationOrder failed", err)
+		return h.handleGetEvaluationOrderError(err, request), nil
+	}
+
+	return server.GetEvaluationOrder200JSONResponse(evaluationOrderV1Alpha1ToServer(*order)), nil
+}
+
+// TestPolicyMatch handles testing a policy's label selector against request labels.
+func (h *PolicyHandler) TestPolicyMatch(ctx context.Context, request server.TestPolicyMatchRequestObject) (server.TestPolicyMatchResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("TestPolicyMatch request received", "policy_id", request.PolicyId)
+
+	if request.Body == nil {
+		log.Warn("TestPolicyMatch called with nil body", "policy_id", request.PolicyId)
+		return h.handleTestPolicyMatchError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	result, err := h.service.TestPolicyMatch(ctx, request.PolicyId, policyMatchTestRequestServerToV1Alpha1(*request.Body))
+	if err != nil {
+		logServiceError(ctx, "TestPolicyMatch failed", err, "policy_id", request.PolicyId)
+		return h.handleTestPolicyMatchError(err, request), nil
+	}
+
+	return server.TestPolicyMatch200JSONResponse(policyMatchTestResultV1Alpha1ToServer(*result)), nil
+}
+
+// SimulatePolicy handles evaluating a service instance as if a draft policy were installed.
+func (h *PolicyHandler) SimulatePolicy(ctx context.Context, request server.SimulatePolicyRequestObject) (server.SimulatePolicyResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("SimulatePolicy request received")
+
+	if request.Body == nil {
+		log.Warn("SimulatePolicy called with nil body")
+		return h.handleSimulatePolicyError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	result, err := h.service.SimulatePolicy(ctx, policySimulationRequestServerToV1Alpha1(*request.Body))
+	if err != nil {
+		logServiceError(ctx, "SimulatePolicy failed", err)
+		return h.handleSimulatePolicyError(err, request), nil
+	}
+
+	return server.SimulatePolicy200JSONResponse(policySimulationV1Alpha1ToServer(*result)), nil
+}
+
+// CheckPolicyConflicts handles checking a candidate policy for conflicts with the stored policies.
+func (h *PolicyHandler) CheckPolicyConflicts(ctx context.Context, request server.CheckPolicyConflictsRequestObject) (server.CheckPolicyConflictsResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("CheckPolicyConflicts request received")
+
+	if request.Body == nil {
+		log.Warn("CheckPolicyConflicts called with nil body")
+		return h.handleCheckPolicyConflictsError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	report, err := h.service.CheckPolicyConflicts(ctx, policyConflictCheckRequestServerToV1Alpha1(*request.Body))
+	if err != nil {
+		logServiceError(ctx, "CheckPolicyConflicts failed", err)
+		return h.handleCheckPolicyConflictsError(err, request), nil
+	}
+
+	return server.CheckPolicyConflicts200JSONResponse(policyConflictReportV1Alpha1ToServer(*report)), nil
+}
+
+// ListPolicyRevisions handles listing the revisions of a policy.
+func (h *PolicyHandler) ListPolicyRevisions(ctx context.Context, request server.ListPolicyRevisionsRequestObject) (server.ListPolicyRevisionsResponseObject, error) {
+	logging.FromContext(ctx).Debug("ListPolicyRevisions request received", "policy_id", request.PolicyId)
+
+	result, err := h.service.ListPolicyRevisions(ctx, request.PolicyId, request.Params.PageToken, request.Params.MaxPageSize)
+	if err != nil {
+		logServiceError(ctx, "ListPolicyRevisions failed", err, "policy_id", request.PolicyId)
+		return h.handleListPolicyRevisionsError(err, request), nil
+	}
+
+	return server.ListPolicyRevisions200JSONResponse(policyRevisionListV1Alpha1ToServer(*result)), nil
+}
+
+// GetPolicyRevision handles retrieving a single revision of a policy.
+func (h *PolicyHandler) GetPolicyRevision(ctx context.Context, request server.GetPolicyRevisionRequestObject) (server.GetPolicyRevisionResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetPolicyRevision request received", "policy_id", request.PolicyId, "revision", request.Revision)
+
+	revision, err := h.service.GetPolicyRevision(ctx, request.PolicyId, request.Revision)
+	if err != nil {
+		logServiceError(ctx, "GetPolicyRevision failed", err, "policy_id", request.PolicyId, "revision", request.Revision)
+		return h.handleGetPolicyRevisionError(err, request), nil
+	}
+
+	return server.GetPolicyRevision200JSONResponse(policyRevisionV1Alpha1ToServer(*revision)), nil
+}
+
+// DiffPolicyRevisions handles comparing two revisions of a policy.
+func (h *PolicyHandler) DiffPolicyRevisions(ctx context.Context, request server.DiffPolicyRevisionsRequestObject) (server.DiffPolicyRevisionsResponseObject, error) {
+	logging.FromContext(ctx).Debug("DiffPolicyRevisions request received", "policy_id", request.PolicyId, "from", request.Params.From, "to", request.Params.To)
+
+	diff, err := h.service.DiffPolicyRevisions(ctx, request.PolicyId, request.Params.From, request.Params.To)
+	if err != nil {
+		logServiceError(ctx, "DiffPolicyRevisions failed", err, "policy_id", request.PolicyId, "from", request.Params.From, "to", request.Params.To)
+		return h.handleDiffPolicyRevisionsError(err, request), nil
+	}
+
+	return server.DiffPolicyRevisions200JSONResponse(policyRevisionDiffV1Alpha1ToServer(*diff)), nil
+}
+
+// RollbackPolicy handles restoring a policy from one of its revisions.
+func (h *PolicyHandler) RollbackPolicy(ctx context.Context, request server.RollbackPolicyRequestObject) (server.RollbackPolicyResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("RollbackPolicy called with nil body", "policy_id", request.PolicyId)
+		return h.handleRollbackPolicyError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	log.Debug("RollbackPolicy request received", "policy_id", request.PolicyId, "revision", request.Body.Revision)
+
+	policy, err := h.service.RollbackPolicy(ctx, request.PolicyId, request.Body.Revision)
+	if err != nil {
+		logServiceError(ctx, "RollbackPolicy failed", err, "policy_id", request.PolicyId, "revision", request.Body.Revision)
+		return h.handleRollbackPolicyError(err, request), nil
+	}
+
+	log.Info("Policy rolled back", "policy_id", request.PolicyId, "revision", request.Body.Revision)
+	return server.RollbackPolicy200JSONResponse(policyV1Alpha1ToServer(*policy)), nil
+}
+
+// LockPolicy handles taking the advisory edit lock on a policy.
+func (h *PolicyHandler) LockPolicy(ctx context.Context, request server.LockPolicyRequestObject) (server.LockPolicyResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("LockPolicy called with nil body", "policy_id", request.PolicyId)
+		return h.handleLockPolicyError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	log.Debug("LockPolicy request received", "policy_id", request.PolicyId, "owner", request.Body.Owner)
+
+	lock, err := h.service.LockPolicy(ctx, request.PolicyId, request.Body.Owner, request.Body.TtlSeconds)
+	if err != nil {
+		logServiceError(ctx, "LockPolicy failed", err, "policy_id", request.PolicyId, "owner", request.Body.Owner)
+		return h.handleLockPolicyError(err, request), nil
+	}
+
+	return server.LockPolicy200JSONResponse(server.PolicyLock(*lock)), nil
+}
+
+// UnlockPolicy handles releasing the advisory edit lock on a policy.
+func (h *PolicyHandler) UnlockPolicy(ctx context.Context, request server.UnlockPolicyRequestObject) (server.UnlockPolicyResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("UnlockPolicy called with nil body", "policy_id", request.PolicyId)
+		return h.handleUnlockPolicyError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	log.Debug("UnlockPolicy request received", "policy_id", request.PolicyId, "owner", request.Body.Owner)
+
+	if err := h.service.UnlockPolicy(ctx, request.PolicyId, request.Body.Owner); err != nil {
+		logServiceError(ctx, "UnlockPolicy failed", err, "policy_id", request.PolicyId, "owner", request.Body.Owner)
+		return h.handleUnlockPolicyError(err, request), nil
+	}
+
+	return server.UnlockPolicy204Response{}, nil
+}
+
+// CreatePolicyTest handles adding a test case to a policy.
+func (h *PolicyHandler) CreatePolicyTest(ctx context.Context, request server.CreatePolicyTestRequestObject) (server.CreatePolicyTestResponseObject, error) {
+	log := logging.FromContext(ctx)
+
+	if request.Body == nil {
+		log.Warn("CreatePolicyTest called with nil body", "policy_id", request.PolicyId)
+		return h.handleCreatePolicyTestError(service.NewInvalidArgumentError("Invalid request body", "Request body is required"), request), nil
+	}
+	log.Debug("CreatePolicyTest request received", "policy_id", request.PolicyId, "test_id", request.Params.Id)
+
+	test, err := h.service.CreatePolicyTest(ctx, request.PolicyId, policyTestServerToV1Alpha1(*request.Body), request.Params.Id)
+	if err != nil {
+		logServiceError(ctx, "CreatePolicyTest failed", err, "policy_id", request.PolicyId)
+		return h.handleCreatePolicyTestError(err, request), nil
+	}
+
+	return server.CreatePolicyTest201JSONResponse(policyTestV1Alpha1ToServer(*test)), nil
+}
+
+// ListPolicyTests handles listing the test cases of a policy.
+func (h *PolicyHandler) ListPolicyTests(ctx context.Context, request server.ListPolicyTestsRequestObject) (server.ListPolicyTestsResponseObject, error) {
+	logging.FromContext(ctx).Debug("ListPolicyTests request received", "policy_id", request.PolicyId)
+
+	result, err := h.service.ListPolicyTests(ctx, request.PolicyId, request.Params.PageToken, request.Params.MaxPageSize)
+	if err != nil {
+		logServiceError(ctx, "ListPolicyTests failed", err, "policy_id", request.PolicyId)
+		return h.handleListPolicyTestsError(err, request), nil
+	}
+
+	return server.ListPolicyTests200JSONResponse(policyTestListV1Alpha1ToServer(*result)), nil
+}
+
+// GetPolicyTest handles retrieving a test case of a policy.
+func (h *PolicyHandler) GetPolicyTest(ctx context.Context, request server.GetPolicyTestRequestObject) (server.GetPolicyTestResponseObject, error) {
+	logging.FromContext(ctx).Debug("GetPolicyTest request received", "policy_id", request.PolicyId, "test_id", request.TestId)
+
+	test, err := h.service.GetPolicyTest(ctx, request.PolicyId, request.TestId)
+	if err != nil {
+		logServiceError(ctx, "GetPolicyTest failed", err, "policy_id", request.PolicyId, "test_id", request.TestId)
+		return h.handleGetPolicyTestError(err, request), nil
+	}
+
+	return server.GetPolicyTest200JSONResponse(policyTestV1Alpha1ToServer(*test)), nil
+}
+
+// DeletePolicyTest handles deleting a test case of a policy.
+func (h *PolicyHandler) DeletePolicyTest(ctx context.Context, request server.DeletePolicyTestRequestObject) (server.DeletePolicyTestResponseObject, error) {
+	logging.FromContext(ctx).Debug("DeletePolicyTest request received", "policy_id", request.PolicyId, "test_id", request.TestId)
+
+	if err := h.service.DeletePolicyTest(ctx, request.PolicyId, request.TestId); err != nil {
+		logServiceError(ctx, "DeletePolicyTest failed", err, "policy_id", request.PolicyId, "test_id", request.TestId)
+		return h.handleDeletePolicyTestError(err, request), nil
+	}
+
+	return server.DeletePolicyTest204Response{}, nil
+}
+
+// RunPolicyTests handles running the test cases of a policy.
+func (h *PolicyHandler) RunPolicyTests(ctx context.Context, request server.RunPolicyTestsRequestObject) (server.RunPolicyTestsResponseObject, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("RunPolicyTests request received", "policy_id", request.PolicyId)
+
+	run, err := h.service.RunPolicyTests(ctx, request.PolicyId)
+	if err != nil {
+		logServiceError(ctx, "RunPolicyTests failed", err, "policy_id", request.PolicyId)
+		return h.handleRunPolicyTestsError(err, request), nil
+	}
+
+	log.Info("Policy tests run", "policy_id", request.PolicyId, "passed", run.Passed, "failed", run.Failed)
+	return server.RunPolicyTests200JSONResponse(policyTestRunV1Alpha1ToServer(*run)), nil
+}
@@ -1,22 +1,67 @@
 package v1alpha1
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
 
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
 	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
 	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/telemetry"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 // MockPolicyService is a mock implementation of PolicyService for testing
 type MockPolicyService struct {
-	CreatePolicyFn func(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error)
-	GetPolicyFn    func(ctx context.Context, id string) (*v1alpha1.Policy, error)
-	ListPoliciesFn func(ctx context.Context, filter *string, orderBy *string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyList, error)
-	UpdatePolicyFn func(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error)
-	DeletePolicyFn func(ctx context.Context, id string) error
+	CreatePolicyFn         func(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error)
+	CreateOrGetPolicyFn    func(ctx context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error)
+	GetPolicyFn            func(ctx context.Context, id string) (*v1alpha1.Policy, error)
+	ListPoliciesFn         func(ctx context.Context, filter *string, orderBy *string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyList, error)
+	UpdatePolicyFn         func(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error)
+	ApplyPolicyFn          func(ctx context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error)
+	DeletePolicyFn         func(ctx context.Context, id string) error
+	DryRunCreatePolicyFn   func(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error)
+	DryRunUpdatePolicyFn   func(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error)
+	DryRunDeletePolicyFn   func(ctx context.Context, id string) error
+	EngineStatusFn         func() service.EngineStatus
+	CheckReadinessFn       func(ctx context.Context) service.Readiness
+	RebuildEngineFn        func(ctx context.Context) (*v1alpha1.EngineRebuild, error)
+	GetEngineRebuildFn     func(ctx context.Context, id string) (*v1alpha1.EngineRebuild, error)
+	GetPolicyFacetsFn      func(ctx context.Context) (*v1alpha1.PolicyFacets, error)
+	GetPolicyChecksumFn    func(ctx context.Context) (*v1alpha1.PolicyChecksum, error)
+	GetEvaluationOrderFn   func(ctx context.Context, labels []string) (*v1alpha1.EvaluationOrder, error)
+	GetPolicyCatalogFn     func(ctx context.Context) (*v1alpha1.PolicyCatalog, error)
+	TestPolicyMatchFn      func(ctx context.Context, id string, req v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error)
+	SimulatePolicyFn       func(ctx context.Context, req v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error)
+	CheckPolicyConflictsFn func(ctx context.Context, req v1alpha1.PolicyConflictCheckRequest) (*v1alpha1.PolicyConflictReport, error)
+	ListPolicyRevisionsFn  func(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyRevisionList, error)
+	GetPolicyRevisionFn    func(ctx context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error)
+	DiffPolicyRevisionsFn  func(ctx context.Context, id string, from, to int64) (*v1alpha1.PolicyRevisionDiff, error)
+	RollbackPolicyFn       func(ctx context.Context, id string, revision int64) (*v1alpha1.Policy, error)
+	LockPolicyFn           func(ctx context.Context, id, owner string, ttlSeconds *int32) (*v1alpha1.PolicyLock, error)
+	UnlockPolicyFn         func(ctx context.Context, id, owner string) error
+	CreatePolicyTestFn     func(ctx context.Context, id string, test v1alpha1.PolicyTest, clientID *string) (*v1alpha1.PolicyTest, error)
+	ListPolicyTestsFn      func(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyTestList, error)
+	GetPolicyTestFn        func(ctx context.Context, id, testID string) (*v1alpha1.PolicyTest, error)
+	DeletePolicyTestFn     func(ctx context.Context, id, testID string) error
+	RunPolicyTestsFn       func(ctx context.Context, id string) (*v1alpha1.PolicyTestRun, error)
+	ImportBundleFn         func(ctx context.Context, archive io.Reader, opts service.BundleImportOptions) (*v1alpha1.BundleImportResult, error)
+	ImportPoliciesFn       func(ctx context.Context, request v1alpha1.PolicyImportRequest, atomic bool) (*v1alpha1.PolicyImportResult, error)
+	ExportBundleFn         func(ctx context.Context, w io.Writer, opts service.BundleExportOptions, flush func() error) (int, error)
+	ConvertGatekeeperFn    func(ctx context.Context, resources io.Reader) (*v1alpha1.GatekeeperConversionResult, error)
 }
 
 func (m *MockPolicyService) CompileAll(_ context.Context) error {
@@ -30,6 +75,13 @@ func (m *MockPolicyService) CreatePolicy(ctx context.Context, policy v1alpha1.Po
 	return nil, nil
 }
 
+func (m *MockPolicyService) CreateOrGetPolicy(ctx context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error) {
+	if m.CreateOrGetPolicyFn != nil {
+		return m.CreateOrGetPolicyFn(ctx, policy, clientID)
+	}
+	return nil, false, nil
+}
+
 func (m *MockPolicyService) GetPolicy(ctx context.Context, id string) (*v1alpha1.Policy, error) {
 	if m.GetPolicyFn != nil {
 		return m.GetPolicyFn(ctx, id)
@@ -51,6 +103,13 @@ func (m *MockPolicyService) UpdatePolicy(ctx context.Context, id string, patch *
 	return nil, nil
 }
 
+func (m *MockPolicyService) ApplyPolicy(ctx context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error) {
+	if m.ApplyPolicyFn != nil {
+		return m.ApplyPolicyFn(ctx, id, policy, allowMissing)
+	}
+	return nil, false, nil
+}
+
 func (m *MockPolicyService) DeletePolicy(ctx context.Context, id string) error {
 	if m.DeletePolicyFn != nil {
 		return m.DeletePolicyFn(ctx, id)
@@ -58,6 +117,209 @@ func (m *MockPolicyService) DeletePolicy(ctx context.Context, id string) error {
 	return nil
 }
 
+func (m *MockPolicyService) DryRunCreatePolicy(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error) {
+	if m.DryRunCreatePolicyFn != nil {
+		return m.DryRunCreatePolicyFn(ctx, policy, clientID)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) DryRunUpdatePolicy(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+	if m.DryRunUpdatePolicyFn != nil {
+		return m.DryRunUpdatePolicyFn(ctx, id, patch)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) EngineStatus() service.EngineStatus {
+	if m.EngineStatusFn != nil {
+		return m.EngineStatusFn()
+	}
+	return service.EngineStatus{}
+}
+
+func (m *MockPolicyService) CheckReadiness(ctx context.Context) service.Readiness {
+	if m.CheckReadinessFn != nil {
+		return m.CheckReadinessFn(ctx)
+	}
+	return service.Readiness{}
+}
+
+func (m *MockPolicyService) RebuildEngine(ctx context.Context) (*v1alpha1.EngineRebuild, error) {
+	if m.RebuildEngineFn != nil {
+		return m.RebuildEngineFn(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetEngineRebuild(ctx context.Context, id string) (*v1alpha1.EngineRebuild, error) {
+	if m.GetEngineRebuildFn != nil {
+		return m.GetEngineRebuildFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) DryRunDeletePolicy(ctx context.Context, id string) error {
+	if m.DryRunDeletePolicyFn != nil {
+		return m.DryRunDeletePolicyFn(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockPolicyService) GetPolicyFacets(ctx context.Context) (*v1alpha1.PolicyFacets, error) {
+	if m.GetPolicyFacetsFn != nil {
+		return m.GetPolicyFacetsFn(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetPolicyChecksum(ctx context.Context) (*v1alpha1.PolicyChecksum, error) {
+	if m.GetPolicyChecksumFn != nil {
+		return m.GetPolicyChecksumFn(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetEvaluationOrder(ctx context.Context, labels []string) (*v1alpha1.EvaluationOrder, error) {
+	if m.GetEvaluationOrderFn != nil {
+		return m.GetEvaluationOrderFn(ctx, labels)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetPolicyCatalog(ctx context.Context) (*v1alpha1.PolicyCatalog, error) {
+	if m.GetPolicyCatalogFn != nil {
+		return m.GetPolicyCatalogFn(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) TestPolicyMatch(ctx context.Context, id string, req v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error) {
+	if m.TestPolicyMatchFn != nil {
+		return m.TestPolicyMatchFn(ctx, id, req)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) SimulatePolicy(ctx context.Context, req v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error) {
+	if m.SimulatePolicyFn != nil {
+		return m.SimulatePolicyFn(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) CheckPolicyConflicts(ctx context.Context, req v1alpha1.PolicyConflictCheckRequest) (*v1alpha1.PolicyConflictReport, error) {
+	if m.CheckPolicyConflictsFn != nil {
+		return m.CheckPolicyConflictsFn(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) ListPolicyRevisions(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyRevisionList, error) {
+	if m.ListPolicyRevisionsFn != nil {
+		return m.ListPolicyRevisionsFn(ctx, id, pageToken, pageSize)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetPolicyRevision(ctx context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error) {
+	if m.GetPolicyRevisionFn != nil {
+		return m.GetPolicyRevisionFn(ctx, id, revision)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) DiffPolicyRevisions(ctx context.Context, id string, from, to int64) (*v1alpha1.PolicyRevisionDiff, error) {
+	if m.DiffPolicyRevisionsFn != nil {
+		return m.DiffPolicyRevisionsFn(ctx, id, from, to)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) RollbackPolicy(ctx context.Context, id string, revision int64) (*v1alpha1.Policy, error) {
+	if m.RollbackPolicyFn != nil {
+		return m.RollbackPolicyFn(ctx, id, revision)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) LockPolicy(ctx context.Context, id, owner string, ttlSeconds *int32) (*v1alpha1.PolicyLock, error) {
+	if m.LockPolicyFn != nil {
+		return m.LockPolicyFn(ctx, id, owner, ttlSeconds)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) UnlockPolicy(ctx context.Context, id, owner string) error {
+	if m.UnlockPolicyFn != nil {
+		return m.UnlockPolicyFn(ctx, id, owner)
+	}
+	return nil
+}
+
+func (m *MockPolicyService) CreatePolicyTest(ctx context.Context, id string, test v1alpha1.PolicyTest, clientID *string) (*v1alpha1.PolicyTest, error) {
+	if m.CreatePolicyTestFn != nil {
+		return m.CreatePolicyTestFn(ctx, id, test, clientID)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) ListPolicyTests(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyTestList, error) {
+	if m.ListPolicyTestsFn != nil {
+		return m.ListPolicyTestsFn(ctx, id, pageToken, pageSize)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) GetPolicyTest(ctx context.Context, id, testID string) (*v1alpha1.PolicyTest, error) {
+	if m.GetPolicyTestFn != nil {
+		return m.GetPolicyTestFn(ctx, id, testID)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) DeletePolicyTest(ctx context.Context, id, testID string) error {
+	if m.DeletePolicyTestFn != nil {
+		return m.DeletePolicyTestFn(ctx, id, testID)
+	}
+	return nil
+}
+
+func (m *MockPolicyService) RunPolicyTests(ctx context.Context, id string) (*v1alpha1.PolicyTestRun, error) {
+	if m.RunPolicyTestsFn != nil {
+		return m.RunPolicyTestsFn(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) ImportBundle(ctx context.Context, archive io.Reader, opts service.BundleImportOptions) (*v1alpha1.BundleImportResult, error) {
+	if m.ImportBundleFn != nil {
+		return m.ImportBundleFn(ctx, archive, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) ImportPolicies(ctx context.Context, request v1alpha1.PolicyImportRequest, atomic bool) (*v1alpha1.PolicyImportResult, error) {
+	if m.ImportPoliciesFn != nil {
+		return m.ImportPoliciesFn(ctx, request, atomic)
+	}
+	return nil, nil
+}
+
+func (m *MockPolicyService) ExportBundle(ctx context.Context, w io.Writer, opts service.BundleExportOptions, flush func() error) (int, error) {
+	if m.ExportBundleFn != nil {
+		return m.ExportBundleFn(ctx, w, opts, flush)
+	}
+	return 0, nil
+}
+
+func (m *MockPolicyService) ConvertGatekeeper(ctx context.Context, resources io.Reader) (*v1alpha1.GatekeeperConversionResult, error) {
+	if m.ConvertGatekeeperFn != nil {
+		return m.ConvertGatekeeperFn(ctx, resources)
+	}
+	return nil, nil
+}
+
 var _ = Describe("PolicyHandler", func() {
 	var handler *PolicyHandler
 	var mockService *MockPolicyService
@@ -84,6 +346,207 @@ var _ = Describe("PolicyHandler", func() {
 			Expect(healthResponse.Path).NotTo(BeNil())
 			Expect(*healthResponse.Path).To(Equal("health"))
 		})
+
+		It("reports the engine and degrades when its last reconciliation failed", func() {
+			reconciled := time.Date(2026, 1, 9, 15, 45, 0, 0, time.UTC)
+			mockService.EngineStatusFn = func() service.EngineStatus {
+				return service.EngineStatus{CompiledPolicies: 3, LastReconcileTime: reconciled, LastReconcileError: "database is locked"}
+			}
+
+			response, err := handler.GetHealth(context.Background(), server.GetHealthRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			healthResponse := response.(server.GetHealth200JSONResponse)
+			Expect(healthResponse.Status).To(Equal("degraded"))
+			Expect(healthResponse.Engine).To(Equal(&server.EngineHealth{
+				CompiledPolicies:   3,
+				LastReconcileTime:  &reconciled,
+				LastReconcileError: strPtr("database is locked"),
+			}))
+		})
+	})
+
+	Describe("GetLiveness", func() {
+		It("should report the process alive without checking dependencies", func() {
+			mockService.CheckReadinessFn = func(context.Context) service.Readiness {
+				Fail("liveness must not check dependencies")
+				return service.Readiness{}
+			}
+
+			response, err := handler.GetLiveness(context.Background(), server.GetLivenessRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(server.GetLiveness200JSONResponse{Status: "ok"}))
+		})
+	})
+
+	Describe("GetReadiness", func() {
+		It("should return 200 with every dependency when all are ready", func() {
+			mockService.CheckReadinessFn = func(context.Context) service.Readiness {
+				return service.Readiness{Dependencies: []service.DependencyStatus{
+					{Name: service.DependencyDatabase, Ready: true},
+					{Name: service.DependencyPolicySync, Ready: true},
+				}}
+			}
+
+			response, err := handler.GetReadiness(context.Background(), server.GetReadinessRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(server.GetReadiness200JSONResponse{
+				Status: "ready",
+				Dependencies: []server.DependencyStatus{
+					{Name: "database", Status: "ok"},
+					{Name: "policy_sync", Status: "ok"},
+				},
+			}))
+		})
+
+		It("should return 503 naming the failed dependency", func() {
+			mockService.CheckReadinessFn = func(context.Context) service.Readiness {
+				return service.Readiness{Dependencies: []service.DependencyStatus{
+					{Name: service.DependencyDatabase, Message: "ping failed: connection refused"},
+					{Name: service.DependencyPolicySync, Ready: true},
+				}}
+			}
+
+			response, err := handler.GetReadiness(context.Background(), server.GetReadinessRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(server.GetReadiness503JSONResponse{
+				Status: "not_ready",
+				Dependencies: []server.DependencyStatus{
+					{Name: "database", Status: "failed", Message: strPtr("ping failed: connection refused")},
+					{Name: "policy_sync", Status: "ok"},
+				},
+			}))
+		})
+	})
+
+	Describe("RebuildEngine", func() {
+		It("should return 202 with the started rebuild", func() {
+			createTime := time.Date(2026, 10, 14, 9, 0, 0, 0, time.UTC)
+			mockService.RebuildEngineFn = func(context.Context) (*v1alpha1.EngineRebuild, error) {
+				return &v1alpha1.EngineRebuild{Id: "rebuild-1", State: v1alpha1.EngineRebuildRunning, CreateTime: createTime}, nil
+			}
+
+			response, err := handler.RebuildEngine(context.Background(), server.RebuildEngineRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(server.RebuildEngine202JSONResponse{
+				Id:         "rebuild-1",
+				State:      server.EngineRebuildRunning,
+				CreateTime: createTime,
+			}))
+		})
+	})
+
+	Describe("GetEngineRebuild", func() {
+		It("should return the progress of the rebuild", func() {
+			message := "failed to compile policies: rego_parse_error"
+			mockService.GetEngineRebuildFn = func(_ context.Context, id string) (*v1alpha1.EngineRebuild, error) {
+				Expect(id).To(Equal("rebuild-1"))
+				return &v1alpha1.EngineRebuild{Id: id, State: v1alpha1.EngineRebuildFailed, PoliciesTotal: 3, Error: &message}, nil
+			}
+
+			response, err := handler.GetEngineRebuild(context.Background(), server.GetEngineRebuildRequestObject{RebuildId: "rebuild-1"})
+
+			Expect(err).NotTo(HaveOccurred())
+			body, ok := response.(server.GetEngineRebuild200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(body.State).To(Equal(server.EngineRebuildFailed))
+			Expect(body.PoliciesTotal).To(Equal(int32(3)))
+			Expect(body.Error).To(HaveValue(Equal(message)))
+		})
+
+		It("should return 404 for an unknown rebuild", func() {
+			mockService.GetEngineRebuildFn = func(_ context.Context, id string) (*v1alpha1.EngineRebuild, error) {
+				return nil, service.NewNotFoundError("Engine rebuild not found", "Engine rebuild 'rebuild-9' does not exist")
+			}
+
+			response, err := handler.GetEngineRebuild(context.Background(), server.GetEngineRebuildRequestObject{RebuildId: "rebuild-9"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.GetEngineRebuild404JSONResponse{}))
+		})
+	})
+
+	Describe("ReloadConfig", func() {
+		It("should return the reloaded settings", func() {
+			cacheMaxAge := runtimeconfig.NewDuration(0)
+			reloader := runtimeconfig.NewReloader(
+				func() (*config.Config, error) {
+					return &config.Config{Service: config.ServiceConfig{CacheMaxAge: time.Minute}}, nil
+				},
+				runtimeconfig.DurationSetting("API_CACHE_MAX_AGE", cacheMaxAge, func(cfg *config.Config) time.Duration {
+					return cfg.Service.CacheMaxAge
+				}),
+			)
+			handler = NewPolicyHandler(mockService, WithReloadableCacheMaxAge(cacheMaxAge), WithConfigReloader(reloader))
+
+			response, err := handler.ReloadConfig(context.Background(), server.ReloadConfigRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			body, ok := response.(server.ReloadConfig200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(body.Settings).To(Equal(map[string]string{"API_CACHE_MAX_AGE": "1m0s"}))
+			Expect(handler.cacheControl()).To(Equal("max-age=60"))
+		})
+
+		It("should return 400 when the configuration is invalid", func() {
+			reloader := runtimeconfig.NewReloader(func() (*config.Config, error) {
+				return nil, errors.New("invalid configuration: EVALUATION_POLICY_TIMEOUT: cannot parse 'soon' as time.Duration")
+			})
+			handler = NewPolicyHandler(mockService, WithConfigReloader(reloader))
+
+			response, err := handler.ReloadConfig(context.Background(), server.ReloadConfigRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			body, ok := response.(server.ReloadConfig400JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(body.Detail).To(HaveValue(ContainSubstring("EVALUATION_POLICY_TIMEOUT")))
+		})
+	})
+
+	Describe("GetBuildInfo", func() {
+		It("should report build details, capabilities and feature flags", func() {
+			handler = NewPolicyHandler(mockService, WithFeatureFlags(map[string]bool{"ext_authz": true}))
+
+			response, err := handler.GetBuildInfo(context.Background(), server.GetBuildInfoRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+
+			buildInfoResponse, ok := response.(server.GetBuildInfo200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetBuildInfo200JSONResponse")
+			Expect(buildInfoResponse.Version).NotTo(BeEmpty())
+			Expect(buildInfoResponse.GoVersion).NotTo(BeEmpty())
+			Expect(buildInfoResponse.FeatureFlags).To(Equal(map[string]bool{"ext_authz": true}))
+			Expect(buildInfoResponse.Capabilities).To(HaveKeyWithValue("embedded_opa", true))
+		})
+
+		It("should report empty feature flags when none are configured", func() {
+			response, err := handler.GetBuildInfo(context.Background(), server.GetBuildInfoRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.(server.GetBuildInfo200JSONResponse).FeatureFlags).To(BeEmpty())
+		})
+
+		It("should report telemetry as disabled without a reporter", func() {
+			response, err := handler.GetBuildInfo(context.Background(), server.GetBuildInfoRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.(server.GetBuildInfo200JSONResponse).Telemetry).To(Equal(server.TelemetryStatus{Enabled: false}))
+		})
+
+		It("should report the telemetry endpoint and interval when enabled", func() {
+			reporter, err := telemetry.New(config.TelemetryConfig{Enabled: true, Endpoint: "https://telemetry.example.com/v1", Interval: time.Hour}, "v1", nil)
+			Expect(err).NotTo(HaveOccurred())
+			handler = NewPolicyHandler(mockService, WithTelemetry(reporter))
+
+			response, err := handler.GetBuildInfo(context.Background(), server.GetBuildInfoRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			status := response.(server.GetBuildInfo200JSONResponse).Telemetry
+			Expect(status.Enabled).To(BeTrue())
+			Expect(*status.Endpoint).To(Equal("https://telemetry.example.com/v1"))
+			Expect(*status.Interval).To(Equal("1h0m0s"))
+			Expect(status.LastReport).To(BeNil())
+		})
 	})
 
 	Describe("CreatePolicy", func() {
@@ -128,6 +591,28 @@ var _ = Describe("PolicyHandler", func() {
 			Expect(*createResponse.Body.Id).To(Equal("test-policy"))
 		})
 
+		It("should return the lint warnings of the created policy", func() {
+			line := int32(2)
+			mockService.CreatePolicyFn = func(_ context.Context, policy v1alpha1.Policy, _ *string) (*v1alpha1.Policy, error) {
+				policy.Id = strPtr("sloppy")
+				policy.Warnings = &[]v1alpha1.PolicyWarning{
+					{Code: v1alpha1.WarningUnusedImport, Message: "import data.lib.helpers unused", Line: &line, Column: &line},
+				}
+				return &policy, nil
+			}
+
+			response, err := handler.CreatePolicy(context.Background(), server.CreatePolicyRequestObject{
+				Body: &server.Policy{DisplayName: strPtr("Sloppy"), RegoCode: strPtr("package sloppy")},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			createResponse, ok := response.(server.CreatePolicy201JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreatePolicy201JSONResponse")
+			Expect(*createResponse.Body.Warnings).To(Equal([]server.PolicyWarning{
+				{Code: server.WarningUnusedImport, Message: "import data.lib.helpers unused", Line: &line, Column: &line},
+			}))
+		})
+
 		It("should return 400 when body is nil", func() {
 			ctx := context.Background()
 
@@ -164,6 +649,122 @@ var _ = Describe("PolicyHandler", func() {
 			_, ok := response.(server.CreatePolicy409JSONResponse)
 			Expect(ok).To(BeTrue(), "response should be CreatePolicy409JSONResponse")
 		})
+
+		Context("with on_conflict=return_existing", func() {
+			var body server.Policy
+
+			BeforeEach(func() {
+				displayName := "Test Policy"
+				regoCodeReq := "package test"
+				pt := server.GLOBAL
+				body = server.Policy{
+					DisplayName: &displayName,
+					PolicyType:  &pt,
+					RegoCode:    &regoCodeReq,
+				}
+			})
+
+			It("should return 200 when an identical policy already exists", func() {
+				ctx := context.Background()
+				policyID := "test-policy"
+
+				mockService.CreateOrGetPolicyFn = func(_ context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error) {
+					Expect(clientID).To(Equal(policyID))
+					policy.Id = &clientID
+					return &policy, false, nil
+				}
+
+				onConflict := server.OnConflictReturnExisting
+				response, err := handler.CreatePolicy(ctx, server.CreatePolicyRequestObject{
+					Params: server.CreatePolicyParams{Id: &policyID, OnConflict: &onConflict},
+					Body:   &body,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				existing, ok := response.(server.CreatePolicy200JSONResponse)
+				Expect(ok).To(BeTrue(), "response should be CreatePolicy200JSONResponse")
+				Expect(*existing.Id).To(Equal(policyID))
+			})
+
+			It("should return 201 when the policy is created", func() {
+				ctx := context.Background()
+				policyID := "test-policy"
+
+				mockService.CreateOrGetPolicyFn = func(_ context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error) {
+					policy.Id = &clientID
+					return &policy, true, nil
+				}
+
+				onConflict := server.OnConflictReturnExisting
+				response, err := handler.CreatePolicy(ctx, server.CreatePolicyRequestObject{
+					Params: server.CreatePolicyParams{Id: &policyID, OnConflict: &onConflict},
+					Body:   &body,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, ok := response.(server.CreatePolicy201JSONResponse)
+				Expect(ok).To(BeTrue(), "response should be CreatePolicy201JSONResponse")
+			})
+
+			It("should return 409 when the existing policy differs", func() {
+				ctx := context.Background()
+				policyID := "test-policy"
+
+				mockService.CreateOrGetPolicyFn = func(context.Context, v1alpha1.Policy, string) (*v1alpha1.Policy, bool, error) {
+					return nil, false, service.NewAlreadyExistsError("Policy already exists", "Different content")
+				}
+
+				onConflict := server.OnConflictReturnExisting
+				response, err := handler.CreatePolicy(ctx, server.CreatePolicyRequestObject{
+					Params: server.CreatePolicyParams{Id: &policyID, OnConflict: &onConflict},
+					Body:   &body,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				_, ok := response.(server.CreatePolicy409JSONResponse)
+				Expect(ok).To(BeTrue(), "response should be CreatePolicy409JSONResponse")
+			})
+
+			It("should create normally when no ID is given", func() {
+				ctx := context.Background()
+				policyID := "generated-id"
+				called := false
+
+				mockService.CreatePolicyFn = func(_ context.Context, policy v1alpha1.Policy, _ *string) (*v1alpha1.Policy, error) {
+					called = true
+					policy.Id = &policyID
+					return &policy, nil
+				}
+
+				onConflict := server.OnConflictReturnExisting
+				response, err := handler.CreatePolicy(ctx, server.CreatePolicyRequestObject{
+					Params: server.CreatePolicyParams{OnConflict: &onConflict},
+					Body:   &body,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(called).To(BeTrue())
+				_, ok := response.(server.CreatePolicy201JSONResponse)
+				Expect(ok).To(BeTrue(), "response should be CreatePolicy201JSONResponse")
+			})
+		})
+
+		It("should return 400 for an invalid on_conflict", func() {
+			ctx := context.Background()
+			displayName := "Test Policy"
+			regoCodeReq := "package test"
+			pt := server.GLOBAL
+			onConflict := server.CreatePolicyParamsOnConflict("ignore")
+
+			response, err := handler.CreatePolicy(ctx, server.CreatePolicyRequestObject{
+				Params: server.CreatePolicyParams{OnConflict: &onConflict},
+				Body:   &server.Policy{DisplayName: &displayName, PolicyType: &pt, RegoCode: &regoCodeReq},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.CreatePolicy400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreatePolicy400JSONResponse")
+		})
 	})
 
 	Describe("GetPolicy", func() {
@@ -192,7 +793,7 @@ var _ = Describe("PolicyHandler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			policy, ok := response.(server.GetPolicy200JSONResponse)
 			Expect(ok).To(BeTrue(), "response should be GetPolicy200JSONResponse")
-			Expect(*policy.Id).To(Equal("test-policy"))
+			Expect(*policy.Body.Id).To(Equal("test-policy"))
 		})
 
 		It("should return 404 when policy not found", func() {
@@ -212,10 +813,106 @@ var _ = Describe("PolicyHandler", func() {
 		})
 	})
 
-	Describe("ListPolicies", func() {
-		It("should return 200 with list of policies", func() {
-			ctx := context.Background()
-			policyID1 := "policy-1"
+	Describe("response caching", func() {
+		var updateTime time.Time
+
+		BeforeEach(func() {
+			policyID := "cached"
+			updateTime = time.Date(2026, 1, 9, 15, 45, 0, 500_000_000, time.UTC)
+			mockService.GetPolicyFn = func(_ context.Context, _ string) (*v1alpha1.Policy, error) {
+				return &v1alpha1.Policy{Id: &policyID, UpdateTime: &updateTime}, nil
+			}
+			mockService.ListPoliciesFn = func(_ context.Context, _ *string, _ *string, _ *string, _ *int32) (*v1alpha1.PolicyList, error) {
+				earlier := updateTime.Add(-time.Hour)
+				return &v1alpha1.PolicyList{Policies: []v1alpha1.Policy{
+					{Id: &policyID, UpdateTime: &earlier},
+					{Id: &policyID, UpdateTime: &updateTime},
+				}}, nil
+			}
+		})
+
+		getPolicy := func(params server.GetPolicyParams) server.GetPolicyResponseObject {
+			response, err := handler.GetPolicy(context.Background(), server.GetPolicyRequestObject{PolicyId: "cached", Params: params})
+			Expect(err).NotTo(HaveOccurred())
+			return response
+		}
+
+		It("should send the ETag, Last-Modified and Cache-Control of a policy", func() {
+			result, ok := getPolicy(server.GetPolicyParams{}).(server.GetPolicy200JSONResponse)
+
+			Expect(ok).To(BeTrue(), "response should be GetPolicy200JSONResponse")
+			Expect(*result.Headers.ETag).To(MatchRegexp(`^"[0-9a-f]{32}"$`))
+			Expect(*result.Headers.LastModified).To(Equal("Fri, 09 Jan 2026 15:45:00 GMT"))
+			Expect(*result.Headers.CacheControl).To(Equal("no-cache"))
+		})
+
+		It("should change the ETag when the policy changes", func() {
+			first := getPolicy(server.GetPolicyParams{}).(server.GetPolicy200JSONResponse)
+			updateTime = updateTime.Add(time.Second)
+			second := getPolicy(server.GetPolicyParams{}).(server.GetPolicy200JSONResponse)
+
+			Expect(*second.Headers.ETag).NotTo(Equal(*first.Headers.ETag))
+		})
+
+		It("should return 304 when If-None-Match matches", func() {
+			etag := *getPolicy(server.GetPolicyParams{}).(server.GetPolicy200JSONResponse).Headers.ETag
+			ifNoneMatch := `"other", W/` + etag
+
+			result, ok := getPolicy(server.GetPolicyParams{IfNoneMatch: &ifNoneMatch}).(server.GetPolicy304Response)
+
+			Expect(ok).To(BeTrue(), "response should be GetPolicy304Response")
+			Expect(*result.Headers.ETag).To(Equal(etag))
+			Expect(*result.Headers.CacheControl).To(Equal("no-cache"))
+		})
+
+		It("should ignore If-Modified-Since when If-None-Match does not match", func() {
+			ifNoneMatch := `"other"`
+			ifModifiedSince := "Fri, 09 Jan 2026 15:45:00 GMT"
+
+			_, ok := getPolicy(server.GetPolicyParams{IfNoneMatch: &ifNoneMatch, IfModifiedSince: &ifModifiedSince}).(server.GetPolicy200JSONResponse)
+
+			Expect(ok).To(BeTrue(), "response should be GetPolicy200JSONResponse")
+		})
+
+		DescribeTable("should honour If-Modified-Since without If-None-Match",
+			func(ifModifiedSince string, expectNotModified bool) {
+				_, ok := getPolicy(server.GetPolicyParams{IfModifiedSince: &ifModifiedSince}).(server.GetPolicy304Response)
+				Expect(ok).To(Equal(expectNotModified))
+			},
+			Entry("at the update time", "Fri, 09 Jan 2026 15:45:00 GMT", true),
+			Entry("after the update time", "Fri, 09 Jan 2026 16:00:00 GMT", true),
+			Entry("before the update time", "Fri, 09 Jan 2026 15:44:59 GMT", false),
+			Entry("unparseable", "yesterday", false),
+		)
+
+		It("should use the configured max age", func() {
+			handler = NewPolicyHandler(mockService, WithCacheMaxAge(90*time.Second))
+
+			result := getPolicy(server.GetPolicyParams{}).(server.GetPolicy200JSONResponse)
+
+			Expect(*result.Headers.CacheControl).To(Equal("max-age=90"))
+		})
+
+		It("should validate lists by ETag only and report the latest update time", func() {
+			response, err := handler.ListPolicies(context.Background(), server.ListPoliciesRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.ListPolicies200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListPolicies200JSONResponse")
+			Expect(*result.Headers.LastModified).To(Equal("Fri, 09 Jan 2026 15:45:00 GMT"))
+
+			response, err = handler.ListPolicies(context.Background(), server.ListPoliciesRequestObject{
+				Params: server.ListPoliciesParams{IfNoneMatch: result.Headers.ETag},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, ok = response.(server.ListPolicies304Response)
+			Expect(ok).To(BeTrue(), "response should be ListPolicies304Response")
+		})
+	})
+
+	Describe("ListPolicies", func() {
+		It("should return 200 with list of policies", func() {
+			ctx := context.Background()
+			policyID1 := "policy-1"
 			policyID2 := "policy-2"
 			path1 := "policies/policy-1"
 			path2 := "policies/policy-2"
@@ -254,9 +951,9 @@ var _ = Describe("PolicyHandler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			listResponse, ok := response.(server.ListPolicies200JSONResponse)
 			Expect(ok).To(BeTrue(), "response should be ListPolicies200JSONResponse")
-			Expect(listResponse.Policies).To(HaveLen(2))
-			Expect(*listResponse.Policies[0].Id).To(Equal("policy-1"))
-			Expect(*listResponse.Policies[1].Id).To(Equal("policy-2"))
+			Expect(listResponse.Body.Policies).To(HaveLen(2))
+			Expect(*listResponse.Body.Policies[0].Id).To(Equal("policy-1"))
+			Expect(*listResponse.Body.Policies[1].Id).To(Equal("policy-2"))
 		})
 
 		It("should pass filter parameter to service", func() {
@@ -414,6 +1111,98 @@ var _ = Describe("PolicyHandler", func() {
 		})
 	})
 
+	Describe("ApplyPolicy", func() {
+		var body server.Policy
+
+		BeforeEach(func() {
+			displayName := "Applied Policy"
+			regoCode := "package applied"
+			pt := server.GLOBAL
+			body = server.Policy{DisplayName: &displayName, PolicyType: &pt, RegoCode: &regoCode}
+		})
+
+		applied := func(id string, policy v1alpha1.Policy) *v1alpha1.Policy {
+			policy.Id = &id
+			return &policy
+		}
+
+		It("should return 200 when the policy was replaced", func() {
+			var gotAllowMissing bool
+			mockService.ApplyPolicyFn = func(_ context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error) {
+				gotAllowMissing = allowMissing
+				return applied(id, policy), false, nil
+			}
+
+			response, err := handler.ApplyPolicy(context.Background(), server.ApplyPolicyRequestObject{
+				PolicyId: "test-policy",
+				Body:     &body,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			applyResponse, ok := response.(server.ApplyPolicy200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ApplyPolicy200JSONResponse")
+			Expect(*applyResponse.Id).To(Equal("test-policy"))
+			Expect(*applyResponse.DisplayName).To(Equal("Applied Policy"))
+			Expect(gotAllowMissing).To(BeFalse())
+		})
+
+		It("should return 201 when allow_missing created the policy", func() {
+			mockService.ApplyPolicyFn = func(_ context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error) {
+				Expect(allowMissing).To(BeTrue())
+				return applied(id, policy), true, nil
+			}
+			allowMissing := true
+
+			response, err := handler.ApplyPolicy(context.Background(), server.ApplyPolicyRequestObject{
+				PolicyId: "test-policy",
+				Params:   server.ApplyPolicyParams{AllowMissing: &allowMissing},
+				Body:     &body,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ApplyPolicy201JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ApplyPolicy201JSONResponse")
+		})
+
+		It("should return 400 when body is nil", func() {
+			response, err := handler.ApplyPolicy(context.Background(), server.ApplyPolicyRequestObject{PolicyId: "test-policy"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ApplyPolicy400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ApplyPolicy400JSONResponse")
+		})
+
+		It("should return 404 when the policy is missing", func() {
+			mockService.ApplyPolicyFn = func(_ context.Context, id string, _ v1alpha1.Policy, _ bool) (*v1alpha1.Policy, bool, error) {
+				return nil, false, service.NewPolicyNotFoundError(id)
+			}
+
+			response, err := handler.ApplyPolicy(context.Background(), server.ApplyPolicyRequestObject{
+				PolicyId: "test-policy",
+				Body:     &body,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ApplyPolicy404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ApplyPolicy404JSONResponse")
+		})
+
+		It("should return 409 on a uniqueness conflict", func() {
+			mockService.ApplyPolicyFn = func(context.Context, string, v1alpha1.Policy, bool) (*v1alpha1.Policy, bool, error) {
+				return nil, false, service.NewPolicyPriorityPolicyTypeTakenError(500, v1alpha1.GLOBAL)
+			}
+
+			response, err := handler.ApplyPolicy(context.Background(), server.ApplyPolicyRequestObject{
+				PolicyId: "test-policy",
+				Body:     &body,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ApplyPolicy409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ApplyPolicy409JSONResponse")
+		})
+	})
+
 	Describe("DeletePolicy", func() {
 		It("should return 204 on successful deletion", func() {
 			ctx := context.Background()
@@ -447,4 +1236,1147 @@ var _ = Describe("PolicyHandler", func() {
 			Expect(ok).To(BeTrue(), "response should be DeletePolicy404JSONResponse")
 		})
 	})
+
+	Describe("dry_run", func() {
+		dryRun := true
+
+		BeforeEach(func() {
+			mockService.CreatePolicyFn = func(_ context.Context, _ v1alpha1.Policy, _ *string) (*v1alpha1.Policy, error) {
+				Fail("dry runs must not create the policy")
+				return nil, nil
+			}
+			mockService.UpdatePolicyFn = func(_ context.Context, _ string, _ *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+				Fail("dry runs must not update the policy")
+				return nil, nil
+			}
+			mockService.DeletePolicyFn = func(_ context.Context, _ string) error {
+				Fail("dry runs must not delete the policy")
+				return nil
+			}
+		})
+
+		It("returns 200 with the policy that would be created", func() {
+			mockService.DryRunCreatePolicyFn = func(_ context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error) {
+				policy.Id = clientID
+				return &policy, nil
+			}
+			displayName := "Test"
+			clientID := "test-policy"
+
+			response, err := handler.CreatePolicy(context.Background(), server.CreatePolicyRequestObject{
+				Params: server.CreatePolicyParams{Id: &clientID, DryRun: &dryRun},
+				Body:   &server.Policy{DisplayName: &displayName},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			created, ok := response.(server.CreatePolicy200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreatePolicy200JSONResponse")
+			Expect(created.Id).To(HaveValue(Equal("test-policy")))
+		})
+
+		It("maps dry run update errors like update errors", func() {
+			mockService.DryRunUpdatePolicyFn = func(_ context.Context, _ string, _ *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+				return nil, service.NewAlreadyExistsError("Policy already exists", "Taken")
+			}
+
+			response, err := handler.UpdatePolicy(context.Background(), server.UpdatePolicyRequestObject{
+				PolicyId: "test-policy",
+				Params:   server.UpdatePolicyParams{DryRun: &dryRun},
+				Body:     &server.UpdatePolicyApplicationMergePatchPlusJSONRequestBody{},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.UpdatePolicy409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be UpdatePolicy409JSONResponse")
+		})
+
+		It("returns 204 when the policy would be deleted", func() {
+			var checked string
+			mockService.DryRunDeletePolicyFn = func(_ context.Context, id string) error {
+				checked = id
+				return nil
+			}
+
+			response, err := handler.DeletePolicy(context.Background(), server.DeletePolicyRequestObject{
+				PolicyId: "test-policy",
+				Params:   server.DeletePolicyParams{DryRun: &dryRun},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.DeletePolicy204Response)
+			Expect(ok).To(BeTrue(), "response should be DeletePolicy204Response")
+			Expect(checked).To(Equal("test-policy"))
+		})
+	})
+
+	Describe("ImportPolicyBundle", func() {
+		It("should pass query parameters to the service and return per-file results", func() {
+			ctx := context.Background()
+			format := server.CONFIGMAP
+			convention := server.SIDECAR
+			policyType := server.ImportPolicyTypeUser
+			prefix := "team-"
+
+			var capturedOpts service.BundleImportOptions
+			mockService.ImportBundleFn = func(_ context.Context, _ io.Reader, opts service.BundleImportOptions) (*v1alpha1.BundleImportResult, error) {
+				capturedOpts = opts
+				policyID := "team-authz-region"
+				return &v1alpha1.BundleImportResult{
+					Results: []v1alpha1.BundleImportItem{
+						{FilePath: "authz/region.rego", PolicyId: &policyID, Status: v1alpha1.CREATED},
+					},
+				}, nil
+			}
+
+			response, err := handler.ImportPolicyBundle(ctx, server.ImportPolicyBundleRequestObject{
+				Params: server.ImportPolicyBundleParams{
+					Format:             &format,
+					MetadataConvention: &convention,
+					PolicyType:         &policyType,
+					IdPrefix:           &prefix,
+				},
+				Body: strings.NewReader("kind: ConfigMap"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capturedOpts).To(Equal(service.BundleImportOptions{
+				Format:             service.BundleFormatConfigMap,
+				MetadataConvention: service.MetadataConventionSidecar,
+				DefaultPolicyType:  v1alpha1.USER,
+				IDPrefix:           "team-",
+			}))
+			result, ok := response.(server.ImportPolicyBundle200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ImportPolicyBundle200JSONResponse")
+			Expect(result.Results).To(HaveLen(1))
+			Expect(result.Results[0].Status).To(Equal(server.CREATED))
+			Expect(*result.Results[0].PolicyId).To(Equal("team-authz-region"))
+		})
+
+		It("should return 400 when the archive is invalid", func() {
+			ctx := context.Background()
+
+			mockService.ImportBundleFn = func(_ context.Context, _ io.Reader, _ service.BundleImportOptions) (*v1alpha1.BundleImportResult, error) {
+				return nil, service.NewInvalidArgumentError("Invalid bundle", "bundle is not a gzip archive")
+			}
+
+			response, err := handler.ImportPolicyBundle(ctx, server.ImportPolicyBundleRequestObject{
+				Body: strings.NewReader("not a bundle"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ImportPolicyBundle400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ImportPolicyBundle400JSONResponse")
+		})
+	})
+
+	Describe("ImportPolicies", func() {
+		var received v1alpha1.PolicyImportRequest
+		var receivedAtomic bool
+
+		BeforeEach(func() {
+			mockService.ImportPoliciesFn = func(_ context.Context, request v1alpha1.PolicyImportRequest, atomic bool) (*v1alpha1.PolicyImportResult, error) {
+				received, receivedAtomic = request, atomic
+				return &v1alpha1.PolicyImportResult{Results: []v1alpha1.PolicyImportItem{
+					{Index: 0, PolicyId: strPtr("region"), Status: v1alpha1.PolicyImportCreated},
+				}}, nil
+			}
+		})
+
+		It("should pass the entries of a JSON body and return the results", func() {
+			atomic := true
+			response, err := handler.ImportPolicies(context.Background(), server.ImportPoliciesRequestObject{
+				Params: server.ImportPoliciesParams{Atomic: &atomic},
+				JSONBody: &server.ImportPoliciesJSONRequestBody{Policies: []server.PolicyImportEntry{
+					{PolicyId: strPtr("region"), Policy: server.Policy{DisplayName: strPtr("Region"), RegoCode: strPtr("package region")}},
+				}},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.ImportPolicies200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ImportPolicies200JSONResponse")
+			Expect(result.Results).To(HaveLen(1))
+			Expect(result.Results[0].Status).To(Equal(server.PolicyImportCreated))
+			Expect(receivedAtomic).To(BeTrue())
+			Expect(received.Policies).To(HaveLen(1))
+			Expect(*received.Policies[0].PolicyId).To(Equal("region"))
+			Expect(*received.Policies[0].Policy.RegoCode).To(Equal("package region"))
+		})
+
+		It("should read the Rego files of a multipart body", func() {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			Expect(writer.WriteField("manifest", `{"policies": [{"rego_file": "region.rego", "policy": {"display_name": "Region"}}]}`)).To(Succeed())
+			part, err := writer.CreateFormFile("files", "region.rego")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = io.WriteString(part, "package region")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(writer.Close()).To(Succeed())
+
+			response, err := handler.ImportPolicies(context.Background(), server.ImportPoliciesRequestObject{
+				MultipartBody: multipart.NewReader(body, writer.Boundary()),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ImportPolicies200JSONResponse{}))
+			Expect(receivedAtomic).To(BeFalse())
+			Expect(*received.Policies[0].Policy.RegoCode).To(Equal("package region"))
+		})
+
+		It("should return 400 when the multipart body has no manifest", func() {
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			Expect(writer.Close()).To(Succeed())
+
+			response, err := handler.ImportPolicies(context.Background(), server.ImportPoliciesRequestObject{
+				MultipartBody: multipart.NewReader(body, writer.Boundary()),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(server.ImportPolicies400JSONResponse{}))
+		})
+	})
+
+	Describe("ExportPolicyBundle", func() {
+		export := func(params server.ExportPolicyBundleParams) *httptest.ResponseRecorder {
+			response, err := handler.ExportPolicyBundle(context.Background(), server.ExportPolicyBundleRequestObject{Params: params})
+			Expect(err).NotTo(HaveOccurred())
+			recorder := httptest.NewRecorder()
+			Expect(response.VisitExportPolicyBundleResponse(recorder)).To(Succeed())
+			return recorder
+		}
+
+		It("should stream the archive, flushing it after every policy", func() {
+			policyType := server.ExportPolicyTypeUser
+			var capturedOpts service.BundleExportOptions
+			mockService.ExportBundleFn = func(_ context.Context, w io.Writer, opts service.BundleExportOptions, flush func() error) (int, error) {
+				capturedOpts = opts
+				if _, err := io.WriteString(w, "archive"); err != nil {
+					return 0, err
+				}
+				return 1, flush()
+			}
+
+			recorder := export(server.ExportPolicyBundleParams{PolicyType: &policyType, StartAfter: strPtr("eu-only")})
+
+			Expect(capturedOpts).To(Equal(service.BundleExportOptions{PolicyType: v1alpha1.USER, StartAfter: "eu-only"}))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/gzip"))
+			Expect(recorder.Flushed).To(BeTrue())
+			Expect(recorder.Body.String()).To(Equal("archive"))
+		})
+
+		It("should return an error response when the export fails before it wrote anything", func() {
+			mockService.ExportBundleFn = func(context.Context, io.Writer, service.BundleExportOptions, func() error) (int, error) {
+				return 0, service.NewInvalidArgumentError("Invalid policy type", "policy_type must be GLOBAL or USER")
+			}
+
+			recorder := export(server.ExportPolicyBundleParams{})
+
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+		})
+
+		It("should abort the response when the export fails after it started", func() {
+			mockService.ExportBundleFn = func(_ context.Context, w io.Writer, _ service.BundleExportOptions, _ func() error) (int, error) {
+				_, _ = io.WriteString(w, "partial")
+				return 1, service.NewInternalError("Failed to list policies", "database is closed", nil)
+			}
+			response, err := handler.ExportPolicyBundle(context.Background(), server.ExportPolicyBundleRequestObject{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(func() { _ = response.VisitExportPolicyBundleResponse(httptest.NewRecorder()) }).To(PanicWith(http.ErrAbortHandler))
+		})
+	})
+
+	Describe("ConvertGatekeeper", func() {
+		It("should return converted policies and the report", func() {
+			ctx := context.Background()
+
+			mockService.ConvertGatekeeperFn = func(_ context.Context, _ io.Reader) (*v1alpha1.GatekeeperConversionResult, error) {
+				return &v1alpha1.GatekeeperConversionResult{
+					Policies: []v1alpha1.Policy{{Id: strPtr("k8srequiredlabels-owner"), DisplayName: strPtr("K8sRequiredLabels/owner")}},
+					Report: []v1alpha1.GatekeeperConversionIssue{
+						{Source: "K8sRequiredLabels/owner", Severity: v1alpha1.WARNING, Message: "match.kinds has no equivalent; ignored"},
+					},
+				}, nil
+			}
+
+			response, err := handler.ConvertGatekeeper(ctx, server.ConvertGatekeeperRequestObject{
+				Body: strings.NewReader("kind: ConstraintTemplate"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.ConvertGatekeeper200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ConvertGatekeeper200JSONResponse")
+			Expect(result.Policies).To(HaveLen(1))
+			Expect(*result.Policies[0].Id).To(Equal("k8srequiredlabels-owner"))
+			Expect(result.Report).To(HaveLen(1))
+			Expect(result.Report[0].Severity).To(Equal(server.WARNING))
+		})
+
+		It("should return 400 when the resources are invalid", func() {
+			ctx := context.Background()
+
+			mockService.ConvertGatekeeperFn = func(_ context.Context, _ io.Reader) (*v1alpha1.GatekeeperConversionResult, error) {
+				return nil, service.NewInvalidArgumentError("Invalid Gatekeeper resources", "no ConstraintTemplates or Constraints found")
+			}
+
+			response, err := handler.ConvertGatekeeper(ctx, server.ConvertGatekeeperRequestObject{
+				Body: strings.NewReader("kind: ConfigMap"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ConvertGatekeeper400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ConvertGatekeeper400JSONResponse")
+		})
+	})
+
+	Describe("GetPolicyFacets", func() {
+		It("should return the facets", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyFacetsFn = func(context.Context) (*v1alpha1.PolicyFacets, error) {
+				return &v1alpha1.PolicyFacets{
+					PolicyTypes: []v1alpha1.FacetValue{{Value: "GLOBAL", Count: 2}},
+					LabelSelectorKeys: []v1alpha1.LabelSelectorKeyFacet{
+						{Key: "env", Count: 2, Values: []v1alpha1.FacetValue{{Value: "dev", Count: 1}, {Value: "prod", Count: 1}}},
+					},
+					PriorityHistogram: []v1alpha1.PriorityBucket{{Min: 1, Max: 100, Count: 2}},
+				}, nil
+			}
+
+			response, err := handler.GetPolicyFacets(ctx, server.GetPolicyFacetsRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			facets, ok := response.(server.GetPolicyFacets200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyFacets200JSONResponse")
+			Expect(facets.PolicyTypes).To(Equal([]server.FacetValue{{Value: "GLOBAL", Count: 2}}))
+			Expect(facets.LabelSelectorKeys).To(HaveLen(1))
+			Expect(facets.LabelSelectorKeys[0].Values).To(HaveLen(2))
+			Expect(facets.PriorityHistogram).To(Equal([]server.PriorityBucket{{Min: 1, Max: 100, Count: 2}}))
+		})
+
+		It("should return 500 when the store fails", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyFacetsFn = func(context.Context) (*v1alpha1.PolicyFacets, error) {
+				return nil, service.NewInternalError("Failed to get policy facets", "database unavailable", nil)
+			}
+
+			response, err := handler.GetPolicyFacets(ctx, server.GetPolicyFacetsRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.GetPolicyFacets500JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyFacets500JSONResponse")
+		})
+	})
+
+	Describe("GetPolicyChecksum", func() {
+		It("should return the checksum", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyChecksumFn = func(context.Context) (*v1alpha1.PolicyChecksum, error) {
+				return &v1alpha1.PolicyChecksum{Checksum: "sha256:abc", PolicyCount: 2}, nil
+			}
+
+			response, err := handler.GetPolicyChecksum(ctx, server.GetPolicyChecksumRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			checksum, ok := response.(server.GetPolicyChecksum200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyChecksum200JSONResponse")
+			Expect(checksum.Checksum).To(Equal("sha256:abc"))
+			Expect(checksum.PolicyCount).To(Equal(int32(2)))
+		})
+
+		It("should return 500 when the store fails", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyChecksumFn = func(context.Context) (*v1alpha1.PolicyChecksum, error) {
+				return nil, service.NewInternalError("Failed to list policies", "database unavailable", nil)
+			}
+
+			response, err := handler.GetPolicyChecksum(ctx, server.GetPolicyChecksumRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.GetPolicyChecksum500JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyChecksum500JSONResponse")
+		})
+	})
+
+	Describe("WatchPolicies", func() {
+		var (
+			bus     *events.Bus
+			watcher *service.PolicyWatcher
+		)
+
+		BeforeEach(func() {
+			bus = events.NewBus()
+			watcher = service.NewPolicyWatcher(bus, service.DefaultWatchHistory)
+			handler = NewPolicyHandler(mockService, WithPolicyWatcher(watcher))
+		})
+
+		// watch opens a stream and returns a reader of its lines, after the opening comment
+		watch := func(ctx context.Context, params server.WatchPoliciesParams) *bufio.Reader {
+			response, err := handler.WatchPolicies(ctx, server.WatchPoliciesRequestObject{Params: params})
+			Expect(err).NotTo(HaveOccurred())
+			stream, ok := response.(server.WatchPolicies200TexteventStreamResponse)
+			Expect(ok).To(BeTrue(), "response should be WatchPolicies200TexteventStreamResponse")
+			Expect(*stream.Headers.CacheControl).To(Equal("no-cache"))
+			lines := bufio.NewReader(stream.Body)
+			Expect(lines.ReadString('\n')).To(Equal(": watching policies\n"))
+			Expect(lines.ReadString('\n')).To(Equal("\n"))
+			return lines
+		}
+
+		// nextEvent reads the next server-sent event of a stream
+		nextEvent := func(lines *bufio.Reader) (id, eventType string, data server.PolicyWatchEvent) {
+			for {
+				line, err := lines.ReadString('\n')
+				Expect(err).NotTo(HaveOccurred())
+				switch line = strings.TrimSuffix(line, "\n"); {
+				case line == "":
+					return id, eventType, data
+				case strings.HasPrefix(line, "id: "):
+					id = strings.TrimPrefix(line, "id: ")
+				case strings.HasPrefix(line, "event: "):
+					eventType = strings.TrimPrefix(line, "event: ")
+				case strings.HasPrefix(line, "data: "):
+					Expect(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &data)).To(Succeed())
+				}
+			}
+		}
+
+		It("should stream policy changes with their resource versions", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			lines := watch(ctx, server.WatchPoliciesParams{})
+
+			bus.Publish(ctx, events.PolicyCreated{Policy: v1alpha1.Policy{Id: strPtr("p1"), DisplayName: strPtr("P1")}})
+			bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+
+			id, eventType, data := nextEvent(lines)
+			Expect(eventType).To(Equal("CREATED"))
+			Expect(data.ResourceVersion).To(Equal(id))
+			Expect(*data.PolicyId).To(Equal("p1"))
+			Expect(*data.Policy.DisplayName).To(Equal("P1"))
+
+			deletedID, eventType, data := nextEvent(lines)
+			Expect(eventType).To(Equal("DELETED"))
+			Expect(deletedID).NotTo(Equal(id))
+			Expect(*data.PolicyId).To(Equal("p1"))
+			Expect(data.Policy).To(BeNil())
+
+			cancel()
+			_, err := io.ReadAll(lines)
+			Expect(err).NotTo(HaveOccurred(), "the stream ends cleanly when the request is cancelled")
+		})
+
+		It("should replay the changes after the Last-Event-ID", func() {
+			ctx := context.Background()
+			first := watcher.Watch("")
+			bus.Publish(ctx, events.PolicyCreated{Policy: v1alpha1.Policy{Id: strPtr("p1")}})
+			bus.Publish(ctx, events.PolicyUpdated{Policy: v1alpha1.Policy{Id: strPtr("p1")}})
+			received := <-first.Changes
+			first.Stop()
+
+			lines := watch(ctx, server.WatchPoliciesParams{LastEventID: &received.ResourceVersion})
+
+			_, eventType, _ := nextEvent(lines)
+			Expect(eventType).To(Equal("UPDATED"))
+		})
+
+		It("should start with a resync when the resource version cannot be resumed", func() {
+			lines := watch(context.Background(), server.WatchPoliciesParams{ResourceVersion: strPtr("0.1")})
+
+			_, eventType, data := nextEvent(lines)
+			Expect(eventType).To(Equal("RESYNC"))
+			Expect(data.PolicyId).To(BeNil())
+		})
+
+		It("should end streams when the watcher is closed", func() {
+			lines := watch(context.Background(), server.WatchPoliciesParams{})
+
+			watcher.Close()
+
+			_, err := io.ReadAll(lines)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return 500 without a policy watcher", func() {
+			handler = NewPolicyHandler(mockService)
+
+			response, err := handler.WatchPolicies(context.Background(), server.WatchPoliciesRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.WatchPolicies500JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be WatchPolicies500JSONResponse")
+		})
+	})
+
+	Describe("GetEvaluationOrder", func() {
+		It("should pass the labels and return the order", func() {
+			ctx := context.Background()
+
+			var receivedLabels []string
+			mockService.GetEvaluationOrderFn = func(_ context.Context, labels []string) (*v1alpha1.EvaluationOrder, error) {
+				receivedLabels = labels
+				return &v1alpha1.EvaluationOrder{
+					RequestLabels: map[string]string{"env": "prod"},
+					Policies: []v1alpha1.EvaluationOrderEntry{
+						{Position: 1, Id: "first", DisplayName: "First", PolicyType: v1alpha1.EvaluationOrderGlobal, Priority: 10},
+						{Position: 2, Id: "second", DisplayName: "Second", PolicyType: v1alpha1.EvaluationOrderUser, Priority: 5},
+					},
+					PoliciesSkipped: 3,
+				}, nil
+			}
+
+			labels := []string{"env=prod"}
+			response, err := handler.GetEvaluationOrder(ctx, server.GetEvaluationOrderRequestObject{
+				Params: server.GetEvaluationOrderParams{Labels: &labels},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			order, ok := response.(server.GetEvaluationOrder200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetEvaluationOrder200JSONResponse")
+			Expect(order.Policies).To(HaveLen(2))
+			Expect(order.Policies[1].Id).To(Equal("second"))
+			Expect(order.Policies[1].PolicyType).To(Equal(server.EvaluationOrderUser))
+			Expect(order.PoliciesSkipped).To(Equal(int32(3)))
+			Expect(receivedLabels).To(Equal(labels))
+		})
+
+		It("should return 400 for an invalid label", func() {
+			ctx := context.Background()
+
+			mockService.GetEvaluationOrderFn = func(context.Context, []string) (*v1alpha1.EvaluationOrder, error) {
+				return nil, service.NewInvalidArgumentError("Invalid label", "labels must have the form key=value (got 'env')")
+			}
+
+			response, err := handler.GetEvaluationOrder(ctx, server.GetEvaluationOrderRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.GetEvaluationOrder400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetEvaluationOrder400JSONResponse")
+		})
+	})
+
+	Describe("GetPolicyCatalog", func() {
+		var catalog *v1alpha1.PolicyCatalog
+
+		BeforeEach(func() {
+			catalog = &v1alpha1.PolicyCatalog{
+				GenerateTime: time.Date(2026, 1, 9, 15, 45, 0, 0, time.UTC),
+				Policies: []v1alpha1.PolicyCatalogEntry{{
+					Id:            "region",
+					DisplayName:   "Region Enforcement",
+					PolicyType:    v1alpha1.CatalogGlobal,
+					Priority:      100,
+					Documentation: &v1alpha1.PolicyDocumentation{OwnerContact: strPtr("platform-team@example.com")},
+				}},
+			}
+			mockService.GetPolicyCatalogFn = func(context.Context) (*v1alpha1.PolicyCatalog, error) {
+				return catalog, nil
+			}
+		})
+
+		It("should return the catalog as JSON by default", func() {
+			response, err := handler.GetPolicyCatalog(context.Background(), server.GetPolicyCatalogRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.GetPolicyCatalog200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyCatalog200JSONResponse")
+			Expect(result.Policies).To(HaveLen(1))
+			Expect(result.Policies[0].PolicyType).To(Equal(server.CatalogGlobal))
+			Expect(*result.Policies[0].Documentation.OwnerContact).To(Equal("platform-team@example.com"))
+		})
+
+		It("should return the catalog as markdown", func() {
+			format := server.CatalogFormatMarkdown
+			response, err := handler.GetPolicyCatalog(context.Background(), server.GetPolicyCatalogRequestObject{
+				Params: server.GetPolicyCatalogParams{Format: &format},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.GetPolicyCatalog200TextmarkdownResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyCatalog200TextmarkdownResponse")
+			body, err := io.ReadAll(result.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(ContainSubstring("## Region Enforcement"))
+			Expect(result.ContentLength).To(Equal(int64(len(body))))
+		})
+
+		It("should return 400 for an unknown format", func() {
+			format := server.GetPolicyCatalogParamsFormat("HTML")
+			response, err := handler.GetPolicyCatalog(context.Background(), server.GetPolicyCatalogRequestObject{
+				Params: server.GetPolicyCatalogParams{Format: &format},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.GetPolicyCatalog400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyCatalog400JSONResponse")
+		})
+	})
+
+	Describe("TestPolicyMatch", func() {
+		It("should pass the labels and return the result", func() {
+			ctx := context.Background()
+
+			var received v1alpha1.PolicyMatchTestRequest
+			mockService.TestPolicyMatchFn = func(_ context.Context, id string, req v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error) {
+				Expect(id).To(Equal("region-policy"))
+				received = req
+				return &v1alpha1.PolicyMatchTestResult{
+					Matches:       false,
+					Enabled:       true,
+					LabelSelector: map[string]string{"env": "prod"},
+					FailedTerms: []v1alpha1.SelectorTermFailure{
+						{Key: "env", Expected: "prod", Actual: strPtr("dev"), Reason: v1alpha1.SelectorTermMismatch},
+					},
+				}, nil
+			}
+
+			labels := map[string]string{"env": "dev"}
+			response, err := handler.TestPolicyMatch(ctx, server.TestPolicyMatchRequestObject{
+				PolicyId: "region-policy",
+				Body:     &server.PolicyMatchTestRequest{Labels: &labels},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.TestPolicyMatch200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be TestPolicyMatch200JSONResponse")
+			Expect(result.Matches).To(BeFalse())
+			Expect(result.FailedTerms).To(HaveLen(1))
+			Expect(result.FailedTerms[0].Reason).To(Equal(server.SelectorTermMismatch))
+			Expect(*result.FailedTerms[0].Actual).To(Equal("dev"))
+			Expect(received.Labels).To(Equal(&labels))
+		})
+
+		It("should return 400 when body is nil", func() {
+			ctx := context.Background()
+
+			response, err := handler.TestPolicyMatch(ctx, server.TestPolicyMatchRequestObject{PolicyId: "region-policy"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.TestPolicyMatch400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be TestPolicyMatch400JSONResponse")
+		})
+
+		It("should return 404 when the policy does not exist", func() {
+			ctx := context.Background()
+
+			mockService.TestPolicyMatchFn = func(_ context.Context, id string, _ v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error) {
+				return nil, service.NewPolicyNotFoundError(id)
+			}
+
+			response, err := handler.TestPolicyMatch(ctx, server.TestPolicyMatchRequestObject{
+				PolicyId: "missing",
+				Body:     &server.PolicyMatchTestRequest{},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.TestPolicyMatch404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be TestPolicyMatch404JSONResponse")
+		})
+	})
+
+	Describe("SimulatePolicy", func() {
+		It("should pass the draft and the spec and return the simulation", func() {
+			ctx := context.Background()
+
+			var received v1alpha1.PolicySimulationRequest
+			mockService.SimulatePolicyFn = func(_ context.Context, req v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error) {
+				received = req
+				return &v1alpha1.PolicySimulation{
+					PolicyId:                 "draft",
+					Status:                   v1alpha1.SimulationModified,
+					EvaluatedServiceInstance: &v1alpha1.SimulatedServiceInstance{Spec: map[string]any{"service_type": "vm", "size": "small"}},
+					PoliciesEvaluated:        []string{"draft"},
+					Draft: v1alpha1.DraftPolicyResult{
+						Outcome: v1alpha1.DraftApplied,
+						Patch:   &map[string]any{"size": "small"},
+					},
+				}, nil
+			}
+
+			policyType := server.GLOBAL
+			response, err := handler.SimulatePolicy(ctx, server.SimulatePolicyRequestObject{
+				Body: &server.PolicySimulationRequest{
+					PolicyId:        strPtr("draft"),
+					Policy:          server.Policy{DisplayName: strPtr("Draft"), PolicyType: &policyType, RegoCode: strPtr("package draft")},
+					ServiceInstance: server.SimulatedServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.SimulatePolicy200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be SimulatePolicy200JSONResponse")
+			Expect(result.Status).To(Equal(server.SimulationModified))
+			Expect(result.EvaluatedServiceInstance.Spec).To(HaveKeyWithValue("size", "small"))
+			Expect(result.Draft.Outcome).To(Equal(server.DraftApplied))
+			Expect(*received.PolicyId).To(Equal("draft"))
+			Expect(*received.Policy.PolicyType).To(Equal(v1alpha1.GLOBAL))
+			Expect(received.ServiceInstance.Spec).To(Equal(map[string]any{"service_type": "vm"}))
+		})
+
+		It("should return 400 when body is nil", func() {
+			response, err := handler.SimulatePolicy(context.Background(), server.SimulatePolicyRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.SimulatePolicy400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be SimulatePolicy400JSONResponse")
+		})
+
+		It("should return 409 when the draft's priority is taken", func() {
+			mockService.SimulatePolicyFn = func(_ context.Context, _ v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error) {
+				return nil, service.NewPolicyPriorityPolicyTypeTakenError(100, v1alpha1.GLOBAL)
+			}
+
+			response, err := handler.SimulatePolicy(context.Background(), server.SimulatePolicyRequestObject{
+				Body: &server.PolicySimulationRequest{},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.SimulatePolicy409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be SimulatePolicy409JSONResponse")
+		})
+	})
+
+	Describe("CheckPolicyConflicts", func() {
+		It("should pass the candidate and samples and return the report", func() {
+			ctx := context.Background()
+
+			var received v1alpha1.PolicyConflictCheckRequest
+			mockService.CheckPolicyConflictsFn = func(_ context.Context, req v1alpha1.PolicyConflictCheckRequest) (*v1alpha1.PolicyConflictReport, error) {
+				received = req
+				sampleIndex := int32(0)
+				return &v1alpha1.PolicyConflictReport{
+					PolicyId: "candidate",
+					Conflicts: []v1alpha1.PolicyConflict{
+						{Kind: v1alpha1.ConflictPriority, PolicyId: strPtr("region"), Detail: "taken"},
+						{Kind: v1alpha1.ConflictSample, SampleIndex: &sampleIndex, Detail: "violates constraints"},
+					},
+					SamplesChecked: 1,
+				}, nil
+			}
+
+			samples := []server.SimulatedServiceInstance{{Spec: map[string]any{"service_type": "vm"}}}
+			response, err := handler.CheckPolicyConflicts(ctx, server.CheckPolicyConflictsRequestObject{
+				Body: &server.PolicyConflictCheckRequest{
+					Policy:  server.Policy{DisplayName: strPtr("Candidate"), RegoCode: strPtr("package candidate")},
+					Samples: &samples,
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.CheckPolicyConflicts200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CheckPolicyConflicts200JSONResponse")
+			Expect(result.Conflicts).To(HaveLen(2))
+			Expect(result.Conflicts[0].Kind).To(Equal(server.ConflictPriority))
+			Expect(*result.Conflicts[1].SampleIndex).To(Equal(int32(0)))
+			Expect(result.SamplesChecked).To(Equal(int32(1)))
+			Expect(*received.Policy.DisplayName).To(Equal("Candidate"))
+			Expect(*received.Samples).To(HaveLen(1))
+		})
+
+		It("should return 400 when body is nil", func() {
+			response, err := handler.CheckPolicyConflicts(context.Background(), server.CheckPolicyConflictsRequestObject{})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.CheckPolicyConflicts400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CheckPolicyConflicts400JSONResponse")
+		})
+	})
+
+	Describe("CreatePolicyTest", func() {
+		It("should pass the policy and test IDs and return 201", func() {
+			ctx := context.Background()
+
+			mockService.CreatePolicyTestFn = func(_ context.Context, id string, test v1alpha1.PolicyTest, testID *string) (*v1alpha1.PolicyTest, error) {
+				Expect(id).To(Equal("tested"))
+				Expect(*testID).To(Equal("eu-rejected"))
+				test.Path = strPtr("policies/tested/tests/eu-rejected")
+				test.Id = testID
+				return &test, nil
+			}
+
+			response, err := handler.CreatePolicyTest(ctx, server.CreatePolicyTestRequestObject{
+				PolicyId: "tested",
+				Params:   server.CreatePolicyTestParams{Id: strPtr("eu-rejected")},
+				Body: &server.PolicyTest{
+					ServiceInstance: server.SimulatedServiceInstance{Spec: map[string]any{"service_type": "vm"}},
+					Expected:        server.PolicyTestExpectation{Status: server.SimulationRejected},
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.CreatePolicyTest201JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreatePolicyTest201JSONResponse")
+			Expect(*result.Path).To(Equal("policies/tested/tests/eu-rejected"))
+			Expect(result.Expected.Status).To(Equal(server.SimulationRejected))
+		})
+
+		It("should return 400 when body is nil", func() {
+			response, err := handler.CreatePolicyTest(context.Background(), server.CreatePolicyTestRequestObject{PolicyId: "tested"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.CreatePolicyTest400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be CreatePolicyTest400JSONResponse")
+		})
+	})
+
+	Describe("RunPolicyTests", func() {
+		It("should return the results of the run", func() {
+			mockService.RunPolicyTestsFn = func(_ context.Context, id string) (*v1alpha1.PolicyTestRun, error) {
+				return &v1alpha1.PolicyTestRun{
+					PolicyId: id,
+					Passed:   1,
+					Failed:   1,
+					Results: []v1alpha1.PolicyTestResult{
+						{TestId: "ok", Passed: true, Status: v1alpha1.SimulationModified, PolicyOutcome: v1alpha1.DraftApplied, Failures: []string{}},
+						{TestId: "wrong", Status: v1alpha1.SimulationRejected, PolicyOutcome: v1alpha1.DraftRejected, Failures: []string{"status is REJECTED, expected APPROVED"}},
+					},
+				}, nil
+			}
+
+			response, err := handler.RunPolicyTests(context.Background(), server.RunPolicyTestsRequestObject{PolicyId: "tested"})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.RunPolicyTests200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be RunPolicyTests200JSONResponse")
+			Expect(result.PolicyId).To(Equal("tested"))
+			Expect(result.Failed).To(Equal(int32(1)))
+			Expect(result.Results[1].PolicyOutcome).To(Equal(server.DraftRejected))
+			Expect(result.Results[1].Failures).To(ConsistOf("status is REJECTED, expected APPROVED"))
+		})
+	})
+
+	Describe("DeletePolicyTest", func() {
+		It("should return 404 when the test case does not exist", func() {
+			mockService.DeletePolicyTestFn = func(_ context.Context, id, testID string) error {
+				return service.NewPolicyTestNotFoundError(id, testID)
+			}
+
+			response, err := handler.DeletePolicyTest(context.Background(), server.DeletePolicyTestRequestObject{PolicyId: "tested", TestId: "missing"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.DeletePolicyTest404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be DeletePolicyTest404JSONResponse")
+		})
+	})
+
+	Describe("ListPolicyRevisions", func() {
+		It("should pass the paging parameters and return the revisions", func() {
+			ctx := context.Background()
+
+			token := "next"
+			size := int32(10)
+			mockService.ListPolicyRevisionsFn = func(_ context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyRevisionList, error) {
+				Expect(id).To(Equal("revised"))
+				Expect(pageToken).To(Equal(&token))
+				Expect(pageSize).To(Equal(&size))
+				return &v1alpha1.PolicyRevisionList{
+					Revisions: []v1alpha1.PolicyRevision{
+						{Path: strPtr("policies/revised/revisions/2"), Revision: 2, Policy: v1alpha1.Policy{DisplayName: strPtr("Renamed")}},
+						{Path: strPtr("policies/revised/revisions/1"), Revision: 1, Policy: v1alpha1.Policy{DisplayName: strPtr("Original")}},
+					},
+				}, nil
+			}
+
+			response, err := handler.ListPolicyRevisions(ctx, server.ListPolicyRevisionsRequestObject{
+				PolicyId: "revised",
+				Params:   server.ListPolicyRevisionsParams{PageToken: &token, MaxPageSize: &size},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.ListPolicyRevisions200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListPolicyRevisions200JSONResponse")
+			Expect(result.Revisions).To(HaveLen(2))
+			Expect(result.Revisions[0].Revision).To(Equal(int64(2)))
+			Expect(*result.Revisions[0].Policy.DisplayName).To(Equal("Renamed"))
+		})
+
+		It("should return 404 when the policy does not exist", func() {
+			ctx := context.Background()
+
+			mockService.ListPolicyRevisionsFn = func(_ context.Context, id string, _ *string, _ *int32) (*v1alpha1.PolicyRevisionList, error) {
+				return nil, service.NewPolicyNotFoundError(id)
+			}
+
+			response, err := handler.ListPolicyRevisions(ctx, server.ListPolicyRevisionsRequestObject{PolicyId: "missing"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ListPolicyRevisions404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListPolicyRevisions404JSONResponse")
+		})
+
+		It("should return 400 for an invalid page token", func() {
+			ctx := context.Background()
+
+			mockService.ListPolicyRevisionsFn = func(_ context.Context, _ string, _ *string, _ *int32) (*v1alpha1.PolicyRevisionList, error) {
+				return nil, service.NewInvalidArgumentError("Invalid page token", "page token is invalid")
+			}
+
+			response, err := handler.ListPolicyRevisions(ctx, server.ListPolicyRevisionsRequestObject{PolicyId: "revised"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.ListPolicyRevisions400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be ListPolicyRevisions400JSONResponse")
+		})
+	})
+
+	Describe("RollbackPolicy", func() {
+		It("should roll back to the requested revision", func() {
+			ctx := context.Background()
+
+			mockService.RollbackPolicyFn = func(_ context.Context, id string, revision int64) (*v1alpha1.Policy, error) {
+				Expect(id).To(Equal("revised"))
+				Expect(revision).To(Equal(int64(2)))
+				return &v1alpha1.Policy{Id: &id, RegoCode: strPtr("package revised")}, nil
+			}
+
+			response, err := handler.RollbackPolicy(ctx, server.RollbackPolicyRequestObject{
+				PolicyId: "revised",
+				Body:     &server.PolicyRollbackRequest{Revision: 2},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.RollbackPolicy200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be RollbackPolicy200JSONResponse")
+			Expect(*result.RegoCode).To(Equal("package revised"))
+		})
+
+		It("should return 400 when body is nil", func() {
+			ctx := context.Background()
+
+			response, err := handler.RollbackPolicy(ctx, server.RollbackPolicyRequestObject{PolicyId: "revised"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.RollbackPolicy400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be RollbackPolicy400JSONResponse")
+		})
+
+		It("should return 404 when the revision does not exist", func() {
+			ctx := context.Background()
+
+			mockService.RollbackPolicyFn = func(_ context.Context, id string, revision int64) (*v1alpha1.Policy, error) {
+				return nil, service.NewPolicyRevisionNotFoundError(id, revision)
+			}
+
+			response, err := handler.RollbackPolicy(ctx, server.RollbackPolicyRequestObject{
+				PolicyId: "revised",
+				Body:     &server.PolicyRollbackRequest{Revision: 9},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.RollbackPolicy404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be RollbackPolicy404JSONResponse")
+		})
+
+		It("should return 409 when the restored priority is taken", func() {
+			ctx := context.Background()
+
+			mockService.RollbackPolicyFn = func(_ context.Context, _ string, _ int64) (*v1alpha1.Policy, error) {
+				return nil, service.NewAlreadyExistsError("Policy already exists", "priority taken")
+			}
+
+			response, err := handler.RollbackPolicy(ctx, server.RollbackPolicyRequestObject{
+				PolicyId: "revised",
+				Body:     &server.PolicyRollbackRequest{Revision: 1},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.RollbackPolicy409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be RollbackPolicy409JSONResponse")
+		})
+	})
+
+	Describe("LockPolicy", func() {
+		It("should lock the policy for the owner", func() {
+			ctx := context.Background()
+			expireTime := time.Date(2026, 1, 9, 10, 35, 0, 0, time.UTC)
+
+			mockService.LockPolicyFn = func(_ context.Context, id, owner string, ttlSeconds *int32) (*v1alpha1.PolicyLock, error) {
+				Expect(id).To(Equal("edited"))
+				Expect(owner).To(Equal("alice"))
+				Expect(*ttlSeconds).To(Equal(int32(600)))
+				return &v1alpha1.PolicyLock{Owner: owner, ExpireTime: expireTime}, nil
+			}
+
+			ttl := int32(600)
+			response, err := handler.LockPolicy(ctx, server.LockPolicyRequestObject{
+				PolicyId: "edited",
+				Body:     &server.PolicyLockRequest{Owner: "alice", TtlSeconds: &ttl},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.LockPolicy200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be LockPolicy200JSONResponse")
+			Expect(result.Owner).To(Equal("alice"))
+			Expect(result.ExpireTime).To(Equal(expireTime))
+		})
+
+		It("should return 400 when body is nil", func() {
+			ctx := context.Background()
+
+			response, err := handler.LockPolicy(ctx, server.LockPolicyRequestObject{PolicyId: "edited"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.LockPolicy400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be LockPolicy400JSONResponse")
+		})
+
+		It("should return 409 when another owner holds the lock", func() {
+			ctx := context.Background()
+
+			mockService.LockPolicyFn = func(_ context.Context, id, _ string, _ *int32) (*v1alpha1.PolicyLock, error) {
+				return nil, service.NewAlreadyExistsError("Policy locked", "Policy '"+id+"' is locked by 'alice' until 2026-01-09T10:35:00Z")
+			}
+
+			response, err := handler.LockPolicy(ctx, server.LockPolicyRequestObject{
+				PolicyId: "edited",
+				Body:     &server.PolicyLockRequest{Owner: "bob"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.LockPolicy409JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be LockPolicy409JSONResponse")
+			Expect(*result.Detail).To(ContainSubstring("locked by 'alice'"))
+		})
+	})
+
+	Describe("UnlockPolicy", func() {
+		It("should unlock the policy for the owner", func() {
+			ctx := context.Background()
+
+			mockService.UnlockPolicyFn = func(_ context.Context, id, owner string) error {
+				Expect(id).To(Equal("edited"))
+				Expect(owner).To(Equal("alice"))
+				return nil
+			}
+
+			response, err := handler.UnlockPolicy(ctx, server.UnlockPolicyRequestObject{
+				PolicyId: "edited",
+				Body:     &server.PolicyUnlockRequest{Owner: "alice"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.UnlockPolicy204Response)
+			Expect(ok).To(BeTrue(), "response should be UnlockPolicy204Response")
+		})
+
+		It("should return 404 when the policy does not exist", func() {
+			ctx := context.Background()
+
+			mockService.UnlockPolicyFn = func(_ context.Context, id, _ string) error {
+				return service.NewPolicyNotFoundError(id)
+			}
+
+			response, err := handler.UnlockPolicy(ctx, server.UnlockPolicyRequestObject{
+				PolicyId: "missing",
+				Body:     &server.PolicyUnlockRequest{Owner: "alice"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.UnlockPolicy404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be UnlockPolicy404JSONResponse")
+		})
+	})
+
+	Describe("GetPolicyRevision", func() {
+		It("should return the revision", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyRevisionFn = func(_ context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error) {
+				Expect(id).To(Equal("revised"))
+				Expect(revision).To(Equal(int64(1)))
+				return &v1alpha1.PolicyRevision{
+					Path:     strPtr("policies/revised/revisions/1"),
+					Revision: 1,
+					Policy:   v1alpha1.Policy{RegoCode: strPtr("package revised")},
+				}, nil
+			}
+
+			response, err := handler.GetPolicyRevision(ctx, server.GetPolicyRevisionRequestObject{PolicyId: "revised", Revision: 1})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.GetPolicyRevision200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyRevision200JSONResponse")
+			Expect(*result.Path).To(Equal("policies/revised/revisions/1"))
+			Expect(*result.Policy.RegoCode).To(Equal("package revised"))
+		})
+
+		It("should return 404 when the revision does not exist", func() {
+			ctx := context.Background()
+
+			mockService.GetPolicyRevisionFn = func(_ context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error) {
+				return nil, service.NewPolicyRevisionNotFoundError(id, revision)
+			}
+
+			response, err := handler.GetPolicyRevision(ctx, server.GetPolicyRevisionRequestObject{PolicyId: "revised", Revision: 7})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.GetPolicyRevision404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be GetPolicyRevision404JSONResponse")
+		})
+	})
+
+	Describe("DiffPolicyRevisions", func() {
+		It("should return the diff", func() {
+			ctx := context.Background()
+
+			mockService.DiffPolicyRevisionsFn = func(_ context.Context, id string, from, to int64) (*v1alpha1.PolicyRevisionDiff, error) {
+				Expect(id).To(Equal("revised"))
+				Expect(from).To(Equal(int64(1)))
+				Expect(to).To(Equal(int64(2)))
+				return &v1alpha1.PolicyRevisionDiff{
+					From:     1,
+					To:       2,
+					Changes:  []v1alpha1.PolicyFieldChange{{Field: "priority", From: int32(100), To: int32(200)}},
+					RegoDiff: "--- revision 1\n+++ revision 2\n",
+				}, nil
+			}
+
+			response, err := handler.DiffPolicyRevisions(ctx, server.DiffPolicyRevisionsRequestObject{
+				PolicyId: "revised",
+				Params:   server.DiffPolicyRevisionsParams{From: 1, To: 2},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			result, ok := response.(server.DiffPolicyRevisions200JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be DiffPolicyRevisions200JSONResponse")
+			Expect(result.Changes).To(ConsistOf(server.PolicyFieldChange{Field: "priority", From: int32(100), To: int32(200)}))
+			Expect(result.RegoDiff).To(HavePrefix("--- revision 1"))
+		})
+
+		It("should return 400 for an invalid revision", func() {
+			ctx := context.Background()
+
+			mockService.DiffPolicyRevisionsFn = func(_ context.Context, _ string, _, _ int64) (*v1alpha1.PolicyRevisionDiff, error) {
+				return nil, service.NewInvalidArgumentError("Invalid revision", "from and to must be at least 1")
+			}
+
+			response, err := handler.DiffPolicyRevisions(ctx, server.DiffPolicyRevisionsRequestObject{PolicyId: "revised"})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.DiffPolicyRevisions400JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be DiffPolicyRevisions400JSONResponse")
+		})
+
+		It("should return 404 when a revision does not exist", func() {
+			ctx := context.Background()
+
+			mockService.DiffPolicyRevisionsFn = func(_ context.Context, id string, _, to int64) (*v1alpha1.PolicyRevisionDiff, error) {
+				return nil, service.NewPolicyRevisionNotFoundError(id, to)
+			}
+
+			response, err := handler.DiffPolicyRevisions(ctx, server.DiffPolicyRevisionsRequestObject{
+				PolicyId: "revised",
+				Params:   server.DiffPolicyRevisionsParams{From: 1, To: 9},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := response.(server.DiffPolicyRevisions404JSONResponse)
+			Expect(ok).To(BeTrue(), "response should be DiffPolicyRevisions404JSONResponse")
+		})
+	})
 })
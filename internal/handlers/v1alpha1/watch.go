@@ -0,0 +1,100 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/api/server"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// watchHeartbeatInterval is how often an idle watch stream sends a comment line, so proxies
+// do not close it
+const watchHeartbeatInterval = 30 * time.Second
+
+// errWatchNotConfigured is returned by the watch endpoint of a handler created without WithPolicyWatcher
+var errWatchNotConfigured = service.NewInternalError("Policy watch is not available", "No policy watcher is configured", nil)
+
+// WithPolicyWatcher serves the policy watch endpoint from watcher
+func WithPolicyWatcher(watcher *service.PolicyWatcher) Option {
+	return func(h *PolicyHandler) {
+		h.watcher = watcher
+	}
+}
+
+// WatchPolicies handles streaming policy changes as server-sent events.
+func (h *PolicyHandler) WatchPolicies(ctx context.Context, request server.WatchPoliciesRequestObject) (server.WatchPoliciesResponseObject, error) {
+	resourceVersion := ""
+	switch {
+	case request.Params.ResourceVersion != nil:
+		resourceVersion = *request.Params.ResourceVersion
+	case request.Params.LastEventID != nil:
+		resourceVersion = *request.Params.LastEventID
+	}
+	logging.FromContext(ctx).Debug("WatchPolicies request received", "resource_version", resourceVersion)
+
+	if h.watcher == nil {
+		return h.handleWatchPoliciesError(errWatchNotConfigured, request), nil
+	}
+	watch := h.watcher.Watch(resourceVersion)
+	reader, writer := io.Pipe()
+	go streamPolicyChanges(ctx, watch, writer)
+
+	cacheControl := "no-cache"
+	return server.WatchPolicies200TexteventStreamResponse{
+		Body:    reader,
+		Headers: server.WatchPolicies200ResponseHeaders{CacheControl: &cacheControl},
+	}, nil
+}
+
+// streamPolicyChanges writes the changes of watch to w as server-sent events until the
+// watch ends, the request is cancelled or the client stops reading
+func streamPolicyChanges(ctx context.Context, watch *service.PolicyWatch, w *io.PipeWriter) {
+	defer watch.Stop()
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	// A first line sends the response headers before any change happens
+	_, err := io.WriteString(w, ": watching policies\n\n")
+	for err == nil {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-heartbeat.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		case change, ok := <-watch.Changes:
+			if !ok {
+				err = io.EOF
+				break
+			}
+			err = writePolicyChange(w, change)
+		}
+	}
+	// The response ends cleanly; a write error means the client already stopped reading
+	_ = w.Close()
+}
+
+// writePolicyChange writes change as one server-sent event
+func writePolicyChange(w io.Writer, change service.PolicyChange) error {
+	event := server.PolicyWatchEvent{
+		Type:            string(change.Type),
+		ResourceVersion: change.ResourceVersion,
+	}
+	if change.PolicyID != "" {
+		event.PolicyId = &change.PolicyID
+	}
+	if change.Policy != nil {
+		policy := policyV1Alpha1ToServer(*change.Policy)
+		event.Policy = &policy
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", change.ResourceVersion, change.Type, data)
+	return err
+}
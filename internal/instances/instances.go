@@ -0,0 +1,78 @@
+// Package instances looks up the previous evaluation of existing service instances from an
+// HTTP service, typically the service catalog that provisioned them, so policies
+// re-evaluating an instance for a day-2 change can compare the request with it.
+package instances
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/service"
+)
+
+// instanceDocument is what the instance service answers for a known instance
+type instanceDocument struct {
+	Spec             map[string]any `json:"spec"`
+	SelectedProvider string         `json:"selected_provider"`
+}
+
+// HTTPProvider is a service.InstanceProvider that GETs URL/{instance_id}. A 200 answer is
+// the instance's previous evaluation and a 404 an unknown instance; anything else fails the
+// lookup.
+type HTTPProvider struct {
+	url    string
+	client *http.Client
+}
+
+var _ service.InstanceProvider = (*HTTPProvider)(nil)
+
+// New creates an HTTPProvider from the instance provider configuration, or returns nil when
+// no URL is configured
+func New(cfg config.InstanceProviderConfig) (*HTTPProvider, error) {
+	if cfg.URL == "" {
+		return nil, nil
+	}
+	endpoint, err := url.Parse(cfg.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("INSTANCE_PROVIDER_URL must be an http or https URL (got '%s')", cfg.URL)
+	}
+	if cfg.Timeout <= 0 {
+		return nil, fmt.Errorf("INSTANCE_PROVIDER_TIMEOUT must be positive (got %s)", cfg.Timeout)
+	}
+	return &HTTPProvider{
+		url:    strings.TrimSuffix(cfg.URL, "/"),
+		client: &http.Client{Timeout: cfg.Timeout},
+	}, nil
+}
+
+// PreviousEvaluation looks up the previous evaluation of the instance
+func (p *HTTPProvider) PreviousEvaluation(ctx context.Context, instanceID string) (*service.PreviousEvaluation, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+"/"+url.PathEscape(instanceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("instance provider answered %s", resp.Status)
+	}
+
+	var document instanceDocument
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		return nil, fmt.Errorf("decoding instance '%s': %w", instanceID, err)
+	}
+	return &service.PreviousEvaluation{Spec: document.Spec, SelectedProvider: document.SelectedProvider}, nil
+}
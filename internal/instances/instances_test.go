@@ -0,0 +1,85 @@
+package instances_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/instances"
+	"github.com/dcm-project/policy-manager/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTPProvider", func() {
+	var (
+		ctx         context.Context
+		requestPath string
+		status      int
+		body        string
+		server      *httptest.Server
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		status = http.StatusOK
+		body = `{"spec":{"region":"eu-west-1"},"selected_provider":"aws"}`
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestPath = r.URL.EscapedPath()
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	newProvider := func() *instances.HTTPProvider {
+		provider, err := instances.New(config.InstanceProviderConfig{URL: server.URL + "/instances/", Timeout: time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		return provider
+	}
+
+	It("should return the previous evaluation of a known instance", func() {
+		previous, err := newProvider().PreviousEvaluation(ctx, "vm/1")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requestPath).To(Equal("/instances/vm%2F1"))
+		Expect(previous).To(Equal(&service.PreviousEvaluation{
+			Spec:             map[string]any{"region": "eu-west-1"},
+			SelectedProvider: "aws",
+		}))
+	})
+
+	It("should return nil for an unknown instance", func() {
+		status = http.StatusNotFound
+
+		previous, err := newProvider().PreviousEvaluation(ctx, "vm-1")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(previous).To(BeNil())
+	})
+
+	It("should fail when the instance service answers an error or an undecodable body", func() {
+		status = http.StatusInternalServerError
+		_, err := newProvider().PreviousEvaluation(ctx, "vm-1")
+		Expect(err).To(MatchError(ContainSubstring("500")))
+
+		status = http.StatusOK
+		body = "not json"
+		_, err = newProvider().PreviousEvaluation(ctx, "vm-1")
+		Expect(err).To(MatchError(ContainSubstring("decoding instance 'vm-1'")))
+	})
+
+	It("should be disabled without a URL and reject an invalid one", func() {
+		provider, err := instances.New(config.InstanceProviderConfig{Timeout: time.Second})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).To(BeNil())
+
+		_, err = instances.New(config.InstanceProviderConfig{URL: "ftp://catalog", Timeout: time.Second})
+		Expect(err).To(MatchError(ContainSubstring("INSTANCE_PROVIDER_URL")))
+
+		_, err = instances.New(config.InstanceProviderConfig{URL: server.URL})
+		Expect(err).To(MatchError(ContainSubstring("INSTANCE_PROVIDER_TIMEOUT")))
+	})
+})
@@ -0,0 +1,189 @@
+// Package lifecycle starts the components of the service in dependency order, runs them
+// until the service is asked to stop or one of them fails, and stops them in reverse order.
+//
+// A component is ready once its Start returns, and components start only after every
+// component they depend on is ready. Long-running work (servers, background jobs) goes in
+// Run, which is started as soon as the component is ready and must return once its context
+// is cancelled. On shutdown every Run is cancelled and awaited, and every Stop is called, in
+// reverse start order, each bounded by the component's stop timeout.
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// DefaultStopTimeout bounds the shutdown of components that do not set their own timeout
+const DefaultStopTimeout = 10 * time.Second
+
+// Component is a part of the service managed by a Manager. Start, Run and Stop are optional.
+type Component struct {
+	Name string
+	// DependsOn names the components that must be ready before this one starts
+	DependsOn []string
+	// Start prepares the component and returns once it is ready
+	Start func(ctx context.Context) error
+	// Run runs the component until ctx is cancelled. Returning early, with or without an error,
+	// stops the service.
+	Run func(ctx context.Context) error
+	// Stop releases what Start acquired
+	Stop func(ctx context.Context) error
+	// StopTimeout bounds waiting for Run to return and for Stop; DefaultStopTimeout when zero
+	StopTimeout time.Duration
+}
+
+// Manager runs registered components
+type Manager struct {
+	components []Component
+	names      map[string]bool
+}
+
+// New creates a Manager without components
+func New() *Manager {
+	return &Manager{names: map[string]bool{}}
+}
+
+// Register adds a component. Names must be unique.
+func (m *Manager) Register(component Component) error {
+	if component.Name == "" {
+		return errors.New("component name is required")
+	}
+	if m.names[component.Name] {
+		return fmt.Errorf("component '%s' is already registered", component.Name)
+	}
+	m.names[component.Name] = true
+	m.components = append(m.components, component)
+	return nil
+}
+
+// Run starts every component, runs them until ctx is cancelled or a component's Run returns,
+// and then stops them. It returns the first start or run error.
+func (m *Manager) Run(ctx context.Context) error {
+	order, err := m.startOrder()
+	if err != nil {
+		return err
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type running struct {
+		component Component
+		done      chan struct{}
+	}
+	var started []running
+	runErrs := make(chan error, len(order))
+
+	var firstErr error
+	for _, component := range order {
+		if component.Start != nil {
+			slog.Debug("Starting component", "component", component.Name)
+			if err := component.Start(runCtx); err != nil {
+				firstErr = fmt.Errorf("failed to start %s: %w", component.Name, err)
+				break
+			}
+		}
+		slog.Debug("Component ready", "component", component.Name)
+
+		r := running{component: component}
+		if component.Run != nil {
+			r.done = make(chan struct{})
+			go func(component Component, done chan struct{}) {
+				defer close(done)
+				err := component.Run(runCtx)
+				if err != nil {
+					err = fmt.Errorf("%s: %w", component.Name, err)
+				}
+				runErrs <- err
+			}(component, r.done)
+		}
+		started = append(started, r)
+	}
+
+	if firstErr == nil {
+		select {
+		case <-ctx.Done():
+		case err := <-runErrs:
+			// A component stopping on its own before shutdown stops the service
+			firstErr = err
+		}
+	}
+	cancel()
+
+	for i := len(started) - 1; i >= 0; i-- {
+		stop(started[i].component, started[i].done)
+	}
+	return firstErr
+}
+
+// stop waits for the component's Run to return and calls its Stop, both within its stop timeout
+func stop(component Component, done chan struct{}) {
+	timeout := component.StopTimeout
+	if timeout <= 0 {
+		timeout = DefaultStopTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if done != nil {
+		select {
+		case <-done:
+		case <-ctx.Done():
+			slog.Error("Component did not stop in time", "component", component.Name, "timeout", timeout)
+			return
+		}
+	}
+	if component.Stop != nil {
+		if err := component.Stop(ctx); err != nil {
+			slog.Error("Failed to stop component", "component", component.Name, "error", err)
+			return
+		}
+	}
+	slog.Debug("Component stopped", "component", component.Name)
+}
+
+// startOrder orders components so each follows its dependencies, keeping registration order otherwise
+func (m *Manager) startOrder() ([]Component, error) {
+	byName := make(map[string]Component, len(m.components))
+	for _, component := range m.components {
+		byName[component.Name] = component
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(m.components))
+	order := make([]Component, 0, len(m.components))
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("components have a dependency cycle: %v", append(path, name))
+		}
+		state[name] = visiting
+		component := byName[name]
+		for _, dependency := range component.DependsOn {
+			if _, ok := byName[dependency]; !ok {
+				return fmt.Errorf("component '%s' depends on unregistered component '%s'", name, dependency)
+			}
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, component)
+		return nil
+	}
+	for _, component := range m.components {
+		if err := visit(component.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
@@ -0,0 +1,145 @@
+package lifecycle_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/lifecycle"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		manager *lifecycle.Manager
+		mu      sync.Mutex
+		calls   []string
+	)
+
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+	recorded := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), calls...)
+	}
+	component := func(name string, dependsOn ...string) lifecycle.Component {
+		return lifecycle.Component{
+			Name:      name,
+			DependsOn: dependsOn,
+			Start: func(context.Context) error {
+				record("start " + name)
+				return nil
+			},
+			Stop: func(context.Context) error {
+				record("stop " + name)
+				return nil
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		manager = lifecycle.New()
+		calls = nil
+	})
+
+	It("starts components after their dependencies and stops them in reverse order", func() {
+		Expect(manager.Register(component("server", "services"))).To(Succeed())
+		Expect(manager.Register(component("services", "database"))).To(Succeed())
+		Expect(manager.Register(component("database"))).To(Succeed())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(manager.Run(ctx)).To(Succeed())
+
+		Expect(recorded()).To(Equal([]string{
+			"start database", "start services", "start server",
+			"stop server", "stop services", "stop database",
+		}))
+	})
+
+	It("runs components until the context is cancelled", func() {
+		running := make(chan struct{})
+		server := component("server")
+		server.Run = func(ctx context.Context) error {
+			close(running)
+			<-ctx.Done()
+			record("run server returned")
+			return nil
+		}
+		Expect(manager.Register(server)).To(Succeed())
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-running
+			cancel()
+		}()
+
+		Expect(manager.Run(ctx)).To(Succeed())
+
+		Expect(recorded()).To(Equal([]string{"start server", "run server returned", "stop server"}))
+	})
+
+	It("stops every component when one fails while running", func() {
+		Expect(manager.Register(component("database"))).To(Succeed())
+		server := component("server", "database")
+		server.Run = func(context.Context) error { return errors.New("address in use") }
+		Expect(manager.Register(server)).To(Succeed())
+
+		err := manager.Run(context.Background())
+
+		Expect(err).To(MatchError("server: address in use"))
+		Expect(recorded()).To(Equal([]string{"start database", "start server", "stop server", "stop database"}))
+	})
+
+	It("stops the started components when one fails to start", func() {
+		Expect(manager.Register(component("database"))).To(Succeed())
+		services := component("services", "database")
+		services.Start = func(context.Context) error { return errors.New("compile failed") }
+		Expect(manager.Register(services)).To(Succeed())
+		Expect(manager.Register(component("server", "services"))).To(Succeed())
+
+		err := manager.Run(context.Background())
+
+		Expect(err).To(MatchError("failed to start services: compile failed"))
+		Expect(recorded()).To(Equal([]string{"start database", "stop database"}))
+	})
+
+	It("gives up on a component that does not stop within its timeout", func() {
+		stuck := component("stuck")
+		stuck.StopTimeout = 10 * time.Millisecond
+		stuck.Run = func(context.Context) error {
+			select {}
+		}
+		Expect(manager.Register(component("database"))).To(Succeed())
+		Expect(manager.Register(stuck)).To(Succeed())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		Expect(manager.Run(ctx)).To(Succeed())
+
+		Expect(recorded()).To(Equal([]string{"start database", "start stuck", "stop database"}))
+	})
+
+	It("rejects duplicate and unnamed components", func() {
+		Expect(manager.Register(component("database"))).To(Succeed())
+
+		Expect(manager.Register(component("database"))).To(MatchError("component 'database' is already registered"))
+		Expect(manager.Register(lifecycle.Component{})).To(HaveOccurred())
+	})
+
+	It("rejects unknown dependencies and dependency cycles", func() {
+		Expect(manager.Register(component("server", "missing"))).To(Succeed())
+		Expect(manager.Run(context.Background())).To(MatchError(ContainSubstring("unregistered component 'missing'")))
+
+		manager = lifecycle.New()
+		Expect(manager.Register(component("a", "b"))).To(Succeed())
+		Expect(manager.Register(component("b", "a"))).To(Succeed())
+		Expect(manager.Run(context.Background())).To(MatchError(ContainSubstring("dependency cycle")))
+		Expect(recorded()).To(BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package logging
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
 	"strings"
@@ -10,33 +11,45 @@ import (
 
 type contextKey struct{}
 
+// level is the level of the default logger, which SetLevel changes while it is used
+var level slog.LevelVar
+
 // Init configures the default slog logger with the specified level and JSON output.
-func Init(level string) {
-	var logLevel slog.Level
-	var unrecognizedLevel bool
-	switch strings.ToLower(level) {
-	case "debug":
-		logLevel = slog.LevelDebug
-	case "warn", "warning":
-		logLevel = slog.LevelWarn
-	case "error":
-		logLevel = slog.LevelError
-	default:
-		logLevel = slog.LevelInfo
-		unrecognizedLevel = true
-	}
+func Init(logLevel string) {
+	parsed, err := ParseLevel(logLevel)
+	level.Set(parsed)
 
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-		Level: logLevel,
+		Level: &level,
 	})
 	slog.SetDefault(slog.New(handler))
 
-	if unrecognizedLevel {
-		slog.Warn("Unrecognized log level", level, "using 'info'")
+	if err != nil {
+		slog.Warn("Unrecognized log level", logLevel, "using 'info'")
 	}
 }
 
-// WithLogger returns a new context with the given logger stored in it.
+// ParseLevel parses a log level name: debug, info, warn or error. Unrecognized names are an
+// error, returned along with the info level.
+func ParseLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("log level must be one of: debug, info, warn, error (got '%s')", name)
+}
+
+// SetLevel changes the level of the default logger configured by Init
+func SetLevel(logLevel slog.Level) {
+	level.Set(logLevel)
+}
+
 func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
 	return context.WithValue(ctx, contextKey{}, logger)
 }
@@ -0,0 +1,221 @@
+// Package metrics provides a minimal registry of labelled counters and gauges exposed in the
+// Prometheus text exposition format.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Registry holds the metrics served by Handler
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+}
+
+type collector interface {
+	write(w io.Writer)
+	metricName() string
+}
+
+// Default is the registry used by the package-level constructors
+var Default = NewRegistry()
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.collectors {
+		if existing.metricName() == c.metricName() {
+			panic(fmt.Sprintf("metrics: duplicate metric %q", c.metricName()))
+		}
+	}
+	r.collectors = append(r.collectors, c)
+}
+
+// Handler serves the registry in the Prometheus text exposition format
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		r.mu.Lock()
+		collectors := append([]collector(nil), r.collectors...)
+		r.mu.Unlock()
+		sort.Slice(collectors, func(i, j int) bool { return collectors[i].metricName() < collectors[j].metricName() })
+		for _, c := range collectors {
+			c.write(w)
+		}
+	})
+}
+
+// Handler serves the Default registry
+func Handler() http.Handler {
+	return Default.Handler()
+}
+
+// CounterVec is a monotonically increasing counter partitioned by label values
+type CounterVec struct {
+	vec
+}
+
+// GaugeVec is a value that can go up and down, partitioned by label values
+type GaugeVec struct {
+	vec
+}
+
+// vec stores the series shared by counters and gauges
+type vec struct {
+	name       string
+	help       string
+	metricType string
+	labelNames []string
+
+	mu     sync.Mutex
+	values map[string]*series
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+}
+
+// NewCounterVec creates a counter registered on the Default registry
+func NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	return Default.NewCounterVec(name, help, labelNames...)
+}
+
+// NewCounterVec creates a counter registered on r
+func (r *Registry) NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	c := &CounterVec{vec: newVec(name, help, "counter", labelNames)}
+	r.register(c)
+	return c
+}
+
+// NewGaugeVec creates a gauge registered on the Default registry
+func NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	return Default.NewGaugeVec(name, help, labelNames...)
+}
+
+// NewGaugeVec creates a gauge registered on r
+func (r *Registry) NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	g := &GaugeVec{vec: newVec(name, help, "gauge", labelNames)}
+	r.register(g)
+	return g
+}
+
+func newVec(name, help, metricType string, labelNames []string) vec {
+	return vec{
+		name:       name,
+		help:       help,
+		metricType: metricType,
+		labelNames: labelNames,
+		values:     map[string]*series{},
+	}
+}
+
+// Inc increments the counter for the given label values by one
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add increments the counter for the given label values. Negative values are ignored.
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		c.checkLabels(labelValues)
+		return
+	}
+	c.update(labelValues, func(current float64) float64 { return current + delta })
+}
+
+// Set sets the gauge for the given label values
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.update(labelValues, func(float64) float64 { return value })
+}
+
+// Value returns the current value for the given label values
+func (v *vec) Value(labelValues ...string) float64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if s, ok := v.values[strings.Join(labelValues, "\xff")]; ok {
+		return s.value
+	}
+	return 0
+}
+
+func (v *vec) checkLabels(labelValues []string) {
+	if len(labelValues) != len(v.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labelNames), len(labelValues)))
+	}
+}
+
+func (v *vec) update(labelValues []string, fn func(current float64) float64) {
+	v.checkLabels(labelValues)
+	key := strings.Join(labelValues, "\xff")
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s, ok := v.values[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		v.values[key] = s
+	}
+	s.value = fn(s.value)
+}
+
+func (v *vec) metricName() string {
+	return v.name
+}
+
+func (v *vec) write(w io.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	writeHeader(w, v.name, v.help, v.metricType)
+	keys := make([]string, 0, len(v.values))
+	for k := range v.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := v.values[k]
+		writeSample(w, v.name, v.labelNames, s.labelValues, s.value)
+	}
+}
+
+func writeHeader(w io.Writer, name, help, metricType string) {
+	_, _ = fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, strings.ReplaceAll(help, "\n", " "), name, metricType)
+}
+
+func writeSample(w io.Writer, name string, labelNames, labelValues []string, value float64) {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labelNames) > 0 {
+		b.WriteByte('{')
+		for i, labelName := range labelNames {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(labelName)
+			b.WriteString(`="`)
+			b.WriteString(escapeLabelValue(labelValues[i]))
+			b.WriteByte('"')
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	b.WriteByte('\n')
+	_, _ = io.WriteString(w, b.String())
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
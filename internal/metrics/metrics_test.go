@@ -0,0 +1,77 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Registry", func() {
+	var registry *metrics.Registry
+
+	BeforeEach(func() {
+		registry = metrics.NewRegistry()
+	})
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		registry.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(recorder.Header().Get("Content-Type")).To(HavePrefix("text/plain"))
+		return recorder.Body.String()
+	}
+
+	It("renders counters in the Prometheus text format", func() {
+		counter := registry.NewCounterVec("requests_total", "Requests served", "code")
+		counter.Inc("200")
+		counter.Add(2, "200")
+		counter.Inc("500")
+
+		Expect(scrape()).To(Equal(`# HELP requests_total Requests served
+# TYPE requests_total counter
+requests_total{code="200"} 3
+requests_total{code="500"} 1
+`))
+		Expect(counter.Value("200")).To(Equal(float64(3)))
+	})
+
+	It("renders gauges in the Prometheus text format", func() {
+		gauge := registry.NewGaugeVec("flag_enabled", "Whether a flag is enabled", "flag")
+		gauge.Set(1, "a")
+		gauge.Set(1, "b")
+		gauge.Set(0, "b")
+
+		Expect(scrape()).To(Equal(`# HELP flag_enabled Whether a flag is enabled
+# TYPE flag_enabled gauge
+flag_enabled{flag="a"} 1
+flag_enabled{flag="b"} 0
+`))
+		Expect(gauge.Value("a")).To(Equal(float64(1)))
+	})
+
+	It("escapes label values", func() {
+		counter := registry.NewCounterVec("events_total", "Events", "source")
+		counter.Inc("a \"quoted\"\nvalue")
+
+		Expect(scrape()).To(ContainSubstring(`events_total{source="a \"quoted\"\nvalue"} 1`))
+	})
+
+	It("ignores negative increments", func() {
+		counter := registry.NewCounterVec("events_total", "Events")
+		counter.Add(-1)
+
+		Expect(counter.Value()).To(BeZero())
+	})
+
+	It("panics on duplicate registration", func() {
+		registry.NewCounterVec("events_total", "Events")
+		Expect(func() { registry.NewCounterVec("events_total", "Events") }).To(Panic())
+	})
+
+	It("panics on a label value count mismatch", func() {
+		counter := registry.NewCounterVec("events_total", "Events", "source")
+		Expect(func() { counter.Inc() }).To(Panic())
+	})
+})
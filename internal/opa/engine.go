@@ -4,6 +4,7 @@ package opa
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
@@ -16,11 +17,22 @@ type Engine interface {
 	// Compile loads and compiles all Rego modules, replacing any previously compiled state.
 	Compile(ctx context.Context, policies []PolicyModule) error
 
-	// EvaluatePolicy evaluates a policy by ID against the given input.
+	// EvaluatePolicy evaluates a policy by ID against the given input. The input is not
+	// retained after it returns, so callers may reuse its maps.
 	EvaluatePolicy(ctx context.Context, policyID string, input map[string]any) (*EvaluationResult, error)
 
+	// EvaluateComposite evaluates the composite rule of a policy by ID against the given input.
+	// The result is undefined for policies without a composite rule.
+	EvaluateComposite(ctx context.Context, policyID string, input map[string]any) (*EvaluationResult, error)
+
 	// ValidateRego checks Rego syntax without persisting.
 	ValidateRego(ctx context.Context, regoCode string) error
+
+	// CheckCompile compiles the modules like Compile without replacing the compiled state.
+	CheckCompile(ctx context.Context, policies []PolicyModule) error
+
+	// Modules returns the modules of the compiled state, in the order they were compiled.
+	Modules() []PolicyModule
 }
 
 // PolicyModule represents a Rego module to compile
@@ -29,11 +41,21 @@ type PolicyModule struct {
 	RegoCode string
 }
 
+// compositeRule is the rule a policy defines to check a composite request as a whole
+const compositeRule = "composite"
+
 // embeddedEngine implements Engine using OPA's Go library
 type embeddedEngine struct {
-	mu        sync.RWMutex // protects reads/writes of queries
+	mu        sync.RWMutex // protects reads/writes of queries and modules
 	compileMu sync.Mutex   // serializes Compile calls
-	queries   map[string]*rego.PreparedEvalQuery
+	queries   map[string]*preparedPolicy
+	modules   []PolicyModule // the modules queries were prepared from
+}
+
+// preparedPolicy holds the prepared queries of one policy
+type preparedPolicy struct {
+	main      *rego.PreparedEvalQuery
+	composite *rego.PreparedEvalQuery // nil when the policy has no composite rule
 }
 
 // NewEngine creates a new embedded OPA engine
@@ -50,10 +72,41 @@ func (e *embeddedEngine) Compile(ctx context.Context, policies []PolicyModule) e
 	if len(policies) == 0 {
 		e.mu.Lock()
 		e.queries = nil
+		e.modules = nil
 		e.mu.Unlock()
 		return nil
 	}
 
+	newQueries, err := prepareQueries(ctx, policies)
+	if err != nil {
+		return err
+	}
+
+	// Atomically swap the query map
+	e.mu.Lock()
+	e.queries = newQueries
+	e.modules = slices.Clone(policies)
+	e.mu.Unlock()
+
+	return nil
+}
+
+// Modules returns a copy of the modules of the compiled state. Safe for concurrent use.
+func (e *embeddedEngine) Modules() []PolicyModule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return slices.Clone(e.modules)
+}
+
+// CheckCompile compiles the modules and prepares their queries, then discards the result.
+// Compile errors are returned as an *InvalidRegoError.
+func (e *embeddedEngine) CheckCompile(ctx context.Context, policies []PolicyModule) error {
+	_, err := prepareQueries(ctx, policies)
+	return err
+}
+
+// prepareQueries compiles the modules together and prepares the queries of each policy
+func prepareQueries(ctx context.Context, policies []PolicyModule) (map[string]*preparedPolicy, error) {
 	// Build source map for compilation
 	sources := make(map[string]string, len(policies))
 	for _, p := range policies {
@@ -63,47 +116,88 @@ func (e *embeddedEngine) Compile(ctx context.Context, policies []PolicyModule) e
 	// Compile all modules together to catch cross-module errors
 	compiler, err := ast.CompileModulesWithOpt(sources, ast.CompileOpts{ParserOptions: ast.ParserOptions{RegoVersion: ast.RegoV1}})
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrInvalidRego, err)
+		return nil, newCompileError(err)
 	}
 
-	// Build one PreparedEvalQuery per policy, keyed by policy ID
-	newQueries := make(map[string]*rego.PreparedEvalQuery, len(policies))
+	// Build the PreparedEvalQueries of each policy, keyed by policy ID
+	newQueries := make(map[string]*preparedPolicy, len(policies))
 	for _, p := range policies {
 		mod := compiler.Modules[p.ID]
 		// mod.Package.Path is like "data.policies.my_policy", we need the part after "data."
 		pkgName := strings.TrimPrefix(mod.Package.Path.String(), "data.")
-		query := fmt.Sprintf("data.%s.main", pkgName)
 
-		r := rego.New(
-			rego.Query(query),
-			rego.Compiler(compiler),
-		)
-		pq, err := r.PrepareForEval(ctx)
+		prepared := &preparedPolicy{}
+		prepared.main, err = prepareQuery(ctx, compiler, fmt.Sprintf("data.%s.main", pkgName))
 		if err != nil {
-			return fmt.Errorf("%w: failed to prepare query for policy '%s': %v", ErrInvalidRego, p.ID, err)
+			return nil, fmt.Errorf("%w: failed to prepare query for policy '%s': %v", ErrInvalidRego, p.ID, err)
+		}
+		if definesRule(mod, compositeRule) {
+			prepared.composite, err = prepareQuery(ctx, compiler, fmt.Sprintf("data.%s.%s", pkgName, compositeRule))
+			if err != nil {
+				return nil, fmt.Errorf("%w: failed to prepare composite query for policy '%s': %v", ErrInvalidRego, p.ID, err)
+			}
 		}
-		newQueries[p.ID] = &pq
+		newQueries[p.ID] = prepared
 	}
 
-	// Atomically swap the query map
-	e.mu.Lock()
-	e.queries = newQueries
-	e.mu.Unlock()
+	return newQueries, nil
+}
 
-	return nil
+func prepareQuery(ctx context.Context, compiler *ast.Compiler, query string) (*rego.PreparedEvalQuery, error) {
+	pq, err := rego.New(
+		rego.Query(query),
+		rego.Compiler(compiler),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pq, nil
+}
+
+// definesRule reports whether the module defines a rule with the given name
+func definesRule(module *ast.Module, name string) bool {
+	for _, rule := range module.Rules {
+		if rule.Head.Ref().Equal(ast.Ref{ast.VarTerm(name)}) {
+			return true
+		}
+	}
+	return false
 }
 
 // EvaluatePolicy evaluates a policy by ID. Safe for concurrent use.
 func (e *embeddedEngine) EvaluatePolicy(ctx context.Context, policyID string, input map[string]any) (*EvaluationResult, error) {
 	e.mu.RLock()
-	pq, ok := e.queries[policyID]
+	prepared, ok := e.queries[policyID]
 	e.mu.RUnlock()
 
 	if !ok {
 		return &EvaluationResult{Defined: false}, nil
 	}
+	return evaluate(ctx, prepared.main, policyID, "main", input)
+}
+
+// EvaluateComposite evaluates the composite rule of a policy by ID. Safe for concurrent use.
+func (e *embeddedEngine) EvaluateComposite(ctx context.Context, policyID string, input map[string]any) (*EvaluationResult, error) {
+	e.mu.RLock()
+	prepared, ok := e.queries[policyID]
+	e.mu.RUnlock()
 
-	rs, err := pq.Eval(ctx, rego.EvalInput(input))
+	if !ok || prepared.composite == nil {
+		return &EvaluationResult{Defined: false}, nil
+	}
+	return evaluate(ctx, prepared.composite, policyID, compositeRule, input)
+}
+
+// evaluate runs a prepared query whose result must be an object
+func evaluate(ctx context.Context, pq *rego.PreparedEvalQuery, policyID, rule string, input map[string]any) (*EvaluationResult, error) {
+	// Converting the input here rather than with rego.EvalInput skips the JSON round trip
+	// OPA makes of raw inputs, which was most of the garbage of an evaluation. Values that
+	// are not JSON types are still round-tripped on their own.
+	value, err := ast.InterfaceToValue(input)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input for policy '%s': %w", policyID, err)
+	}
+	rs, err := pq.Eval(ctx, rego.EvalParsedInput(value))
 	if err != nil {
 		return nil, fmt.Errorf("evaluation error for policy '%s': %w", policyID, err)
 	}
@@ -119,7 +213,7 @@ func (e *embeddedEngine) EvaluatePolicy(ctx context.Context, policyID string, in
 
 	resultMap, ok := val.(map[string]any)
 	if !ok {
-		return nil, fmt.Errorf("%w: policy main rule must return an object; got %T", ErrEngineInternal, val)
+		return nil, fmt.Errorf("%w: policy %s rule must return an object; got %T", ErrEngineInternal, rule, val)
 	}
 
 	return &EvaluationResult{
@@ -128,7 +222,8 @@ func (e *embeddedEngine) EvaluatePolicy(ctx context.Context, policyID string, in
 	}, nil
 }
 
-// ValidateRego checks that the given Rego code compiles without errors.
+// ValidateRego checks that the given Rego code parses without errors. Parse errors are
+// returned as an *InvalidRegoError.
 func (e *embeddedEngine) ValidateRego(_ context.Context, regoCode string) error {
 	if strings.TrimSpace(regoCode) == "" {
 		return fmt.Errorf("%w: empty Rego code", ErrInvalidRego)
@@ -136,7 +231,7 @@ func (e *embeddedEngine) ValidateRego(_ context.Context, regoCode string) error
 
 	_, err := ast.ParseModuleWithOpts("validation", regoCode, ast.ParserOptions{RegoVersion: ast.RegoV1})
 	if err != nil {
-		return fmt.Errorf("%w: %v", ErrInvalidRego, err)
+		return newInvalidRegoError(err)
 	}
 
 	return nil
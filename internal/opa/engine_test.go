@@ -48,6 +48,17 @@ var _ = Describe("Engine", func() {
 			Expect(errors.Is(err, opa.ErrInvalidRego)).To(BeTrue())
 		})
 
+		It("reports compile errors with the policy, line and column", func() {
+			err := engine.Compile(ctx, []opa.PolicyModule{
+				{ID: "unsafe", RegoCode: "package test\n\nallow if { x }"},
+			})
+
+			var invalid *opa.InvalidRegoError
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+			Expect(invalid.Compile).To(BeTrue())
+			Expect(invalid.Issues).To(ConsistOf(opa.RegoIssue{PolicyID: "unsafe", Line: 3, Column: 12, Message: "var x is unsafe"}))
+		})
+
 		It("replaces previous policies", func() {
 			// Compile policy A
 			err := engine.Compile(ctx, []opa.PolicyModule{
@@ -93,6 +104,20 @@ var _ = Describe("Engine", func() {
 		})
 	})
 
+	Describe("Modules", func() {
+		It("returns the modules of the compiled state", func() {
+			Expect(engine.Modules()).To(BeEmpty())
+
+			modules := []opa.PolicyModule{{ID: "a", RegoCode: "package policy_a\nmain = {\"rejected\": false}"}}
+			Expect(engine.Compile(ctx, modules)).To(Succeed())
+			Expect(engine.Compile(ctx, []opa.PolicyModule{{ID: "bad", RegoCode: "package bad\n{invalid"}})).NotTo(Succeed())
+			Expect(engine.Modules()).To(Equal(modules))
+
+			Expect(engine.Compile(ctx, nil)).To(Succeed())
+			Expect(engine.Modules()).To(BeEmpty())
+		})
+	})
+
 	Describe("EvaluatePolicy", func() {
 		It("returns decision", func() {
 			err := engine.Compile(ctx, []opa.PolicyModule{
@@ -221,6 +246,54 @@ main = {"rejected": false, "patch": {"cpu_limit": input.spec.cpu}} if {
 		})
 	})
 
+	Describe("EvaluateComposite", func() {
+		It("evaluates the composite rule of a policy", func() {
+			err := engine.Compile(ctx, []opa.PolicyModule{
+				{ID: "composite", RegoCode: "package composite_test\nmain := {}\ncomposite := {\"rejected\": count(input.instances) > 1}"},
+				{ID: "plain", RegoCode: "package plain\nmain := {}"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := engine.EvaluateComposite(ctx, "composite", map[string]any{"instances": []any{"a", "b"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Defined).To(BeTrue())
+			Expect(result.Result).To(Equal(map[string]any{"rejected": true}))
+
+			result, err = engine.EvaluateComposite(ctx, "plain", map[string]any{"instances": []any{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Defined).To(BeFalse())
+		})
+	})
+
+	Describe("CheckCompile", func() {
+		It("accepts modules that compile without replacing the compiled state", func() {
+			Expect(engine.Compile(ctx, []opa.PolicyModule{
+				{ID: "current", RegoCode: "package current\nmain = {\"rejected\": false}"},
+			})).To(Succeed())
+
+			err := engine.CheckCompile(ctx, []opa.PolicyModule{
+				{ID: "candidate", RegoCode: "package candidate\nmain = {\"rejected\": true}"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := engine.EvaluatePolicy(ctx, "current", map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Defined).To(BeTrue())
+			result, err = engine.EvaluatePolicy(ctx, "candidate", map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Defined).To(BeFalse())
+		})
+
+		It("rejects modules that parse but do not compile", func() {
+			err := engine.CheckCompile(ctx, []opa.PolicyModule{
+				{ID: "unsafe", RegoCode: "package test\n\nallow if { x }"},
+			})
+
+			Expect(errors.Is(err, opa.ErrInvalidRego)).To(BeTrue())
+			Expect(err).To(MatchError("invalid Rego code: policy 'unsafe', line 3, column 12: var x is unsafe"))
+		})
+	})
+
 	Describe("ValidateRego", func() {
 		It("accepts valid code", func() {
 			err := engine.ValidateRego(ctx, "package test\nmain = true")
@@ -233,6 +306,15 @@ main = {"rejected": false, "patch": {"cpu_limit": input.spec.cpu}} if {
 			Expect(errors.Is(err, opa.ErrInvalidRego)).To(BeTrue())
 		})
 
+		It("reports the line and column of syntax errors", func() {
+			err := engine.ValidateRego(ctx, "package test\n\nallow if {\n\tx :=\n}")
+
+			var invalid *opa.InvalidRegoError
+			Expect(errors.As(err, &invalid)).To(BeTrue())
+			Expect(invalid.Issues).To(ConsistOf(opa.RegoIssue{Line: 5, Column: 1, Message: "unexpected } token"}))
+			Expect(err).To(MatchError("invalid Rego code: line 5, column 1: unexpected } token"))
+		})
+
 		It("rejects empty code", func() {
 			err := engine.ValidateRego(ctx, "")
 			Expect(err).To(HaveOccurred())
@@ -2,6 +2,10 @@ package opa
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
 )
 
 // Sentinel errors for policy engine operations
@@ -12,3 +16,73 @@ var (
 	// ErrEngineInternal indicates an unexpected error within the policy engine
 	ErrEngineInternal = errors.New("policy engine internal error")
 )
+
+// RegoIssue is a problem found in Rego code. Line and Column are 1-based, and zero when OPA
+// reports no location. PolicyID names the module for compile errors.
+type RegoIssue struct {
+	PolicyID string
+	Line     int
+	Column   int
+	Message  string
+}
+
+func (i RegoIssue) String() string {
+	var location string
+	if i.PolicyID != "" {
+		location = fmt.Sprintf("policy '%s', ", i.PolicyID)
+	}
+	if i.Line == 0 {
+		return location + i.Message
+	}
+	return fmt.Sprintf("%sline %d, column %d: %s", location, i.Line, i.Column, i.Message)
+}
+
+// InvalidRegoError lists the problems found in Rego code that does not parse, or in modules
+// that parse but do not compile together. It matches ErrInvalidRego.
+type InvalidRegoError struct {
+	Issues  []RegoIssue
+	Compile bool // the modules parsed; the issues are compile errors
+}
+
+func (e *InvalidRegoError) Error() string {
+	issues := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		issues[i] = issue.String()
+	}
+	return fmt.Sprintf("%v: %s", ErrInvalidRego, strings.Join(issues, "; "))
+}
+
+func (e *InvalidRegoError) Is(target error) bool {
+	return target == ErrInvalidRego
+}
+
+// newInvalidRegoError converts a parse error into an InvalidRegoError, keeping the location
+// of each problem
+func newInvalidRegoError(err error) error {
+	return invalidRegoError(err, false)
+}
+
+// newCompileError converts a compile error into an InvalidRegoError. Modules are compiled
+// under their policy IDs, so the file of each problem is the policy it is in.
+func newCompileError(err error) error {
+	return invalidRegoError(err, true)
+}
+
+func invalidRegoError(err error, compile bool) error {
+	var astErrors ast.Errors
+	if !errors.As(err, &astErrors) || len(astErrors) == 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidRego, err)
+	}
+	issues := make([]RegoIssue, len(astErrors))
+	for i, astErr := range astErrors {
+		issues[i] = RegoIssue{Message: astErr.Message}
+		if astErr.Location != nil {
+			issues[i].Line = astErr.Location.Row
+			issues[i].Column = astErr.Location.Col
+			if compile {
+				issues[i].PolicyID = astErr.Location.File
+			}
+		}
+	}
+	return &InvalidRegoError{Issues: issues, Compile: compile}
+}
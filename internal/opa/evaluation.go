@@ -1,5 +1,7 @@
 package opa
 
+import "github.com/dcm-project/policy-manager/pkg/constraints"
+
 // EvaluationResult represents the result from OPA evaluation
 type EvaluationResult struct {
 	Result  map[string]any // The policy decision
@@ -7,16 +9,29 @@ type EvaluationResult struct {
 }
 
 // ServiceProviderConstraints represents constraints on which service providers are allowed
-type ServiceProviderConstraints struct {
-	AllowList []string `json:"allow_list,omitempty"`
-	Patterns  []string `json:"patterns,omitempty"`
+type ServiceProviderConstraints = constraints.ServiceProviderConstraints
+
+// Array merge strategies a policy can choose for arrays in its patch
+const (
+	ArrayMergeReplace = "replace"      // the patch array replaces the base array (RFC 7396)
+	ArrayMergeAppend  = "append"       // patch items are appended to the base array
+	ArrayMergeByKey   = "merge_by_key" // patch items are merged into base items with the same key value
+)
+
+// ArrayMergeOption selects how the array at one spec field path is merged
+type ArrayMergeOption struct {
+	Strategy string `json:"strategy"`
+	Key      string `json:"key,omitempty"` // item field identifying items; merge_by_key only
 }
 
 // PolicyDecision represents the expected output from OPA policies
 type PolicyDecision struct {
 	Rejected                   bool                        `json:"rejected"`
 	RejectionReason            string                      `json:"rejection_reason,omitempty"`
+	RejectionCode              string                      `json:"rejection_code,omitempty"`   // selects a message template
+	RejectionParams            map[string]any              `json:"rejection_params,omitempty"` // values for the template placeholders
 	Patch                      map[string]any              `json:"patch,omitempty"`
+	ArrayMerge                 map[string]ArrayMergeOption `json:"array_merge,omitempty"` // keyed by dot-separated spec field path
 	Constraints                map[string]any              `json:"constraints,omitempty"`
 	ServiceProviderConstraints *ServiceProviderConstraints `json:"service_provider_constraints,omitempty"`
 	SelectedProvider           string                      `json:"selected_provider,omitempty"`
@@ -34,10 +49,30 @@ func ParsePolicyDecision(result map[string]any) *PolicyDecision {
 		decision.RejectionReason = reason
 	}
 
+	if code, ok := result["rejection_code"].(string); ok {
+		decision.RejectionCode = code
+	}
+
+	if params, ok := result["rejection_params"].(map[string]any); ok {
+		decision.RejectionParams = params
+	}
+
 	if patch, ok := result["patch"].(map[string]any); ok {
 		decision.Patch = patch
 	}
 
+	if arrayMerge, ok := result["array_merge"].(map[string]any); ok {
+		decision.ArrayMerge = make(map[string]ArrayMergeOption, len(arrayMerge))
+		for path, value := range arrayMerge {
+			option := ArrayMergeOption{}
+			if spec, ok := value.(map[string]any); ok {
+				option.Strategy, _ = spec["strategy"].(string)
+				option.Key, _ = spec["key"].(string)
+			}
+			decision.ArrayMerge[path] = option
+		}
+	}
+
 	if constraints, ok := result["constraints"].(map[string]any); ok {
 		decision.Constraints = constraints
 	}
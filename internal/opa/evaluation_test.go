@@ -82,6 +82,44 @@ func TestParsePolicyDecision(t *testing.T) {
 				RejectionReason: "Security policy violation",
 			},
 		},
+		{
+			name: "rejection with code and params",
+			result: map[string]interface{}{
+				"rejected":         true,
+				"rejection_reason": "Region not allowed",
+				"rejection_code":   "region_not_allowed",
+				"rejection_params": map[string]interface{}{"region": "eu-west-1"},
+			},
+			expected: &PolicyDecision{
+				Rejected:        true,
+				RejectionReason: "Region not allowed",
+				RejectionCode:   "region_not_allowed",
+				RejectionParams: map[string]interface{}{"region": "eu-west-1"},
+			},
+		},
+		{
+			name: "patch with array merge options",
+			result: map[string]interface{}{
+				"patch": map[string]interface{}{
+					"tags": []interface{}{"managed"},
+				},
+				"array_merge": map[string]interface{}{
+					"tags":            map[string]interface{}{"strategy": "append"},
+					"network.volumes": map[string]interface{}{"strategy": "merge_by_key", "key": "name"},
+					"invalid":         "append",
+				},
+			},
+			expected: &PolicyDecision{
+				Patch: map[string]interface{}{
+					"tags": []interface{}{"managed"},
+				},
+				ArrayMerge: map[string]ArrayMergeOption{
+					"tags":            {Strategy: ArrayMergeAppend},
+					"network.volumes": {Strategy: ArrayMergeByKey, Key: "name"},
+					"invalid":         {},
+				},
+			},
+		},
 		{
 			name:   "empty result",
 			result: map[string]interface{}{},
@@ -112,6 +150,7 @@ func TestParsePolicyDecision(t *testing.T) {
 			assert.Equal(t, tt.expected.Rejected, decision.Rejected)
 			assert.Equal(t, tt.expected.RejectionReason, decision.RejectionReason)
 			assert.Equal(t, tt.expected.Patch, decision.Patch)
+			assert.Equal(t, tt.expected.ArrayMerge, decision.ArrayMerge)
 			assert.Equal(t, tt.expected.Constraints, decision.Constraints)
 			assert.Equal(t, tt.expected.SelectedProvider, decision.SelectedProvider)
 			if tt.expected.ServiceProviderConstraints != nil {
@@ -0,0 +1,112 @@
+package opa
+
+import (
+	"bytes"
+	"cmp"
+	"slices"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/format"
+)
+
+// LintCode identifies the kind of a lint issue
+type LintCode string
+
+const (
+	LintNotFormatted   LintCode = "NOT_FORMATTED"   // opa fmt would change the code
+	LintUnusedImport   LintCode = "UNUSED_IMPORT"   // an import is never referenced
+	LintUnusedVariable LintCode = "UNUSED_VARIABLE" // a local variable is assigned or declared but never used
+	LintUnusedArgument LintCode = "UNUSED_ARGUMENT" // a function argument is never used
+	LintStrict         LintCode = "STRICT"          // another construct the compiler's strict mode rejects
+)
+
+// LintIssue is a maintainability problem found in Rego code that compiles
+type LintIssue struct {
+	Code LintCode
+	RegoIssue
+}
+
+// lintFile is the file name lint issues are reported under
+const lintFile = "lint"
+
+// LintRego reports the problems of Rego code that are not errors: formatting that differs
+// from opa fmt other than in trailing newlines, and what the compiler's strict mode rejects,
+// such as unused imports, local variables and function arguments. Issues are ordered by
+// location. Code that does not parse returns an *InvalidRegoError; code that does not
+// compile on its own has no issues, since compile errors are reported when it is stored.
+func LintRego(regoCode string) ([]LintIssue, error) {
+	module, err := parseLintModule(regoCode)
+	if err != nil {
+		return nil, err
+	}
+	compiler := ast.NewCompiler()
+	if compiler.Compile(map[string]*ast.Module{lintFile: module}); compiler.Failed() {
+		return nil, nil
+	}
+
+	var issues []LintIssue
+	if formatted, err := format.SourceWithOpts(lintFile, []byte(regoCode), format.Opts{RegoVersion: ast.RegoV1}); err == nil && !bytes.Equal(bytes.TrimRight(formatted, "\n"), []byte(strings.TrimRight(regoCode, "\n"))) {
+		issues = append(issues, LintIssue{Code: LintNotFormatted, RegoIssue: RegoIssue{Message: "code is not formatted as opa fmt formats it"}})
+	}
+
+	// The strict compiler stops after the stage that found problems, and unused imports are
+	// found before unused variables; compile again without the unused imports to find both
+	unusedImports := map[int]bool{}
+	for range 2 {
+		module, err := parseLintModule(regoCode)
+		if err != nil {
+			return nil, err
+		}
+		module.Imports = slices.DeleteFunc(module.Imports, func(imp *ast.Import) bool {
+			return imp.Location != nil && unusedImports[imp.Location.Row]
+		})
+		compiler := ast.NewCompiler().WithStrict(true)
+		if compiler.Compile(map[string]*ast.Module{lintFile: module}); !compiler.Failed() {
+			break
+		}
+
+		found := false
+		for _, astErr := range compiler.Errors {
+			issue := LintIssue{Code: lintCode(astErr.Message), RegoIssue: RegoIssue{Message: astErr.Message}}
+			if astErr.Location != nil {
+				issue.Line = astErr.Location.Row
+				issue.Column = astErr.Location.Col
+			}
+			if issue.Code == LintUnusedImport {
+				unusedImports[issue.Line] = true
+				found = true
+			}
+			issues = append(issues, issue)
+		}
+		if !found {
+			break
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b LintIssue) int {
+		return cmp.Or(cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+	return issues, nil
+}
+
+func parseLintModule(regoCode string) (*ast.Module, error) {
+	module, err := ast.ParseModuleWithOpts(lintFile, regoCode, ast.ParserOptions{RegoVersion: ast.RegoV1})
+	if err != nil {
+		return nil, newInvalidRegoError(err)
+	}
+	return module, nil
+}
+
+// lintCode classifies a strict mode compile error by its message
+func lintCode(message string) LintCode {
+	switch {
+	case strings.HasPrefix(message, "import ") && strings.HasSuffix(message, " unused"):
+		return LintUnusedImport
+	case strings.HasPrefix(message, "unused argument "):
+		return LintUnusedArgument
+	case strings.HasSuffix(message, " unused") && strings.Contains(message, " var "):
+		return LintUnusedVariable
+	}
+	return LintStrict
+}
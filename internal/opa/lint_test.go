@@ -0,0 +1,58 @@
+package opa_test
+
+import (
+	"errors"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LintRego", func() {
+	It("reports nothing for formatted code without strict mode problems", func() {
+		issues, err := opa.LintRego("package clean\n\nmain := {\"rejected\": false}")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("reports unformatted code, unused imports, variables and arguments in order", func() {
+		regoCode := `package sloppy
+import data.lib.helpers
+main := {"rejected": false} if {
+	unused := input.spec.size
+	true
+}
+double(x, y) := x * 2
+`
+
+		issues, err := opa.LintRego(regoCode)
+
+		Expect(err).NotTo(HaveOccurred())
+		codes := make([]opa.LintCode, len(issues))
+		for i, issue := range issues {
+			codes[i] = issue.Code
+		}
+		Expect(codes).To(Equal([]opa.LintCode{
+			opa.LintNotFormatted, opa.LintUnusedImport, opa.LintUnusedVariable, opa.LintUnusedArgument,
+		}))
+		Expect(issues[1].Line).To(Equal(2))
+		Expect(issues[1].Message).To(Equal("import data.lib.helpers unused"))
+		Expect(issues[2].Line).To(Equal(4))
+		Expect(issues[3].Message).To(ContainSubstring("unused argument y"))
+	})
+
+	It("leaves compile errors to the compiler", func() {
+		issues, err := opa.LintRego("package broken\n\nmain := {\"rejected\": r} if {\n\tsome z\n\tr := true\n}")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issues).To(BeEmpty())
+	})
+
+	It("returns parse errors as InvalidRegoError", func() {
+		_, err := opa.LintRego("package broken\nmain := {")
+
+		var invalid *opa.InvalidRegoError
+		Expect(errors.As(err, &invalid)).To(BeTrue())
+	})
+})
@@ -0,0 +1,40 @@
+package opa
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+)
+
+// ModuleMetadata holds the package name and package-scoped METADATA annotations of a Rego module
+type ModuleMetadata struct {
+	Package     string // package path without the data. prefix, e.g. "authz.region"
+	Title       string
+	Description string
+	Custom      map[string]any
+}
+
+// ParseModuleMetadata parses the Rego module and returns its package name and package-scoped
+// METADATA annotations. A module without a package annotation yields empty annotations.
+func ParseModuleMetadata(filename, regoCode string) (*ModuleMetadata, error) {
+	module, err := ast.ParseModuleWithOpts(filename, regoCode, ast.ParserOptions{
+		RegoVersion:       ast.RegoV1,
+		ProcessAnnotation: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRego, err)
+	}
+
+	metadata := &ModuleMetadata{Package: strings.TrimPrefix(module.Package.Path.String(), "data.")}
+	for _, annotation := range module.Annotations {
+		if annotation.Scope != "package" {
+			continue
+		}
+		metadata.Title = annotation.Title
+		metadata.Description = annotation.Description
+		metadata.Custom = annotation.Custom
+		break
+	}
+	return metadata, nil
+}
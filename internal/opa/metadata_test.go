@@ -0,0 +1,46 @@
+package opa_test
+
+import (
+	"errors"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseModuleMetadata", func() {
+	It("returns the package-scoped annotations", func() {
+		regoCode := `# METADATA
+# title: Region policy
+# description: Enforces allowed regions
+# custom:
+#   priority: 100
+package authz.region
+
+main := {"rejected": false}
+`
+		metadata, err := opa.ParseModuleMetadata("authz/region.rego", regoCode)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.Package).To(Equal("authz.region"))
+		Expect(metadata.Title).To(Equal("Region policy"))
+		Expect(metadata.Description).To(Equal("Enforces allowed regions"))
+		Expect(metadata.Custom).To(HaveKeyWithValue("priority", BeNumerically("==", 100)))
+	})
+
+	It("ignores rule-scoped annotations", func() {
+		regoCode := `package authz.region
+
+# METADATA
+# title: Main rule
+main := {"rejected": false}
+`
+		metadata, err := opa.ParseModuleMetadata("authz/region.rego", regoCode)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.Title).To(BeEmpty())
+	})
+
+	It("returns ErrInvalidRego for syntax errors", func() {
+		_, err := opa.ParseModuleMetadata("broken.rego", "package broken\nmain := {")
+		Expect(errors.Is(err, opa.ErrInvalidRego)).To(BeTrue())
+	})
+})
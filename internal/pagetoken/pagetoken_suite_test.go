@@ -0,0 +1,13 @@
+package pagetoken
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPageToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Page Token Suite")
+}
@@ -0,0 +1,107 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+)
+
+var _ = Describe("Codec", func() {
+	const secret = "0123456789abcdef0123456789abcdef"
+
+	var (
+		codec *Codec
+		now   time.Time
+		scope string
+	)
+
+	BeforeEach(func() {
+		var err error
+		codec, err = NewCodec(config.PageTokenConfig{Secret: secret, TTL: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		now = time.Date(2026, 1, 9, 10, 0, 0, 0, time.UTC)
+		codec.now = func() time.Time { return now }
+		scope = Scope("policies", "enabled=true", "priority ASC")
+	})
+
+	It("round-trips the offset", func() {
+		offset, err := codec.Decode(codec.Encode(50, scope), scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(offset).To(Equal(50))
+	})
+
+	It("accepts tokens from another codec with the same secret", func() {
+		other, err := NewCodec(config.PageTokenConfig{Secret: secret, TTL: time.Hour})
+		Expect(err).NotTo(HaveOccurred())
+		other.now = codec.now
+
+		offset, err := other.Decode(codec.Encode(10, scope), scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(offset).To(Equal(10))
+	})
+
+	It("rejects a token with a forged offset", func() {
+		token := codec.Encode(50, scope)
+		encoded, signature, _ := strings.Cut(token, ".")
+		body, err := base64.RawURLEncoding.DecodeString(encoded)
+		Expect(err).NotTo(HaveOccurred())
+		forged := strings.Replace(string(body), `"o":50`, `"o":5000`, 1)
+
+		_, err = codec.Decode(base64.RawURLEncoding.EncodeToString([]byte(forged))+"."+signature, scope)
+		Expect(err).To(MatchError(ErrInvalid))
+	})
+
+	It("rejects the legacy base64 offset format", func() {
+		_, err := codec.Decode(base64.StdEncoding.EncodeToString([]byte("50")), scope)
+		Expect(err).To(MatchError(ErrInvalid))
+	})
+
+	It("rejects tokens signed with a different key", func() {
+		_, err := NewEphemeral(time.Hour).Decode(codec.Encode(50, scope), scope)
+		Expect(err).To(MatchError(ErrInvalid))
+	})
+
+	It("rejects expired tokens", func() {
+		token := codec.Encode(50, scope)
+		now = now.Add(time.Hour)
+
+		_, err := codec.Decode(token, scope)
+		Expect(err).To(MatchError(ErrExpired))
+	})
+
+	It("rejects tokens issued for a different scope", func() {
+		token := codec.Encode(50, scope)
+
+		_, err := codec.Decode(token, Scope("policies", "enabled=true", "priority DESC"))
+		Expect(err).To(MatchError(ErrScopeMismatch))
+	})
+
+	Describe("Scope", func() {
+		It("distinguishes how parameters are split", func() {
+			Expect(Scope("policies", "ab", "c")).NotTo(Equal(Scope("policies", "a", "bc")))
+		})
+	})
+
+	Describe("NewCodec", func() {
+		It("rejects a short secret", func() {
+			_, err := NewCodec(config.PageTokenConfig{Secret: "short", TTL: time.Hour})
+			Expect(err).To(MatchError(ContainSubstring("at least 32 bytes")))
+		})
+
+		It("rejects a non-positive TTL", func() {
+			_, err := NewCodec(config.PageTokenConfig{Secret: secret})
+			Expect(err).To(MatchError(ContainSubstring("TTL must be positive")))
+		})
+
+		It("generates a key when no secret is configured", func() {
+			generated, err := NewCodec(config.PageTokenConfig{TTL: time.Hour})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generated.key).To(HaveLen(minSecretLength))
+		})
+	})
+})
@@ -0,0 +1,166 @@
+// Package quota enforces per-tenant and per-service-type evaluation rate limits.
+package quota
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+const (
+	ScopeTenant      = "tenant"
+	ScopeServiceType = "service_type"
+
+	serviceTypeLabel = "service_type"
+	// maxIdleBuckets triggers eviction of buckets that have fully refilled
+	maxIdleBuckets = 10000
+)
+
+var exceededTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_quota_exceeded_total",
+	"Evaluations rejected because a tenant or service type exceeded its quota",
+	"scope", "key",
+)
+
+// Decision is the outcome of a quota check
+type Decision struct {
+	Allowed    bool
+	Scope      string // ScopeTenant or ScopeServiceType when not allowed
+	Key        string // tenant or service type that exceeded its quota
+	Limit      int    // evaluations per minute for Key
+	RetryAfter time.Duration
+}
+
+// Message describes a rejected decision
+func (d Decision) Message() string {
+	scope := "Tenant"
+	if d.Scope == ScopeServiceType {
+		scope = "Service type"
+	}
+	return fmt.Sprintf("%s '%s' exceeded its quota of %d evaluations per minute", scope, d.Key, d.Limit)
+}
+
+// Limiter enforces evaluations-per-minute quotas with a token bucket per tenant and per service type.
+// A zero limit disables the quota for that scope.
+type Limiter struct {
+	tenantLabel    string
+	perTenant      int
+	perServiceType int
+	overrides      map[string]int // "<scope>=<key>" -> limit
+	now            func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens   float64
+	limit    int
+	lastFill time.Time
+}
+
+// NewLimiter creates a Limiter from the quota configuration, or returns nil when no quota is configured
+func NewLimiter(cfg config.QuotaConfig) (*Limiter, error) {
+	overrides := make(map[string]int, len(cfg.Overrides))
+	for key, limit := range cfg.Overrides {
+		if limit < 0 {
+			return nil, fmt.Errorf("quota override for '%s' must not be negative", key)
+		}
+		overrides[key] = limit
+	}
+	if cfg.PerTenant < 0 || cfg.PerServiceType < 0 {
+		return nil, fmt.Errorf("evaluation quotas must not be negative")
+	}
+	if cfg.PerTenant == 0 && cfg.PerServiceType == 0 && len(overrides) == 0 {
+		return nil, nil
+	}
+	return &Limiter{
+		tenantLabel:    cfg.TenantLabel,
+		perTenant:      cfg.PerTenant,
+		perServiceType: cfg.PerServiceType,
+		overrides:      overrides,
+		now:            time.Now,
+		buckets:        map[string]*bucket{},
+	}, nil
+}
+
+// Allow consumes one evaluation from the tenant and service type buckets of the request labels.
+// Nothing is consumed when either quota is exhausted.
+func (l *Limiter) Allow(labels map[string]string) Decision {
+	type check struct {
+		scope, key string
+		limit      int
+	}
+	var checks []check
+	if tenant, ok := labels[l.tenantLabel]; ok && tenant != "" {
+		if limit := l.limitFor(ScopeTenant, tenant, l.perTenant); limit > 0 {
+			checks = append(checks, check{ScopeTenant, tenant, limit})
+		}
+	}
+	if serviceType, ok := labels[serviceTypeLabel]; ok && serviceType != "" {
+		if limit := l.limitFor(ScopeServiceType, serviceType, l.perServiceType); limit > 0 {
+			checks = append(checks, check{ScopeServiceType, serviceType, limit})
+		}
+	}
+	if len(checks) == 0 {
+		return Decision{Allowed: true}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	l.evictIdle(now)
+
+	buckets := make([]*bucket, len(checks))
+	for i, c := range checks {
+		b := l.bucket(c.scope+"="+c.key, c.limit, now)
+		if b.tokens < 1 {
+			exceededTotal.Inc(c.scope, c.key)
+			perToken := time.Minute / time.Duration(c.limit)
+			retryAfter := time.Duration(math.Ceil((1 - b.tokens) * float64(perToken)))
+			return Decision{Scope: c.scope, Key: c.key, Limit: c.limit, RetryAfter: retryAfter}
+		}
+		buckets[i] = b
+	}
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return Decision{Allowed: true}
+}
+
+func (l *Limiter) limitFor(scope, key string, defaultLimit int) int {
+	if limit, ok := l.overrides[scope+"="+key]; ok {
+		return limit
+	}
+	return defaultLimit
+}
+
+// bucket returns the refilled bucket for key; callers must hold l.mu
+func (l *Limiter) bucket(key string, limit int, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok || b.limit != limit {
+		b = &bucket{tokens: float64(limit), limit: limit, lastFill: now}
+		l.buckets[key] = b
+		return b
+	}
+	elapsed := now.Sub(b.lastFill)
+	b.tokens = math.Min(float64(limit), b.tokens+elapsed.Minutes()*float64(limit))
+	b.lastFill = now
+	return b
+}
+
+// evictIdle drops buckets that would be full again, bounding memory for many tenants; callers must hold l.mu
+func (l *Limiter) evictIdle(now time.Time) {
+	if len(l.buckets) < maxIdleBuckets {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.lastFill) >= time.Minute {
+			delete(l.buckets, key)
+		}
+	}
+}
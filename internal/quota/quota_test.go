@@ -0,0 +1,101 @@
+package quota
+
+import (
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		now     time.Time
+		limiter *Limiter
+	)
+
+	newLimiter := func(cfg config.QuotaConfig) *Limiter {
+		if cfg.TenantLabel == "" {
+			cfg.TenantLabel = "tenant"
+		}
+		l, err := NewLimiter(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).NotTo(BeNil())
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	It("returns nil when no quota is configured", func() {
+		l, err := NewLimiter(config.QuotaConfig{TenantLabel: "tenant"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).To(BeNil())
+	})
+
+	It("rejects negative limits", func() {
+		_, err := NewLimiter(config.QuotaConfig{PerTenant: -1})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("enforces the per-tenant limit and refills over time", func() {
+		limiter = newLimiter(config.QuotaConfig{PerTenant: 2})
+		labels := map[string]string{"tenant": "acme", "service_type": "vm"}
+
+		Expect(limiter.Allow(labels).Allowed).To(BeTrue())
+		Expect(limiter.Allow(labels).Allowed).To(BeTrue())
+
+		decision := limiter.Allow(labels)
+		Expect(decision.Allowed).To(BeFalse())
+		Expect(decision.Scope).To(Equal(ScopeTenant))
+		Expect(decision.Key).To(Equal("acme"))
+		Expect(decision.RetryAfter).To(Equal(30 * time.Second))
+		Expect(decision.Message()).To(Equal("Tenant 'acme' exceeded its quota of 2 evaluations per minute"))
+		Expect(exceededTotal.Value(ScopeTenant, "acme")).To(BeNumerically(">=", 1))
+
+		Expect(limiter.Allow(map[string]string{"tenant": "other"}).Allowed).To(BeTrue())
+
+		now = now.Add(30 * time.Second)
+		Expect(limiter.Allow(labels).Allowed).To(BeTrue())
+		Expect(limiter.Allow(labels).Allowed).To(BeFalse())
+	})
+
+	It("enforces the per-service-type limit without consuming the tenant quota on rejection", func() {
+		limiter = newLimiter(config.QuotaConfig{PerTenant: 2, PerServiceType: 1})
+
+		Expect(limiter.Allow(map[string]string{"tenant": "acme", "service_type": "vm"}).Allowed).To(BeTrue())
+
+		decision := limiter.Allow(map[string]string{"tenant": "acme", "service_type": "vm"})
+		Expect(decision.Allowed).To(BeFalse())
+		Expect(decision.Scope).To(Equal(ScopeServiceType))
+		Expect(decision.Message()).To(Equal("Service type 'vm' exceeded its quota of 1 evaluations per minute"))
+
+		Expect(limiter.Allow(map[string]string{"tenant": "acme", "service_type": "db"}).Allowed).To(BeTrue())
+	})
+
+	It("applies overrides, including disabling a quota", func() {
+		limiter = newLimiter(config.QuotaConfig{
+			PerTenant: 1,
+			Overrides: map[string]int{"tenant=big": 3, "tenant=internal": 0},
+		})
+
+		for range 3 {
+			Expect(limiter.Allow(map[string]string{"tenant": "big"}).Allowed).To(BeTrue())
+		}
+		Expect(limiter.Allow(map[string]string{"tenant": "big"}).Allowed).To(BeFalse())
+
+		for range 5 {
+			Expect(limiter.Allow(map[string]string{"tenant": "internal"}).Allowed).To(BeTrue())
+		}
+	})
+
+	It("allows requests without the tenant label", func() {
+		limiter = newLimiter(config.QuotaConfig{PerTenant: 1})
+
+		for range 3 {
+			Expect(limiter.Allow(map[string]string{"service_type": "vm"}).Allowed).To(BeTrue())
+		}
+	})
+})
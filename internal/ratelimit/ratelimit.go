@@ -0,0 +1,259 @@
+// Package ratelimit limits the request rate of every client of an API server with a token
+// bucket per client, so a runaway client cannot starve the others.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+const (
+	// ClientKeyIP tells clients apart by their address
+	ClientKeyIP = "ip"
+	// ClientKeyAPIKey tells clients apart by the identity of the key in their X-API-Key
+	// header, and those without a known key by their address
+	ClientKeyAPIKey = "api_key"
+
+	// maxIdleBuckets triggers eviction of buckets that have fully refilled, once the limiter
+	// has that many
+	maxIdleBuckets = 10000
+)
+
+var limitedTotal = metrics.NewCounterVec(
+	"policy_manager_rate_limited_requests_total",
+	"Requests refused because their client exceeded its request rate, by server: public or engine",
+	"server",
+)
+
+// APIKeyIdentifier returns the identity of an API key, or "" when it is not a known key
+type APIKeyIdentifier func(ctx context.Context, key string) (string, error)
+
+// Refuse writes the response to a request over its client's rate; the Retry-After header is
+// already set
+type Refuse func(w http.ResponseWriter, r *http.Request)
+
+// Limiter allows every client rate requests per second, and up to burst at once
+type Limiter struct {
+	server         string
+	clientIPHeader string
+	identifyAPIKey APIKeyIdentifier // nil unless clients are told apart by API key
+	now            func() time.Time
+
+	mu      sync.Mutex
+	rate    float64 // zero lets every request through
+	burst   float64
+	buckets map[string]*bucket
+	sweepAt int // bucket count at which a new client triggers evictIdle
+}
+
+type bucket struct {
+	tokens   float64
+	lastFill time.Time
+}
+
+// NewPublicLimiter creates the Limiter of the public API, or returns nil when its rate is not limited
+func NewPublicLimiter(cfg config.RateLimitConfig) (*Limiter, error) {
+	return newLimiter("public", "RATE_LIMIT_PUBLIC", cfg.PublicRPS, cfg.PublicBurst, cfg.ClientIPHeader, nil)
+}
+
+// NewEngineLimiter creates the Limiter of the engine API, or returns nil when its rate is not
+// limited. Telling clients apart by API key requires identify, which verifies the keys: the
+// limiter runs before requests are authorized, and unverified keys would let a client get a
+// fresh budget per made-up key.
+func NewEngineLimiter(cfg config.RateLimitConfig, identify APIKeyIdentifier) (*Limiter, error) {
+	switch cfg.EngineClientKey {
+	case ClientKeyIP:
+		identify = nil
+	case ClientKeyAPIKey:
+		if identify == nil {
+			return nil, errors.New("RATE_LIMIT_ENGINE_CLIENT_KEY=api_key requires ENGINE_AUTHZ_MODE=api_key, which verifies the keys")
+		}
+	default:
+		return nil, fmt.Errorf("RATE_LIMIT_ENGINE_CLIENT_KEY must be one of: %s, %s (got '%s')", ClientKeyIP, ClientKeyAPIKey, cfg.EngineClientKey)
+	}
+	return newLimiter("engine", "RATE_LIMIT_ENGINE", cfg.EngineRPS, cfg.EngineBurst, cfg.ClientIPHeader, identify)
+}
+
+func newLimiter(server, prefix string, rate float64, burst int, clientIPHeader string, identifyAPIKey APIKeyIdentifier) (*Limiter, error) {
+	burstSize, err := limits(prefix, rate, burst)
+	if err != nil {
+		return nil, err
+	}
+	if rate == 0 {
+		return nil, nil
+	}
+	return &Limiter{
+		server:         server,
+		rate:           rate,
+		burst:          burstSize,
+		clientIPHeader: clientIPHeader,
+		identifyAPIKey: identifyAPIKey,
+		now:            time.Now,
+		buckets:        map[string]*bucket{},
+		sweepAt:        maxIdleBuckets,
+	}, nil
+}
+
+// limits validates the rate and burst of the variables starting with prefix and returns the
+// burst size, which defaults to the rate rounded up
+func limits(prefix string, rate float64, burst int) (float64, error) {
+	if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return 0, fmt.Errorf("%s_RPS must be a non-negative number (got %g)", prefix, rate)
+	}
+	if burst < 0 {
+		return 0, fmt.Errorf("%s_BURST must not be negative (got %d)", prefix, burst)
+	}
+	if burst == 0 {
+		return math.Ceil(rate), nil
+	}
+	return float64(burst), nil
+}
+
+// PrepareReload validates the rate and burst cfg sets for the server of l and returns a
+// function applying them to l. A zero rate lets every request through until a later reload
+// sets a rate again. Buckets keep their tokens, capped at the new burst.
+func (l *Limiter) PrepareReload(cfg config.RateLimitConfig) (apply func(), err error) {
+	prefix, rate, burst := "RATE_LIMIT_PUBLIC", cfg.PublicRPS, cfg.PublicBurst
+	if l.server == "engine" {
+		prefix, rate, burst = "RATE_LIMIT_ENGINE", cfg.EngineRPS, cfg.EngineBurst
+	}
+	burstSize, err := limits(prefix, rate, burst)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.rate = rate
+		l.burst = burstSize
+		for _, b := range l.buckets {
+			b.tokens = math.Min(b.tokens, burstSize)
+		}
+	}, nil
+}
+
+// Middleware refuses the requests of clients over their rate with refuse, except requests
+// for the exempt paths
+func (l *Limiter) Middleware(refuse Refuse, exemptPaths ...string) func(http.Handler) http.Handler {
+	exempt := make(map[string]bool, len(exemptPaths))
+	for _, path := range exemptPaths {
+		exempt[path] = true
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if retryAfter, ok := l.allow(r); !ok {
+				limitedTotal.Inc(l.server)
+				w.Header().Set("Retry-After", strconv.Itoa(int((retryAfter+time.Second-1)/time.Second)))
+				refuse(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Allow consumes a request from the bucket of client, or returns how long until it allows one
+func (l *Limiter) Allow(client string) (retryAfter time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		return 0, true
+	}
+	now := l.now()
+	b, found := l.buckets[client]
+	if !found {
+		if len(l.buckets) >= l.sweepAt {
+			l.evictIdle(now)
+		}
+		b = &bucket{tokens: l.burst, lastFill: now}
+		l.buckets[client] = b
+	} else {
+		b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.lastFill).Seconds()*l.rate)
+		b.lastFill = now
+	}
+	if b.tokens < 1 {
+		return time.Duration(math.Ceil((1 - b.tokens) / l.rate * float64(time.Second))), false
+	}
+	b.tokens--
+	return 0, true
+}
+
+// allow consumes a request of the client of r: of the identity of its API key when clients
+// are told apart by API key and the key is known, of its address otherwise. The address is
+// charged before the key is verified, so made-up keys share the budget of their address and
+// an address over its rate gets no more key lookups; a verified key gets the token of its
+// address back.
+func (l *Limiter) allow(r *http.Request) (retryAfter time.Duration, ok bool) {
+	address := "ip:" + l.clientIP(r)
+	var key string
+	if l.identifyAPIKey != nil {
+		key = strings.TrimSpace(r.Header.Get("X-API-Key"))
+	}
+	if key == "" {
+		return l.Allow(address)
+	}
+	if retryAfter, ok := l.Allow(address); !ok {
+		return retryAfter, false
+	}
+	identity, err := l.identifyAPIKey(r.Context(), key)
+	if err != nil || identity == "" {
+		return 0, true
+	}
+	l.refund(address)
+	return l.Allow("api_key:" + identity)
+}
+
+// refund gives client back the request Allow consumed
+func (l *Limiter) refund(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, found := l.buckets[client]; found {
+		b.tokens = math.Min(l.burst, b.tokens+1)
+	}
+}
+
+// clientIP returns the last address of the client IP header, the one the trusted proxy in
+// front of the server added, or the address of the connection
+func (l *Limiter) clientIP(r *http.Request) string {
+	if l.clientIPHeader != "" {
+		if values := r.Header.Values(l.clientIPHeader); len(values) > 0 {
+			addresses := strings.Split(values[len(values)-1], ",")
+			if ip := strings.TrimSpace(addresses[len(addresses)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// evictIdle drops buckets that would be full again, bounding memory for many clients. The
+// next sweep waits until the remaining buckets have doubled, so the scans of many active
+// clients cost a constant per new client; callers must hold l.mu
+func (l *Limiter) evictIdle(now time.Time) {
+	refill := time.Duration(l.burst / l.rate * float64(time.Second))
+	for client, b := range l.buckets {
+		if now.Sub(b.lastFill) >= refill {
+			delete(l.buckets, client)
+		}
+	}
+	l.sweepAt = max(maxIdleBuckets, 2*len(l.buckets))
+}
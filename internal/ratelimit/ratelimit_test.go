@@ -0,0 +1,247 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		now     time.Time
+		lookups int
+	)
+
+	// identify knows the keys pmk_a and pmk_b, and fails for pmk_broken
+	identify := func(_ context.Context, key string) (string, error) {
+		lookups++
+		switch key {
+		case "pmk_a":
+			return "team-a", nil
+		case "pmk_b":
+			return "team-b", nil
+		case "pmk_broken":
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	}
+
+	newLimiter := func(cfg config.RateLimitConfig) *Limiter {
+		if cfg.EngineClientKey == "" {
+			cfg.EngineClientKey = ClientKeyIP
+		}
+		l, err := NewEngineLimiter(cfg, identify)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).NotTo(BeNil())
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		lookups = 0
+	})
+
+	It("returns nil when the rate is not limited", func() {
+		l, err := NewPublicLimiter(config.RateLimitConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).To(BeNil())
+		l, err = NewEngineLimiter(config.RateLimitConfig{EngineClientKey: ClientKeyIP}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(l).To(BeNil())
+	})
+
+	It("rejects invalid configurations", func() {
+		_, err := NewPublicLimiter(config.RateLimitConfig{PublicRPS: -1})
+		Expect(err).To(MatchError(ContainSubstring("RATE_LIMIT_PUBLIC_RPS")))
+		_, err = NewEngineLimiter(config.RateLimitConfig{EngineRPS: 1, EngineBurst: -1, EngineClientKey: ClientKeyIP}, nil)
+		Expect(err).To(MatchError(ContainSubstring("RATE_LIMIT_ENGINE_BURST")))
+		_, err = NewEngineLimiter(config.RateLimitConfig{EngineRPS: 1, EngineClientKey: "tenant"}, nil)
+		Expect(err).To(MatchError(ContainSubstring("ip, api_key")))
+		_, err = NewEngineLimiter(config.RateLimitConfig{EngineRPS: 1, EngineClientKey: ClientKeyAPIKey}, nil)
+		Expect(err).To(MatchError(ContainSubstring("ENGINE_AUTHZ_MODE=api_key")))
+	})
+
+	It("applies reloaded rates and bursts", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 1, EngineBurst: 5})
+		for range 3 {
+			_, ok := l.Allow("ip:10.0.0.1")
+			Expect(ok).To(BeTrue())
+		}
+
+		_, err := l.PrepareReload(config.RateLimitConfig{EngineRPS: -2})
+		Expect(err).To(MatchError(ContainSubstring("RATE_LIMIT_ENGINE_RPS")))
+		apply, err := l.PrepareReload(config.RateLimitConfig{EngineRPS: 4, EngineBurst: 1, PublicRPS: 100})
+		Expect(err).NotTo(HaveOccurred())
+		apply()
+
+		_, ok := l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeTrue())
+		retryAfter, ok := l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeFalse())
+		Expect(retryAfter).To(Equal(250 * time.Millisecond))
+
+		apply, err = l.PrepareReload(config.RateLimitConfig{})
+		Expect(err).NotTo(HaveOccurred())
+		apply()
+		_, ok = l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("allows a burst per client and refills at the rate", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 2, EngineBurst: 3})
+
+		for range 3 {
+			_, ok := l.Allow("ip:10.0.0.1")
+			Expect(ok).To(BeTrue())
+		}
+		retryAfter, ok := l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeFalse())
+		Expect(retryAfter).To(Equal(500 * time.Millisecond))
+
+		_, ok = l.Allow("ip:10.0.0.2")
+		Expect(ok).To(BeTrue())
+
+		now = now.Add(500 * time.Millisecond)
+		_, ok = l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeTrue())
+		_, ok = l.Allow("ip:10.0.0.1")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("defaults the burst to the rate rounded up", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 1.5})
+		Expect(l.burst).To(Equal(2.0))
+	})
+
+	request := func(remoteAddr, apiKey string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/policies:evaluateRequest", nil)
+		r.RemoteAddr = remoteAddr
+		if apiKey != "" {
+			r.Header.Set("X-API-Key", apiKey)
+		}
+		return r
+	}
+
+	// charged lists the clients whose buckets are not full
+	charged := func(l *Limiter) []string {
+		var clients []string
+		for client, b := range l.buckets {
+			if b.tokens < l.burst {
+				clients = append(clients, client)
+			}
+		}
+		return clients
+	}
+
+	It("tells clients apart by address, or by API key identity when configured", func() {
+		byIP := newLimiter(config.RateLimitConfig{EngineRPS: 1, EngineBurst: 10})
+		byIP.allow(request("10.0.0.1:5000", "pmk_a"))
+		byIP.allow(request("10.0.0.1:5001", "pmk_b"))
+		Expect(charged(byIP)).To(ConsistOf("ip:10.0.0.1"))
+		Expect(byIP.buckets["ip:10.0.0.1"].tokens).To(Equal(8.0))
+		Expect(lookups).To(BeZero())
+
+		byKey := newLimiter(config.RateLimitConfig{EngineRPS: 1, EngineBurst: 10, EngineClientKey: ClientKeyAPIKey})
+		byKey.allow(request("10.0.0.1:5000", "pmk_a"))
+		byKey.allow(request("10.0.0.2:5000", "pmk_a"))
+		byKey.allow(request("10.0.0.1:5000", "pmk_b"))
+		Expect(charged(byKey)).To(ConsistOf("api_key:team-a", "api_key:team-b"))
+		Expect(byKey.buckets["api_key:team-a"].tokens).To(Equal(8.0))
+
+		byKey.allow(request("10.0.0.1:5000", ""))
+		byKey.allow(request("10.0.0.1:5000", "pmk_unknown"))
+		byKey.allow(request("10.0.0.1:5000", "pmk_broken"))
+		Expect(charged(byKey)).To(ConsistOf("api_key:team-a", "api_key:team-b", "ip:10.0.0.1"))
+		Expect(byKey.buckets["ip:10.0.0.1"].tokens).To(Equal(7.0))
+	})
+
+	It("gives unknown API keys from one address a single limit", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 0.5, EngineBurst: 2, EngineClientKey: ClientKeyAPIKey})
+		handler := l.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		serve := func(apiKey string) int {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request("10.0.0.1:5000", apiKey))
+			return recorder.Code
+		}
+
+		Expect(serve("pmk_random_1")).To(Equal(http.StatusNoContent))
+		Expect(serve("pmk_random_2")).To(Equal(http.StatusNoContent))
+		for i := range 5 {
+			Expect(serve(fmt.Sprintf("pmk_random_%d", i+3))).To(Equal(http.StatusTooManyRequests))
+		}
+		Expect(serve("")).To(Equal(http.StatusTooManyRequests))
+		Expect(lookups).To(Equal(2), "keys from an address over its rate are not looked up")
+
+		now = now.Add(2 * time.Second)
+		Expect(serve("pmk_a")).To(Equal(http.StatusNoContent))
+		Expect(serve("pmk_a")).To(Equal(http.StatusNoContent))
+		Expect(serve("pmk_a")).To(Equal(http.StatusTooManyRequests))
+		Expect(serve("pmk_random_8")).To(Equal(http.StatusNoContent), "verified keys give the token of their address back")
+	})
+
+	It("evicts idle buckets once there are many, sweeping again only when they have doubled", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 1, EngineBurst: 1})
+		for i := range maxIdleBuckets {
+			l.Allow(fmt.Sprintf("ip:%d", i))
+		}
+		Expect(l.buckets).To(HaveLen(maxIdleBuckets))
+
+		l.Allow("ip:active")
+		Expect(l.buckets).To(HaveLen(maxIdleBuckets+1), "no bucket is idle yet")
+		Expect(l.sweepAt).To(Equal(2 * maxIdleBuckets))
+
+		now = now.Add(time.Second)
+		l.Allow("ip:late")
+		Expect(l.buckets).To(HaveLen(maxIdleBuckets+2), "the next sweep waits for the buckets to double")
+
+		l.sweepAt = len(l.buckets)
+		l.Allow("ip:new")
+		Expect(l.buckets).To(HaveLen(2))
+		Expect(l.buckets).To(HaveKey("ip:late"))
+		Expect(l.sweepAt).To(Equal(maxIdleBuckets))
+	})
+
+	It("takes the client address added last to the client IP header", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 1, ClientIPHeader: "X-Forwarded-For"})
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = "192.0.2.10:443"
+		Expect(l.clientIP(r)).To(Equal("192.0.2.10"))
+
+		r.Header.Add("X-Forwarded-For", "203.0.113.7, 198.51.100.1")
+		r.Header.Add("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+		Expect(l.clientIP(r)).To(Equal("10.0.0.2"))
+	})
+
+	It("refuses requests over the rate with a Retry-After header, except for exempt paths", func() {
+		l := newLimiter(config.RateLimitConfig{EngineRPS: 0.5, EngineBurst: 1})
+		handler := l.Middleware(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+		}, "/metrics")(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		serve := func(path string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+			return recorder
+		}
+
+		Expect(serve("/evaluate").Code).To(Equal(http.StatusNoContent))
+		refused := serve("/evaluate")
+		Expect(refused.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(refused.Header().Get("Retry-After")).To(Equal("2"))
+		Expect(limitedTotal.Value("engine")).To(BeNumerically(">=", 1))
+		Expect(serve("/metrics").Code).To(Equal(http.StatusNoContent))
+	})
+})
@@ -0,0 +1,169 @@
+// Package runtimeconfig reloads the settings that can change without a restart: the log
+// level, the rate limits, the per-policy evaluation timeout and the cache max age of the
+// public API. The configuration is loaded again on SIGHUP or on request, and applied only
+// when every reloaded setting is valid, so a bad edit changes nothing. Requests in flight
+// are not interrupted; they see the new settings from their next use of them.
+package runtimeconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
+)
+
+// Duration is a duration setting that can be changed while it is read. A nil Duration is zero.
+type Duration struct {
+	value atomic.Int64
+}
+
+// NewDuration creates a Duration set to d
+func NewDuration(d time.Duration) *Duration {
+	duration := &Duration{}
+	duration.Store(d)
+	return duration
+}
+
+// Load returns the current duration
+func (d *Duration) Load() time.Duration {
+	if d == nil {
+		return 0
+	}
+	return time.Duration(d.value.Load())
+}
+
+// Store sets the duration
+func (d *Duration) Store(value time.Duration) {
+	d.value.Store(int64(value))
+}
+
+// Tunable checks the settings it reloads in cfg and returns them, by environment variable
+// name, with a function applying them
+type Tunable func(cfg *config.Config) (settings map[string]string, apply func(), err error)
+
+// Reload is the outcome of a successful reload
+type Reload struct {
+	Time time.Time
+	// Settings are the reloaded settings, by environment variable name
+	Settings map[string]string
+}
+
+// Reloader loads the configuration again and applies its tunable settings
+type Reloader struct {
+	load     func() (*config.Config, error)
+	tunables []Tunable
+
+	mu sync.Mutex // serializes reloads
+}
+
+// NewReloader creates a Reloader loading the configuration with load and applying it to tunables
+func NewReloader(load func() (*config.Config, error), tunables ...Tunable) *Reloader {
+	return &Reloader{load: load, tunables: tunables}
+}
+
+// Reload loads the configuration and applies the settings of every tunable, or none of them
+// when the configuration cannot be loaded or any setting is invalid
+func (r *Reloader) Reload(ctx context.Context) (*Reload, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	settings := map[string]string{}
+	applies := make([]func(), 0, len(r.tunables))
+	for _, tunable := range r.tunables {
+		tunableSettings, apply, err := tunable(cfg)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(settings, tunableSettings)
+		applies = append(applies, apply)
+	}
+	for _, apply := range applies {
+		apply()
+	}
+	logging.FromContext(ctx).Info("Reloaded the runtime configuration", "settings", settings)
+	return &Reload{Time: time.Now().UTC(), Settings: settings}, nil
+}
+
+// Run reloads the configuration whenever a signal arrives on signals, until ctx is cancelled.
+// A failed reload is logged and keeps the current settings.
+func (r *Reloader) Run(ctx context.Context, signals <-chan os.Signal) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case sig := <-signals:
+			logging.FromContext(ctx).Info("Reloading the runtime configuration", "signal", sig.String())
+			if _, err := r.Reload(ctx); err != nil {
+				logging.FromContext(ctx).Error("Failed to reload the runtime configuration; keeping the current settings", "error", err)
+			}
+		}
+	}
+}
+
+// LogLevel reloads LOG_LEVEL into the default logger
+func LogLevel() Tunable {
+	return func(cfg *config.Config) (map[string]string, func(), error) {
+		level, err := logging.ParseLevel(cfg.Service.LogLevel)
+		if err != nil {
+			return nil, nil, fmt.Errorf("LOG_LEVEL: %w", err)
+		}
+		return map[string]string{"LOG_LEVEL": cfg.Service.LogLevel}, func() { logging.SetLevel(level) }, nil
+	}
+}
+
+// RateLimits reloads the rates and bursts of the public and engine API limiters. A limiter
+// is nil when its API was started without rate limiting, which takes a restart to enable.
+func RateLimits(public, engine *ratelimit.Limiter) Tunable {
+	return func(cfg *config.Config) (map[string]string, func(), error) {
+		settings := map[string]string{}
+		var applies []func()
+		for _, limiter := range []struct {
+			prefix  string
+			limiter *ratelimit.Limiter
+			rate    float64
+			burst   int
+		}{
+			{"RATE_LIMIT_PUBLIC", public, cfg.RateLimit.PublicRPS, cfg.RateLimit.PublicBurst},
+			{"RATE_LIMIT_ENGINE", engine, cfg.RateLimit.EngineRPS, cfg.RateLimit.EngineBurst},
+		} {
+			if limiter.limiter == nil {
+				if limiter.rate != 0 {
+					return nil, nil, fmt.Errorf("%s_RPS: the API was started without rate limiting; enabling it takes a restart", limiter.prefix)
+				}
+				continue
+			}
+			apply, err := limiter.limiter.PrepareReload(cfg.RateLimit)
+			if err != nil {
+				return nil, nil, err
+			}
+			settings[limiter.prefix+"_RPS"] = strconv.FormatFloat(limiter.rate, 'f', -1, 64)
+			settings[limiter.prefix+"_BURST"] = strconv.Itoa(limiter.burst)
+			applies = append(applies, apply)
+		}
+		return settings, func() {
+			for _, apply := range applies {
+				apply()
+			}
+		}, nil
+	}
+}
+
+// DurationSetting reloads the duration variable, read from cfg by value, into d
+func DurationSetting(variable string, d *Duration, value func(cfg *config.Config) time.Duration) Tunable {
+	return func(cfg *config.Config) (map[string]string, func(), error) {
+		reloaded := value(cfg)
+		return map[string]string{variable: reloaded.String()}, func() { d.Store(reloaded) }, nil
+	}
+}
@@ -0,0 +1,13 @@
+package runtimeconfig_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRuntimeConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RuntimeConfig Suite")
+}
@@ -0,0 +1,113 @@
+package runtimeconfig_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/ratelimit"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reloader", func() {
+	var (
+		ctx           context.Context
+		cfg           *config.Config
+		loadErr       error
+		policyTimeout *runtimeconfig.Duration
+		reloader      *runtimeconfig.Reloader
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cfg = &config.Config{
+			Service:    config.ServiceConfig{LogLevel: "info"},
+			Evaluation: config.EvaluationConfig{PolicyTimeout: time.Second},
+		}
+		loadErr = nil
+		policyTimeout = runtimeconfig.NewDuration(time.Second)
+		reloader = runtimeconfig.NewReloader(
+			func() (*config.Config, error) { return cfg, loadErr },
+			runtimeconfig.LogLevel(),
+			runtimeconfig.DurationSetting("EVALUATION_POLICY_TIMEOUT", policyTimeout, func(cfg *config.Config) time.Duration {
+				return cfg.Evaluation.PolicyTimeout
+			}),
+		)
+		DeferCleanup(logging.SetLevel, slog.LevelInfo)
+	})
+
+	It("applies the reloaded settings and returns them", func() {
+		cfg.Service.LogLevel = "debug"
+		cfg.Evaluation.PolicyTimeout = 250 * time.Millisecond
+
+		reload, err := reloader.Reload(ctx)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reload.Settings).To(Equal(map[string]string{
+			"LOG_LEVEL":                 "debug",
+			"EVALUATION_POLICY_TIMEOUT": "250ms",
+		}))
+		Expect(policyTimeout.Load()).To(Equal(250 * time.Millisecond))
+	})
+
+	It("applies nothing when a setting is invalid", func() {
+		cfg.Service.LogLevel = "verbose"
+		cfg.Evaluation.PolicyTimeout = 250 * time.Millisecond
+
+		_, err := reloader.Reload(ctx)
+
+		Expect(err).To(MatchError(ContainSubstring("LOG_LEVEL")))
+		Expect(policyTimeout.Load()).To(Equal(time.Second))
+	})
+
+	It("applies nothing when the configuration cannot be loaded", func() {
+		cfg.Evaluation.PolicyTimeout = 250 * time.Millisecond
+		loadErr = errors.New("invalid configuration")
+
+		_, err := reloader.Reload(ctx)
+
+		Expect(err).To(MatchError("invalid configuration"))
+		Expect(policyTimeout.Load()).To(Equal(time.Second))
+	})
+
+	It("reloads on every signal until its context is cancelled", func() {
+		runCtx, cancel := context.WithCancel(ctx)
+		signals := make(chan os.Signal)
+		done := make(chan error)
+		go func() { done <- reloader.Run(runCtx, signals) }()
+
+		cfg.Evaluation.PolicyTimeout = 2 * time.Second
+		signals <- syscall.SIGHUP
+		Eventually(policyTimeout.Load).Should(Equal(2 * time.Second))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	Describe("RateLimits", func() {
+		It("refuses to enable rate limiting for an API started without it", func() {
+			engine, err := ratelimit.NewEngineLimiter(config.RateLimitConfig{EngineRPS: 10, EngineClientKey: ratelimit.ClientKeyIP}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			tunable := runtimeconfig.RateLimits(nil, engine)
+
+			settings, _, err := tunable(&config.Config{RateLimit: config.RateLimitConfig{EngineRPS: 20, EngineBurst: 40}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings).To(Equal(map[string]string{"RATE_LIMIT_ENGINE_RPS": "20", "RATE_LIMIT_ENGINE_BURST": "40"}))
+
+			_, _, err = tunable(&config.Config{RateLimit: config.RateLimitConfig{PublicRPS: 5}})
+			Expect(err).To(MatchError(ContainSubstring("RATE_LIMIT_PUBLIC_RPS: the API was started without rate limiting")))
+		})
+	})
+
+	It("reads a nil Duration as zero", func() {
+		var unset *runtimeconfig.Duration
+		Expect(unset.Load()).To(BeZero())
+	})
+})
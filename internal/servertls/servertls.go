@@ -0,0 +1,76 @@
+// Package servertls builds the TLS configuration the public and engine API servers
+// terminate TLS with, so they can be served over HTTPS without a proxy in front of them.
+package servertls
+
+import (
+	"crypto/tls"
+	"fmt"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+)
+
+// tlsVersions are the values of TLS_MIN_VERSION; the empty string is 1.2
+var tlsVersions = map[string]uint16{
+	"":    tls.VersionTLS12,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// New returns the TLS configuration of the public API, or nil when TLS_CERT_FILE and
+// TLS_KEY_FILE are not set and it is served over plain HTTP. The minimum version and cipher
+// suites are checked either way, so a mistyped setting fails at startup.
+func New(cfg config.TLSConfig) (*tls.Config, error) {
+	if _, _, err := settings(cfg); err != nil {
+		return nil, err
+	}
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		return nil, nil
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	return WithCertificate(cfg, cfg.CertFile, cfg.KeyFile)
+}
+
+// WithCertificate returns a TLS configuration presenting the certificate in certFile and
+// keyFile, with the minimum version and cipher suites of cfg
+func WithCertificate(cfg config.TLSConfig, certFile, keyFile string) (*tls.Config, error) {
+	minVersion, cipherSuites, err := settings(cfg)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   minVersion,
+		CipherSuites: cipherSuites,
+	}, nil
+}
+
+// settings parses the minimum version and the cipher suites of cfg. Only the suites Go
+// considers secure can be selected; TLS 1.3 suites are not configurable.
+func settings(cfg config.TLSConfig) (uint16, []uint16, error) {
+	minVersion, ok := tlsVersions[cfg.MinVersion]
+	if !ok {
+		return 0, nil, fmt.Errorf("TLS_MIN_VERSION must be one of: 1.2, 1.3 (got '%s')", cfg.MinVersion)
+	}
+	if len(cfg.CipherSuites) == 0 {
+		return minVersion, nil, nil
+	}
+	secure := tls.CipherSuites()
+	cipherSuites := make([]uint16, 0, len(cfg.CipherSuites))
+	for _, name := range cfg.CipherSuites {
+		i := slices.IndexFunc(secure, func(suite *tls.CipherSuite) bool {
+			return suite.Name == name && slices.Contains(suite.SupportedVersions, tls.VersionTLS12)
+		})
+		if i < 0 {
+			return 0, nil, fmt.Errorf("TLS_CIPHER_SUITES contains '%s', which is not a secure TLS 1.2 cipher suite", name)
+		}
+		cipherSuites = append(cipherSuites, secure[i].ID)
+	}
+	return minVersion, cipherSuites, nil
+}
@@ -0,0 +1,13 @@
+package servertls_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServerTLS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Server TLS Suite")
+}
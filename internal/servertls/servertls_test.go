@@ -0,0 +1,113 @@
+package servertls_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/servertls"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("New", func() {
+	var certFile, keyFile string
+
+	BeforeEach(func() {
+		dir := GinkgoT().TempDir()
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "policy-manager"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			IPAddresses:  []net.IP{net.IPv6loopback, net.IPv4(127, 0, 0, 1)},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		certFile = filepath.Join(dir, "tls.crt")
+		keyFile = filepath.Join(dir, "tls.key")
+		Expect(os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)).To(Succeed())
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)).To(Succeed())
+	})
+
+	It("serves plain HTTP when no certificate is configured", func() {
+		tlsConfig, err := servertls.New(config.TLSConfig{MinVersion: "1.2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig).To(BeNil())
+	})
+
+	It("serves HTTPS with the configured minimum version and cipher suites", func() {
+		tlsConfig, err := servertls.New(config.TLSConfig{
+			CertFile:     certFile,
+			KeyFile:      keyFile,
+			MinVersion:   "1.2",
+			CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(tlsConfig.CipherSuites).To(Equal([]uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}))
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		server.TLS = tlsConfig
+		server.StartTLS()
+		defer server.Close()
+
+		pemCert, err := os.ReadFile(certFile)
+		Expect(err).NotTo(HaveOccurred())
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM(pemCert)).To(BeTrue())
+		get := func(clientConfig *tls.Config) (*http.Response, error) {
+			clientConfig.RootCAs = roots
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+			return client.Get(server.URL)
+		}
+
+		response, err := get(&tls.Config{MaxVersion: tls.VersionTLS12})
+		Expect(err).NotTo(HaveOccurred())
+		response.Body.Close()
+		Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+		Expect(response.TLS.CipherSuite).To(Equal(tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384))
+
+		_, err = get(&tls.Config{MaxVersion: tls.VersionTLS12, CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("raises the minimum version to TLS 1.3", func() {
+		tlsConfig, err := servertls.New(config.TLSConfig{CertFile: certFile, KeyFile: keyFile, MinVersion: "1.3"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+	})
+
+	It("rejects invalid configurations, even without a certificate", func() {
+		_, err := servertls.New(config.TLSConfig{CertFile: certFile})
+		Expect(err).To(MatchError(ContainSubstring("must be set together")))
+
+		_, err = servertls.New(config.TLSConfig{MinVersion: "1.0"})
+		Expect(err).To(MatchError(ContainSubstring("TLS_MIN_VERSION must be one of")))
+
+		_, err = servertls.New(config.TLSConfig{CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"}})
+		Expect(err).To(MatchError(ContainSubstring("not a secure TLS 1.2 cipher suite")))
+
+		_, err = servertls.New(config.TLSConfig{CertFile: certFile, KeyFile: certFile})
+		Expect(err).To(MatchError(ContainSubstring("failed to load TLS certificate")))
+	})
+})
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+)
+
+// ApplyPolicy replaces the mutable fields of policy id with the given resource (AEP-137 Apply).
+// Like create, the resource must be complete: omitted optional fields are reset to their
+// defaults rather than kept, except that a priority picked by a priority strategy other than
+// fixed is kept. When the policy does not exist it is created if allowMissing
+// is set, and reported as NotFound otherwise. The returned bool is true when the policy was created.
+func (s *PolicyServiceImpl) ApplyPolicy(ctx context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("Applying policy", "policy_id", id, "allow_missing", allowMissing)
+
+	if err := validatePostInput(policy); err != nil {
+		return nil, false, err
+	}
+	if policy.Id != nil && *policy.Id != id {
+		return nil, false, NewInvalidArgumentError(
+			"id does not match the policy path",
+			fmt.Sprintf("The id field '%s' must match the policy ID '%s' in the request path", *policy.Id, id),
+		)
+	}
+
+	existingDB, err := s.store.Policy().Get(ctx, id)
+	if err != nil && !errors.Is(err, store.ErrPolicyNotFound) {
+		log.Error("Failed to get existing policy for apply", "policy_id", id, "error", err)
+		return nil, false, NewInternalError("Failed to get existing policy", err.Error(), err)
+	}
+
+	if existingDB == nil {
+		if !allowMissing {
+			return nil, false, NewPolicyNotFoundError(id)
+		}
+		created, err := s.CreatePolicy(ctx, policy, &id)
+		if err == nil {
+			return created, true, nil
+		}
+		// A concurrent apply may have created the policy first; replace it instead.
+		// Other conflicts (display name, priority) leave the policy missing and are returned as is.
+		var serviceErr *ServiceError
+		if !errors.As(err, &serviceErr) || serviceErr.Type != ErrorTypeAlreadyExists {
+			return nil, false, err
+		}
+		var getErr error
+		existingDB, getErr = s.store.Policy().Get(ctx, id)
+		if getErr != nil {
+			return nil, false, err
+		}
+	}
+
+	existing := DBToAPIModel(existingDB)
+	if err := validatePatchImmutableFields(&policy, existing); err != nil {
+		return nil, false, err
+	}
+	replaced := replacePolicy(s.defaults.fill(policy, s.defaults.keptPriority(existingDB.Priority)), existing)
+	regoChanged := *replaced.RegoCode != existingDB.RegoCode
+
+	updated, err := s.commitUpdate(ctx, existingDB, replaced, regoChanged)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, false, nil
+}
+
+// replacePolicy takes every mutable field from policy and the read-only and immutable fields from existing
+func replacePolicy(policy v1alpha1.Policy, existing v1alpha1.Policy) v1alpha1.Policy {
+	return v1alpha1.Policy{
+		Id:                existing.Id,
+		Path:              existing.Path,
+		PolicyType:        existing.PolicyType,
+		CreateTime:        existing.CreateTime,
+		UpdateTime:        existing.UpdateTime,
+		CreatedBy:         existing.CreatedBy,
+		UpdatedBy:         existing.UpdatedBy,
+		DisplayName:       policy.DisplayName,
+		Description:       policy.Description,
+		Enabled:           policy.Enabled,
+		LabelSelector:     policy.LabelSelector,
+		Priority:          policy.Priority,
+		RegoCode:          policy.RegoCode,
+		RejectionMessages: policy.RejectionMessages,
+		Annotations:       policy.Annotations,
+		Parameters:        policy.Parameters,
+		Documentation:     policy.Documentation,
+	}
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
+)
+
+// resolveArrayMerges returns a copy of patch in which each array at a field path with an
+// append or merge_by_key option is replaced by the result of merging it into the array at the
+// same path in base. Merging the returned patch with RFC 7396 semantics then applies the
+// options, and constraints are validated against the arrays the spec will actually contain.
+// Paths the patch does not set, or sets to something other than an array, are left alone.
+func resolveArrayMerges(base, patch map[string]any, options map[string]opa.ArrayMergeOption) (map[string]any, error) {
+	if len(options) == 0 {
+		return patch, nil
+	}
+	for _, path := range slices.Sorted(maps.Keys(options)) {
+		if err := validateArrayMergeOption(path, options[path]); err != nil {
+			return nil, err
+		}
+	}
+
+	resolved, err := deep.Copy(patch)
+	if err != nil {
+		return nil, err
+	}
+	for path, option := range options {
+		if option.Strategy == opa.ArrayMergeReplace {
+			continue
+		}
+		parent, key, ok := arrayMergeParent(resolved, path)
+		if !ok {
+			continue
+		}
+		patchItems, ok := parent[key].([]any)
+		if !ok {
+			continue
+		}
+		baseItems, _ := fieldValue(base, path).([]any)
+
+		switch option.Strategy {
+		case opa.ArrayMergeAppend:
+			parent[key] = append(slices.Clone(baseItems), patchItems...)
+		case opa.ArrayMergeByKey:
+			merged, err := mergeArrayByKey(baseItems, patchItems, option.Key)
+			if err != nil {
+				return nil, fmt.Errorf("array_merge for '%s': %w", path, err)
+			}
+			parent[key] = merged
+		}
+	}
+	return resolved, nil
+}
+
+func validateArrayMergeOption(path string, option opa.ArrayMergeOption) error {
+	if path == "" || slices.Contains(strings.Split(path, "."), "") {
+		return fmt.Errorf("array_merge field path '%s' is not a dot-separated field path", path)
+	}
+	switch option.Strategy {
+	case opa.ArrayMergeReplace, opa.ArrayMergeAppend:
+		return nil
+	case opa.ArrayMergeByKey:
+		if option.Key == "" {
+			return fmt.Errorf("array_merge for '%s': merge_by_key requires a key", path)
+		}
+		return nil
+	}
+	return fmt.Errorf("array_merge for '%s': strategy must be one of: %s, %s, %s (got '%s')",
+		path, opa.ArrayMergeReplace, opa.ArrayMergeAppend, opa.ArrayMergeByKey, option.Strategy)
+}
+
+// arrayMergeParent returns the patch object holding the last segment of path
+func arrayMergeParent(patch map[string]any, path string) (map[string]any, string, bool) {
+	segments := strings.Split(path, ".")
+	current := patch
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, "", false
+		}
+		current = next
+	}
+	return current, segments[len(segments)-1], true
+}
+
+// fieldValue returns the value at the dot-separated field path in spec, or nil when the field
+// or one of its parents is missing or a parent is not an object
+func fieldValue(spec map[string]any, path string) any {
+	var current any = spec
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+		current = object[segment]
+	}
+	return current
+}
+
+// mergeArrayByKey merges each patch item into the base item with the same key value using
+// RFC 7396 semantics, appending items whose key value is new. Base item order is kept.
+func mergeArrayByKey(base, patch []any, key string) ([]any, error) {
+	result := slices.Clone(base)
+	positions := make(map[string]int, len(result))
+	for i, item := range result {
+		if value, ok := arrayItemKey(item, key); ok {
+			positions[value] = i
+		}
+	}
+
+	for i, item := range patch {
+		value, ok := arrayItemKey(item, key)
+		if !ok {
+			return nil, fmt.Errorf("patch item %d has no scalar '%s' field", i, key)
+		}
+		position, exists := positions[value]
+		if !exists {
+			positions[value] = len(result)
+			result = append(result, item)
+			continue
+		}
+		baseItem, _ := result[position].(map[string]any)
+		merged, err := specmerge.Merge(baseItem, item.(map[string]any))
+		if err != nil {
+			return nil, err
+		}
+		result[position] = merged
+	}
+	return result, nil
+}
+
+// arrayItemKey returns the key field of an object item. Request numbers decode as float64
+// and policy output numbers as json.Number, so numbers are compared by their printed form.
+func arrayItemKey(item any, key string) (string, bool) {
+	object, ok := item.(map[string]any)
+	if !ok {
+		return "", false
+	}
+	switch value := object[key].(type) {
+	case string:
+		return "string:" + value, true
+	case float64, json.Number:
+		return fmt.Sprintf("number:%v", value), true
+	case bool:
+		return fmt.Sprintf("bool:%t", value), true
+	}
+	return "", false
+}
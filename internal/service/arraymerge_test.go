@@ -0,0 +1,117 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Test suite is registered in other test files - don't register again
+
+var _ = Describe("resolveArrayMerges", func() {
+	It("returns the patch unchanged without options", func() {
+		patch := map[string]any{"tags": []any{"a"}}
+
+		resolved, err := resolveArrayMerges(map[string]any{"tags": []any{"b"}}, patch, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).To(Equal(patch))
+	})
+
+	It("appends patch items to the base array", func() {
+		base := map[string]any{"tags": []any{"a", "b"}}
+		patch := map[string]any{"tags": []any{"c"}}
+
+		resolved, err := resolveArrayMerges(base, patch, map[string]opa.ArrayMergeOption{
+			"tags": {Strategy: opa.ArrayMergeAppend},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).To(Equal(map[string]any{"tags": []any{"a", "b", "c"}}))
+		Expect(patch).To(Equal(map[string]any{"tags": []any{"c"}}), "the policy's patch must not be modified")
+		Expect(base).To(Equal(map[string]any{"tags": []any{"a", "b"}}))
+	})
+
+	It("merges items with the same key and appends new ones", func() {
+		base := map[string]any{"disks": []any{
+			map[string]any{"name": "root", "size": 20.0, "type": "ssd"},
+			map[string]any{"name": "data", "size": 100.0},
+		}}
+		patch := map[string]any{"disks": []any{
+			map[string]any{"name": "root", "size": 50.0, "type": nil},
+			map[string]any{"name": "logs", "size": 10.0},
+		}}
+
+		resolved, err := resolveArrayMerges(base, patch, map[string]opa.ArrayMergeOption{
+			"disks": {Strategy: opa.ArrayMergeByKey, Key: "name"},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["disks"]).To(Equal([]any{
+			map[string]any{"name": "root", "size": 50.0},
+			map[string]any{"name": "data", "size": 100.0},
+			map[string]any{"name": "logs", "size": 10.0},
+		}))
+	})
+
+	It("matches numeric keys from the request and from policy output", func() {
+		base := map[string]any{"rules": []any{map[string]any{"port": 443.0, "open": false}}}
+		patch := map[string]any{"rules": []any{map[string]any{"port": json.Number("443"), "open": true}}}
+
+		resolved, err := resolveArrayMerges(base, patch, map[string]opa.ArrayMergeOption{
+			"rules": {Strategy: opa.ArrayMergeByKey, Key: "port"},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved["rules"]).To(HaveLen(1))
+	})
+
+	It("resolves nested paths and uses the patch array when the base has none", func() {
+		patch := map[string]any{"network": map[string]any{"rules": []any{"allow-ssh"}}}
+
+		resolved, err := resolveArrayMerges(map[string]any{}, patch, map[string]opa.ArrayMergeOption{
+			"network.rules": {Strategy: opa.ArrayMergeAppend},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).To(Equal(patch))
+	})
+
+	It("leaves paths the patch does not set as arrays alone", func() {
+		base := map[string]any{"tags": []any{"a"}}
+		patch := map[string]any{"tags": nil, "region": "eu"}
+
+		resolved, err := resolveArrayMerges(base, patch, map[string]opa.ArrayMergeOption{
+			"tags":   {Strategy: opa.ArrayMergeAppend},
+			"zones":  {Strategy: opa.ArrayMergeAppend},
+			"region": {Strategy: opa.ArrayMergeReplace},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolved).To(Equal(patch))
+	})
+
+	It("rejects invalid options", func() {
+		for path, option := range map[string]opa.ArrayMergeOption{
+			"tags":   {Strategy: "union"},
+			"disks":  {Strategy: opa.ArrayMergeByKey},
+			"a..b":   {Strategy: opa.ArrayMergeAppend},
+			"":       {Strategy: opa.ArrayMergeAppend},
+			"labels": {},
+		} {
+			_, err := resolveArrayMerges(map[string]any{}, map[string]any{}, map[string]opa.ArrayMergeOption{path: option})
+
+			Expect(err).To(HaveOccurred(), "path %q", path)
+		}
+	})
+
+	It("rejects merge_by_key patch items without the key", func() {
+		_, err := resolveArrayMerges(map[string]any{}, map[string]any{"disks": []any{"root"}}, map[string]opa.ArrayMergeOption{
+			"disks": {Strategy: opa.ArrayMergeByKey, Key: "name"},
+		})
+
+		Expect(err).To(MatchError(ContainSubstring("patch item 0 has no scalar 'name' field")))
+	})
+})
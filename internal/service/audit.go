@@ -0,0 +1,610 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// auditAppendAttempts bounds retries when another writer appends the same sequence
+const auditAppendAttempts = 5
+
+// auditVerifyPageSize is the number of entries read per page while verifying the chain
+const auditVerifyPageSize = 1000
+
+var auditRecordFailuresTotal = metrics.NewCounterVec(
+	"policy_manager_audit_record_failures_total",
+	"Events that could not be recorded in the audit log",
+	"event",
+)
+
+// AuditService exposes the hash-chained audit log.
+type AuditService interface {
+	ListAuditEntries(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.AuditEntryList, error)
+	VerifyAuditLog(ctx context.Context) (*v1alpha1.AuditVerification, error)
+	ScrubAuditEntries(ctx context.Context, req v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error)
+}
+
+// AuditServiceImpl records audit entries and implements the AuditService interface.
+// Each entry's hash covers its contents and the previous entry's hash, so changing,
+// removing or reordering an entry invalidates every entry after it.
+type AuditServiceImpl struct {
+	store    store.Audit
+	policies store.Policy // scrubbed along with the audit entries of a user
+	key      []byte
+	tokens   *pagetoken.Codec
+	pages    PageSizeLimits
+	now      func() time.Time
+
+	mu sync.Mutex // serializes appends from this process; other writers are caught by the sequence key
+}
+
+var _ AuditService = (*AuditServiceImpl)(nil)
+
+// AuditOption configures optional behavior of the audit service.
+type AuditOption func(*AuditServiceImpl)
+
+// WithAuditSigningKey signs entry hashes with HMAC-SHA256 under key. Without a key the
+// hashes are plain SHA-256, which anyone able to write the database can recompute.
+func WithAuditSigningKey(key []byte) AuditOption {
+	return func(s *AuditServiceImpl) {
+		s.key = key
+	}
+}
+
+// WithAuditPageTokens signs list page tokens with codec instead of a per-process random key.
+func WithAuditPageTokens(codec *pagetoken.Codec) AuditOption {
+	return func(s *AuditServiceImpl) {
+		s.tokens = codec
+	}
+}
+
+// WithAuditPageSizeLimits sets the default and maximum page size of list requests.
+func WithAuditPageSizeLimits(limits PageSizeLimits) AuditOption {
+	return func(s *AuditServiceImpl) {
+		s.pages = limits
+	}
+}
+
+// NewAuditService creates a new AuditService instance. Scrubbing a user also scrubs their
+// principals from the policies and revisions of policyStore.
+func NewAuditService(auditStore store.Audit, policyStore store.Policy, opts ...AuditOption) *AuditServiceImpl {
+	s := &AuditServiceImpl{
+		store:    auditStore,
+		policies: policyStore,
+		tokens:   pagetoken.NewEphemeral(pagetoken.DefaultTTL),
+		pages:    DefaultPageSizeLimits,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// RecordEvents records every policy change and evaluation outcome delivered by bus, an event
+// bus or a queue in front of one. Failures are logged and counted; they do not affect the
+// operation that published the event.
+func (s *AuditServiceImpl) RecordEvents(bus events.Subscriber) (unsubscribe func()) {
+	return bus.Subscribe(func(ctx context.Context, event events.Event) {
+		if err := s.Record(ctx, event); err != nil {
+			auditRecordFailuresTotal.Inc(event.EventType())
+			logging.FromContext(ctx).Error("Failed to record audit entry", "event", event.EventType(), "error", err)
+		}
+	})
+}
+
+// Record appends an entry for event. Events that are not audited are ignored.
+func (s *AuditServiceImpl) Record(ctx context.Context, event events.Event) error {
+	subject, details, ok := auditDetails(event)
+	if !ok {
+		return nil
+	}
+	return s.appendEntry(ctx, event.EventType(), subject, details)
+}
+
+func (s *AuditServiceImpl) appendEntry(ctx context.Context, entryType, subject string, details map[string]any) error {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("encoding audit data: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range auditAppendAttempts {
+		last, err := s.store.Last(ctx)
+		if err != nil {
+			return err
+		}
+		entry := model.AuditEntry{
+			Sequence:   1,
+			Time:       s.now().UTC().Truncate(time.Microsecond), // the precision every supported database keeps
+			Type:       entryType,
+			Subject:    subject,
+			Data:       string(data),
+			DataDigest: digestAuditData(string(data)),
+		}
+		if last != nil {
+			entry.Sequence = last.Sequence + 1
+			entry.PrevHash = last.Hash
+		}
+		entry.Hash = s.hashEntry(&entry)
+
+		_, err = s.store.Append(ctx, entry)
+		if !errors.Is(err, store.ErrAuditSequenceTaken) {
+			return err
+		}
+	}
+	return fmt.Errorf("audit log is being appended concurrently; gave up after %d attempts", auditAppendAttempts)
+}
+
+// auditDetails returns the subject and details recorded for event
+func auditDetails(event events.Event) (string, map[string]any, bool) {
+	switch e := event.(type) {
+	case events.PolicyCreated:
+		return policyIDOf(e.Policy), map[string]any{"policy": e.Policy}, true
+	case events.PolicyUpdated:
+		return policyIDOf(e.Policy), map[string]any{"previous": e.Previous, "policy": e.Policy}, true
+	case events.PolicyDeleted:
+		return e.PolicyID, map[string]any{"policy_id": e.PolicyID}, true
+	case events.EvaluationRejected:
+		data := map[string]any{
+			"policy_id":      e.PolicyID,
+			"reason":         e.Reason,
+			"request_labels": e.RequestLabels,
+		}
+		if e.RequestContext != nil {
+			data["request_context"] = e.RequestContext
+		}
+		return e.PolicyID, data, true
+	case events.EvaluationCompleted:
+		data := map[string]any{
+			"status":             e.Status,
+			"selected_provider":  e.SelectedProvider,
+			"policies_evaluated": e.PoliciesEvaluated,
+			"request_labels":     e.RequestLabels,
+		}
+		if e.RequestContext != nil {
+			data["request_context"] = e.RequestContext
+		}
+		if e.FailedOpen {
+			data["failed_open"] = true
+		}
+		return "", data, true
+	}
+	return "", nil, false
+}
+
+func policyIDOf(policy v1alpha1.Policy) string {
+	if policy.Id == nil {
+		return ""
+	}
+	return *policy.Id
+}
+
+func digestAuditData(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashEntry computes the chain hash over the entry fields and the previous hash.
+// Fields are length-prefixed so no two distinct entries share an input.
+func (s *AuditServiceImpl) hashEntry(entry *model.AuditEntry) string {
+	var h hash.Hash
+	if len(s.key) > 0 {
+		h = hmac.New(sha256.New, s.key)
+	} else {
+		h = sha256.New()
+	}
+	for _, field := range []string{
+		strconv.FormatInt(entry.Sequence, 10),
+		entry.Time.UTC().Format(time.RFC3339Nano),
+		entry.Type,
+		entry.Subject,
+		entry.DataDigest,
+		entry.PrevHash,
+	} {
+		fmt.Fprintf(h, "%d:%s\n", len(field), field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ListAuditEntries lists audit entries in sequence order.
+func (s *AuditServiceImpl) ListAuditEntries(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.AuditEntryList, error) {
+	size, err := s.pages.parsePageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	scope := pagetoken.Scope("audit")
+	offset, err := decodePageToken(s.tokens, pageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.store.List(ctx, &store.AuditListOptions{Offset: offset, PageSize: size})
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list audit entries from store", "error", err)
+		return nil, NewInternalError("Failed to list audit entries", err.Error(), err)
+	}
+
+	entries := make([]v1alpha1.AuditEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i], err = auditEntryToAPIModel(entry)
+		if err != nil {
+			return nil, NewInternalError("Failed to decode audit entry", err.Error(), err)
+		}
+	}
+	response := &v1alpha1.AuditEntryList{Entries: entries, PageSize: int32(size)}
+	if result.NextOffset > 0 {
+		nextPageToken := s.tokens.Encode(result.NextOffset, scope)
+		response.NextPageToken = &nextPageToken
+	}
+	return response, nil
+}
+
+func auditEntryToAPIModel(entry model.AuditEntry) (v1alpha1.AuditEntry, error) {
+	var data map[string]any
+	if err := json.Unmarshal([]byte(entry.Data), &data); err != nil {
+		return v1alpha1.AuditEntry{}, fmt.Errorf("audit entry %d: %w", entry.Sequence, err)
+	}
+	return v1alpha1.AuditEntry{
+		Sequence:   entry.Sequence,
+		Time:       entry.Time,
+		Type:       entry.Type,
+		Subject:    entry.Subject,
+		Data:       data,
+		DataDigest: entry.DataDigest,
+		PrevHash:   entry.PrevHash,
+		Hash:       entry.Hash,
+		Redacted:   entry.Redacted,
+	}, nil
+}
+
+// VerifyAuditLog walks the chain from the first entry and reports the first entry that does not verify.
+func (s *AuditServiceImpl) VerifyAuditLog(ctx context.Context) (*v1alpha1.AuditVerification, error) {
+	scrubs, err := s.scrubbedDigests(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list audit scrubs from store", "error", err)
+		return nil, NewInternalError("Failed to verify audit log", err.Error(), err)
+	}
+
+	result := &v1alpha1.AuditVerification{Valid: true}
+	prevHash := ""
+	offset := 0
+	for {
+		page, err := s.store.List(ctx, &store.AuditListOptions{Offset: offset, PageSize: auditVerifyPageSize})
+		if err != nil {
+			logging.FromContext(ctx).Error("Failed to list audit entries from store", "error", err)
+			return nil, NewInternalError("Failed to verify audit log", err.Error(), err)
+		}
+		for _, entry := range page.Entries {
+			if reason := s.verifyEntry(&entry, result.EntriesVerified+1, prevHash, scrubs); reason != "" {
+				sequence := entry.Sequence
+				result.Valid = false
+				result.FirstInvalidSequence = &sequence
+				result.Reason = &reason
+				return result, nil
+			}
+			result.EntriesVerified++
+			prevHash = entry.Hash
+		}
+		if page.NextOffset == 0 {
+			break
+		}
+		offset = page.NextOffset
+	}
+	if prevHash != "" {
+		result.HeadHash = &prevHash
+	}
+	return result, nil
+}
+
+// verifyEntry returns why entry does not continue the chain ending in prevHash, or "" when it
+// does. The details of a redacted entry are checked against the digest scrubs recorded for it.
+func (s *AuditServiceImpl) verifyEntry(entry *model.AuditEntry, expectedSequence int64, prevHash string, scrubs map[int64]string) string {
+	scrubbedDigest, scrubbed := scrubs[entry.Sequence]
+	switch {
+	case entry.Sequence != expectedSequence:
+		return fmt.Sprintf("expected sequence %d; an entry was removed", expectedSequence)
+	case entry.PrevHash != prevHash:
+		return "previous hash does not match the preceding entry"
+	case entry.Redacted && !scrubbed:
+		return "entry is redacted but no later scrub lists it"
+	case entry.Redacted && digestAuditData(entry.Data) != scrubbedDigest:
+		return "redacted data does not match the digest recorded by its scrub"
+	case !entry.Redacted && digestAuditData(entry.Data) != entry.DataDigest:
+		return "data does not match its digest"
+	case !hmac.Equal([]byte(s.hashEntry(entry)), []byte(entry.Hash)):
+		return "hash does not match entry contents"
+	}
+	return ""
+}
+
+// scrubbedDigests returns the digest of the scrubbed details of every entry listed by a
+// scrub, by sequence, as recorded by the last scrub listing it. Scrub entries are verified
+// with the rest of the chain, so one that was changed fails verification itself.
+func (s *AuditServiceImpl) scrubbedDigests(ctx context.Context) (map[int64]string, error) {
+	scrubs := map[int64]string{}
+	offset := 0
+	for {
+		page, err := s.store.List(ctx, &store.AuditListOptions{
+			Types:    []string{auditScrubbedType},
+			Offset:   offset,
+			PageSize: auditVerifyPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range page.Entries {
+			var details struct {
+				Digests map[string]string `json:"digests"`
+			}
+			if err := json.Unmarshal([]byte(entry.Data), &details); err != nil {
+				continue
+			}
+			for key, digest := range details.Digests {
+				// Only earlier entries can have been scrubbed by this one
+				if sequence, err := strconv.ParseInt(key, 10, 64); err == nil && sequence < entry.Sequence {
+					scrubs[sequence] = digest
+				}
+			}
+		}
+		if page.NextOffset == 0 {
+			return scrubs, nil
+		}
+		offset = page.NextOffset
+	}
+}
+
+// auditScrubbedType is the type of the entry recording a scrub
+const auditScrubbedType = "AuditEntriesScrubbed"
+
+// redactedValue replaces scrubbed request label values and principals
+const redactedValue = "[REDACTED]"
+
+// auditSubject identifies the data subject of a scrub by a request label or a user
+type auditSubject struct {
+	labelKey, labelValue string
+	user                 string
+}
+
+// scrubbedEntry is an entry matching a scrub, with its scrubbed details
+type scrubbedEntry struct {
+	sequence int64
+	data     string
+}
+
+// ScrubAuditEntries removes the details of a data subject from the evaluation entries whose
+// request labels contain the given label or whose requester is the given user, and from the
+// policy entries, policies and revisions the user created or updated. The chain stays
+// verifiable because entry hashes cover the digest of the original details, and the
+// AuditEntriesScrubbed entry recording the scrub covers the digests of the scrubbed ones.
+func (s *AuditServiceImpl) ScrubAuditEntries(ctx context.Context, req v1alpha1.AuditScrubRequest) (*v1alpha1.AuditScrubResult, error) {
+	log := logging.FromContext(ctx)
+
+	subject, err := parseAuditSubject(req)
+	if err != nil {
+		return nil, err
+	}
+	mode := v1alpha1.AuditScrubRedact
+	if req.Mode != nil {
+		mode = *req.Mode
+	}
+	if !mode.Valid() {
+		return nil, NewInvalidArgumentError(
+			"Invalid mode",
+			fmt.Sprintf("mode must be one of: REDACT, DELETE (got '%s')", mode),
+		)
+	}
+	dryRun := req.DryRun != nil && *req.DryRun
+
+	matched, err := s.matchScrub(ctx, subject, mode)
+	if err != nil {
+		log.Error("Failed to scrub audit entries", "error", err)
+		return nil, NewInternalError("Failed to scrub audit entries", err.Error(), err)
+	}
+	var principals store.PrincipalCounts
+	if subject.user != "" {
+		principals, err = s.policies.CountPrincipal(ctx, subject.user)
+		if err != nil {
+			log.Error("Failed to count the policies of a scrubbed user", "error", err)
+			return nil, NewInternalError("Failed to scrub audit entries", err.Error(), err)
+		}
+	}
+
+	result := &v1alpha1.AuditScrubResult{
+		Mode:              v1alpha1.AuditScrubResultMode(mode),
+		DryRun:            dryRun,
+		AffectedEntries:   int64(len(matched)),
+		Sequences:         make([]int64, len(matched)),
+		AffectedPolicies:  principals.Policies,
+		AffectedRevisions: principals.Revisions,
+	}
+	digests := make(map[string]string, len(matched))
+	for i, entry := range matched {
+		result.Sequences[i] = entry.sequence
+		digests[strconv.FormatInt(entry.sequence, 10)] = digestAuditData(entry.data)
+	}
+
+	if !dryRun && (len(matched) > 0 || principals != store.PrincipalCounts{}) {
+		// The scrub is recorded first, so every redacted entry is listed by a later entry.
+		// Neither the label value nor the user is recorded: they are the personal data removed.
+		details := map[string]any{
+			"mode":      mode,
+			"sequences": result.Sequences,
+			"digests":   digests,
+			"policies":  principals.Policies,
+			"revisions": principals.Revisions,
+		}
+		if subject.user != "" {
+			details["subject"] = "user"
+		} else {
+			details["subject"] = "label"
+			details["label_key"] = subject.labelKey
+		}
+		if err := s.appendEntry(ctx, auditScrubbedType, "", details); err != nil {
+			log.Error("Failed to record audit scrub", "error", err)
+			return nil, NewInternalError("Failed to record audit scrub", err.Error(), err)
+		}
+		for _, entry := range matched {
+			if err := s.store.Redact(ctx, entry.sequence, entry.data); err != nil {
+				log.Error("Failed to scrub audit entry", "sequence", entry.sequence, "error", err)
+				return nil, NewInternalError("Failed to scrub audit entries", err.Error(), err)
+			}
+		}
+		if subject.user != "" {
+			if principals, err = s.policies.RedactPrincipal(ctx, subject.user, redactedValue); err != nil {
+				log.Error("Failed to scrub the policies of a user", "error", err)
+				return nil, NewInternalError("Failed to scrub policies", err.Error(), err)
+			}
+			result.AffectedPolicies, result.AffectedRevisions = principals.Policies, principals.Revisions
+		}
+	}
+	log.Info("Audit entries scrubbed", "mode", mode, "label_key", subject.labelKey, "affected_entries", result.AffectedEntries,
+		"affected_policies", result.AffectedPolicies, "affected_revisions", result.AffectedRevisions, "dry_run", dryRun)
+	return result, nil
+}
+
+// parseAuditSubject returns the data subject of req, which names either a label or a user
+func parseAuditSubject(req v1alpha1.AuditScrubRequest) (auditSubject, error) {
+	var subject auditSubject
+	if req.LabelKey != nil {
+		subject.labelKey = *req.LabelKey
+	}
+	if req.LabelValue != nil {
+		subject.labelValue = *req.LabelValue
+	}
+	if req.User != nil {
+		subject.user = *req.User
+	}
+	byLabel := subject.labelKey != "" || subject.labelValue != ""
+	switch {
+	case byLabel && subject.user != "":
+		return auditSubject{}, NewInvalidArgumentError(
+			"Either a label or a user is required",
+			"Identify the data subject by label_key and label_value, or by user, not both",
+		)
+	case byLabel && (subject.labelKey == "" || subject.labelValue == ""):
+		return auditSubject{}, NewInvalidArgumentError(
+			"label_key and label_value are required",
+			"Both the label key and value identifying the data subject must be non-empty",
+		)
+	case !byLabel && subject.user == "":
+		return auditSubject{}, NewInvalidArgumentError(
+			"Either a label or a user is required",
+			"Identify the data subject by label_key and label_value, or by user",
+		)
+	}
+	return subject, nil
+}
+
+// matchScrub returns the entries matching subject, in sequence order, with their details
+// scrubbed in mode
+func (s *AuditServiceImpl) matchScrub(ctx context.Context, subject auditSubject, mode v1alpha1.AuditScrubRequestMode) ([]scrubbedEntry, error) {
+	types := []string{events.EvaluationCompleted{}.EventType(), events.EvaluationRejected{}.EventType()}
+	if subject.user != "" {
+		types = append(types, events.PolicyCreated{}.EventType(), events.PolicyUpdated{}.EventType())
+	}
+	var matched []scrubbedEntry
+	offset := 0
+	for {
+		page, err := s.store.List(ctx, &store.AuditListOptions{
+			Types:    types,
+			Offset:   offset,
+			PageSize: auditVerifyPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range page.Entries {
+			scrubbed, ok, err := scrubAuditData(entry.Type, entry.Data, subject, mode)
+			if err != nil {
+				return nil, fmt.Errorf("audit entry %d: %w", entry.Sequence, err)
+			}
+			if ok {
+				matched = append(matched, scrubbedEntry{sequence: entry.Sequence, data: scrubbed})
+			}
+		}
+		if page.NextOffset == 0 {
+			return matched, nil
+		}
+		offset = page.NextOffset
+	}
+}
+
+// scrubAuditData returns the scrubbed details of an entry of entryType and whether they
+// concern subject: evaluation entries whose request labels contain the subject's label or
+// whose requester is the subject's user, and policy entries naming the user as a principal.
+// Entries scrubbed before no longer match.
+func scrubAuditData(entryType, data string, subject auditSubject, mode v1alpha1.AuditScrubRequestMode) (string, bool, error) {
+	var details map[string]any
+	if err := json.Unmarshal([]byte(data), &details); err != nil {
+		return "", false, err
+	}
+
+	var matched bool
+	switch entryType {
+	case events.PolicyCreated{}.EventType(), events.PolicyUpdated{}.EventType():
+		for _, field := range []string{"policy", "previous"} {
+			if policy, ok := details[field].(map[string]any); ok && redactPrincipals(policy, subject.user) {
+				matched = true
+			}
+		}
+	default:
+		labels, _ := details["request_labels"].(map[string]any)
+		requestContext, _ := details["request_context"].(map[string]any)
+		if subject.user != "" {
+			matched = requestContext["requester"] == subject.user
+		} else {
+			matched = labels[subject.labelKey] == subject.labelValue
+		}
+		for label := range labels {
+			labels[label] = redactedValue
+		}
+		// The request context may identify the data subject as well
+		for field := range requestContext {
+			requestContext[field] = redactedValue
+		}
+	}
+	if !matched {
+		return "", false, nil
+	}
+
+	if mode == v1alpha1.AuditScrubDelete {
+		return "{}", true, nil
+	}
+	scrubbed, err := json.Marshal(details)
+	if err != nil {
+		return "", false, err
+	}
+	return string(scrubbed), true, nil
+}
+
+// redactPrincipals replaces user as the created_by and updated_by principal of the recorded
+// policy and reports whether it did
+func redactPrincipals(policy map[string]any, user string) bool {
+	var redacted bool
+	for _, field := range []string{"created_by", "updated_by"} {
+		if user != "" && policy[field] == user {
+			policy[field] = redactedValue
+			redacted = true
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,357 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("AuditService", func() {
+	var (
+		db            *gorm.DB
+		dataStore     store.Store
+		bus           *events.Bus
+		auditService  *service.AuditServiceImpl
+		policyService service.PolicyService
+		ctx           context.Context
+	)
+
+	signingKey := []byte("audit-signing-key")
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.AuditEntry{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+
+		dataStore = store.NewStore(db)
+		bus = events.NewBus()
+		auditService = service.NewAuditService(dataStore.Audit(), dataStore.Policy(), service.WithAuditSigningKey(signingKey))
+		auditService.RecordEvents(bus)
+		policyService = service.NewPolicyService(dataStore, opa.NewEngine(), service.WithPolicyEvents(bus))
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	createPolicies := func(ids ...string) {
+		for i, id := range ids {
+			priority := int32(100 + i)
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr(id),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+				Priority:    &priority,
+			}, &id)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	It("should chain entries for published events", func() {
+		createPolicies("audit-a")
+		Expect(policyService.DeletePolicy(ctx, "audit-a")).To(Succeed())
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED", RequestLabels: map[string]string{"tenant": "team-a"}})
+
+		list, err := auditService.ListAuditEntries(ctx, nil, nil)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Entries).To(HaveLen(3))
+		Expect(list.Entries[0].Type).To(Equal("PolicyCreated"))
+		Expect(list.Entries[0].Subject).To(Equal("audit-a"))
+		Expect(list.Entries[0].PrevHash).To(BeEmpty())
+		Expect(list.Entries[1].Type).To(Equal("PolicyDeleted"))
+		Expect(list.Entries[1].PrevHash).To(Equal(list.Entries[0].Hash))
+		Expect(list.Entries[2].Type).To(Equal("EvaluationCompleted"))
+		Expect(list.Entries[2].Data).To(HaveKeyWithValue("request_labels", map[string]any{"tenant": "team-a"}))
+		Expect(list.Entries[2].PrevHash).To(Equal(list.Entries[1].Hash))
+	})
+
+	It("should record the request context of evaluations", func() {
+		bus.Publish(ctx, events.EvaluationRejected{
+			PolicyID:       "no-legacy",
+			Reason:         "legacy mode is reserved",
+			RequestContext: map[string]string{"source_system": "portal"},
+		})
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED"})
+
+		list, err := auditService.ListAuditEntries(ctx, nil, nil)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Entries).To(HaveLen(2))
+		Expect(list.Entries[0].Data).To(HaveKeyWithValue("request_context", map[string]any{"source_system": "portal"}))
+		Expect(list.Entries[1].Data).NotTo(HaveKey("request_context"))
+	})
+
+	It("should page through entries", func() {
+		createPolicies("audit-a", "audit-b", "audit-c")
+		pageSize := int32(2)
+
+		first, err := auditService.ListAuditEntries(ctx, nil, &pageSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(first.Entries).To(HaveLen(2))
+		Expect(first.NextPageToken).NotTo(BeNil())
+
+		second, err := auditService.ListAuditEntries(ctx, first.NextPageToken, &pageSize)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.Entries).To(HaveLen(1))
+		Expect(second.Entries[0].Sequence).To(Equal(int64(3)))
+		Expect(second.NextPageToken).To(BeNil())
+	})
+
+	Describe("VerifyAuditLog", func() {
+		It("should report an empty log as valid", func() {
+			result, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.EntriesVerified).To(BeZero())
+			Expect(result.HeadHash).To(BeNil())
+		})
+
+		It("should verify an untouched log", func() {
+			createPolicies("audit-a", "audit-b")
+
+			result, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeTrue())
+			Expect(result.EntriesVerified).To(Equal(int64(2)))
+			Expect(result.HeadHash).NotTo(BeNil())
+		})
+
+		It("should detect changed entry data", func() {
+			createPolicies("audit-a", "audit-b")
+			Expect(db.Model(&model.AuditEntry{}).Where("sequence = ?", 1).
+				Update("data", `{"policy":{"id":"forged"}}`).Error).To(Succeed())
+
+			result, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(*result.FirstInvalidSequence).To(Equal(int64(1)))
+			Expect(*result.Reason).To(ContainSubstring("digest"))
+		})
+
+		It("should detect changed entry fields", func() {
+			createPolicies("audit-a")
+			Expect(db.Model(&model.AuditEntry{}).Where("sequence = ?", 1).
+				Update("subject", "forged").Error).To(Succeed())
+
+			result, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(*result.Reason).To(ContainSubstring("hash does not match"))
+		})
+
+		It("should detect a removed entry", func() {
+			createPolicies("audit-a", "audit-b", "audit-c")
+			Expect(db.Where("sequence = ?", 2).Delete(&model.AuditEntry{}).Error).To(Succeed())
+
+			result, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(result.EntriesVerified).To(Equal(int64(1)))
+			Expect(*result.FirstInvalidSequence).To(Equal(int64(3)))
+		})
+
+		It("should reject entries signed with a different key", func() {
+			createPolicies("audit-a")
+
+			other := service.NewAuditService(dataStore.Audit(), dataStore.Policy(), service.WithAuditSigningKey([]byte("other-key")))
+			result, err := other.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Valid).To(BeFalse())
+			Expect(*result.FirstInvalidSequence).To(Equal(int64(1)))
+		})
+	})
+
+	Describe("ScrubAuditEntries", func() {
+		evaluate := func(labels map[string]string) {
+			bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED", RequestLabels: labels})
+		}
+
+		BeforeEach(func() {
+			evaluate(map[string]string{"tenant": "acme", "user": "jdoe"})
+			evaluate(map[string]string{"tenant": "other"})
+			bus.Publish(ctx, events.EvaluationRejected{
+				PolicyID:       "deny",
+				Reason:         "no",
+				RequestLabels:  map[string]string{"tenant": "acme"},
+				RequestContext: map[string]string{"requester": "jdoe@acme.example"},
+			})
+		})
+
+		scrub := func(mode v1alpha1.AuditScrubRequestMode, dryRun bool) *v1alpha1.AuditScrubResult {
+			result, err := auditService.ScrubAuditEntries(ctx, v1alpha1.AuditScrubRequest{
+				LabelKey:   strPtr("tenant"),
+				LabelValue: strPtr("acme"),
+				Mode:       &mode,
+				DryRun:     &dryRun,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return result
+		}
+
+		It("should redact label values and keep the chain verifiable", func() {
+			result := scrub(v1alpha1.AuditScrubRedact, false)
+
+			Expect(result.AffectedEntries).To(Equal(int64(2)))
+			Expect(result.Sequences).To(Equal([]int64{1, 3}))
+
+			list, err := auditService.ListAuditEntries(ctx, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Entries).To(HaveLen(4))
+			Expect(list.Entries[0].Redacted).To(BeTrue())
+			Expect(list.Entries[0].Data).To(HaveKeyWithValue("request_labels", map[string]any{"tenant": "[REDACTED]", "user": "[REDACTED]"}))
+			Expect(list.Entries[0].Data).To(HaveKeyWithValue("status", "APPROVED"))
+			Expect(list.Entries[1].Redacted).To(BeFalse())
+			Expect(list.Entries[2].Data).To(HaveKeyWithValue("request_context", map[string]any{"requester": "[REDACTED]"}))
+			Expect(list.Entries[3].Type).To(Equal("AuditEntriesScrubbed"))
+			Expect(list.Entries[3].Data).NotTo(ContainElement("acme"))
+			Expect(list.Entries[3].Data).To(HaveKeyWithValue("subject", "label"))
+			Expect(list.Entries[3].Data["digests"]).To(HaveLen(2))
+
+			verification, err := auditService.VerifyAuditLog(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verification.Valid).To(BeTrue())
+			Expect(verification.EntriesVerified).To(Equal(int64(4)))
+		})
+
+		It("should delete the details of matching entries", func() {
+			scrub(v1alpha1.AuditScrubDelete, false)
+
+			list, err := auditService.ListAuditEntries(ctx, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Entries[0].Data).To(BeEmpty())
+			Expect(list.Entries[2].Data).To(BeEmpty())
+
+			verification, err := auditService.VerifyAuditLog(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verification.Valid).To(BeTrue())
+		})
+
+		It("should only report matching entries in a dry run", func() {
+			result := scrub(v1alpha1.AuditScrubRedact, true)
+
+			Expect(result.DryRun).To(BeTrue())
+			Expect(result.Sequences).To(Equal([]int64{1, 3}))
+
+			list, err := auditService.ListAuditEntries(ctx, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Entries).To(HaveLen(3))
+			Expect(list.Entries[0].Redacted).To(BeFalse())
+		})
+
+		It("should not match entries scrubbed before", func() {
+			scrub(v1alpha1.AuditScrubRedact, false)
+
+			result := scrub(v1alpha1.AuditScrubRedact, false)
+			Expect(result.AffectedEntries).To(BeZero())
+		})
+
+		It("should reject an empty label", func() {
+			_, err := auditService.ScrubAuditEntries(ctx, v1alpha1.AuditScrubRequest{LabelKey: strPtr("tenant")})
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should require either a label or a user", func() {
+			for _, req := range []v1alpha1.AuditScrubRequest{
+				{},
+				{LabelKey: strPtr("tenant"), LabelValue: strPtr("acme"), User: strPtr("jdoe@acme.example")},
+			} {
+				_, err := auditService.ScrubAuditEntries(ctx, req)
+
+				serviceErr, ok := err.(*service.ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			}
+		})
+
+		It("should scrub a user's evaluations, policy entries, policies and revisions", func() {
+			userCtx := service.ContextWithPrincipal(ctx, "jdoe@acme.example")
+			_, err := policyService.CreatePolicy(userCtx, v1alpha1.Policy{
+				DisplayName: strPtr("Owned"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package owned"),
+			}, strPtr("owned"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = policyService.UpdatePolicy(service.ContextWithPrincipal(ctx, "other"), "owned", &v1alpha1.Policy{DisplayName: strPtr("Renamed")})
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := auditService.ScrubAuditEntries(ctx, v1alpha1.AuditScrubRequest{User: strPtr("jdoe@acme.example")})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Sequences).To(Equal([]int64{3, 4, 5}))
+			Expect(result.AffectedPolicies).To(Equal(int64(1)))
+			Expect(result.AffectedRevisions).To(Equal(int64(2)))
+
+			list, err := auditService.ListAuditEntries(ctx, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Entries[2].Data).To(HaveKeyWithValue("request_context", map[string]any{"requester": "[REDACTED]"}))
+			Expect(list.Entries[3].Data["policy"]).To(HaveKeyWithValue("created_by", "[REDACTED]"))
+			Expect(list.Entries[4].Data["previous"]).To(HaveKeyWithValue("created_by", "[REDACTED]"))
+			Expect(list.Entries[4].Data["policy"]).To(HaveKeyWithValue("updated_by", "other"))
+			Expect(list.Entries[5].Type).To(Equal("AuditEntriesScrubbed"))
+			Expect(list.Entries[5].Data).To(HaveKeyWithValue("subject", "user"))
+			Expect(list.Entries[5].Data).NotTo(ContainElement("jdoe@acme.example"))
+
+			policy, err := policyService.GetPolicy(ctx, "owned")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*policy.CreatedBy).To(Equal("[REDACTED]"))
+			Expect(*policy.UpdatedBy).To(Equal("other"))
+			revision, err := policyService.GetPolicyRevision(ctx, "owned", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*revision.Policy.CreatedBy).To(Equal("[REDACTED]"))
+			Expect(*revision.Policy.UpdatedBy).To(Equal("[REDACTED]"))
+
+			verification, err := auditService.VerifyAuditLog(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verification.Valid).To(BeTrue())
+		})
+
+		It("should reject a redacted entry that no scrub lists", func() {
+			Expect(dataStore.Audit().Redact(ctx, 2, `{"status":"APPROVED"}`)).To(Succeed())
+
+			verification, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verification.Valid).To(BeFalse())
+			Expect(*verification.FirstInvalidSequence).To(Equal(int64(2)))
+			Expect(*verification.Reason).To(ContainSubstring("no later scrub lists it"))
+		})
+
+		It("should reject a scrubbed entry changed after its scrub", func() {
+			scrub(v1alpha1.AuditScrubRedact, false)
+			Expect(dataStore.Audit().Redact(ctx, 1, `{"status":"REJECTED"}`)).To(Succeed())
+
+			verification, err := auditService.VerifyAuditLog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verification.Valid).To(BeFalse())
+			Expect(*verification.FirstInvalidSequence).To(Equal(int64(1)))
+			Expect(*verification.Reason).To(ContainSubstring("recorded by its scrub"))
+		})
+	})
+})
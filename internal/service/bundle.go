@@ -0,0 +1,415 @@
+package service
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// maxBundleSize bounds the uncompressed size of an uploaded bundle or ConfigMap dump
+	maxBundleSize = 32 << 20
+	// maxBundleFileSize bounds a single file; matches the rego_code maxLength in the API
+	maxBundleFileSize = 65536
+)
+
+// BundleFormat identifies how an uploaded policy archive is encoded
+type BundleFormat string
+
+const (
+	BundleFormatOPABundle BundleFormat = "OPA_BUNDLE"
+	BundleFormatConfigMap BundleFormat = "CONFIGMAP"
+)
+
+// MetadataConvention identifies where imported policy fields are read from
+type MetadataConvention string
+
+const (
+	MetadataConventionAnnotations MetadataConvention = "ANNOTATIONS"
+	MetadataConventionSidecar     MetadataConvention = "SIDECAR"
+	MetadataConventionNone        MetadataConvention = "NONE"
+)
+
+// BundleImportOptions controls how archive files are mapped to policies
+type BundleImportOptions struct {
+	Format             BundleFormat
+	MetadataConvention MetadataConvention
+	DefaultPolicyType  v1alpha1.PolicyPolicyType
+	IDPrefix           string
+}
+
+// bundleFile is a file extracted from an uploaded bundle or ConfigMap dump
+type bundleFile struct {
+	path    string
+	content string
+}
+
+var idSeparatorPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ImportBundle creates one policy per Rego file in an OPA bundle or ConfigMap dump.
+// Files are imported independently; failures are reported per file rather than aborting the import.
+func (s *PolicyServiceImpl) ImportBundle(ctx context.Context, archive io.Reader, opts BundleImportOptions) (*v1alpha1.BundleImportResult, error) {
+	log := logging.FromContext(ctx)
+
+	var files []bundleFile
+	var err error
+	switch opts.Format {
+	case BundleFormatOPABundle, "":
+		files, err = readOPABundle(archive)
+	case BundleFormatConfigMap:
+		files, err = readConfigMapDump(archive)
+	default:
+		return nil, NewInvalidArgumentError("Invalid bundle format", fmt.Sprintf("Unsupported bundle format '%s'", opts.Format))
+	}
+	if err != nil {
+		return nil, NewInvalidArgumentError("Invalid bundle", err.Error())
+	}
+	if opts.MetadataConvention == "" {
+		opts.MetadataConvention = MetadataConventionAnnotations
+	}
+	if opts.DefaultPolicyType == "" {
+		opts.DefaultPolicyType = v1alpha1.GLOBAL
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	byPath := make(map[string]string, len(files))
+	for _, f := range files {
+		byPath[f.path] = f.content
+	}
+
+	log.Debug("Importing policy bundle", "format", opts.Format, "file_count", len(files))
+
+	results := make([]v1alpha1.BundleImportItem, 0, len(files))
+	for _, f := range files {
+		item := v1alpha1.BundleImportItem{FilePath: f.path}
+		if reason := skipReason(f.path, opts.MetadataConvention); reason != "" {
+			item.Status = v1alpha1.SKIPPED
+			item.Detail = &reason
+			results = append(results, item)
+			continue
+		}
+
+		policy, policyID, err := policyFromBundleFile(f, byPath, opts)
+		if policyID != "" {
+			item.PolicyId = &policyID
+		}
+		if err == nil {
+			_, err = s.CreatePolicy(ctx, policy, &policyID)
+		}
+		if err != nil {
+			detail := err.Error()
+			var serviceErr *ServiceError
+			if errors.As(err, &serviceErr) {
+				detail = serviceErr.Detail
+			}
+			log.Debug("Bundle file import failed", "file_path", f.path, "error", err)
+			item.Status = v1alpha1.FAILED
+			item.Detail = &detail
+		} else {
+			item.Status = v1alpha1.CREATED
+		}
+		results = append(results, item)
+	}
+
+	return &v1alpha1.BundleImportResult{Results: results}, nil
+}
+
+// skipReason returns why a file is not imported as a policy, or "" if it should be imported
+func skipReason(filePath string, convention MetadataConvention) string {
+	switch {
+	case isSidecarFile(filePath) && convention == MetadataConventionSidecar:
+		return "Metadata file"
+	case !strings.HasSuffix(filePath, ".rego"):
+		return "Not a Rego file"
+	case strings.HasSuffix(filePath, "_test.rego"):
+		return "Rego test file"
+	default:
+		return ""
+	}
+}
+
+func isSidecarFile(filePath string) bool {
+	return strings.HasSuffix(filePath, ".metadata.yaml") || strings.HasSuffix(filePath, ".metadata.json")
+}
+
+// policyFromBundleFile builds the policy and its ID for a Rego file according to the metadata convention
+func policyFromBundleFile(f bundleFile, byPath map[string]string, opts BundleImportOptions) (v1alpha1.Policy, string, error) {
+	policyID := opts.IDPrefix + pathToPolicyID(f.path)
+	regoCode := f.content
+	policy := v1alpha1.Policy{RegoCode: &regoCode}
+
+	switch opts.MetadataConvention {
+	case MetadataConventionAnnotations:
+		metadata, err := opa.ParseModuleMetadata(f.path, f.content)
+		if err != nil {
+			// Leave the syntax error to be reported by CreatePolicy
+			break
+		}
+		if err := applyAnnotationMetadata(&policy, &policyID, metadata); err != nil {
+			return policy, policyID, err
+		}
+	case MetadataConventionSidecar:
+		base := strings.TrimSuffix(f.path, ".rego")
+		for _, candidate := range []string{base + ".metadata.yaml", base + ".metadata.json"} {
+			raw, ok := byPath[candidate]
+			if !ok {
+				continue
+			}
+			var sidecar v1alpha1.Policy
+			if err := yaml.Unmarshal([]byte(raw), &sidecar); err != nil {
+				return policy, policyID, fmt.Errorf("invalid metadata file '%s': %w", candidate, err)
+			}
+			applySidecarMetadata(&policy, &policyID, sidecar)
+			break
+		}
+	}
+
+	if policy.DisplayName == nil {
+		displayName := f.path
+		policy.DisplayName = &displayName
+	}
+	if policy.PolicyType == nil {
+		policyType := opts.DefaultPolicyType
+		policy.PolicyType = &policyType
+	}
+	return policy, policyID, nil
+}
+
+// applyAnnotationMetadata maps METADATA title/description and custom keys onto the policy
+func applyAnnotationMetadata(policy *v1alpha1.Policy, policyID *string, metadata *opa.ModuleMetadata) error {
+	if metadata.Title != "" {
+		policy.DisplayName = &metadata.Title
+	}
+	if metadata.Description != "" {
+		policy.Description = &metadata.Description
+	}
+	custom := metadata.Custom
+	if id, ok := custom["id"].(string); ok && id != "" {
+		*policyID = id
+	}
+	if value, ok := custom["policy_type"]; ok {
+		policyType, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("custom.policy_type must be a string")
+		}
+		t := v1alpha1.PolicyPolicyType(policyType)
+		policy.PolicyType = &t
+	}
+	if value, ok := custom["priority"]; ok {
+		priority, ok := toFloat64(value)
+		if !ok || priority != float64(int32(priority)) {
+			return fmt.Errorf("custom.priority must be an integer")
+		}
+		p := int32(priority)
+		policy.Priority = &p
+	}
+	if value, ok := custom["enabled"]; ok {
+		enabled, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("custom.enabled must be a boolean")
+		}
+		policy.Enabled = &enabled
+	}
+	if value, ok := custom["label_selector"]; ok {
+		selector, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("custom.label_selector must be an object")
+		}
+		labels := make(map[string]string, len(selector))
+		for k, v := range selector {
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("custom.label_selector value for '%s' must be a string", k)
+			}
+			labels[k] = s
+		}
+		policy.LabelSelector = &labels
+	}
+	if value, ok := custom["rejection_messages"]; ok {
+		templates, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("custom.rejection_messages must be an object")
+		}
+		messages := make(map[string]string, len(templates))
+		for code, v := range templates {
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("custom.rejection_messages value for '%s' must be a string", code)
+			}
+			messages[code] = s
+		}
+		policy.RejectionMessages = &messages
+	}
+	if value, ok := custom["documentation"]; ok {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("custom.documentation must be an object")
+		}
+		documentation := v1alpha1.PolicyDocumentation{}
+		targets := map[string]**string{
+			"summary":         &documentation.Summary,
+			"rationale":       &documentation.Rationale,
+			"remediation_url": &documentation.RemediationUrl,
+			"owner_contact":   &documentation.OwnerContact,
+		}
+		for field, v := range fields {
+			target, known := targets[field]
+			s, ok := v.(string)
+			if !known || !ok {
+				return fmt.Errorf("custom.documentation.%s must be one of summary, rationale, remediation_url and owner_contact, with a string value", field)
+			}
+			*target = &s
+		}
+		policy.Documentation = &documentation
+	}
+	return nil
+}
+
+// applySidecarMetadata copies the mutable fields of a sidecar policy document onto the policy
+func applySidecarMetadata(policy *v1alpha1.Policy, policyID *string, sidecar v1alpha1.Policy) {
+	if sidecar.Id != nil && *sidecar.Id != "" {
+		*policyID = *sidecar.Id
+	}
+	policy.DisplayName = sidecar.DisplayName
+	policy.Description = sidecar.Description
+	policy.PolicyType = sidecar.PolicyType
+	policy.Priority = sidecar.Priority
+	policy.Enabled = sidecar.Enabled
+	policy.LabelSelector = sidecar.LabelSelector
+	policy.RejectionMessages = sidecar.RejectionMessages
+	policy.Documentation = sidecar.Documentation
+}
+
+// pathToPolicyID derives an AEP-122 style ID from a file path: lowercased, without the
+// .rego extension, with every run of non-alphanumeric characters collapsed into a hyphen.
+func pathToPolicyID(filePath string) string {
+	id := strings.ToLower(strings.TrimSuffix(filePath, ".rego"))
+	id = idSeparatorPattern.ReplaceAllString(id, "-")
+	return strings.Trim(id, "-")
+}
+
+// readOPABundle extracts the regular files of a gzipped OPA bundle tarball
+func readOPABundle(r io.Reader) ([]bundleFile, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("bundle is not a gzip archive: %w", err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	var files []bundleFile
+	var total int64
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Size > maxBundleFileSize {
+			return nil, fmt.Errorf("file '%s' exceeds the maximum size of %d bytes", header.Name, maxBundleFileSize)
+		}
+		total += header.Size
+		if total > maxBundleSize {
+			return nil, fmt.Errorf("bundle exceeds the maximum size of %d bytes", maxBundleSize)
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, maxBundleFileSize))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle file '%s': %w", header.Name, err)
+		}
+		files = append(files, bundleFile{
+			path:    strings.TrimPrefix(path.Clean("/"+header.Name), "/"),
+			content: string(content),
+		})
+	}
+	return files, nil
+}
+
+// configMapDump is the subset of a ConfigMap (or List of ConfigMaps) read by the importer
+type configMapDump struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Data  map[string]string `json:"data"`
+	Items []configMapDump   `json:"items"`
+}
+
+// readConfigMapDump extracts the data entries of a ConfigMap or List of ConfigMaps in YAML or JSON
+func readConfigMapDump(r io.Reader) ([]bundleFile, error) {
+	raw, err := io.ReadAll(io.LimitReader(r, maxBundleSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ConfigMap dump: %w", err)
+	}
+	if len(raw) > maxBundleSize {
+		return nil, fmt.Errorf("ConfigMap dump exceeds the maximum size of %d bytes", maxBundleSize)
+	}
+
+	var dump configMapDump
+	if err := yaml.Unmarshal(raw, &dump); err != nil {
+		return nil, fmt.Errorf("ConfigMap dump is not valid YAML or JSON: %w", err)
+	}
+
+	configMaps := []configMapDump{dump}
+	switch dump.Kind {
+	case "ConfigMap":
+	case "List", "ConfigMapList":
+		configMaps = dump.Items
+	default:
+		return nil, fmt.Errorf("expected a ConfigMap or List, got kind '%s'", dump.Kind)
+	}
+
+	var files []bundleFile
+	for _, cm := range configMaps {
+		if cm.Kind != "" && cm.Kind != "ConfigMap" {
+			return nil, fmt.Errorf("list item has kind '%s', expected ConfigMap", cm.Kind)
+		}
+		for key, content := range cm.Data {
+			if len(content) > maxBundleFileSize {
+				return nil, fmt.Errorf("ConfigMap '%s' key '%s' exceeds the maximum size of %d bytes", cm.Metadata.Name, key, maxBundleFileSize)
+			}
+			files = append(files, bundleFile{
+				path:    path.Join(cm.Metadata.Name, key),
+				content: content,
+			})
+		}
+	}
+	return files, nil
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,232 @@
+package service_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// buildBundle returns a gzipped tarball containing the given files
+func buildBundle(files map[string]string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+	return buf
+}
+
+func resultByPath(result *v1alpha1.BundleImportResult, filePath string) v1alpha1.BundleImportItem {
+	for _, item := range result.Results {
+		if item.FilePath == filePath {
+			return item
+		}
+	}
+	Fail("no result for " + filePath)
+	return v1alpha1.BundleImportItem{}
+}
+
+var _ = Describe("PolicyService ImportBundle", func() {
+	var (
+		db            *gorm.DB
+		policyService *service.PolicyServiceImpl
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+
+		policyService = service.NewPolicyService(store.NewStore(db), opa.NewEngine())
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	Context("with an OPA bundle", func() {
+		It("should create a policy per Rego file using IDs derived from paths", func() {
+			bundle := buildBundle(map[string]string{
+				"/authz/Region_v2.rego":    "package authz.region\nmain := {\"rejected\": false}",
+				"./authz/region_test.rego": "package authz.region_test\ntest_ok if true",
+				"data.json":                "{}",
+			})
+
+			result, err := policyService.ImportBundle(ctx, bundle, service.BundleImportOptions{
+				MetadataConvention: service.MetadataConventionNone,
+				IDPrefix:           "imported-",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Results).To(HaveLen(3))
+
+			created := resultByPath(result, "authz/Region_v2.rego")
+			Expect(created.Status).To(Equal(v1alpha1.CREATED))
+			Expect(*created.PolicyId).To(Equal("imported-authz-region-v2"))
+
+			Expect(resultByPath(result, "authz/region_test.rego").Status).To(Equal(v1alpha1.SKIPPED))
+			Expect(resultByPath(result, "data.json").Status).To(Equal(v1alpha1.SKIPPED))
+
+			policy, err := policyService.GetPolicy(ctx, "imported-authz-region-v2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*policy.DisplayName).To(Equal("authz/Region_v2.rego"))
+			Expect(*policy.PolicyType).To(Equal(v1alpha1.GLOBAL))
+		})
+
+		It("should read policy fields from package METADATA annotations", func() {
+			bundle := buildBundle(map[string]string{
+				"authz/region.rego": `# METADATA
+# title: Region policy
+# description: Enforces allowed regions
+# custom:
+#   id: region-policy
+#   policy_type: USER
+#   priority: 50
+#   enabled: false
+#   label_selector:
+#     env: prod
+#   rejection_messages:
+#     region_not_allowed: Region {value} is not allowed
+#   documentation:
+#     owner_contact: platform-team@example.com
+package authz.region
+
+main := {"rejected": false}
+`,
+			})
+
+			result, err := policyService.ImportBundle(ctx, bundle, service.BundleImportOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Results[0].Status).To(Equal(v1alpha1.CREATED))
+
+			policy, err := policyService.GetPolicy(ctx, "region-policy")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*policy.DisplayName).To(Equal("Region policy"))
+			Expect(*policy.Description).To(Equal("Enforces allowed regions"))
+			Expect(*policy.PolicyType).To(Equal(v1alpha1.USER))
+			Expect(*policy.Priority).To(Equal(int32(50)))
+			Expect(*policy.Enabled).To(BeFalse())
+			Expect(*policy.LabelSelector).To(Equal(map[string]string{"env": "prod"}))
+			Expect(*policy.RejectionMessages).To(Equal(map[string]string{"region_not_allowed": "Region {value} is not allowed"}))
+			Expect(policy.Documentation).To(Equal(&v1alpha1.PolicyDocumentation{OwnerContact: strPtr("platform-team@example.com")}))
+		})
+
+		It("should read policy fields from sidecar metadata files", func() {
+			bundle := buildBundle(map[string]string{
+				"authz/region.rego":          "package authz.region\nmain := {\"rejected\": false}",
+				"authz/region.metadata.yaml": "display_name: Region policy\npriority: 10\n",
+			})
+
+			result, err := policyService.ImportBundle(ctx, bundle, service.BundleImportOptions{
+				MetadataConvention: service.MetadataConventionSidecar,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resultByPath(result, "authz/region.metadata.yaml").Status).To(Equal(v1alpha1.SKIPPED))
+			Expect(resultByPath(result, "authz/region.rego").Status).To(Equal(v1alpha1.CREATED))
+
+			policy, err := policyService.GetPolicy(ctx, "authz-region")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*policy.DisplayName).To(Equal("Region policy"))
+			Expect(*policy.Priority).To(Equal(int32(10)))
+		})
+
+		It("should report invalid files without aborting the import", func() {
+			bundle := buildBundle(map[string]string{
+				"a/broken.rego": "package broken\nmain := {",
+				"b/valid.rego":  "package valid\nmain := {\"rejected\": false}",
+			})
+
+			result, err := policyService.ImportBundle(ctx, bundle, service.BundleImportOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Results).To(HaveLen(2))
+			Expect(result.Results[0].FilePath).To(Equal("a/broken.rego"))
+			Expect(result.Results[0].Status).To(Equal(v1alpha1.FAILED))
+			Expect(result.Results[0].Detail).NotTo(BeNil())
+			Expect(result.Results[1].Status).To(Equal(v1alpha1.CREATED))
+		})
+
+		It("should report a FAILED entry when the policy already exists", func() {
+			files := map[string]string{"valid.rego": "package valid\nmain := {\"rejected\": false}"}
+			_, err := policyService.ImportBundle(ctx, buildBundle(files), service.BundleImportOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := policyService.ImportBundle(ctx, buildBundle(files), service.BundleImportOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Results[0].Status).To(Equal(v1alpha1.FAILED))
+		})
+
+		It("should return INVALID_ARGUMENT for a body that is not a gzip archive", func() {
+			_, err := policyService.ImportBundle(ctx, strings.NewReader("not a bundle"), service.BundleImportOptions{})
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+	})
+
+	Context("with a ConfigMap dump", func() {
+		It("should import the data keys of every ConfigMap in a List", func() {
+			dump := `apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: opa-policies
+    data:
+      region.rego: |
+        package region
+        main := {"rejected": false}
+      README.md: docs
+`
+			result, err := policyService.ImportBundle(ctx, strings.NewReader(dump), service.BundleImportOptions{
+				Format: service.BundleFormatConfigMap,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Results).To(HaveLen(2))
+			Expect(resultByPath(result, "opa-policies/README.md").Status).To(Equal(v1alpha1.SKIPPED))
+
+			created := resultByPath(result, "opa-policies/region.rego")
+			Expect(created.Status).To(Equal(v1alpha1.CREATED))
+			Expect(*created.PolicyId).To(Equal("opa-policies-region"))
+		})
+
+		It("should reject documents that are not ConfigMaps", func() {
+			_, err := policyService.ImportBundle(ctx, strings.NewReader("kind: Secret\n"), service.BundleImportOptions{
+				Format: service.BundleFormatConfigMap,
+			})
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// GetPolicyCatalog returns the enabled policies with their documentation, in evaluation order.
+func (s *PolicyServiceImpl) GetPolicyCatalog(ctx context.Context) (*v1alpha1.PolicyCatalog, error) {
+	catalog := &v1alpha1.PolicyCatalog{
+		GenerateTime: time.Now().UTC(),
+		Policies:     []v1alpha1.PolicyCatalogEntry{},
+	}
+	err := forEachEnabledPolicy(ctx, s.store.Policy(), func(policy *model.Policy) error {
+		entry := v1alpha1.PolicyCatalogEntry{
+			Id:            policy.ID,
+			DisplayName:   policy.DisplayName,
+			PolicyType:    v1alpha1.PolicyCatalogEntryPolicyType(policy.PolicyType),
+			Priority:      policy.Priority,
+			Documentation: documentationDBToAPI(policy.Documentation),
+			UpdateTime:    policy.UpdateTime,
+		}
+		if policy.Description != "" {
+			entry.Description = &policy.Description
+		}
+		if len(policy.LabelSelector) > 0 {
+			entry.LabelSelector = &policy.LabelSelector
+		}
+		catalog.Policies = append(catalog.Policies, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Debug("Policy catalog generated", "policies", len(catalog.Policies))
+	return catalog, nil
+}
+
+// PolicyCatalogMarkdown renders a policy catalog as a markdown document with a section per
+// policy, for publishing to documentation portals
+func PolicyCatalogMarkdown(catalog *v1alpha1.PolicyCatalog) string {
+	var b strings.Builder
+	b.WriteString("# Policy Catalog\n\n")
+	fmt.Fprintf(&b, "%d enabled policies in evaluation order, generated %s.\n", len(catalog.Policies), catalog.GenerateTime.Format(time.RFC3339))
+
+	for _, entry := range catalog.Policies {
+		fmt.Fprintf(&b, "\n## %s\n\n", entry.DisplayName)
+		fmt.Fprintf(&b, "`%s` · %s · priority %d\n", entry.Id, entry.PolicyType, entry.Priority)
+
+		var documentation v1alpha1.PolicyDocumentation
+		if entry.Documentation != nil {
+			documentation = *entry.Documentation
+		}
+		if documentation.Summary != nil {
+			fmt.Fprintf(&b, "\n> %s\n", *documentation.Summary)
+		}
+		if entry.Description != nil {
+			fmt.Fprintf(&b, "\n%s\n", strings.TrimSpace(*entry.Description))
+		}
+
+		appliesTo := "all requests"
+		if entry.LabelSelector != nil {
+			labels := make([]string, 0, len(*entry.LabelSelector))
+			for _, key := range slices.Sorted(maps.Keys(*entry.LabelSelector)) {
+				labels = append(labels, fmt.Sprintf("`%s=%s`", key, (*entry.LabelSelector)[key]))
+			}
+			appliesTo = strings.Join(labels, ", ")
+		}
+		fmt.Fprintf(&b, "\n- **Applies to:** %s\n", appliesTo)
+		if documentation.OwnerContact != nil {
+			fmt.Fprintf(&b, "- **Owner:** %s\n", *documentation.OwnerContact)
+		}
+		if documentation.RemediationUrl != nil {
+			fmt.Fprintf(&b, "- **Remediation:** <%s>\n", *documentation.RemediationUrl)
+		}
+		fmt.Fprintf(&b, "- **Last updated:** %s\n", entry.UpdateTime.UTC().Format(time.RFC3339))
+
+		if documentation.Rationale != nil {
+			fmt.Fprintf(&b, "\n### Rationale\n\n%s\n", strings.TrimSpace(*documentation.Rationale))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// GetPolicyChecksum returns a deterministic digest of the stored policy set covering each
+// policy's ID, latest revision and Rego code, so copies of the store can be compared
+// without exporting them.
+func (s *PolicyServiceImpl) GetPolicyChecksum(ctx context.Context) (*v1alpha1.PolicyChecksum, error) {
+	log := logging.FromContext(ctx)
+
+	policies, err := s.store.Policy().ListAll(ctx)
+	if err != nil {
+		log.Error("Failed to list policies for checksum", "error", err)
+		return nil, NewInternalError("Failed to list policies", err.Error(), err)
+	}
+	revisions, err := s.store.Revision().Latest(ctx)
+	if err != nil {
+		log.Error("Failed to get latest policy revisions for checksum", "error", err)
+		return nil, NewInternalError("Failed to get policy revisions", err.Error(), err)
+	}
+
+	return &v1alpha1.PolicyChecksum{
+		Checksum:    policySetChecksum(policies, revisions),
+		PolicyCount: int32(len(policies)),
+	}, nil
+}
+
+// policySetChecksum hashes one line per policy, in the ID order ListAll returns them:
+// "<id> <revision> <hex SHA-256 of the Rego code>\n". Policy IDs cannot contain spaces or
+// newlines, so the lines cannot be confused with one another.
+func policySetChecksum(policies model.PolicyList, revisions map[string]int64) string {
+	digest := sha256.New()
+	for _, p := range policies {
+		rego := sha256.Sum256([]byte(p.RegoCode))
+		fmt.Fprintf(digest, "%s %d %s\n", p.ID, revisions[p.ID], hex.EncodeToString(rego[:]))
+	}
+	return "sha256:" + hex.EncodeToString(digest.Sum(nil))
+}
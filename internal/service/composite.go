@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// MaxCompositeInstances is the maximum number of service instances in a composite request
+const MaxCompositeInstances = 100
+
+// CompositeEvaluationRequest is a set of related service instances evaluated together
+type CompositeEvaluationRequest struct {
+	Instances      []CompositeInstance
+	AcceptLanguage string // Accept-Language header selecting the language of rejection messages
+}
+
+// CompositeInstance is one named service instance of a composite request
+type CompositeInstance struct {
+	Name            string
+	ServiceInstance map[string]any
+	RequestLabels   map[string]string
+}
+
+// CompositeEvaluationResponse holds the result of every instance of a composite request
+type CompositeEvaluationResponse struct {
+	Instances         []CompositeInstanceResult
+	Status            EvaluationStatus // MODIFIED when any instance was modified
+	CompositePolicies []string         // policies whose composite rule approved the request
+	FailedOpen        bool             // composite rules were skipped because policies were unavailable
+}
+
+// CompositeInstanceResult is the evaluation result of one instance of a composite request
+type CompositeInstanceResult struct {
+	Name string
+	EvaluationResponse
+}
+
+// EvaluateComposite evaluates each instance like EvaluateRequest, then evaluates the composite
+// rule of every enabled policy whose label selector matches at least one instance, with the
+// evaluated instances as input. The first failure fails the request.
+func (s *evaluationService) EvaluateComposite(ctx context.Context, req *CompositeEvaluationRequest) (*CompositeEvaluationResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validateCompositeRequest(req); err != nil {
+		return nil, err
+	}
+	log.Debug("Starting composite policy evaluation", "instances", len(req.Instances))
+
+	response := &CompositeEvaluationResponse{
+		Instances:         make([]CompositeInstanceResult, len(req.Instances)),
+		Status:            EvaluationStatusApproved,
+		CompositePolicies: []string{},
+	}
+	instances := make([]any, len(req.Instances))
+	for i, instance := range req.Instances {
+		result, err := s.EvaluateRequest(ctx, &EvaluationRequest{
+			ServiceInstance: instance.ServiceInstance,
+			RequestLabels:   instance.RequestLabels,
+			AcceptLanguage:  req.AcceptLanguage,
+		})
+		if err != nil {
+			return nil, compositeInstanceError(instance.Name, err)
+		}
+		response.Instances[i] = CompositeInstanceResult{Name: instance.Name, EvaluationResponse: *result}
+		if result.Status == EvaluationStatusModified {
+			response.Status = EvaluationStatusModified
+		}
+		instances[i] = map[string]any{
+			"name":     instance.Name,
+			"spec":     result.EvaluatedServiceInstance,
+			"provider": result.SelectedProvider,
+		}
+	}
+
+	input := map[string]any{"instances": instances}
+	err := s.forEachCompositePolicy(ctx, req.Instances, func(policy *model.Policy) error {
+		evalResult, err := s.withPolicyTimeout(ctx, policy.ID, func(ctx context.Context) (*opa.EvaluationResult, error) {
+			return s.engine.EvaluateComposite(ctx, policy.ID, input)
+		})
+		if err != nil {
+			return NewInternalError(
+				fmt.Sprintf("Failed to evaluate the composite rule of policy '%s'", policy.ID),
+				err.Error(),
+				err,
+			)
+		}
+		if !evalResult.Defined {
+			return nil
+		}
+		decision := opa.ParsePolicyDecision(evalResult.Result)
+		if decision.Rejected {
+			log.Info("Policy rejected composite request", "policy_id", policy.ID, "reason", decision.RejectionReason)
+			return NewPolicyRejectedError(policy.ID, decision.RejectionReason)
+		}
+		response.CompositePolicies = append(response.CompositePolicies, policy.ID)
+		return nil
+	})
+	if err != nil {
+		if s.failureMode != FailureModeOpen || !isUnavailable(err) {
+			return nil, err
+		}
+		failedOpenTotal.Inc()
+		log.Warn("Policies unavailable; skipping the remaining composite rules", "error", err)
+		response.FailedOpen = true
+	}
+
+	log.Info("Composite policy evaluation completed",
+		"status", response.Status,
+		"instances", len(response.Instances),
+		"composite_policies", len(response.CompositePolicies),
+	)
+	return response, nil
+}
+
+func validateCompositeRequest(req *CompositeEvaluationRequest) error {
+	if len(req.Instances) == 0 {
+		return NewInvalidArgumentError("Invalid composite request", "At least one service instance is required")
+	}
+	if len(req.Instances) > MaxCompositeInstances {
+		return NewInvalidArgumentError(
+			"Invalid composite request",
+			fmt.Sprintf("At most %d service instances are allowed (got %d)", MaxCompositeInstances, len(req.Instances)),
+		)
+	}
+	names := make(map[string]bool, len(req.Instances))
+	for _, instance := range req.Instances {
+		if instance.Name == "" {
+			return NewInvalidArgumentError("Invalid composite request", "Every service instance needs a name")
+		}
+		if names[instance.Name] {
+			return NewInvalidArgumentError(
+				"Invalid composite request",
+				fmt.Sprintf("Service instance name '%s' is used more than once", instance.Name),
+			)
+		}
+		names[instance.Name] = true
+	}
+	return nil
+}
+
+// forEachCompositePolicy calls fn, in evaluation order, for every enabled policy whose label
+// selector matches the labels of at least one instance
+func (s *evaluationService) forEachCompositePolicy(ctx context.Context, instances []CompositeInstance, fn func(*model.Policy) error) error {
+	return s.policies.forEachEnabled(ctx, func(policy *model.Policy) error {
+		for _, instance := range instances {
+			if MatchesLabelSelector(policy.LabelSelector, instance.RequestLabels) {
+				return fn(policy)
+			}
+		}
+		return nil
+	})
+}
+
+// compositeInstanceError names the failing instance in the detail of an evaluation error
+func compositeInstanceError(name string, err error) error {
+	serviceErr, ok := err.(*ServiceError)
+	if !ok {
+		return err
+	}
+	detailed := *serviceErr
+	detailed.Detail = fmt.Sprintf("service instance '%s': %s", name, serviceErr.Detail)
+	return &detailed
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EvaluateComposite", func() {
+	const (
+		// Places every instance in one region unless it names its own
+		defaultRegion = `package default_region
+
+main := {"patch": {"region": "us-east-1"}} if not input.spec.region
+`
+		// Rejects applications whose tiers are spread over several regions
+		sameRegion = `package same_region
+
+main := {}
+
+composite := {"rejected": true, "rejection_reason": "all tiers must share one region"} if {
+	count({instance.spec.region | some instance in input.instances}) > 1
+} else := {}
+`
+	)
+
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		engine    opa.Engine
+		svc       EvaluationService
+	)
+
+	compile := func(policies ...model.Policy) {
+		modules := make([]opa.PolicyModule, len(policies))
+		for i, policy := range policies {
+			modules[i] = opa.PolicyModule{ID: policy.ID, RegoCode: policy.RegoCode}
+		}
+		Expect(engine.Compile(ctx, modules)).To(Succeed())
+		mockStore.policies = policies
+	}
+	instance := func(name string, spec map[string]any) CompositeInstance {
+		return CompositeInstance{Name: name, ServiceInstance: spec, RequestLabels: map[string]string{"service_type": name}}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{}
+		engine = opa.NewEngine()
+		svc = NewEvaluationService(mockStore, engine)
+		compile(
+			model.Policy{ID: "default-region", Enabled: true, PolicyType: "GLOBAL", Priority: 100, RegoCode: defaultRegion},
+			model.Policy{ID: "same-region", Enabled: true, PolicyType: "GLOBAL", Priority: 200, RegoCode: sameRegion},
+		)
+	})
+
+	It("evaluates every instance and then the composite rules", func() {
+		response, err := svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{Instances: []CompositeInstance{
+			instance("database", map[string]any{"region": "us-east-1"}),
+			instance("cache", map[string]any{}),
+		}})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Status).To(Equal(EvaluationStatusModified))
+		Expect(response.Instances).To(HaveLen(2))
+		Expect(response.Instances[0].Name).To(Equal("database"))
+		Expect(response.Instances[0].Status).To(Equal(EvaluationStatusApproved))
+		Expect(response.Instances[1].EvaluatedServiceInstance).To(Equal(map[string]any{"region": "us-east-1"}))
+		Expect(response.Instances[1].Status).To(Equal(EvaluationStatusModified))
+		Expect(response.CompositePolicies).To(Equal([]string{"same-region"}))
+	})
+
+	It("rejects the request when a composite rule rejects the evaluated instances", func() {
+		_, err := svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{Instances: []CompositeInstance{
+			instance("database", map[string]any{"region": "eu-west-1"}),
+			instance("cache", map[string]any{}),
+		}})
+
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+		Expect(serviceErr.Message).To(Equal("Request rejected by policy 'same-region'"))
+		Expect(serviceErr.Detail).To(Equal("all tiers must share one region"))
+	})
+
+	It("names the instance whose evaluation failed", func() {
+		compile(model.Policy{
+			ID: "no-cache", Enabled: true, PolicyType: "GLOBAL", Priority: 100,
+			LabelSelector: map[string]string{"service_type": "cache"},
+			RegoCode:      "package no_cache\n\nmain := {\"rejected\": true, \"rejection_reason\": \"caches are not offered\"}",
+		})
+
+		_, err := svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{Instances: []CompositeInstance{
+			instance("database", map[string]any{}),
+			instance("cache", map[string]any{}),
+		}})
+
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+		Expect(serviceErr.Detail).To(Equal("service instance 'cache': caches are not offered"))
+	})
+
+	It("only evaluates composite rules of policies matching at least one instance", func() {
+		compile(model.Policy{
+			ID: "same-region", Enabled: true, PolicyType: "GLOBAL", Priority: 200,
+			LabelSelector: map[string]string{"service_type": "vm"},
+			RegoCode:      sameRegion,
+		})
+
+		response, err := svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{Instances: []CompositeInstance{
+			instance("database", map[string]any{"region": "eu-west-1"}),
+			instance("cache", map[string]any{"region": "us-east-1"}),
+		}})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.CompositePolicies).To(BeEmpty())
+	})
+
+	It("rejects requests without instances or with duplicate names", func() {
+		_, err := svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{})
+		Expect(err).To(BeAssignableToTypeOf(&ServiceError{}))
+		Expect(err.(*ServiceError).Type).To(Equal(ErrorTypeInvalidArgument))
+
+		_, err = svc.EvaluateComposite(ctx, &CompositeEvaluationRequest{Instances: []CompositeInstance{
+			instance("database", map[string]any{}),
+			instance("database", map[string]any{}),
+		}})
+		Expect(err).To(BeAssignableToTypeOf(&ServiceError{}))
+		Expect(err.(*ServiceError).Detail).To(Equal("Service instance name 'database' is used more than once"))
+	})
+})
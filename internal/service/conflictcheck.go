@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+)
+
+// CheckPolicyConflicts reports the conflicts of a candidate policy with the stored policies:
+// priorities and display names that would fail its creation, shared Rego packages, compile
+// failures and, for each sample, decisions that conflict with other policies' constraints.
+// Nothing is stored and the engine is not changed.
+func (s *PolicyServiceImpl) CheckPolicyConflicts(ctx context.Context, req v1alpha1.PolicyConflictCheckRequest) (*v1alpha1.PolicyConflictReport, error) {
+	log := logging.FromContext(ctx)
+
+	sim, err := s.newDraftSimulation(ctx, req.PolicyId, req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	candidate, err := opa.ParseModuleMetadata(sim.id, sim.draft.RegoCode)
+	if err != nil {
+		return nil, handleEngineError(err, "conflict check")
+	}
+
+	report := &v1alpha1.PolicyConflictReport{PolicyId: sim.id, Conflicts: []v1alpha1.PolicyConflict{}}
+	addConflict := func(kind v1alpha1.PolicyConflictKind, policyID string, detail string) *v1alpha1.PolicyConflict {
+		conflict := v1alpha1.PolicyConflict{Kind: kind, Detail: detail}
+		if policyID != "" {
+			conflict.PolicyId = &policyID
+		}
+		report.Conflicts = append(report.Conflicts, conflict)
+		return &report.Conflicts[len(report.Conflicts)-1]
+	}
+
+	if taken := sim.priorityTakenBy(); taken != nil {
+		addConflict(v1alpha1.ConflictPriority, taken.ID,
+			fmt.Sprintf("Policy '%s' already has priority %d among %s policies", taken.ID, taken.Priority, taken.PolicyType))
+	}
+	for _, p := range sim.others() {
+		if p.PolicyType == sim.draft.PolicyType && p.DisplayName == sim.draft.DisplayName {
+			addConflict(v1alpha1.ConflictDisplayName, p.ID,
+				fmt.Sprintf("Policy '%s' already has display name '%s' among %s policies", p.ID, p.DisplayName, p.PolicyType))
+		}
+		stored, err := opa.ParseModuleMetadata(p.ID, p.RegoCode)
+		if err != nil {
+			// Stored policies always parse; one that does not cannot share the package
+			log.Warn("Failed to parse stored policy for conflict check", "policy_id", p.ID, "error", err)
+			continue
+		}
+		if stored.Package == candidate.Package {
+			addConflict(v1alpha1.ConflictPackage, p.ID,
+				fmt.Sprintf("Policy '%s' also declares package '%s'; policies sharing a package share their rules", p.ID, candidate.Package))
+		}
+	}
+
+	if err := sim.compile(ctx); err != nil {
+		serviceErr := handleEngineError(err, "conflict check")
+		if serviceErr.Type != ErrorTypeInvalidArgument {
+			return nil, serviceErr
+		}
+		addConflict(v1alpha1.ConflictCompile, "", serviceErr.Detail)
+		log.Debug("Policy conflicts checked", "policy_id", sim.id, "conflicts", len(report.Conflicts), "samples_checked", 0)
+		return report, nil
+	}
+
+	var samples []v1alpha1.SimulatedServiceInstance
+	if req.Samples != nil {
+		samples = *req.Samples
+	}
+	for i, sample := range samples {
+		result, err := sim.run(ctx, sample.Spec)
+		if err != nil {
+			if serviceErr, ok := err.(*ServiceError); ok && serviceErr.Type == ErrorTypeInvalidArgument {
+				return nil, NewInvalidArgumentError(fmt.Sprintf("Invalid sample %d", i), serviceErr.Detail)
+			}
+			return nil, err
+		}
+		report.SamplesChecked++
+
+		var conflict *v1alpha1.PolicyConflict
+		switch {
+		case result.Draft.Outcome == v1alpha1.DraftFailed:
+			conflict = addConflict(v1alpha1.ConflictSample, "", *result.Draft.Reason)
+		case result.Status == v1alpha1.SimulationFailed && result.Draft.Outcome == v1alpha1.DraftApplied:
+			conflict = addConflict(v1alpha1.ConflictSample, *result.FailedPolicyId,
+				fmt.Sprintf("Policy '%s' fails after the candidate: %s", *result.FailedPolicyId, *result.Detail))
+		default:
+			continue
+		}
+		sampleIndex := int32(i)
+		conflict.SampleIndex = &sampleIndex
+	}
+
+	log.Debug("Policy conflicts checked", "policy_id", sim.id, "conflicts", len(report.Conflicts), "samples_checked", report.SamplesChecked)
+	return report, nil
+}
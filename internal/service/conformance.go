@@ -0,0 +1,108 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/conformance"
+)
+
+// ConformanceEvaluator evaluates the cases of the conformance suite with the central engine:
+// each case's policy set is compiled into a scratch engine and the spec is evaluated against
+// it as a dry run, like a simulation
+type ConformanceEvaluator struct {
+	options []EvaluationOption
+}
+
+var _ conformance.Evaluator = (*ConformanceEvaluator)(nil)
+
+// NewConformanceEvaluator creates a ConformanceEvaluator whose evaluations are configured
+// with opts
+func NewConformanceEvaluator(opts ...EvaluationOption) *ConformanceEvaluator {
+	return &ConformanceEvaluator{options: opts}
+}
+
+// Evaluate evaluates spec against the policies. Rejections and constraint conflicts are
+// reported in the outcome; other failures are returned.
+func (e *ConformanceEvaluator) Evaluate(ctx context.Context, policies []conformance.Policy, spec map[string]any) (*conformance.Outcome, error) {
+	modules := make([]opa.PolicyModule, len(policies))
+	list := make(policyList, len(policies))
+	for i, p := range policies {
+		modules[i] = opa.PolicyModule{ID: p.ID, RegoCode: p.RegoCode}
+		list[i] = model.Policy{
+			ID:            p.ID,
+			PolicyType:    p.PolicyType,
+			Priority:      p.Priority,
+			LabelSelector: p.LabelSelector,
+			RegoCode:      p.RegoCode,
+			Enabled:       true,
+		}
+	}
+	slices.SortFunc(list, func(a, b model.Policy) int {
+		return cmp.Or(cmp.Compare(a.PolicyType, b.PolicyType), cmp.Compare(a.Priority, b.Priority))
+	})
+	engine := opa.NewEngine()
+	if err := engine.Compile(ctx, modules); err != nil {
+		return nil, err
+	}
+
+	labels, err := ExtractRequestLabels(spec)
+	if err != nil {
+		return nil, NewInvalidArgumentError("Invalid service instance spec", err.Error())
+	}
+	opts := append(slices.Clone(e.options), func(s *evaluationService) { s.policies = list })
+	response, err := NewEvaluationService(nil, engine, opts...).EvaluateRequest(ctx, &EvaluationRequest{
+		ServiceInstance: spec,
+		RequestLabels:   labels,
+		Explain:         true,
+		DryRun:          true,
+	})
+	if err != nil {
+		var serviceErr *ServiceError
+		if !errors.As(err, &serviceErr) || serviceErr.Explanation == nil || len(serviceErr.Explanation.Policies) == 0 {
+			return nil, err
+		}
+		outcome := &conformance.Outcome{PoliciesEvaluated: evaluatedPolicyIDs(serviceErr.Explanation)}
+		switch serviceErr.Type {
+		case ErrorTypeRejected:
+			outcome.Status = conformance.StatusRejected
+		case ErrorTypePolicyConflict:
+			outcome.Status = conformance.StatusPolicyConflict
+		default:
+			return nil, err
+		}
+		outcome.PolicyID = outcome.PoliciesEvaluated[len(outcome.PoliciesEvaluated)-1]
+		return outcome, nil
+	}
+	return &conformance.Outcome{
+		Status:            conformance.Status(response.Status),
+		Spec:              response.EvaluatedServiceInstance,
+		SelectedProvider:  response.SelectedProvider,
+		PoliciesEvaluated: evaluatedPolicyIDs(response.Explanation),
+	}, nil
+}
+
+// evaluatedPolicyIDs returns the IDs of the policies traced by explanation, in order
+func evaluatedPolicyIDs(explanation *Explanation) []string {
+	ids := []string{}
+	for _, trace := range explanation.Policies {
+		ids = append(ids, trace.PolicyID)
+	}
+	return ids
+}
+
+// policyList is a fixed policy source, already in evaluation order
+type policyList model.PolicyList
+
+func (l policyList) forEachEnabled(_ context.Context, fn func(*model.Policy) error) error {
+	for i := range l {
+		if err := fn(&l[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/pkg/conformance"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ConformanceEvaluator", func() {
+	cases, err := conformance.Suite()
+	It("loads the published suite", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cases).NotTo(BeEmpty())
+	})
+
+	for _, c := range cases {
+		It("passes "+c.Name, func() {
+			results := conformance.Run(context.Background(), service.NewConformanceEvaluator(), []conformance.Case{c})
+
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Err).NotTo(HaveOccurred())
+			Expect(results[0].Differences).To(BeEmpty())
+		})
+	}
+})
@@ -42,6 +42,18 @@ func APIToDBModel(api v1alpha1.Policy, id string) model.Policy {
 	if api.RegoCode != nil {
 		db.RegoCode = *api.RegoCode
 	}
+	if api.RejectionMessages != nil {
+		db.RejectionMessages = *api.RejectionMessages
+	}
+	if api.Annotations != nil {
+		db.Annotations = *api.Annotations
+	}
+	if api.Parameters != nil {
+		db.Parameters = *api.Parameters
+	}
+	if api.Documentation != nil {
+		db.Documentation = documentationAPIToDB(*api.Documentation)
+	}
 
 	return db
 }
@@ -68,5 +80,139 @@ func DBToAPIModel(db *model.Policy) v1alpha1.Policy {
 	if len(db.LabelSelector) > 0 {
 		api.LabelSelector = &db.LabelSelector
 	}
+	if len(db.RejectionMessages) > 0 {
+		api.RejectionMessages = &db.RejectionMessages
+	}
+	if len(db.Annotations) > 0 {
+		api.Annotations = &db.Annotations
+	}
+	if len(db.Parameters) > 0 {
+		api.Parameters = &db.Parameters
+	}
+	if db.CreatedBy != "" {
+		api.CreatedBy = &db.CreatedBy
+	}
+	if db.UpdatedBy != "" {
+		api.UpdatedBy = &db.UpdatedBy
+	}
+	api.Documentation = documentationDBToAPI(db.Documentation)
+	return api
+}
+
+// documentationAPIToDB converts API policy documentation to its database model
+func documentationAPIToDB(api v1alpha1.PolicyDocumentation) model.Documentation {
+	var db model.Documentation
+	if api.Summary != nil {
+		db.Summary = *api.Summary
+	}
+	if api.Rationale != nil {
+		db.Rationale = *api.Rationale
+	}
+	if api.RemediationUrl != nil {
+		db.RemediationURL = *api.RemediationUrl
+	}
+	if api.OwnerContact != nil {
+		db.OwnerContact = *api.OwnerContact
+	}
+	return db
+}
+
+// documentationDBToAPI converts database policy documentation to its API model, or nil
+// when no field is set
+func documentationDBToAPI(db model.Documentation) *v1alpha1.PolicyDocumentation {
+	if db == (model.Documentation{}) {
+		return nil
+	}
+	api := &v1alpha1.PolicyDocumentation{}
+	if db.Summary != "" {
+		api.Summary = &db.Summary
+	}
+	if db.Rationale != "" {
+		api.Rationale = &db.Rationale
+	}
+	if db.RemediationURL != "" {
+		api.RemediationUrl = &db.RemediationURL
+	}
+	if db.OwnerContact != "" {
+		api.OwnerContact = &db.OwnerContact
+	}
+	return api
+}
+
+// RevisionToAPIModel converts a database PolicyRevision model to an API PolicyRevision model.
+func RevisionToAPIModel(db *model.PolicyRevision) v1alpha1.PolicyRevision {
+	policy := db.Policy()
+	path := fmt.Sprintf("policies/%s/revisions/%d", db.PolicyID, db.Revision)
+	return v1alpha1.PolicyRevision{
+		Path:       &path,
+		Revision:   db.Revision,
+		CreateTime: db.CreateTime,
+		Policy:     DBToAPIModel(&policy),
+	}
+}
+
+// PolicyTestAPIToDBModel converts an API PolicyTest model of policy policyID to a database
+// PolicyTest model.
+func PolicyTestAPIToDBModel(api v1alpha1.PolicyTest, policyID, id string) model.PolicyTest {
+	db := model.PolicyTest{
+		PolicyID: policyID,
+		ID:       id,
+		Spec:     api.ServiceInstance.Spec,
+		Expected: model.PolicyTestOutcome{Status: string(api.Expected.Status)},
+	}
+	if api.DisplayName != nil {
+		db.DisplayName = *api.DisplayName
+	}
+	if api.Expected.PolicyOutcome != nil {
+		db.Expected.PolicyOutcome = string(*api.Expected.PolicyOutcome)
+	}
+	if api.Expected.EvaluatedServiceInstance != nil {
+		db.Expected.EvaluatedSpec = api.Expected.EvaluatedServiceInstance.Spec
+	}
+	if api.Expected.Detail != nil {
+		db.Expected.Detail = *api.Expected.Detail
+	}
+	return db
+}
+
+// PolicyTestToAPIModel converts a database PolicyTest model to an API PolicyTest model.
+func PolicyTestToAPIModel(db *model.PolicyTest) v1alpha1.PolicyTest {
+	path := fmt.Sprintf("policies/%s/tests/%s", db.PolicyID, db.ID)
+	api := v1alpha1.PolicyTest{
+		Path:            &path,
+		Id:              &db.ID,
+		ServiceInstance: v1alpha1.SimulatedServiceInstance{Spec: db.Spec},
+		Expected:        v1alpha1.PolicyTestExpectation{Status: v1alpha1.SimulationStatus(db.Expected.Status)},
+		CreateTime:      &db.CreateTime,
+	}
+	if db.DisplayName != "" {
+		api.DisplayName = &db.DisplayName
+	}
+	if db.Expected.PolicyOutcome != "" {
+		outcome := v1alpha1.DraftPolicyOutcome(db.Expected.PolicyOutcome)
+		api.Expected.PolicyOutcome = &outcome
+	}
+	if db.Expected.EvaluatedSpec != nil {
+		api.Expected.EvaluatedServiceInstance = &v1alpha1.SimulatedServiceInstance{Spec: db.Expected.EvaluatedSpec}
+	}
+	if db.Expected.Detail != "" {
+		api.Expected.Detail = &db.Expected.Detail
+	}
+	return api
+}
+
+// EngineAPIKeyToAPIModel converts a stored key to its API model, which never has the key itself
+func EngineAPIKeyToAPIModel(db *model.EngineAPIKey) v1alpha1.EngineApiKey {
+	path := "engine-api-keys/" + db.ID
+	api := v1alpha1.EngineApiKey{
+		Path:       &path,
+		Id:         &db.ID,
+		Identity:   db.Identity,
+		KeyPrefix:  &db.Prefix,
+		CreateTime: &db.CreateTime,
+	}
+	if db.CreatedBy != "" {
+		api.CreatedBy = &db.CreatedBy
+	}
 	return api
 }
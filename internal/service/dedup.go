@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"golang.org/x/sync/singleflight"
+)
+
+var deduplicatedTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_deduplicated_total",
+	"Evaluation requests answered from an identical in-flight or recently completed evaluation",
+	"source",
+)
+
+// deduplicatingEvaluationService coalesces identical evaluation requests. Requests with
+// the same spec, labels, request context and explain flag share a single in-flight
+// evaluation, and the outcome is reused for requests arriving within the window after it completes.
+type deduplicatingEvaluationService struct {
+	next   EvaluationService
+	window time.Duration
+	now    func() time.Time
+	group  singleflight.Group
+
+	mu         sync.Mutex
+	results    map[string]*dedupResult
+	expiry     []dedupExpiry // insertion order; expiries are monotonic because the window is fixed
+	generation uint64        // incremented on every policy change
+}
+
+type dedupResult struct {
+	response  *EvaluationResponse
+	err       error
+	expiresAt time.Time
+}
+
+type dedupExpiry struct {
+	key       string
+	expiresAt time.Time
+}
+
+// NewDeduplicatingEvaluationService wraps next so identical requests within window are evaluated once.
+// Reused results are discarded whenever a policy event is published on bus, which may be nil.
+// A non-positive window returns next unchanged.
+func NewDeduplicatingEvaluationService(next EvaluationService, window time.Duration, bus *events.Bus) EvaluationService {
+	if window <= 0 {
+		return next
+	}
+	s := &deduplicatingEvaluationService{
+		next:    next,
+		window:  window,
+		now:     time.Now,
+		results: map[string]*dedupResult{},
+	}
+	bus.Subscribe(func(_ context.Context, event events.Event) {
+		switch event.(type) {
+		case events.PolicyCreated, events.PolicyUpdated, events.PolicyDeleted:
+			s.invalidate()
+		}
+	})
+	return s
+}
+
+func (s *deduplicatingEvaluationService) EvaluateRequest(ctx context.Context, req *EvaluationRequest) (*EvaluationResponse, error) {
+	// Dry runs neither reuse a recorded outcome nor leave one behind
+	if req.DryRun {
+		return s.next.EvaluateRequest(ctx, req)
+	}
+
+	key, err := dedupKey(req)
+	if err != nil {
+		// Unhashable input is evaluated directly; the evaluation reports any real problem
+		return s.next.EvaluateRequest(ctx, req)
+	}
+
+	if result, ok := s.lookup(key); ok {
+		deduplicatedTotal.Inc("window")
+		logging.FromContext(ctx).Debug("Evaluation answered from deduplication window")
+		return copyDedupResult(result)
+	}
+
+	value, err, shared := s.group.Do(key, func() (any, error) {
+		generation := s.currentGeneration()
+		response, err := s.next.EvaluateRequest(context.WithoutCancel(ctx), req)
+		s.store(key, generation, response, err)
+		return response, err
+	})
+	if shared {
+		deduplicatedTotal.Inc("in_flight")
+		logging.FromContext(ctx).Debug("Evaluation coalesced onto an in-flight request")
+	}
+	response, _ := value.(*EvaluationResponse)
+	return copyDedupResult(&dedupResult{response: response, err: err})
+}
+
+// EvaluateComposite evaluates composite requests directly; they are not deduplicated
+func (s *deduplicatingEvaluationService) EvaluateComposite(ctx context.Context, req *CompositeEvaluationRequest) (*CompositeEvaluationResponse, error) {
+	return s.next.EvaluateComposite(ctx, req)
+}
+
+// CreateSession opens a session on the wrapped service
+func (s *deduplicatingEvaluationService) CreateSession(ctx context.Context) (*EvaluationSession, error) {
+	return s.next.CreateSession(ctx)
+}
+
+// EvaluateSession evaluates session steps directly; they depend on the session state
+func (s *deduplicatingEvaluationService) EvaluateSession(ctx context.Context, id string, spec map[string]any) (*SessionEvaluationResponse, error) {
+	return s.next.EvaluateSession(ctx, id, spec)
+}
+
+// FinalizeSession finalizes sessions directly; they depend on the session state
+func (s *deduplicatingEvaluationService) FinalizeSession(ctx context.Context, id string) (*EvaluationResponse, error) {
+	return s.next.FinalizeSession(ctx, id)
+}
+
+func (s *deduplicatingEvaluationService) lookup(key string) (*dedupResult, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evictExpired()
+	result, ok := s.results[key]
+	return result, ok
+}
+
+func (s *deduplicatingEvaluationService) currentGeneration() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generation
+}
+
+// invalidate drops every reusable result so policy changes apply to the next request
+func (s *deduplicatingEvaluationService) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generation++
+	s.results = map[string]*dedupResult{}
+	s.expiry = nil
+}
+
+// store keeps successful and deterministic outcomes; internal errors, unavailability and
+// failed-open approvals are retried on the next request.
+// Outcomes of evaluations that started before a policy change are not kept.
+func (s *deduplicatingEvaluationService) store(key string, generation uint64, response *EvaluationResponse, err error) {
+	var serviceErr *ServiceError
+	if err != nil && (!errors.As(err, &serviceErr) || serviceErr.Type == ErrorTypeInternal || serviceErr.Type == ErrorTypeUnavailable) {
+		return
+	}
+	// Failing open answers for an outage that may be over by the next request
+	if response != nil && response.FailedOpen {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation != s.generation {
+		return
+	}
+	expiresAt := s.now().Add(s.window)
+	s.results[key] = &dedupResult{response: response, err: err, expiresAt: expiresAt}
+	s.expiry = append(s.expiry, dedupExpiry{key: key, expiresAt: expiresAt})
+}
+
+// evictExpired drops results whose window has passed; callers must hold s.mu
+func (s *deduplicatingEvaluationService) evictExpired() {
+	now := s.now()
+	n := 0
+	for n < len(s.expiry) && !now.Before(s.expiry[n].expiresAt) {
+		entry := s.expiry[n]
+		// A key stored again later has a newer result that must be kept
+		if result, ok := s.results[entry.key]; ok && result.expiresAt.Equal(entry.expiresAt) {
+			delete(s.results, entry.key)
+		}
+		n++
+	}
+	s.expiry = s.expiry[n:]
+}
+
+// dedupKey hashes the parts of the request that determine the evaluation outcome.
+// encoding/json sorts map keys, so equal specs produce equal keys.
+func dedupKey(req *EvaluationRequest) (string, error) {
+	payload, err := json.Marshal(struct {
+		Spec               map[string]any    `json:"spec"`
+		Labels             map[string]string `json:"labels"`
+		Explain            bool              `json:"explain"`
+		AcceptLanguage     string            `json:"accept_language"`
+		RequestContext     RequestContext    `json:"request_context"`
+		InstanceID         string            `json:"instance_id"`
+		PreferredProviders []string          `json:"preferred_providers"`
+	}{req.ServiceInstance, req.RequestLabels, req.Explain, req.AcceptLanguage, req.RequestContext, req.InstanceID, req.PreferredProviders})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// copyDedupResult returns a private copy of a shared response so callers cannot affect each other
+func copyDedupResult(result *dedupResult) (*EvaluationResponse, error) {
+	if result.err != nil || result.response == nil {
+		return nil, result.err
+	}
+	response, err := deep.Copy(result.response)
+	if err != nil {
+		return nil, NewInternalError("Failed to copy evaluation result", err.Error(), err)
+	}
+	return response, nil
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type countingEvaluationService struct {
+	calls    atomic.Int32
+	release  chan struct{}
+	response *EvaluationResponse
+	err      error
+}
+
+func (s *countingEvaluationService) EvaluateRequest(_ context.Context, _ *EvaluationRequest) (*EvaluationResponse, error) {
+	s.calls.Add(1)
+	if s.release != nil {
+		<-s.release
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &EvaluationResponse{
+		EvaluatedServiceInstance: map[string]any{"region": s.response.EvaluatedServiceInstance["region"]},
+		Status:                   s.response.Status,
+		FailedOpen:               s.response.FailedOpen,
+	}, nil
+}
+
+func (s *countingEvaluationService) EvaluateComposite(_ context.Context, _ *CompositeEvaluationRequest) (*CompositeEvaluationResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *countingEvaluationService) CreateSession(_ context.Context) (*EvaluationSession, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *countingEvaluationService) EvaluateSession(_ context.Context, _ string, _ map[string]any) (*SessionEvaluationResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (s *countingEvaluationService) FinalizeSession(_ context.Context, _ string) (*EvaluationResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+var _ = Describe("DeduplicatingEvaluationService", func() {
+	var (
+		ctx   context.Context
+		inner *countingEvaluationService
+		now   time.Time
+		bus   *events.Bus
+		svc   *deduplicatingEvaluationService
+	)
+
+	request := func(region string) *EvaluationRequest {
+		return &EvaluationRequest{
+			ServiceInstance: map[string]any{"service_type": "vm", "region": region},
+			RequestLabels:   map[string]string{"service_type": "vm"},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		inner = &countingEvaluationService{
+			response: &EvaluationResponse{
+				EvaluatedServiceInstance: map[string]any{"region": "us-east-1"},
+				Status:                   EvaluationStatusApproved,
+			},
+		}
+		bus = events.NewBus()
+		svc = NewDeduplicatingEvaluationService(inner, time.Second, bus).(*deduplicatingEvaluationService)
+		svc.now = func() time.Time { return now }
+	})
+
+	It("returns the wrapped service when the window is disabled", func() {
+		Expect(NewDeduplicatingEvaluationService(inner, 0, bus)).To(BeIdenticalTo(inner))
+	})
+
+	It("coalesces concurrent identical requests onto one evaluation", func() {
+		inner.release = make(chan struct{})
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				response, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Status).To(Equal(EvaluationStatusApproved))
+			}()
+		}
+		Eventually(inner.calls.Load).Should(Equal(int32(1)))
+		// Give the remaining goroutines time to join the in-flight call before releasing it
+		time.Sleep(20 * time.Millisecond)
+		close(inner.release)
+		wg.Wait()
+		Expect(inner.calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("reuses the result within the window and evaluates again after it", func() {
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(1)))
+
+		now = now.Add(time.Second)
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("neither reuses nor keeps the outcome of a dry run", func() {
+		dryRun := request("us-east-1")
+		dryRun.DryRun = true
+
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = svc.EvaluateRequest(ctx, dryRun)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+
+		svc.invalidate()
+		_, err = svc.EvaluateRequest(ctx, dryRun)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(4)))
+	})
+
+	It("evaluates requests with different specs separately", func() {
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		_, _ = svc.EvaluateRequest(ctx, request("eu-west-1"))
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("returns independent copies of a shared response", func() {
+		first, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		first.EvaluatedServiceInstance["region"] = "mutated"
+
+		second, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.EvaluatedServiceInstance["region"]).To(Equal("us-east-1"))
+	})
+
+	It("reuses policy rejections within the window", func() {
+		inner.err = NewPolicyRejectedError("deny", "not allowed")
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).To(MatchError(inner.err))
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).To(MatchError(inner.err))
+		Expect(inner.calls.Load()).To(Equal(int32(1)))
+	})
+
+	It("does not reuse internal errors", func() {
+		inner.err = errors.New("database unavailable")
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("does not reuse unavailable errors or approvals that failed open", func() {
+		inner.err = NewUnavailableError("Failed to retrieve policies", "database unavailable", nil)
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+
+		inner.err = nil
+		inner.response.FailedOpen = true
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(4)))
+	})
+
+	It("evaluates again after a policy change", func() {
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+
+		bus.Publish(ctx, events.PolicyUpdated{})
+		_, err = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("does not keep results of evaluations that started before a policy change", func() {
+		inner.release = make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+			Expect(err).NotTo(HaveOccurred())
+		}()
+		Eventually(inner.calls.Load).Should(Equal(int32(1)))
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "p1"})
+		close(inner.release)
+		<-done
+
+		inner.release = nil
+		_, err := svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inner.calls.Load()).To(Equal(int32(2)))
+	})
+
+	It("ignores evaluation events", func() {
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		bus.Publish(ctx, events.EvaluationRejected{PolicyID: "deny"})
+		_, _ = svc.EvaluateRequest(ctx, request("us-east-1"))
+		Expect(inner.calls.Load()).To(Equal(int32(1)))
+	})
+})
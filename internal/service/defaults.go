@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// PriorityStrategy selects the priority of a policy created without one
+type PriorityStrategy string
+
+const (
+	// PriorityFixed gives the policy DefaultPriority
+	PriorityFixed PriorityStrategy = "fixed"
+	// PriorityNextAvailable gives the policy the first priority from DefaultPriority up, or
+	// else down from it, that no other policy of its type has
+	PriorityNextAvailable PriorityStrategy = "next_available"
+	// PriorityLast gives the policy one more than the largest priority of its type, so it is
+	// evaluated after the existing ones, or DefaultPriority when it is the first of its type
+	PriorityLast PriorityStrategy = "last"
+)
+
+// ParsePriorityStrategy parses a default priority strategy; the empty string is fixed
+func ParsePriorityStrategy(strategy string) (PriorityStrategy, error) {
+	switch PriorityStrategy(strategy) {
+	case "", PriorityFixed:
+		return PriorityFixed, nil
+	case PriorityNextAvailable, PriorityLast:
+		return PriorityStrategy(strategy), nil
+	}
+	return "", fmt.Errorf("default priority strategy must be one of: fixed, next_available, last (got '%s')", strategy)
+}
+
+// PolicyDefaults are the values given to the optional fields a created policy omits
+type PolicyDefaults struct {
+	// Enabled is the enabled state of policies created without one
+	Enabled bool
+	// PriorityStrategy picks the priority of policies created without one
+	PriorityStrategy PriorityStrategy
+}
+
+// DefaultPolicyDefaults creates policies enabled at DefaultPriority
+var DefaultPolicyDefaults = PolicyDefaults{Enabled: true, PriorityStrategy: PriorityFixed}
+
+// WithPolicyDefaults sets the enabled state and priority strategy of policies created or
+// replaced without them instead of DefaultPolicyDefaults.
+func WithPolicyDefaults(defaults PolicyDefaults) PolicyServiceOption {
+	return func(s *PolicyServiceImpl) {
+		s.defaults = defaults
+	}
+}
+
+// fill sets the enabled state and priority of policy when they are omitted, to the default
+// enabled state and priority
+func (d PolicyDefaults) fill(policy v1alpha1.Policy, priority int32) v1alpha1.Policy {
+	if policy.Enabled == nil {
+		enabled := d.Enabled
+		policy.Enabled = &enabled
+	}
+	if policy.Priority == nil {
+		policy.Priority = &priority
+	}
+	return policy
+}
+
+// keptPriority returns the priority a policy created earlier keeps when it is created or
+// replaced again without one. A priority the strategy picked is not picked again, since the
+// policy itself now holds it, so the current one is kept.
+func (d PolicyDefaults) keptPriority(current int32) int32 {
+	if d.PriorityStrategy == PriorityFixed {
+		return DefaultPriority
+	}
+	return current
+}
+
+// withCreateDefaults fills in the enabled state and priority that policy, created as id,
+// omits. The priority strategies other than fixed look at the other policies of its type,
+// stored or pending creation with it.
+func (s *PolicyServiceImpl) withCreateDefaults(ctx context.Context, policy v1alpha1.Policy, id string, pending model.PolicyList) (v1alpha1.Policy, error) {
+	priority := int32(DefaultPriority)
+	if policy.Priority == nil && s.defaults.PriorityStrategy != PriorityFixed {
+		var err error
+		if priority, err = s.defaultPriority(ctx, string(*policy.PolicyType), id, pending); err != nil {
+			return v1alpha1.Policy{}, err
+		}
+	}
+	return s.defaults.fill(policy, priority), nil
+}
+
+// defaultPriority picks the priority of policy id of policyType according to the priority strategy
+func (s *PolicyServiceImpl) defaultPriority(ctx context.Context, policyType, id string, pending model.PolicyList) (int32, error) {
+	allPolicies, err := s.store.Policy().ListAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list policies for the default priority", "policy_id", id, "error", err)
+		return 0, NewInternalError("Failed to create policy", err.Error(), err)
+	}
+	taken := map[int32]bool{}
+	var largest int32
+	for _, p := range append(allPolicies, pending...) {
+		if p.PolicyType == policyType && p.ID != id {
+			taken[p.Priority] = true
+			largest = max(largest, p.Priority)
+		}
+	}
+
+	switch s.defaults.PriorityStrategy {
+	case PriorityLast:
+		if largest == 0 {
+			return DefaultPriority, nil
+		}
+		if largest < MaxPriority {
+			return largest + 1, nil
+		}
+	case PriorityNextAvailable:
+		for priority := int32(DefaultPriority); priority <= MaxPriority; priority++ {
+			if !taken[priority] {
+				return priority, nil
+			}
+		}
+		for priority := int32(DefaultPriority) - 1; priority >= MinPriority; priority-- {
+			if !taken[priority] {
+				return priority, nil
+			}
+		}
+	}
+	return 0, NewAlreadyExistsError(
+		"No default priority available",
+		fmt.Sprintf("No priority is left for a new %s policy by the %s strategy; set the priority explicitly", policyType, s.defaults.PriorityStrategy),
+	)
+}
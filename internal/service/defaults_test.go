@@ -0,0 +1,155 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Policy defaults", func() {
+	var (
+		db        *gorm.DB
+		dataStore store.Store
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		dataStore = store.NewStore(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	newPolicy := func(name string, priority *int32) v1alpha1.Policy {
+		regoCode := "package " + name + "\ndefault allow = true"
+		return v1alpha1.Policy{
+			DisplayName: strPtr(name),
+			PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+			Priority:    priority,
+			RegoCode:    &regoCode,
+		}
+	}
+
+	create := func(policyService *service.PolicyServiceImpl, name string, priority *int32) *v1alpha1.Policy {
+		created, err := policyService.CreatePolicy(ctx, newPolicy(name, priority), strPtr(name))
+		Expect(err).NotTo(HaveOccurred())
+		return created
+	}
+
+	priority := func(p int32) *int32 { return &p }
+
+	It("creates policies disabled when the default enabled state is false", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: false, PriorityStrategy: service.PriorityFixed}))
+
+		created := create(policyService, "reviewed", nil)
+
+		Expect(*created.Enabled).To(BeFalse())
+		Expect(*created.Priority).To(Equal(int32(service.DefaultPriority)))
+	})
+
+	It("keeps an explicit enabled state", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: false, PriorityStrategy: service.PriorityFixed}))
+		enabled := true
+		policy := newPolicy("enabled", nil)
+		policy.Enabled = &enabled
+
+		created, err := policyService.CreatePolicy(ctx, policy, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*created.Enabled).To(BeTrue())
+	})
+
+	It("picks the first free priority from 500 with the next_available strategy", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: true, PriorityStrategy: service.PriorityNextAvailable}))
+		create(policyService, "first", nil)
+		create(policyService, "explicit", priority(501))
+
+		created := create(policyService, "third", nil)
+
+		Expect(*created.Priority).To(Equal(int32(502)))
+	})
+
+	It("picks one more than the largest priority of the type with the last strategy", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: true, PriorityStrategy: service.PriorityLast}))
+		Expect(*create(policyService, "first", nil).Priority).To(Equal(int32(service.DefaultPriority)))
+		create(policyService, "explicit", priority(700))
+
+		created := create(policyService, "third", nil)
+
+		Expect(*created.Priority).To(Equal(int32(701)))
+	})
+
+	It("returns a conflict when the last strategy has no priority left", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: true, PriorityStrategy: service.PriorityLast}))
+		create(policyService, "lowest", priority(service.MaxPriority))
+
+		_, err := policyService.CreatePolicy(ctx, newPolicy("next", nil), nil)
+
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*service.ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(service.ErrorTypeAlreadyExists))
+	})
+
+	It("returns the existing policy on a retried create whose priority the strategy picked", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: false, PriorityStrategy: service.PriorityNextAvailable}))
+		create(policyService, "other", nil)
+		first, created, err := policyService.CreateOrGetPolicy(ctx, newPolicy("retried", nil), "retried")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		again, created, err := policyService.CreateOrGetPolicy(ctx, newPolicy("retried", nil), "retried")
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(*again.Priority).To(Equal(*first.Priority))
+		Expect(*again.Enabled).To(BeFalse())
+	})
+
+	It("keeps the picked priority and applies the default enabled state on replace", func() {
+		policyService := service.NewPolicyService(dataStore, opa.NewEngine(),
+			service.WithPolicyDefaults(service.PolicyDefaults{Enabled: false, PriorityStrategy: service.PriorityLast}))
+		create(policyService, "other", priority(800))
+		created := create(policyService, "replaced", nil)
+		Expect(*created.Priority).To(Equal(int32(801)))
+
+		replaced, _, err := policyService.ApplyPolicy(ctx, "replaced", newPolicy("replaced", nil), false)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*replaced.Priority).To(Equal(int32(801)))
+		Expect(*replaced.Enabled).To(BeFalse())
+	})
+
+	Describe("ParsePriorityStrategy", func() {
+		It("defaults to fixed and rejects unknown strategies", func() {
+			Expect(service.ParsePriorityStrategy("")).To(Equal(service.PriorityFixed))
+			Expect(service.ParsePriorityStrategy("last")).To(Equal(service.PriorityLast))
+			_, err := service.ParsePriorityStrategy("random")
+			Expect(err).To(MatchError(ContainSubstring("must be one of: fixed, next_available, last")))
+		})
+	})
+})
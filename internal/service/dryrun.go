@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	v1alpha1 "github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// DryRunCreatePolicy runs the validation of CreatePolicy, including the Rego compile check and
+// the uniqueness of the ID, display name and priority, and returns the policy that would be
+// created without storing it. The returned policy has no create or update time.
+func (s *PolicyServiceImpl) DryRunCreatePolicy(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error) {
+	dbPolicy, err := s.prepareCreate(ctx, policy, clientID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUnique(ctx, dbPolicy, false, "create"); err != nil {
+		return nil, err
+	}
+
+	apiPolicy := DBToAPIModel(&dbPolicy)
+	apiPolicy.CreateTime = nil
+	apiPolicy.UpdateTime = nil
+	apiPolicy.Warnings = regoWarnings(ctx, dbPolicy.ID, dbPolicy.RegoCode)
+	logging.FromContext(ctx).Debug("Policy create dry run succeeded", "policy_id", dbPolicy.ID)
+	return &apiPolicy, nil
+}
+
+// DryRunUpdatePolicy runs the validation of UpdatePolicy and returns the policy as it would
+// be after the update without storing it. The returned update time is the current one.
+func (s *PolicyServiceImpl) DryRunUpdatePolicy(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+	existingDB, merged, err := s.prepareUpdate(ctx, id, patch)
+	if err != nil {
+		return nil, err
+	}
+	if patch != nil && patch.RegoCode != nil {
+		if err := s.validateUpdatedRego(ctx, id, *merged.RegoCode); err != nil {
+			return nil, err
+		}
+	}
+
+	dbPolicy := APIToDBModel(merged, id)
+	if err := s.checkUnique(ctx, dbPolicy, true, "update"); err != nil {
+		return nil, err
+	}
+
+	dbPolicy.CreateTime = existingDB.CreateTime
+	dbPolicy.UpdateTime = existingDB.UpdateTime
+	dbPolicy.CreatedBy = existingDB.CreatedBy
+	dbPolicy.UpdatedBy = PrincipalFromContext(ctx)
+	apiPolicy := DBToAPIModel(&dbPolicy)
+	apiPolicy.Warnings = regoWarnings(ctx, id, dbPolicy.RegoCode)
+	logging.FromContext(ctx).Debug("Policy update dry run succeeded", "policy_id", id)
+	return &apiPolicy, nil
+}
+
+// DryRunDeletePolicy returns the error DeletePolicy would return without deleting the policy
+func (s *PolicyServiceImpl) DryRunDeletePolicy(ctx context.Context, id string) error {
+	if _, err := s.store.Policy().Get(ctx, id); err != nil {
+		if errors.Is(err, store.ErrPolicyNotFound) {
+			return NewPolicyNotFoundError(id)
+		}
+		logging.FromContext(ctx).Error("Failed to get policy for delete dry run", "policy_id", id, "error", err)
+		return NewInternalError("Failed to delete policy", err.Error(), err)
+	}
+	logging.FromContext(ctx).Debug("Policy delete dry run succeeded", "policy_id", id)
+	return nil
+}
+
+// checkUnique returns the error storing dbPolicy would fail with on a unique constraint
+func (s *PolicyServiceImpl) checkUnique(ctx context.Context, dbPolicy model.Policy, isUpdate bool, operation string) error {
+	if err := s.store.Policy().CheckUnique(ctx, dbPolicy, isUpdate); err != nil {
+		return processPolicyStoreError(err, dbPolicy, operation)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Dry runs", func() {
+	var (
+		ctx           context.Context
+		dataStore     store.Store
+		engine        opa.Engine
+		policyService *service.PolicyServiceImpl
+		existing      *v1alpha1.Policy
+	)
+
+	priority := int32(100)
+
+	expectErrorType := func(err error, errorType service.ErrorType) {
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*service.ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(errorType))
+	}
+
+	storedIDs := func() []string {
+		policies, err := dataStore.Policy().ListAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		ids := make([]string, len(policies))
+		for i, p := range policies {
+			ids[i] = p.ID
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		DeferCleanup(func() {
+			sqlDB, _ := db.DB()
+			_ = sqlDB.Close()
+		})
+
+		ctx = context.Background()
+		dataStore = store.NewStore(db)
+		engine = opa.NewEngine()
+		policyService = service.NewPolicyService(dataStore, engine)
+
+		existing, err = policyService.CreatePolicy(ctx, v1alpha1.Policy{
+			DisplayName: strPtr("Existing"),
+			PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+			RegoCode:    strPtr("package existing\nmain := {\"rejected\": false}"),
+		}, strPtr("existing"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("DryRunCreatePolicy", func() {
+		It("returns the policy that would be created without storing it", func() {
+			policy, err := policyService.DryRunCreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("New"),
+				PolicyType:  policyTypePtr(v1alpha1.USER),
+				RegoCode:    strPtr("package new\nmain := {\"rejected\": false}"),
+			}, strPtr("new"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*policy.Id).To(Equal("new"))
+			Expect(*policy.Path).To(Equal("policies/new"))
+			Expect(*policy.Enabled).To(BeTrue())
+			Expect(*policy.Priority).To(Equal(int32(service.DefaultPriority)))
+			Expect(policy.CreateTime).To(BeNil())
+			Expect(storedIDs()).To(ConsistOf("existing"))
+
+			result, err := engine.EvaluatePolicy(ctx, "new", map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Defined).To(BeFalse())
+		})
+
+		It("rejects what creating would reject", func() {
+			_, err := policyService.DryRunCreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Existing"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package other\nmain := {\"rejected\": false}"),
+				Priority:    &priority,
+			}, strPtr("other"))
+			expectErrorType(err, service.ErrorTypeAlreadyExists)
+
+			_, err = policyService.DryRunCreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Existing"),
+				PolicyType:  policyTypePtr(v1alpha1.USER),
+				RegoCode:    strPtr("package other\nmain := {\"rejected\": false}"),
+			}, strPtr("existing"))
+			expectErrorType(err, service.ErrorTypeAlreadyExists)
+
+			_, err = policyService.DryRunCreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Broken"),
+				PolicyType:  policyTypePtr(v1alpha1.USER),
+				RegoCode:    strPtr("package broken\nmain := {"),
+			}, strPtr("broken"))
+			expectErrorType(err, service.ErrorTypeInvalidArgument)
+
+			_, err = policyService.DryRunCreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Bad ID"),
+				PolicyType:  policyTypePtr(v1alpha1.USER),
+				RegoCode:    strPtr("package badid\nmain := {\"rejected\": false}"),
+			}, strPtr("Bad_ID"))
+			expectErrorType(err, service.ErrorTypeInvalidArgument)
+		})
+	})
+
+	Describe("DryRunUpdatePolicy", func() {
+		It("returns the updated policy without storing it", func() {
+			policy, err := policyService.DryRunUpdatePolicy(ctx, "existing", &v1alpha1.Policy{
+				DisplayName: strPtr("Renamed"),
+				RegoCode:    strPtr("package existing\nmain := {\"rejected\": true}"),
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*policy.DisplayName).To(Equal("Renamed"))
+			Expect(*policy.CreateTime).To(BeTemporally("==", *existing.CreateTime))
+
+			stored, err := policyService.GetPolicy(ctx, "existing")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stored.DisplayName).To(Equal("Existing"))
+			result, err := engine.EvaluatePolicy(ctx, "existing", map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Result).To(HaveKeyWithValue("rejected", false))
+		})
+
+		It("rejects what updating would reject", func() {
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Second"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package second\nmain := {\"rejected\": false}"),
+				Priority:    &priority,
+			}, strPtr("second"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = policyService.DryRunUpdatePolicy(ctx, "second", &v1alpha1.Policy{DisplayName: strPtr("Existing")})
+			expectErrorType(err, service.ErrorTypeAlreadyExists)
+
+			_, err = policyService.DryRunUpdatePolicy(ctx, "existing", &v1alpha1.Policy{PolicyType: policyTypePtr(v1alpha1.USER)})
+			expectErrorType(err, service.ErrorTypeInvalidArgument)
+
+			_, err = policyService.DryRunUpdatePolicy(ctx, "missing", &v1alpha1.Policy{DisplayName: strPtr("Missing")})
+			expectErrorType(err, service.ErrorTypeNotFound)
+		})
+	})
+
+	Describe("DryRunDeletePolicy", func() {
+		It("checks the policy exists without deleting it", func() {
+			Expect(policyService.DryRunDeletePolicy(ctx, "existing")).To(Succeed())
+			Expect(storedIDs()).To(ConsistOf("existing"))
+
+			expectErrorType(policyService.DryRunDeletePolicy(ctx, "missing"), service.ErrorTypeNotFound)
+		})
+	})
+})
@@ -3,6 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dcm-project/policy-manager/internal/opa"
 )
@@ -13,6 +14,21 @@ func handleEngineError(err error, operation string) *ServiceError {
 		return nil
 	}
 
+	var invalidRego *opa.InvalidRegoError
+	if errors.As(err, &invalidRego) {
+		issues := make([]string, len(invalidRego.Issues))
+		for i, issue := range invalidRego.Issues {
+			issues[i] = issue.String()
+		}
+		problem := "contains syntax errors"
+		if invalidRego.Compile {
+			problem = "does not compile"
+		}
+		return NewInvalidArgumentError(
+			"Invalid Rego code",
+			fmt.Sprintf("The Rego code %s: %s", problem, strings.Join(issues, "; ")),
+		)
+	}
 	if errors.Is(err, opa.ErrInvalidRego) {
 		return NewInvalidArgumentError(
 			"Invalid Rego code",
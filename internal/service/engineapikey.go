@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/google/uuid"
+)
+
+const (
+	// engineAPIKeyPrefix starts every generated key, so leaked keys are easy to recognize
+	engineAPIKeyPrefix = "pmk_"
+	// engineAPIKeyBytes is the number of random bytes of a generated key
+	engineAPIKeyBytes = 32
+	// engineAPIKeyShownLength is the number of leading characters of a key kept as its prefix
+	engineAPIKeyShownLength = 10
+
+	maxEngineAPIKeyIdentityLength = 256
+)
+
+// EngineAPIKeyService manages the API keys identifying engine API callers
+type EngineAPIKeyService interface {
+	CreateEngineAPIKey(ctx context.Context, key v1alpha1.EngineApiKey, id *string) (*v1alpha1.EngineApiKey, error)
+	ListEngineAPIKeys(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.EngineApiKeyList, error)
+	DeleteEngineAPIKey(ctx context.Context, id string) error
+}
+
+// EngineAPIKeyServiceImpl implements EngineAPIKeyService. It generates the keys and stores
+// their digests, which Identity looks them up by.
+type EngineAPIKeyServiceImpl struct {
+	store  store.EngineAPIKey
+	tokens *pagetoken.Codec
+	pages  PageSizeLimits
+}
+
+var _ EngineAPIKeyService = (*EngineAPIKeyServiceImpl)(nil)
+
+// EngineAPIKeyOption configures optional EngineAPIKeyServiceImpl behaviour.
+type EngineAPIKeyOption func(*EngineAPIKeyServiceImpl)
+
+// WithEngineAPIKeyPageTokens signs list page tokens with codec instead of a per-process random key.
+func WithEngineAPIKeyPageTokens(codec *pagetoken.Codec) EngineAPIKeyOption {
+	return func(s *EngineAPIKeyServiceImpl) {
+		s.tokens = codec
+	}
+}
+
+// WithEngineAPIKeyPageSizeLimits sets the default and maximum page size of list requests.
+func WithEngineAPIKeyPageSizeLimits(limits PageSizeLimits) EngineAPIKeyOption {
+	return func(s *EngineAPIKeyServiceImpl) {
+		s.pages = limits
+	}
+}
+
+// NewEngineAPIKeyService creates a new EngineAPIKeyService instance.
+func NewEngineAPIKeyService(keyStore store.EngineAPIKey, opts ...EngineAPIKeyOption) *EngineAPIKeyServiceImpl {
+	s := &EngineAPIKeyServiceImpl{
+		store:  keyStore,
+		tokens: pagetoken.NewEphemeral(pagetoken.DefaultTTL),
+		pages:  DefaultPageSizeLimits,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// CreateEngineAPIKey generates a key identifying callers as key.Identity and stores its
+// digest under id, or a generated ID when id is nil. The key is only in the returned copy.
+func (s *EngineAPIKeyServiceImpl) CreateEngineAPIKey(ctx context.Context, key v1alpha1.EngineApiKey, id *string) (*v1alpha1.EngineApiKey, error) {
+	log := logging.FromContext(ctx)
+
+	identity := strings.TrimSpace(key.Identity)
+	if identity == "" || len(identity) > maxEngineAPIKeyIdentityLength {
+		return nil, NewInvalidArgumentError(
+			"Invalid identity",
+			fmt.Sprintf("identity must be 1-%d bytes", maxEngineAPIKeyIdentityLength),
+		)
+	}
+	newID := uuid.New().String()
+	if id != nil && *id != "" {
+		if !idPattern.MatchString(*id) {
+			return nil, NewInvalidArgumentError(
+				"Invalid key ID format",
+				fmt.Sprintf("Key ID '%s' does not match required format: 1-63 characters, start with lowercase letter, contain only lowercase letters, numbers, and hyphens, end with letter or number", *id),
+			)
+		}
+		newID = *id
+	}
+
+	secret := make([]byte, engineAPIKeyBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, NewInternalError("Failed to generate engine API key", err.Error(), err)
+	}
+	plaintext := engineAPIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	created, err := s.store.Create(ctx, model.EngineAPIKey{
+		ID:        newID,
+		Identity:  identity,
+		Digest:    authz.APIKeyDigest(plaintext),
+		Prefix:    plaintext[:engineAPIKeyShownLength],
+		CreatedBy: PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrEngineAPIKeyIDTaken) {
+			return nil, NewAlreadyExistsError("Engine API key already exists", fmt.Sprintf("Engine API key with ID '%s' already exists", newID))
+		}
+		log.Error("Failed to create engine API key in store", "key_id", newID, "error", err)
+		return nil, NewInternalError("Failed to create engine API key", err.Error(), err)
+	}
+
+	log.Info("Engine API key created", "key_id", newID, "identity", identity)
+	apiKey := EngineAPIKeyToAPIModel(created)
+	apiKey.Key = &plaintext
+	return &apiKey, nil
+}
+
+// ListEngineAPIKeys returns a page of the stored keys, oldest first, without the keys themselves.
+func (s *EngineAPIKeyServiceImpl) ListEngineAPIKeys(ctx context.Context, pageToken *string, pageSize *int32) (*v1alpha1.EngineApiKeyList, error) {
+	size, err := s.pages.parsePageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	scope := pagetoken.Scope("engine-api-keys")
+	offset, err := decodePageToken(s.tokens, pageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.store.List(ctx, &store.EngineAPIKeyListOptions{Offset: offset, PageSize: size})
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list engine API keys from store", "error", err)
+		return nil, NewInternalError("Failed to list engine API keys", err.Error(), err)
+	}
+
+	keys := make([]v1alpha1.EngineApiKey, len(result.Keys))
+	for i, key := range result.Keys {
+		keys[i] = EngineAPIKeyToAPIModel(&key)
+	}
+	response := &v1alpha1.EngineApiKeyList{Keys: keys, PageSize: int32(size)}
+	if result.NextOffset > 0 {
+		nextPageToken := s.tokens.Encode(result.NextOffset, scope)
+		response.NextPageToken = &nextPageToken
+	}
+	return response, nil
+}
+
+// DeleteEngineAPIKey revokes a stored key.
+func (s *EngineAPIKeyServiceImpl) DeleteEngineAPIKey(ctx context.Context, id string) error {
+	log := logging.FromContext(ctx)
+	if err := s.store.Delete(ctx, id); err != nil {
+		if errors.Is(err, store.ErrEngineAPIKeyNotFound) {
+			return NewEngineAPIKeyNotFoundError(id)
+		}
+		log.Error("Failed to delete engine API key in store", "key_id", id, "error", err)
+		return NewInternalError("Failed to delete engine API key", err.Error(), err)
+	}
+	log.Info("Engine API key deleted", "key_id", id)
+	return nil
+}
+
+// Identity returns the identity of the stored key with the digest, or "" when there is none.
+// It is the authz.APIKeyLookup of the stored keys.
+func (s *EngineAPIKeyServiceImpl) Identity(ctx context.Context, digest string) (string, error) {
+	key, err := s.store.GetByDigest(ctx, digest)
+	if errors.Is(err, store.ErrEngineAPIKeyNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return key.Identity, nil
+}
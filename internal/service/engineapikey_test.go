@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/authz"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("EngineAPIKeyService", func() {
+	var (
+		db         *gorm.DB
+		keyService *service.EngineAPIKeyServiceImpl
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.EngineAPIKey{})).To(Succeed())
+
+		keyService = service.NewEngineAPIKeyService(store.NewStore(db).EngineAPIKey())
+		ctx = service.ContextWithPrincipal(context.Background(), "alice")
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	It("returns the generated key only when it is created", func() {
+		id := "orchestrator-key"
+		created, err := keyService.CreateEngineAPIKey(ctx, v1alpha1.EngineApiKey{Identity: " orchestrator "}, &id)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created.Key).NotTo(BeNil())
+		Expect(*created.Key).To(HavePrefix("pmk_"))
+		Expect(created.Identity).To(Equal("orchestrator"))
+		Expect(*created.KeyPrefix).To(Equal((*created.Key)[:10]))
+		Expect(*created.CreatedBy).To(Equal("alice"))
+
+		identity, err := keyService.Identity(ctx, authz.APIKeyDigest(*created.Key))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity).To(Equal("orchestrator"))
+
+		list, err := keyService.ListEngineAPIKeys(ctx, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Keys).To(HaveLen(1))
+		Expect(list.Keys[0].Key).To(BeNil())
+	})
+
+	It("rejects invalid identities and taken IDs", func() {
+		_, err := keyService.CreateEngineAPIKey(ctx, v1alpha1.EngineApiKey{Identity: strings.Repeat("a", 257)}, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeInvalidArgument))
+
+		id := "orchestrator-key"
+		_, err = keyService.CreateEngineAPIKey(ctx, v1alpha1.EngineApiKey{Identity: "orchestrator"}, &id)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = keyService.CreateEngineAPIKey(ctx, v1alpha1.EngineApiKey{Identity: "orchestrator"}, &id)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeAlreadyExists))
+	})
+
+	It("revokes deleted keys", func() {
+		created, err := keyService.CreateEngineAPIKey(ctx, v1alpha1.EngineApiKey{Identity: "orchestrator"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(keyService.DeleteEngineAPIKey(ctx, *created.Id)).To(Succeed())
+		identity, err := keyService.Identity(ctx, authz.APIKeyDigest(*created.Key))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identity).To(BeEmpty())
+
+		err = keyService.DeleteEngineAPIKey(ctx, *created.Id)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeNotFound))
+	})
+})
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
 	"github.com/dcm-project/policy-manager/internal/store"
 	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
 	"gorm.io/gorm"
 )
 
@@ -22,6 +24,8 @@ const (
 	ErrorTypeFailedPrecondition ErrorType = "FAILED_PRECONDITION"
 	ErrorTypeRejected           ErrorType = "REJECTED"        // Policy evaluation rejected
 	ErrorTypePolicyConflict     ErrorType = "POLICY_CONFLICT" // Policy constraint conflict
+	ErrorTypeResourceExhausted  ErrorType = "RESOURCE_EXHAUSTED"
+	ErrorTypeUnavailable        ErrorType = "UNAVAILABLE" // Policy store or engine unavailable
 )
 
 // ServiceError represents a structured error from the service layer
@@ -30,6 +34,12 @@ type ServiceError struct {
 	Message string
 	Detail  string
 	Err     error
+	// Explanation traces the evaluation up to the failing policy; set only in explain mode
+	Explanation *Explanation
+	// Rejection identifies the rejecting policy; set only for policy rejections
+	Rejection *Rejection
+	// Suggestions are ways to resolve a constraint conflict; set only for policy conflicts
+	Suggestions []constraints.Suggestion
 }
 
 func (e *ServiceError) Error() string {
@@ -73,6 +83,22 @@ func NewPolicyNotFoundError(policyID string) *ServiceError {
 	return NewNotFoundError("Policy not found", fmt.Sprintf("Policy with ID '%s' does not exist", policyID))
 }
 
+func NewPolicyRevisionNotFoundError(policyID string, revision int64) *ServiceError {
+	return NewNotFoundError("Policy revision not found", fmt.Sprintf("Policy '%s' has no revision %d", policyID, revision))
+}
+
+func NewPolicyTestNotFoundError(policyID, testID string) *ServiceError {
+	return NewNotFoundError("Policy test not found", fmt.Sprintf("Policy '%s' has no test '%s'", policyID, testID))
+}
+
+func NewEngineAPIKeyNotFoundError(keyID string) *ServiceError {
+	return NewNotFoundError("Engine API key not found", fmt.Sprintf("Engine API key '%s' does not exist", keyID))
+}
+
+func NewSessionNotFoundError(sessionID string) *ServiceError {
+	return NewNotFoundError("Evaluation session not found", fmt.Sprintf("Evaluation session '%s' does not exist or has expired", sessionID))
+}
+
 // NewNotFoundError creates a new not found error
 func NewNotFoundError(message, detail string) *ServiceError {
 	return &ServiceError{
@@ -100,6 +126,17 @@ func NewPolicyPriorityPolicyTypeTakenError(priority int32, policyType v1alpha1.P
 	)
 }
 
+// NewPolicyLockedError reports that another owner holds the lock on a policy
+func NewPolicyLockedError(policyID string, lock *model.PolicyLock) *ServiceError {
+	if lock == nil {
+		return NewAlreadyExistsError("Policy locked", fmt.Sprintf("Policy '%s' is locked by another owner", policyID))
+	}
+	return NewAlreadyExistsError(
+		"Policy locked",
+		fmt.Sprintf("Policy '%s' is locked by '%s' until %s", policyID, lock.Owner, lock.ExpireTime.UTC().Format(time.RFC3339)),
+	)
+}
+
 // NewAlreadyExistsError creates a new already exists error
 func NewAlreadyExistsError(message, detail string) *ServiceError {
 	return &ServiceError{
@@ -119,6 +156,16 @@ func NewInternalError(message, detail string, err error) *ServiceError {
 	}
 }
 
+// NewUnavailableError creates a new error for a policy store or engine that cannot serve a request
+func NewUnavailableError(message, detail string, err error) *ServiceError {
+	return &ServiceError{
+		Type:    ErrorTypeUnavailable,
+		Message: message,
+		Detail:  detail,
+		Err:     err,
+	}
+}
+
 // NewFailedPreconditionError creates a new failed precondition error
 func NewFailedPreconditionError(message, detail string) *ServiceError {
 	return &ServiceError{
@@ -131,9 +178,10 @@ func NewFailedPreconditionError(message, detail string) *ServiceError {
 // NewPolicyRejectedError creates a new policy rejected error (406 Not Acceptable)
 func NewPolicyRejectedError(policyID, reason string) *ServiceError {
 	return &ServiceError{
-		Type:    ErrorTypeRejected,
-		Message: fmt.Sprintf("Request rejected by policy '%s'", policyID),
-		Detail:  reason,
+		Type:      ErrorTypeRejected,
+		Message:   fmt.Sprintf("Request rejected by policy '%s'", policyID),
+		Detail:    reason,
+		Rejection: &Rejection{PolicyID: policyID, Reason: reason},
 	}
 }
 
@@ -148,41 +196,122 @@ func NewPolicyConflictError(lowerPolicyID, field, higherPolicyID string) *Servic
 
 // NewConstraintViolationError creates a new constraint violation error (409 Conflict)
 // This is used when a patch value violates accumulated constraints from higher-priority policies
-func NewConstraintViolationError(policyID string, violations []ConstraintViolation) *ServiceError {
+func NewConstraintViolationError(policyID string, violations []constraints.Violation) *ServiceError {
 	parts := make([]string, len(violations))
 	for i, v := range violations {
 		parts[i] = fmt.Sprintf("field '%s': %s (constrained by policy '%s')", v.FieldPath, v.Reason, v.SetByPolicy)
 	}
 	detail := fmt.Sprintf("Constraint violations: %s", strings.Join(parts, "; "))
 	return &ServiceError{
-		Type:    ErrorTypePolicyConflict,
-		Message: fmt.Sprintf("Policy '%s' produced values that violate constraints set by higher-priority policies", policyID),
-		Detail:  detail,
+		Type:        ErrorTypePolicyConflict,
+		Message:     fmt.Sprintf("Policy '%s' produced values that violate constraints set by higher-priority policies", policyID),
+		Detail:      detail,
+		Suggestions: violationSuggestions(violations),
+	}
+}
+
+// NewSessionConstraintViolationError creates a constraint violation error (409 Conflict) for a
+// session step whose spec violates constraints accumulated by earlier steps
+func NewSessionConstraintViolationError(violations []constraints.Violation) *ServiceError {
+	parts := make([]string, len(violations))
+	for i, v := range violations {
+		parts[i] = fmt.Sprintf("field '%s': %s (constrained by policy '%s')", v.FieldPath, v.Reason, v.SetByPolicy)
+	}
+	return &ServiceError{
+		Type:        ErrorTypePolicyConflict,
+		Message:     "The spec violates constraints set by policies in earlier steps of the session",
+		Detail:      fmt.Sprintf("Constraint violations: %s", strings.Join(parts, "; ")),
+		Suggestions: violationSuggestions(violations),
 	}
 }
 
+// violationSuggestions returns the suggestions of the violations that have one
+func violationSuggestions(violations []constraints.Violation) []constraints.Suggestion {
+	var suggestions []constraints.Suggestion
+	for _, v := range violations {
+		if v.Suggestion != nil {
+			suggestions = append(suggestions, *v.Suggestion)
+		}
+	}
+	return suggestions
+}
+
 // NewConstraintConflictError creates a new constraint conflict error (409 Conflict)
 // This is used when a lower-priority policy tries to loosen constraints set by a higher-priority policy
-func NewConstraintConflictError(policyID, fieldPath, existingPolicyID, reason string) *ServiceError {
+func NewConstraintConflictError(policyID, fieldPath, existingPolicyID, reason string, suggestion *constraints.Suggestion) *ServiceError {
+	return &ServiceError{
+		Type:        ErrorTypePolicyConflict,
+		Message:     fmt.Sprintf("Policy '%s' attempted to loosen constraint on field '%s' set by higher-priority policy '%s'", policyID, fieldPath, existingPolicyID),
+		Detail:      reason,
+		Suggestions: suggestionList(suggestion),
+	}
+}
+
+// NewPatchConflictError creates a patch conflict error (409 Conflict) for a policy that
+// overwrote fields set by policies of comparable priority
+func NewPatchConflictError(policyID string, overwrites []PatchOverwrite) *ServiceError {
+	parts := make([]string, len(overwrites))
+	for i, o := range overwrites {
+		parts[i] = fmt.Sprintf("field '%s' (set by policy '%s')", o.FieldPath, o.PreviousPolicyID)
+	}
+	return &ServiceError{
+		Type: ErrorTypePolicyConflict,
+		Message: fmt.Sprintf("Policy '%s' overwrote field '%s' set by policy '%s' of comparable priority",
+			policyID, overwrites[0].FieldPath, overwrites[0].PreviousPolicyID),
+		Detail: fmt.Sprintf("Fields overwritten with a different value: %s", strings.Join(parts, "; ")),
+	}
+}
+
+// NewProtectedFieldError creates a protected field error (409 Conflict) for a policy whose
+// patch would change a spec field no policy may change
+func NewProtectedFieldError(policyID, field string) *ServiceError {
 	return &ServiceError{
 		Type:    ErrorTypePolicyConflict,
-		Message: fmt.Sprintf("Policy '%s' attempted to loosen constraint on field '%s' set by higher-priority policy '%s'", policyID, fieldPath, existingPolicyID),
-		Detail:  reason,
+		Message: fmt.Sprintf("Policy '%s' attempted to modify protected field '%s'", policyID, field),
+		Detail:  fmt.Sprintf("Field '%s' is protected by server configuration and cannot be changed by policies", field),
 	}
 }
 
-// NewServiceProviderConstraintError creates a new SP constraint error (409 Conflict)
-func NewServiceProviderConstraintError(policyID, detail string) *ServiceError {
+// NewUnknownPatchFieldError creates an unknown patch field error (409 Conflict) for a policy
+// whose patch touches fields not allowed for the service type in the strict patch field mode
+func NewUnknownPatchFieldError(policyID, serviceType string, fields []string) *ServiceError {
 	return &ServiceError{
 		Type:    ErrorTypePolicyConflict,
-		Message: fmt.Sprintf("Policy '%s' selected a service provider that violates constraints", policyID),
-		Detail:  detail,
+		Message: fmt.Sprintf("Policy '%s' patched field '%s', which is not allowed for service type '%s'", policyID, fields[0], serviceType),
+		Detail:  fmt.Sprintf("Fields not allowed for service type '%s': %s", serviceType, strings.Join(fields, ", ")),
+	}
+}
+
+// NewServiceProviderConstraintError creates a new SP constraint error (409 Conflict)
+func NewServiceProviderConstraintError(policyID, detail string, suggestion *constraints.Suggestion) *ServiceError {
+	return &ServiceError{
+		Type:        ErrorTypePolicyConflict,
+		Message:     fmt.Sprintf("Policy '%s' selected a service provider that violates constraints", policyID),
+		Detail:      detail,
+		Suggestions: suggestionList(suggestion),
+	}
+}
+
+// suggestionList returns suggestion as a list, empty when it is nil
+func suggestionList(suggestion *constraints.Suggestion) []constraints.Suggestion {
+	if suggestion == nil {
+		return nil
+	}
+	return []constraints.Suggestion{*suggestion}
+}
+
+// NewTooManySessionsError creates a resource exhausted error (429 Too Many Requests) for a
+// session that cannot be opened because the maximum number of sessions is open
+func NewTooManySessionsError(limit int) *ServiceError {
+	return &ServiceError{
+		Type:    ErrorTypeResourceExhausted,
+		Message: "Too many evaluation sessions",
+		Detail:  fmt.Sprintf("At most %d evaluation sessions can be open at once; finalize a session or wait for one to expire", limit),
 	}
 }
 
-// ConstraintViolation represents a single constraint violation
-type ConstraintViolation struct {
-	FieldPath   string
-	Reason      string
-	SetByPolicy string
+// PatchOverwrite is a field a policy changed after a policy of comparable priority set it
+type PatchOverwrite struct {
+	FieldPath        string
+	PreviousPolicyID string
 }
@@ -6,10 +6,14 @@ import (
 	"fmt"
 
 	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/events"
 	"github.com/dcm-project/policy-manager/internal/logging"
 	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
 	"github.com/dcm-project/policy-manager/internal/store"
 	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
 )
 
 // EvaluationStatus represents the status of the evaluation
@@ -18,17 +22,36 @@ type EvaluationStatus string
 const (
 	EvaluationStatusApproved EvaluationStatus = "APPROVED"
 	EvaluationStatusModified EvaluationStatus = "MODIFIED"
+	// EvaluationStatusApprovedWithWarnings replaces APPROVED for a failed-open approval when
+	// the request asked for extended statuses
+	EvaluationStatusApprovedWithWarnings EvaluationStatus = "APPROVED_WITH_WARNINGS"
+	// EvaluationStatusDryRunWouldReject answers a rejected dry run that asked for extended
+	// statuses, instead of the rejection error
+	EvaluationStatusDryRunWouldReject EvaluationStatus = "DRYRUN_WOULD_REJECT"
 )
 
 // EvaluationService defines the interface for policy evaluation
 type EvaluationService interface {
 	EvaluateRequest(ctx context.Context, req *EvaluationRequest) (*EvaluationResponse, error)
+	EvaluateComposite(ctx context.Context, req *CompositeEvaluationRequest) (*CompositeEvaluationResponse, error)
+	CreateSession(ctx context.Context) (*EvaluationSession, error)
+	EvaluateSession(ctx context.Context, id string, spec map[string]any) (*SessionEvaluationResponse, error)
+	FinalizeSession(ctx context.Context, id string) (*EvaluationResponse, error)
 }
 
 // EvaluationRequest represents a request for policy evaluation
 type EvaluationRequest struct {
 	ServiceInstance map[string]any
 	RequestLabels   map[string]string
+	Explain         bool           // record a per-policy trace in the response
+	DryRun          bool           // evaluate without publishing evaluation events
+	AcceptLanguage  string         // Accept-Language header selecting the language of rejection messages
+	RequestContext  RequestContext // who made the request and from where, passed to policies and audited
+	ExtendedStatus  bool           // answer with the statuses added after APPROVED and MODIFIED
+	InstanceID      string         // the existing instance being re-evaluated, empty for a new one
+	// PreferredProviders are the providers the caller would like, most preferred first; the
+	// first one the service provider constraints allow is selected when no policy selects one
+	PreferredProviders []string
 }
 
 // EvaluationResponse represents the response from policy evaluation
@@ -36,124 +59,346 @@ type EvaluationResponse struct {
 	EvaluatedServiceInstance map[string]any
 	SelectedProvider         string
 	Status                   EvaluationStatus
+	StatusReason             StatusReason
+	Rejection                *Rejection          // set only for DRYRUN_WOULD_REJECT
+	Explanation              *Explanation        // set only when explain mode was requested
+	DryRun                   bool                // the request was evaluated as a dry run
+	FailedOpen               bool                // approved unchanged because policies were unavailable
+	Warnings                 []EvaluationWarning // problems that did not fail the evaluation
 }
 
 // evaluationService implements EvaluationService
 type evaluationService struct {
-	policyStore store.Policy
-	engine      opa.Engine
+	policies              policySource
+	engine                opa.Engine
+	explainRedactedFields []string
+	events                *events.Bus
+	patchConflicts        PatchConflictMode
+	patchConflictWindow   int32
+	protectedFields       []string
+	sessions              *evaluationSessions
+	messages              *MessageCatalog // nil without a rejection message catalog
+	execution             ExecutionStrategy
+	phaseConcurrency      int
+	failureMode           FailureMode
+	policyTimeout         *runtimeconfig.Duration // nil or zero disables the per-policy deadline
+	deniedSpecFields      []string
+	deniedSpecFieldMode   DeniedSpecFieldMode
+	inputLayout           InputLayout
+	instances             InstanceProvider     // nil without an instance provider
+	patchFields           *PatchFieldAllowlist // nil disables the patch field check
+	patchFieldMode        PatchFieldMode
+}
+
+// EvaluationOption configures optional behavior of the evaluation service
+type EvaluationOption func(*evaluationService)
+
+// WithExplainRedactedFields masks the given dot-separated spec field paths in explain output
+func WithExplainRedactedFields(fields []string) EvaluationOption {
+	return func(s *evaluationService) {
+		s.explainRedactedFields = fields
+	}
+}
+
+// WithProtectedFields fails the evaluation with a conflict when a policy patch would change
+// any of the given dot-separated spec field paths or a field below them
+func WithProtectedFields(fields []string) EvaluationOption {
+	return func(s *evaluationService) {
+		s.protectedFields = fields
+	}
+}
+
+// WithEvaluationEvents publishes EvaluationCompleted on bus for every approved request and
+// EvaluationRejected whenever a policy rejects a request
+func WithEvaluationEvents(bus *events.Bus) EvaluationOption {
+	return func(s *evaluationService) {
+		s.events = bus
+	}
 }
 
 // NewEvaluationService creates a new evaluation service
-func NewEvaluationService(policyStore store.Policy, engine opa.Engine) EvaluationService {
-	return &evaluationService{
-		policyStore: policyStore,
-		engine:      engine,
+func NewEvaluationService(policyStore store.Policy, engine opa.Engine, opts ...EvaluationOption) EvaluationService {
+	s := &evaluationService{
+		policies:         storePolicies{policyStore},
+		engine:           engine,
+		patchConflicts:   PatchConflictsAllow,
+		execution:        ExecutionSequential,
+		phaseConcurrency: 1,
+		failureMode:      FailureModeClosed,
+		inputLayout:      InputLayoutFlat,
+		sessions:         newEvaluationSessions(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// evaluationState is the state threaded through the sequential evaluation of policies
+type evaluationState struct {
+	spec               map[string]any
+	selectedProvider   string
+	constraints        *constraints.Set
+	explanation        *Explanation // nil unless explain mode was requested
+	requestLabels      map[string]string
+	requestContext     map[string]string // nil when the request has no context
+	preferredProviders []string          // passed to policies and selected from when none selects a provider
+	previous           map[string]any    // the previous evaluation of the instance, nil when unknown
+	inputSpec          map[string]any    // the spec as submitted without denied fields, published with evaluation events
+	acceptLanguage     string
+	writers            *patchWriters       // nil unless patch conflicts are detected
+	warnings           []EvaluationWarning // returned with the response
+	events             *events.Bus         // nil in dry runs, so nothing is published
+	// speculative holds the results evaluated ahead of their turn for the current phase of
+	// a phased execution, by policy ID
+	speculative map[string]*speculativeResult
 }
 
 // EvaluateRequest evaluates a service instance request against all applicable policies
 func (s *evaluationService) EvaluateRequest(ctx context.Context, req *EvaluationRequest) (*EvaluationResponse, error) {
+	response, _, err := s.evaluate(ctx, req, constraints.NewSet())
+	if err != nil && s.failureMode == FailureModeOpen && isUnavailable(err) {
+		return s.failOpen(ctx, req, err)
+	}
+	if err != nil && req.DryRun && req.ExtendedStatus {
+		if rejected, ok := s.wouldReject(ctx, req, err); ok {
+			return rejected, nil
+		}
+	}
+	return response, err
+}
+
+// evaluate evaluates req starting from the accumulated constraints, which it updates, and
+// returns the final evaluation state along with the response
+func (s *evaluationService) evaluate(ctx context.Context, req *EvaluationRequest, accumulated *constraints.Set) (*EvaluationResponse, *evaluationState, error) {
 	log := logging.FromContext(ctx)
 	log.Debug("Starting policy evaluation", "label_count", len(req.RequestLabels))
 
-	// Initialize the current service instance spec (we'll modify this as we evaluate policies)
-	currentSpec, err := deep.Copy(req.ServiceInstance)
+	if err := validateRequestContext(req.RequestContext); err != nil {
+		return nil, nil, err
+	}
+	if err := validatePreferredProviders(req.PreferredProviders); err != nil {
+		return nil, nil, err
+	}
+	inputSpec, err := s.sanitizeSpec(ctx, req.ServiceInstance)
 	if err != nil {
-		return nil, NewInternalError("Failed to make a deep copy of the service instance spec", err.Error(), err)
+		return nil, nil, err
+	}
+	previous, err := s.previousInput(ctx, req)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// Initialize constraint context
-	constraintCtx := NewConstraintContext()
-
-	// Track selected provider across policies (starts unknown)
-	selectedProvider := ""
+	// Initialize the current service instance spec (we'll modify this as we evaluate policies)
+	currentSpec, err := deep.Copy(inputSpec)
+	if err != nil {
+		return nil, nil, NewInternalError("Failed to make a deep copy of the service instance spec", err.Error(), err)
+	}
 
-	// Paginate over all enabled policies, ordered by policy_type ASC, priority ASC
-	var pageToken *string
-	policiesEvaluated := 0
-	policiesSkipped := 0
-	for {
-		policyListResult, err := s.policyStore.List(ctx, &store.PolicyListOptions{
-			Filter: &store.PolicyFilter{
-				Enabled: boolPtr(true),
-			},
-			PageSize:  1000,
-			PageToken: pageToken,
-		})
+	// Selected provider starts unknown
+	state := &evaluationState{
+		spec:               currentSpec,
+		constraints:        accumulated,
+		requestLabels:      req.RequestLabels,
+		requestContext:     req.RequestContext.fields(),
+		preferredProviders: req.PreferredProviders,
+		previous:           previous,
+		inputSpec:          inputSpec,
+		acceptLanguage:     req.AcceptLanguage,
+		events:             s.events,
+	}
+	if req.DryRun {
+		state.events = nil
+	}
+	if req.Explain {
+		explainedSpec, err := redactPatch(currentSpec, s.explainRedactedFields)
 		if err != nil {
-			log.Error("Failed to retrieve policies for evaluation", "error", err)
-			return nil, NewInternalError("Failed to retrieve policies", err.Error(), err)
+			return nil, nil, NewInternalError("Failed to record the input spec for explanation", err.Error(), err)
 		}
-
-		// Evaluate each policy on this page sequentially
-		for _, policy := range policyListResult.Policies {
-			// Filter by label selector
-			if !MatchesLabelSelector(policy.LabelSelector, req.RequestLabels) {
-				policiesSkipped++
-				continue
-			}
-
-			log.Debug("Evaluating policy", "policy_id", policy.ID, "policy_type", policy.PolicyType, "priority", policy.Priority)
-
-			currentSpec, selectedProvider, err = s.evaluatePolicy(ctx, &policy, currentSpec, selectedProvider, constraintCtx)
-			if err != nil {
-				log.Warn("Policy evaluation failed", "policy_id", policy.ID, "error", err)
-				return nil, err
-			}
-			policiesEvaluated++
+		state.explanation = &Explanation{
+			InputSpec:       explainedSpec,
+			RequestLabels:   redactLabels(req.RequestLabels, s.explainRedactedFields),
+			Policies:        []PolicyTrace{},
+			SkippedPolicies: []SkippedPolicy{},
+			FieldProvenance: map[string]string{},
 		}
+	}
+	if s.patchConflicts != PatchConflictsAllow {
+		state.writers = &patchWriters{fields: map[string]string{}, priorities: map[string]int32{}}
+	}
 
-		if policyListResult.NextPageToken == "" {
-			break
+	// Evaluate each applicable policy in evaluation order
+	policiesEvaluated := 0
+	var skip func(*model.Policy)
+	if state.explanation != nil {
+		skip = func(policy *model.Policy) {
+			state.explanation.SkippedPolicies = append(state.explanation.SkippedPolicies, SkippedPolicy{
+				PolicyID: policy.ID,
+				Reason:   "label selector does not match the request labels",
+			})
 		}
-		pageToken = &policyListResult.NextPageToken
 	}
+	evaluate := func(policy *model.Policy) error {
+		log.Debug("Evaluating policy", "policy_id", policy.ID, "policy_type", policy.PolicyType, "priority", policy.Priority)
 
-	// Determine status
-	status := EvaluationStatusApproved
-	if !deep.Equal(req.ServiceInstance, currentSpec) {
-		status = EvaluationStatusModified
+		if err := s.evaluatePolicy(ctx, policy, state); err != nil {
+			log.Warn("Policy evaluation failed", "policy_id", policy.ID, "error", err)
+			return err
+		}
+		policiesEvaluated++
+		return nil
 	}
+	var policiesSkipped int
+	if s.execution == ExecutionPhased {
+		var applicable model.PolicyList
+		policiesSkipped, err = forEachApplicablePolicy(ctx, s.policies, req.RequestLabels, skip, func(policy *model.Policy) error {
+			applicable = append(applicable, *policy)
+			return nil
+		})
+		if err == nil {
+			err = s.evaluatePhases(ctx, applicable, state, evaluate)
+		}
+	} else {
+		policiesSkipped, err = forEachApplicablePolicy(ctx, s.policies, req.RequestLabels, skip, evaluate)
+	}
+	if err != nil {
+		var serviceErr *ServiceError
+		if state.explanation != nil && errors.As(err, &serviceErr) {
+			serviceErr.Explanation = state.explanation
+		}
+		return nil, nil, err
+	}
+
+	selectPreferredProvider(ctx, state)
+	status, reason := evaluationStatus(req.ServiceInstance, inputSpec, state.spec, policiesEvaluated)
 
 	log.Info("Policy evaluation completed",
 		"status", status,
+		"status_reason", reason,
 		"policies_evaluated", policiesEvaluated,
 		"policies_skipped", policiesSkipped,
-		"selected_provider", selectedProvider,
+		"selected_provider", state.selectedProvider,
+		"dry_run", req.DryRun,
 	)
+	state.events.Publish(ctx, events.EvaluationCompleted{
+		Status:            string(status),
+		SelectedProvider:  state.selectedProvider,
+		PoliciesEvaluated: policiesEvaluated,
+		RequestLabels:     req.RequestLabels,
+		RequestContext:    state.requestContext,
+		InputSpec:         state.inputSpec,
+	})
 
 	return &EvaluationResponse{
-		EvaluatedServiceInstance: currentSpec,
-		SelectedProvider:         selectedProvider,
+		EvaluatedServiceInstance: state.spec,
+		SelectedProvider:         state.selectedProvider,
 		Status:                   status,
-	}, nil
+		StatusReason:             reason,
+		Explanation:              state.explanation,
+		DryRun:                   req.DryRun,
+		Warnings:                 state.warnings,
+	}, state, nil
 }
 
-func (s *evaluationService) evaluatePolicy(
-	ctx context.Context,
-	policy *model.Policy,
-	currentSpec map[string]any,
-	selectedProvider string,
-	constraintCtx *ConstraintContext,
-) (map[string]any, string, error) {
-	log := logging.FromContext(ctx)
-	// 1. Build OPA input with constraints and SP constraints
-	opaInput := map[string]any{
-		"spec":     currentSpec,
-		"provider": selectedProvider,
+// policySource lists the policies evaluations run against
+type policySource interface {
+	// forEachEnabled calls fn for every enabled policy in evaluation order, stopping at the
+	// first error returned by fn and returning it unchanged
+	forEachEnabled(ctx context.Context, fn func(*model.Policy) error) error
+}
+
+// storePolicies reads the enabled policies from the store on every call
+type storePolicies struct {
+	store store.Policy
+}
+
+func (p storePolicies) forEachEnabled(ctx context.Context, fn func(*model.Policy) error) error {
+	return forEachEnabledPolicy(ctx, p.store, fn)
+}
+
+// forEachApplicablePolicy calls fn, in evaluation order, for every enabled policy of policies
+// whose label selector matches labels, and returns the number of enabled policies skipped.
+// skip, if not nil, is called for each skipped policy. It stops at the first error returned
+// by fn and returns it unchanged.
+func forEachApplicablePolicy(ctx context.Context, policies policySource, labels map[string]string, skip func(*model.Policy), fn func(*model.Policy) error) (int, error) {
+	skipped := 0
+	err := policies.forEachEnabled(ctx, func(policy *model.Policy) error {
+		// Filter by label selector
+		if !MatchesLabelSelector(policy.LabelSelector, labels) {
+			skipped++
+			if skip != nil {
+				skip(policy)
+			}
+			return nil
+		}
+		return fn(policy)
+	})
+	if err != nil {
+		return 0, err
 	}
-	if constraints := constraintCtx.GetConstraintsMap(); constraints != nil {
-		opaInput["constraints"] = constraints
+	return skipped, nil
+}
+
+// forEachEnabledPolicy calls fn for every enabled policy in evaluation order, stopping at the
+// first error returned by fn and returning it unchanged
+func forEachEnabledPolicy(ctx context.Context, policyStore store.Policy, fn func(*model.Policy) error) error {
+	// Paginate over all enabled policies, ordered by policy_type ASC, priority ASC
+	offset := 0
+	for {
+		policyListResult, err := policyStore.List(ctx, &store.PolicyListOptions{
+			Filter: &store.PolicyFilter{
+				Enabled: boolPtr(true),
+			},
+			PageSize: 1000,
+			Offset:   offset,
+		})
+		if err != nil {
+			logging.FromContext(ctx).Error("Failed to retrieve policies for evaluation", "error", err)
+			return NewUnavailableError("Failed to retrieve policies", err.Error(), err)
+		}
+
+		for _, policy := range policyListResult.Policies {
+			if err := fn(&policy); err != nil {
+				return err
+			}
+		}
+
+		if policyListResult.NextOffset == 0 {
+			return nil
+		}
+		offset = policyListResult.NextOffset
 	}
-	if spConstraints := constraintCtx.GetSPConstraintsMap(); spConstraints != nil {
-		opaInput["service_provider_constraints"] = spConstraints
+}
+
+func (s *evaluationService) evaluatePolicy(ctx context.Context, policy *model.Policy, state *evaluationState) (err error) {
+	log := logging.FromContext(ctx)
+	constraintCtx := state.constraints
+
+	// 1. Build OPA input with constraints and SP constraints. Its maps are reused by later
+	// evaluations once this one is done with them; the trace keeps a copy.
+	inputMaps := acquireInputMaps()
+	defer inputMaps.release()
+	opaInput := inputMaps.policyInput(s.inputLayout, state.spec, state.requestContext, state.preferredProviders, state.previous, state.selectedProvider, state.constraints, policy)
+
+	var trace *PolicyTrace
+	if state.explanation != nil {
+		traceInput, redactErr := redactInput(opaInput, s.explainRedactedFields)
+		if redactErr != nil {
+			return NewInternalError("Failed to record policy input for explanation", redactErr.Error(), redactErr)
+		}
+		trace = &PolicyTrace{PolicyID: policy.ID, Input: traceInput, Outcome: PolicyOutcomeApplied}
+		defer func() {
+			// err is the result of evaluatePolicy
+			trace.finish(err)
+			state.explanation.Policies = append(state.explanation.Policies, *trace)
+		}()
 	}
 
-	// 2. Evaluate the policy using the embedded engine
-	evalResult, err := s.engine.EvaluatePolicy(ctx, policy.ID, opaInput)
+	// 2. Evaluate the policy using the embedded engine. Evaluation errors, including timeouts,
+	// are the policy's own failure rather than an unavailable engine, so they never fail open.
+	evalResult, err := s.evaluateRego(ctx, policy.ID, opaInput, state)
 	if err != nil {
-		return nil, "", NewInternalError(
+		return NewInternalError(
 			fmt.Sprintf("Failed to evaluate policy '%s'", policy.ID),
 			err.Error(),
 			err,
@@ -163,96 +408,155 @@ func (s *evaluationService) evaluatePolicy(
 	// Skip if policy is undefined
 	if !evalResult.Defined {
 		log.Debug("Policy returned undefined result, skipping", "policy_id", policy.ID)
-		return currentSpec, selectedProvider, nil
+		if trace != nil {
+			trace.Outcome = PolicyOutcomeUndefined
+		}
+		return nil
 	}
 
 	// Parse the policy decision
 	decision := opa.ParsePolicyDecision(evalResult.Result)
+	if trace != nil {
+		trace.Constraints = decision.Constraints
+		trace.SelectedProvider = decision.SelectedProvider
+	}
 
 	// 3. Check for rejection
 	if decision.Rejected {
-		log.Info("Policy rejected request", "policy_id", policy.ID, "reason", decision.RejectionReason)
-		return nil, "", NewPolicyRejectedError(policy.ID, decision.RejectionReason)
+		log.Info("Policy rejected request", "policy_id", policy.ID, "reason", decision.RejectionReason, "code", decision.RejectionCode)
+		state.events.Publish(ctx, events.EvaluationRejected{
+			PolicyID:       policy.ID,
+			Reason:         s.rejectionMessage(policy, decision, ""), // untranslated, so records do not vary by client
+			RequestLabels:  state.requestLabels,
+			RequestContext: state.requestContext,
+			InputSpec:      state.inputSpec,
+		})
+		err := NewPolicyRejectedError(policy.ID, s.rejectionMessage(policy, decision, state.acceptLanguage))
+		err.Rejection.Code = decision.RejectionCode
+		return err
 	}
 
 	// 4. Validate and merge constraints — new constraints must not loosen existing ones
 	if decision.Constraints != nil {
 		if err := constraintCtx.MergeConstraints(decision.Constraints, policy.ID); err != nil {
-			var conflictErr *ConstraintConflictError
+			var conflictErr *constraints.ConflictError
 			if errors.As(err, &conflictErr) {
-				return nil, "", NewConstraintConflictError(
-					policy.ID, conflictErr.FieldPath, conflictErr.SetByPolicy, conflictErr.Reason,
+				return NewConstraintConflictError(
+					policy.ID, conflictErr.FieldPath, conflictErr.SetByPolicy, conflictErr.Reason, conflictErr.Suggestion,
 				)
 			}
-			return nil, "", NewConstraintConflictError(policy.ID, "", "", err.Error())
+			return NewConstraintConflictError(policy.ID, "", "", err.Error(), nil)
 		}
 	}
 
 	// 5. Merge service provider constraints
 	if err := constraintCtx.MergeSPConstraints(decision.ServiceProviderConstraints, policy.ID); err != nil {
-		return nil, "", NewServiceProviderConstraintError(policy.ID, err.Error())
+		return NewServiceProviderConstraintError(policy.ID, err.Error(), providerSuggestion(err))
 	}
 
 	// 6. Validate patch against accumulated constraints
 	if decision.Patch != nil {
+		// Arrays the policy merges rather than replaces are resolved first, so constraints
+		// apply to the arrays the spec ends up with
+		decision.Patch, err = resolveArrayMerges(state.spec, decision.Patch, decision.ArrayMerge)
+		if err != nil {
+			return NewInternalError(
+				fmt.Sprintf("Policy '%s' returned invalid array merge options", policy.ID),
+				err.Error(),
+				err,
+			)
+		}
+		if trace != nil {
+			if trace.Patch, err = redactPatch(decision.Patch, s.explainRedactedFields); err != nil {
+				return NewInternalError("Failed to record policy patch for explanation", err.Error(), err)
+			}
+		}
 		violations := constraintCtx.ValidatePatch(decision.Patch)
 		if len(violations) > 0 {
-			return nil, "", NewConstraintViolationError(policy.ID, violations)
+			return NewConstraintViolationError(policy.ID, violations)
+		}
+
+		if state.explanation != nil {
+			recordProvenance(state.explanation.FieldProvenance, "", state.spec, decision.Patch, policy.ID)
 		}
 
-		// 7. Apply patch — deep merge into currentSpec (RFC 7396 JSON Merge Patch semantics)
-		currentSpec, err = mergePatch(currentSpec, decision.Patch)
+		// 7. Apply patch — deep merge into the current spec (RFC 7396 JSON Merge Patch semantics)
+		merged, err := specmerge.Merge(state.spec, decision.Patch)
 		if err != nil {
-			return nil, "", NewInternalError("Failed to merge patch into current spec", err.Error(), err)
+			return NewInternalError("Failed to merge patch into current spec", err.Error(), err)
 		}
+		for _, field := range s.protectedFields {
+			if !deep.Equal(fieldValue(state.spec, field), fieldValue(merged, field)) {
+				return NewProtectedFieldError(policy.ID, field)
+			}
+		}
+		if s.patchFields != nil {
+			if err := s.checkPatchFields(ctx, policy.ID, state, decision.Patch); err != nil {
+				return err
+			}
+		}
+		if state.writers != nil {
+			if err := s.checkPatchConflicts(ctx, policy, state, decision.Patch, merged); err != nil {
+				return err
+			}
+		}
+		state.spec = merged
 		log.Debug("Policy patch applied", "policy_id", policy.ID)
 	}
 
 	// 8. Validate service provider against SP constraints
 	if decision.SelectedProvider != "" {
 		if err := constraintCtx.ValidateServiceProvider(decision.SelectedProvider); err != nil {
-			return nil, "", NewServiceProviderConstraintError(policy.ID, err.Error())
+			return NewServiceProviderConstraintError(policy.ID, err.Error(), providerSuggestion(err))
 		}
 		log.Debug("Policy selected provider", "policy_id", policy.ID, "provider", decision.SelectedProvider)
-		selectedProvider = decision.SelectedProvider
+		state.selectedProvider = decision.SelectedProvider
 	}
 
-	return currentSpec, selectedProvider, nil
+	return nil
 }
 
-// mergePatch performs a recursive JSON Merge Patch (RFC 7396) of patch into base.
-// Fields in patch override fields in base. Null values in patch remove fields from base.
-// Fields not mentioned in patch are preserved from base.
-func mergePatch(base, patch map[string]any) (map[string]any, error) {
-	result, err := deep.Copy(base)
-	if err != nil {
-		return nil, err
+// providerSuggestion returns the providers a service provider constraint error leaves allowed
+func providerSuggestion(err error) *constraints.Suggestion {
+	var spErr *constraints.ServiceProviderError
+	if errors.As(err, &spErr) {
+		return spErr.Suggestion
 	}
+	return nil
+}
 
-	for key, patchValue := range patch {
-		if patchValue == nil {
-			// null means remove the field
-			delete(result, key)
-			continue
-		}
+// policyInput builds the OPA input of policy, the next policy, from the current spec,
+// selected provider and accumulated constraints
+func (s *evaluationService) policyInput(state *evaluationState, policy *model.Policy) map[string]any {
+	return buildInput(s.inputLayout, state.spec, state.requestContext, state.preferredProviders, state.previous, state.selectedProvider, state.constraints, policyMetadataInput(policy))
+}
 
-		patchMap, patchIsMap := patchValue.(map[string]any)
-		baseValue, baseExists := result[key]
-		baseMap, baseIsMap := baseValue.(map[string]any)
+// policyMetadataInput returns the policy's own configuration, passed to its Rego as
+// input.policy so one module can serve several policies with different parameters. Unset
+// maps are empty objects rather than null.
+func policyMetadataInput(policy *model.Policy) map[string]any {
+	return setPolicyMetadata(make(map[string]any, 6), policy)
+}
 
-		if patchIsMap && baseExists && baseIsMap {
-			// Both are maps — recurse
-			result[key], err = mergePatch(baseMap, patchMap)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			// Patch value overrides base
-			result[key] = patchValue
-		}
+// setPolicyMetadata sets the fields of policyMetadataInput in metadata and returns it
+func setPolicyMetadata(metadata map[string]any, policy *model.Policy) map[string]any {
+	labelSelector, annotations, parameters := policy.LabelSelector, policy.Annotations, policy.Parameters
+	if labelSelector == nil {
+		labelSelector = map[string]string{}
 	}
-
-	return result, nil
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if parameters == nil {
+		parameters = map[string]any{}
+	}
+	metadata["id"] = policy.ID
+	metadata["policy_type"] = policy.PolicyType
+	metadata["priority"] = int(policy.Priority)
+	metadata["label_selector"] = labelSelector
+	metadata["annotations"] = annotations
+	metadata["parameters"] = parameters
+	return metadata
 }
 
 // boolPtr returns a pointer to a bool value
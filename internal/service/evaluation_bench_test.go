@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime"
+	"testing"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// benchmarkPolicies is how many policies every benchmarked request is evaluated against
+const benchmarkPolicies = 10
+
+// newBenchmarkService returns an evaluation service over benchmarkPolicies GLOBAL policies
+// compiled into a real engine, each patching a field and constraining it, a request they
+// all match and a context discarding the evaluation logs
+func newBenchmarkService(b *testing.B) (EvaluationService, *EvaluationRequest, context.Context) {
+	b.Helper()
+	ctx := logging.WithLogger(context.Background(), slog.New(slog.DiscardHandler))
+	modules := make([]opa.PolicyModule, benchmarkPolicies)
+	list := make(policyList, benchmarkPolicies)
+	for i := range benchmarkPolicies {
+		id := fmt.Sprintf("policy-%d", i)
+		rego := fmt.Sprintf(`package bench.p%d
+
+main := {
+	"rejected": false,
+	"patch": {"field_%d": input.spec.cpu_count},
+	"constraints": {"field_%d": {"maximum": 64}},
+}
+`, i, i, i)
+		modules[i] = opa.PolicyModule{ID: id, RegoCode: rego}
+		list[i] = model.Policy{ID: id, PolicyType: "GLOBAL", Priority: int32(i + 1), RegoCode: rego, Enabled: true}
+	}
+	engine := opa.NewEngine()
+	if err := engine.Compile(ctx, modules); err != nil {
+		b.Fatal(err)
+	}
+	svc := NewEvaluationService(nil, engine, func(s *evaluationService) { s.policies = list })
+	request := &EvaluationRequest{
+		ServiceInstance: map[string]any{
+			"service_type": "vm",
+			"cpu_count":    4,
+			"metadata":     map[string]any{"labels": map[string]any{"env": "prod"}},
+		},
+		RequestLabels: map[string]string{"service_type": "vm", "env": "prod"},
+	}
+	return svc, request, ctx
+}
+
+// reportGCs reports the garbage collections since before, per thousand evaluations
+func reportGCs(b *testing.B, before *runtime.MemStats) {
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	b.ReportMetric(float64(after.NumGC-before.NumGC)*1000/float64(b.N), "gc/1k-evals")
+}
+
+func BenchmarkEvaluateRequest(b *testing.B) {
+	svc, request, ctx := newBenchmarkService(b)
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := svc.EvaluateRequest(ctx, request); err != nil {
+			b.Fatal(err)
+		}
+	}
+	reportGCs(b, &before)
+}
+
+func BenchmarkEvaluateRequestParallel(b *testing.B) {
+	svc, request, ctx := newBenchmarkService(b)
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := svc.EvaluateRequest(ctx, request); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+	reportGCs(b, &before)
+}
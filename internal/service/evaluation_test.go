@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/events"
 	"github.com/dcm-project/policy-manager/internal/opa"
 	"github.com/dcm-project/policy-manager/internal/store"
 	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -17,17 +21,23 @@ import (
 type mockPolicyStore struct {
 	policies []model.Policy
 	err      error
+	lists    int // number of List calls
 }
 
 func (m *mockPolicyStore) Create(_ context.Context, _ model.Policy) (*model.Policy, error) {
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockPolicyStore) CreateAll(_ context.Context, _ []model.Policy) ([]model.Policy, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockPolicyStore) Get(_ context.Context, _ string) (*model.Policy, error) {
 	return nil, errors.New("not implemented")
 }
 
 func (m *mockPolicyStore) List(_ context.Context, _ *store.PolicyListOptions) (*store.PolicyListResult, error) {
+	m.lists++
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -43,6 +53,14 @@ func (m *mockPolicyStore) ListAll(_ context.Context) (model.PolicyList, error) {
 	return m.policies, nil
 }
 
+func (m *mockPolicyStore) Facets(_ context.Context) (*store.PolicyFacets, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockPolicyStore) CheckUnique(_ context.Context, _ model.Policy, _ bool) error {
+	return errors.New("not implemented")
+}
+
 func (m *mockPolicyStore) Update(_ context.Context, _ model.Policy) (*model.Policy, error) {
 	return nil, errors.New("not implemented")
 }
@@ -51,6 +69,22 @@ func (m *mockPolicyStore) Delete(_ context.Context, _ string) error {
 	return errors.New("not implemented")
 }
 
+func (m *mockPolicyStore) Discard(_ context.Context, _ string) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockPolicyStore) Revert(_ context.Context, _ model.Policy) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockPolicyStore) CountPrincipal(_ context.Context, _ string) (store.PrincipalCounts, error) {
+	return store.PrincipalCounts{}, errors.New("not implemented")
+}
+
+func (m *mockPolicyStore) RedactPrincipal(_ context.Context, _, _ string) (store.PrincipalCounts, error) {
+	return store.PrincipalCounts{}, errors.New("not implemented")
+}
+
 type mockEngine struct {
 	evaluations map[string]*opa.EvaluationResult
 	err         error
@@ -64,6 +98,18 @@ func (m *mockEngine) ValidateRego(_ context.Context, _ string) error {
 	return nil
 }
 
+func (m *mockEngine) CheckCompile(_ context.Context, _ []opa.PolicyModule) error {
+	return nil
+}
+
+func (m *mockEngine) Modules() []opa.PolicyModule {
+	return nil
+}
+
+func (m *mockEngine) EvaluateComposite(_ context.Context, _ string, _ map[string]any) (*opa.EvaluationResult, error) {
+	return &opa.EvaluationResult{Defined: false}, nil
+}
+
 func (m *mockEngine) EvaluatePolicy(_ context.Context, policyID string, _ map[string]any) (*opa.EvaluationResult, error) {
 	if m.err != nil {
 		return nil, m.err
@@ -75,6 +121,18 @@ func (m *mockEngine) EvaluatePolicy(_ context.Context, policyID string, _ map[st
 	return &opa.EvaluationResult{Defined: false}, nil
 }
 
+type fakeInstanceProvider struct {
+	previous map[string]*PreviousEvaluation
+	err      error
+}
+
+func (f *fakeInstanceProvider) PreviousEvaluation(_ context.Context, instanceID string) (*PreviousEvaluation, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.previous[instanceID], nil
+}
+
 var _ = Describe("EvaluationService", func() {
 	var (
 		ctx         context.Context
@@ -107,6 +165,7 @@ var _ = Describe("EvaluationService", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(response.Status).To(Equal(EvaluationStatusApproved))
+				Expect(response.StatusReason).To(Equal(StatusReasonNoPoliciesApplied))
 				Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{}))
 				Expect(response.SelectedProvider).To(Equal(""))
 			})
@@ -132,6 +191,7 @@ var _ = Describe("EvaluationService", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(response.Status).To(Equal(EvaluationStatusApproved))
+				Expect(response.StatusReason).To(Equal(StatusReasonNoPoliciesApplied))
 			})
 		})
 
@@ -163,11 +223,51 @@ var _ = Describe("EvaluationService", func() {
 
 				Expect(err).NotTo(HaveOccurred())
 				Expect(response.Status).To(Equal(EvaluationStatusModified))
+				Expect(response.StatusReason).To(Equal(StatusReasonPatched))
 				Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{
 					"region": "us-east-1",
 				}))
 				Expect(response.SelectedProvider).To(Equal("aws"))
 			})
+
+			It("publishes an EvaluationCompleted event", func() {
+				bus := events.NewBus()
+				var completed []events.EvaluationCompleted
+				events.Subscribe(bus, func(_ context.Context, event events.EvaluationCompleted) {
+					completed = append(completed, event)
+				})
+				service = NewEvaluationService(mockStore, mockOPA, WithEvaluationEvents(bus))
+				baseRequest.RequestLabels = map[string]string{"tenant": "team-a"}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(completed).To(ConsistOf(events.EvaluationCompleted{
+					Status:            string(EvaluationStatusModified),
+					SelectedProvider:  "aws",
+					PoliciesEvaluated: 1,
+					RequestLabels:     map[string]string{"tenant": "team-a"},
+					InputSpec:         baseRequest.ServiceInstance,
+				}))
+			})
+
+			It("evaluates a dry run in full without publishing events", func() {
+				bus := events.NewBus()
+				var published []events.Event
+				bus.Subscribe(func(_ context.Context, event events.Event) {
+					published = append(published, event)
+				})
+				service = NewEvaluationService(mockStore, mockOPA, WithEvaluationEvents(bus))
+				baseRequest.DryRun = true
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.DryRun).To(BeTrue())
+				Expect(response.Status).To(Equal(EvaluationStatusModified))
+				Expect(response.SelectedProvider).To(Equal("aws"))
+				Expect(published).To(BeEmpty())
+			})
 		})
 
 		Context("when patch merges with existing spec", func() {
@@ -208,6 +308,191 @@ var _ = Describe("EvaluationService", func() {
 			})
 		})
 
+		Context("when a policy chooses array merge options", func() {
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{
+						ID:         "policy-1",
+						Enabled:    true,
+						PolicyType: "GLOBAL",
+						Priority:   100,
+					},
+				}
+
+				mockOPA.evaluations["policy-1"] = &opa.EvaluationResult{
+					Defined: true,
+					Result: map[string]any{
+						"patch": map[string]any{
+							"network": map[string]any{
+								"security_groups": []any{map[string]any{"name": "audit", "port": 514}},
+							},
+							"tags": []any{"managed"},
+						},
+						"array_merge": map[string]any{
+							"network.security_groups": map[string]any{"strategy": "merge_by_key", "key": "name"},
+							"tags":                    map[string]any{"strategy": "append"},
+						},
+					},
+				}
+			})
+
+			It("keeps the user-provided items", func() {
+				baseRequest.ServiceInstance = map[string]any{
+					"network": map[string]any{
+						"security_groups": []any{map[string]any{"name": "web", "port": 443}},
+					},
+					"tags": []any{"team-a"},
+				}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{
+					"network": map[string]any{
+						"security_groups": []any{
+							map[string]any{"name": "web", "port": 443},
+							map[string]any{"name": "audit", "port": 514},
+						},
+					},
+					"tags": []any{"team-a", "managed"},
+				}))
+			})
+
+			It("returns internal error for an unknown strategy", func() {
+				mockOPA.evaluations["policy-1"].Result["array_merge"] = map[string]any{
+					"tags": map[string]any{"strategy": "union"},
+				}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+				Expect(serviceErr.Detail).To(ContainSubstring("strategy must be one of"))
+			})
+		})
+
+		Context("when spec fields are protected", func() {
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "policy-1", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+				}
+				baseRequest.ServiceInstance = map[string]any{
+					"metadata":        map[string]any{"owner": "alice", "labels": map[string]any{"env": "prod"}},
+					"billing_account": "acct-1",
+				}
+				service = NewEvaluationService(mockStore, mockOPA, WithProtectedFields([]string{"metadata.owner", "billing_account"}))
+			})
+
+			DescribeTable("returns a conflict naming the policy and field",
+				func(patch map[string]any, field string) {
+					mockOPA.evaluations["policy-1"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": patch}}
+
+					_, err := service.EvaluateRequest(ctx, baseRequest)
+
+					Expect(err).To(HaveOccurred())
+					serviceErr, ok := err.(*ServiceError)
+					Expect(ok).To(BeTrue())
+					Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
+					Expect(serviceErr.Message).To(Equal("Policy 'policy-1' attempted to modify protected field '" + field + "'"))
+				},
+				Entry("setting the field", map[string]any{"metadata": map[string]any{"owner": "bob"}}, "metadata.owner"),
+				Entry("removing the field", map[string]any{"billing_account": nil}, "billing_account"),
+				Entry("replacing a parent", map[string]any{"metadata": "none"}, "metadata.owner"),
+			)
+
+			It("allows patches that leave protected fields unchanged", func() {
+				mockOPA.evaluations["policy-1"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{
+					"patch": map[string]any{"metadata": map[string]any{"labels": map[string]any{"env": "dev"}, "owner": "alice"}},
+				}}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Status).To(Equal(EvaluationStatusModified))
+			})
+		})
+
+		Context("when patch conflict detection is enabled", func() {
+			patchResult := func(patch map[string]any) *opa.EvaluationResult {
+				return &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": patch}}
+			}
+
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "global-placement", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+					{ID: "user-placement", Enabled: true, PolicyType: "USER", Priority: 100},
+				}
+				mockOPA.evaluations["global-placement"] = patchResult(map[string]any{
+					"region":  "us-east-1",
+					"network": map[string]any{"zone": "a"},
+				})
+				mockOPA.evaluations["user-placement"] = patchResult(map[string]any{"region": "eu-west-1"})
+			})
+
+			It("returns a conflict naming both policies in deny mode", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsDeny, 0))
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
+				Expect(serviceErr.Message).To(ContainSubstring("'user-placement'"))
+				Expect(serviceErr.Message).To(ContainSubstring("'region'"))
+				Expect(serviceErr.Message).To(ContainSubstring("'global-placement'"))
+			})
+
+			It("lets the last writer win in warn mode", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsWarn, 0))
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.EvaluatedServiceInstance).To(HaveKeyWithValue("region", "eu-west-1"))
+			})
+
+			It("detects fields removed by replacing their parent", func() {
+				mockOPA.evaluations["user-placement"] = patchResult(map[string]any{"network": "default"})
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsDeny, 0))
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*ServiceError).Message).To(ContainSubstring("'network.zone'"))
+			})
+
+			It("ignores a field set to the value it already has", func() {
+				mockOPA.evaluations["user-placement"] = patchResult(map[string]any{"region": "us-east-1", "size": "large"})
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsDeny, 0))
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("ignores policies outside the priority window", func() {
+				mockStore.policies[1].Priority = 150
+
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsDeny, 49))
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+				Expect(err).NotTo(HaveOccurred())
+
+				service = NewEvaluationService(mockStore, mockOPA, WithPatchConflicts(PatchConflictsDeny, 50))
+				_, err = service.EvaluateRequest(ctx, baseRequest)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not detect conflicts in allow mode", func() {
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.EvaluatedServiceInstance).To(HaveKeyWithValue("region", "eu-west-1"))
+			})
+		})
+
 		Context("when policy rejects the request", func() {
 			BeforeEach(func() {
 				mockStore.policies = []model.Policy{
@@ -238,6 +523,67 @@ var _ = Describe("EvaluationService", func() {
 				Expect(serviceErr.Message).To(ContainSubstring("policy-1"))
 				Expect(serviceErr.Detail).To(Equal("Security policy violation"))
 			})
+
+			It("publishes an EvaluationRejected event", func() {
+				bus := events.NewBus()
+				var rejected []events.EvaluationRejected
+				events.Subscribe(bus, func(_ context.Context, event events.EvaluationRejected) {
+					rejected = append(rejected, event)
+				})
+				service = NewEvaluationService(mockStore, mockOPA, WithEvaluationEvents(bus))
+				baseRequest.RequestLabels = map[string]string{"tenant": "team-a"}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				Expect(rejected).To(ConsistOf(events.EvaluationRejected{
+					PolicyID:      "policy-1",
+					Reason:        "Security policy violation",
+					RequestLabels: map[string]string{"tenant": "team-a"},
+					InputSpec:     baseRequest.ServiceInstance,
+				}))
+			})
+
+			It("does not publish the rejection of a dry run", func() {
+				bus := events.NewBus()
+				var published []events.Event
+				bus.Subscribe(func(_ context.Context, event events.Event) {
+					published = append(published, event)
+				})
+				service = NewEvaluationService(mockStore, mockOPA, WithEvaluationEvents(bus))
+				baseRequest.DryRun = true
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				Expect(published).To(BeEmpty())
+			})
+
+			It("answers a dry run that asks for extended statuses with the rejection it would get", func() {
+				mockOPA.evaluations["policy-1"].Result["rejection_code"] = "SECURITY"
+				baseRequest.ServiceInstance = map[string]any{"region": "eu-west-1"}
+				baseRequest.DryRun = true
+				baseRequest.ExtendedStatus = true
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Status).To(Equal(EvaluationStatusDryRunWouldReject))
+				Expect(response.StatusReason).To(Equal(StatusReasonPolicyRejected))
+				Expect(response.Rejection).To(Equal(&Rejection{PolicyID: "policy-1", Reason: "Security policy violation", Code: "SECURITY"}))
+				Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{"region": "eu-west-1"}))
+				Expect(response.DryRun).To(BeTrue())
+			})
+
+			It("still fails a request that asks for extended statuses outside a dry run", func() {
+				baseRequest.ExtendedStatus = true
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+			})
 		})
 
 		Context("when lower-priority policy violates constraint", func() {
@@ -294,6 +640,19 @@ var _ = Describe("EvaluationService", func() {
 				Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
 				Expect(serviceErr.Message).To(ContainSubstring("policy-2"))
 			})
+
+			It("suggests the value the constraint allows", func() {
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Suggestions).To(Equal([]constraints.Suggestion{{
+					FieldPath:     "region",
+					SetByPolicy:   "policy-1",
+					NearestValue:  "us-east-1",
+					AllowedValues: []any{"us-east-1"},
+				}}))
+			})
 		})
 
 		Context("when lower-priority policy tries to loosen constraint", func() {
@@ -350,6 +709,11 @@ var _ = Describe("EvaluationService", func() {
 				Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
 				Expect(serviceErr.Message).To(ContainSubstring("policy-2"))
 				Expect(serviceErr.Detail).To(ContainSubstring("loosen"))
+				Expect(serviceErr.Suggestions).To(ConsistOf(constraints.Suggestion{
+					FieldPath:    "cpu_count",
+					SetByPolicy:  "policy-1",
+					NearestValue: float64(4),
+				}))
 			})
 		})
 
@@ -471,7 +835,7 @@ var _ = Describe("EvaluationService", func() {
 					},
 				}
 
-				mockOPA.err = errors.New("OPA unavailable")
+				mockOPA.err = errors.New("eval_conflict_error: functions must not produce multiple outputs")
 			})
 
 			It("returns internal error", func() {
@@ -481,6 +845,57 @@ var _ = Describe("EvaluationService", func() {
 				serviceErr, ok := err.(*ServiceError)
 				Expect(ok).To(BeTrue())
 				Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+				Expect(serviceErr.Message).To(Equal("Failed to evaluate policy 'policy-1'"))
+			})
+
+			It("does not fail open in the open failure mode", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithFailureMode(FailureModeOpen))
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(response).To(BeNil())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+			})
+		})
+
+		Context("when the policy store fails", func() {
+			BeforeEach(func() {
+				mockStore.err = errors.New("database unavailable")
+			})
+
+			It("returns unavailable error", func() {
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeUnavailable))
+			})
+
+			It("approves the request unchanged in the open failure mode", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithFailureMode(FailureModeOpen))
+				baseRequest.ServiceInstance = map[string]any{"region": "eu-west-1"}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Status).To(Equal(EvaluationStatusApproved))
+				Expect(response.StatusReason).To(Equal(StatusReasonFailedOpen))
+				Expect(response.FailedOpen).To(BeTrue())
+				Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{"region": "eu-west-1"}))
+			})
+
+			It("approves the request with warnings when extended statuses are requested", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithFailureMode(FailureModeOpen))
+				baseRequest.ExtendedStatus = true
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Status).To(Equal(EvaluationStatusApprovedWithWarnings))
+				Expect(response.StatusReason).To(Equal(StatusReasonFailedOpen))
 			})
 		})
 
@@ -595,6 +1010,200 @@ var _ = Describe("EvaluationService", func() {
 			})
 		})
 
+		Context("when OPA input includes the policy metadata", func() {
+			It("passes each policy its own metadata as input.policy", func() {
+				mockStore.policies = []model.Policy{
+					{
+						ID:            "max-size",
+						Enabled:       true,
+						PolicyType:    "GLOBAL",
+						Priority:      100,
+						LabelSelector: map[string]string{"env": "prod"},
+						Annotations:   map[string]string{"owner": "platform"},
+						Parameters:    map[string]any{"max_cpu": 8},
+					},
+					{
+						ID:         "plain",
+						Enabled:    true,
+						PolicyType: "USER",
+						Priority:   10,
+					},
+				}
+				baseRequest.RequestLabels = map[string]string{"env": "prod"}
+
+				var captured []map[string]any
+				service = NewEvaluationService(mockStore, &mockEngineWithCapture{
+					evaluations: map[string]*opa.EvaluationResult{},
+					captureFunc: func(input map[string]any) {
+						captured = append(captured, input["policy"].(map[string]any))
+					},
+				})
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(captured).To(Equal([]map[string]any{
+					{
+						"id":             "max-size",
+						"policy_type":    "GLOBAL",
+						"priority":       100,
+						"label_selector": map[string]string{"env": "prod"},
+						"annotations":    map[string]string{"owner": "platform"},
+						"parameters":     map[string]any{"max_cpu": 8},
+					},
+					{
+						"id":             "plain",
+						"policy_type":    "USER",
+						"priority":       10,
+						"label_selector": map[string]string{},
+						"annotations":    map[string]string{},
+						"parameters":     map[string]any{},
+					},
+				}))
+			})
+		})
+
+		Context("when the request has a context", func() {
+			var captured []map[string]any
+
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "policy-1", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+				}
+				captured = nil
+				baseRequest.RequestContext = RequestContext{Requester: "alice", SourceSystem: "migration-tool"}
+			})
+
+			evaluateWith := func(opts ...EvaluationOption) error {
+				service = NewEvaluationService(mockStore, &mockEngineWithCapture{
+					evaluations: map[string]*opa.EvaluationResult{},
+					captureFunc: func(input map[string]any) { captured = append(captured, input) },
+				}, opts...)
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+				return err
+			}
+
+			It("passes the set fields to policies as input.request_context", func() {
+				Expect(evaluateWith()).To(Succeed())
+				Expect(captured).To(HaveLen(1))
+				Expect(captured[0]).To(HaveKeyWithValue("request_context", map[string]string{
+					"requester":     "alice",
+					"source_system": "migration-tool",
+				}))
+			})
+
+			It("passes them as input.request.context in the namespaced layout", func() {
+				Expect(evaluateWith(WithInputLayout(InputLayoutNamespaced))).To(Succeed())
+				Expect(captured[0]["request"]).To(HaveKeyWithValue("context", HaveKeyWithValue("requester", "alice")))
+			})
+
+			It("omits the context when the request has none", func() {
+				baseRequest.RequestContext = RequestContext{}
+				Expect(evaluateWith()).To(Succeed())
+				Expect(captured[0]).NotTo(HaveKey("request_context"))
+			})
+
+			It("rejects a value that is too long", func() {
+				baseRequest.RequestContext.CorrelationID = strings.Repeat("x", 257)
+				err := evaluateWith()
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInvalidArgument))
+				Expect(serviceErr.Detail).To(ContainSubstring("request_context.correlation_id"))
+				Expect(captured).To(BeEmpty())
+			})
+		})
+
+		Context("when the request re-evaluates an existing instance", func() {
+			var (
+				captured  []map[string]any
+				instances *fakeInstanceProvider
+			)
+
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "policy-1", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+				}
+				captured = nil
+				instances = &fakeInstanceProvider{previous: map[string]*PreviousEvaluation{
+					"vm-1": {Spec: map[string]any{"region": "eu-west-1", "password": "hunter2"}, SelectedProvider: "aws"},
+				}}
+				baseRequest.InstanceID = "vm-1"
+			})
+
+			evaluateWith := func(opts ...EvaluationOption) (*EvaluationResponse, error) {
+				service = NewEvaluationService(mockStore, &mockEngineWithCapture{
+					evaluations: map[string]*opa.EvaluationResult{},
+					captureFunc: func(input map[string]any) { captured = append(captured, input) },
+				}, append([]EvaluationOption{WithInstanceProvider(instances)}, opts...)...)
+				return service.EvaluateRequest(ctx, baseRequest)
+			}
+
+			It("passes its previous evaluation to policies as input.previous", func() {
+				_, err := evaluateWith()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(captured).To(HaveLen(1))
+				Expect(captured[0]).To(HaveKeyWithValue("previous", map[string]any{
+					"spec":     map[string]any{"region": "eu-west-1", "password": "hunter2"},
+					"provider": "aws",
+				}))
+			})
+
+			It("passes it as input.context.previous in the namespaced layout", func() {
+				_, err := evaluateWith(WithInputLayout(InputLayoutNamespaced))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(captured[0]["context"]).To(HaveKeyWithValue("previous", HaveKeyWithValue("provider", "aws")))
+			})
+
+			It("omits it for an instance the provider does not know", func() {
+				baseRequest.InstanceID = "vm-2"
+				_, err := evaluateWith()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(captured[0]).NotTo(HaveKey("previous"))
+			})
+
+			It("redacts the previous spec in explain output", func() {
+				baseRequest.Explain = true
+				response, err := evaluateWith(WithExplainRedactedFields([]string{"password"}))
+				Expect(err).NotTo(HaveOccurred())
+				traceInput := response.Explanation.Policies[0].Input
+				Expect(traceInput).To(HaveKeyWithValue("previous", HaveKeyWithValue("spec", HaveKeyWithValue("password", RedactedValue))))
+				Expect(captured[0]).To(HaveKeyWithValue("previous", HaveKeyWithValue("spec", HaveKeyWithValue("password", "hunter2"))))
+			})
+
+			It("fails when the lookup fails", func() {
+				instances.err = errors.New("catalog unreachable")
+				_, err := evaluateWith()
+
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+				Expect(captured).To(BeEmpty())
+			})
+
+			It("does not approve the request when the lookup fails in the open failure mode", func() {
+				instances.err = errors.New("catalog unreachable")
+				response, err := evaluateWith(WithFailureMode(FailureModeOpen))
+
+				Expect(response).To(BeNil())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+				Expect(captured).To(BeEmpty())
+			})
+
+			It("rejects an instance ID that is too long", func() {
+				baseRequest.InstanceID = strings.Repeat("x", 257)
+				_, err := evaluateWith()
+
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInvalidArgument))
+				Expect(serviceErr.Detail).To(ContainSubstring("instance_id"))
+			})
+		})
+
 		Context("when service provider constraints are enforced", func() {
 			BeforeEach(func() {
 				mockStore.policies = []model.Policy{
@@ -642,6 +1251,275 @@ var _ = Describe("EvaluationService", func() {
 				Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
 				Expect(serviceErr.Message).To(ContainSubstring("policy-2"))
 				Expect(serviceErr.Detail).To(ContainSubstring("not in the allowed list"))
+				Expect(serviceErr.Suggestions).To(HaveLen(1))
+				Expect(serviceErr.Suggestions[0].AllowedProviders).To(Equal([]string{"aws", "gcp"}))
+			})
+		})
+
+		Context("when the request lists preferred providers", func() {
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "guardrail", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+				}
+				mockOPA.evaluations["guardrail"] = &opa.EvaluationResult{
+					Defined: true,
+					Result: map[string]any{
+						"service_provider_constraints": map[string]any{"allow_list": []any{"aws", "gcp"}},
+					},
+				}
+				baseRequest.PreferredProviders = []string{"azure", "gcp", "aws"}
+			})
+
+			It("selects the first preferred provider the constraints allow", func() {
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.SelectedProvider).To(Equal("gcp"))
+			})
+
+			It("keeps the provider a policy selects", func() {
+				mockOPA.evaluations["guardrail"].Result["selected_provider"] = "aws"
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.SelectedProvider).To(Equal("aws"))
+			})
+
+			It("selects no provider when the constraints allow none of them", func() {
+				baseRequest.PreferredProviders = []string{"azure"}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.SelectedProvider).To(BeEmpty())
+			})
+
+			It("passes them to policies as input.preferred_providers", func() {
+				var captured []map[string]any
+				service = NewEvaluationService(mockStore, &mockEngineWithCapture{
+					evaluations: map[string]*opa.EvaluationResult{},
+					captureFunc: func(input map[string]any) { captured = append(captured, input) },
+				})
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(captured).To(HaveLen(1))
+				Expect(captured[0]).To(HaveKeyWithValue("preferred_providers", []string{"azure", "gcp", "aws"}))
+			})
+
+			It("rejects a provider listed twice", func() {
+				baseRequest.PreferredProviders = []string{"aws", "aws"}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(ErrorTypeInvalidArgument))
+				Expect(serviceErr.Detail).To(Equal("preferred_providers lists 'aws' more than once"))
+			})
+		})
+
+		Context("when explain mode is requested", func() {
+			BeforeEach(func() {
+				mockStore.policies = []model.Policy{
+					{ID: "policy-1", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+					{ID: "policy-2", Enabled: true, PolicyType: "GLOBAL", Priority: 200},
+				}
+				mockOPA.evaluations["policy-1"] = &opa.EvaluationResult{
+					Defined: true,
+					Result: map[string]any{
+						"rejected":          false,
+						"patch":             map[string]any{"region": "us-east-1"},
+						"constraints":       map[string]any{"region": map[string]any{"const": "us-east-1"}},
+						"selected_provider": "aws",
+					},
+				}
+				baseRequest.ServiceInstance = map[string]any{
+					"region":      "eu-west-1",
+					"credentials": map[string]any{"token": "secret"},
+				}
+				baseRequest.Explain = true
+			})
+
+			It("records the input each policy was evaluated with", func() {
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Explanation).NotTo(BeNil())
+				Expect(response.Explanation.Policies).To(HaveLen(2))
+
+				first := response.Explanation.Policies[0]
+				Expect(first.PolicyID).To(Equal("policy-1"))
+				Expect(first.Input["spec"]).To(HaveKeyWithValue("region", "eu-west-1"))
+				Expect(first.Input["provider"]).To(Equal(""))
+				Expect(first.Input).NotTo(HaveKey("constraints"))
+				Expect(first.Input).NotTo(HaveKey("request"))
+
+				second := response.Explanation.Policies[1]
+				Expect(second.PolicyID).To(Equal("policy-2"))
+				Expect(second.Input["spec"]).To(HaveKeyWithValue("region", "us-east-1"))
+				Expect(second.Input["provider"]).To(Equal("aws"))
+				Expect(second.Input["constraints"]).To(HaveKey("region"))
+			})
+
+			It("records the input in the namespaced layout when configured", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithInputLayout(InputLayoutNamespaced))
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				first := response.Explanation.Policies[0]
+				Expect(first.Input["request"]).To(HaveKeyWithValue("spec", HaveKeyWithValue("region", "eu-west-1")))
+				Expect(first.Input["context"]).To(Equal(map[string]any{"provider": ""}))
+
+				second := response.Explanation.Policies[1]
+				Expect(second.Input["request"]).To(HaveKeyWithValue("spec", HaveKeyWithValue("region", "us-east-1")))
+				Expect(second.Input["context"]).To(HaveKeyWithValue("provider", "aws"))
+				Expect(second.Input["context"]).To(HaveKeyWithValue("constraints", HaveKey("region")))
+			})
+
+			It("records what each policy decided", func() {
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				first := response.Explanation.Policies[0]
+				Expect(first.Outcome).To(Equal(PolicyOutcomeApplied))
+				Expect(first.Reason).To(BeEmpty())
+				Expect(first.Patch).To(Equal(map[string]any{"region": "us-east-1"}))
+				Expect(first.Constraints).To(Equal(map[string]any{"region": map[string]any{"const": "us-east-1"}}))
+				Expect(first.SelectedProvider).To(Equal("aws"))
+
+				second := response.Explanation.Policies[1]
+				Expect(second.Outcome).To(Equal(PolicyOutcomeUndefined))
+				Expect(second.Patch).To(BeNil())
+				Expect(second.Constraints).To(BeNil())
+			})
+
+			It("lists the policies skipped by their label selector", func() {
+				mockStore.policies = append(mockStore.policies, model.Policy{
+					ID: "prod-only", Enabled: true, PolicyType: "USER", Priority: 100, LabelSelector: map[string]string{"env": "prod"},
+				})
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Explanation.Policies).To(HaveLen(2))
+				Expect(response.Explanation.SkippedPolicies).To(Equal([]SkippedPolicy{
+					{PolicyID: "prod-only", Reason: "label selector does not match the request labels"},
+				}))
+			})
+
+			It("returns the trace up to the policy that caused a conflict", func() {
+				mockOPA.evaluations["policy-2"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": map[string]any{"region": "eu-west-1"}}}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr := err.(*ServiceError)
+				Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
+				Expect(serviceErr.Explanation).NotTo(BeNil())
+				Expect(serviceErr.Explanation.Policies).To(HaveLen(2))
+				Expect(serviceErr.Explanation.FieldProvenance).To(Equal(map[string]string{"region": "policy-1"}))
+
+				failed := serviceErr.Explanation.Policies[1]
+				Expect(failed.Outcome).To(Equal(PolicyOutcomeFailed))
+				Expect(failed.Patch).To(Equal(map[string]any{"region": "eu-west-1"}))
+				Expect(failed.Reason).To(ContainSubstring("violate constraints"))
+				Expect(failed.Reason).To(ContainSubstring("field 'region'"))
+			})
+
+			It("returns the trace with the reason a policy rejected the request", func() {
+				mockOPA.evaluations["policy-2"] = &opa.EvaluationResult{
+					Defined: true,
+					Result:  map[string]any{"rejected": true, "rejection_reason": "region not allowed"},
+				}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr := err.(*ServiceError)
+				Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+				rejected := serviceErr.Explanation.Policies[1]
+				Expect(rejected.Outcome).To(Equal(PolicyOutcomeRejected))
+				Expect(rejected.Reason).To(Equal("region not allowed"))
+			})
+
+			It("masks redacted spec fields without altering the evaluated spec", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithExplainRedactedFields([]string{"credentials.token", "missing.field"}))
+				mockOPA.evaluations["policy-2"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": map[string]any{"credentials": map[string]any{"token": "rotated"}}}}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				spec, _ := inputSpec(response.Explanation.Policies[0].Input)
+				Expect(spec["credentials"]).To(Equal(map[string]any{"token": RedactedValue}))
+				Expect(response.Explanation.Policies[1].Patch).To(Equal(map[string]any{"credentials": map[string]any{"token": RedactedValue}}))
+				Expect(response.EvaluatedServiceInstance["credentials"]).To(Equal(map[string]any{"token": "rotated"}))
+			})
+
+			It("records the spec and request labels the evaluation started from", func() {
+				service = NewEvaluationService(mockStore, mockOPA, WithExplainRedactedFields([]string{"credentials.token", "metadata.labels.owner"}))
+				baseRequest.ServiceInstance["metadata"] = map[string]any{"labels": map[string]any{"env": "prod", "owner": "alice"}}
+				baseRequest.RequestLabels = map[string]string{"service_type": "vm", "env": "prod", "owner": "alice"}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Explanation.InputSpec).To(Equal(map[string]any{
+					"region":      "eu-west-1",
+					"credentials": map[string]any{"token": RedactedValue},
+					"metadata":    map[string]any{"labels": map[string]any{"env": "prod", "owner": RedactedValue}},
+				}))
+				Expect(response.Explanation.RequestLabels).To(Equal(map[string]string{"service_type": "vm", "env": "prod", "owner": RedactedValue}))
+				Expect(baseRequest.RequestLabels).To(HaveKeyWithValue("owner", "alice"))
+			})
+
+			It("attributes each patched field to the policy that last wrote it", func() {
+				mockOPA.evaluations["policy-1"].Result["patch"] = map[string]any{
+					"region":   "us-east-1",
+					"metadata": map[string]any{"labels": map[string]any{"team": "platform", "tier": "gold"}},
+				}
+				mockOPA.evaluations["policy-2"] = &opa.EvaluationResult{
+					Defined: true,
+					Result: map[string]any{
+						"rejected": false,
+						"patch": map[string]any{
+							"metadata":      map[string]any{"labels": map[string]any{"tier": nil, "team": "backend"}},
+							"instance_type": "t3.medium",
+						},
+					},
+				}
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Explanation.FieldProvenance).To(Equal(map[string]string{
+					"region":               "policy-1",
+					"metadata.labels.team": "policy-2",
+					"instance_type":        "policy-2",
+				}))
+			})
+
+			It("omits the explanation when not requested", func() {
+				baseRequest.Explain = false
+
+				response, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Explanation).To(BeNil())
+			})
+
+			It("does not trace failures when not requested", func() {
+				baseRequest.Explain = false
+				mockOPA.evaluations["policy-2"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": map[string]any{"region": "eu-west-1"}}}
+
+				_, err := service.EvaluateRequest(ctx, baseRequest)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*ServiceError).Explanation).To(BeNil())
 			})
 		})
 	})
@@ -661,12 +1539,61 @@ func (m *mockEngineWithCapture) ValidateRego(_ context.Context, _ string) error
 	return nil
 }
 
+func (m *mockEngineWithCapture) CheckCompile(_ context.Context, _ []opa.PolicyModule) error {
+	return nil
+}
+
+func (m *mockEngineWithCapture) Modules() []opa.PolicyModule {
+	return nil
+}
+
+func (m *mockEngineWithCapture) EvaluateComposite(_ context.Context, _ string, _ map[string]any) (*opa.EvaluationResult, error) {
+	return &opa.EvaluationResult{Defined: false}, nil
+}
+
 func (m *mockEngineWithCapture) EvaluatePolicy(_ context.Context, policyID string, input map[string]any) (*opa.EvaluationResult, error) {
 	if m.captureFunc != nil {
-		m.captureFunc(input)
+		// Engines do not retain their input, whose maps are reused
+		m.captureFunc(deep.MustCopy(input))
 	}
 	if result, ok := m.evaluations[policyID]; ok {
 		return result, nil
 	}
 	return &opa.EvaluationResult{Defined: false}, nil
 }
+
+var _ = Describe("ParsePatchConflictMode", func() {
+	It("defaults to allow", func() {
+		Expect(ParsePatchConflictMode("")).To(Equal(PatchConflictsAllow))
+		Expect(ParsePatchConflictMode("deny")).To(Equal(PatchConflictsDeny))
+	})
+
+	It("rejects unknown modes", func() {
+		_, err := ParsePatchConflictMode("strict")
+		Expect(err).To(MatchError(ContainSubstring("allow, warn, deny")))
+	})
+})
+
+var _ = Describe("recordProvenance", func() {
+	It("drops the attribution of fields below a replaced object", func() {
+		provenance := map[string]string{"network.subnet": "policy-1", "network.vpc": "policy-1", "networking": "policy-1"}
+		base := map[string]any{"network": map[string]any{"subnet": "a", "vpc": "b"}, "networking": "x"}
+
+		recordProvenance(provenance, "", base, map[string]any{"network": "default"}, "policy-2")
+
+		Expect(provenance).To(Equal(map[string]string{"network": "policy-2", "networking": "policy-1"}))
+	})
+
+	It("attributes the leaves of an object written over a scalar", func() {
+		provenance := map[string]string{"disk": "policy-1"}
+		base := map[string]any{"disk": "small"}
+
+		recordProvenance(provenance, "", base, map[string]any{"disk": map[string]any{"size": 100, "tags": []any{"ssd"}, "options": map[string]any{}}}, "policy-2")
+
+		Expect(provenance).To(Equal(map[string]string{
+			"disk.size":    "policy-2",
+			"disk.tags":    "policy-2",
+			"disk.options": "policy-2",
+		}))
+	})
+})
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// GetEvaluationOrder returns the policies a request with the given labels would be evaluated
+// against, in evaluation order. labels are "key=value" strings. No Rego is evaluated.
+func (s *PolicyServiceImpl) GetEvaluationOrder(ctx context.Context, labels []string) (*v1alpha1.EvaluationOrder, error) {
+	requestLabels, err := parseRequestLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &v1alpha1.EvaluationOrder{
+		RequestLabels: requestLabels,
+		Policies:      []v1alpha1.EvaluationOrderEntry{},
+	}
+	skipped, err := forEachApplicablePolicy(ctx, storePolicies{s.store.Policy()}, requestLabels, nil, func(policy *model.Policy) error {
+		entry := v1alpha1.EvaluationOrderEntry{
+			Position:    int32(len(order.Policies) + 1),
+			Id:          policy.ID,
+			DisplayName: policy.DisplayName,
+			PolicyType:  v1alpha1.EvaluationOrderEntryPolicyType(policy.PolicyType),
+			Priority:    policy.Priority,
+		}
+		if len(policy.LabelSelector) > 0 {
+			entry.LabelSelector = &policy.LabelSelector
+		}
+		order.Policies = append(order.Policies, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	order.PoliciesSkipped = int32(skipped)
+
+	logging.FromContext(ctx).Debug("Evaluation order computed", "policies", len(order.Policies), "policies_skipped", skipped)
+	return order, nil
+}
+
+// parseRequestLabels parses "key=value" label strings
+func parseRequestLabels(labels []string) (map[string]string, error) {
+	result := make(map[string]string, len(labels))
+	for _, label := range labels {
+		key, value, ok := strings.Cut(label, "=")
+		if !ok || key == "" {
+			return nil, NewInvalidArgumentError(
+				"Invalid label",
+				fmt.Sprintf("labels must have the form key=value (got '%s')", label),
+			)
+		}
+		if previous, exists := result[key]; exists && previous != value {
+			return nil, NewInvalidArgumentError(
+				"Invalid label",
+				fmt.Sprintf("label '%s' is given more than once with different values", key),
+			)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
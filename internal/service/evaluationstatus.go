@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+// StatusReason says why an evaluation ended with its status, so callers can branch on it
+type StatusReason string
+
+const (
+	StatusReasonNoPoliciesApplied    StatusReason = "NO_POLICIES_APPLIED"    // no enabled policy matched the request labels
+	StatusReasonPoliciesPassed       StatusReason = "POLICIES_PASSED"        // matching policies left the request unchanged
+	StatusReasonPatched              StatusReason = "PATCHED"                // policies patched the request
+	StatusReasonDeniedFieldsStripped StatusReason = "DENIED_FIELDS_STRIPPED" // only denied spec fields were removed
+	StatusReasonFailedOpen           StatusReason = "FAILED_OPEN"            // approved unchanged because policies were unavailable
+	StatusReasonPolicyRejected       StatusReason = "POLICY_REJECTED"        // a policy rejected the request
+)
+
+// Rejection identifies the policy that rejected a request and what it said
+type Rejection struct {
+	PolicyID string
+	Reason   string // the rejection message, translated for the request
+	Code     string // the rejection_code the policy returned, if any
+}
+
+// evaluationStatus returns the status and reason of an evaluation that submitted spec,
+// evaluated it as inputSpec after denied fields were stripped and ended with evaluatedSpec
+func evaluationStatus(spec, inputSpec, evaluatedSpec map[string]any, policiesEvaluated int) (EvaluationStatus, StatusReason) {
+	switch {
+	case !deep.Equal(inputSpec, evaluatedSpec):
+		return EvaluationStatusModified, StatusReasonPatched
+	case !deep.Equal(spec, evaluatedSpec):
+		return EvaluationStatusModified, StatusReasonDeniedFieldsStripped
+	case policiesEvaluated == 0:
+		return EvaluationStatusApproved, StatusReasonNoPoliciesApplied
+	default:
+		return EvaluationStatusApproved, StatusReasonPoliciesPassed
+	}
+}
+
+// wouldReject returns the DRYRUN_WOULD_REJECT answer to the dry run req when err is a policy
+// rejection. The submitted spec is returned unchanged, as a rejected request is never patched.
+func (s *evaluationService) wouldReject(ctx context.Context, req *EvaluationRequest, err error) (*EvaluationResponse, bool) {
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Rejection == nil {
+		return nil, false
+	}
+	inputSpec, _ := s.withoutDeniedSpecFields(req.ServiceInstance)
+	spec, copyErr := deep.Copy(inputSpec)
+	if copyErr != nil {
+		return nil, false
+	}
+
+	logging.FromContext(ctx).Info("Dry run would be rejected", "policy_id", serviceErr.Rejection.PolicyID)
+	return &EvaluationResponse{
+		EvaluatedServiceInstance: spec,
+		Status:                   EvaluationStatusDryRunWouldReject,
+		StatusReason:             StatusReasonPolicyRejected,
+		Rejection:                serviceErr.Rejection,
+		Explanation:              serviceErr.Explanation,
+		DryRun:                   true,
+	}, true
+}
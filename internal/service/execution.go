@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+var speculativeEvaluationsTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_speculative_results_total",
+	"Policy results evaluated ahead of their turn by the phased execution strategy, by whether they were used",
+	"result",
+)
+
+// ExecutionStrategy selects how the applicable policies of a request are run
+type ExecutionStrategy string
+
+const (
+	// ExecutionSequential evaluates one policy at a time in evaluation order
+	ExecutionSequential ExecutionStrategy = "sequential"
+	// ExecutionPhased evaluates the policies of a type concurrently against the spec the
+	// phase starts with, then applies their decisions in evaluation order. GLOBAL policies
+	// form a phase that completes before the USER phase starts.
+	ExecutionPhased ExecutionStrategy = "phased"
+)
+
+// ParseExecutionStrategy parses an execution strategy; the empty string is sequential
+func ParseExecutionStrategy(strategy string) (ExecutionStrategy, error) {
+	switch ExecutionStrategy(strategy) {
+	case "", ExecutionSequential:
+		return ExecutionSequential, nil
+	case ExecutionPhased:
+		return ExecutionPhased, nil
+	}
+	return "", fmt.Errorf("execution strategy must be one of: sequential, phased (got '%s')", strategy)
+}
+
+// WithExecutionStrategy runs the policies of each request with strategy. With the phased
+// strategy at most concurrency policies are evaluated at once; values below 1 are treated as 1.
+func WithExecutionStrategy(strategy ExecutionStrategy, concurrency int) EvaluationOption {
+	return func(s *evaluationService) {
+		s.execution = strategy
+		s.phaseConcurrency = max(concurrency, 1)
+	}
+}
+
+// speculativeResult is the result of a policy evaluated against the input its phase started with
+type speculativeResult struct {
+	input  map[string]any
+	result *opa.EvaluationResult
+	err    error
+	done   chan struct{} // closed once result and err are set
+}
+
+// evaluatePhases calls evaluate for each policy in evaluation order, one phase per policy type.
+// Before a phase is evaluated, the Rego of all its policies is evaluated concurrently
+// against the phase's starting input. A policy whose input is unchanged when its turn comes
+// uses that result; one whose input an earlier policy of the phase changed is evaluated again,
+// so decisions are exactly those of a sequential evaluation.
+func (s *evaluationService) evaluatePhases(ctx context.Context, policies model.PolicyList, state *evaluationState, evaluate func(*model.Policy) error) error {
+	for start := 0; start < len(policies); {
+		end := start + 1
+		for end < len(policies) && policies[end].PolicyType == policies[start].PolicyType {
+			end++
+		}
+		if err := s.evaluatePhase(ctx, policies[start:end], state, evaluate); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// evaluatePhase evaluates the policies of one type, which are in evaluation order
+func (s *evaluationService) evaluatePhase(ctx context.Context, phase model.PolicyList, state *evaluationState, evaluate func(*model.Policy) error) error {
+	if len(phase) > 1 {
+		// Policies of a phase share the input but for their own metadata
+		shared, err := deep.Copy(s.policyInput(state, &phase[0]))
+		if err != nil {
+			return NewInternalError("Failed to copy the policy input for concurrent evaluation", err.Error(), err)
+		}
+
+		speculativeCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer cancel() // stop evaluations not yet needed when the phase ends early
+
+		state.speculative = make(map[string]*speculativeResult, len(phase))
+		defer func() { state.speculative = nil }()
+		slots := make(chan struct{}, s.phaseConcurrency)
+		for _, policy := range phase {
+			input := maps.Clone(shared)
+			input["policy"] = policyMetadataInput(&policy)
+			result := &speculativeResult{input: input, done: make(chan struct{})}
+			state.speculative[policy.ID] = result
+			wg.Go(func() {
+				defer close(result.done)
+				select {
+				case slots <- struct{}{}:
+				case <-speculativeCtx.Done():
+					result.err = speculativeCtx.Err()
+					return
+				}
+				defer func() { <-slots }()
+				result.result, result.err = s.withPolicyTimeout(speculativeCtx, policy.ID, func(ctx context.Context) (*opa.EvaluationResult, error) {
+					return s.engine.EvaluatePolicy(ctx, policy.ID, input)
+				})
+			})
+		}
+	}
+
+	for i := range phase {
+		if err := evaluate(&phase[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evaluateRego evaluates the policy's Rego against input, using its speculative result when
+// that was evaluated against the same input
+func (s *evaluationService) evaluateRego(ctx context.Context, policyID string, input map[string]any, state *evaluationState) (*opa.EvaluationResult, error) {
+	if speculative, ok := state.speculative[policyID]; ok {
+		if deep.Equal(speculative.input, input) {
+			<-speculative.done
+			if speculative.err == nil {
+				speculativeEvaluationsTotal.Inc("used")
+				return speculative.result, nil
+			}
+			if errors.Is(speculative.err, context.DeadlineExceeded) {
+				// Evaluating the same input again would run into the deadline again
+				speculativeEvaluationsTotal.Inc("used")
+				return nil, speculative.err
+			}
+		}
+		speculativeEvaluationsTotal.Inc("discarded")
+	}
+	return s.withPolicyTimeout(ctx, policyID, func(ctx context.Context) (*opa.EvaluationResult, error) {
+		return s.engine.EvaluatePolicy(ctx, policyID, input)
+	})
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"sync"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// inputRecordingEngine records the spec and policy metadata each policy is evaluated with;
+// it is safe for concurrent use
+type inputRecordingEngine struct {
+	mockEngine
+	mu       sync.Mutex
+	specs    map[string][]map[string]any
+	metadata map[string][]map[string]any
+}
+
+func (m *inputRecordingEngine) EvaluatePolicy(ctx context.Context, policyID string, input map[string]any) (*opa.EvaluationResult, error) {
+	m.mu.Lock()
+	spec, _ := inputSpec(input)
+	m.specs[policyID] = append(m.specs[policyID], spec)
+	// The input maps are reused once the engine returns
+	m.metadata[policyID] = append(m.metadata[policyID], maps.Clone(input["policy"].(map[string]any)))
+	m.mu.Unlock()
+	return m.mockEngine.EvaluatePolicy(ctx, policyID, input)
+}
+
+var _ = Describe("Phased execution", func() {
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		engine    *inputRecordingEngine
+		request   *EvaluationRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{policies: []model.Policy{
+			{ID: "global-region", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+			{ID: "user-check", Enabled: true, PolicyType: "USER", Priority: 10},
+			{ID: "user-size", Enabled: true, PolicyType: "USER", Priority: 20},
+			{ID: "user-after-size", Enabled: true, PolicyType: "USER", Priority: 30},
+		}}
+		engine = &inputRecordingEngine{
+			mockEngine: mockEngine{evaluations: map[string]*opa.EvaluationResult{
+				"global-region": {Defined: true, Result: map[string]any{"patch": map[string]any{"region": "us-east-1"}}},
+				"user-check":    {Defined: true, Result: map[string]any{}},
+				"user-size":     {Defined: true, Result: map[string]any{"patch": map[string]any{"size": "small"}}},
+			}},
+			specs:    map[string][]map[string]any{},
+			metadata: map[string][]map[string]any{},
+		}
+		request = &EvaluationRequest{ServiceInstance: map[string]any{"service_type": "vm"}}
+	})
+
+	evaluate := func(opts ...EvaluationOption) (*EvaluationResponse, error) {
+		return NewEvaluationService(mockStore, engine, opts...).EvaluateRequest(ctx, request)
+	}
+
+	It("produces the decisions of a sequential evaluation", func() {
+		sequential, err := evaluate()
+		Expect(err).NotTo(HaveOccurred())
+
+		phased, err := evaluate(WithExecutionStrategy(ExecutionPhased, 4))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(phased.EvaluatedServiceInstance).To(Equal(sequential.EvaluatedServiceInstance))
+		Expect(phased.EvaluatedServiceInstance).To(Equal(map[string]any{"service_type": "vm", "region": "us-east-1", "size": "small"}))
+		Expect(phased.Status).To(Equal(EvaluationStatusModified))
+	})
+
+	It("starts USER policies after the GLOBAL decisions are applied", func() {
+		_, err := evaluate(WithExecutionStrategy(ExecutionPhased, 4))
+
+		Expect(err).NotTo(HaveOccurred())
+		for _, id := range []string{"user-check", "user-size", "user-after-size"} {
+			for _, spec := range engine.specs[id] {
+				Expect(spec).To(HaveKeyWithValue("region", "us-east-1"), id)
+			}
+		}
+	})
+
+	It("uses concurrent results for unchanged inputs and re-evaluates changed ones", func() {
+		_, err := evaluate(WithExecutionStrategy(ExecutionPhased, 2))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(engine.specs["user-check"]).To(HaveLen(1))
+		Expect(engine.specs["user-size"]).To(HaveLen(1))
+		Expect(engine.specs["user-after-size"]).To(ContainElement(HaveKeyWithValue("size", "small")))
+	})
+
+	It("passes each concurrently evaluated policy its own metadata", func() {
+		_, err := evaluate(WithExecutionStrategy(ExecutionPhased, 4))
+
+		Expect(err).NotTo(HaveOccurred())
+		for id, metadata := range engine.metadata {
+			for _, policy := range metadata {
+				Expect(policy).To(HaveKeyWithValue("id", id))
+			}
+		}
+		Expect(engine.metadata["user-size"]).To(ContainElement(HaveKeyWithValue("priority", 20)))
+	})
+
+	It("stops at the first rejection of a phase", func() {
+		engine.evaluations["user-check"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{
+			"rejected":         true,
+			"rejection_reason": "not allowed",
+		}}
+
+		_, err := evaluate(WithExecutionStrategy(ExecutionPhased, 4))
+
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+		Expect(serviceErr.Detail).To(Equal("not allowed"))
+	})
+})
+
+var _ = Describe("ParseExecutionStrategy", func() {
+	It("defaults to sequential", func() {
+		Expect(ParseExecutionStrategy("")).To(Equal(ExecutionSequential))
+		Expect(ParseExecutionStrategy("phased")).To(Equal(ExecutionPhased))
+	})
+
+	It("rejects unknown strategies", func() {
+		_, err := ParseExecutionStrategy("parallel")
+		Expect(err).To(MatchError(ContainSubstring("sequential, phased")))
+	})
+})
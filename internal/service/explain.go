@@ -0,0 +1,181 @@
+package service
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	"github.com/brunoga/deep/v4"
+)
+
+// RedactedValue replaces the value of redacted spec fields in explain output
+const RedactedValue = "[REDACTED]"
+
+// Explanation describes how each evaluated policy saw the request and what it decided
+type Explanation struct {
+	// InputSpec is the service instance spec the evaluation started from, redacted
+	InputSpec map[string]any
+	// RequestLabels are the labels policy selectors were matched against, redacted
+	RequestLabels   map[string]string
+	Policies        []PolicyTrace
+	SkippedPolicies []SkippedPolicy
+	// FieldProvenance maps dot-separated spec field paths to the policy that last patched them
+	FieldProvenance map[string]string
+}
+
+// PolicyOutcome is what came of evaluating one policy
+type PolicyOutcome string
+
+const (
+	PolicyOutcomeApplied   PolicyOutcome = "APPLIED"   // the decision was applied
+	PolicyOutcomeUndefined PolicyOutcome = "UNDEFINED" // the policy returned no decision
+	PolicyOutcomeRejected  PolicyOutcome = "REJECTED"  // the policy rejected the request
+	PolicyOutcomeFailed    PolicyOutcome = "FAILED"    // the decision could not be applied
+)
+
+// PolicyTrace records the evaluation of a single policy
+type PolicyTrace struct {
+	PolicyID         string
+	Input            map[string]any // OPA input document, redacted
+	Outcome          PolicyOutcome
+	Reason           string         // why the policy rejected the request or failed
+	Patch            map[string]any // patch returned by the policy, redacted
+	Constraints      map[string]any // constraints returned by the policy
+	SelectedProvider string
+}
+
+// SkippedPolicy is an enabled policy that was not evaluated for the request
+type SkippedPolicy struct {
+	PolicyID string
+	Reason   string
+}
+
+// finish records the outcome of the policy traced by trace, given the error its evaluation
+// returned
+func (trace *PolicyTrace) finish(err error) {
+	var serviceErr *ServiceError
+	switch {
+	case err == nil:
+		return
+	case !errors.As(err, &serviceErr):
+		trace.Outcome, trace.Reason = PolicyOutcomeFailed, err.Error()
+	case serviceErr.Type == ErrorTypeRejected:
+		// The detail of a rejection is the reason the policy gave
+		trace.Outcome, trace.Reason = PolicyOutcomeRejected, serviceErr.Detail
+	default:
+		trace.Outcome, trace.Reason = PolicyOutcomeFailed, serviceErr.Message
+		if serviceErr.Detail != "" {
+			trace.Reason += ": " + serviceErr.Detail
+		}
+	}
+}
+
+// redactInput returns a deep copy of the OPA input with the given dot-separated
+// spec field paths replaced by RedactedValue, in the spec and in the previous spec of the
+// instance. Paths that do not exist are ignored.
+func redactInput(input map[string]any, redactedFields []string) (map[string]any, error) {
+	copied, err := deep.Copy(input)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lookup := range []func(map[string]any) (map[string]any, bool){inputSpec, inputPreviousSpec} {
+		spec, ok := lookup(copied)
+		if !ok {
+			continue
+		}
+		for _, fieldPath := range redactedFields {
+			redactField(spec, strings.Split(fieldPath, "."))
+		}
+	}
+	return copied, nil
+}
+
+// redactPatch returns a deep copy of patch, or of a spec, with the given dot-separated spec
+// field paths replaced by RedactedValue
+func redactPatch(patch map[string]any, redactedFields []string) (map[string]any, error) {
+	copied, err := redactInput(map[string]any{"spec": patch}, redactedFields)
+	if err != nil {
+		return nil, err
+	}
+	return copied["spec"].(map[string]any), nil
+}
+
+// redactLabels returns a copy of the request labels with the values derived from redacted
+// spec fields replaced by RedactedValue: service_type and spec.metadata.labels
+func redactLabels(labels map[string]string, redactedFields []string) map[string]string {
+	redacted := make(map[string]string, len(labels))
+	maps.Copy(redacted, labels)
+	for _, fieldPath := range redactedFields {
+		switch {
+		case fieldPath == "metadata" || fieldPath == "metadata.labels":
+			for key := range redacted {
+				if key != "service_type" {
+					redacted[key] = RedactedValue
+				}
+			}
+		case fieldPath == "service_type":
+			if _, ok := redacted[fieldPath]; ok {
+				redacted[fieldPath] = RedactedValue
+			}
+		default:
+			if key, ok := strings.CutPrefix(fieldPath, "metadata.labels."); ok {
+				if _, exists := redacted[key]; exists {
+					redacted[key] = RedactedValue
+				}
+			}
+		}
+	}
+	return redacted
+}
+
+// redactField walks the path segments into m and masks the leaf value if present
+func redactField(m map[string]any, segments []string) {
+	value, exists := m[segments[0]]
+	if !exists {
+		return
+	}
+	if len(segments) == 1 {
+		m[segments[0]] = RedactedValue
+		return
+	}
+	if nested, ok := value.(map[string]any); ok {
+		redactField(nested, segments[1:])
+	}
+}
+
+// recordProvenance attributes the fields written by patch to policyID. base is the spec
+// before the patch is merged; it decides whether a nested object is merged into or replaced.
+// Removed and replaced subtrees lose their previous attribution.
+func recordProvenance(provenance map[string]string, prefix string, base, patch map[string]any, policyID string) {
+	for key, patchValue := range patch {
+		path := prefix + key
+		patchMap, patchIsMap := patchValue.(map[string]any)
+		baseMap, baseIsMap := base[key].(map[string]any)
+
+		if patchIsMap && baseIsMap {
+			recordProvenance(provenance, path+".", baseMap, patchMap, policyID)
+			continue
+		}
+
+		clearProvenance(provenance, path)
+		switch {
+		case patchValue == nil:
+			// Field removed
+		case patchIsMap && len(patchMap) > 0:
+			recordProvenance(provenance, path+".", nil, patchMap, policyID)
+		default:
+			provenance[path] = policyID
+		}
+	}
+}
+
+// clearProvenance removes the attribution of path and every field below it
+func clearProvenance(provenance map[string]string, path string) {
+	delete(provenance, path)
+	for existing := range provenance {
+		if strings.HasPrefix(existing, path+".") {
+			delete(provenance, existing)
+		}
+	}
+}
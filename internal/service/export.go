@@ -0,0 +1,119 @@
+package service
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"sigs.k8s.io/yaml"
+)
+
+// exportPageSize is how many policies ExportBundle reads at a time, bounding its memory
+const exportPageSize = 500
+
+// BundleExportOptions selects the policies ExportBundle writes
+type BundleExportOptions struct {
+	// PolicyType, when set, only exports the policies of this type
+	PolicyType v1alpha1.PolicyPolicyType
+	// StartAfter, when set, only exports the policies whose ID sorts after it
+	StartAfter string
+}
+
+// ExportBundle writes the policies opts selects to w as a gzipped OPA bundle that ImportBundle
+// reads back with the SIDECAR convention: per policy, in ID order, <id>.rego and then
+// <id>.metadata.yaml. Policies are read a page at a time and flush is called after each one,
+// so the export holds a page in memory however many policies there are. Nothing is written
+// before the first page is read. It returns the number of policies written.
+func (s *PolicyServiceImpl) ExportBundle(ctx context.Context, w io.Writer, opts BundleExportOptions, flush func() error) (int, error) {
+	log := logging.FromContext(ctx)
+
+	filter := &store.PolicyFilter{}
+	if opts.PolicyType != "" {
+		// Like list filters, a type this version does not know is not an error
+		policyType := string(opts.PolicyType)
+		filter.PolicyType = &policyType
+	}
+	if opts.StartAfter != "" {
+		startAfter := opts.StartAfter
+		filter.IDAfter = &startAfter
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	exported := 0
+	for {
+		page, err := s.store.Policy().List(ctx, &store.PolicyListOptions{
+			Filter:   filter,
+			OrderBy:  "id ASC",
+			PageSize: exportPageSize,
+		})
+		if err != nil {
+			log.Error("Failed to list policies for export", "error", err)
+			return exported, NewInternalError("Failed to list policies", err.Error(), err)
+		}
+		for i := range page.Policies {
+			if err := writeExportedPolicy(tw, &page.Policies[i]); err != nil {
+				return exported, fmt.Errorf("failed to write policy '%s': %w", page.Policies[i].ID, err)
+			}
+			// Everything written so far can be decompressed once flushed
+			if err := gz.Flush(); err != nil {
+				return exported, err
+			}
+			if err := flush(); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+		if page.NextOffset == 0 {
+			break
+		}
+		lastID := page.Policies[len(page.Policies)-1].ID
+		filter.IDAfter = &lastID
+	}
+	if err := tw.Close(); err != nil {
+		return exported, err
+	}
+	return exported, gz.Close()
+}
+
+// writeExportedPolicy writes the Rego file of policy and then its sidecar, so a received
+// sidecar means the policy was received in full
+func writeExportedPolicy(tw *tar.Writer, policy *model.Policy) error {
+	sidecar := DBToAPIModel(policy)
+	// The Rego code has its own file; output-only fields are not imported
+	sidecar.RegoCode = nil
+	sidecar.Path = nil
+	sidecar.CreateTime = nil
+	sidecar.UpdateTime = nil
+	sidecar.CreatedBy = nil
+	sidecar.UpdatedBy = nil
+	metadata, err := yaml.Marshal(sidecar)
+	if err != nil {
+		return err
+	}
+
+	if err := writeExportedFile(tw, policy.ID+".rego", []byte(policy.RegoCode), policy); err != nil {
+		return err
+	}
+	return writeExportedFile(tw, policy.ID+".metadata.yaml", metadata, policy)
+}
+
+func writeExportedFile(tw *tar.Writer, name string, content []byte, policy *model.Policy) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(content)),
+		ModTime:  policy.UpdateTime,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(content)
+	return err
+}
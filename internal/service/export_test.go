@@ -0,0 +1,189 @@
+package service_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// readBundle returns the names of the files in a gzipped tarball in archive order, and their contents
+func readBundle(archive []byte) ([]string, map[string]string) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gz)
+	var names []string
+	contents := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return names, contents
+		}
+		Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(tr)
+		Expect(err).NotTo(HaveOccurred())
+		names = append(names, header.Name)
+		contents[header.Name] = string(content)
+	}
+}
+
+var _ = Describe("PolicyService ExportBundle", func() {
+	var (
+		db            *gorm.DB
+		policyService *service.PolicyServiceImpl
+		ctx           context.Context
+	)
+
+	newService := func() (*gorm.DB, *service.PolicyServiceImpl) {
+		db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		return db, service.NewPolicyService(store.NewStore(db), opa.NewEngine())
+	}
+
+	BeforeEach(func() {
+		db, policyService = newService()
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	createPolicy := func(id string, policyType v1alpha1.PolicyPolicyType, priority int32) {
+		rego := fmt.Sprintf("package export.p%d\nmain := {\"rejected\": false}", priority)
+		displayName := "Policy " + id
+		_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+			DisplayName:   &displayName,
+			PolicyType:    &policyType,
+			Priority:      &priority,
+			RegoCode:      &rego,
+			LabelSelector: &map[string]string{"env": "prod"},
+		}, &id)
+		Expect(err).NotTo(HaveOccurred())
+	}
+	export := func(opts service.BundleExportOptions) ([]string, map[string]string, int) {
+		buf := &bytes.Buffer{}
+		flushes := 0
+		exported, err := policyService.ExportBundle(ctx, buf, opts, func() error {
+			flushes++
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flushes).To(Equal(exported))
+		names, contents := readBundle(buf.Bytes())
+		return names, contents, exported
+	}
+
+	It("should write each policy's Rego file and then its sidecar, in ID order", func() {
+		createPolicy("zeta", v1alpha1.GLOBAL, 10)
+		createPolicy("alpha", v1alpha1.USER, 20)
+
+		names, contents, exported := export(service.BundleExportOptions{})
+
+		Expect(exported).To(Equal(2))
+		Expect(names).To(Equal([]string{"alpha.rego", "alpha.metadata.yaml", "zeta.rego", "zeta.metadata.yaml"}))
+		Expect(contents["alpha.rego"]).To(ContainSubstring("package export.p20"))
+		Expect(contents["alpha.metadata.yaml"]).To(ContainSubstring("policy_type: USER"))
+		Expect(contents["alpha.metadata.yaml"]).NotTo(ContainSubstring("rego_code"))
+		Expect(contents["alpha.metadata.yaml"]).NotTo(ContainSubstring("create_time"))
+	})
+
+	It("should produce a bundle the SIDECAR import recreates the policies from", func() {
+		createPolicy("eu-only", v1alpha1.USER, 30)
+		buf := &bytes.Buffer{}
+		_, err := policyService.ExportBundle(ctx, buf, service.BundleExportOptions{}, func() error { return nil })
+		Expect(err).NotTo(HaveOccurred())
+		original, err := policyService.GetPolicy(ctx, "eu-only")
+		Expect(err).NotTo(HaveOccurred())
+
+		importDB, importService := newService()
+		defer func() {
+			sqlDB, _ := importDB.DB()
+			_ = sqlDB.Close()
+		}()
+		result, err := importService.ImportBundle(ctx, buf, service.BundleImportOptions{MetadataConvention: service.MetadataConventionSidecar})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resultByPath(result, "eu-only.rego").Status).To(Equal(v1alpha1.CREATED))
+
+		imported, err := importService.GetPolicy(ctx, "eu-only")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imported.DisplayName).To(Equal(original.DisplayName))
+		Expect(imported.PolicyType).To(Equal(original.PolicyType))
+		Expect(imported.Priority).To(Equal(original.Priority))
+		Expect(imported.LabelSelector).To(Equal(original.LabelSelector))
+		Expect(imported.RegoCode).To(Equal(original.RegoCode))
+	})
+
+	It("should only export the policies of the requested type after start_after", func() {
+		createPolicy("alpha", v1alpha1.USER, 10)
+		createPolicy("bravo", v1alpha1.GLOBAL, 20)
+		createPolicy("charlie", v1alpha1.USER, 30)
+		createPolicy("delta", v1alpha1.USER, 40)
+
+		names, _, exported := export(service.BundleExportOptions{PolicyType: v1alpha1.USER, StartAfter: "alpha"})
+
+		Expect(exported).To(Equal(2))
+		Expect(names).To(Equal([]string{"charlie.rego", "charlie.metadata.yaml", "delta.rego", "delta.metadata.yaml"}))
+	})
+
+	It("should page through more policies than it reads at a time", func() {
+		policies := make(model.PolicyList, 501)
+		for i := range policies {
+			policies[i] = model.Policy{
+				ID:          fmt.Sprintf("policy-%03d", i),
+				DisplayName: fmt.Sprintf("Policy %d", i),
+				PolicyType:  "GLOBAL",
+				Priority:    int32(i + 1),
+				RegoCode:    fmt.Sprintf("package export.p%d\nmain := {\"rejected\": false}", i),
+				Enabled:     true,
+			}
+		}
+		Expect(db.CreateInBatches(policies, 100).Error).To(Succeed())
+
+		names, _, exported := export(service.BundleExportOptions{})
+
+		Expect(exported).To(Equal(501))
+		Expect(names).To(HaveLen(1002))
+		Expect(names[1000]).To(Equal("policy-500.rego"))
+	})
+
+	It("should export an empty bundle for a policy type this version does not know", func() {
+		createPolicy("alpha", v1alpha1.USER, 10)
+
+		names, _, exported := export(service.BundleExportOptions{PolicyType: "TEAM"})
+
+		Expect(exported).To(BeZero())
+		Expect(names).To(BeEmpty())
+	})
+
+	It("should write nothing when the policies cannot be read", func() {
+		sqlDB, _ := db.DB()
+		Expect(sqlDB.Close()).To(Succeed())
+		buf := &bytes.Buffer{}
+
+		_, err := policyService.ExportBundle(ctx, buf, service.BundleExportOptions{}, func() error { return nil })
+
+		var serviceErr *service.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(service.ErrorTypeInternal))
+		Expect(buf.Len()).To(BeZero())
+	})
+})
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+// priorityBucketSize is the width of each priority histogram range
+const priorityBucketSize = 100
+
+// GetPolicyFacets returns the distinct policy types, label selector values and priority
+// ranges in use, each with the number of policies having it.
+func (s *PolicyServiceImpl) GetPolicyFacets(ctx context.Context) (*v1alpha1.PolicyFacets, error) {
+	facets, err := s.store.Policy().Facets(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to get policy facets from store", "error", err)
+		return nil, NewInternalError("Failed to get policy facets", err.Error(), err)
+	}
+
+	labelKeys := make([]v1alpha1.LabelSelectorKeyFacet, 0, len(facets.LabelSelectors))
+	for _, key := range slices.Sorted(maps.Keys(facets.LabelSelectors)) {
+		values := facetValues(facets.LabelSelectors[key])
+		count := 0
+		for _, value := range values {
+			count += int(value.Count)
+		}
+		labelKeys = append(labelKeys, v1alpha1.LabelSelectorKeyFacet{
+			Key:    key,
+			Count:  int32(count),
+			Values: values,
+		})
+	}
+
+	return &v1alpha1.PolicyFacets{
+		PolicyTypes:       facetValues(facets.PolicyTypes),
+		LabelSelectorKeys: labelKeys,
+		PriorityHistogram: priorityHistogram(facets.Priorities),
+	}, nil
+}
+
+// facetValues converts value counts to facet values in alphabetical order
+func facetValues(counts map[string]int) []v1alpha1.FacetValue {
+	values := make([]v1alpha1.FacetValue, 0, len(counts))
+	for _, value := range slices.Sorted(maps.Keys(counts)) {
+		values = append(values, v1alpha1.FacetValue{Value: value, Count: int32(counts[value])})
+	}
+	return values
+}
+
+// priorityHistogram groups priority counts into fixed ranges covering MinPriority to MaxPriority
+func priorityHistogram(counts map[int32]int) []v1alpha1.PriorityBucket {
+	buckets := make([]v1alpha1.PriorityBucket, 0, (MaxPriority-MinPriority+1)/priorityBucketSize)
+	for start := int32(MinPriority); start <= MaxPriority; start += priorityBucketSize {
+		buckets = append(buckets, v1alpha1.PriorityBucket{
+			Min: start,
+			Max: min(start+priorityBucketSize-1, MaxPriority),
+		})
+	}
+	for priority, count := range counts {
+		if priority < MinPriority || priority > MaxPriority {
+			continue
+		}
+		buckets[(priority-MinPriority)/priorityBucketSize].Count += int32(count)
+	}
+	return buckets
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+var failedOpenTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_failed_open_total",
+	"Evaluation requests approved unchanged because the policy store was unavailable",
+)
+
+// FailureMode selects how an evaluation request is answered when the policy store is
+// unavailable. Errors of a policy itself, such as a Rego runtime error or a timeout, always
+// fail the request.
+type FailureMode string
+
+const (
+	// FailureModeClosed fails the request with an Unavailable error
+	FailureModeClosed FailureMode = "closed"
+	// FailureModeOpen approves the request unchanged, as if no policy applied
+	FailureModeOpen FailureMode = "open"
+)
+
+// ParseFailureMode parses a failure mode; the empty string is closed
+func ParseFailureMode(mode string) (FailureMode, error) {
+	switch FailureMode(mode) {
+	case "", FailureModeClosed:
+		return FailureModeClosed, nil
+	case FailureModeOpen:
+		return FailureModeOpen, nil
+	}
+	return "", fmt.Errorf("failure mode must be one of: closed, open (got '%s')", mode)
+}
+
+// WithFailureMode answers evaluation requests that fail because the policy store is
+// unavailable according to mode. Sessions always fail closed.
+func WithFailureMode(mode FailureMode) EvaluationOption {
+	return func(s *evaluationService) {
+		s.failureMode = mode
+	}
+}
+
+// isUnavailable reports whether err is an Unavailable service error
+func isUnavailable(err error) bool {
+	var serviceErr *ServiceError
+	return errors.As(err, &serviceErr) && serviceErr.Type == ErrorTypeUnavailable
+}
+
+// failOpen returns the approval of req, unchanged, that answers an evaluation which failed
+// with the Unavailable error err in the open failure mode
+func (s *evaluationService) failOpen(ctx context.Context, req *EvaluationRequest, err error) (*EvaluationResponse, error) {
+	inputSpec, _ := s.withoutDeniedSpecFields(req.ServiceInstance)
+	spec, copyErr := deep.Copy(inputSpec)
+	if copyErr != nil {
+		return nil, err
+	}
+
+	failedOpenTotal.Inc()
+	logging.FromContext(ctx).Warn("Policies unavailable; approving the request unchanged", "error", err, "dry_run", req.DryRun)
+	if !req.DryRun {
+		s.events.Publish(ctx, events.EvaluationCompleted{
+			Status:         string(EvaluationStatusApproved),
+			RequestLabels:  req.RequestLabels,
+			RequestContext: req.RequestContext.fields(),
+			FailedOpen:     true,
+		})
+	}
+	status := EvaluationStatusApproved
+	if req.ExtendedStatus {
+		status = EvaluationStatusApprovedWithWarnings
+	}
+	return &EvaluationResponse{
+		EvaluatedServiceInstance: spec,
+		Status:                   status,
+		StatusReason:             StatusReasonFailedOpen,
+		DryRun:                   req.DryRun,
+		FailedOpen:               true,
+	}, nil
+}
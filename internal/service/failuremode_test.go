@@ -0,0 +1,18 @@
+package service
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseFailureMode", func() {
+	It("defaults to closed", func() {
+		Expect(ParseFailureMode("")).To(Equal(FailureModeClosed))
+		Expect(ParseFailureMode("open")).To(Equal(FailureModeOpen))
+	})
+
+	It("rejects unknown modes", func() {
+		_, err := ParseFailureMode("half-open")
+		Expect(err).To(MatchError(ContainSubstring("closed, open")))
+	})
+})
@@ -3,27 +3,35 @@ package service
 import (
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dcm-project/policy-manager/internal/store"
 )
 
-var (
-	// Regex patterns for CEL filter parsing
-	policyTypePattern = regexp.MustCompile(`policy_type\s*=\s*'(GLOBAL|USER)'`)
-	enabledPattern    = regexp.MustCompile(`enabled\s*=\s*(true|false)`)
-)
+// filterTermPattern matches one comparison of a filter expression
+var filterTermPattern = regexp.MustCompile(`^\s*([a-z_]+)\s*(<=|>=|=|<|>)\s*(.*?)\s*$`)
+
+// filterPolicyTypePattern matches a quoted policy type. Types this version does not know are
+// accepted, and match the policies a newer replica sharing the database created with them.
+var filterPolicyTypePattern = regexp.MustCompile(`^'[A-Z][A-Z0-9_]*'$`)
 
 // parseFilter parses a CEL filter expression into a PolicyFilter.
-// Supports filtering by policy_type and enabled fields.
+// A filter is one or more comparisons joined by AND:
 //
-// Supported expressions:
-//   - policy_type='GLOBAL'
-//   - policy_type='USER'
-//   - enabled=true
-//   - enabled=false
+//   - policy_type compared with = to a quoted policy type, e.g. policy_type='GLOBAL'
+//   - enabled=true or enabled=false
+//   - priority compared with =, <, <=, > or >= to an integer, e.g. priority>=100
+//   - create_time or update_time compared with <, <=, > or >= to a quoted RFC 3339
+//     timestamp, e.g. update_time>'2024-01-01T00:00:00Z'
+//   - created_by or updated_by compared with = to a quoted principal, e.g. created_by='alice'
+//
+// policy_type, enabled, created_by and updated_by may appear once each. Examples:
 //   - policy_type='GLOBAL' AND enabled=true
-//   - enabled=true AND policy_type='USER'
+//   - priority>=100 AND priority<500
+//   - create_time>='2024-01-01T00:00:00Z' AND create_time<'2024-02-01T00:00:00Z'
 //
 // Returns an error for invalid filter expressions.
 func parseFilter(filterExpr string) (*store.PolicyFilter, error) {
@@ -32,39 +40,109 @@ func parseFilter(filterExpr string) (*store.PolicyFilter, error) {
 	}
 
 	filter := &store.PolicyFilter{}
+	for term := range strings.SplitSeq(filterExpr, " AND ") {
+		if err := parseFilterTerm(filter, term); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
 
-	// Parse policy_type filter
-	if matches := policyTypePattern.FindStringSubmatch(filterExpr); len(matches) > 1 {
-		policyType := matches[1]
-		filter.PolicyType = &policyType
+// parseFilterTerm parses one comparison of a filter expression into filter
+func parseFilterTerm(filter *store.PolicyFilter, term string) error {
+	matches := filterTermPattern.FindStringSubmatch(term)
+	if matches == nil {
+		return invalidFilterError(fmt.Sprintf("'%s' is not a comparison. Use <field><operator><value>, e.g. priority>=100", strings.TrimSpace(term)))
 	}
+	field, operator, value := matches[1], matches[2], matches[3]
 
-	// Parse enabled filter
-	if matches := enabledPattern.FindStringSubmatch(filterExpr); len(matches) > 1 {
-		enabledStr := matches[1]
-		enabled := enabledStr == "true"
+	switch field {
+	case "policy_type":
+		if operator != "=" || !filterPolicyTypePattern.MatchString(value) {
+			return invalidFilterError("policy_type can only be compared with = to a quoted policy type, e.g. 'GLOBAL' or 'USER'")
+		}
+		if filter.PolicyType != nil {
+			return invalidFilterError("policy_type may appear only once")
+		}
+		policyType := strings.Trim(value, "'")
+		filter.PolicyType = &policyType
+	case "enabled":
+		if operator != "=" || (value != "true" && value != "false") {
+			return invalidFilterError("enabled can only be compared with = to true or false")
+		}
+		if filter.Enabled != nil {
+			return invalidFilterError("enabled may appear only once")
+		}
+		enabled := value == "true"
 		filter.Enabled = &enabled
+	case "created_by", "updated_by":
+		quoted := len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'")
+		if operator != "=" || !quoted {
+			return invalidFilterError(fmt.Sprintf("%s can only be compared with = to a quoted principal, e.g. 'alice'", field))
+		}
+		principal := strings.Trim(value, "'")
+		target := &filter.CreatedBy
+		if field == "updated_by" {
+			target = &filter.UpdatedBy
+		}
+		if *target != nil {
+			return invalidFilterError(fmt.Sprintf("%s may appear only once", field))
+		}
+		*target = &principal
+	case "priority":
+		priority, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return invalidFilterError(fmt.Sprintf("priority must be compared to an integer (got %s)", value))
+		}
+		filter.Comparisons = append(filter.Comparisons, store.PolicyComparison{Field: field, Operator: operator, Value: int32(priority)})
+	case "create_time", "update_time":
+		if operator == "=" {
+			return invalidFilterError(fmt.Sprintf("%s can only be compared with <, <=, > or >=", field))
+		}
+		quoted := len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'")
+		timestamp, err := time.Parse(time.RFC3339Nano, strings.Trim(value, "'"))
+		if !quoted || err != nil {
+			return invalidFilterError(fmt.Sprintf("%s must be compared to a quoted RFC 3339 timestamp, e.g. '2024-01-01T00:00:00Z' (got %s)", field, value))
+		}
+		filter.Comparisons = append(filter.Comparisons, store.PolicyComparison{Field: field, Operator: operator, Value: timestamp.UTC()})
+	default:
+		return invalidFilterError(fmt.Sprintf("Field '%s' is not supported. Supported fields: policy_type, enabled, priority, create_time, update_time, created_by, updated_by", field))
 	}
+	return nil
+}
 
-	// Validate that we parsed something
-	if filter.PolicyType == nil && filter.Enabled == nil {
-		return nil, NewInvalidArgumentError(
-			"Invalid filter expression",
-			fmt.Sprintf("Filter expression '%s' does not contain valid conditions. Supported fields: policy_type, enabled", filterExpr),
-		)
-	}
+func invalidFilterError(detail string) error {
+	return NewInvalidArgumentError("Invalid filter expression", detail)
+}
 
-	// Validate AND operator usage if present
-	if strings.Contains(filterExpr, " AND ") {
-		// If AND is present, both conditions should be present
-		parts := strings.Split(filterExpr, " AND ")
-		if len(parts) != 2 {
-			return nil, NewInvalidArgumentError(
-				"Invalid filter expression",
-				"Multiple AND operators are not supported",
-			)
+// filterScope renders a parsed filter canonically as the parts of a page token scope, so
+// equivalent filter expressions share page tokens. Each comparison is a part of its own, as
+// principals may contain the characters that would separate them.
+func filterScope(filter *store.PolicyFilter) []string {
+	if filter == nil {
+		return nil
+	}
+	var parts []string
+	if filter.PolicyType != nil {
+		parts = append(parts, "policy_type="+*filter.PolicyType)
+	}
+	if filter.Enabled != nil {
+		parts = append(parts, fmt.Sprintf("enabled=%t", *filter.Enabled))
+	}
+	if filter.CreatedBy != nil {
+		parts = append(parts, "created_by="+*filter.CreatedBy)
+	}
+	if filter.UpdatedBy != nil {
+		parts = append(parts, "updated_by="+*filter.UpdatedBy)
+	}
+	var comparisons []string
+	for _, c := range filter.Comparisons {
+		value := fmt.Sprint(c.Value)
+		if t, ok := c.Value.(time.Time); ok {
+			value = t.Format(time.RFC3339Nano)
 		}
+		comparisons = append(comparisons, c.Field+c.Operator+value)
 	}
-
-	return filter, nil
+	slices.Sort(comparisons)
+	return append(parts, comparisons...)
 }
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/gatekeeper"
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+// ConvertGatekeeper converts Gatekeeper ConstraintTemplates and Constraints into GLOBAL policies.
+// The converted policies are returned for review and are not persisted.
+func (s *PolicyServiceImpl) ConvertGatekeeper(ctx context.Context, resources io.Reader) (*v1alpha1.GatekeeperConversionResult, error) {
+	log := logging.FromContext(ctx)
+
+	raw, err := io.ReadAll(io.LimitReader(resources, maxBundleSize+1))
+	if err != nil {
+		return nil, NewInternalError("Failed to read request body", err.Error(), err)
+	}
+	if len(raw) > maxBundleSize {
+		return nil, NewInvalidArgumentError("Invalid Gatekeeper resources", fmt.Sprintf("Request body exceeds the maximum size of %d bytes", maxBundleSize))
+	}
+
+	converted, err := gatekeeper.Convert(raw)
+	if err != nil {
+		return nil, NewInvalidArgumentError("Invalid Gatekeeper resources", err.Error())
+	}
+
+	policies := make([]v1alpha1.Policy, len(converted.Policies))
+	for i, p := range converted.Policies {
+		policyType := v1alpha1.GLOBAL
+		policies[i] = v1alpha1.Policy{
+			Id:          &p.ID,
+			DisplayName: &p.DisplayName,
+			Description: &p.Description,
+			PolicyType:  &policyType,
+			Enabled:     &p.Enabled,
+			RegoCode:    &p.RegoCode,
+		}
+		if p.LabelSelector != nil {
+			policies[i].LabelSelector = &p.LabelSelector
+		}
+	}
+
+	report := make([]v1alpha1.GatekeeperConversionIssue, len(converted.Issues))
+	for i, issue := range converted.Issues {
+		report[i] = v1alpha1.GatekeeperConversionIssue{
+			Source:   issue.Source,
+			Severity: v1alpha1.GatekeeperConversionIssueSeverity(issue.Severity),
+			Message:  issue.Message,
+		}
+	}
+
+	log.Debug("Converted Gatekeeper resources", "policy_count", len(policies), "issue_count", len(report))
+	return &v1alpha1.GatekeeperConversionResult{Policies: policies, Report: report}, nil
+}
@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyService ConvertGatekeeper", func() {
+	var policyService *service.PolicyServiceImpl
+
+	BeforeEach(func() {
+		policyService = service.NewPolicyService(nil, nil)
+	})
+
+	It("should return GLOBAL policies and the conversion report", func() {
+		resources := `apiVersion: templates.gatekeeper.sh/v1
+kind: ConstraintTemplate
+metadata:
+  name: denyall
+spec:
+  crd:
+    spec:
+      names:
+        kind: DenyAll
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package denyall
+        violation[{"msg": "denied"}] { true }
+`
+		result, err := policyService.ConvertGatekeeper(context.Background(), strings.NewReader(resources))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Policies).To(HaveLen(1))
+		Expect(*result.Policies[0].Id).To(Equal("denyall"))
+		Expect(*result.Policies[0].PolicyType).To(Equal(v1alpha1.GLOBAL))
+		Expect(result.Policies[0].LabelSelector).To(BeNil())
+		Expect(result.Report).To(HaveLen(1))
+		Expect(result.Report[0].Severity).To(Equal(v1alpha1.WARNING))
+	})
+
+	It("should return INVALID_ARGUMENT when no Gatekeeper resources are found", func() {
+		_, err := policyService.ConvertGatekeeper(context.Background(), strings.NewReader("kind: ConfigMap\n"))
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*service.ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+	})
+})
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+)
+
+// InputLayout selects how the OPA input of a policy is structured
+type InputLayout string
+
+const (
+	// InputLayoutNamespaced puts the request under input.request and what the engine adds
+	// under input.context, so no spec field can be mistaken for an engine field. Policies opt
+	// in to it once they are written against it.
+	InputLayoutNamespaced InputLayout = "namespaced"
+	// InputLayoutFlat is the original layout, with the spec, provider and constraints at the
+	// top level of the input, and the default so stored policies keep working
+	InputLayoutFlat InputLayout = "flat"
+)
+
+// ParseInputLayout parses an input layout; the empty string is flat
+func ParseInputLayout(layout string) (InputLayout, error) {
+	switch InputLayout(layout) {
+	case "", InputLayoutFlat:
+		return InputLayoutFlat, nil
+	case InputLayoutNamespaced:
+		return InputLayoutNamespaced, nil
+	}
+	return "", fmt.Errorf("input layout must be one of: flat, namespaced (got '%s')", layout)
+}
+
+// WithInputLayout structures the OPA input of every policy according to layout
+func WithInputLayout(layout InputLayout) EvaluationOption {
+	return func(s *evaluationService) {
+		s.inputLayout = layout
+	}
+}
+
+// buildInput returns the OPA input of a policy in layout. The request context, the preferred
+// providers, the previous evaluation of the instance and the accumulated constraints are
+// omitted while there are none; policy is the policy's own metadata, input.policy in both layouts.
+func buildInput(layout InputLayout, spec map[string]any, requestContext map[string]string, preferredProviders []string, previous map[string]any, provider string, accumulated *constraints.Set, policy map[string]any) map[string]any {
+	maps := &inputMaps{root: map[string]any{}, request: map[string]any{}, context: map[string]any{}}
+	return maps.build(layout, spec, requestContext, preferredProviders, previous, provider, accumulated, policy)
+}
+
+// inputMaps are the maps an OPA input is made of, besides the spec and the policy metadata.
+// The maps of the inputs evaluations build for every policy are taken from inputMapsPool and
+// put back once the engine, which does not retain its input, has returned.
+type inputMaps struct {
+	root, request, context map[string]any
+	policy                 map[string]any // input.policy, only set by acquireInputMaps
+}
+
+var inputMapsPool = sync.Pool{
+	New: func() any {
+		return &inputMaps{
+			root:    make(map[string]any, 3),
+			request: make(map[string]any, 3),
+			context: make(map[string]any, 4),
+			policy:  make(map[string]any, 6),
+		}
+	},
+}
+
+// acquireInputMaps returns empty input maps from the pool; release puts them back
+func acquireInputMaps() *inputMaps {
+	return inputMapsPool.Get().(*inputMaps)
+}
+
+// release empties the maps and puts them back in the pool. Nothing may use the input built
+// from them afterwards.
+func (m *inputMaps) release() {
+	clear(m.root)
+	clear(m.request)
+	clear(m.context)
+	clear(m.policy)
+	inputMapsPool.Put(m)
+}
+
+// policyInput builds the input of a policy from the pooled maps, like buildInput
+func (m *inputMaps) policyInput(layout InputLayout, spec map[string]any, requestContext map[string]string, preferredProviders []string, previous map[string]any, provider string, accumulated *constraints.Set, policy *model.Policy) map[string]any {
+	return m.build(layout, spec, requestContext, preferredProviders, previous, provider, accumulated, setPolicyMetadata(m.policy, policy))
+}
+
+// build fills the maps with the input of a policy and returns its root
+func (m *inputMaps) build(layout InputLayout, spec map[string]any, requestContext map[string]string, preferredProviders []string, previous map[string]any, provider string, accumulated *constraints.Set, policy map[string]any) map[string]any {
+	engineFields := m.context
+	engineFields["provider"] = provider
+	if previous != nil {
+		engineFields["previous"] = previous
+	}
+	if constraints := accumulated.GetConstraintsMap(); constraints != nil {
+		engineFields["constraints"] = constraints
+	}
+	if spConstraints := accumulated.GetSPConstraintsMap(); spConstraints != nil {
+		engineFields["service_provider_constraints"] = spConstraints
+	}
+
+	if layout == InputLayoutFlat {
+		engineFields["spec"] = spec
+		if requestContext != nil {
+			engineFields["request_context"] = requestContext
+		}
+		if len(preferredProviders) > 0 {
+			engineFields["preferred_providers"] = preferredProviders
+		}
+		engineFields["policy"] = policy
+		return engineFields
+	}
+	request := m.request
+	request["spec"] = spec
+	if requestContext != nil {
+		request["context"] = requestContext
+	}
+	if len(preferredProviders) > 0 {
+		request["preferred_providers"] = preferredProviders
+	}
+	m.root["request"] = request
+	m.root["context"] = engineFields
+	m.root["policy"] = policy
+	return m.root
+}
+
+// inputSpec returns the spec of an OPA input in either layout
+func inputSpec(input map[string]any) (map[string]any, bool) {
+	if request, ok := input["request"].(map[string]any); ok {
+		spec, ok := request["spec"].(map[string]any)
+		return spec, ok
+	}
+	spec, ok := input["spec"].(map[string]any)
+	return spec, ok
+}
+
+// inputPreviousSpec returns the spec of the previous evaluation in an OPA input in either
+// layout, if the input has one
+func inputPreviousSpec(input map[string]any) (map[string]any, bool) {
+	if engineFields, ok := input["context"].(map[string]any); ok {
+		input = engineFields
+	}
+	previous, ok := input["previous"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	spec, ok := previous["spec"].(map[string]any)
+	return spec, ok
+}
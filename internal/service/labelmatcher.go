@@ -1,5 +1,7 @@
 package service
 
+import "fmt"
+
 // MatchesLabelSelector checks if request labels match the policy label selector.
 // Uses AND semantics: all policy selector labels must match request labels.
 // Empty policy selector matches all requests.
@@ -19,3 +21,26 @@ func MatchesLabelSelector(policySelector map[string]string, requestLabels map[st
 
 	return true
 }
+
+// ExtractRequestLabels derives the labels policy selectors are matched against from a
+// service instance spec: its service_type and the string values of spec.metadata.labels
+func ExtractRequestLabels(spec map[string]any) (map[string]string, error) {
+	serviceType, ok := spec["service_type"].(string)
+	if !ok {
+		return nil, fmt.Errorf("service type is required")
+	}
+	result := make(map[string]string)
+	result["service_type"] = serviceType
+
+	if metadata, ok := spec["metadata"].(map[string]any); ok {
+		if labels, ok := metadata["labels"].(map[string]any); ok {
+			for k, v := range labels {
+				if strVal, ok := v.(string); ok {
+					result[k] = strVal
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -83,3 +83,86 @@ var _ = Describe("MatchesLabelSelector", func() {
 		Expect(MatchesLabelSelector(policySelector, requestLabels)).To(BeFalse())
 	})
 })
+
+var _ = Describe("ExtractRequestLabels", func() {
+	It("returns error when service_type is missing", func() {
+		spec := map[string]any{}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).To(MatchError("service type is required"))
+		Expect(labels).To(BeNil())
+	})
+
+	It("returns error when service_type is not a string", func() {
+		spec := map[string]any{"service_type": 123}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).To(MatchError("service type is required"))
+		Expect(labels).To(BeNil())
+	})
+
+	It("returns only service_type when no metadata or labels", func() {
+		spec := map[string]any{"service_type": "compute"}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
+	})
+
+	It("includes metadata.labels when present", func() {
+		spec := map[string]any{
+			"service_type": "storage",
+			"metadata": map[string]any{
+				"labels": map[string]any{
+					"env":    "prod",
+					"region": "us-east-1",
+				},
+			},
+		}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{
+			"service_type": "storage",
+			"env":          "prod",
+			"region":       "us-east-1",
+		}))
+	})
+
+	It("skips non-string label values", func() {
+		spec := map[string]any{
+			"service_type": "compute",
+			"metadata": map[string]any{
+				"labels": map[string]any{
+					"env":  "prod",
+					"num":  42,
+					"flag": true,
+				},
+			},
+		}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{
+			"service_type": "compute",
+			"env":          "prod",
+		}))
+	})
+
+	It("returns only service_type when metadata is not a map", func() {
+		spec := map[string]any{
+			"service_type": "compute",
+			"metadata":     "not-a-map",
+		}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
+	})
+
+	It("returns only service_type when labels is not a map", func() {
+		spec := map[string]any{
+			"service_type": "compute",
+			"metadata": map[string]any{
+				"labels": []string{"a", "b"},
+			},
+		}
+		labels, err := ExtractRequestLabels(spec)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"service_type": "compute"}))
+	})
+})
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+)
+
+// regoWarnings lints the Rego code of a policy returned by a create or update. Lint
+// problems never fail the change; nil means none were found.
+func regoWarnings(ctx context.Context, policyID, regoCode string) *[]v1alpha1.PolicyWarning {
+	issues, err := opa.LintRego(regoCode)
+	if err != nil {
+		// Stored Rego always parses
+		logging.FromContext(ctx).Warn("Failed to lint policy", "policy_id", policyID, "error", err)
+		return nil
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+
+	warnings := make([]v1alpha1.PolicyWarning, len(issues))
+	for i, issue := range issues {
+		warnings[i] = v1alpha1.PolicyWarning{Code: v1alpha1.PolicyWarningCode(issue.Code), Message: issue.Message}
+		if issue.Line > 0 {
+			line, column := int32(issue.Line), int32(issue.Column)
+			warnings[i].Line = &line
+			warnings[i].Column = &column
+		}
+	}
+	logging.FromContext(ctx).Debug("Policy linted", "policy_id", policyID, "warnings", len(warnings))
+	return &warnings
+}
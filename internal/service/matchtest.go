@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+)
+
+// TestPolicyMatch reports whether the policy's label selector matches the request labels,
+// or the labels derived from the request spec, listing the selector terms that fail.
+// No Rego is evaluated.
+func (s *PolicyServiceImpl) TestPolicyMatch(ctx context.Context, id string, req v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error) {
+	log := logging.FromContext(ctx)
+
+	labels, err := matchTestLabels(req)
+	if err != nil {
+		return nil, err
+	}
+
+	dbPolicy, err := s.store.Policy().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyNotFound) {
+			return nil, NewPolicyNotFoundError(id)
+		}
+		log.Error("Failed to get policy from store", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to get policy", err.Error(), err)
+	}
+
+	selector := dbPolicy.LabelSelector
+	if selector == nil {
+		selector = map[string]string{}
+	}
+	failed := failedSelectorTerms(selector, labels)
+	log.Debug("Policy match tested", "policy_id", id, "failed_terms", len(failed))
+
+	return &v1alpha1.PolicyMatchTestResult{
+		Matches:       len(failed) == 0,
+		Enabled:       dbPolicy.Enabled,
+		LabelSelector: selector,
+		RequestLabels: labels,
+		FailedTerms:   failed,
+	}, nil
+}
+
+// matchTestLabels returns the request labels of a match test request
+func matchTestLabels(req v1alpha1.PolicyMatchTestRequest) (map[string]string, error) {
+	switch {
+	case req.Labels != nil && req.Spec != nil:
+		return nil, NewInvalidArgumentError("Invalid match test request", "Set either labels or spec, not both")
+	case req.Spec != nil:
+		labels, err := ExtractRequestLabels(*req.Spec)
+		if err != nil {
+			return nil, NewInvalidArgumentError("Invalid service instance spec", err.Error())
+		}
+		return labels, nil
+	case req.Labels != nil:
+		return *req.Labels, nil
+	}
+	return map[string]string{}, nil
+}
+
+// failedSelectorTerms returns the selector terms the labels do not satisfy, ordered by key.
+// It fails exactly the terms that make MatchesLabelSelector return false.
+func failedSelectorTerms(selector, labels map[string]string) []v1alpha1.SelectorTermFailure {
+	failed := []v1alpha1.SelectorTermFailure{}
+	for _, key := range slices.Sorted(maps.Keys(selector)) {
+		expected := selector[key]
+		actual, exists := labels[key]
+		switch {
+		case !exists:
+			failed = append(failed, v1alpha1.SelectorTermFailure{
+				Key:      key,
+				Expected: expected,
+				Reason:   v1alpha1.SelectorTermMissing,
+			})
+		case actual != expected:
+			failed = append(failed, v1alpha1.SelectorTermFailure{
+				Key:      key,
+				Expected: expected,
+				Actual:   &actual,
+				Reason:   v1alpha1.SelectorTermMismatch,
+			})
+		}
+	}
+	return failed
+}
@@ -2,7 +2,10 @@ package service
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/store"
 )
 
 const (
@@ -10,22 +13,17 @@ const (
 	DefaultOrderBy = "policy_type ASC, priority ASC, id ASC"
 )
 
-// Supported order by fields
-var supportedOrderByFields = map[string]bool{
-	"priority":     true,
-	"display_name": true,
-	"create_time":  true,
-}
-
 // parseOrderBy parses an order_by parameter into GORM format.
 // Supports single and multiple field ordering with asc/desc directions.
 //
-// Supported fields: priority, display_name, create_time
+// Supported fields are store.PolicyOrderByFields: id, display_name, policy_type, priority,
+// enabled, create_time, update_time. Each field may appear once. Unless the ordering
+// includes id, "id ASC" is appended so policies with equal values page in a stable order.
 //
 // Examples:
-//   - "priority asc" → "priority ASC"
-//   - "display_name desc" → "display_name DESC"
-//   - "create_time desc,priority asc" → "create_time DESC, priority ASC"
+//   - "priority asc" → "priority ASC, id ASC"
+//   - "update_time desc" → "update_time DESC, id ASC"
+//   - "policy_type asc,priority asc" → "policy_type ASC, priority ASC, id ASC"
 //
 // If orderBy is empty, returns the default ordering.
 //
@@ -38,6 +36,7 @@ func parseOrderBy(orderBy string) (string, error) {
 	// Split by comma for multiple fields
 	parts := strings.Split(orderBy, ",")
 	var gormParts []string
+	seen := map[string]bool{}
 
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
@@ -55,12 +54,19 @@ func parseOrderBy(orderBy string) (string, error) {
 		direction := "ASC" // Default direction
 
 		// Validate field
-		if !supportedOrderByFields[field] {
+		if !slices.Contains(store.PolicyOrderByFields, field) {
+			return "", NewInvalidArgumentError(
+				"Invalid order_by field",
+				fmt.Sprintf("Field '%s' is not supported for ordering. Supported fields: %s", field, strings.Join(store.PolicyOrderByFields, ", ")),
+			)
+		}
+		if seen[field] {
 			return "", NewInvalidArgumentError(
 				"Invalid order_by field",
-				fmt.Sprintf("Field '%s' is not supported for ordering. Supported fields: priority, display_name, create_time", field),
+				fmt.Sprintf("Field '%s' appears more than once in order_by", field),
 			)
 		}
+		seen[field] = true
 
 		// Parse direction if provided
 		if len(tokens) > 1 {
@@ -88,6 +94,9 @@ func parseOrderBy(orderBy string) (string, error) {
 	if len(gormParts) == 0 {
 		return DefaultOrderBy, nil
 	}
+	if !seen["id"] {
+		gormParts = append(gormParts, "id ASC")
+	}
 
 	return strings.Join(gormParts, ", "), nil
 }
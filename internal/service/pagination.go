@@ -0,0 +1,58 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+)
+
+// PageSizeLimits are the page size used when a list request does not set one and the largest
+// page size a list request may use
+type PageSizeLimits struct {
+	Default int
+	Max     int
+}
+
+// DefaultPageSizeLimits are the page size limits used unless configured otherwise
+var DefaultPageSizeLimits = PageSizeLimits{Default: 50, Max: 1000}
+
+// Validate checks that the default page size is between 1 and the maximum
+func (l PageSizeLimits) Validate() error {
+	if l.Max < 1 {
+		return fmt.Errorf("maximum page size must be at least 1 (got %d)", l.Max)
+	}
+	if l.Default < 1 || l.Default > l.Max {
+		return fmt.Errorf("default page size must be between 1 and the maximum page size %d (got %d)", l.Max, l.Default)
+	}
+	return nil
+}
+
+// parsePageSize validates a requested page size and returns the page size to use: the default
+// when none is requested, and at most the maximum
+func (l PageSizeLimits) parsePageSize(pageSize *int32) (int, error) {
+	if pageSize == nil {
+		return l.Default, nil
+	}
+	if *pageSize < 1 {
+		return 0, NewInvalidArgumentError(
+			"Invalid page size",
+			"Page size must be at least 1",
+		)
+	}
+	return min(int(*pageSize), l.Max), nil
+}
+
+// decodePageToken returns the offset carried by pageToken, or zero for the first page
+func decodePageToken(tokens *pagetoken.Codec, pageToken *string, scope string) (int, error) {
+	if pageToken == nil || *pageToken == "" {
+		return 0, nil
+	}
+	offset, err := tokens.Decode(*pageToken, scope)
+	if err != nil {
+		return 0, NewInvalidArgumentError(
+			"Invalid page token",
+			fmt.Sprintf("%s; restart the listing without page_token", err),
+		)
+	}
+	return offset, nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/brunoga/deep/v4"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+var patchConflictsTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_patch_conflicts_total",
+	"Fields overwritten with a different value by a policy of comparable priority within one evaluation",
+	"mode",
+)
+
+// PatchConflictMode selects what happens when a policy overwrites a field that a policy of
+// comparable priority set earlier in the same evaluation
+type PatchConflictMode string
+
+const (
+	PatchConflictsAllow PatchConflictMode = "allow" // the last writer wins silently
+	PatchConflictsWarn  PatchConflictMode = "warn"  // the last writer wins; the overwrite is logged and counted
+	PatchConflictsDeny  PatchConflictMode = "deny"  // the evaluation fails with a conflict naming both policies
+)
+
+// ParsePatchConflictMode parses a patch conflict mode; the empty string is allow
+func ParsePatchConflictMode(mode string) (PatchConflictMode, error) {
+	switch PatchConflictMode(mode) {
+	case "", PatchConflictsAllow:
+		return PatchConflictsAllow, nil
+	case PatchConflictsWarn, PatchConflictsDeny:
+		return PatchConflictMode(mode), nil
+	}
+	return "", fmt.Errorf("patch conflict mode must be one of: allow, warn, deny (got '%s')", mode)
+}
+
+// WithPatchConflicts detects policies overwriting fields set earlier in the same evaluation by
+// a policy whose priority differs by at most window, and handles them according to mode.
+// Priorities are unique within a policy type, so a window of zero only compares policies of
+// different types sharing a priority.
+func WithPatchConflicts(mode PatchConflictMode, window int32) EvaluationOption {
+	return func(s *evaluationService) {
+		s.patchConflicts = mode
+		s.patchConflictWindow = window
+	}
+}
+
+// patchWriters tracks which policy last wrote each spec field during one evaluation
+type patchWriters struct {
+	fields     map[string]string // field path -> policy ID
+	priorities map[string]int32  // policy ID -> priority
+}
+
+// checkPatchConflicts records the fields policy writes when merged turns the current spec into
+// merged, and handles the fields it overwrites with a different value that a policy of
+// comparable priority set. Setting a field to the value it already has is not a conflict.
+func (s *evaluationService) checkPatchConflicts(ctx context.Context, policy *model.Policy, state *evaluationState, patch, merged map[string]any) error {
+	writers := state.writers
+	previous := maps.Clone(writers.fields)
+	recordProvenance(writers.fields, "", state.spec, patch, policy.ID)
+	writers.priorities[policy.ID] = policy.Priority
+
+	var overwrites []PatchOverwrite
+	for _, path := range slices.Sorted(maps.Keys(previous)) {
+		writer := previous[path]
+		if writers.fields[path] == writer || !s.comparablePriority(writers.priorities[writer], policy.Priority) {
+			continue
+		}
+		if deep.Equal(fieldValue(state.spec, path), fieldValue(merged, path)) {
+			continue
+		}
+		overwrites = append(overwrites, PatchOverwrite{FieldPath: path, PreviousPolicyID: writer})
+	}
+	if len(overwrites) == 0 {
+		return nil
+	}
+
+	patchConflictsTotal.Add(float64(len(overwrites)), string(s.patchConflicts))
+	if s.patchConflicts == PatchConflictsDeny {
+		return NewPatchConflictError(policy.ID, overwrites)
+	}
+	log := logging.FromContext(ctx)
+	for _, overwrite := range overwrites {
+		log.Warn("Policy overwrote a field set by a policy of comparable priority",
+			"policy_id", policy.ID,
+			"field", overwrite.FieldPath,
+			"previous_policy_id", overwrite.PreviousPolicyID,
+		)
+	}
+	return nil
+}
+
+func (s *evaluationService) comparablePriority(a, b int32) bool {
+	diff := int64(a) - int64(b)
+	return diff <= int64(s.patchConflictWindow) && -diff <= int64(s.patchConflictWindow)
+}
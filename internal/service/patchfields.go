@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"sigs.k8s.io/yaml"
+)
+
+var unknownPatchFieldsTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_unknown_patch_fields_total",
+	"Policy patch fields missing from the allowed fields of the service type, by action: warned or rejected",
+	"action",
+)
+
+// PatchFieldMode selects what happens when a policy patches a field the allowed patch fields
+// of the service type do not list
+type PatchFieldMode string
+
+const (
+	// PatchFieldsWarn applies the patch and returns a warning naming the policy and field
+	PatchFieldsWarn PatchFieldMode = "warn"
+	// PatchFieldsStrict fails the evaluation with a conflict naming the policy and field
+	PatchFieldsStrict PatchFieldMode = "strict"
+)
+
+// ParsePatchFieldMode parses a patch field mode; the empty string is warn
+func ParsePatchFieldMode(mode string) (PatchFieldMode, error) {
+	switch PatchFieldMode(mode) {
+	case "", PatchFieldsWarn:
+		return PatchFieldsWarn, nil
+	case PatchFieldsStrict:
+		return PatchFieldsStrict, nil
+	}
+	return "", fmt.Errorf("patch field mode must be one of: warn, strict (got '%s')", mode)
+}
+
+// WarningCodeUnknownPatchField is the code of the warning returned for a policy patching a
+// field the allowed patch fields of the service type do not list
+const WarningCodeUnknownPatchField = "UNKNOWN_PATCH_FIELD"
+
+// EvaluationWarning is a problem found during an evaluation that did not fail it
+type EvaluationWarning struct {
+	Code     string
+	PolicyID string
+	Field    string // dot-separated spec field path
+	Message  string
+}
+
+// PatchFieldAllowlist holds the spec fields policies may patch, by service type
+type PatchFieldAllowlist struct {
+	fields map[string][]string
+}
+
+// NewPatchFieldAllowlist creates an allowlist from dot-separated spec field paths keyed by
+// service type. Patching a listed field or a field below it is allowed.
+func NewPatchFieldAllowlist(fields map[string][]string) (*PatchFieldAllowlist, error) {
+	allowlist := &PatchFieldAllowlist{fields: make(map[string][]string, len(fields))}
+	for serviceType, paths := range fields {
+		if strings.TrimSpace(serviceType) == "" {
+			return nil, fmt.Errorf("service types must be non-empty")
+		}
+		for _, path := range paths {
+			if path == "" || slices.Contains(strings.Split(path, "."), "") {
+				return nil, fmt.Errorf("service type '%s': invalid field path '%s'", serviceType, path)
+			}
+		}
+		allowlist.fields[serviceType] = paths
+	}
+	return allowlist, nil
+}
+
+// LoadPatchFieldAllowlist reads an allowlist from a YAML or JSON file mapping service types
+// to the dot-separated spec field paths policies may patch
+func LoadPatchFieldAllowlist(path string) (*PatchFieldAllowlist, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read patch field allowlist: %w", err)
+	}
+	var fields map[string][]string
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to parse patch field allowlist %s: %w", path, err)
+	}
+	allowlist, err := NewPatchFieldAllowlist(fields)
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch field allowlist %s: %w", path, err)
+	}
+	return allowlist, nil
+}
+
+// WithPatchFieldAllowlist checks the fields policy patches set or remove against the allowed
+// fields of the service type of the request, handling the others according to mode. Service
+// types the allowlist does not list are not checked. A nil allowlist disables the check.
+func WithPatchFieldAllowlist(allowlist *PatchFieldAllowlist, mode PatchFieldMode) EvaluationOption {
+	return func(s *evaluationService) {
+		s.patchFields = allowlist
+		s.patchFieldMode = mode
+	}
+}
+
+// unknownFields returns the sorted dot-separated paths of the fields patch sets or removes
+// that are neither allowed for serviceType nor below an allowed field. ok is false when the
+// service type is not listed.
+func (a *PatchFieldAllowlist) unknownFields(serviceType string, patch map[string]any) (unknown []string, ok bool) {
+	allowed, ok := a.fields[serviceType]
+	if !ok {
+		return nil, false
+	}
+	collectUnknownFields(allowed, "", patch, &unknown)
+	slices.Sort(unknown)
+	return unknown, true
+}
+
+// collectUnknownFields appends to unknown the paths below prefix of the fields patch sets or
+// removes that allowed does not cover. A patch object is descended into when an allowed path
+// is below it, so allowing metadata.labels does not allow metadata.owner.
+func collectUnknownFields(allowed []string, prefix string, patch map[string]any, unknown *[]string) {
+	for key, value := range patch {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if slices.ContainsFunc(allowed, func(field string) bool {
+			return path == field || strings.HasPrefix(path, field+".")
+		}) {
+			continue
+		}
+		nested, isObject := value.(map[string]any)
+		if isObject && slices.ContainsFunc(allowed, func(field string) bool {
+			return strings.HasPrefix(field, path+".")
+		}) {
+			collectUnknownFields(allowed, path, nested, unknown)
+			continue
+		}
+		*unknown = append(*unknown, path)
+	}
+}
+
+// checkPatchFields checks the fields the patch of policy touches against the allowed fields
+// of the service type of the request. In the warn mode a warning is recorded in state for
+// each unknown field; in the strict mode the first one fails the evaluation.
+func (s *evaluationService) checkPatchFields(ctx context.Context, policyID string, state *evaluationState, patch map[string]any) error {
+	serviceType, _ := state.inputSpec["service_type"].(string)
+	unknown, ok := s.patchFields.unknownFields(serviceType, patch)
+	if !ok || len(unknown) == 0 {
+		return nil
+	}
+	if s.patchFieldMode == PatchFieldsStrict {
+		unknownPatchFieldsTotal.Add(float64(len(unknown)), "rejected")
+		return NewUnknownPatchFieldError(policyID, serviceType, unknown)
+	}
+	unknownPatchFieldsTotal.Add(float64(len(unknown)), "warned")
+	logging.FromContext(ctx).Warn("Policy patched fields not allowed for the service type",
+		"policy_id", policyID, "service_type", serviceType, "fields", unknown)
+	for _, field := range unknown {
+		state.warnings = append(state.warnings, EvaluationWarning{
+			Code:     WarningCodeUnknownPatchField,
+			PolicyID: policyID,
+			Field:    field,
+			Message:  fmt.Sprintf("Policy '%s' patched field '%s', which is not allowed for service type '%s'", policyID, field, serviceType),
+		})
+	}
+	return nil
+}
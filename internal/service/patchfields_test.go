@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Patch field allowlist", func() {
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		mockOPA   *mockEngine
+		allowlist *PatchFieldAllowlist
+		request   *EvaluationRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{policies: []model.Policy{{ID: "sizing", Enabled: true, PolicyType: "GLOBAL", Priority: 100}}}
+		mockOPA = &mockEngine{evaluations: map[string]*opa.EvaluationResult{}}
+		var err error
+		allowlist, err = NewPatchFieldAllowlist(map[string][]string{
+			"vm": {"instance_type", "resources", "metadata.labels"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		request = &EvaluationRequest{
+			ServiceInstance: map[string]any{"service_type": "vm", "instance_type": "small"},
+			RequestLabels:   map[string]string{"service_type": "vm"},
+		}
+	})
+
+	patch := func(patch map[string]any) {
+		mockOPA.evaluations["sizing"] = &opa.EvaluationResult{Defined: true, Result: map[string]any{"patch": patch}}
+	}
+
+	Context("in the warn mode", func() {
+		var svc EvaluationService
+
+		BeforeEach(func() {
+			svc = NewEvaluationService(mockStore, mockOPA, WithPatchFieldAllowlist(allowlist, PatchFieldsWarn))
+		})
+
+		It("applies the patch and warns about each unknown field", func() {
+			patch(map[string]any{
+				"instance_typ": "large",
+				"resources":    map[string]any{"cpu": 4},
+				"metadata":     map[string]any{"labels": map[string]any{"env": "dev"}, "owner": "bob"},
+			})
+
+			response, err := svc.EvaluateRequest(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Status).To(Equal(EvaluationStatusModified))
+			Expect(response.EvaluatedServiceInstance).To(HaveKeyWithValue("instance_typ", "large"))
+			Expect(response.Warnings).To(Equal([]EvaluationWarning{
+				{
+					Code:     WarningCodeUnknownPatchField,
+					PolicyID: "sizing",
+					Field:    "instance_typ",
+					Message:  "Policy 'sizing' patched field 'instance_typ', which is not allowed for service type 'vm'",
+				},
+				{
+					Code:     WarningCodeUnknownPatchField,
+					PolicyID: "sizing",
+					Field:    "metadata.owner",
+					Message:  "Policy 'sizing' patched field 'metadata.owner', which is not allowed for service type 'vm'",
+				},
+			}))
+		})
+
+		It("returns no warnings for patches of allowed fields", func() {
+			patch(map[string]any{"instance_type": "large", "resources": map[string]any{"memory": "8Gi"}})
+
+			response, err := svc.EvaluateRequest(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Warnings).To(BeEmpty())
+		})
+
+		It("does not check service types the allowlist does not list", func() {
+			patch(map[string]any{"instance_typ": "large"})
+			request.ServiceInstance["service_type"] = "storage"
+
+			response, err := svc.EvaluateRequest(ctx, request)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Warnings).To(BeEmpty())
+		})
+	})
+
+	Context("in the strict mode", func() {
+		It("returns a conflict naming the policy and the unknown fields", func() {
+			patch(map[string]any{"instance_typ": "large", "zone": "b"})
+			svc := NewEvaluationService(mockStore, mockOPA, WithPatchFieldAllowlist(allowlist, PatchFieldsStrict))
+
+			_, err := svc.EvaluateRequest(ctx, request)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
+			Expect(serviceErr.Message).To(Equal("Policy 'sizing' patched field 'instance_typ', which is not allowed for service type 'vm'"))
+			Expect(serviceErr.Detail).To(Equal("Fields not allowed for service type 'vm': instance_typ, zone"))
+		})
+	})
+
+	Describe("LoadPatchFieldAllowlist", func() {
+		It("reads an allowlist from a YAML file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "allowlist.yaml")
+			Expect(os.WriteFile(path, []byte("vm:\n  - instance_type\n  - resources.cpu\n"), 0o600)).To(Succeed())
+
+			loaded, err := LoadPatchFieldAllowlist(path)
+
+			Expect(err).NotTo(HaveOccurred())
+			unknown, ok := loaded.unknownFields("vm", map[string]any{"resources": map[string]any{"cpu": 2, "gpu": 1}})
+			Expect(ok).To(BeTrue())
+			Expect(unknown).To(Equal([]string{"resources.gpu"}))
+		})
+
+		It("rejects invalid field paths", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "allowlist.yaml")
+			Expect(os.WriteFile(path, []byte("vm:\n  - resources..cpu\n"), 0o600)).To(Succeed())
+
+			_, err := LoadPatchFieldAllowlist(path)
+
+			Expect(err).To(MatchError(ContainSubstring("invalid field path 'resources..cpu'")))
+		})
+	})
+
+	Describe("ParsePatchFieldMode", func() {
+		It("defaults to warn and rejects unknown modes", func() {
+			Expect(ParsePatchFieldMode("")).To(Equal(PatchFieldsWarn))
+			Expect(ParsePatchFieldMode("strict")).To(Equal(PatchFieldsStrict))
+			_, err := ParsePatchFieldMode("deny")
+			Expect(err).To(MatchError(ContainSubstring("must be one of: warn, strict")))
+		})
+	})
+})
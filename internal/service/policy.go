@@ -3,21 +3,40 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"reflect"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
 	"github.com/dcm-project/policy-manager/internal/logging"
 	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
 	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
 	"github.com/google/uuid"
 )
 
 const (
 	MinPriority = 1
 	MaxPriority = 1000
+
+	maxRejectionMessageLength = 1024
+
+	maxAnnotationLength = 1024
+	maxParametersSize   = 64 * 1024
+
+	maxDocumentationLineLength      = 256
+	maxDocumentationRationaleLength = 4096
+	maxRemediationURLLength         = 2048
 )
 
 // AEP-122 compliant ID format: 1-63 chars, start with lowercase letter,
@@ -31,25 +50,103 @@ type PolicyService interface {
 	GetPolicy(ctx context.Context, id string) (*v1alpha1.Policy, error)
 	ListPolicies(ctx context.Context, filter *string, orderBy *string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyList, error)
 	UpdatePolicy(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error)
+	ApplyPolicy(ctx context.Context, id string, policy v1alpha1.Policy, allowMissing bool) (*v1alpha1.Policy, bool, error)
+	CreateOrGetPolicy(ctx context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error)
 	DeletePolicy(ctx context.Context, id string) error
+	DryRunCreatePolicy(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error)
+	DryRunUpdatePolicy(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error)
+	DryRunDeletePolicy(ctx context.Context, id string) error
+	GetPolicyFacets(ctx context.Context) (*v1alpha1.PolicyFacets, error)
+	GetPolicyChecksum(ctx context.Context) (*v1alpha1.PolicyChecksum, error)
+	GetEvaluationOrder(ctx context.Context, labels []string) (*v1alpha1.EvaluationOrder, error)
+	GetPolicyCatalog(ctx context.Context) (*v1alpha1.PolicyCatalog, error)
+	TestPolicyMatch(ctx context.Context, id string, req v1alpha1.PolicyMatchTestRequest) (*v1alpha1.PolicyMatchTestResult, error)
+	SimulatePolicy(ctx context.Context, req v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error)
+	CheckPolicyConflicts(ctx context.Context, req v1alpha1.PolicyConflictCheckRequest) (*v1alpha1.PolicyConflictReport, error)
+	ListPolicyRevisions(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyRevisionList, error)
+	GetPolicyRevision(ctx context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error)
+	DiffPolicyRevisions(ctx context.Context, id string, from, to int64) (*v1alpha1.PolicyRevisionDiff, error)
+	RollbackPolicy(ctx context.Context, id string, revision int64) (*v1alpha1.Policy, error)
+	LockPolicy(ctx context.Context, id, owner string, ttlSeconds *int32) (*v1alpha1.PolicyLock, error)
+	UnlockPolicy(ctx context.Context, id, owner string) error
+	CreatePolicyTest(ctx context.Context, id string, test v1alpha1.PolicyTest, clientID *string) (*v1alpha1.PolicyTest, error)
+	ListPolicyTests(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyTestList, error)
+	GetPolicyTest(ctx context.Context, id, testID string) (*v1alpha1.PolicyTest, error)
+	DeletePolicyTest(ctx context.Context, id, testID string) error
+	RunPolicyTests(ctx context.Context, id string) (*v1alpha1.PolicyTestRun, error)
+	ImportBundle(ctx context.Context, archive io.Reader, opts BundleImportOptions) (*v1alpha1.BundleImportResult, error)
+	ImportPolicies(ctx context.Context, request v1alpha1.PolicyImportRequest, atomic bool) (*v1alpha1.PolicyImportResult, error)
+	ExportBundle(ctx context.Context, w io.Writer, opts BundleExportOptions, flush func() error) (int, error)
+	ConvertGatekeeper(ctx context.Context, resources io.Reader) (*v1alpha1.GatekeeperConversionResult, error)
+	EngineStatus() EngineStatus
+	RebuildEngine(ctx context.Context) (*v1alpha1.EngineRebuild, error)
+	GetEngineRebuild(ctx context.Context, id string) (*v1alpha1.EngineRebuild, error)
+	CheckReadiness(ctx context.Context) Readiness
 }
 
 // PolicyServiceImpl implements the PolicyService interface.
 type PolicyServiceImpl struct {
 	store  store.Store
 	engine opa.Engine
+	events *events.Bus
+	tokens *pagetoken.Codec
+	pages  PageSizeLimits
+	// compileMu serializes recompiling the engine from the store
+	compileMu sync.Mutex
+	// reconcileMu guards lastReconcile, which is read without waiting for a recompilation
+	reconcileMu   sync.Mutex
+	lastReconcile reconcileOutcome
+	// compiled is set once CompileAll compiled the stored policies into the engine
+	compiled atomic.Bool
+	// rebuilds are the last rebuilds of the engine requested through RebuildEngine
+	rebuilds engineRebuilds
+	// simulation configures the evaluations run by SimulatePolicy and RunPolicyTests
+	simulation []EvaluationOption
+	// defaults are the enabled state and priority strategy of policies created without them
+	defaults PolicyDefaults
 }
 
 var _ PolicyService = (*PolicyServiceImpl)(nil)
 
-// NewPolicyService creates a new PolicyService instance.
-func NewPolicyService(store store.Store, engine opa.Engine) *PolicyServiceImpl {
-	return &PolicyServiceImpl{
-		store:  store,
-		engine: engine,
+// PolicyServiceOption configures optional behavior of the policy service.
+type PolicyServiceOption func(*PolicyServiceImpl)
+
+// WithPolicyEvents publishes PolicyCreated, PolicyUpdated and PolicyDeleted on bus after each committed change.
+func WithPolicyEvents(bus *events.Bus) PolicyServiceOption {
+	return func(s *PolicyServiceImpl) {
+		s.events = bus
+	}
+}
+
+// WithPageTokens signs list page tokens with codec instead of a per-process random key.
+func WithPageTokens(codec *pagetoken.Codec) PolicyServiceOption {
+	return func(s *PolicyServiceImpl) {
+		s.tokens = codec
+	}
+}
+
+// WithPageSizeLimits sets the default and maximum page size of list requests.
+func WithPageSizeLimits(limits PageSizeLimits) PolicyServiceOption {
+	return func(s *PolicyServiceImpl) {
+		s.pages = limits
 	}
 }
 
+// NewPolicyService creates a new PolicyService instance.
+func NewPolicyService(store store.Store, engine opa.Engine, opts ...PolicyServiceOption) *PolicyServiceImpl {
+	s := &PolicyServiceImpl{
+		store:    store,
+		engine:   engine,
+		tokens:   pagetoken.NewEphemeral(pagetoken.DefaultTTL),
+		pages:    DefaultPageSizeLimits,
+		defaults: DefaultPolicyDefaults,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
 func validatePostInput(policy v1alpha1.Policy) error {
 	if policy.DisplayName == nil || strings.TrimSpace(*policy.DisplayName) == "" {
 		return NewInvalidArgumentError(
@@ -75,6 +172,18 @@ func validatePostInput(policy v1alpha1.Policy) error {
 	if err := validatePriority(policy.Priority); err != nil {
 		return err
 	}
+	if err := validateRejectionMessages(policy.RejectionMessages); err != nil {
+		return err
+	}
+	if err := validateAnnotations(policy.Annotations); err != nil {
+		return err
+	}
+	if err := validateParameters(policy.Parameters); err != nil {
+		return err
+	}
+	if err := validateDocumentation(policy.Documentation); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -108,66 +217,165 @@ func validatePriority(priority *int32) error {
 	return nil
 }
 
+func validateRejectionMessages(messages *map[string]string) error {
+	if messages == nil {
+		return nil
+	}
+	for code, template := range *messages {
+		if strings.TrimSpace(code) == "" || strings.TrimSpace(template) == "" || len(template) > maxRejectionMessageLength {
+			return NewInvalidArgumentError(
+				"Invalid rejection_messages",
+				fmt.Sprintf("rejection_messages must map non-empty codes to non-empty templates of at most %d bytes (code '%s')", maxRejectionMessageLength, code),
+			)
+		}
+	}
+	return nil
+}
+
+func validateAnnotations(annotations *map[string]string) error {
+	if annotations == nil {
+		return nil
+	}
+	for key, value := range *annotations {
+		if strings.TrimSpace(key) == "" || len(value) > maxAnnotationLength {
+			return NewInvalidArgumentError(
+				"Invalid annotations",
+				fmt.Sprintf("annotations must map non-empty keys to values of at most %d bytes (key '%s')", maxAnnotationLength, key),
+			)
+		}
+	}
+	return nil
+}
+
+func validateParameters(parameters *map[string]any) error {
+	if parameters == nil {
+		return nil
+	}
+	encoded, err := json.Marshal(*parameters)
+	if err != nil || len(encoded) > maxParametersSize {
+		return NewInvalidArgumentError(
+			"Invalid parameters",
+			fmt.Sprintf("parameters must be a JSON object of at most %d bytes", maxParametersSize),
+		)
+	}
+	return nil
+}
+
+func validateDocumentation(documentation *v1alpha1.PolicyDocumentation) error {
+	if documentation == nil {
+		return nil
+	}
+	lengths := []struct {
+		field string
+		value *string
+		max   int
+	}{
+		{"summary", documentation.Summary, maxDocumentationLineLength},
+		{"rationale", documentation.Rationale, maxDocumentationRationaleLength},
+		{"remediation_url", documentation.RemediationUrl, maxRemediationURLLength},
+		{"owner_contact", documentation.OwnerContact, maxDocumentationLineLength},
+	}
+	for _, l := range lengths {
+		if l.value != nil && len(*l.value) > l.max {
+			return NewInvalidArgumentError(
+				"Invalid documentation",
+				fmt.Sprintf("documentation.%s must be at most %d bytes", l.field, l.max),
+			)
+		}
+	}
+	if documentation.RemediationUrl != nil && *documentation.RemediationUrl != "" {
+		u, err := url.Parse(*documentation.RemediationUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return NewInvalidArgumentError(
+				"Invalid documentation",
+				fmt.Sprintf("documentation.remediation_url '%s' must be an absolute http or https URL", *documentation.RemediationUrl),
+			)
+		}
+	}
+	return nil
+}
+
 // CompileAll loads all policies from the store and compiles them into the engine.
 func (s *PolicyServiceImpl) CompileAll(ctx context.Context) error {
-	return s.recompileEngine(ctx)
+	if err := s.recompileEngine(ctx); err != nil {
+		return err
+	}
+	s.compiled.Store(true)
+	return nil
 }
 
-// recompileEngine loads all policies from the store and recompiles the engine.
+// recompileEngine loads all policies from the store and recompiles the engine. Loading and
+// compiling are serialized with reconciliation, so an older listing never replaces a newer one.
 func (s *PolicyServiceImpl) recompileEngine(ctx context.Context) error {
+	s.compileMu.Lock()
+	defer s.compileMu.Unlock()
+
 	allPolicies, err := s.store.Policy().ListAll(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to list policies for recompilation: %w", err)
 	}
+	return s.engine.Compile(ctx, policyModules(allPolicies))
+}
 
-	modules := make([]opa.PolicyModule, len(allPolicies))
-	for i, p := range allPolicies {
+// policyModules returns the Rego modules of policies
+func policyModules(policies model.PolicyList) []opa.PolicyModule {
+	modules := make([]opa.PolicyModule, len(policies))
+	for i, p := range policies {
 		modules[i] = opa.PolicyModule{
 			ID:       p.ID,
 			RegoCode: p.RegoCode,
 		}
 	}
+	return modules
+}
 
-	return s.engine.Compile(ctx, modules)
+// checkCompile compiles the stored policies with the Rego of policy id replaced by regoCode,
+// together with the pending policies created with it, without changing the engine, so that
+// Rego that parses but would fail recompilation is rejected before it is stored.
+func (s *PolicyServiceImpl) checkCompile(ctx context.Context, id, regoCode, operation string, pending model.PolicyList) error {
+	allPolicies, err := s.store.Policy().ListAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list policies for compile check", "policy_id", id, "error", err)
+		return NewInternalError(fmt.Sprintf("Failed to %s policy", operation), err.Error(), err)
+	}
+
+	modules := []opa.PolicyModule{{ID: id, RegoCode: regoCode}}
+	for _, p := range append(allPolicies, pending...) {
+		if p.ID != id {
+			modules = append(modules, opa.PolicyModule{ID: p.ID, RegoCode: p.RegoCode})
+		}
+	}
+
+	if err := s.engine.CheckCompile(ctx, modules); err != nil {
+		return handleEngineError(err, operation)
+	}
+	return nil
 }
 
 // CreatePolicy creates a new policy resource.
 // Required fields (display_name, policy_type, rego_code) are enforced here since the schema has no required.
 func (s *PolicyServiceImpl) CreatePolicy(ctx context.Context, policy v1alpha1.Policy, clientID *string) (*v1alpha1.Policy, error) {
-	if err := validatePostInput(policy); err != nil {
-		return nil, err
-	}
-
-	policyID, err := getPolicyID(clientID)
+	dbPolicy, err := s.prepareCreate(ctx, policy, clientID, nil)
 	if err != nil {
 		return nil, err
 	}
-
+	policyID := dbPolicy.ID
 	log := logging.FromContext(ctx)
-	log.Debug("Creating policy", "policy_id", *policyID)
-
-	// Validate Rego via engine
-	if err := s.engine.ValidateRego(ctx, *policy.RegoCode); err != nil {
-		return nil, handleEngineError(err, "create")
-	}
-
-	// Convert API model to DB model (includes RegoCode)
-	dbPolicy := APIToDBModel(policy, *policyID)
 
 	// Create policy in store (duplicate ID fails here)
 	created, err := s.store.Policy().Create(ctx, dbPolicy)
 	if err != nil {
-		log.Error("Failed to create policy in store", "policy_id", *policyID, "error", err)
+		log.Error("Failed to create policy in store", "policy_id", policyID, "error", err)
 		return nil, processPolicyStoreError(err, dbPolicy, "create")
 	}
 
 	// Recompile the engine with the new policy
 	if err := s.recompileEngine(ctx); err != nil {
-		log.Error("Failed to recompile engine after create, rolling back DB", "policy_id", *policyID, "error", err)
-		// Rollback: Delete from DB since recompilation failed
-		if delErr := s.store.Policy().Delete(ctx, *policyID); delErr != nil {
+		log.Error("Failed to recompile engine after create, rolling back DB", "policy_id", policyID, "error", err)
+		// Rollback: Discard from DB since recompilation failed
+		if delErr := s.store.Policy().Discard(ctx, policyID); delErr != nil {
 			log.Error("Failed to rollback DB policy after compile failure",
-				"policy_id", *policyID,
+				"policy_id", policyID,
 				"db_error", delErr,
 				"compile_error", err)
 		}
@@ -176,11 +384,94 @@ func (s *PolicyServiceImpl) CreatePolicy(ctx context.Context, policy v1alpha1.Po
 
 	// Convert back to API model
 	apiPolicy := DBToAPIModel(created)
+	s.events.Publish(ctx, events.PolicyCreated{Policy: apiPolicy})
+	apiPolicy.Warnings = regoWarnings(ctx, created.ID, created.RegoCode)
 
-	log.Debug("Policy created successfully", "policy_id", *policyID)
+	log.Debug("Policy created successfully", "policy_id", policyID)
 	return &apiPolicy, nil
 }
 
+// prepareCreate validates policy and its Rego for creation, alongside the pending policies
+// created with it, and returns the policy to store
+func (s *PolicyServiceImpl) prepareCreate(ctx context.Context, policy v1alpha1.Policy, clientID *string, pending model.PolicyList) (model.Policy, error) {
+	if err := validatePostInput(policy); err != nil {
+		return model.Policy{}, err
+	}
+
+	policyID, err := getPolicyID(clientID)
+	if err != nil {
+		return model.Policy{}, err
+	}
+
+	logging.FromContext(ctx).Debug("Creating policy", "policy_id", *policyID)
+
+	// Validate Rego via engine
+	if err := s.engine.ValidateRego(ctx, *policy.RegoCode); err != nil {
+		return model.Policy{}, handleEngineError(err, "create")
+	}
+	if err := s.checkCompile(ctx, *policyID, *policy.RegoCode, "create", pending); err != nil {
+		return model.Policy{}, err
+	}
+
+	policy, err = s.withCreateDefaults(ctx, policy, *policyID, pending)
+	if err != nil {
+		return model.Policy{}, err
+	}
+
+	// Convert API model to DB model (includes RegoCode)
+	dbPolicy := APIToDBModel(policy, *policyID)
+	dbPolicy.CreatedBy = PrincipalFromContext(ctx)
+	dbPolicy.UpdatedBy = dbPolicy.CreatedBy
+	return dbPolicy, nil
+}
+
+// CreateOrGetPolicy creates a policy with the given client ID like CreatePolicy. When a policy
+// with that ID already exists and its content is identical to policy (after applying the
+// create defaults), the existing policy is returned instead of an AlreadyExists error.
+// The returned bool is true when the policy was created.
+func (s *PolicyServiceImpl) CreateOrGetPolicy(ctx context.Context, policy v1alpha1.Policy, clientID string) (*v1alpha1.Policy, bool, error) {
+	created, err := s.CreatePolicy(ctx, policy, &clientID)
+	if err == nil {
+		return created, true, nil
+	}
+	var serviceErr *ServiceError
+	if !errors.As(err, &serviceErr) || serviceErr.Type != ErrorTypeAlreadyExists {
+		return nil, false, err
+	}
+
+	// Display name and priority conflicts also report AlreadyExists; only an ID conflict finds a policy here
+	existingDB, getErr := s.store.Policy().Get(ctx, clientID)
+	if getErr != nil {
+		return nil, false, err
+	}
+	policy = s.defaults.fill(policy, s.defaults.keptPriority(existingDB.Priority))
+	if !samePolicyContent(APIToDBModel(policy, clientID), *existingDB) {
+		return nil, false, NewAlreadyExistsError(
+			"Policy already exists",
+			fmt.Sprintf("A policy with ID '%s' already exists with different content", clientID),
+		)
+	}
+
+	logging.FromContext(ctx).Debug("Returning identical existing policy", "policy_id", clientID)
+	existing := DBToAPIModel(existingDB)
+	return &existing, false, nil
+}
+
+// samePolicyContent reports whether two policies have the same user-settable fields
+func samePolicyContent(a, b model.Policy) bool {
+	return a.DisplayName == b.DisplayName &&
+		a.Description == b.Description &&
+		a.PolicyType == b.PolicyType &&
+		a.Enabled == b.Enabled &&
+		a.Priority == b.Priority &&
+		a.RegoCode == b.RegoCode &&
+		maps.Equal(a.LabelSelector, b.LabelSelector) &&
+		maps.Equal(a.RejectionMessages, b.RejectionMessages) &&
+		maps.Equal(a.Annotations, b.Annotations) &&
+		(len(a.Parameters) == 0 && len(b.Parameters) == 0 || reflect.DeepEqual(a.Parameters, b.Parameters)) &&
+		a.Documentation == b.Documentation
+}
+
 // GetPolicy retrieves a policy by ID.
 func (s *PolicyServiceImpl) GetPolicy(ctx context.Context, id string) (*v1alpha1.Policy, error) {
 	log := logging.FromContext(ctx)
@@ -196,20 +487,21 @@ func (s *PolicyServiceImpl) GetPolicy(ctx context.Context, id string) (*v1alpha1
 		return nil, NewInternalError("Failed to get policy", err.Error(), err)
 	}
 
-	// Convert to API model (includes RegoCode from DB)
-	apiPolicy := DBToAPIModel(dbPolicy)
+	// Convert to API model (includes RegoCode from DB) with the lock held on it, if any
+	apiPolicies := []v1alpha1.Policy{DBToAPIModel(dbPolicy)}
+	s.attachPolicyLocks(ctx, apiPolicies)
 
-	return &apiPolicy, nil
+	return &apiPolicies[0], nil
 }
 
-func getListOptions(filter *string, orderBy *string, pageToken *string, pageSize *int32) (*store.PolicyListOptions, error) {
+func (s *PolicyServiceImpl) getListOptions(filter *string, orderBy *string, pageToken *string, pageSize *int32) (*store.PolicyListOptions, string, error) {
 	// Parse filter expression
 	var policyFilter *store.PolicyFilter
 	var err error
 	if filter != nil && *filter != "" {
 		policyFilter, err = parseFilter(*filter)
 		if err != nil {
-			return nil, err // Already a ServiceError
+			return nil, "", err // Already a ServiceError
 		}
 	}
 
@@ -220,34 +512,28 @@ func getListOptions(filter *string, orderBy *string, pageToken *string, pageSize
 	}
 	orderByStr, err = parseOrderBy(orderByStr)
 	if err != nil {
-		return nil, err // Already a ServiceError
+		return nil, "", err // Already a ServiceError
 	}
 
-	// Validate and set page size (default: 50, max: 1000)
-	pageSizeInt := 50
-	if pageSize != nil {
-		if *pageSize < 1 {
-			return nil, NewInvalidArgumentError(
-				"Invalid page size",
-				"Page size must be at least 1",
-			)
-		}
-		if *pageSize > 1000 {
-			return nil, NewInvalidArgumentError(
-				"Invalid page size",
-				"Page size must not exceed 1000",
-			)
-		}
-		pageSizeInt = int(*pageSize)
+	pageSizeInt, err := s.pages.parsePageSize(pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Tokens are bound to the filter and ordering so a listing cannot continue with different ones
+	scope := pagetoken.Scope("policies", append([]string{orderByStr}, filterScope(policyFilter)...)...)
+	offset, err := decodePageToken(s.tokens, pageToken, scope)
+	if err != nil {
+		return nil, "", err
 	}
 
 	// Build list options
 	return &store.PolicyListOptions{
-		Filter:    policyFilter,
-		OrderBy:   orderByStr,
-		PageToken: pageToken,
-		PageSize:  pageSizeInt,
-	}, nil
+		Filter:   policyFilter,
+		OrderBy:  orderByStr,
+		Offset:   offset,
+		PageSize: pageSizeInt,
+	}, scope, nil
 }
 
 // ListPolicies lists policies with optional filtering, ordering, and pagination.
@@ -255,7 +541,7 @@ func (s *PolicyServiceImpl) ListPolicies(ctx context.Context, filter *string, or
 	log := logging.FromContext(ctx)
 	log.Debug("Listing policies")
 
-	opts, err := getListOptions(filter, orderBy, pageToken, pageSize)
+	opts, scope, err := s.getListOptions(filter, orderBy, pageToken, pageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -272,22 +558,25 @@ func (s *PolicyServiceImpl) ListPolicies(ctx context.Context, filter *string, or
 	for i, dbPolicy := range result.Policies {
 		apiPolicies[i] = DBToAPIModel(&dbPolicy)
 	}
+	s.attachPolicyLocks(ctx, apiPolicies)
 
 	// Build response
 	response := &v1alpha1.PolicyList{
 		Policies: apiPolicies,
+		PageSize: int32(opts.PageSize),
 	}
 
-	if result.NextPageToken != "" {
-		response.NextPageToken = &result.NextPageToken
+	if result.NextOffset > 0 {
+		nextPageToken := s.tokens.Encode(result.NextOffset, scope)
+		response.NextPageToken = &nextPageToken
 	}
 
-	log.Debug("Policies listed", "count", len(apiPolicies), "has_next_page", result.NextPageToken != "")
+	log.Debug("Policies listed", "count", len(apiPolicies), "has_next_page", result.NextOffset > 0)
 	return response, nil
 }
 
 // mergePolicyOntoPolicy merges a PATCH body (Policy) onto an existing policy per RFC 7396.
-// Only non-nil mutable fields in patch are applied. Read-only and immutable fields (path, id, policy_type, create_time, update_time) are ignored.
+// Only non-nil mutable fields in patch are applied. Read-only and immutable fields (path, id, policy_type, create_time, update_time, created_by, updated_by) are ignored.
 func mergePolicyOntoPolicy(patch *v1alpha1.Policy, existing v1alpha1.Policy) v1alpha1.Policy {
 	merged := existing
 	if patch == nil {
@@ -311,7 +600,19 @@ func mergePolicyOntoPolicy(patch *v1alpha1.Policy, existing v1alpha1.Policy) v1a
 	if patch.RegoCode != nil {
 		merged.RegoCode = patch.RegoCode
 	}
-	// policy_type, path, id, create_time, update_time are immutable/read-only; do not merge
+	if patch.RejectionMessages != nil {
+		merged.RejectionMessages = patch.RejectionMessages
+	}
+	if patch.Annotations != nil {
+		merged.Annotations = patch.Annotations
+	}
+	if patch.Parameters != nil {
+		merged.Parameters = patch.Parameters
+	}
+	if patch.Documentation != nil {
+		merged.Documentation = patch.Documentation
+	}
+	// policy_type, path, id, create_time, update_time, created_by, updated_by are immutable/read-only; do not merge
 	return merged
 }
 
@@ -328,6 +629,18 @@ func validatePatchInput(patch *v1alpha1.Policy) error {
 	if err := validatePriority(patch.Priority); err != nil {
 		return err
 	}
+	if err := validateRejectionMessages(patch.RejectionMessages); err != nil {
+		return err
+	}
+	if err := validateAnnotations(patch.Annotations); err != nil {
+		return err
+	}
+	if err := validateParameters(patch.Parameters); err != nil {
+		return err
+	}
+	if err := validateDocumentation(patch.Documentation); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -378,41 +691,71 @@ func validatePatchImmutableFields(patch *v1alpha1.Policy, existing v1alpha1.Poli
 			)
 		}
 	}
+	if patch.CreatedBy != nil {
+		if existing.CreatedBy == nil || *patch.CreatedBy != *existing.CreatedBy {
+			return NewInvalidArgumentError(
+				"created_by cannot be updated",
+				"The created_by field is read-only and cannot be changed",
+			)
+		}
+	}
+	if patch.UpdatedBy != nil {
+		if existing.UpdatedBy == nil || *patch.UpdatedBy != *existing.UpdatedBy {
+			return NewInvalidArgumentError(
+				"updated_by cannot be updated",
+				"The updated_by field is read-only and cannot be changed",
+			)
+		}
+	}
 	return nil
 }
 
 // UpdatePolicy updates an existing policy using partial merge (PATCH).
 func (s *PolicyServiceImpl) UpdatePolicy(ctx context.Context, id string, patch *v1alpha1.Policy) (*v1alpha1.Policy, error) {
+	existingDB, merged, err := s.prepareUpdate(ctx, id, patch)
+	if err != nil {
+		return nil, err
+	}
+	regoChanged := patch != nil && patch.RegoCode != nil
+	return s.commitUpdate(ctx, existingDB, merged, regoChanged)
+}
+
+// prepareUpdate validates patch against the stored policy id and returns that policy with
+// patch merged onto it
+func (s *PolicyServiceImpl) prepareUpdate(ctx context.Context, id string, patch *v1alpha1.Policy) (*model.Policy, v1alpha1.Policy, error) {
 	log := logging.FromContext(ctx)
 	log.Debug("Updating policy", "policy_id", id)
 
 	if err := validatePatchInput(patch); err != nil {
-		return nil, err
+		return nil, v1alpha1.Policy{}, err
 	}
 
 	existingDB, err := s.store.Policy().Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, store.ErrPolicyNotFound) {
-			return nil, NewPolicyNotFoundError(id)
+			return nil, v1alpha1.Policy{}, NewPolicyNotFoundError(id)
 		}
 		log.Error("Failed to get existing policy for update", "policy_id", id, "error", err)
-		return nil, NewInternalError("Failed to get existing policy", err.Error(), err)
+		return nil, v1alpha1.Policy{}, NewInternalError("Failed to get existing policy", err.Error(), err)
 	}
 	existing := DBToAPIModel(existingDB)
 	if err := validatePatchImmutableFields(patch, existing); err != nil {
-		return nil, err
+		return nil, v1alpha1.Policy{}, err
 	}
-	merged := mergePolicyOntoPolicy(patch, existing)
+	return existingDB, mergePolicyOntoPolicy(patch, existing), nil
+}
+
+// commitUpdate validates changed Rego, stores merged over existingDB and recompiles the
+// engine when the Rego changed, restoring existingDB if compilation fails.
+func (s *PolicyServiceImpl) commitUpdate(ctx context.Context, existingDB *model.Policy, merged v1alpha1.Policy, regoChanged bool) (*v1alpha1.Policy, error) {
+	log := logging.FromContext(ctx)
+	id := existingDB.ID
 
 	// If RegoCode is being updated, validate it
-	regoChanged := patch != nil && patch.RegoCode != nil
 	if regoChanged {
-		// Validate Rego via engine
-		if err := s.engine.ValidateRego(ctx, *patch.RegoCode); err != nil {
-			return nil, handleEngineError(err, "update")
+		if err := s.validateUpdatedRego(ctx, id, *merged.RegoCode); err != nil {
+			return nil, err
 		}
-
-		log.Debug("Rego code validated", "policy_id", id)
 	}
 
 	// Save the existing DB state for potential rollback
@@ -420,6 +763,7 @@ func (s *PolicyServiceImpl) UpdatePolicy(ctx context.Context, id string, patch *
 
 	// Convert API model to DB model and update store
 	dbPolicy := APIToDBModel(merged, id)
+	dbPolicy.UpdatedBy = PrincipalFromContext(ctx)
 	updated, err := s.store.Policy().Update(ctx, dbPolicy)
 	if err != nil {
 		log.Error("Failed to update policy in store", "policy_id", id, "error", err)
@@ -430,8 +774,8 @@ func (s *PolicyServiceImpl) UpdatePolicy(ctx context.Context, id string, patch *
 	if regoChanged {
 		if err := s.recompileEngine(ctx); err != nil {
 			log.Error("Failed to recompile engine after update, rolling back DB", "policy_id", id, "error", err)
-			// Rollback: restore previous DB state
-			if _, rollbackErr := s.store.Policy().Update(ctx, previousDB); rollbackErr != nil {
+			// Rollback: restore previous DB state without the revision of the failed update
+			if rollbackErr := s.store.Policy().Revert(ctx, previousDB); rollbackErr != nil {
 				log.Error("Failed to rollback DB policy after compile failure",
 					"policy_id", id,
 					"db_error", rollbackErr,
@@ -443,11 +787,28 @@ func (s *PolicyServiceImpl) UpdatePolicy(ctx context.Context, id string, patch *
 
 	// Convert back to API model
 	apiPolicy := DBToAPIModel(updated)
+	s.events.Publish(ctx, events.PolicyUpdated{Previous: DBToAPIModel(&previousDB), Policy: apiPolicy})
+	apiPolicy.Warnings = regoWarnings(ctx, id, updated.RegoCode)
 
 	log.Debug("Policy updated successfully", "policy_id", id)
 	return &apiPolicy, nil
 }
 
+// validateUpdatedRego validates the new Rego of policy id and checks that the stored policies
+// still compile with it
+func (s *PolicyServiceImpl) validateUpdatedRego(ctx context.Context, id, regoCode string) error {
+	// Validate Rego via engine
+	if err := s.engine.ValidateRego(ctx, regoCode); err != nil {
+		return handleEngineError(err, "update")
+	}
+	if err := s.checkCompile(ctx, id, regoCode, "update", nil); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Debug("Rego code validated", "policy_id", id)
+	return nil
+}
+
 // DeletePolicy deletes a policy by ID.
 func (s *PolicyServiceImpl) DeletePolicy(ctx context.Context, id string) error {
 	log := logging.FromContext(ctx)
@@ -467,6 +828,7 @@ func (s *PolicyServiceImpl) DeletePolicy(ctx context.Context, id string) error {
 	if err := s.recompileEngine(ctx); err != nil {
 		log.Warn("Failed to recompile engine after delete", "policy_id", id, "error", err)
 	}
+	s.events.Publish(ctx, events.PolicyDeleted{PolicyID: id})
 
 	log.Debug("Policy deleted successfully", "policy_id", id)
 	return nil
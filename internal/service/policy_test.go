@@ -2,9 +2,15 @@ package service_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
 	"github.com/dcm-project/policy-manager/internal/opa"
 	"github.com/dcm-project/policy-manager/internal/service"
 	"github.com/dcm-project/policy-manager/internal/store"
@@ -18,8 +24,24 @@ import (
 
 func strPtr(s string) *string { return &s }
 
+// compileFailingEngine fails every Compile of the engine once fail is set, as a policy set
+// that validated but cannot be loaded would
+type compileFailingEngine struct {
+	opa.Engine
+	fail bool
+}
+
+func (e *compileFailingEngine) Compile(ctx context.Context, modules []opa.PolicyModule) error {
+	if e.fail {
+		return errors.New("compilation failed")
+	}
+	return e.Engine.Compile(ctx, modules)
+}
+
 func policyTypePtr(t v1alpha1.PolicyPolicyType) *v1alpha1.PolicyPolicyType { return &t }
 
+func outcomePtr(o v1alpha1.DraftPolicyOutcome) *v1alpha1.DraftPolicyOutcome { return &o }
+
 var _ = Describe("PolicyService", func() {
 	var (
 		db            *gorm.DB
@@ -35,7 +57,7 @@ var _ = Describe("PolicyService", func() {
 			Logger: logger.Default.LogMode(logger.Silent),
 		})
 		Expect(err).NotTo(HaveOccurred())
-		Expect(db.AutoMigrate(&model.Policy{})).To(Succeed())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
 
 		dataStore = store.NewStore(db)
 
@@ -78,6 +100,30 @@ var _ = Describe("PolicyService", func() {
 			Expect(*created.Priority).To(Equal(int32(500))) // Default value
 		})
 
+		It("should return lint warnings without failing the create", func() {
+			regoCode := "package sloppy\nimport data.lib.helpers\nmain := {\"rejected\": false}"
+
+			created, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Sloppy Policy"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    &regoCode,
+			}, strPtr("sloppy"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.Warnings).NotTo(BeNil())
+			Expect(*created.Warnings).To(HaveLen(2))
+			Expect((*created.Warnings)[0].Code).To(Equal(v1alpha1.WarningNotFormatted))
+			Expect((*created.Warnings)[1].Code).To(Equal(v1alpha1.WarningUnusedImport))
+			Expect(*(*created.Warnings)[1].Line).To(Equal(int32(2)))
+
+			updated, err := policyService.UpdatePolicy(ctx, "sloppy", &v1alpha1.Policy{
+				RegoCode: strPtr("package sloppy\n\nmain := {\"rejected\": false}"),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Warnings).To(BeNil())
+		})
+
 		It("should create policy with server-generated UUID", func() {
 			regoCode := "package test\ndefault allow = true"
 
@@ -149,6 +195,78 @@ var _ = Describe("PolicyService", func() {
 			Expect(serviceErr.Message).To(ContainSubstring("priority must be between 1 and 1000"))
 		})
 
+		It("should reject empty rejection message templates", func() {
+			policy := v1alpha1.Policy{
+				DisplayName:       strPtr("Test Policy"),
+				PolicyType:        policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:          strPtr("package test"),
+				RejectionMessages: &map[string]string{"region_not_allowed": ""},
+			}
+
+			_, err := policyService.CreatePolicy(ctx, policy, nil)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			Expect(serviceErr.Detail).To(ContainSubstring("code 'region_not_allowed'"))
+		})
+
+		It("should reject documentation with a remediation URL that is not http or https", func() {
+			for _, remediationURL := range []string{"docs/region", "ftp://docs.example.com/region", "https://"} {
+				policy := v1alpha1.Policy{
+					DisplayName:   strPtr("Test Policy"),
+					PolicyType:    policyTypePtr(v1alpha1.GLOBAL),
+					RegoCode:      strPtr("package test"),
+					Documentation: &v1alpha1.PolicyDocumentation{RemediationUrl: strPtr(remediationURL)},
+				}
+
+				_, err := policyService.CreatePolicy(ctx, policy, nil)
+
+				Expect(err).To(HaveOccurred(), "remediation_url %s", remediationURL)
+				serviceErr, ok := err.(*service.ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+				Expect(serviceErr.Detail).To(ContainSubstring("documentation.remediation_url"))
+			}
+		})
+
+		It("should reject empty annotation keys and oversized parameters", func() {
+			for _, policy := range []v1alpha1.Policy{
+				{Annotations: &map[string]string{" ": "platform"}},
+				{Parameters: &map[string]any{"allowed": strings.Repeat("x", 64*1024)}},
+			} {
+				policy.DisplayName = strPtr("Test Policy")
+				policy.PolicyType = policyTypePtr(v1alpha1.GLOBAL)
+				policy.RegoCode = strPtr("package test")
+
+				_, err := policyService.CreatePolicy(ctx, policy, nil)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*service.ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			}
+		})
+
+		It("should store annotations and parameters", func() {
+			policy := v1alpha1.Policy{
+				DisplayName: strPtr("Test Policy"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+				Annotations: &map[string]string{"owner": "platform"},
+				Parameters:  &map[string]any{"allowed_regions": []any{"eu-west-1"}},
+			}
+
+			created, err := policyService.CreatePolicy(ctx, policy, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			fetched, err := policyService.GetPolicy(ctx, *created.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*fetched.Annotations).To(Equal(map[string]string{"owner": "platform"}))
+			Expect(*fetched.Parameters).To(Equal(map[string]any{"allowed_regions": []any{"eu-west-1"}}))
+		})
+
 		It("should accept priority at minimum (1)", func() {
 			priority := int32(1)
 			policy := v1alpha1.Policy{
@@ -400,6 +518,25 @@ var _ = Describe("PolicyService", func() {
 			Expect(ok).To(BeTrue())
 			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
 			Expect(serviceErr.Message).To(ContainSubstring("Invalid Rego code"))
+			Expect(serviceErr.Detail).To(HavePrefix("The Rego code contains syntax errors: line 2, column 10: "))
+		})
+
+		It("should reject Rego that parses but does not compile, without storing it", func() {
+			clientID := "unsafe-rego"
+			policy := v1alpha1.Policy{
+				DisplayName: strPtr("Unsafe"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test\n\nallow if { x }"),
+			}
+
+			_, err := policyService.CreatePolicy(ctx, policy, &clientID)
+
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			Expect(serviceErr.Detail).To(Equal("The Rego code does not compile: policy 'unsafe-rego', line 3, column 12: var x is unsafe"))
+			_, err = policyService.GetPolicy(ctx, clientID)
+			Expect(err).To(HaveOccurred())
 		})
 
 		It("should store rego_code in DB and return it on Get", func() {
@@ -420,6 +557,37 @@ var _ = Describe("PolicyService", func() {
 			Expect(retrieved.RegoCode).NotTo(BeNil())
 			Expect(*retrieved.RegoCode).To(Equal(regoCode))
 		})
+
+		It("should store documentation and return it on Get", func() {
+			clientID := "documented"
+			documentation := v1alpha1.PolicyDocumentation{
+				Summary:        strPtr("Restricts production workloads to approved regions"),
+				RemediationUrl: strPtr("https://docs.example.com/policies/region"),
+			}
+			policy := v1alpha1.Policy{
+				DisplayName:   strPtr("Documented"),
+				PolicyType:    policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:      strPtr("package test"),
+				Documentation: &documentation,
+			}
+
+			_, err := policyService.CreatePolicy(ctx, policy, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+
+			retrieved, err := policyService.GetPolicy(ctx, clientID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retrieved.Documentation).To(Equal(&documentation))
+
+			priority := int32(600)
+			undocumented, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Undocumented"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+				Priority:    &priority,
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(undocumented.Documentation).To(BeNil())
+		})
 	})
 
 	Describe("GetPolicy", func() {
@@ -528,6 +696,14 @@ var _ = Describe("PolicyService", func() {
 			}
 		})
 
+		It("should accept a policy type this version does not know", func() {
+			filter := "policy_type='TEAM'"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(BeEmpty())
+		})
+
 		It("should filter by enabled=true", func() {
 			filter := "enabled=true"
 			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
@@ -571,6 +747,49 @@ var _ = Describe("PolicyService", func() {
 			Expect(*result.Policies[3].Id).To(Equal("policy-1"))
 		})
 
+		It("should order by update_time desc", func() {
+			description := "changed"
+			_, err := policyService.UpdatePolicy(ctx, "policy-2", &v1alpha1.Policy{Description: &description})
+			Expect(err).ToNot(HaveOccurred())
+
+			orderBy := "update_time desc"
+			result, err := policyService.ListPolicies(ctx, nil, &orderBy, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Policies[0].Id).To(Equal("policy-2"))
+		})
+
+		It("should order by enabled, then by id", func() {
+			orderBy := "enabled desc"
+			result, err := policyService.ListPolicies(ctx, nil, &orderBy, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			ids := []string{}
+			for _, p := range result.Policies {
+				ids = append(ids, *p.Id)
+			}
+			Expect(ids).To(Equal([]string{"policy-1", "policy-2", "policy-3", "policy-4"}))
+		})
+
+		It("should order by policy_type and priority", func() {
+			orderBy := "policy_type desc, priority desc"
+			result, err := policyService.ListPolicies(ctx, nil, &orderBy, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*result.Policies[0].Id).To(Equal("policy-4"))
+			Expect(*result.Policies[3].Id).To(Equal("policy-1"))
+		})
+
+		It("should reject a field ordered by twice", func() {
+			orderBy := "priority asc, priority desc"
+			_, err := policyService.ListPolicies(ctx, nil, &orderBy, nil, nil)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
 		It("should support pagination", func() {
 			pageSize := int32(2)
 			result, err := policyService.ListPolicies(ctx, nil, nil, nil, &pageSize)
@@ -587,19 +806,64 @@ var _ = Describe("PolicyService", func() {
 			Expect(result2.NextPageToken).To(BeNil()) // No more pages
 		})
 
-		It("should validate page size minimum", func() {
-			pageSize := int32(0)
-			_, err := policyService.ListPolicies(ctx, nil, nil, nil, &pageSize)
+		It("should reject a forged page token", func() {
+			pageSize := int32(2)
+			forged := base64.StdEncoding.EncodeToString([]byte("2"))
+			_, err := policyService.ListPolicies(ctx, nil, nil, &forged, &pageSize)
 
 			Expect(err).To(HaveOccurred())
 			serviceErr, ok := err.(*service.ServiceError)
 			Expect(ok).To(BeTrue())
 			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
-			Expect(serviceErr.Message).To(ContainSubstring("Invalid page size"))
+			Expect(serviceErr.Message).To(ContainSubstring("Invalid page token"))
 		})
 
-		It("should validate page size maximum", func() {
-			pageSize := int32(1001)
+		It("should reject a page token used with a different order", func() {
+			pageSize := int32(2)
+			result, err := policyService.ListPolicies(ctx, nil, nil, nil, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.NextPageToken).NotTo(BeNil())
+
+			orderBy := "priority desc"
+			_, err = policyService.ListPolicies(ctx, nil, &orderBy, result.NextPageToken, &pageSize)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			Expect(serviceErr.Detail).To(ContainSubstring("does not match"))
+		})
+
+		It("should accept a page token with an equivalent filter", func() {
+			pageSize := int32(1)
+			filter := "enabled=true"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.NextPageToken).NotTo(BeNil())
+
+			respaced := "enabled = true"
+			_, err = policyService.ListPolicies(ctx, &respaced, nil, result.NextPageToken, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject a page token used with a filter on a principal that reads like two", func() {
+			pageSize := int32(1)
+			filter := "created_by='' AND updated_by=''"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.NextPageToken).NotTo(BeNil())
+
+			lookalike := "created_by=',updated_by='"
+			_, err = policyService.ListPolicies(ctx, &lookalike, nil, result.NextPageToken, &pageSize)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Detail).To(ContainSubstring("does not match"))
+		})
+
+		It("should validate page size minimum", func() {
+			pageSize := int32(0)
 			_, err := policyService.ListPolicies(ctx, nil, nil, nil, &pageSize)
 
 			Expect(err).To(HaveOccurred())
@@ -609,6 +873,110 @@ var _ = Describe("PolicyService", func() {
 			Expect(serviceErr.Message).To(ContainSubstring("Invalid page size"))
 		})
 
+		It("should lower page sizes above the maximum", func() {
+			pageSize := int32(1001)
+			result, err := policyService.ListPolicies(ctx, nil, nil, nil, &pageSize)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(4))
+			Expect(result.PageSize).To(Equal(int32(1000)))
+		})
+
+		It("should return the default page size", func() {
+			result, err := policyService.ListPolicies(ctx, nil, nil, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PageSize).To(Equal(int32(50)))
+		})
+
+		It("should apply configured page size limits", func() {
+			limited := service.NewPolicyService(dataStore, engine,
+				service.WithPageSizeLimits(service.PageSizeLimits{Default: 1, Max: 3}))
+
+			result, err := limited.ListPolicies(ctx, nil, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(1))
+			Expect(result.PageSize).To(Equal(int32(1)))
+
+			pageSize := int32(10)
+			result, err = limited.ListPolicies(ctx, nil, nil, nil, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(3))
+			Expect(result.PageSize).To(Equal(int32(3)))
+			Expect(result.NextPageToken).NotTo(BeNil())
+		})
+
+		It("should filter by priority range", func() {
+			filter := "priority>=200 AND priority<400"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(2))
+			Expect(*result.Policies[0].Id).To(Equal("policy-3"))
+			Expect(*result.Policies[1].Id).To(Equal("policy-2"))
+		})
+
+		It("should filter by update time window", func() {
+			since := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+			filter := "update_time>'" + since + "' AND policy_type='USER'"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(2))
+
+			future := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+			filter = "create_time>='" + future + "'"
+			result, err = policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(BeEmpty())
+		})
+
+		It("should filter by the principal that created or last updated a policy", func() {
+			clientID := "alice-policy"
+			_, err := policyService.CreatePolicy(service.ContextWithPrincipal(ctx, "alice"), v1alpha1.Policy{
+				DisplayName: strPtr("Alice Policy"),
+				PolicyType:  policyTypePtr(v1alpha1.USER),
+				RegoCode:    strPtr("package alice"),
+			}, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = policyService.UpdatePolicy(service.ContextWithPrincipal(ctx, "bob"), "policy-1", &v1alpha1.Policy{
+				Description: strPtr("Reviewed"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			filter := "created_by='alice'"
+			result, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(1))
+			Expect(*result.Policies[0].Id).To(Equal("alice-policy"))
+
+			filter = "updated_by='bob' AND created_by='alice'"
+			result, err = policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Policies).To(BeEmpty())
+		})
+
+		DescribeTable("should reject ill-typed comparisons",
+			func(filter, detail string) {
+				_, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
+
+				Expect(err).To(HaveOccurred())
+				serviceErr, ok := err.(*service.ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+				Expect(serviceErr.Detail).To(ContainSubstring(detail))
+			},
+			Entry("a priority that is not an integer", "priority>='high'", "integer"),
+			Entry("an unquoted timestamp", "create_time>2024-01-01T00:00:00Z", "quoted RFC 3339"),
+			Entry("a timestamp that is not RFC 3339", "update_time<'yesterday'", "quoted RFC 3339"),
+			Entry("a time compared for equality", "create_time='2024-01-01T00:00:00Z'", "<, <=, > or >="),
+			Entry("an ordered comparison of policy_type", "policy_type>'GLOBAL'", "policy_type can only"),
+			Entry("an unquoted policy_type", "policy_type=GLOBAL", "quoted policy type"),
+			Entry("a repeated enabled", "enabled=true AND enabled=false", "only once"),
+			Entry("an unquoted principal", "created_by=alice", "quoted principal"),
+			Entry("an ordered comparison of updated_by", "updated_by>'alice'", "updated_by can only"),
+		)
+
 		It("should return error for invalid filter", func() {
 			filter := "invalid_field='value'"
 			_, err := policyService.ListPolicies(ctx, &filter, nil, nil, nil)
@@ -671,6 +1039,39 @@ var _ = Describe("PolicyService", func() {
 			Expect(updated.UpdateTime).NotTo(Equal(created.UpdateTime)) // UpdateTime changed
 		})
 
+		It("should record the principal creating and updating the policy", func() {
+			clientID := "principal-test"
+			created, err := policyService.CreatePolicy(service.ContextWithPrincipal(ctx, "alice"), v1alpha1.Policy{
+				DisplayName: strPtr("Principal Policy"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package principal"),
+			}, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.CreatedBy).To(Equal(strPtr("alice")))
+			Expect(created.UpdatedBy).To(Equal(strPtr("alice")))
+
+			updated, err := policyService.UpdatePolicy(service.ContextWithPrincipal(ctx, "bob"), clientID, &v1alpha1.Policy{
+				Description: strPtr("Updated by bob"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.CreatedBy).To(Equal(strPtr("alice")))
+			Expect(updated.UpdatedBy).To(Equal(strPtr("bob")))
+
+			// A change without a principal leaves the updater unset
+			updated, err = policyService.UpdatePolicy(ctx, clientID, &v1alpha1.Policy{
+				Description: strPtr("Updated anonymously"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.CreatedBy).To(Equal(strPtr("alice")))
+			Expect(updated.UpdatedBy).To(BeNil())
+
+			_, err = policyService.UpdatePolicy(ctx, clientID, &v1alpha1.Policy{CreatedBy: strPtr("mallory")})
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
 		It("should update Rego code and recompile engine", func() {
 			clientID := "update-rego-test"
 			policy := v1alpha1.Policy{
@@ -744,6 +1145,26 @@ var _ = Describe("PolicyService", func() {
 			Expect(*retrieved.RegoCode).To(Equal("package test"))
 		})
 
+		It("should reject an update whose Rego does not compile with the other policies", func() {
+			clientID := "update-compile-check"
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Test Policy"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+			}, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = policyService.UpdatePolicy(ctx, clientID, &v1alpha1.Policy{RegoCode: strPtr("package test\n\nallow if { x }")})
+
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			Expect(serviceErr.Detail).To(HavePrefix("The Rego code does not compile: "))
+			retrieved, err := policyService.GetPolicy(ctx, clientID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*retrieved.RegoCode).To(Equal("package test"))
+		})
+
 		It("should not recompile when RegoCode not in patch", func() {
 			clientID := "update-no-rego-test"
 			policy := v1alpha1.Policy{
@@ -1134,36 +1555,1262 @@ var _ = Describe("PolicyService", func() {
 		})
 	})
 
-	Describe("DeletePolicy", func() {
-		It("should delete existing policy", func() {
-			clientID := "delete-test"
-			policy := v1alpha1.Policy{
-				DisplayName: strPtr("Test"),
-				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
-				RegoCode:    strPtr("package test"),
+	Describe("GetPolicyFacets", func() {
+		It("should return sorted facets and a full priority histogram", func() {
+			for _, p := range []struct {
+				id       string
+				priority int32
+				selector map[string]string
+			}{
+				{"facet-a", 50, map[string]string{"env": "prod"}},
+				{"facet-b", 150, map[string]string{"env": "dev", "tier": "gold"}},
+				{"facet-c", 1000, nil},
+			} {
+				id := p.id
+				priority := p.priority
+				policy := v1alpha1.Policy{
+					DisplayName: strPtr(p.id),
+					PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+					RegoCode:    strPtr("package test"),
+					Priority:    &priority,
+				}
+				if p.selector != nil {
+					policy.LabelSelector = &p.selector
+				}
+				_, err := policyService.CreatePolicy(ctx, policy, &id)
+				Expect(err).ToNot(HaveOccurred())
 			}
-			_, err := policyService.CreatePolicy(ctx, policy, &clientID)
-			Expect(err).ToNot(HaveOccurred())
 
-			err = policyService.DeletePolicy(ctx, "delete-test")
+			facets, err := policyService.GetPolicyFacets(ctx)
 
 			Expect(err).ToNot(HaveOccurred())
-
-			// Verify it's deleted
-			_, err = policyService.GetPolicy(ctx, "delete-test")
-			Expect(err).To(HaveOccurred())
-			serviceErr, ok := err.(*service.ServiceError)
-			Expect(ok).To(BeTrue())
-			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+			Expect(facets.PolicyTypes).To(Equal([]v1alpha1.FacetValue{{Value: "GLOBAL", Count: 3}}))
+			Expect(facets.LabelSelectorKeys).To(Equal([]v1alpha1.LabelSelectorKeyFacet{
+				{Key: "env", Count: 2, Values: []v1alpha1.FacetValue{{Value: "dev", Count: 1}, {Value: "prod", Count: 1}}},
+				{Key: "tier", Count: 1, Values: []v1alpha1.FacetValue{{Value: "gold", Count: 1}}},
+			}))
+			Expect(facets.PriorityHistogram).To(HaveLen(10))
+			Expect(facets.PriorityHistogram[0]).To(Equal(v1alpha1.PriorityBucket{Min: 1, Max: 100, Count: 1}))
+			Expect(facets.PriorityHistogram[1]).To(Equal(v1alpha1.PriorityBucket{Min: 101, Max: 200, Count: 1}))
+			Expect(facets.PriorityHistogram[5].Count).To(BeZero())
+			Expect(facets.PriorityHistogram[9]).To(Equal(v1alpha1.PriorityBucket{Min: 901, Max: 1000, Count: 1}))
 		})
+	})
 
-		It("should return NotFound error for non-existent policy", func() {
-			err := policyService.DeletePolicy(ctx, "non-existent")
-
-			Expect(err).To(HaveOccurred())
-			serviceErr, ok := err.(*service.ServiceError)
+	Describe("GetPolicyChecksum", func() {
+		create := func(id string, priority int32, rego string) {
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr(id),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr(rego),
+				Priority:    &priority,
+			}, &id)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should digest the ID, latest revision and Rego of every policy", func() {
+			create("sum-b", 200, "package sum_b")
+			create("sum-a", 100, "package sum_a")
+
+			checksum, err := policyService.GetPolicyChecksum(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checksum.PolicyCount).To(Equal(int32(2)))
+			regoA := sha256.Sum256([]byte("package sum_a"))
+			regoB := sha256.Sum256([]byte("package sum_b"))
+			lines := "sum-a 1 " + hex.EncodeToString(regoA[:]) + "\n" + "sum-b 1 " + hex.EncodeToString(regoB[:]) + "\n"
+			expected := sha256.Sum256([]byte(lines))
+			Expect(checksum.Checksum).To(Equal("sha256:" + hex.EncodeToString(expected[:])))
+		})
+
+		It("should change with every policy change", func() {
+			create("sum-a", 100, "package sum_a")
+			before, err := policyService.GetPolicyChecksum(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			again, err := policyService.GetPolicyChecksum(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again).To(Equal(before))
+
+			_, err = policyService.UpdatePolicy(ctx, "sum-a", &v1alpha1.Policy{Description: strPtr("Described")})
+			Expect(err).ToNot(HaveOccurred())
+			after, err := policyService.GetPolicyChecksum(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(after.Checksum).NotTo(Equal(before.Checksum))
+		})
+	})
+
+	Describe("GetEvaluationOrder", func() {
+		BeforeEach(func() {
+			for _, p := range []struct {
+				id       string
+				typ      v1alpha1.PolicyPolicyType
+				priority int32
+				selector map[string]string
+				enabled  bool
+			}{
+				{"order-user", v1alpha1.USER, 1, nil, true},
+				{"order-global-late", v1alpha1.GLOBAL, 300, map[string]string{"env": "prod"}, true},
+				{"order-global-early", v1alpha1.GLOBAL, 20, nil, true},
+				{"order-staging", v1alpha1.GLOBAL, 30, map[string]string{"env": "staging"}, true},
+				{"order-disabled", v1alpha1.GLOBAL, 40, nil, false},
+			} {
+				id := p.id
+				priority := p.priority
+				enabled := p.enabled
+				policy := v1alpha1.Policy{
+					DisplayName: strPtr(p.id),
+					PolicyType:  policyTypePtr(p.typ),
+					RegoCode:    strPtr("package test"),
+					Priority:    &priority,
+					Enabled:     &enabled,
+				}
+				if p.selector != nil {
+					policy.LabelSelector = &p.selector
+				}
+				_, err := policyService.CreatePolicy(ctx, policy, &id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should list matching enabled policies in evaluation order", func() {
+			order, err := policyService.GetEvaluationOrder(ctx, []string{"env=prod", "service_type=compute"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.RequestLabels).To(Equal(map[string]string{"env": "prod", "service_type": "compute"}))
+			ids := make([]string, len(order.Policies))
+			for i, entry := range order.Policies {
+				ids[i] = entry.Id
+				Expect(entry.Position).To(Equal(int32(i + 1)))
+			}
+			Expect(ids).To(Equal([]string{"order-global-early", "order-global-late", "order-user"}))
+			Expect(order.Policies[1].LabelSelector).To(Equal(&map[string]string{"env": "prod"}))
+			Expect(order.Policies[2].PolicyType).To(Equal(v1alpha1.EvaluationOrderUser))
+			Expect(order.PoliciesSkipped).To(Equal(int32(1)))
+		})
+
+		It("should only include policies with empty selectors without labels", func() {
+			order, err := policyService.GetEvaluationOrder(ctx, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Policies).To(HaveLen(2))
+			Expect(order.PoliciesSkipped).To(Equal(int32(2)))
+		})
+
+		It("should reject malformed labels", func() {
+			for _, labels := range [][]string{{"env"}, {"=prod"}, {"env=prod", "env=dev"}} {
+				_, err := policyService.GetEvaluationOrder(ctx, labels)
+
+				Expect(err).To(HaveOccurred(), "labels %v", labels)
+				serviceErr, ok := err.(*service.ServiceError)
+				Expect(ok).To(BeTrue())
+				Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+			}
+		})
+	})
+
+	Describe("GetPolicyCatalog", func() {
+		BeforeEach(func() {
+			for _, p := range []struct {
+				id            string
+				typ           v1alpha1.PolicyPolicyType
+				priority      int32
+				enabled       bool
+				documentation *v1alpha1.PolicyDocumentation
+			}{
+				{"catalog-user", v1alpha1.USER, 1, true, nil},
+				{"catalog-region", v1alpha1.GLOBAL, 100, true, &v1alpha1.PolicyDocumentation{
+					Summary:        strPtr("Restricts workloads to approved regions"),
+					Rationale:      strPtr("Data residency rules require it."),
+					RemediationUrl: strPtr("https://docs.example.com/policies/region"),
+					OwnerContact:   strPtr("platform-team@example.com"),
+				}},
+				{"catalog-disabled", v1alpha1.GLOBAL, 50, false, nil},
+			} {
+				id := p.id
+				priority := p.priority
+				enabled := p.enabled
+				policy := v1alpha1.Policy{
+					DisplayName:   strPtr(p.id),
+					PolicyType:    policyTypePtr(p.typ),
+					RegoCode:      strPtr("package test"),
+					Priority:      &priority,
+					Enabled:       &enabled,
+					Documentation: p.documentation,
+				}
+				if p.id == "catalog-region" {
+					policy.LabelSelector = &map[string]string{"env": "prod"}
+				}
+				_, err := policyService.CreatePolicy(ctx, policy, &id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should list enabled policies with their documentation in evaluation order", func() {
+			catalog, err := policyService.GetPolicyCatalog(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(catalog.GenerateTime).NotTo(BeZero())
+			Expect(catalog.Policies).To(HaveLen(2))
+			Expect(catalog.Policies[0].Id).To(Equal("catalog-region"))
+			Expect(*catalog.Policies[0].Documentation.OwnerContact).To(Equal("platform-team@example.com"))
+			Expect(*catalog.Policies[0].LabelSelector).To(Equal(map[string]string{"env": "prod"}))
+			Expect(catalog.Policies[1].Id).To(Equal("catalog-user"))
+			Expect(catalog.Policies[1].Documentation).To(BeNil())
+		})
+
+		It("should render the catalog as markdown", func() {
+			catalog, err := policyService.GetPolicyCatalog(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			markdown := service.PolicyCatalogMarkdown(catalog)
+
+			Expect(markdown).To(HavePrefix("# Policy Catalog\n\n2 enabled policies in evaluation order"))
+			Expect(markdown).To(ContainSubstring("## catalog-region\n\n`catalog-region` · GLOBAL · priority 100\n\n> Restricts workloads to approved regions\n"))
+			Expect(markdown).To(ContainSubstring("- **Applies to:** `env=prod`\n- **Owner:** platform-team@example.com\n- **Remediation:** <https://docs.example.com/policies/region>\n"))
+			Expect(markdown).To(ContainSubstring("### Rationale\n\nData residency rules require it.\n"))
+			Expect(markdown).To(ContainSubstring("## catalog-user\n\n`catalog-user` · USER · priority 1\n\n- **Applies to:** all requests\n"))
+			Expect(markdown).NotTo(ContainSubstring("catalog-disabled"))
+		})
+	})
+
+	Describe("SimulatePolicy", func() {
+		BeforeEach(func() {
+			for _, p := range []struct {
+				id       string
+				priority int32
+				rego     string
+			}{
+				{"sim-region", 100, "package sim.region\nmain := {\"patch\": {\"region\": \"us-east-1\"}, \"constraints\": {\"region\": {\"const\": \"us-east-1\"}}}"},
+				{"sim-deny-large", 300, "package sim.deny_large\nmain := {\"rejected\": true, \"rejection_reason\": \"too large\"} if input.spec.size == \"large\""},
+			} {
+				id := p.id
+				priority := p.priority
+				_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+					DisplayName: strPtr(p.id),
+					PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+					RegoCode:    strPtr(p.rego),
+					Priority:    &priority,
+				}, &id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		draft := func(priority int32, rego string) v1alpha1.Policy {
+			return v1alpha1.Policy{
+				DisplayName: strPtr("Draft"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr(rego),
+				Priority:    &priority,
+			}
+		}
+		simulate := func(policyID *string, policy v1alpha1.Policy, spec map[string]any) (*v1alpha1.PolicySimulation, error) {
+			return policyService.SimulatePolicy(ctx, v1alpha1.PolicySimulationRequest{
+				PolicyId:        policyID,
+				Policy:          policy,
+				ServiceInstance: v1alpha1.SimulatedServiceInstance{Spec: spec},
+			})
+		}
+
+		It("should evaluate the draft at its priority without storing it", func() {
+			result, err := simulate(strPtr("sim-draft"),
+				draft(200, "package sim.draft\nmain := {\"patch\": {\"size\": \"small\"}}"),
+				map[string]any{"service_type": "vm"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PolicyId).To(Equal("sim-draft"))
+			Expect(result.Status).To(Equal(v1alpha1.SimulationModified))
+			Expect(result.PoliciesEvaluated).To(Equal([]string{"sim-region", "sim-draft", "sim-deny-large"}))
+			Expect(result.EvaluatedServiceInstance.Spec).To(Equal(map[string]any{"service_type": "vm", "region": "us-east-1", "size": "small"}))
+			Expect(result.Draft.Outcome).To(Equal(v1alpha1.DraftApplied))
+			Expect(result.Draft.Patch).To(Equal(&map[string]any{"size": "small"}))
+
+			_, err = policyService.GetPolicy(ctx, "sim-draft")
+			Expect(err).To(HaveOccurred())
+			live, err := engine.EvaluatePolicy(ctx, "sim-draft", map[string]any{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(live.Defined).To(BeFalse())
+		})
+
+		It("should report a rejection, and the draft not being reached, as results", func() {
+			result, err := simulate(nil,
+				draft(400, "package sim.draft\nmain := {\"patch\": {\"size\": \"small\"}}"),
+				map[string]any{"service_type": "vm", "size": "large"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.PolicyId).NotTo(BeEmpty())
+			Expect(result.Status).To(Equal(v1alpha1.SimulationRejected))
+			Expect(*result.FailedPolicyId).To(Equal("sim-deny-large"))
+			Expect(*result.Detail).To(Equal("too large"))
+			Expect(result.EvaluatedServiceInstance).To(BeNil())
+			Expect(result.Draft.Outcome).To(Equal(v1alpha1.DraftNotReached))
+		})
+
+		It("should report the draft violating constraints of higher-priority policies", func() {
+			result, err := simulate(nil,
+				draft(200, "package sim.draft\nmain := {\"patch\": {\"region\": \"eu-west-1\"}}"),
+				map[string]any{"service_type": "vm"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(v1alpha1.SimulationFailed))
+			Expect(*result.FailedPolicyId).To(Equal(result.PolicyId))
+			Expect(result.Draft.Outcome).To(Equal(v1alpha1.DraftFailed))
+			Expect(*result.Draft.Reason).To(ContainSubstring("region"))
+		})
+
+		It("should replace the stored policy with the draft's ID", func() {
+			result, err := simulate(strPtr("sim-deny-large"),
+				draft(300, "package sim.deny_large\nmain := {\"rejected\": false}"),
+				map[string]any{"service_type": "vm", "size": "large"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(v1alpha1.SimulationModified))
+			Expect(result.PoliciesEvaluated).To(Equal([]string{"sim-region", "sim-deny-large"}))
+			Expect(result.Draft.Outcome).To(Equal(v1alpha1.DraftApplied))
+		})
+
+		It("should report a draft whose label selector does not match as skipped", func() {
+			policy := draft(200, "package sim.draft\nmain := {\"rejected\": true}")
+			policy.LabelSelector = &map[string]string{"env": "prod"}
+
+			result, err := simulate(nil, policy, map[string]any{"service_type": "vm"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Status).To(Equal(v1alpha1.SimulationModified))
+			Expect(result.Draft.Outcome).To(Equal(v1alpha1.DraftSkipped))
+		})
+
+		It("should refuse drafts that could not be created", func() {
+			_, err := simulate(nil, draft(100, "package sim.draft\nmain := {}"), map[string]any{"service_type": "vm"})
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeAlreadyExists))
+
+			_, err = simulate(nil, draft(200, "package sim.draft\nmain := {"), map[string]any{"service_type": "vm"})
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+
+			_, err = simulate(nil, draft(200, "package sim.draft"), map[string]any{})
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Invalid service instance spec"))
+		})
+	})
+
+	Describe("CheckPolicyConflicts", func() {
+		BeforeEach(func() {
+			for _, p := range []struct {
+				id       string
+				priority int32
+				rego     string
+			}{
+				{"check-region", 100, "package check.region\nmain := {\"patch\": {\"region\": \"us-east-1\"}, \"constraints\": {\"region\": {\"const\": \"us-east-1\"}}}"},
+				{"check-size", 300, "package check.size\nmain := {\"patch\": {\"size\": \"large\"}}"},
+			} {
+				id := p.id
+				priority := p.priority
+				_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+					DisplayName: strPtr(p.id),
+					PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+					RegoCode:    strPtr(p.rego),
+					Priority:    &priority,
+				}, &id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		candidate := func(displayName string, priority int32, rego string) v1alpha1.Policy {
+			return v1alpha1.Policy{
+				DisplayName: strPtr(displayName),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr(rego),
+				Priority:    &priority,
+			}
+		}
+		samples := func(specs ...map[string]any) *[]v1alpha1.SimulatedServiceInstance {
+			out := make([]v1alpha1.SimulatedServiceInstance, len(specs))
+			for i, spec := range specs {
+				out[i] = v1alpha1.SimulatedServiceInstance{Spec: spec}
+			}
+			return &out
+		}
+
+		It("should report no conflicts for a candidate that fits in", func() {
+			report, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy:  candidate("Tier", 200, "package check.tier\nmain := {\"patch\": {\"tier\": \"gold\"}}"),
+				Samples: samples(map[string]any{"service_type": "vm"}),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.PolicyId).NotTo(BeEmpty())
+			Expect(report.Conflicts).To(BeEmpty())
+			Expect(report.SamplesChecked).To(Equal(int32(1)))
+		})
+
+		It("should report priority, display name and package conflicts together", func() {
+			report, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy:  candidate("check-size", 100, "package check.size\nallowed := true"),
+				Samples: samples(map[string]any{"service_type": "vm"}),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Conflicts).To(HaveLen(3))
+			Expect(report.Conflicts[0].Kind).To(Equal(v1alpha1.ConflictPriority))
+			Expect(*report.Conflicts[0].PolicyId).To(Equal("check-region"))
+			Expect(report.Conflicts[1].Kind).To(Equal(v1alpha1.ConflictDisplayName))
+			Expect(*report.Conflicts[1].PolicyId).To(Equal("check-size"))
+			Expect(report.Conflicts[2].Kind).To(Equal(v1alpha1.ConflictPackage))
+			Expect(report.Conflicts[2].Detail).To(ContainSubstring("package 'check.size'"))
+		})
+
+		It("should report a candidate that does not compile with the stored policies without evaluating samples", func() {
+			report, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy:  candidate("Other size", 200, "package check.size\nmain contains \"small\""),
+				Samples: samples(map[string]any{"service_type": "vm"}),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			kinds := []v1alpha1.PolicyConflictKind{}
+			for _, conflict := range report.Conflicts {
+				kinds = append(kinds, conflict.Kind)
+			}
+			Expect(kinds).To(Equal([]v1alpha1.PolicyConflictKind{v1alpha1.ConflictPackage, v1alpha1.ConflictCompile}))
+			Expect(report.SamplesChecked).To(BeZero())
+		})
+
+		It("should report sample decisions that conflict with other policies", func() {
+			report, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy: candidate("Small", 200, `package check.small
+main := {"patch": {"region": "eu-west-1"}} if input.spec.move
+else := {"constraints": {"size": {"const": "small"}}}`),
+				Samples: samples(
+					map[string]any{"service_type": "vm", "move": true},
+					map[string]any{"service_type": "vm"},
+				),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.SamplesChecked).To(Equal(int32(2)))
+			Expect(report.Conflicts).To(HaveLen(2))
+			Expect(report.Conflicts[0].Kind).To(Equal(v1alpha1.ConflictSample))
+			Expect(*report.Conflicts[0].SampleIndex).To(Equal(int32(0)))
+			Expect(report.Conflicts[0].PolicyId).To(BeNil())
+			Expect(*report.Conflicts[1].SampleIndex).To(Equal(int32(1)))
+			Expect(*report.Conflicts[1].PolicyId).To(Equal("check-size"))
+			Expect(report.Conflicts[1].Detail).To(ContainSubstring("Policy 'check-size' fails after the candidate"))
+		})
+
+		It("should not compare a replacement with the policy it replaces", func() {
+			report, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				PolicyId: strPtr("check-size"),
+				Policy:   candidate("check-size", 300, "package check.size\nmain := {\"patch\": {\"size\": \"medium\"}}"),
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.PolicyId).To(Equal("check-size"))
+			Expect(report.Conflicts).To(BeEmpty())
+		})
+
+		It("should reject invalid candidates and samples", func() {
+			_, err := policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy: candidate("Broken", 200, "package check.broken\nmain := {"),
+			})
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+
+			_, err = policyService.CheckPolicyConflicts(ctx, v1alpha1.PolicyConflictCheckRequest{
+				Policy:  candidate("Tier", 200, "package check.tier"),
+				Samples: samples(map[string]any{}),
+			})
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Invalid sample 0"))
+		})
+	})
+
+	Describe("TestPolicyMatch", func() {
+		BeforeEach(func() {
+			id := "match-test"
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Match Test"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+				LabelSelector: &map[string]string{
+					"env":    "prod",
+					"region": "us-east-1",
+					"tier":   "gold",
+				},
+			}, &id)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should match when every selector term is satisfied", func() {
+			result, err := policyService.TestPolicyMatch(ctx, "match-test", v1alpha1.PolicyMatchTestRequest{
+				Labels: &map[string]string{"env": "prod", "region": "us-east-1", "tier": "gold", "team": "core"},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Matches).To(BeTrue())
+			Expect(result.Enabled).To(BeTrue())
+			Expect(result.FailedTerms).To(BeEmpty())
+		})
+
+		It("should explain missing and mismatched terms in key order", func() {
+			result, err := policyService.TestPolicyMatch(ctx, "match-test", v1alpha1.PolicyMatchTestRequest{
+				Labels: &map[string]string{"env": "production", "region": "us-east-1"},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Matches).To(BeFalse())
+			Expect(result.LabelSelector).To(HaveLen(3))
+			Expect(result.FailedTerms).To(Equal([]v1alpha1.SelectorTermFailure{
+				{Key: "env", Expected: "prod", Actual: strPtr("production"), Reason: v1alpha1.SelectorTermMismatch},
+				{Key: "tier", Expected: "gold", Reason: v1alpha1.SelectorTermMissing},
+			}))
+		})
+
+		It("should match any labels for an empty selector", func() {
+			id := "match-all"
+			priority := int32(600)
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Match All"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				Priority:    &priority,
+				RegoCode:    strPtr("package test"),
+			}, &id)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := policyService.TestPolicyMatch(ctx, "match-all", v1alpha1.PolicyMatchTestRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Matches).To(BeTrue())
+			Expect(result.LabelSelector).To(BeEmpty())
+		})
+
+		It("should derive the labels from a spec", func() {
+			result, err := policyService.TestPolicyMatch(ctx, "match-test", v1alpha1.PolicyMatchTestRequest{
+				Spec: &map[string]any{
+					"service_type": "compute",
+					"metadata": map[string]any{
+						"labels": map[string]any{"env": "prod", "region": "us-east-1", "tier": "gold"},
+					},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Matches).To(BeTrue())
+			Expect(result.RequestLabels).To(HaveKeyWithValue("service_type", "compute"))
+		})
+
+		It("should reject a spec without service_type", func() {
+			_, err := policyService.TestPolicyMatch(ctx, "match-test", v1alpha1.PolicyMatchTestRequest{
+				Spec: &map[string]any{"metadata": map[string]any{}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should reject both labels and spec", func() {
+			_, err := policyService.TestPolicyMatch(ctx, "match-test", v1alpha1.PolicyMatchTestRequest{
+				Labels: &map[string]string{},
+				Spec:   &map[string]any{"service_type": "compute"},
+			})
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should return NotFound error for non-existent policy", func() {
+			_, err := policyService.TestPolicyMatch(ctx, "non-existent", v1alpha1.PolicyMatchTestRequest{})
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+		})
+	})
+
+	Describe("CreateOrGetPolicy", func() {
+		desired := func(displayName string) v1alpha1.Policy {
+			return v1alpha1.Policy{
+				DisplayName: strPtr(displayName),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+				LabelSelector: &map[string]string{
+					"env": "prod",
+				},
+			}
+		}
+
+		It("should create a missing policy", func() {
+			policy, created, err := policyService.CreateOrGetPolicy(ctx, desired("Fresh"), "get-or-create")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(*policy.Id).To(Equal("get-or-create"))
+		})
+
+		It("should return an identical existing policy", func() {
+			first, created, err := policyService.CreateOrGetPolicy(ctx, desired("Identical"), "get-identical")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			second, created, err := policyService.CreateOrGetPolicy(ctx, desired("Identical"), "get-identical")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(*second.Id).To(Equal(*first.Id))
+			Expect(second.CreateTime.Equal(*first.CreateTime)).To(BeTrue())
+		})
+
+		It("should return AlreadyExists when the existing policy differs", func() {
+			_, _, err := policyService.CreateOrGetPolicy(ctx, desired("Original"), "get-different")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = policyService.CreateOrGetPolicy(ctx, desired("Changed"), "get-different")
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeAlreadyExists))
+			Expect(serviceErr.Detail).To(ContainSubstring("different content"))
+		})
+
+		It("should keep reporting conflicts with other policies", func() {
+			_, _, err := policyService.CreateOrGetPolicy(ctx, desired("Taken"), "get-taken")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = policyService.CreateOrGetPolicy(ctx, desired("Other"), "get-other")
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeAlreadyExists))
+			Expect(serviceErr.Detail).NotTo(ContainSubstring("different content"))
+		})
+	})
+
+	Describe("ApplyPolicy", func() {
+		desired := func(displayName, regoCode string) v1alpha1.Policy {
+			return v1alpha1.Policy{
+				DisplayName: strPtr(displayName),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr(regoCode),
+			}
+		}
+
+		It("should return NotFound for a missing policy without allow_missing", func() {
+			_, _, err := policyService.ApplyPolicy(ctx, "apply-missing", desired("Missing", "package test"), false)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+		})
+
+		It("should create a missing policy with allow_missing", func() {
+			applied, created, err := policyService.ApplyPolicy(ctx, "apply-create", desired("Created", "package test"), true)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(*applied.Id).To(Equal("apply-create"))
+
+			fetched, err := policyService.GetPolicy(ctx, "apply-create")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*fetched.DisplayName).To(Equal("Created"))
+		})
+
+		It("should reject an invalid ID when creating", func() {
+			_, _, err := policyService.ApplyPolicy(ctx, "Invalid_ID", desired("Invalid", "package test"), true)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should replace an existing policy and reset omitted fields", func() {
+			clientID := "apply-replace"
+			existing := desired("Original", "package test")
+			existing.Description = strPtr("Original description")
+			existing.LabelSelector = &map[string]string{"env": "prod"}
+			enabled := false
+			existing.Enabled = &enabled
+			_, err := policyService.CreatePolicy(ctx, existing, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+
+			applied, created, err := policyService.ApplyPolicy(ctx, clientID, desired("Replaced", "package test\ndefault allow := true"), true)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(*applied.DisplayName).To(Equal("Replaced"))
+			Expect(applied.Description).To(BeNil())
+			Expect(applied.LabelSelector).To(BeNil())
+			Expect(*applied.Enabled).To(BeTrue())
+			Expect(*applied.Priority).To(Equal(int32(service.DefaultPriority)))
+			Expect(*applied.RegoCode).To(ContainSubstring("default allow := true"))
+		})
+
+		It("should be idempotent", func() {
+			policy := desired("Idempotent", "package test")
+			_, created, err := policyService.ApplyPolicy(ctx, "apply-idempotent", policy, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			applied, created, err := policyService.ApplyPolicy(ctx, "apply-idempotent", policy, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+			Expect(*applied.DisplayName).To(Equal("Idempotent"))
+		})
+
+		It("should reject changing policy_type", func() {
+			clientID := "apply-type"
+			_, err := policyService.CreatePolicy(ctx, desired("Typed", "package test"), &clientID)
+			Expect(err).ToNot(HaveOccurred())
+
+			policy := desired("Typed", "package test")
+			policy.PolicyType = policyTypePtr(v1alpha1.USER)
+			_, _, err = policyService.ApplyPolicy(ctx, clientID, policy, false)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should reject a body id that differs from the path", func() {
+			policy := desired("Mismatch", "package test")
+			policy.Id = strPtr("other-id")
+
+			_, _, err := policyService.ApplyPolicy(ctx, "apply-mismatch", policy, true)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+
+		It("should require the complete resource", func() {
+			_, _, err := policyService.ApplyPolicy(ctx, "apply-incomplete", v1alpha1.Policy{DisplayName: strPtr("Incomplete")}, true)
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+		})
+	})
+
+	Describe("policy revisions", func() {
+		BeforeEach(func() {
+			id := "revised"
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Original"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package revised"),
+			}, &id)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should record the create, updates and applies as revisions, newest first", func() {
+			_, err := policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{DisplayName: strPtr("Renamed")})
+			Expect(err).ToNot(HaveOccurred())
+			_, _, err = policyService.ApplyPolicy(ctx, "revised", v1alpha1.Policy{
+				DisplayName: strPtr("Applied"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package revised\ndefault allow = true"),
+			}, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			list, err := policyService.ListPolicyRevisions(ctx, "revised", nil, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.NextPageToken).To(BeNil())
+			Expect(list.Revisions).To(HaveLen(3))
+			Expect(list.Revisions[0].Revision).To(Equal(int64(3)))
+			Expect(*list.Revisions[0].Path).To(Equal("policies/revised/revisions/3"))
+			Expect(*list.Revisions[0].Policy.DisplayName).To(Equal("Applied"))
+			Expect(*list.Revisions[1].Policy.DisplayName).To(Equal("Renamed"))
+			Expect(*list.Revisions[2].Policy.DisplayName).To(Equal("Original"))
+			Expect(*list.Revisions[2].Policy.RegoCode).To(Equal("package revised"))
+		})
+
+		It("should page through revisions with page tokens", func() {
+			_, err := policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{DisplayName: strPtr("Renamed")})
+			Expect(err).ToNot(HaveOccurred())
+
+			pageSize := int32(1)
+			first, err := policyService.ListPolicyRevisions(ctx, "revised", nil, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.Revisions).To(HaveLen(1))
+			Expect(first.NextPageToken).NotTo(BeNil())
+
+			second, err := policyService.ListPolicyRevisions(ctx, "revised", first.NextPageToken, &pageSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.Revisions[0].Revision).To(Equal(int64(1)))
+			Expect(second.NextPageToken).To(BeNil())
+
+			_, err = policyService.ListPolicyRevisions(ctx, "other", first.NextPageToken, &pageSize)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should get a revision by number", func() {
+			revision, err := policyService.GetPolicyRevision(ctx, "revised", 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*revision.Policy.Id).To(Equal("revised"))
+			Expect(*revision.Policy.DisplayName).To(Equal("Original"))
+		})
+
+		It("should not record a revision for an update rolled back after a compile failure", func() {
+			failing := &compileFailingEngine{Engine: engine, fail: true}
+			policyService = service.NewPolicyService(dataStore, failing)
+
+			_, err := policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{
+				DisplayName: strPtr("Broken"),
+				RegoCode:    strPtr("package revised\nmain := {\"rejected\": true}"),
+			})
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInternal))
+
+			list, err := policyService.ListPolicyRevisions(ctx, "revised", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revisions).To(HaveLen(1))
+			policy, err := policyService.GetPolicy(ctx, "revised")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*policy.DisplayName).To(Equal("Original"))
+
+			failing.fail = false
+			_, err = policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{DisplayName: strPtr("Renamed")})
+			Expect(err).ToNot(HaveOccurred())
+			list, err = policyService.ListPolicyRevisions(ctx, "revised", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revisions).To(HaveLen(2))
+			Expect(list.Revisions[0].Revision).To(Equal(int64(2)))
+		})
+
+		It("should roll back to a prior revision and record the rollback as a new revision", func() {
+			enabled := false
+			_, err := policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{
+				DisplayName: strPtr("Broken"),
+				Enabled:     &enabled,
+				RegoCode:    strPtr("package revised\nmain := {\"rejected\": true}"),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			rolledBack, err := policyService.RollbackPolicy(ctx, "revised", 1)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*rolledBack.DisplayName).To(Equal("Original"))
+			Expect(*rolledBack.Enabled).To(BeTrue())
+			Expect(*rolledBack.RegoCode).To(Equal("package revised"))
+
+			list, err := policyService.ListPolicyRevisions(ctx, "revised", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revisions).To(HaveLen(3))
+			Expect(*list.Revisions[0].Policy.RegoCode).To(Equal("package revised"))
+			Expect(*list.Revisions[1].Policy.DisplayName).To(Equal("Broken"))
+		})
+
+		It("should reject rolling back to an unknown or invalid revision", func() {
+			_, err := policyService.RollbackPolicy(ctx, "revised", 5)
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+
+			_, err = policyService.RollbackPolicy(ctx, "revised", 0)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+
+			_, err = policyService.RollbackPolicy(ctx, "missing", 1)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Policy not found"))
+		})
+
+		It("should diff two revisions field by field with a unified Rego diff", func() {
+			priority := int32(200)
+			_, err := policyService.UpdatePolicy(ctx, "revised", &v1alpha1.Policy{
+				DisplayName:       strPtr("Renamed"),
+				LabelSelector:     &map[string]string{"env": "prod"},
+				Priority:          &priority,
+				RegoCode:          strPtr("package revised\nmain := {\"rejected\": true}"),
+				RejectionMessages: &map[string]string{"denied": "Denied by {policy_id}"},
+				Annotations:       &map[string]string{"owner": "platform"},
+				Parameters:        &map[string]any{"max_cpu": 8},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			diff, err := policyService.DiffPolicyRevisions(ctx, "revised", 1, 2)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diff.From).To(Equal(int64(1)))
+			Expect(diff.To).To(Equal(int64(2)))
+			Expect(diff.Changes).To(Equal([]v1alpha1.PolicyFieldChange{
+				{Field: "display_name", From: "Original", To: "Renamed"},
+				{Field: "label_selector.env", From: nil, To: "prod"},
+				{Field: "priority", From: int32(500), To: int32(200)},
+				{Field: "rejection_messages.denied", From: nil, To: "Denied by {policy_id}"},
+				{Field: "annotations.owner", From: nil, To: "platform"},
+				{Field: "parameters.max_cpu", From: nil, To: float64(8)},
+			}))
+			Expect(diff.RegoDiff).To(Equal("--- revision 1\n+++ revision 2\n@@ -1 +1,2 @@\n package revised\n+main := {\"rejected\": true}\n"))
+
+			same, err := policyService.DiffPolicyRevisions(ctx, "revised", 2, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(same.Changes).To(BeEmpty())
+			Expect(same.RegoDiff).To(BeEmpty())
+		})
+
+		It("should reject diffing unknown or invalid revisions", func() {
+			_, err := policyService.DiffPolicyRevisions(ctx, "revised", 1, 4)
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Policy revision not found"))
+
+			_, err = policyService.DiffPolicyRevisions(ctx, "revised", 0, 1)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeInvalidArgument))
+
+			_, err = policyService.DiffPolicyRevisions(ctx, "missing", 1, 1)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Policy not found"))
+		})
+
+		It("should return NotFound for an unknown revision or policy", func() {
+			_, err := policyService.GetPolicyRevision(ctx, "revised", 2)
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+			Expect(serviceErr.Message).To(Equal("Policy revision not found"))
+
+			_, err = policyService.GetPolicyRevision(ctx, "missing", 1)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Policy not found"))
+
+			_, err = policyService.ListPolicyRevisions(ctx, "missing", nil, nil)
+			serviceErr, ok = err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+		})
+
+		It("should keep the revisions of a deleted policy", func() {
+			Expect(policyService.DeletePolicy(ctx, "revised")).To(Succeed())
+
+			list, err := policyService.ListPolicyRevisions(ctx, "revised", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revisions).To(HaveLen(1))
+			revision, err := policyService.GetPolicyRevision(ctx, "revised", 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*revision.Policy.DisplayName).To(Equal("Original"))
+
+			_, err = policyService.GetPolicyRevision(ctx, "revised", 2)
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Message).To(Equal("Policy revision not found"))
+		})
+	})
+
+	Describe("policy tests", func() {
+		BeforeEach(func() {
+			for _, p := range []struct {
+				id       string
+				priority int32
+				enabled  bool
+				rego     string
+			}{
+				{"tested-region", 100, false, "package tested.region\nmain := {\"rejected\": true, \"rejection_reason\": \"region not allowed\"} if input.spec.region == \"eu-west-1\" else := {\"patch\": {\"zone\": \"a\"}}"},
+				{"tested-size", 200, true, "package tested.size\nmain := {\"patch\": {\"size\": \"small\"}}"},
+			} {
+				id := p.id
+				priority := p.priority
+				enabled := p.enabled
+				_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+					DisplayName: strPtr(p.id),
+					PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+					RegoCode:    strPtr(p.rego),
+					Priority:    &priority,
+					Enabled:     &enabled,
+				}, &id)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		newTest := func(spec map[string]any, status v1alpha1.SimulationStatus) v1alpha1.PolicyTest {
+			return v1alpha1.PolicyTest{
+				ServiceInstance: v1alpha1.SimulatedServiceInstance{Spec: spec},
+				Expected:        v1alpha1.PolicyTestExpectation{Status: status},
+			}
+		}
+
+		It("should store, list, get and delete test cases", func() {
+			test := newTest(map[string]any{"service_type": "vm", "region": "eu-west-1"}, v1alpha1.SimulationRejected)
+			test.DisplayName = strPtr("EU is rejected")
+			created, err := policyService.CreatePolicyTest(ctx, "tested-region", test, strPtr("eu-rejected"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*created.Path).To(Equal("policies/tested-region/tests/eu-rejected"))
+			Expect(*created.DisplayName).To(Equal("EU is rejected"))
+
+			generated, err := policyService.CreatePolicyTest(ctx, "tested-region", newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationModified), nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*generated.Id).NotTo(BeEmpty())
+
+			list, err := policyService.ListPolicyTests(ctx, "tested-region", nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Tests).To(HaveLen(2))
+			Expect(*list.Tests[0].Id).To(Equal("eu-rejected"))
+
+			got, err := policyService.GetPolicyTest(ctx, "tested-region", "eu-rejected")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(got.ServiceInstance.Spec).To(Equal(map[string]any{"service_type": "vm", "region": "eu-west-1"}))
+			Expect(got.Expected.Status).To(Equal(v1alpha1.SimulationRejected))
+
+			Expect(policyService.DeletePolicyTest(ctx, "tested-region", "eu-rejected")).To(Succeed())
+			_, err = policyService.GetPolicyTest(ctx, "tested-region", "eu-rejected")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*service.ServiceError).Message).To(Equal("Policy test not found"))
+		})
+
+		It("should reject duplicate IDs, invalid test cases and unknown policies", func() {
+			_, err := policyService.CreatePolicyTest(ctx, "tested-region", newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationApproved), strPtr("dup"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = policyService.CreatePolicyTest(ctx, "tested-region", newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationApproved), strPtr("dup"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeAlreadyExists))
+
+			for _, test := range []v1alpha1.PolicyTest{
+				newTest(nil, v1alpha1.SimulationApproved),
+				newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationStatus("PASSED")),
+				newTest(map[string]any{"region": "eu-west-1"}, v1alpha1.SimulationApproved),
+			} {
+				_, err = policyService.CreatePolicyTest(ctx, "tested-region", test, nil)
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeInvalidArgument))
+			}
+
+			_, err = policyService.CreatePolicyTest(ctx, "missing", newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationApproved), nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*service.ServiceError).Message).To(Equal("Policy not found"))
+			_, err = policyService.RunPolicyTests(ctx, "missing")
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*service.ServiceError).Type).To(Equal(service.ErrorTypeNotFound))
+		})
+
+		It("should run test cases with the policy enabled and report mismatches", func() {
+			rejected := newTest(map[string]any{"service_type": "vm", "region": "eu-west-1"}, v1alpha1.SimulationRejected)
+			rejected.Expected.PolicyOutcome = outcomePtr(v1alpha1.DraftRejected)
+			rejected.Expected.Detail = strPtr("region not allowed")
+			_, err := policyService.CreatePolicyTest(ctx, "tested-region", rejected, strPtr("a-rejected"))
+			Expect(err).ToNot(HaveOccurred())
+
+			modified := newTest(map[string]any{"service_type": "vm", "region": "us-east-1"}, v1alpha1.SimulationModified)
+			modified.Expected.EvaluatedServiceInstance = &v1alpha1.SimulatedServiceInstance{Spec: map[string]any{"service_type": "vm", "region": "us-east-1", "zone": "a", "size": "small"}}
+			_, err = policyService.CreatePolicyTest(ctx, "tested-region", modified, strPtr("b-modified"))
+			Expect(err).ToNot(HaveOccurred())
+
+			wrong := newTest(map[string]any{"service_type": "vm", "region": "eu-west-1"}, v1alpha1.SimulationApproved)
+			wrong.Expected.EvaluatedServiceInstance = &v1alpha1.SimulatedServiceInstance{Spec: map[string]any{"service_type": "vm", "region": "eu-west-1"}}
+			_, err = policyService.CreatePolicyTest(ctx, "tested-region", wrong, strPtr("c-wrong"))
+			Expect(err).ToNot(HaveOccurred())
+
+			run, err := policyService.RunPolicyTests(ctx, "tested-region")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(run.Passed).To(Equal(int32(2)))
+			Expect(run.Failed).To(Equal(int32(1)))
+			Expect(run.Results).To(HaveLen(3))
+			Expect(run.Results[0].Passed).To(BeTrue())
+			Expect(run.Results[0].PolicyOutcome).To(Equal(v1alpha1.DraftRejected))
+			Expect(run.Results[1].Passed).To(BeTrue())
+			Expect(run.Results[1].Failures).To(BeEmpty())
+			Expect(run.Results[2].TestId).To(Equal("c-wrong"))
+			Expect(run.Results[2].Passed).To(BeFalse())
+			Expect(run.Results[2].Failures).To(Equal([]string{
+				"status is REJECTED, expected APPROVED",
+				"evaluated service instance differs from the expected one",
+			}))
+		})
+
+		It("should run no test cases of a policy without any", func() {
+			run, err := policyService.RunPolicyTests(ctx, "tested-size")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(run.Results).To(BeEmpty())
+			Expect(run.Passed).To(BeZero())
+		})
+
+		It("should delete test cases with their policy", func() {
+			_, err := policyService.CreatePolicyTest(ctx, "tested-size", newTest(map[string]any{"service_type": "vm"}, v1alpha1.SimulationModified), strPtr("kept"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(policyService.DeletePolicy(ctx, "tested-size")).To(Succeed())
+
+			tests, err := dataStore.PolicyTest().ListAll(ctx, "tested-size")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tests).To(BeEmpty())
+		})
+	})
+
+	Describe("policy locks", func() {
+		expectErrorType := func(err error, errorType service.ErrorType) {
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(errorType))
+		}
+
+		BeforeEach(func() {
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Edited"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package edited"),
+			}, strPtr("edited"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should report the lock in get and list until it is released", func() {
+			lock, err := policyService.LockPolicy(ctx, "edited", "alice", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lock.Owner).To(Equal("alice"))
+			Expect(lock.ExpireTime).To(BeTemporally("~", time.Now().Add(service.DefaultPolicyLockTTL), time.Minute))
+
+			policy, err := policyService.GetPolicy(ctx, "edited")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Lock).To(Equal(lock))
+			list, err := policyService.ListPolicies(ctx, nil, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Policies).To(HaveLen(1))
+			Expect(list.Policies[0].Lock).To(Equal(lock))
+
+			Expect(policyService.UnlockPolicy(ctx, "edited", "alice")).To(Succeed())
+			policy, err = policyService.GetPolicy(ctx, "edited")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Lock).To(BeNil())
+		})
+
+		It("should refuse the lock to another owner and let the owner extend it", func() {
+			_, err := policyService.LockPolicy(ctx, "edited", "alice", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = policyService.LockPolicy(ctx, "edited", "bob", nil)
+			expectErrorType(err, service.ErrorTypeAlreadyExists)
+			Expect(err.(*service.ServiceError).Detail).To(ContainSubstring("locked by 'alice'"))
+			expectErrorType(policyService.UnlockPolicy(ctx, "edited", "bob"), service.ErrorTypeAlreadyExists)
+
+			ttl := int32(3600)
+			lock, err := policyService.LockPolicy(ctx, "edited", "alice", &ttl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lock.ExpireTime).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("should not block updates while the policy is locked", func() {
+			_, err := policyService.LockPolicy(ctx, "edited", "alice", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = policyService.UpdatePolicy(ctx, "edited", &v1alpha1.Policy{DisplayName: strPtr("Edited by Bob")})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reject invalid lock requests", func() {
+			_, err := policyService.LockPolicy(ctx, "edited", " ", nil)
+			expectErrorType(err, service.ErrorTypeInvalidArgument)
+			ttl := int32(3601)
+			_, err = policyService.LockPolicy(ctx, "edited", "alice", &ttl)
+			expectErrorType(err, service.ErrorTypeInvalidArgument)
+			_, err = policyService.LockPolicy(ctx, "missing", "alice", nil)
+			expectErrorType(err, service.ErrorTypeNotFound)
+			expectErrorType(policyService.UnlockPolicy(ctx, "missing", "alice"), service.ErrorTypeNotFound)
+		})
+	})
+
+	Describe("DeletePolicy", func() {
+		It("should delete existing policy", func() {
+			clientID := "delete-test"
+			policy := v1alpha1.Policy{
+				DisplayName: strPtr("Test"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+			}
+			_, err := policyService.CreatePolicy(ctx, policy, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = policyService.DeletePolicy(ctx, "delete-test")
+
+			Expect(err).ToNot(HaveOccurred())
+
+			// Verify it's deleted
+			_, err = policyService.GetPolicy(ctx, "delete-test")
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
+			Expect(ok).To(BeTrue())
+			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+		})
+
+		It("should return NotFound error for non-existent policy", func() {
+			err := policyService.DeletePolicy(ctx, "non-existent")
+
+			Expect(err).To(HaveOccurred())
+			serviceErr, ok := err.(*service.ServiceError)
 			Expect(ok).To(BeTrue())
 			Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
 		})
 	})
+
+	Describe("events", func() {
+		var published []events.Event
+
+		BeforeEach(func() {
+			published = nil
+			bus := events.NewBus()
+			bus.Subscribe(func(_ context.Context, event events.Event) {
+				published = append(published, event)
+			})
+			policyService = service.NewPolicyService(dataStore, engine, service.WithPolicyEvents(bus))
+		})
+
+		It("should publish an event for each committed change", func() {
+			clientID := "events-test"
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Before"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test"),
+			}, &clientID)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = policyService.UpdatePolicy(ctx, clientID, &v1alpha1.Policy{DisplayName: strPtr("After")})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policyService.DeletePolicy(ctx, clientID)).To(Succeed())
+
+			Expect(published).To(HaveLen(3))
+			created, ok := published[0].(events.PolicyCreated)
+			Expect(ok).To(BeTrue())
+			Expect(*created.Policy.Id).To(Equal(clientID))
+			updated, ok := published[1].(events.PolicyUpdated)
+			Expect(ok).To(BeTrue())
+			Expect(*updated.Previous.DisplayName).To(Equal("Before"))
+			Expect(*updated.Policy.DisplayName).To(Equal("After"))
+			Expect(published[2]).To(Equal(events.PolicyDeleted{PolicyID: clientID}))
+		})
+
+		It("should not publish when a change fails", func() {
+			_, err := policyService.CreatePolicy(ctx, v1alpha1.Policy{
+				DisplayName: strPtr("Invalid"),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr("package test\nallow if {"),
+			}, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(policyService.DeletePolicy(ctx, "non-existent")).NotTo(Succeed())
+
+			Expect(published).To(BeEmpty())
+		})
+	})
 })
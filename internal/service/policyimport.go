@@ -0,0 +1,226 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"sigs.k8s.io/yaml"
+)
+
+// maxImportPolicies is the largest number of policies a single import may create
+const maxImportPolicies = 100
+
+// importManifestPart is the form name of the manifest part of a multipart import
+const importManifestPart = "manifest"
+
+// ReadPolicyImportForm reads a multipart policy import: the PolicyImportRequest of its
+// manifest part, as JSON or YAML, with the rego_code of every entry naming a rego_file read
+// from the file part of that filename. Files are bounded like bundle files.
+func ReadPolicyImportForm(form *multipart.Reader) (v1alpha1.PolicyImportRequest, error) {
+	invalid := func(format string, args ...any) error {
+		return NewInvalidArgumentError("Invalid import form", fmt.Sprintf(format, args...))
+	}
+
+	var manifest []byte
+	files := map[string]string{}
+	var total int64
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return v1alpha1.PolicyImportRequest{}, invalid("Failed to read the form: %v", err)
+		}
+		limit := int64(maxBundleFileSize)
+		if part.FormName() == importManifestPart {
+			limit = maxBundleSize
+		}
+		content, err := io.ReadAll(io.LimitReader(part, limit+1))
+		_ = part.Close()
+		if err != nil {
+			return v1alpha1.PolicyImportRequest{}, invalid("Failed to read part '%s': %v", part.FormName(), err)
+		}
+		if int64(len(content)) > limit {
+			return v1alpha1.PolicyImportRequest{}, invalid("Part '%s' exceeds the maximum size of %d bytes", part.FormName(), limit)
+		}
+		total += int64(len(content))
+		if total > maxBundleSize {
+			return v1alpha1.PolicyImportRequest{}, invalid("The form exceeds the maximum size of %d bytes", maxBundleSize)
+		}
+
+		if part.FormName() == importManifestPart {
+			if manifest != nil {
+				return v1alpha1.PolicyImportRequest{}, invalid("The form has more than one manifest part")
+			}
+			manifest = content
+			continue
+		}
+		name := part.FileName()
+		if name == "" {
+			return v1alpha1.PolicyImportRequest{}, invalid("Part '%s' is not a file", part.FormName())
+		}
+		if _, ok := files[name]; ok {
+			return v1alpha1.PolicyImportRequest{}, invalid("The form has more than one file named '%s'", name)
+		}
+		files[name] = string(content)
+	}
+	if manifest == nil {
+		return v1alpha1.PolicyImportRequest{}, invalid("The form has no %s part", importManifestPart)
+	}
+
+	var request v1alpha1.PolicyImportRequest
+	if err := yaml.Unmarshal(manifest, &request); err != nil {
+		return v1alpha1.PolicyImportRequest{}, invalid("The manifest is not a valid JSON or YAML import request: %v", err)
+	}
+	for i, entry := range request.Policies {
+		if entry.RegoFile == nil {
+			continue
+		}
+		if entry.Policy.RegoCode != nil {
+			return v1alpha1.PolicyImportRequest{}, invalid("policies[%d] sets both rego_code and rego_file", i)
+		}
+		regoCode, ok := files[*entry.RegoFile]
+		if !ok {
+			return v1alpha1.PolicyImportRequest{}, invalid("policies[%d].rego_file names no file of the form: '%s'", i, *entry.RegoFile)
+		}
+		request.Policies[i].Policy.RegoCode = &regoCode
+	}
+	return request, nil
+}
+
+// ImportPolicies creates the policies of request in order, each validated like on create.
+// Entries are created independently and their failures reported per entry. When atomic,
+// they are created in one transaction instead: when any entry fails, none is created.
+func (s *PolicyServiceImpl) ImportPolicies(ctx context.Context, request v1alpha1.PolicyImportRequest, atomic bool) (*v1alpha1.PolicyImportResult, error) {
+	switch {
+	case len(request.Policies) == 0:
+		return nil, NewInvalidArgumentError("Invalid import request", "policies must list at least one policy")
+	case len(request.Policies) > maxImportPolicies:
+		return nil, NewInvalidArgumentError("Invalid import request", fmt.Sprintf("policies must list at most %d policies", maxImportPolicies))
+	}
+
+	logging.FromContext(ctx).Debug("Importing policies", "policy_count", len(request.Policies), "atomic", atomic)
+	if atomic {
+		return s.importAtomically(ctx, request.Policies)
+	}
+
+	results := make([]v1alpha1.PolicyImportItem, len(request.Policies))
+	for i, entry := range request.Policies {
+		results[i] = v1alpha1.PolicyImportItem{Index: int32(i), PolicyId: entry.PolicyId}
+		created, err := s.CreatePolicy(ctx, entry.Policy, entry.PolicyId)
+		if err != nil {
+			failImportItem(ctx, &results[i], err)
+			continue
+		}
+		results[i].PolicyId = created.Id
+		results[i].Status = v1alpha1.PolicyImportCreated
+	}
+	return &v1alpha1.PolicyImportResult{Results: results}, nil
+}
+
+// importAtomically validates every entry against the stored policies and the entries before
+// it, then creates them all in one transaction and recompiles the engine once. When an entry
+// fails, it is reported as failed, the others as aborted, and nothing is stored.
+func (s *PolicyServiceImpl) importAtomically(ctx context.Context, entries []v1alpha1.PolicyImportEntry) (*v1alpha1.PolicyImportResult, error) {
+	log := logging.FromContext(ctx)
+	results := make([]v1alpha1.PolicyImportItem, len(entries))
+	pending := make(model.PolicyList, 0, len(entries))
+	failed := false
+	for i, entry := range entries {
+		results[i] = v1alpha1.PolicyImportItem{Index: int32(i), PolicyId: entry.PolicyId, Status: v1alpha1.PolicyImportAborted}
+		dbPolicy, err := s.prepareImport(ctx, entry, pending)
+		if err != nil {
+			failImportItem(ctx, &results[i], err)
+			failed = true
+			continue
+		}
+		results[i].PolicyId = &dbPolicy.ID
+		pending = append(pending, dbPolicy)
+	}
+	if failed {
+		return &v1alpha1.PolicyImportResult{Results: results}, nil
+	}
+
+	created, err := s.store.Policy().CreateAll(ctx, pending)
+	if err != nil {
+		// Another request may have taken an ID, display name or priority since validation
+		var batchErr *store.BatchError
+		if !errors.As(err, &batchErr) || !isUniqueConstraintError(batchErr.Err) {
+			log.Error("Failed to create imported policies in store", "error", err)
+			return nil, NewInternalError("Failed to import policies", err.Error(), err)
+		}
+		failImportItem(ctx, &results[batchErr.Index], processPolicyStoreError(batchErr.Err, pending[batchErr.Index], "create"))
+		return &v1alpha1.PolicyImportResult{Results: results}, nil
+	}
+
+	if err := s.recompileEngine(ctx); err != nil {
+		log.Error("Failed to recompile engine after import, rolling back DB", "error", err)
+		for _, p := range created {
+			if delErr := s.store.Policy().Discard(ctx, p.ID); delErr != nil {
+				log.Error("Failed to rollback DB policy after compile failure",
+					"policy_id", p.ID,
+					"db_error", delErr,
+					"compile_error", err)
+			}
+		}
+		return nil, NewInternalError("Failed to compile policies after import", err.Error(), err)
+	}
+
+	for i := range created {
+		s.events.Publish(ctx, events.PolicyCreated{Policy: DBToAPIModel(&created[i])})
+		results[i].Status = v1alpha1.PolicyImportCreated
+	}
+	log.Debug("Policies imported atomically", "policy_count", len(created))
+	return &v1alpha1.PolicyImportResult{Results: results}, nil
+}
+
+// prepareImport validates entry for creation like CreatePolicy, as if the pending policies
+// before it were stored, and returns the policy to store
+func (s *PolicyServiceImpl) prepareImport(ctx context.Context, entry v1alpha1.PolicyImportEntry, pending model.PolicyList) (model.Policy, error) {
+	dbPolicy, err := s.prepareCreate(ctx, entry.Policy, entry.PolicyId, pending)
+	if err != nil {
+		return model.Policy{}, err
+	}
+	for _, p := range pending {
+		switch {
+		case p.ID == dbPolicy.ID:
+			return model.Policy{}, NewPolicyAlreadyExistsError(dbPolicy.ID)
+		case p.PolicyType == dbPolicy.PolicyType && p.DisplayName == dbPolicy.DisplayName:
+			return model.Policy{}, NewPolicyDisplayNamePolicyTypeTakenError(dbPolicy.DisplayName, v1alpha1.PolicyPolicyType(dbPolicy.PolicyType))
+		case p.PolicyType == dbPolicy.PolicyType && p.Priority == dbPolicy.Priority:
+			return model.Policy{}, NewPolicyPriorityPolicyTypeTakenError(dbPolicy.Priority, v1alpha1.PolicyPolicyType(dbPolicy.PolicyType))
+		}
+	}
+	if err := s.checkUnique(ctx, dbPolicy, false, "create"); err != nil {
+		return model.Policy{}, err
+	}
+	return dbPolicy, nil
+}
+
+// isUniqueConstraintError reports whether err is a store error of a taken ID, display name or priority
+func isUniqueConstraintError(err error) bool {
+	return errors.Is(err, store.ErrPolicyIDTaken) ||
+		errors.Is(err, store.ErrDisplayNamePolicyTypeTaken) ||
+		errors.Is(err, store.ErrPriorityPolicyTypeTaken)
+}
+
+// failImportItem marks item as failed with the detail of err
+func failImportItem(ctx context.Context, item *v1alpha1.PolicyImportItem, err error) {
+	detail := err.Error()
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		detail = serviceErr.Detail
+	}
+	logging.FromContext(ctx).Debug("Policy import entry failed", "index", item.Index, "error", err)
+	item.Status = v1alpha1.PolicyImportFailed
+	item.Detail = &detail
+}
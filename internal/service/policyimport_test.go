@@ -0,0 +1,229 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// errorDetail returns the detail of the service error err
+func errorDetail(err error) string {
+	Expect(err).To(BeAssignableToTypeOf(&service.ServiceError{}))
+	return err.(*service.ServiceError).Detail
+}
+
+var _ = Describe("PolicyService ImportPolicies", func() {
+	var (
+		db            *gorm.DB
+		dataStore     store.Store
+		bus           *events.Bus
+		policyService *service.PolicyServiceImpl
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		dataStore = store.NewStore(db)
+		bus = events.NewBus()
+		policyService = service.NewPolicyService(dataStore, opa.NewEngine(), service.WithPolicyEvents(bus))
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	entry := func(id, regoCode string) v1alpha1.PolicyImportEntry {
+		return v1alpha1.PolicyImportEntry{
+			PolicyId: strPtr(id),
+			Policy: v1alpha1.Policy{
+				DisplayName: strPtr(id),
+				PolicyType:  policyTypePtr(v1alpha1.GLOBAL),
+				RegoCode:    strPtr(regoCode),
+			},
+		}
+	}
+
+	validEntry := func(id string, priority int32) v1alpha1.PolicyImportEntry {
+		e := entry(id, fmt.Sprintf("package import_%d\nmain := {\"rejected\": false}", priority))
+		e.Policy.Priority = &priority
+		return e
+	}
+
+	statuses := func(result *v1alpha1.PolicyImportResult) []v1alpha1.PolicyImportItemStatus {
+		var out []v1alpha1.PolicyImportItemStatus
+		for _, item := range result.Results {
+			out = append(out, item.Status)
+		}
+		return out
+	}
+
+	storedIDs := func() []string {
+		policies, err := dataStore.Policy().ListAll(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		ids := []string{}
+		for _, p := range policies {
+			ids = append(ids, p.ID)
+		}
+		return ids
+	}
+
+	It("creates every entry independently, reporting the ones that fail", func() {
+		generated := validEntry("generated", 300)
+		generated.PolicyId = nil
+		result, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: []v1alpha1.PolicyImportEntry{
+			validEntry("first", 100),
+			entry("broken", "package broken\nmain := {"),
+			generated,
+		}}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(statuses(result)).To(Equal([]v1alpha1.PolicyImportItemStatus{
+			v1alpha1.PolicyImportCreated, v1alpha1.PolicyImportFailed, v1alpha1.PolicyImportCreated,
+		}))
+		Expect(result.Results[1].Index).To(Equal(int32(1)))
+		Expect(*result.Results[1].PolicyId).To(Equal("broken"))
+		Expect(result.Results[1].Detail).NotTo(BeNil())
+		Expect(result.Results[2].PolicyId).NotTo(BeNil())
+		Expect(storedIDs()).To(ConsistOf("first", *result.Results[2].PolicyId))
+	})
+
+	Context("when atomic", func() {
+		It("creates every entry and recompiles the engine once", func() {
+			var created []string
+			events.Subscribe(bus, func(_ context.Context, event events.PolicyCreated) {
+				created = append(created, *event.Policy.Id)
+			})
+
+			result, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: []v1alpha1.PolicyImportEntry{
+				validEntry("first", 100),
+				validEntry("second", 200),
+			}}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statuses(result)).To(Equal([]v1alpha1.PolicyImportItemStatus{v1alpha1.PolicyImportCreated, v1alpha1.PolicyImportCreated}))
+			Expect(storedIDs()).To(ConsistOf("first", "second"))
+			Expect(created).To(Equal([]string{"first", "second"}))
+			Expect(policyService.EngineStatus().CompiledPolicies).To(Equal(2))
+		})
+
+		It("creates nothing when an entry fails, aborting the others", func() {
+			_, err := policyService.CreatePolicy(ctx, validEntry("taken", 900).Policy, strPtr("taken"))
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: []v1alpha1.PolicyImportEntry{
+				validEntry("first", 100),
+				validEntry("taken", 200),
+				validEntry("third", 300),
+			}}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statuses(result)).To(Equal([]v1alpha1.PolicyImportItemStatus{
+				v1alpha1.PolicyImportAborted, v1alpha1.PolicyImportFailed, v1alpha1.PolicyImportAborted,
+			}))
+			Expect(*result.Results[1].Detail).To(ContainSubstring("taken"))
+			Expect(storedIDs()).To(ConsistOf("taken"))
+		})
+
+		It("checks the uniqueness of each entry against the entries before it", func() {
+			duplicate := validEntry("second", 200)
+			duplicate.Policy.DisplayName = strPtr("first")
+
+			result, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: []v1alpha1.PolicyImportEntry{
+				validEntry("first", 100),
+				duplicate,
+			}}, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statuses(result)).To(Equal([]v1alpha1.PolicyImportItemStatus{v1alpha1.PolicyImportAborted, v1alpha1.PolicyImportFailed}))
+			Expect(storedIDs()).To(BeEmpty())
+		})
+
+		It("picks distinct default priorities for the entries", func() {
+			policyService = service.NewPolicyService(dataStore, opa.NewEngine(),
+				service.WithPolicyDefaults(service.PolicyDefaults{Enabled: true, PriorityStrategy: service.PriorityNextAvailable}))
+			first, second := validEntry("first", 0), validEntry("second", 0)
+			first.Policy.Priority, second.Policy.Priority = nil, nil
+
+			result, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: []v1alpha1.PolicyImportEntry{first, second}}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(statuses(result)).To(Equal([]v1alpha1.PolicyImportItemStatus{v1alpha1.PolicyImportCreated, v1alpha1.PolicyImportCreated}))
+
+			policies, err := dataStore.Policy().ListAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect([]int32{policies[0].Priority, policies[1].Priority}).To(ConsistOf(int32(500), int32(501)))
+		})
+	})
+
+	It("rejects an empty or oversized request", func() {
+		_, err := policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{}, false)
+		Expect(errorDetail(err)).To(ContainSubstring("at least one policy"))
+
+		entries := make([]v1alpha1.PolicyImportEntry, 101)
+		_, err = policyService.ImportPolicies(ctx, v1alpha1.PolicyImportRequest{Policies: entries}, false)
+		Expect(errorDetail(err)).To(ContainSubstring("at most 100 policies"))
+	})
+})
+
+var _ = Describe("ReadPolicyImportForm", func() {
+	form := func(manifest string, files map[string]string) *multipart.Reader {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		Expect(writer.WriteField("manifest", manifest)).To(Succeed())
+		for name, content := range files {
+			part, err := writer.CreateFormFile("files", name)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = part.Write([]byte(content))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(writer.Close()).To(Succeed())
+		return multipart.NewReader(body, writer.Boundary())
+	}
+
+	It("reads the rego_code of each entry from the file its rego_file names", func() {
+		request, err := service.ReadPolicyImportForm(form(`
+policies:
+  - policy_id: region
+    rego_file: region.rego
+    policy:
+      display_name: Region
+      policy_type: GLOBAL
+`, map[string]string{"region.rego": "package region"}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.Policies).To(HaveLen(1))
+		Expect(*request.Policies[0].PolicyId).To(Equal("region"))
+		Expect(*request.Policies[0].Policy.RegoCode).To(Equal("package region"))
+	})
+
+	It("rejects a rego_file that names no file of the form", func() {
+		_, err := service.ReadPolicyImportForm(form(`{"policies": [{"rego_file": "missing.rego", "policy": {}}]}`, nil))
+		Expect(errorDetail(err)).To(ContainSubstring("missing.rego"))
+	})
+
+	It("rejects a form without a manifest", func() {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		Expect(writer.Close()).To(Succeed())
+		_, err := service.ReadPolicyImportForm(multipart.NewReader(body, writer.Boundary()))
+		Expect(errorDetail(err)).To(ContainSubstring("no manifest part"))
+	})
+})
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+const (
+	// DefaultPolicyLockTTL is how long a policy lock is held when the request sets no TTL
+	DefaultPolicyLockTTL = 5 * time.Minute
+	// MaxPolicyLockTTL is the longest a policy lock can be held without extending it
+	MaxPolicyLockTTL = time.Hour
+)
+
+// LockPolicy takes the advisory edit lock on policy id for owner, for ttlSeconds or
+// DefaultPolicyLockTTL. Locking again as the owner extends the lock; while another owner
+// holds an unexpired lock it fails with an AlreadyExists error naming the holder. Locks do
+// not block updates; they are reported by GetPolicy and ListPolicies so editors can be
+// warned.
+func (s *PolicyServiceImpl) LockPolicy(ctx context.Context, id, owner string, ttlSeconds *int32) (*v1alpha1.PolicyLock, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("Locking policy", "policy_id", id, "owner", owner)
+
+	if err := validateLockOwner(owner); err != nil {
+		return nil, err
+	}
+	ttl := DefaultPolicyLockTTL
+	if ttlSeconds != nil {
+		ttl = time.Duration(*ttlSeconds) * time.Second
+		if ttl <= 0 || ttl > MaxPolicyLockTTL {
+			return nil, NewInvalidArgumentError("Invalid ttl_seconds",
+				fmt.Sprintf("ttl_seconds must be between 1 and %d (got %d)", int(MaxPolicyLockTTL/time.Second), *ttlSeconds))
+		}
+	}
+	if err := s.checkPolicyExists(ctx, id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	lock, err := s.store.PolicyLock().Acquire(ctx, model.PolicyLock{PolicyID: id, Owner: owner, ExpireTime: now.Add(ttl)}, now)
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyLockHeld) {
+			return nil, s.policyLockedError(ctx, id, now)
+		}
+		log.Error("Failed to lock policy", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to lock policy", err.Error(), err)
+	}
+
+	log.Info("Policy locked", "policy_id", id, "owner", owner, "expire_time", lock.ExpireTime)
+	return policyLockDBToAPI(*lock), nil
+}
+
+// UnlockPolicy releases the lock owner holds on policy id. It succeeds when the policy is not
+// locked, and fails with an AlreadyExists error while another owner holds the lock.
+func (s *PolicyServiceImpl) UnlockPolicy(ctx context.Context, id, owner string) error {
+	log := logging.FromContext(ctx)
+	log.Debug("Unlocking policy", "policy_id", id, "owner", owner)
+
+	if err := validateLockOwner(owner); err != nil {
+		return err
+	}
+	if err := s.checkPolicyExists(ctx, id); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err := s.store.PolicyLock().Release(ctx, id, owner, now); err != nil {
+		if errors.Is(err, store.ErrPolicyLockHeld) {
+			return s.policyLockedError(ctx, id, now)
+		}
+		log.Error("Failed to unlock policy", "policy_id", id, "error", err)
+		return NewInternalError("Failed to unlock policy", err.Error(), err)
+	}
+
+	log.Info("Policy unlocked", "policy_id", id, "owner", owner)
+	return nil
+}
+
+func validateLockOwner(owner string) error {
+	if strings.TrimSpace(owner) == "" {
+		return NewInvalidArgumentError("owner is required", "The owner field must be present and non-empty")
+	}
+	return nil
+}
+
+func (s *PolicyServiceImpl) checkPolicyExists(ctx context.Context, id string) error {
+	if _, err := s.store.Policy().Get(ctx, id); err != nil {
+		if errors.Is(err, store.ErrPolicyNotFound) {
+			return NewPolicyNotFoundError(id)
+		}
+		logging.FromContext(ctx).Error("Failed to get policy", "policy_id", id, "error", err)
+		return NewInternalError("Failed to get policy", err.Error(), err)
+	}
+	return nil
+}
+
+// policyLockedError describes the lock that kept a lock or unlock of policy id from
+// succeeding. The holder is left out when it can no longer be read.
+func (s *PolicyServiceImpl) policyLockedError(ctx context.Context, id string, now time.Time) *ServiceError {
+	holder, err := s.store.PolicyLock().Get(ctx, id, now)
+	if err != nil {
+		holder = nil
+	}
+	return NewPolicyLockedError(id, holder)
+}
+
+// attachPolicyLocks sets the lock of each policy that is locked now. Failing to read the
+// locks is logged rather than failing the read, since locks are advisory.
+func (s *PolicyServiceImpl) attachPolicyLocks(ctx context.Context, policies []v1alpha1.Policy) {
+	ids := make([]string, len(policies))
+	for i, p := range policies {
+		ids[i] = *p.Id
+	}
+	locks, err := s.store.PolicyLock().ListActive(ctx, ids, time.Now().UTC())
+	if err != nil {
+		logging.FromContext(ctx).Warn("Failed to read policy locks", "error", err)
+		return
+	}
+	for i := range policies {
+		if lock, ok := locks[*policies[i].Id]; ok {
+			policies[i].Lock = policyLockDBToAPI(lock)
+		}
+	}
+}
+
+func policyLockDBToAPI(lock model.PolicyLock) *v1alpha1.PolicyLock {
+	return &v1alpha1.PolicyLock{Owner: lock.Owner, ExpireTime: lock.ExpireTime}
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
+	"github.com/google/uuid"
+)
+
+const maxPolicyTestDisplayNameLength = 256
+
+// CreatePolicyTest stores a test case of policy id under testID, or a generated ID when
+// testID is nil.
+func (s *PolicyServiceImpl) CreatePolicyTest(ctx context.Context, id string, test v1alpha1.PolicyTest, testID *string) (*v1alpha1.PolicyTest, error) {
+	log := logging.FromContext(ctx)
+
+	if err := validatePolicyTest(test); err != nil {
+		return nil, err
+	}
+	newID := uuid.New().String()
+	if testID != nil && *testID != "" {
+		if !idPattern.MatchString(*testID) {
+			return nil, NewInvalidArgumentError(
+				"Invalid test ID format",
+				fmt.Sprintf("Test ID '%s' does not match required format: 1-63 characters, start with lowercase letter, contain only lowercase letters, numbers, and hyphens, end with letter or number", *testID),
+			)
+		}
+		newID = *testID
+	}
+	if _, err := s.getPolicyForTests(ctx, id); err != nil {
+		return nil, err
+	}
+
+	created, err := s.store.PolicyTest().Create(ctx, PolicyTestAPIToDBModel(test, id, newID))
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyTestIDTaken) {
+			return nil, NewAlreadyExistsError("Policy test already exists", fmt.Sprintf("Policy '%s' already has a test '%s'", id, newID))
+		}
+		log.Error("Failed to create policy test in store", "policy_id", id, "test_id", newID, "error", err)
+		return nil, NewInternalError("Failed to create policy test", err.Error(), err)
+	}
+
+	log.Info("Policy test created", "policy_id", id, "test_id", newID)
+	apiTest := PolicyTestToAPIModel(created)
+	return &apiTest, nil
+}
+
+// validatePolicyTest checks that a test case has a service instance spec that can be
+// evaluated and a known expected status and policy outcome
+func validatePolicyTest(test v1alpha1.PolicyTest) error {
+	if test.ServiceInstance.Spec == nil {
+		return NewInvalidArgumentError("service_instance is required", "The service_instance.spec field must be present")
+	}
+	if _, err := ExtractRequestLabels(test.ServiceInstance.Spec); err != nil {
+		return NewInvalidArgumentError("Invalid service instance spec", err.Error())
+	}
+	if !test.Expected.Status.Valid() {
+		return NewInvalidArgumentError(
+			"Invalid expected status",
+			fmt.Sprintf("expected.status must be APPROVED, MODIFIED, REJECTED or FAILED (got '%s')", test.Expected.Status),
+		)
+	}
+	if test.Expected.PolicyOutcome != nil && !test.Expected.PolicyOutcome.Valid() {
+		return NewInvalidArgumentError(
+			"Invalid expected policy outcome",
+			fmt.Sprintf("expected.policy_outcome '%s' is not a policy outcome", *test.Expected.PolicyOutcome),
+		)
+	}
+	if test.DisplayName != nil && len(*test.DisplayName) > maxPolicyTestDisplayNameLength {
+		return NewInvalidArgumentError(
+			"Invalid display_name",
+			fmt.Sprintf("display_name must be at most %d bytes", maxPolicyTestDisplayNameLength),
+		)
+	}
+	return nil
+}
+
+// ListPolicyTests lists the test cases of a policy, oldest first.
+func (s *PolicyServiceImpl) ListPolicyTests(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyTestList, error) {
+	size, err := s.pages.parsePageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	scope := pagetoken.Scope("tests", id)
+	offset, err := decodePageToken(s.tokens, pageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getPolicyForTests(ctx, id); err != nil {
+		return nil, err
+	}
+
+	result, err := s.store.PolicyTest().List(ctx, id, &store.PolicyTestListOptions{Offset: offset, PageSize: size})
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list policy tests from store", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to list policy tests", err.Error(), err)
+	}
+
+	tests := make([]v1alpha1.PolicyTest, len(result.Tests))
+	for i, test := range result.Tests {
+		tests[i] = PolicyTestToAPIModel(&test)
+	}
+	response := &v1alpha1.PolicyTestList{Tests: tests, PageSize: int32(size)}
+	if result.NextOffset > 0 {
+		nextPageToken := s.tokens.Encode(result.NextOffset, scope)
+		response.NextPageToken = &nextPageToken
+	}
+	return response, nil
+}
+
+// GetPolicyTest retrieves a test case of a policy.
+func (s *PolicyServiceImpl) GetPolicyTest(ctx context.Context, id, testID string) (*v1alpha1.PolicyTest, error) {
+	test, err := s.store.PolicyTest().Get(ctx, id, testID)
+	if err != nil {
+		return nil, s.policyTestStoreError(ctx, err, id, testID, "get")
+	}
+	apiTest := PolicyTestToAPIModel(test)
+	return &apiTest, nil
+}
+
+// DeletePolicyTest deletes a test case of a policy.
+func (s *PolicyServiceImpl) DeletePolicyTest(ctx context.Context, id, testID string) error {
+	if err := s.store.PolicyTest().Delete(ctx, id, testID); err != nil {
+		return s.policyTestStoreError(ctx, err, id, testID, "delete")
+	}
+	logging.FromContext(ctx).Info("Policy test deleted", "policy_id", id, "test_id", testID)
+	return nil
+}
+
+// policyTestStoreError maps an error getting or deleting a test case to a service error.
+// Test cases exist as long as their policy; without one, it reports whichever is missing.
+func (s *PolicyServiceImpl) policyTestStoreError(ctx context.Context, err error, id, testID, operation string) *ServiceError {
+	if !errors.Is(err, store.ErrPolicyTestNotFound) {
+		logging.FromContext(ctx).Error("Failed to "+operation+" policy test in store", "policy_id", id, "test_id", testID, "error", err)
+		return NewInternalError(fmt.Sprintf("Failed to %s policy test", operation), err.Error(), err)
+	}
+	if _, getErr := s.store.Policy().Get(ctx, id); errors.Is(getErr, store.ErrPolicyNotFound) {
+		return NewPolicyNotFoundError(id)
+	}
+	return NewPolicyTestNotFoundError(id, testID)
+}
+
+// RunPolicyTests evaluates the test cases of policy id as dry runs with the policy installed
+// at its priority, enabled, among the enabled stored policies, and compares each decision
+// with the expected one. Failing test cases are reported in the result rather than returned.
+func (s *PolicyServiceImpl) RunPolicyTests(ctx context.Context, id string) (*v1alpha1.PolicyTestRun, error) {
+	log := logging.FromContext(ctx)
+
+	policy, err := s.getPolicyForTests(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tests, err := s.store.PolicyTest().ListAll(ctx, id)
+	if err != nil {
+		log.Error("Failed to list policy tests from store", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to list policy tests", err.Error(), err)
+	}
+
+	run := &v1alpha1.PolicyTestRun{PolicyId: id, Results: []v1alpha1.PolicyTestResult{}}
+	if len(tests) == 0 {
+		return run, nil
+	}
+	sim, err := s.newDraftSimulation(ctx, &id, DBToAPIModel(policy))
+	if err != nil {
+		return nil, err
+	}
+	if err := sim.compile(ctx); err != nil {
+		return nil, handleEngineError(err, "run tests of")
+	}
+
+	for _, test := range tests {
+		simulation, err := sim.run(ctx, test.Spec)
+		if err != nil {
+			return nil, err
+		}
+		result := policyTestResult(test, simulation)
+		if result.Passed {
+			run.Passed++
+		} else {
+			run.Failed++
+		}
+		run.Results = append(run.Results, result)
+	}
+
+	log.Debug("Policy tests run", "policy_id", id, "passed", run.Passed, "failed", run.Failed)
+	return run, nil
+}
+
+// getPolicyForTests returns the stored policy whose test cases are accessed
+func (s *PolicyServiceImpl) getPolicyForTests(ctx context.Context, id string) (*model.Policy, error) {
+	policy, err := s.store.Policy().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyNotFound) {
+			return nil, NewPolicyNotFoundError(id)
+		}
+		logging.FromContext(ctx).Error("Failed to get policy from store", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to get policy", err.Error(), err)
+	}
+	return policy, nil
+}
+
+// policyTestResult compares the simulated decision for a test case with the expected one
+func policyTestResult(test model.PolicyTest, simulation *v1alpha1.PolicySimulation) v1alpha1.PolicyTestResult {
+	result := v1alpha1.PolicyTestResult{
+		TestId:                   test.ID,
+		Status:                   simulation.Status,
+		PolicyOutcome:            simulation.Draft.Outcome,
+		EvaluatedServiceInstance: simulation.EvaluatedServiceInstance,
+		Detail:                   simulation.Detail,
+		Failures:                 []string{},
+	}
+	expected := test.Expected
+	if string(result.Status) != expected.Status {
+		result.Failures = append(result.Failures, fmt.Sprintf("status is %s, expected %s", result.Status, expected.Status))
+	}
+	if expected.PolicyOutcome != "" && string(result.PolicyOutcome) != expected.PolicyOutcome {
+		result.Failures = append(result.Failures, fmt.Sprintf("policy outcome is %s, expected %s", result.PolicyOutcome, expected.PolicyOutcome))
+	}
+	if expected.EvaluatedSpec != nil {
+		var evaluated map[string]any
+		if result.EvaluatedServiceInstance != nil {
+			evaluated = result.EvaluatedServiceInstance.Spec
+		}
+		if !specmerge.Equal(evaluated, expected.EvaluatedSpec) {
+			result.Failures = append(result.Failures, "evaluated service instance differs from the expected one")
+		}
+	}
+	if expected.Detail != "" {
+		detail := ""
+		if result.Detail != nil {
+			detail = *result.Detail
+		}
+		if detail != expected.Detail {
+			result.Failures = append(result.Failures, fmt.Sprintf("detail is '%s', expected '%s'", detail, expected.Detail))
+		}
+	}
+	result.Passed = len(result.Failures) == 0
+	return result
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+)
+
+const (
+	// maxPreferredProviders is the largest number of preferred providers a request may list
+	maxPreferredProviders = 16
+	// maxPreferredProviderLength is the longest name a preferred provider may have
+	maxPreferredProviderLength = 256
+)
+
+// validatePreferredProviders returns an InvalidArgument error when the preferred providers of a
+// request are too many, or one is empty, too long or listed twice
+func validatePreferredProviders(providers []string) error {
+	invalid := func(format string, args ...any) error {
+		return NewInvalidArgumentError("Invalid evaluation request", fmt.Sprintf(format, args...))
+	}
+	if len(providers) > maxPreferredProviders {
+		return invalid("preferred_providers must list at most %d providers", maxPreferredProviders)
+	}
+	seen := make(map[string]bool, len(providers))
+	for i, provider := range providers {
+		switch {
+		case provider == "":
+			return invalid("preferred_providers[%d] must not be empty", i)
+		case len(provider) > maxPreferredProviderLength:
+			return invalid("preferred_providers[%d] must be at most %d bytes", i, maxPreferredProviderLength)
+		case seen[provider]:
+			return invalid("preferred_providers lists '%s' more than once", provider)
+		}
+		seen[provider] = true
+	}
+	return nil
+}
+
+// selectPreferredProvider selects the first preferred provider of the request the accumulated
+// service provider constraints allow when no policy selected a provider. The preference never
+// overrides a policy's selection; when the constraints rule out every preferred provider, none
+// is selected.
+func selectPreferredProvider(ctx context.Context, state *evaluationState) {
+	if state.selectedProvider != "" {
+		return
+	}
+	for _, provider := range state.preferredProviders {
+		if state.constraints.ValidateServiceProvider(provider) == nil {
+			logging.FromContext(ctx).Debug("Selected the preferred provider", "provider", provider)
+			state.selectedProvider = provider
+			return
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxInstanceIDLength is the longest instance ID an evaluation request may name
+const maxInstanceIDLength = 256
+
+// PreviousEvaluation is what an earlier evaluation decided for an existing service instance
+type PreviousEvaluation struct {
+	Spec             map[string]any // the evaluated spec the instance was provisioned with
+	SelectedProvider string         // empty when no policy selected one
+}
+
+// InstanceProvider looks up the previous evaluation of existing service instances, so
+// policies re-evaluating an instance can compare the request with what it was created with
+type InstanceProvider interface {
+	// PreviousEvaluation returns the previous evaluation of the instance, or nil when the
+	// instance is not known, as before it is first provisioned
+	PreviousEvaluation(ctx context.Context, instanceID string) (*PreviousEvaluation, error)
+}
+
+// WithInstanceProvider passes policies the previous evaluation of the instance a request
+// names, as input.previous (input.context.previous in the namespaced layout)
+func WithInstanceProvider(provider InstanceProvider) EvaluationOption {
+	return func(s *evaluationService) {
+		s.instances = provider
+	}
+}
+
+// previousInput returns the previous evaluation of the instance req names as it is passed
+// to policies, or nil when the request names none, no provider is configured or the
+// instance is not known. A failed lookup is an internal error rather than Unavailable, so it
+// never fails open: callers choose the instance, and policies enforcing what may not change
+// must not pass for want of the previous state.
+func (s *evaluationService) previousInput(ctx context.Context, req *EvaluationRequest) (map[string]any, error) {
+	if len(req.InstanceID) > maxInstanceIDLength {
+		return nil, NewInvalidArgumentError(
+			"Invalid evaluation request",
+			fmt.Sprintf("instance_id must be at most %d bytes", maxInstanceIDLength),
+		)
+	}
+	if req.InstanceID == "" || s.instances == nil {
+		return nil, nil
+	}
+	previous, err := s.instances.PreviousEvaluation(ctx, req.InstanceID)
+	if err != nil {
+		return nil, NewInternalError("Failed to look up the previous evaluation of the instance", err.Error(), err)
+	}
+	if previous == nil {
+		return nil, nil
+	}
+	spec := previous.Spec
+	if spec == nil {
+		spec = map[string]any{}
+	}
+	return map[string]any{"spec": spec, "provider": previous.SelectedProvider}, nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import "context"
+
+// principalKey is the context key of the principal making a request
+type principalKey struct{}
+
+// ContextWithPrincipal returns ctx carrying the principal making the request, recorded as
+// the creator or updater of the policies it changes
+func ContextWithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal making the request, or the empty string when
+// none is known
+func PrincipalFromContext(ctx context.Context) string {
+	principal, _ := ctx.Value(principalKey{}).(string)
+	return principal
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// readinessPingTimeout bounds the database ping of a readiness check, so a hung database
+// fails the check well within a probe's timeout
+const readinessPingTimeout = 2 * time.Second
+
+// Dependencies checked by CheckReadiness, in the order they are reported
+const (
+	DependencyDatabase     = "database"
+	DependencyPolicyEngine = "policy_engine"
+	DependencyPolicySync   = "policy_sync"
+)
+
+// DependencyStatus is the result of checking one dependency
+type DependencyStatus struct {
+	Name  string
+	Ready bool
+	// Message is why the dependency is not ready; empty when it is
+	Message string
+}
+
+// Readiness is the result of a readiness check
+type Readiness struct {
+	Dependencies []DependencyStatus
+}
+
+// Ready reports whether every dependency is ready
+func (r Readiness) Ready() bool {
+	for _, dependency := range r.Dependencies {
+		if !dependency.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckReadiness checks that the database answers, that the last engine reconciliation, if
+// any, succeeded and that CompileAll compiled the stored policies into the engine
+func (s *PolicyServiceImpl) CheckReadiness(ctx context.Context) Readiness {
+	database := DependencyStatus{Name: DependencyDatabase, Ready: true}
+	pingCtx, cancel := context.WithTimeout(ctx, readinessPingTimeout)
+	defer cancel()
+	if err := s.store.Ping(pingCtx); err != nil {
+		database = DependencyStatus{Name: DependencyDatabase, Message: "ping failed: " + err.Error()}
+	}
+
+	engine := DependencyStatus{Name: DependencyPolicyEngine, Ready: true}
+	if status := s.EngineStatus(); !status.Healthy() {
+		engine = DependencyStatus{Name: DependencyPolicyEngine, Message: "last reconciliation failed: " + status.LastReconcileError}
+	}
+
+	sync := DependencyStatus{Name: DependencyPolicySync, Ready: true}
+	if !s.compiled.Load() {
+		sync = DependencyStatus{Name: DependencyPolicySync, Message: "the stored policies are not compiled yet"}
+	}
+
+	return Readiness{Dependencies: []DependencyStatus{database, engine, sync}}
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("CheckReadiness", func() {
+	var (
+		ctx           context.Context
+		db            *gorm.DB
+		policyService *service.PolicyServiceImpl
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		DeferCleanup(func() {
+			sqlDB, _ := db.DB()
+			_ = sqlDB.Close()
+		})
+
+		ctx = context.Background()
+		policyService = service.NewPolicyService(store.NewStore(db), opa.NewEngine())
+	})
+
+	It("is not ready until the stored policies are compiled", func() {
+		readiness := policyService.CheckReadiness(ctx)
+
+		Expect(readiness.Ready()).To(BeFalse())
+		Expect(readiness.Dependencies).To(Equal([]service.DependencyStatus{
+			{Name: service.DependencyDatabase, Ready: true},
+			{Name: service.DependencyPolicyEngine, Ready: true},
+			{Name: service.DependencyPolicySync, Message: "the stored policies are not compiled yet"},
+		}))
+
+		Expect(policyService.CompileAll(ctx)).To(Succeed())
+		Expect(policyService.CheckReadiness(ctx).Ready()).To(BeTrue())
+	})
+
+	It("reports the database and the engine when the database stops answering", func() {
+		Expect(policyService.CompileAll(ctx)).To(Succeed())
+		sqlDB, err := db.DB()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sqlDB.Close()).To(Succeed())
+		_, err = policyService.ReconcileEngine(ctx)
+		Expect(err).To(HaveOccurred())
+
+		readiness := policyService.CheckReadiness(ctx)
+
+		Expect(readiness.Ready()).To(BeFalse())
+		Expect(readiness.Dependencies).To(HaveLen(3))
+		Expect(readiness.Dependencies[0].Ready).To(BeFalse())
+		Expect(readiness.Dependencies[0].Message).To(HavePrefix("ping failed: "))
+		Expect(readiness.Dependencies[1].Ready).To(BeFalse())
+		Expect(readiness.Dependencies[1].Message).To(ContainSubstring("failed to list policies"))
+		Expect(readiness.Dependencies[2].Ready).To(BeTrue())
+	})
+})
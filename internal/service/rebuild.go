@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/google/uuid"
+)
+
+// maxEngineRebuilds is the number of rebuilds kept for GetEngineRebuild
+const maxEngineRebuilds = 10
+
+// engineRebuilds holds the last rebuilds of the engine, oldest first
+type engineRebuilds struct {
+	mu         sync.Mutex
+	operations []*v1alpha1.EngineRebuild
+}
+
+// RebuildEngine starts discarding the compiled state of the engine and compiling every
+// stored policy again, and returns the rebuild without waiting for it. While a rebuild
+// runs, the running one is returned instead of starting another.
+func (s *PolicyServiceImpl) RebuildEngine(ctx context.Context) (*v1alpha1.EngineRebuild, error) {
+	s.rebuilds.mu.Lock()
+	defer s.rebuilds.mu.Unlock()
+
+	if n := len(s.rebuilds.operations); n > 0 && s.rebuilds.operations[n-1].State == v1alpha1.EngineRebuildRunning {
+		running := *s.rebuilds.operations[n-1]
+		return &running, nil
+	}
+	rebuild := &v1alpha1.EngineRebuild{
+		Id:         uuid.New().String(),
+		State:      v1alpha1.EngineRebuildRunning,
+		CreateTime: time.Now().UTC(),
+	}
+	s.rebuilds.operations = append(s.rebuilds.operations, rebuild)
+	if len(s.rebuilds.operations) > maxEngineRebuilds {
+		s.rebuilds.operations = s.rebuilds.operations[1:]
+	}
+
+	// The rebuild outlives the request that started it
+	go s.rebuildEngine(context.WithoutCancel(ctx), rebuild)
+	started := *rebuild
+	return &started, nil
+}
+
+// GetEngineRebuild returns the state and progress of a rebuild started by RebuildEngine
+func (s *PolicyServiceImpl) GetEngineRebuild(_ context.Context, id string) (*v1alpha1.EngineRebuild, error) {
+	s.rebuilds.mu.Lock()
+	defer s.rebuilds.mu.Unlock()
+	for _, rebuild := range s.rebuilds.operations {
+		if rebuild.Id == id {
+			copied := *rebuild
+			return &copied, nil
+		}
+	}
+	return nil, NewNotFoundError("Engine rebuild not found", fmt.Sprintf("Engine rebuild '%s' does not exist or is no longer kept", id))
+}
+
+// rebuildEngine compiles the stored policies into the engine from scratch, recording its
+// progress in rebuild. It is serialized with reconciliation and counts as one, so a
+// successful rebuild clears the error of a failed reconciliation.
+func (s *PolicyServiceImpl) rebuildEngine(ctx context.Context, rebuild *v1alpha1.EngineRebuild) {
+	log := logging.FromContext(ctx).With("rebuild_id", rebuild.Id)
+	log.Info("Rebuilding the engine from the stored policies")
+
+	s.compileMu.Lock()
+	defer s.compileMu.Unlock()
+	err := func() error {
+		allPolicies, err := s.store.Policy().ListAll(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list policies for the rebuild: %w", err)
+		}
+		s.updateRebuild(func() { rebuild.PoliciesTotal = int32(len(allPolicies)) })
+		if err := s.engine.Compile(ctx, policyModules(allPolicies)); err != nil {
+			return fmt.Errorf("failed to compile policies: %w", err)
+		}
+		return nil
+	}()
+
+	endTime := time.Now()
+	s.reconcileMu.Lock()
+	s.lastReconcile = reconcileOutcome{time: endTime, err: err}
+	s.reconcileMu.Unlock()
+	endTime = endTime.UTC()
+	if err != nil {
+		engineReconciliationsTotal.Inc("failed")
+		log.Error("Failed to rebuild the engine", "error", err)
+		message := err.Error()
+		s.updateRebuild(func() {
+			rebuild.State = v1alpha1.EngineRebuildFailed
+			rebuild.Error = &message
+			rebuild.EndTime = &endTime
+		})
+		return
+	}
+	engineReconciliationsTotal.Inc("recompiled")
+	s.compiled.Store(true)
+	log.Info("Rebuilt the engine", "policies", rebuild.PoliciesTotal)
+	s.updateRebuild(func() {
+		rebuild.State = v1alpha1.EngineRebuildSucceeded
+		rebuild.PoliciesCompiled = rebuild.PoliciesTotal
+		rebuild.EndTime = &endTime
+	})
+}
+
+// updateRebuild applies update to a kept rebuild, which GetEngineRebuild reads concurrently
+func (s *PolicyServiceImpl) updateRebuild(update func()) {
+	s.rebuilds.mu.Lock()
+	defer s.rebuilds.mu.Unlock()
+	update()
+}
@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("RebuildEngine", func() {
+	var (
+		ctx           context.Context
+		db            *gorm.DB
+		engine        opa.Engine
+		policyService *service.PolicyServiceImpl
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		DeferCleanup(func() {
+			sqlDB, _ := db.DB()
+			_ = sqlDB.Close()
+		})
+
+		ctx = context.Background()
+		engine = opa.NewEngine()
+		policyService = service.NewPolicyService(store.NewStore(db), engine)
+		Expect(db.Create(&model.Policy{
+			ID:          "require-region",
+			DisplayName: "Require region",
+			PolicyType:  "GLOBAL",
+			Priority:    10,
+			RegoCode:    "package policies.require_region\nmain := {\"rejected\": false}",
+			Enabled:     true,
+		}).Error).To(Succeed())
+	})
+
+	// finished waits for the rebuild to end and returns it
+	finished := func(id string) *v1alpha1.EngineRebuild {
+		var rebuild *v1alpha1.EngineRebuild
+		Eventually(func() v1alpha1.EngineRebuildState {
+			var err error
+			rebuild, err = policyService.GetEngineRebuild(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			return rebuild.State
+		}).ShouldNot(Equal(v1alpha1.EngineRebuildRunning))
+		return rebuild
+	}
+
+	It("compiles every stored policy into an engine that lost its state", func() {
+		Expect(engine.Compile(ctx, nil)).To(Succeed())
+
+		started, err := policyService.RebuildEngine(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(started.State).To(Equal(v1alpha1.EngineRebuildRunning))
+
+		rebuild := finished(started.Id)
+		Expect(rebuild.State).To(Equal(v1alpha1.EngineRebuildSucceeded))
+		Expect(rebuild.PoliciesTotal).To(Equal(int32(1)))
+		Expect(rebuild.PoliciesCompiled).To(Equal(int32(1)))
+		Expect(rebuild.EndTime).NotTo(BeNil())
+		Expect(engine.Modules()).To(ConsistOf(HaveField("ID", "require-region")))
+		Expect(policyService.CheckReadiness(ctx).Ready()).To(BeTrue())
+	})
+
+	It("reports why the rebuild failed and keeps the compiled state", func() {
+		Expect(policyService.CompileAll(ctx)).To(Succeed())
+		sqlDB, err := db.DB()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sqlDB.Close()).To(Succeed())
+
+		started, err := policyService.RebuildEngine(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		rebuild := finished(started.Id)
+		Expect(rebuild.State).To(Equal(v1alpha1.EngineRebuildFailed))
+		Expect(rebuild.Error).To(HaveValue(ContainSubstring("failed to list policies for the rebuild")))
+		Expect(engine.Modules()).To(HaveLen(1))
+		Expect(policyService.EngineStatus().Healthy()).To(BeFalse())
+	})
+
+	It("returns not found for an unknown rebuild", func() {
+		_, err := policyService.GetEngineRebuild(ctx, "unknown")
+
+		var serviceErr *service.ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(service.ErrorTypeNotFound))
+	})
+})
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+var (
+	engineDriftTotal = metrics.NewCounterVec(
+		"policy_manager_engine_drift_policies_total",
+		"Policies found out of sync between the store and the engine by reconciliation, by kind of drift",
+		"kind",
+	)
+	engineReconciliationsTotal = metrics.NewCounterVec(
+		"policy_manager_engine_reconciliations_total",
+		"Engine reconciliation runs, by result: in_sync, recompiled or failed",
+		"result",
+	)
+)
+
+// EngineDrift lists the policies whose compiled state in the engine differs from the store
+type EngineDrift struct {
+	Missing  []string // stored policies the engine has not compiled
+	Changed  []string // stored policies the engine compiled from different Rego
+	Orphaned []string // policies the engine compiled that are no longer stored
+}
+
+// InSync reports whether the engine matches the store
+func (d *EngineDrift) InSync() bool {
+	return len(d.Missing) == 0 && len(d.Changed) == 0 && len(d.Orphaned) == 0
+}
+
+// EngineStatus describes the state of the engine reported by the health endpoint
+type EngineStatus struct {
+	CompiledPolicies int
+	// LastReconcileTime is when reconciliation last ran; zero when it has not run
+	LastReconcileTime time.Time
+	// LastReconcileError is why the last reconciliation failed; empty when it succeeded
+	LastReconcileError string
+}
+
+// Healthy reports whether the last reconciliation, if any, succeeded
+func (st EngineStatus) Healthy() bool {
+	return st.LastReconcileError == ""
+}
+
+// reconcileOutcome is the result of the last engine reconciliation
+type reconcileOutcome struct {
+	time time.Time
+	err  error
+}
+
+// EngineStatus returns the number of policies compiled into the engine and the result of
+// the last reconciliation with the store
+func (s *PolicyServiceImpl) EngineStatus() EngineStatus {
+	s.reconcileMu.Lock()
+	last := s.lastReconcile
+	s.reconcileMu.Unlock()
+
+	status := EngineStatus{CompiledPolicies: len(s.engine.Modules()), LastReconcileTime: last.time}
+	if last.err != nil {
+		status.LastReconcileError = last.err.Error()
+	}
+	return status
+}
+
+// ReconcileEngine compares the policies compiled into the engine with the stored policies and
+// recompiles the engine from the store when they differ, for instance after another replica
+// sharing the database changed a policy. It returns the drift found before recompiling.
+func (s *PolicyServiceImpl) ReconcileEngine(ctx context.Context) (drift *EngineDrift, err error) {
+	s.compileMu.Lock()
+	defer s.compileMu.Unlock()
+	defer func() {
+		s.reconcileMu.Lock()
+		s.lastReconcile = reconcileOutcome{time: time.Now(), err: err}
+		s.reconcileMu.Unlock()
+	}()
+
+	allPolicies, err := s.store.Policy().ListAll(ctx)
+	if err != nil {
+		engineReconciliationsTotal.Inc("failed")
+		return nil, fmt.Errorf("failed to list policies for reconciliation: %w", err)
+	}
+	drift = engineDrift(allPolicies, s.engine.Modules())
+	if drift.InSync() {
+		engineReconciliationsTotal.Inc("in_sync")
+		return drift, nil
+	}
+
+	engineDriftTotal.Add(float64(len(drift.Missing)), "missing")
+	engineDriftTotal.Add(float64(len(drift.Changed)), "changed")
+	engineDriftTotal.Add(float64(len(drift.Orphaned)), "orphaned")
+	logging.FromContext(ctx).Warn("Engine out of sync with the store; recompiling",
+		"missing", drift.Missing, "changed", drift.Changed, "orphaned", drift.Orphaned)
+	if err := s.engine.Compile(ctx, policyModules(allPolicies)); err != nil {
+		engineReconciliationsTotal.Inc("failed")
+		return drift, fmt.Errorf("failed to recompile policies: %w", err)
+	}
+	engineReconciliationsTotal.Inc("recompiled")
+	return drift, nil
+}
+
+// RunEngineReconciler reconciles the engine with the store every interval until ctx is
+// cancelled. Failures are logged and retried on the next tick.
+func (s *PolicyServiceImpl) RunEngineReconciler(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if _, err := s.ReconcileEngine(ctx); err != nil && ctx.Err() == nil {
+			logging.FromContext(ctx).Error("Failed to reconcile the engine with the store", "error", err)
+		}
+	}
+}
+
+// engineDrift compares the stored policies with the modules compiled into the engine
+func engineDrift(policies model.PolicyList, compiled []opa.PolicyModule) *EngineDrift {
+	compiledRego := make(map[string]string, len(compiled))
+	for _, module := range compiled {
+		compiledRego[module.ID] = module.RegoCode
+	}
+
+	drift := &EngineDrift{}
+	for _, p := range policies {
+		regoCode, ok := compiledRego[p.ID]
+		switch {
+		case !ok:
+			drift.Missing = append(drift.Missing, p.ID)
+		case regoCode != p.RegoCode:
+			drift.Changed = append(drift.Changed, p.ID)
+		}
+		delete(compiledRego, p.ID)
+	}
+	for _, module := range compiled {
+		if _, ok := compiledRego[module.ID]; ok {
+			drift.Orphaned = append(drift.Orphaned, module.ID)
+		}
+	}
+	return drift
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/service"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("ReconcileEngine", func() {
+	var (
+		ctx           context.Context
+		dataStore     store.Store
+		engine        opa.Engine
+		policyService *service.PolicyServiceImpl
+		db            *gorm.DB
+	)
+
+	regoCode := func(pkg string) string {
+		return "package " + pkg + "\nmain = {\"rejected\": false}"
+	}
+
+	storePolicy := func(id string, priority int32) {
+		_, err := dataStore.Policy().Create(ctx, model.Policy{
+			ID:          id,
+			DisplayName: id,
+			PolicyType:  "GLOBAL",
+			Priority:    priority,
+			RegoCode:    regoCode(id),
+			Enabled:     true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+		DeferCleanup(func() {
+			sqlDB, _ := db.DB()
+			_ = sqlDB.Close()
+		})
+
+		ctx = context.Background()
+		dataStore = store.NewStore(db)
+		engine = opa.NewEngine()
+		policyService = service.NewPolicyService(dataStore, engine)
+
+		storePolicy("kept", 100)
+		storePolicy("changed", 200)
+		storePolicy("deleted", 300)
+		Expect(policyService.CompileAll(ctx)).To(Succeed())
+	})
+
+	It("does nothing when the engine matches the store", func() {
+		drift, err := policyService.ReconcileEngine(ctx)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.InSync()).To(BeTrue())
+	})
+
+	It("recompiles policies changed in the store behind the engine's back", func() {
+		storePolicy("added", 400)
+		changed, err := dataStore.Policy().Get(ctx, "changed")
+		Expect(err).NotTo(HaveOccurred())
+		changed.RegoCode = "package changed\nmain = {\"rejected\": true}"
+		_, err = dataStore.Policy().Update(ctx, *changed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dataStore.Policy().Delete(ctx, "deleted")).To(Succeed())
+
+		drift, err := policyService.ReconcileEngine(ctx)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.Missing).To(ConsistOf("added"))
+		Expect(drift.Changed).To(ConsistOf("changed"))
+		Expect(drift.Orphaned).To(ConsistOf("deleted"))
+
+		result, err := engine.EvaluatePolicy(ctx, "changed", map[string]any{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Result).To(HaveKeyWithValue("rejected", true))
+		result, err = engine.EvaluatePolicy(ctx, "deleted", map[string]any{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Defined).To(BeFalse())
+
+		drift, err = policyService.ReconcileEngine(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.InSync()).To(BeTrue())
+	})
+
+	It("reports the last reconciliation in the engine status", func() {
+		Expect(policyService.EngineStatus()).To(Equal(service.EngineStatus{CompiledPolicies: 3}))
+
+		_, err := policyService.ReconcileEngine(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		status := policyService.EngineStatus()
+		Expect(status.Healthy()).To(BeTrue())
+		Expect(status.LastReconcileTime).NotTo(BeZero())
+
+		sqlDB, err := db.DB()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sqlDB.Close()).To(Succeed())
+		_, err = policyService.ReconcileEngine(ctx)
+		Expect(err).To(HaveOccurred())
+		status = policyService.EngineStatus()
+		Expect(status.Healthy()).To(BeFalse())
+		Expect(status.LastReconcileError).To(ContainSubstring("failed to list policies"))
+		Expect(status.CompiledPolicies).To(Equal(3))
+	})
+})
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"golang.org/x/text/language"
+	"sigs.k8s.io/yaml"
+)
+
+// messagePlaceholder matches the {name} placeholders of a rejection message template
+var messagePlaceholder = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// MessageCatalog holds rejection message templates translated into several languages
+type MessageCatalog struct {
+	codes map[string]*catalogCode
+}
+
+// catalogCode holds the translations of the template of one rejection code
+type catalogCode struct {
+	languages []language.Tag
+	templates []string // template for each of languages
+	matcher   language.Matcher
+}
+
+// NewMessageCatalog creates a catalog from templates keyed by BCP 47 language tag, then by
+// rejection code
+func NewMessageCatalog(templates map[string]map[string]string) (*MessageCatalog, error) {
+	catalog := &MessageCatalog{codes: map[string]*catalogCode{}}
+	for tag, messages := range templates {
+		lang, err := language.Parse(tag)
+		if err != nil {
+			return nil, fmt.Errorf("invalid language tag '%s': %w", tag, err)
+		}
+		for code, template := range messages {
+			if strings.TrimSpace(code) == "" || strings.TrimSpace(template) == "" {
+				return nil, fmt.Errorf("language '%s': rejection codes and templates must be non-empty", tag)
+			}
+			entry, ok := catalog.codes[code]
+			if !ok {
+				entry = &catalogCode{}
+				catalog.codes[code] = entry
+			}
+			entry.languages = append(entry.languages, lang)
+			entry.templates = append(entry.templates, template)
+		}
+	}
+	for _, entry := range catalog.codes {
+		entry.matcher = language.NewMatcher(entry.languages)
+	}
+	return catalog, nil
+}
+
+// LoadMessageCatalog reads a message catalog from a YAML or JSON file mapping language tags
+// to rejection codes and their templates
+func LoadMessageCatalog(path string) (*MessageCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rejection message catalog: %w", err)
+	}
+	var templates map[string]map[string]string
+	if err := yaml.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("failed to parse rejection message catalog %s: %w", path, err)
+	}
+	catalog, err := NewMessageCatalog(templates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rejection message catalog %s: %w", path, err)
+	}
+	return catalog, nil
+}
+
+// WithMessageCatalog translates the rejection messages of policies into the languages
+// requests accept
+func WithMessageCatalog(catalog *MessageCatalog) EvaluationOption {
+	return func(s *evaluationService) {
+		s.messages = catalog
+	}
+}
+
+// template returns the catalog template for code in the language that best matches
+// acceptLanguage, or false when the catalog has no translation the request accepts
+func (c *MessageCatalog) template(code, acceptLanguage string) (string, bool) {
+	if c == nil || acceptLanguage == "" {
+		return "", false
+	}
+	entry, ok := c.codes[code]
+	if !ok {
+		return "", false
+	}
+	accepted, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(accepted) == 0 {
+		return "", false
+	}
+	_, index, confidence := entry.matcher.Match(accepted...)
+	if confidence == language.No {
+		return "", false
+	}
+	return entry.templates[index], true
+}
+
+// rejectionMessage returns the message for a rejection of the request by policy. A decision
+// with a rejection code uses the catalog translation the request accepts, else the policy's
+// template for the code. Without a template the policy's rejection reason is used as is.
+func (s *evaluationService) rejectionMessage(policy *model.Policy, decision *opa.PolicyDecision, acceptLanguage string) string {
+	if decision.RejectionCode == "" {
+		return decision.RejectionReason
+	}
+	template, ok := s.messages.template(decision.RejectionCode, acceptLanguage)
+	if !ok {
+		template, ok = policy.RejectionMessages[decision.RejectionCode]
+	}
+	if !ok {
+		if decision.RejectionReason != "" {
+			return decision.RejectionReason
+		}
+		return decision.RejectionCode
+	}
+	return renderRejectionMessage(template, policy.ID, decision)
+}
+
+// renderRejectionMessage replaces the {name} placeholders of template with the decision's
+// rejection params, {policy_id} and {reason}. Unknown placeholders are kept.
+func renderRejectionMessage(template, policyID string, decision *opa.PolicyDecision) string {
+	return messagePlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		if value, ok := decision.RejectionParams[name]; ok {
+			return formatMessageParam(value)
+		}
+		switch name {
+		case "policy_id":
+			return policyID
+		case "reason":
+			return decision.RejectionReason
+		}
+		return placeholder
+	})
+}
+
+// formatMessageParam formats a rejection param for a message: strings as they are, arrays as
+// comma-separated items and other values as JSON
+func formatMessageParam(value any) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case []any:
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = formatMessageParam(item)
+		}
+		return strings.Join(items, ", ")
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
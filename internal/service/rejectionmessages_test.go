@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rejection messages", func() {
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		mockOPA   *mockEngine
+		catalog   *MessageCatalog
+		rejected  []events.EvaluationRejected
+		bus       *events.Bus
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{policies: []model.Policy{{
+			ID: "regions", Enabled: true, PolicyType: "GLOBAL", Priority: 100,
+			RejectionMessages: map[string]string{"region_not_allowed": "Region {value} is not allowed for {field}; choose one of {allowed} ({policy_id})"},
+		}}}
+		mockOPA = &mockEngine{evaluations: map[string]*opa.EvaluationResult{"regions": {
+			Defined: true,
+			Result: map[string]any{
+				"rejected":         true,
+				"rejection_reason": "region not allowed",
+				"rejection_code":   "region_not_allowed",
+				"rejection_params": map[string]any{
+					"field":   "region",
+					"value":   "eu-west-1",
+					"allowed": []any{"us-east-1", "us-west-2"},
+				},
+			},
+		}}}
+		var err error
+		catalog, err = NewMessageCatalog(map[string]map[string]string{
+			"de": {"region_not_allowed": "Die Region {value} ist nicht erlaubt"},
+			"fr": {"other_code": "Autre"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		rejected = nil
+		bus = events.NewBus()
+		events.Subscribe(bus, func(_ context.Context, e events.EvaluationRejected) {
+			rejected = append(rejected, e)
+		})
+	})
+
+	evaluate := func(acceptLanguage string) *ServiceError {
+		svc := NewEvaluationService(mockStore, mockOPA, WithMessageCatalog(catalog), WithEvaluationEvents(bus))
+		_, err := svc.EvaluateRequest(ctx, &EvaluationRequest{
+			ServiceInstance: map[string]any{"region": "eu-west-1"},
+			AcceptLanguage:  acceptLanguage,
+		})
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+		return serviceErr
+	}
+
+	It("renders the policy's template for the rejection code", func() {
+		Expect(evaluate("").Detail).To(Equal("Region eu-west-1 is not allowed for region; choose one of us-east-1, us-west-2 (regions)"))
+	})
+
+	It("uses the catalog translation in the best accepted language", func() {
+		Expect(evaluate("fr, de-CH;q=0.8, en;q=0.5").Detail).To(Equal("Die Region eu-west-1 ist nicht erlaubt"))
+	})
+
+	It("falls back to the policy's template when no accepted language has a translation", func() {
+		Expect(evaluate("fr, ja").Detail).To(HavePrefix("Region eu-west-1 is not allowed"))
+		Expect(evaluate("not a language tag;;").Detail).To(HavePrefix("Region eu-west-1 is not allowed"))
+	})
+
+	It("publishes the untranslated message", func() {
+		evaluate("de")
+
+		Expect(rejected).To(HaveLen(1))
+		Expect(rejected[0].Reason).To(HavePrefix("Region eu-west-1 is not allowed"))
+	})
+
+	It("uses the rejection reason without a template for the code", func() {
+		mockStore.policies[0].RejectionMessages = nil
+
+		Expect(evaluate("").Detail).To(Equal("region not allowed"))
+
+		delete(mockOPA.evaluations["regions"].Result, "rejection_reason")
+		Expect(evaluate("").Detail).To(Equal("region_not_allowed"))
+	})
+
+	It("uses the rejection reason of decisions without a rejection code", func() {
+		delete(mockOPA.evaluations["regions"].Result, "rejection_code")
+
+		Expect(evaluate("de").Detail).To(Equal("region not allowed"))
+	})
+
+	It("keeps unknown placeholders and formats non-string params as JSON", func() {
+		decision := &opa.PolicyDecision{
+			RejectionReason: "too big",
+			RejectionParams: map[string]any{"limit": 8, "sizes": map[string]any{"max": "large"}},
+		}
+
+		Expect(renderRejectionMessage("{limit} {sizes} {reason} {missing}", "p", decision)).
+			To(Equal(`8 {"max":"large"} too big {missing}`))
+	})
+
+	Describe("LoadMessageCatalog", func() {
+		It("reads a YAML file of templates by language and code", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "messages.yaml")
+			Expect(os.WriteFile(file, []byte("de:\n  region_not_allowed: Nicht erlaubt\nen-GB:\n  region_not_allowed: Not allowed\n"), 0o600)).To(Succeed())
+
+			catalog, err := LoadMessageCatalog(file)
+
+			Expect(err).NotTo(HaveOccurred())
+			template, ok := catalog.template("region_not_allowed", "en-US")
+			Expect(ok).To(BeTrue())
+			Expect(template).To(Equal("Not allowed"))
+		})
+
+		It("rejects invalid language tags and empty templates", func() {
+			_, err := NewMessageCatalog(map[string]map[string]string{"not a tag": {"code": "message"}})
+			Expect(err).To(MatchError(ContainSubstring("invalid language tag 'not a tag'")))
+
+			_, err = NewMessageCatalog(map[string]map[string]string{"de": {"code": " "}})
+			Expect(err).To(MatchError(ContainSubstring("must be non-empty")))
+		})
+
+		It("fails for a missing file", func() {
+			_, err := LoadMessageCatalog(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+			Expect(err).To(MatchError(ContainSubstring("failed to read rejection message catalog")))
+		})
+	})
+})
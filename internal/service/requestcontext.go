@@ -0,0 +1,48 @@
+package service
+
+import "fmt"
+
+// maxRequestContextValueLength is the longest value a request context field may have
+const maxRequestContextValueLength = 256
+
+// RequestContext describes who made an evaluation request and from where. The values are
+// taken as sent by the caller; policies see them as input.request_context.
+type RequestContext struct {
+	Requester     string
+	SourceSystem  string
+	CorrelationID string
+	Environment   string
+}
+
+// fields returns the set fields of c by their API names, or nil when none is set
+func (c RequestContext) fields() map[string]string {
+	var fields map[string]string
+	for name, value := range map[string]string{
+		"requester":      c.Requester,
+		"source_system":  c.SourceSystem,
+		"correlation_id": c.CorrelationID,
+		"environment":    c.Environment,
+	} {
+		if value == "" {
+			continue
+		}
+		if fields == nil {
+			fields = map[string]string{}
+		}
+		fields[name] = value
+	}
+	return fields
+}
+
+// validateRequestContext returns an InvalidArgument error naming a field of c that is too long
+func validateRequestContext(c RequestContext) error {
+	for name, value := range c.fields() {
+		if len(value) > maxRequestContextValueLength {
+			return NewInvalidArgumentError(
+				"Invalid request context",
+				fmt.Sprintf("request_context.%s must be at most %d bytes", name, maxRequestContextValueLength),
+			)
+		}
+	}
+	return nil
+}
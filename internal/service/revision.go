@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/pagetoken"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// ListPolicyRevisions lists the revisions of a policy, newest first.
+func (s *PolicyServiceImpl) ListPolicyRevisions(ctx context.Context, id string, pageToken *string, pageSize *int32) (*v1alpha1.PolicyRevisionList, error) {
+	log := logging.FromContext(ctx)
+
+	size, err := s.pages.parsePageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	scope := pagetoken.Scope("revisions", id)
+	offset, err := decodePageToken(s.tokens, pageToken, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.store.Revision().List(ctx, id, &store.RevisionListOptions{Offset: offset, PageSize: size})
+	if err != nil {
+		log.Error("Failed to list policy revisions from store", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to list policy revisions", err.Error(), err)
+	}
+	// The revisions of a deleted policy are still listed; an unknown policy is not found
+	// rather than a policy without revisions
+	if len(result.Revisions) == 0 && offset == 0 {
+		if _, err := s.store.Policy().Get(ctx, id); err != nil {
+			if errors.Is(err, store.ErrPolicyNotFound) {
+				return nil, NewPolicyNotFoundError(id)
+			}
+			log.Error("Failed to get policy from store", "policy_id", id, "error", err)
+			return nil, NewInternalError("Failed to get policy", err.Error(), err)
+		}
+	}
+
+	revisions := make([]v1alpha1.PolicyRevision, len(result.Revisions))
+	for i, revision := range result.Revisions {
+		revisions[i] = RevisionToAPIModel(&revision)
+	}
+	response := &v1alpha1.PolicyRevisionList{Revisions: revisions, PageSize: int32(size)}
+	if result.NextOffset > 0 {
+		nextPageToken := s.tokens.Encode(result.NextOffset, scope)
+		response.NextPageToken = &nextPageToken
+	}
+	return response, nil
+}
+
+// GetPolicyRevision retrieves a revision of a policy by number.
+func (s *PolicyServiceImpl) GetPolicyRevision(ctx context.Context, id string, revision int64) (*v1alpha1.PolicyRevision, error) {
+	dbRevision, err := s.getRevision(ctx, id, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	apiRevision := RevisionToAPIModel(dbRevision)
+	return &apiRevision, nil
+}
+
+func (s *PolicyServiceImpl) getRevision(ctx context.Context, id string, revision int64) (*model.PolicyRevision, error) {
+	dbRevision, err := s.store.Revision().Get(ctx, id, revision)
+	if err != nil {
+		if !errors.Is(err, store.ErrPolicyRevisionNotFound) {
+			logging.FromContext(ctx).Error("Failed to get policy revision from store", "policy_id", id, "revision", revision, "error", err)
+			return nil, NewInternalError("Failed to get policy revision", err.Error(), err)
+		}
+		// Revisions outlive their policy; report the policy missing only when it has no history
+		history, listErr := s.store.Revision().List(ctx, id, &store.RevisionListOptions{PageSize: 1})
+		if listErr == nil && len(history.Revisions) == 0 {
+			if _, getErr := s.store.Policy().Get(ctx, id); errors.Is(getErr, store.ErrPolicyNotFound) {
+				return nil, NewPolicyNotFoundError(id)
+			}
+		}
+		return nil, NewPolicyRevisionNotFoundError(id, revision)
+	}
+	return dbRevision, nil
+}
+
+// RollbackPolicy restores the mutable fields of policy id from one of its revisions. The
+// rollback is validated and committed like an update, and stored as a new revision.
+func (s *PolicyServiceImpl) RollbackPolicy(ctx context.Context, id string, revision int64) (*v1alpha1.Policy, error) {
+	log := logging.FromContext(ctx)
+	log.Debug("Rolling back policy", "policy_id", id, "revision", revision)
+
+	if revision < 1 {
+		return nil, NewInvalidArgumentError("Invalid revision", "revision must be at least 1")
+	}
+
+	existingDB, err := s.store.Policy().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyNotFound) {
+			return nil, NewPolicyNotFoundError(id)
+		}
+		log.Error("Failed to get existing policy for rollback", "policy_id", id, "error", err)
+		return nil, NewInternalError("Failed to get existing policy", err.Error(), err)
+	}
+	target, err := s.store.Revision().Get(ctx, id, revision)
+	if err != nil {
+		if errors.Is(err, store.ErrPolicyRevisionNotFound) {
+			return nil, NewPolicyRevisionNotFoundError(id, revision)
+		}
+		log.Error("Failed to get policy revision for rollback", "policy_id", id, "revision", revision, "error", err)
+		return nil, NewInternalError("Failed to get policy revision", err.Error(), err)
+	}
+
+	restored := target.Policy()
+	merged := replacePolicy(DBToAPIModel(&restored), DBToAPIModel(existingDB))
+	return s.commitUpdate(ctx, existingDB, merged, target.RegoCode != existingDB.RegoCode)
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// regoDiffContext is the number of unchanged lines shown around each change in a Rego diff
+const regoDiffContext = 3
+
+// DiffPolicyRevisions compares two revisions of a policy field by field, with a unified diff
+// of their Rego code.
+func (s *PolicyServiceImpl) DiffPolicyRevisions(ctx context.Context, id string, from, to int64) (*v1alpha1.PolicyRevisionDiff, error) {
+	if from < 1 || to < 1 {
+		return nil, NewInvalidArgumentError("Invalid revision", "from and to must be at least 1")
+	}
+
+	fromRevision, err := s.getRevision(ctx, id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.getRevision(ctx, id, to)
+	if err != nil {
+		return nil, err
+	}
+
+	regoDiff, err := diffRego(fromRevision, toRevision)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to diff policy revisions", "policy_id", id, "from", from, "to", to, "error", err)
+		return nil, NewInternalError("Failed to diff policy revisions", err.Error(), err)
+	}
+	return &v1alpha1.PolicyRevisionDiff{
+		From:     from,
+		To:       to,
+		Changes:  diffRevisionFields(fromRevision, toRevision),
+		RegoDiff: regoDiff,
+	}, nil
+}
+
+// diffRevisionFields returns the fields other than the Rego code that differ between two
+// revisions, in the order the fields appear on a policy
+func diffRevisionFields(from, to *model.PolicyRevision) []v1alpha1.PolicyFieldChange {
+	changes := []v1alpha1.PolicyFieldChange{}
+	add := func(field string, fromValue, toValue any) {
+		if fromValue != toValue {
+			changes = append(changes, v1alpha1.PolicyFieldChange{Field: field, From: fromValue, To: toValue})
+		}
+	}
+
+	add("display_name", from.DisplayName, to.DisplayName)
+	add("description", from.Description, to.Description)
+	add("policy_type", from.PolicyType, to.PolicyType)
+	addMap := func(field string, fromMap, toMap map[string]string) {
+		keys := slices.Collect(maps.Keys(fromMap))
+		for key := range toMap {
+			if _, exists := fromMap[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			add(field+"."+key, mapValue(fromMap, key), mapValue(toMap, key))
+		}
+	}
+	addMap("label_selector", from.LabelSelector, to.LabelSelector)
+	add("priority", from.Priority, to.Priority)
+	addMap("rejection_messages", from.RejectionMessages, to.RejectionMessages)
+	addMap("annotations", from.Annotations, to.Annotations)
+	// Parameter values may be objects and arrays, which are compared structurally
+	names := slices.Collect(maps.Keys(from.Parameters))
+	for name := range to.Parameters {
+		if _, exists := from.Parameters[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		fromValue, toValue := from.Parameters[name], to.Parameters[name]
+		if !reflect.DeepEqual(fromValue, toValue) {
+			changes = append(changes, v1alpha1.PolicyFieldChange{Field: "parameters." + name, From: fromValue, To: toValue})
+		}
+	}
+	add("documentation.summary", from.Documentation.Summary, to.Documentation.Summary)
+	add("documentation.rationale", from.Documentation.Rationale, to.Documentation.Rationale)
+	add("documentation.remediation_url", from.Documentation.RemediationURL, to.Documentation.RemediationURL)
+	add("documentation.owner_contact", from.Documentation.OwnerContact, to.Documentation.OwnerContact)
+	add("enabled", from.Enabled, to.Enabled)
+	return changes
+}
+
+// mapValue returns the value of a label selector, rejection message or annotation key, or nil
+// when the key is not set
+func mapValue(values map[string]string, key string) any {
+	if value, exists := values[key]; exists {
+		return value
+	}
+	return nil
+}
+
+// diffRego returns a unified diff of the Rego code of two revisions, or the empty string when
+// the code is the same
+func diffRego(from, to *model.PolicyRevision) (string, error) {
+	if from.RegoCode == to.RegoCode {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.RegoCode),
+		B:        difflib.SplitLines(to.RegoCode),
+		FromFile: fmt.Sprintf("revision %d", from.Revision),
+		ToFile:   fmt.Sprintf("revision %d", to.Revision),
+		Context:  regoDiffContext,
+	})
+}
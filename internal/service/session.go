@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultSessionTTL  = 15 * time.Minute
+	defaultMaxSessions = 1000
+)
+
+// EvaluationSession is an open evaluation session
+type EvaluationSession struct {
+	ID         string
+	ExpireTime time.Time
+}
+
+// SessionEvaluationResponse is the result of one step of an evaluation session
+type SessionEvaluationResponse struct {
+	EvaluationResponse
+	Constraints map[string]any // accumulated JSON Schema constraints by field path
+	ExpireTime  time.Time
+}
+
+// WithSessionLimits expires evaluation sessions unused for ttl and allows at most max open
+// sessions. Non-positive values keep the defaults.
+func WithSessionLimits(ttl time.Duration, max int) EvaluationOption {
+	return func(s *evaluationService) {
+		if ttl > 0 {
+			s.sessions.ttl = ttl
+		}
+		if max > 0 {
+			s.sessions.max = max
+		}
+	}
+}
+
+// evaluationSessions holds the open evaluation sessions of this replica
+type evaluationSessions struct {
+	ttl time.Duration
+	max int
+	now func() time.Time
+	ids func() string
+
+	mu       sync.Mutex
+	sessions map[string]*evaluationSession
+}
+
+type evaluationSession struct {
+	mu          sync.Mutex       // serializes the steps of the session
+	spec        map[string]any   // request spec accumulated from the steps
+	constraints *constraints.Set // constraints accumulated from the steps
+	expiresAt   time.Time        // guarded by evaluationSessions.mu
+}
+
+func newEvaluationSessions() *evaluationSessions {
+	return &evaluationSessions{
+		ttl:      defaultSessionTTL,
+		max:      defaultMaxSessions,
+		now:      time.Now,
+		ids:      uuid.NewString,
+		sessions: map[string]*evaluationSession{},
+	}
+}
+
+// CreateSession opens an evaluation session with an empty spec and no constraints
+func (s *evaluationService) CreateSession(ctx context.Context) (*EvaluationSession, error) {
+	sessions := s.sessions
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	sessions.evictExpired()
+	if len(sessions.sessions) >= sessions.max {
+		return nil, NewTooManySessionsError(sessions.max)
+	}
+
+	id := sessions.ids()
+	session := &evaluationSession{
+		spec:        map[string]any{},
+		constraints: constraints.NewSet(),
+		expiresAt:   sessions.now().Add(sessions.ttl),
+	}
+	sessions.sessions[id] = session
+	logging.FromContext(ctx).Debug("Evaluation session opened", "session_id", id, "open_sessions", len(sessions.sessions))
+	return &EvaluationSession{ID: id, ExpireTime: session.expiresAt}, nil
+}
+
+// EvaluateSession merges spec into the spec accumulated by the session and evaluates the
+// result as a dry run, starting from the constraints accumulated by earlier steps. spec must
+// satisfy those constraints. A failed step leaves the session unchanged.
+func (s *evaluationService) EvaluateSession(ctx context.Context, id string, spec map[string]any) (*SessionEvaluationResponse, error) {
+	session, err := s.sessions.get(id)
+	if err != nil {
+		return nil, err
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if violations := session.constraints.ValidatePatch(spec); len(violations) > 0 {
+		return nil, NewSessionConstraintViolationError(violations)
+	}
+	requestSpec, err := specmerge.Merge(session.spec, spec)
+	if err != nil {
+		return nil, NewInternalError("Failed to merge the step into the session spec", err.Error(), err)
+	}
+
+	response, state, err := s.evaluateSession(ctx, session, requestSpec, true)
+	if err != nil {
+		return nil, err
+	}
+	session.spec = requestSpec
+	session.constraints = state.constraints
+
+	constraints := state.constraints.GetConstraintsMap()
+	if constraints == nil {
+		constraints = map[string]any{}
+	}
+	logging.FromContext(ctx).Debug("Evaluation session step evaluated", "session_id", id, "constraints", len(constraints))
+	return &SessionEvaluationResponse{
+		EvaluationResponse: *response,
+		Constraints:        constraints,
+		ExpireTime:         s.sessions.touch(session),
+	}, nil
+}
+
+// FinalizeSession evaluates the accumulated spec for real, starting from the accumulated
+// constraints, and closes the session. The session stays open when the evaluation fails.
+func (s *evaluationService) FinalizeSession(ctx context.Context, id string) (*EvaluationResponse, error) {
+	session, err := s.sessions.get(id)
+	if err != nil {
+		return nil, err
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	response, _, err := s.evaluateSession(ctx, session, session.spec, false)
+	if err != nil {
+		s.sessions.touch(session)
+		return nil, err
+	}
+	s.sessions.remove(id, session)
+	logging.FromContext(ctx).Debug("Evaluation session finalized", "session_id", id)
+	return response, nil
+}
+
+// evaluateSession evaluates spec starting from a copy of the session constraints
+func (s *evaluationService) evaluateSession(ctx context.Context, session *evaluationSession, spec map[string]any, dryRun bool) (*EvaluationResponse, *evaluationState, error) {
+	requestLabels, err := ExtractRequestLabels(spec)
+	if err != nil {
+		return nil, nil, NewInvalidArgumentError("Invalid session spec", err.Error())
+	}
+	return s.evaluate(ctx, &EvaluationRequest{
+		ServiceInstance: spec,
+		RequestLabels:   requestLabels,
+		DryRun:          dryRun,
+	}, session.constraints.Clone())
+}
+
+// get returns the open session id
+func (s *evaluationSessions) get(id string) (*evaluationSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.evictExpired()
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, NewSessionNotFoundError(id)
+	}
+	return session, nil
+}
+
+// touch extends the expiry of session and returns the new expiry time
+func (s *evaluationSessions) touch(session *evaluationSession) time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session.expiresAt = s.now().Add(s.ttl)
+	return session.expiresAt
+}
+
+// remove closes session if it is still open as id
+func (s *evaluationSessions) remove(id string, session *evaluationSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions[id] == session {
+		delete(s.sessions, id)
+	}
+}
+
+// evictExpired drops the sessions past their expiry; callers must hold s.mu
+func (s *evaluationSessions) evictExpired() {
+	now := s.now()
+	for id, session := range s.sessions {
+		if !now.Before(session.expiresAt) {
+			delete(s.sessions, id)
+		}
+	}
+}
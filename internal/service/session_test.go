@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Evaluation sessions", func() {
+	const (
+		// Defaults the region and limits it to two regions
+		regions = `package regions
+
+allowed := {"region": {"enum": ["us-east-1", "us-west-2"]}}
+
+main := {"patch": {"region": "us-east-1"}, "constraints": allowed} if not input.spec.region
+else := {"constraints": allowed}
+`
+	)
+
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		bus       *events.Bus
+		published []events.Event
+		now       time.Time
+		svc       *evaluationService
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		engine := opa.NewEngine()
+		Expect(engine.Compile(ctx, []opa.PolicyModule{{ID: "regions", RegoCode: regions}})).To(Succeed())
+		mockStore = &mockPolicyStore{policies: []model.Policy{
+			{ID: "regions", Enabled: true, PolicyType: "GLOBAL", Priority: 100, RegoCode: regions},
+		}}
+		bus = events.NewBus()
+		published = nil
+		bus.Subscribe(func(_ context.Context, event events.Event) {
+			published = append(published, event)
+		})
+		svc = NewEvaluationService(mockStore, engine, WithEvaluationEvents(bus), WithSessionLimits(time.Minute, 2)).(*evaluationService)
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		svc.sessions.now = func() time.Time { return now }
+	})
+
+	open := func() string {
+		session, err := svc.CreateSession(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(session.ExpireTime).To(Equal(now.Add(time.Minute)))
+		return session.ID
+	}
+
+	It("accumulates the spec across steps and returns the accumulated constraints", func() {
+		id := open()
+
+		step, err := svc.EvaluateSession(ctx, id, map[string]any{"service_type": "vm"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(step.DryRun).To(BeTrue())
+		Expect(step.Status).To(Equal(EvaluationStatusModified))
+		Expect(step.EvaluatedServiceInstance).To(Equal(map[string]any{"service_type": "vm", "region": "us-east-1"}))
+		Expect(step.Constraints).To(HaveKey("region"))
+
+		step, err = svc.EvaluateSession(ctx, id, map[string]any{"region": "us-west-2", "size": "small"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(step.Status).To(Equal(EvaluationStatusApproved))
+		Expect(step.EvaluatedServiceInstance).To(Equal(map[string]any{"service_type": "vm", "region": "us-west-2", "size": "small"}))
+		Expect(published).To(BeEmpty())
+	})
+
+	It("rejects a step that violates constraints from earlier steps and keeps the session unchanged", func() {
+		id := open()
+		_, err := svc.EvaluateSession(ctx, id, map[string]any{"service_type": "vm"})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = svc.EvaluateSession(ctx, id, map[string]any{"region": "eu-west-1"})
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypePolicyConflict))
+		Expect(serviceErr.Detail).To(ContainSubstring("field 'region'"))
+		Expect(serviceErr.Detail).To(ContainSubstring("constrained by policy 'regions'"))
+
+		response, err := svc.FinalizeSession(ctx, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{"service_type": "vm", "region": "us-east-1"}))
+	})
+
+	It("finalizes with a real evaluation and closes the session", func() {
+		id := open()
+		_, err := svc.EvaluateSession(ctx, id, map[string]any{"service_type": "vm"})
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err := svc.FinalizeSession(ctx, id)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.DryRun).To(BeFalse())
+		Expect(response.Status).To(Equal(EvaluationStatusModified))
+		Expect(published).To(ConsistOf(BeAssignableToTypeOf(events.EvaluationCompleted{})))
+
+		_, err = svc.FinalizeSession(ctx, id)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*ServiceError).Type).To(Equal(ErrorTypeNotFound))
+	})
+
+	It("keeps the session open when finalizing fails", func() {
+		id := open()
+
+		_, err := svc.FinalizeSession(ctx, id)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*ServiceError).Type).To(Equal(ErrorTypeInvalidArgument))
+
+		_, err = svc.EvaluateSession(ctx, id, map[string]any{"service_type": "vm"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("expires sessions unused for the TTL", func() {
+		id := open()
+		now = now.Add(30 * time.Second)
+		step, err := svc.EvaluateSession(ctx, id, map[string]any{"service_type": "vm"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(step.ExpireTime).To(Equal(now.Add(time.Minute)))
+
+		now = now.Add(time.Minute)
+		_, err = svc.EvaluateSession(ctx, id, map[string]any{"size": "small"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*ServiceError).Type).To(Equal(ErrorTypeNotFound))
+	})
+
+	It("limits the number of open sessions", func() {
+		open()
+		open()
+
+		_, err := svc.CreateSession(ctx)
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*ServiceError).Type).To(Equal(ErrorTypeResourceExhausted))
+
+		now = now.Add(time.Minute)
+		open()
+	})
+})
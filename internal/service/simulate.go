@@ -0,0 +1,240 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+// WithSimulationOptions configures the evaluations run by SimulatePolicy and RunPolicyTests,
+// so they redact, protect fields and detect patch conflicts like real evaluations do.
+func WithSimulationOptions(opts ...EvaluationOption) PolicyServiceOption {
+	return func(s *PolicyServiceImpl) {
+		s.simulation = opts
+	}
+}
+
+// SimulatePolicy evaluates a service instance as if the draft policy were installed at its
+// priority among the enabled stored policies, replacing the stored policy with the same ID.
+// Nothing is stored, the engine is not changed and no evaluation events are published.
+func (s *PolicyServiceImpl) SimulatePolicy(ctx context.Context, req v1alpha1.PolicySimulationRequest) (*v1alpha1.PolicySimulation, error) {
+	sim, err := s.newDraftSimulation(ctx, req.PolicyId, req.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if sim.priorityTakenBy() != nil {
+		return nil, NewPolicyPriorityPolicyTypeTakenError(sim.draft.Priority, v1alpha1.PolicyPolicyType(sim.draft.PolicyType))
+	}
+	if err := sim.compile(ctx); err != nil {
+		return nil, handleEngineError(err, "simulate")
+	}
+	result, err := sim.run(ctx, req.ServiceInstance.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.FromContext(ctx).Debug("Policy simulated", "policy_id", sim.id, "status", result.Status, "draft_outcome", result.Draft.Outcome)
+	return result, nil
+}
+
+// draftSimulation evaluates requests as if a draft policy were installed
+type draftSimulation struct {
+	id       string
+	draft    model.Policy
+	policies model.PolicyList // the stored policies, including the one the draft replaces
+	store    store.Policy
+	options  []EvaluationOption
+
+	evaluator EvaluationService // set by compile
+}
+
+// newDraftSimulation validates the draft like on create and prepares its simulation under
+// policyID, or a generated ID when policyID is nil
+func (s *PolicyServiceImpl) newDraftSimulation(ctx context.Context, policyID *string, policy v1alpha1.Policy) (*draftSimulation, error) {
+	if err := validatePostInput(policy); err != nil {
+		return nil, err
+	}
+	if err := s.engine.ValidateRego(ctx, *policy.RegoCode); err != nil {
+		return nil, handleEngineError(err, "simulate")
+	}
+
+	allPolicies, err := s.store.Policy().ListAll(ctx)
+	if err != nil {
+		logging.FromContext(ctx).Error("Failed to list policies for simulation", "error", err)
+		return nil, NewInternalError("Failed to simulate policy", err.Error(), err)
+	}
+	id, err := simulationPolicyID(policyID, allPolicies)
+	if err != nil {
+		return nil, err
+	}
+	if policy, err = s.withCreateDefaults(ctx, policy, id, nil); err != nil {
+		return nil, err
+	}
+	draft := APIToDBModel(policy, id)
+	draft.Enabled = true
+	return &draftSimulation{
+		id:       id,
+		draft:    draft,
+		policies: allPolicies,
+		store:    s.store.Policy(),
+		options:  s.simulation,
+	}, nil
+}
+
+// others returns the stored policies other than the one the draft replaces
+func (sim *draftSimulation) others() model.PolicyList {
+	return slices.DeleteFunc(slices.Clone(sim.policies), func(p model.Policy) bool { return p.ID == sim.id })
+}
+
+// priorityTakenBy returns the stored policy of the draft's type that has its priority, if any
+func (sim *draftSimulation) priorityTakenBy() *model.Policy {
+	for _, p := range sim.others() {
+		if p.PolicyType == sim.draft.PolicyType && p.Priority == sim.draft.Priority {
+			return &p
+		}
+	}
+	return nil
+}
+
+// compile compiles the stored policies and the draft into a scratch engine, so the live
+// engine never sees the draft
+func (sim *draftSimulation) compile(ctx context.Context) error {
+	modules := []opa.PolicyModule{{ID: sim.id, RegoCode: sim.draft.RegoCode}}
+	for _, p := range sim.others() {
+		modules = append(modules, opa.PolicyModule{ID: p.ID, RegoCode: p.RegoCode})
+	}
+	engine := opa.NewEngine()
+	if err := engine.Compile(ctx, modules); err != nil {
+		return err
+	}
+	sim.evaluator = NewEvaluationService(&draftPolicyStore{Policy: sim.store, draft: sim.draft}, engine, sim.options...)
+	return nil
+}
+
+// run evaluates spec as a dry run with the draft installed. Rejections and failures of
+// policies are reported in the result rather than returned.
+func (sim *draftSimulation) run(ctx context.Context, spec map[string]any) (*v1alpha1.PolicySimulation, error) {
+	labels, err := ExtractRequestLabels(spec)
+	if err != nil {
+		return nil, NewInvalidArgumentError("Invalid service instance spec", err.Error())
+	}
+	response, err := sim.evaluator.EvaluateRequest(ctx, &EvaluationRequest{
+		ServiceInstance: spec,
+		RequestLabels:   labels,
+		Explain:         true,
+		DryRun:          true,
+	})
+
+	result := &v1alpha1.PolicySimulation{
+		PolicyId:          sim.id,
+		PoliciesEvaluated: []string{},
+		Draft:             v1alpha1.DraftPolicyResult{Outcome: v1alpha1.DraftNotReached},
+	}
+	var explanation *Explanation
+	if err != nil {
+		var serviceErr *ServiceError
+		if !errors.As(err, &serviceErr) || serviceErr.Explanation == nil || len(serviceErr.Explanation.Policies) == 0 {
+			return nil, err
+		}
+		explanation = serviceErr.Explanation
+		failed := explanation.Policies[len(explanation.Policies)-1]
+		switch failed.Outcome {
+		case PolicyOutcomeRejected:
+			result.Status = v1alpha1.SimulationRejected
+		case PolicyOutcomeFailed:
+			result.Status = v1alpha1.SimulationFailed
+		default:
+			// The evaluation failed outside of any policy, e.g. listing policies
+			return nil, err
+		}
+		result.FailedPolicyId = &failed.PolicyID
+		result.Detail = &failed.Reason
+	} else {
+		explanation = response.Explanation
+		result.Status = v1alpha1.SimulationStatus(response.Status)
+		result.EvaluatedServiceInstance = &v1alpha1.SimulatedServiceInstance{Spec: response.EvaluatedServiceInstance}
+		if response.SelectedProvider != "" {
+			result.SelectedProvider = &response.SelectedProvider
+		}
+	}
+
+	for _, trace := range explanation.Policies {
+		result.PoliciesEvaluated = append(result.PoliciesEvaluated, trace.PolicyID)
+		if trace.PolicyID == sim.id {
+			result.Draft = draftPolicyResult(trace)
+		}
+	}
+	for _, skipped := range explanation.SkippedPolicies {
+		if skipped.PolicyID == sim.id {
+			result.Draft.Outcome = v1alpha1.DraftSkipped
+		}
+	}
+	return result, nil
+}
+
+// simulationPolicyID returns the ID to simulate a draft under: requested when it names a
+// stored policy, else requested validated as a client-specified ID, else a generated ID
+func simulationPolicyID(requested *string, policies model.PolicyList) (string, error) {
+	if requested != nil && slices.ContainsFunc(policies, func(p model.Policy) bool { return p.ID == *requested }) {
+		return *requested, nil
+	}
+	id, err := getPolicyID(requested)
+	if err != nil {
+		return "", err
+	}
+	return *id, nil
+}
+
+// draftPolicyResult describes what the draft decided from its trace
+func draftPolicyResult(trace PolicyTrace) v1alpha1.DraftPolicyResult {
+	result := v1alpha1.DraftPolicyResult{Outcome: v1alpha1.DraftPolicyOutcome(trace.Outcome)}
+	if trace.Reason != "" {
+		result.Reason = &trace.Reason
+	}
+	if trace.Patch != nil {
+		result.Patch = &trace.Patch
+	}
+	if trace.Constraints != nil {
+		result.Constraints = &trace.Constraints
+	}
+	if trace.SelectedProvider != "" {
+		result.SelectedProvider = &trace.SelectedProvider
+	}
+	return result
+}
+
+// draftPolicyStore lists the enabled stored policies with a draft installed in place of the
+// stored policy with its ID. List returns them in evaluation order in a single page,
+// whatever the options; it is only used to evaluate simulations.
+type draftPolicyStore struct {
+	store.Policy
+	draft model.Policy
+}
+
+func (s *draftPolicyStore) List(ctx context.Context, _ *store.PolicyListOptions) (*store.PolicyListResult, error) {
+	allPolicies, err := s.Policy.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := model.PolicyList{s.draft}
+	for _, p := range allPolicies {
+		if p.Enabled && p.ID != s.draft.ID {
+			policies = append(policies, p)
+		}
+	}
+	slices.SortFunc(policies, func(a, b model.Policy) int {
+		return cmp.Or(
+			cmp.Compare(a.PolicyType, b.PolicyType),
+			cmp.Compare(a.Priority, b.Priority),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+	return &store.PolicyListResult{Policies: policies}, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+)
+
+var policySnapshotLoadsTotal = metrics.NewCounterVec(
+	"policy_manager_policy_snapshot_loads_total",
+	"Loads of the evaluation policy snapshot from the store, by result: loaded or failed",
+	"result",
+)
+
+// PolicySnapshot caches the enabled policies in evaluation order so evaluations do not page
+// through the store on every request. It is reloaded by the first evaluation after a policy
+// event is published on its bus, and by Run every interval, which picks up changes made by
+// other replicas sharing the database.
+type PolicySnapshot struct {
+	store store.Policy
+	// loadMu serializes loads, so a burst of evaluations after a change loads the store once
+	loadMu sync.Mutex
+
+	mu       sync.RWMutex
+	policies model.PolicyList
+	loaded   bool
+	// generation is incremented by every policy event; the snapshot is current while it
+	// equals loadedGeneration, the generation its load started at
+	generation       uint64
+	loadedGeneration uint64
+}
+
+var _ policySource = (*PolicySnapshot)(nil)
+
+// NewPolicySnapshot creates a snapshot of the enabled policies of policyStore, loaded by the
+// first evaluation. Policy events published on bus, which may be nil, mark it stale.
+func NewPolicySnapshot(policyStore store.Policy, bus *events.Bus) *PolicySnapshot {
+	p := &PolicySnapshot{store: policyStore}
+	bus.Subscribe(func(_ context.Context, event events.Event) {
+		switch event.(type) {
+		case events.PolicyCreated, events.PolicyUpdated, events.PolicyDeleted:
+			p.mu.Lock()
+			p.generation++
+			p.mu.Unlock()
+		}
+	})
+	return p
+}
+
+// WithPolicySnapshot evaluates requests against snapshot instead of reading the enabled
+// policies from the store for each request
+func WithPolicySnapshot(snapshot *PolicySnapshot) EvaluationOption {
+	return func(s *evaluationService) {
+		s.policies = snapshot
+	}
+}
+
+// Refresh reloads the snapshot from the store. When the store fails the snapshot is kept
+// unchanged and the Unavailable error is returned.
+func (p *PolicySnapshot) Refresh(ctx context.Context) error {
+	p.loadMu.Lock()
+	defer p.loadMu.Unlock()
+	_, err := p.load(ctx)
+	return err
+}
+
+// Run refreshes the snapshot every interval until ctx is cancelled. Failures are logged and
+// the current snapshot is kept until the next tick.
+func (p *PolicySnapshot) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+		if err := p.Refresh(ctx); err != nil && ctx.Err() == nil {
+			logging.FromContext(ctx).Error("Failed to refresh the policy snapshot", "error", err)
+		}
+	}
+}
+
+func (p *PolicySnapshot) forEachEnabled(ctx context.Context, fn func(*model.Policy) error) error {
+	policies, err := p.current(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range policies {
+		if err := fn(&policies[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// current returns the snapshot, loading it first when it has not been loaded or a policy
+// event was published since its load started
+func (p *PolicySnapshot) current(ctx context.Context) (model.PolicyList, error) {
+	if policies, ok := p.fresh(); ok {
+		return policies, nil
+	}
+	p.loadMu.Lock()
+	defer p.loadMu.Unlock()
+	// Another evaluation may have loaded it while this one waited
+	if policies, ok := p.fresh(); ok {
+		return policies, nil
+	}
+	return p.load(ctx)
+}
+
+func (p *PolicySnapshot) fresh() (model.PolicyList, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.policies, p.loaded && p.loadedGeneration == p.generation
+}
+
+// load reads the enabled policies from the store and replaces the snapshot with them. An
+// event published during the load leaves the snapshot stale, so the next evaluation loads
+// it again. Called with loadMu held.
+func (p *PolicySnapshot) load(ctx context.Context) (model.PolicyList, error) {
+	p.mu.RLock()
+	generation := p.generation
+	p.mu.RUnlock()
+
+	var policies model.PolicyList
+	err := forEachEnabledPolicy(ctx, p.store, func(policy *model.Policy) error {
+		policies = append(policies, *policy)
+		return nil
+	})
+	if err != nil {
+		policySnapshotLoadsTotal.Inc("failed")
+		return nil, err
+	}
+
+	policySnapshotLoadsTotal.Inc("loaded")
+	p.mu.Lock()
+	p.policies = policies
+	p.loaded = true
+	p.loadedGeneration = generation
+	p.mu.Unlock()
+	return policies, nil
+}
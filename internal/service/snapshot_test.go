@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicySnapshot", func() {
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		bus       *events.Bus
+		snapshot  *PolicySnapshot
+		evaluator EvaluationService
+	)
+
+	rejecting := &opa.EvaluationResult{Defined: true, Result: map[string]any{"rejected": true, "rejection_reason": "denied"}}
+
+	evaluate := func() (*EvaluationResponse, error) {
+		return evaluator.EvaluateRequest(ctx, &EvaluationRequest{ServiceInstance: map[string]any{"type": "vm"}})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{
+			policies: []model.Policy{{ID: "denied", PolicyType: "GLOBAL", Priority: 100, Enabled: true}},
+		}
+		bus = events.NewBus()
+		snapshot = NewPolicySnapshot(mockStore, bus)
+		evaluator = NewEvaluationService(mockStore, &mockEngine{
+			evaluations: map[string]*opa.EvaluationResult{"denied": rejecting},
+		}, WithPolicySnapshot(snapshot))
+	})
+
+	It("loads the store once for repeated evaluations", func() {
+		for range 3 {
+			_, err := evaluate()
+			Expect(err).To(HaveOccurred())
+		}
+		Expect(mockStore.lists).To(Equal(1))
+	})
+
+	It("reloads after a policy event", func() {
+		_, err := evaluate()
+		Expect(err).To(HaveOccurred())
+
+		mockStore.policies = nil
+		_, err = evaluate()
+		Expect(err).To(HaveOccurred(), "the snapshot is used until a policy changes")
+
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "denied"})
+		response, err := evaluate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Status).To(Equal(EvaluationStatusApproved))
+		Expect(mockStore.lists).To(Equal(2))
+	})
+
+	It("keeps the snapshot when a refresh fails", func() {
+		Expect(snapshot.Refresh(ctx)).To(Succeed())
+
+		mockStore.err = errors.New("database unavailable")
+		Expect(snapshot.Refresh(ctx)).To(HaveOccurred())
+		_, err := evaluate()
+		var serviceErr *ServiceError
+		Expect(errors.As(err, &serviceErr)).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeRejected))
+	})
+
+	It("fails evaluations as unavailable when it cannot be loaded", func() {
+		mockStore.err = errors.New("database unavailable")
+
+		_, err := evaluate()
+
+		Expect(isUnavailable(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+var deniedSpecFieldsTotal = metrics.NewCounterVec(
+	"policy_manager_evaluation_denied_spec_fields_total",
+	"Denied top-level spec fields found in evaluation requests, by action: stripped or rejected",
+	"action",
+)
+
+// DeniedSpecFieldMode selects what happens to an evaluation request whose spec has a denied
+// top-level field
+type DeniedSpecFieldMode string
+
+const (
+	// DeniedSpecFieldsStrip removes the denied fields and evaluates the rest of the spec
+	DeniedSpecFieldsStrip DeniedSpecFieldMode = "strip"
+	// DeniedSpecFieldsReject fails the request with an InvalidArgument error
+	DeniedSpecFieldsReject DeniedSpecFieldMode = "reject"
+)
+
+// ParseDeniedSpecFieldMode parses a denied spec field mode; the empty string is strip
+func ParseDeniedSpecFieldMode(mode string) (DeniedSpecFieldMode, error) {
+	switch DeniedSpecFieldMode(mode) {
+	case "", DeniedSpecFieldsStrip:
+		return DeniedSpecFieldsStrip, nil
+	case DeniedSpecFieldsReject:
+		return DeniedSpecFieldsReject, nil
+	}
+	return "", fmt.Errorf("denied spec field mode must be one of: strip, reject (got '%s')", mode)
+}
+
+// WithDeniedSpecFields handles the top-level spec fields matching any of the given names
+// according to mode before the spec is evaluated. A name ending in * matches the fields
+// starting with the rest of it, so __* matches __proto__.
+func WithDeniedSpecFields(fields []string, mode DeniedSpecFieldMode) EvaluationOption {
+	return func(s *evaluationService) {
+		s.deniedSpecFields = fields
+		s.deniedSpecFieldMode = mode
+	}
+}
+
+// sanitizeSpec returns spec without its denied top-level fields, or an InvalidArgument
+// error naming them in the reject mode. spec itself is not modified.
+func (s *evaluationService) sanitizeSpec(ctx context.Context, spec map[string]any) (map[string]any, error) {
+	sanitized, denied := s.withoutDeniedSpecFields(spec)
+	if len(denied) == 0 {
+		return spec, nil
+	}
+	if s.deniedSpecFieldMode == DeniedSpecFieldsReject {
+		deniedSpecFieldsTotal.Add(float64(len(denied)), "rejected")
+		return nil, NewInvalidArgumentError(
+			"Invalid service instance spec",
+			fmt.Sprintf("spec fields not allowed: %s", strings.Join(denied, ", ")),
+		)
+	}
+	deniedSpecFieldsTotal.Add(float64(len(denied)), "stripped")
+	logging.FromContext(ctx).Debug("Stripped denied spec fields", "fields", denied)
+	return sanitized, nil
+}
+
+// withoutDeniedSpecFields returns a shallow copy of spec without its denied top-level fields,
+// and their sorted names; spec itself is returned when none is denied
+func (s *evaluationService) withoutDeniedSpecFields(spec map[string]any) (map[string]any, []string) {
+	var denied []string
+	for field := range spec {
+		if s.isDeniedSpecField(field) {
+			denied = append(denied, field)
+		}
+	}
+	if len(denied) == 0 {
+		return spec, nil
+	}
+	slices.Sort(denied)
+	sanitized := make(map[string]any, len(spec)-len(denied))
+	for field, value := range spec {
+		if !slices.Contains(denied, field) {
+			sanitized[field] = value
+		}
+	}
+	return sanitized, denied
+}
+
+// isDeniedSpecField reports whether the top-level spec field is denied
+func (s *evaluationService) isDeniedSpecField(field string) bool {
+	for _, name := range s.deniedSpecFields {
+		if prefix, ok := strings.CutSuffix(name, "*"); ok {
+			if strings.HasPrefix(field, prefix) {
+				return true
+			}
+		} else if field == name {
+			return true
+		}
+	}
+	return false
+}
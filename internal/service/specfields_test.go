@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Denied spec fields", func() {
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		inputs    []map[string]any
+		engine    *mockEngineWithCapture
+		request   *EvaluationRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockStore = &mockPolicyStore{policies: []model.Policy{
+			{ID: "policy-1", Enabled: true, PolicyType: "GLOBAL", Priority: 100},
+		}}
+		inputs = nil
+		engine = &mockEngineWithCapture{
+			evaluations: map[string]*opa.EvaluationResult{},
+			captureFunc: func(input map[string]any) { inputs = append(inputs, input) },
+		}
+		request = &EvaluationRequest{ServiceInstance: map[string]any{
+			"region":      "us-east-1",
+			"__proto__":   map[string]any{"admin": true},
+			"constraints": map[string]any{"region": map[string]any{"const": "eu-west-1"}},
+		}}
+	})
+
+	evaluate := func(mode DeniedSpecFieldMode, opts ...EvaluationOption) (*EvaluationResponse, error) {
+		opts = append(opts, WithDeniedSpecFields([]string{"__*", "constraints"}, mode))
+		return NewEvaluationService(mockStore, engine, opts...).EvaluateRequest(ctx, request)
+	}
+
+	It("strips denied fields before the policies see the spec", func() {
+		response, err := evaluate(DeniedSpecFieldsStrip)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(inputs).To(HaveLen(1))
+		Expect(inputs[0]["spec"]).To(Equal(map[string]any{"region": "us-east-1"}))
+		Expect(inputs[0]).NotTo(HaveKey("constraints"))
+		Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{"region": "us-east-1"}))
+		Expect(response.Status).To(Equal(EvaluationStatusModified))
+		Expect(response.StatusReason).To(Equal(StatusReasonDeniedFieldsStripped))
+		Expect(request.ServiceInstance).To(HaveKey("__proto__"), "the request is not modified")
+	})
+
+	It("publishes the spec without denied fields in the completed event", func() {
+		bus := events.NewBus()
+		var completed []events.EvaluationCompleted
+		events.Subscribe(bus, func(_ context.Context, event events.EvaluationCompleted) {
+			completed = append(completed, event)
+		})
+
+		_, err := evaluate(DeniedSpecFieldsStrip, WithEvaluationEvents(bus))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(completed).To(HaveLen(1))
+		Expect(completed[0].InputSpec).To(Equal(map[string]any{"region": "us-east-1"}))
+		Expect(completed[0].InputSpec).NotTo(HaveKey("__proto__"))
+	})
+
+	It("rejects a request with denied fields in the reject mode", func() {
+		_, err := evaluate(DeniedSpecFieldsReject)
+
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeInvalidArgument))
+		Expect(serviceErr.Detail).To(Equal("spec fields not allowed: __proto__, constraints"))
+		Expect(inputs).To(BeEmpty())
+	})
+
+	It("accepts a spec without denied fields unchanged", func() {
+		request.ServiceInstance = map[string]any{"region": "us-east-1", "proto": "tcp"}
+
+		response, err := evaluate(DeniedSpecFieldsReject)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.EvaluatedServiceInstance).To(Equal(map[string]any{"region": "us-east-1", "proto": "tcp"}))
+		Expect(response.StatusReason).To(Equal(StatusReasonPoliciesPassed))
+	})
+})
+
+var _ = Describe("ParseDeniedSpecFieldMode", func() {
+	It("defaults to strip", func() {
+		Expect(ParseDeniedSpecFieldMode("")).To(Equal(DeniedSpecFieldsStrip))
+		Expect(ParseDeniedSpecFieldMode("reject")).To(Equal(DeniedSpecFieldsReject))
+	})
+
+	It("rejects unknown modes", func() {
+		_, err := ParseDeniedSpecFieldMode("ignore")
+		Expect(err).To(MatchError(ContainSubstring("strip, reject")))
+	})
+})
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/runtimeconfig"
+)
+
+var policyTimeoutsTotal = metrics.NewCounterVec(
+	"policy_manager_policy_evaluation_timeouts_total",
+	"Policy evaluations stopped by the per-policy timeout, by policy",
+	"policy_id",
+)
+
+// WithPolicyTimeout stops evaluating the Rego of a single policy after timeout, so one slow
+// rule cannot stall a request. The request then fails with an Unavailable error naming the
+// policy. Zero or less disables the deadline.
+func WithPolicyTimeout(timeout time.Duration) EvaluationOption {
+	return WithReloadablePolicyTimeout(runtimeconfig.NewDuration(timeout))
+}
+
+// WithReloadablePolicyTimeout is WithPolicyTimeout with a timeout that can change while
+// evaluations run; each policy evaluation uses the timeout current when it starts
+func WithReloadablePolicyTimeout(timeout *runtimeconfig.Duration) EvaluationOption {
+	return func(s *evaluationService) {
+		s.policyTimeout = timeout
+	}
+}
+
+// withPolicyTimeout runs eval under the per-policy deadline. When the policy runs into it, the
+// returned error names the policy and the timeout, and wraps context.DeadlineExceeded.
+func (s *evaluationService) withPolicyTimeout(ctx context.Context, policyID string, eval func(context.Context) (*opa.EvaluationResult, error)) (*opa.EvaluationResult, error) {
+	timeout := s.policyTimeout.Load()
+	if timeout <= 0 {
+		return eval(ctx)
+	}
+	policyCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := eval(policyCtx)
+	if err != nil && ctx.Err() == nil && errors.Is(policyCtx.Err(), context.DeadlineExceeded) {
+		policyTimeoutsTotal.Inc(policyID)
+		logging.FromContext(ctx).Warn("Policy evaluation timed out", "policy_id", policyID, "timeout", timeout)
+		return nil, fmt.Errorf("policy '%s' did not finish within %s: %w", policyID, timeout, context.DeadlineExceeded)
+	}
+	return result, err
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Per-policy timeout", func() {
+	const (
+		fast = `package fast
+
+main := {"patch": {"region": "us-east-1"}}
+`
+		// Counts a hundred million pairs, far longer than the test's timeout
+		slow = `package slow
+
+main := {"rejected": count([1 | some i in numbers.range(1, 10000); some j in numbers.range(1, 10000)]) < 0}
+`
+	)
+
+	var (
+		ctx       context.Context
+		mockStore *mockPolicyStore
+		engine    opa.Engine
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		engine = opa.NewEngine()
+		policies := model.PolicyList{
+			{ID: "fast", Enabled: true, PolicyType: "GLOBAL", Priority: 100, RegoCode: fast},
+			{ID: "slow", Enabled: true, PolicyType: "GLOBAL", Priority: 200, RegoCode: slow},
+		}
+		Expect(engine.Compile(ctx, policyModules(policies))).To(Succeed())
+		mockStore = &mockPolicyStore{policies: policies}
+	})
+
+	expectTimeout := func(err error) {
+		Expect(err).To(HaveOccurred())
+		serviceErr, ok := err.(*ServiceError)
+		Expect(ok).To(BeTrue())
+		Expect(serviceErr.Type).To(Equal(ErrorTypeInternal))
+		Expect(serviceErr.Message).To(Equal("Failed to evaluate policy 'slow'"))
+		Expect(serviceErr.Detail).To(ContainSubstring("policy 'slow' did not finish within 20ms"))
+	}
+
+	It("fails the request naming the policy that ran into the deadline", func() {
+		svc := NewEvaluationService(mockStore, engine, WithPolicyTimeout(20*time.Millisecond))
+
+		start := time.Now()
+		_, err := svc.EvaluateRequest(ctx, &EvaluationRequest{ServiceInstance: map[string]any{}})
+
+		expectTimeout(err)
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+	})
+
+	It("does not fail open on a policy that ran into the deadline", func() {
+		svc := NewEvaluationService(mockStore, engine,
+			WithPolicyTimeout(20*time.Millisecond), WithFailureMode(FailureModeOpen))
+
+		_, err := svc.EvaluateRequest(ctx, &EvaluationRequest{ServiceInstance: map[string]any{}})
+
+		expectTimeout(err)
+	})
+
+	It("applies the deadline to policies evaluated ahead of their turn", func() {
+		svc := NewEvaluationService(mockStore, engine,
+			WithPolicyTimeout(20*time.Millisecond), WithExecutionStrategy(ExecutionPhased, 2))
+
+		_, err := svc.EvaluateRequest(ctx, &EvaluationRequest{ServiceInstance: map[string]any{"region": "us-east-1"}})
+
+		expectTimeout(err)
+	})
+
+	It("lets policies finishing in time through", func() {
+		mockStore.policies = mockStore.policies[:1]
+		svc := NewEvaluationService(mockStore, engine, WithPolicyTimeout(time.Second))
+
+		response, err := svc.EvaluateRequest(ctx, &EvaluationRequest{ServiceInstance: map[string]any{}})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.EvaluatedServiceInstance).To(HaveKeyWithValue("region", "us-east-1"))
+	})
+})
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+)
+
+// WarmUpResult summarizes the startup warm-up
+type WarmUpResult struct {
+	PoliciesEvaluated int
+	SchemasCompiled   int
+}
+
+// WarmUp primes the evaluation path before the servers accept requests. Every enabled
+// policy is evaluated once against an empty spec, exercising its prepared query, and the
+// constraint schemas the policies return are compiled into the shared schema cache.
+// Policies that fail or stay undefined without a real spec are skipped. The input is
+// structured in layout, as evaluations structure it.
+func WarmUp(ctx context.Context, policyStore store.Policy, engine opa.Engine, layout InputLayout) (*WarmUpResult, error) {
+	log := logging.FromContext(ctx)
+
+	policies, err := policyStore.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies for warm-up: %w", err)
+	}
+
+	result := &WarmUpResult{}
+	for _, policy := range policies {
+		if !policy.Enabled {
+			continue
+		}
+		input := buildInput(layout, map[string]any{}, nil, nil, nil, "", constraints.NewSet(), policyMetadataInput(&policy))
+		evaluation, err := engine.EvaluatePolicy(ctx, policy.ID, input)
+		result.PoliciesEvaluated++
+		if err != nil {
+			log.Debug("Warm-up evaluation failed", "policy_id", policy.ID, "error", err)
+			continue
+		}
+		if !evaluation.Defined {
+			continue
+		}
+
+		decision := opa.ParsePolicyDecision(evaluation.Result)
+		for fieldPath, constraint := range decision.Constraints {
+			schemaMap, ok := constraint.(map[string]any)
+			if !ok {
+				continue
+			}
+			if err := constraints.Precompile(schemaMap); err != nil {
+				log.Debug("Warm-up schema compilation failed", "policy_id", policy.ID, "field_path", fieldPath, "error", err)
+				continue
+			}
+			result.SchemasCompiled++
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WarmUp", func() {
+	It("evaluates enabled policies and compiles their constraint schemas", func() {
+		policyStore := &mockPolicyStore{policies: []model.Policy{
+			{ID: "constrained", Enabled: true},
+			{ID: "undefined", Enabled: true},
+			{ID: "disabled", Enabled: false},
+		}}
+		engine := &mockEngine{evaluations: map[string]*opa.EvaluationResult{
+			"constrained": {
+				Defined: true,
+				Result: map[string]any{
+					"rejected": false,
+					"constraints": map[string]any{
+						"region": map[string]any{"enum": []any{"warmup-us-east-1", "warmup-eu-west-1"}},
+						"cpu":    map[string]any{"minimum": float64(1), "maximum": float64(64)},
+					},
+				},
+			},
+		}}
+
+		result, err := WarmUp(context.Background(), policyStore, engine, InputLayoutFlat)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.PoliciesEvaluated).To(Equal(2))
+		Expect(result.SchemasCompiled).To(Equal(2))
+	})
+
+	It("ignores evaluation errors", func() {
+		policyStore := &mockPolicyStore{policies: []model.Policy{{ID: "broken", Enabled: true}}}
+		engine := &mockEngine{err: errors.New("input.spec.name is undefined")}
+
+		result, err := WarmUp(context.Background(), policyStore, engine, InputLayoutFlat)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.SchemasCompiled).To(BeZero())
+	})
+
+	It("returns an error when policies cannot be loaded", func() {
+		policyStore := &mockPolicyStore{err: errors.New("database unavailable")}
+
+		_, err := WarmUp(context.Background(), policyStore, &mockEngine{}, InputLayoutFlat)
+		Expect(err).To(MatchError(ContainSubstring("database unavailable")))
+	})
+})
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/metrics"
+)
+
+const (
+	// DefaultWatchHistory is the number of recent policy changes kept for watches resuming
+	// from a resource version
+	DefaultWatchHistory = 1000
+	// watchBuffer is the number of changes a watch may fall behind by before it is closed
+	watchBuffer = 256
+)
+
+var policyWatchesOpen = metrics.NewGaugeVec(
+	"policy_manager_policy_watches",
+	"Policy watch streams currently open",
+)
+
+// PolicyChangeType is the kind of a policy change streamed to watches
+type PolicyChangeType string
+
+const (
+	PolicyChangeCreated PolicyChangeType = "CREATED"
+	PolicyChangeUpdated PolicyChangeType = "UPDATED"
+	PolicyChangeDeleted PolicyChangeType = "DELETED"
+	// PolicyChangeResync tells the watch the changes since its resource version were not
+	// replayed, so it should list the policies again
+	PolicyChangeResync PolicyChangeType = "RESYNC"
+)
+
+// PolicyChange is a committed policy change, or a resync notice
+type PolicyChange struct {
+	Type            PolicyChangeType
+	ResourceVersion string
+	PolicyID        string           // empty for resyncs
+	Policy          *v1alpha1.Policy // the policy after the change; nil for deletions and resyncs
+}
+
+// PolicyWatcher streams the policy changes published on an event bus to watches, and keeps
+// the most recent ones so a watch can resume from the resource version it last received.
+// Resource versions are the epoch of the watcher followed by the sequence number of the
+// change, so versions from before a restart are recognized and answered with a resync.
+type PolicyWatcher struct {
+	epoch       string
+	historySize int
+
+	mu      sync.Mutex
+	seq     uint64
+	history []PolicyChange // the last historySize changes, oldest first
+	watches map[*PolicyWatch]struct{}
+	closed  bool
+}
+
+// PolicyWatch is an open watch of policy changes
+type PolicyWatch struct {
+	// Changes delivers the changes in order. It is closed when the watch is stopped, when
+	// the watcher is closed, or when the watch falls too far behind; the client then resumes
+	// from the last resource version it received.
+	Changes <-chan PolicyChange
+
+	changes chan PolicyChange
+	watcher *PolicyWatcher
+}
+
+// NewPolicyWatcher creates a watcher of the policy events published on bus, keeping the last
+// historySize changes for resuming watches
+func NewPolicyWatcher(bus *events.Bus, historySize int) *PolicyWatcher {
+	w := &PolicyWatcher{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 16),
+		historySize: historySize,
+		watches:     map[*PolicyWatch]struct{}{},
+	}
+	bus.Subscribe(func(_ context.Context, event events.Event) {
+		switch e := event.(type) {
+		case events.PolicyCreated:
+			w.publish(PolicyChangeCreated, e.Policy)
+		case events.PolicyUpdated:
+			w.publish(PolicyChangeUpdated, e.Policy)
+		case events.PolicyDeleted:
+			w.publish(PolicyChangeDeleted, v1alpha1.Policy{Id: &e.PolicyID})
+		}
+	})
+	return w
+}
+
+// Watch opens a watch of the changes after resourceVersion, or of the changes from now on
+// when it is empty. When the changes after resourceVersion are no longer kept, or it was not
+// issued by this watcher, the watch starts with a resync.
+func (w *PolicyWatcher) Watch(resourceVersion string) *PolicyWatch {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var replay []PolicyChange
+	if resourceVersion != "" {
+		var ok bool
+		replay, ok = w.changesAfter(resourceVersion)
+		if !ok {
+			replay = []PolicyChange{{Type: PolicyChangeResync, ResourceVersion: w.version(w.seq)}}
+		}
+	}
+	watch := &PolicyWatch{changes: make(chan PolicyChange, len(replay)+watchBuffer), watcher: w}
+	watch.Changes = watch.changes
+	for _, change := range replay {
+		watch.changes <- change
+	}
+	if w.closed {
+		close(watch.changes)
+		return watch
+	}
+	w.watches[watch] = struct{}{}
+	policyWatchesOpen.Set(float64(len(w.watches)))
+	return watch
+}
+
+// Stop closes the watch
+func (pw *PolicyWatch) Stop() {
+	w := pw.watcher
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.remove(pw)
+}
+
+// Close closes every watch and the watches opened afterwards, so streams end on shutdown
+func (w *PolicyWatcher) Close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.closed = true
+	for watch := range w.watches {
+		w.remove(watch)
+	}
+}
+
+func (w *PolicyWatcher) publish(changeType PolicyChangeType, policy v1alpha1.Policy) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.seq++
+	change := PolicyChange{Type: changeType, ResourceVersion: w.version(w.seq), PolicyID: *policy.Id}
+	if changeType != PolicyChangeDeleted {
+		change.Policy = &policy
+	}
+	if len(w.history) == w.historySize {
+		w.history = w.history[1:]
+	}
+	w.history = append(w.history, change)
+
+	for watch := range w.watches {
+		select {
+		case watch.changes <- change:
+		default:
+			// Publishing must not block on a slow client; it resumes from its last version
+			w.remove(watch)
+		}
+	}
+}
+
+// changesAfter returns the kept changes after resourceVersion; ok is false when they cannot
+// all be replayed. Called with mu held.
+func (w *PolicyWatcher) changesAfter(resourceVersion string) (changes []PolicyChange, ok bool) {
+	epoch, seqText, found := strings.Cut(resourceVersion, ".")
+	if !found || epoch != w.epoch {
+		return nil, false
+	}
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil || seq > w.seq {
+		return nil, false
+	}
+	// history holds the changes oldestSeq..w.seq
+	oldestSeq := w.seq - uint64(len(w.history)) + 1
+	if seq+1 < oldestSeq {
+		return nil, false
+	}
+	return append([]PolicyChange(nil), w.history[seq+1-oldestSeq:]...), true
+}
+
+// remove closes watch if it is open. Called with mu held.
+func (w *PolicyWatcher) remove(watch *PolicyWatch) {
+	if _, ok := w.watches[watch]; !ok {
+		return
+	}
+	delete(w.watches, watch)
+	close(watch.changes)
+	policyWatchesOpen.Set(float64(len(w.watches)))
+}
+
+func (w *PolicyWatcher) version(seq uint64) string {
+	return fmt.Sprintf("%s.%d", w.epoch, seq)
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/policy-manager/api/v1alpha1"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/service"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyWatcher", func() {
+	var (
+		ctx     context.Context
+		bus     *events.Bus
+		watcher *service.PolicyWatcher
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		bus = events.NewBus()
+		watcher = service.NewPolicyWatcher(bus, 3)
+	})
+
+	create := func(id string) {
+		bus.Publish(ctx, events.PolicyCreated{Policy: v1alpha1.Policy{Id: &id}})
+	}
+
+	// drain returns the changes delivered to watch so far
+	drain := func(watch *service.PolicyWatch) []service.PolicyChange {
+		var changes []service.PolicyChange
+		for {
+			select {
+			case change, ok := <-watch.Changes:
+				if !ok {
+					return changes
+				}
+				changes = append(changes, change)
+			default:
+				return changes
+			}
+		}
+	}
+
+	It("streams changes with increasing resource versions", func() {
+		watch := watcher.Watch("")
+		defer watch.Stop()
+
+		create("a")
+		bus.Publish(ctx, events.PolicyUpdated{Policy: v1alpha1.Policy{Id: strPtr("a")}})
+		bus.Publish(ctx, events.PolicyDeleted{PolicyID: "a"})
+
+		changes := drain(watch)
+		Expect(changes).To(HaveLen(3))
+		Expect([]service.PolicyChangeType{changes[0].Type, changes[1].Type, changes[2].Type}).To(Equal(
+			[]service.PolicyChangeType{service.PolicyChangeCreated, service.PolicyChangeUpdated, service.PolicyChangeDeleted}))
+		Expect(changes[0].ResourceVersion).NotTo(Equal(changes[1].ResourceVersion))
+		Expect(changes[2].PolicyID).To(Equal("a"))
+		Expect(changes[2].Policy).To(BeNil())
+	})
+
+	It("resumes from a kept resource version", func() {
+		watch := watcher.Watch("")
+		create("a")
+		create("b")
+		first := drain(watch)[0]
+		watch.Stop()
+
+		resumed := watcher.Watch(first.ResourceVersion)
+		defer resumed.Stop()
+
+		changes := drain(resumed)
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].PolicyID).To(Equal("b"))
+	})
+
+	It("resyncs from a resource version that is no longer kept or unknown", func() {
+		watch := watcher.Watch("")
+		for i := range 5 {
+			create(fmt.Sprintf("p%d", i))
+		}
+		oldest := drain(watch)[0]
+		watch.Stop()
+
+		for _, version := range []string{oldest.ResourceVersion, "0.1", "garbage"} {
+			resumed := watcher.Watch(version)
+			changes := drain(resumed)
+			Expect(changes).To(HaveLen(1), version)
+			Expect(changes[0].Type).To(Equal(service.PolicyChangeResync), version)
+			resumed.Stop()
+		}
+	})
+
+	It("closes a watch that falls too far behind", func() {
+		watch := watcher.Watch("")
+		for i := range 300 {
+			create(fmt.Sprintf("p%d", i))
+		}
+
+		changes := drain(watch)
+		Expect(len(changes)).To(BeNumerically("<", 300))
+		Eventually(watch.Changes).Should(BeClosed())
+	})
+
+	It("closes every watch when closed", func() {
+		watch := watcher.Watch("")
+
+		watcher.Close()
+
+		Eventually(watch.Changes).Should(BeClosed())
+		Eventually(watcher.Watch("").Changes).Should(BeClosed())
+	})
+})
@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrAuditSequenceTaken reports that another writer appended the audit entry with the same sequence
+	ErrAuditSequenceTaken = errors.New("audit sequence already taken")
+	ErrAuditEntryNotFound = errors.New("audit entry not found")
+)
+
+// AuditListOptions contains options for listing audit entries.
+type AuditListOptions struct {
+	// Types lists only entries of the given types; empty lists every entry
+	Types    []string
+	Offset   int
+	PageSize int
+}
+
+// AuditListResult contains the result of an audit List operation.
+type AuditListResult struct {
+	Entries model.AuditEntryList
+	// NextOffset is the offset of the next page, or zero when this is the last page
+	NextOffset int
+}
+
+type Audit interface {
+	Append(ctx context.Context, entry model.AuditEntry) (*model.AuditEntry, error)
+	Last(ctx context.Context) (*model.AuditEntry, error)
+	List(ctx context.Context, opts *AuditListOptions) (*AuditListResult, error)
+	Redact(ctx context.Context, sequence int64, data string) error
+}
+
+type AuditStore struct {
+	db *gorm.DB
+}
+
+var _ Audit = (*AuditStore)(nil)
+
+func NewAudit(db *gorm.DB) Audit {
+	return &AuditStore{db: db}
+}
+
+// Append stores entry as is
+func (s *AuditStore) Append(ctx context.Context, entry model.AuditEntry) (*model.AuditEntry, error) {
+	if err := s.db.WithContext(ctx).Create(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) ||
+			strings.Contains(strings.ToLower(err.Error()), "unique") ||
+			strings.Contains(err.Error(), "duplicate key") {
+			return nil, ErrAuditSequenceTaken
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Last returns the entry with the highest sequence, or nil when the log is empty
+func (s *AuditStore) Last(ctx context.Context) (*model.AuditEntry, error) {
+	var entries model.AuditEntryList
+	if err := s.db.WithContext(ctx).Order("sequence DESC").Limit(1).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// List returns entries in sequence order
+func (s *AuditStore) List(ctx context.Context, opts *AuditListOptions) (*AuditListResult, error) {
+	pageSize := 50
+	offset := 0
+	query := s.db.WithContext(ctx).Order("sequence ASC")
+	if opts != nil {
+		if opts.PageSize > 0 {
+			pageSize = opts.PageSize
+		}
+		if opts.Offset > 0 {
+			offset = opts.Offset
+		}
+		if len(opts.Types) > 0 {
+			query = query.Where("type IN ?", opts.Types)
+		}
+	}
+
+	var entries model.AuditEntryList
+	if err := query.Limit(pageSize + 1).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	result := &AuditListResult{Entries: entries}
+	if len(entries) > pageSize {
+		result.Entries = entries[:pageSize]
+		result.NextOffset = offset + pageSize
+	}
+	return result, nil
+}
+
+// Redact replaces the details of entry sequence and marks it redacted; no other field changes.
+func (s *AuditStore) Redact(ctx context.Context, sequence int64, data string) error {
+	result := s.db.WithContext(ctx).Model(&model.AuditEntry{}).
+		Where("sequence = ?", sequence).
+		Updates(map[string]any{"data": data, "redacted": true})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAuditEntryNotFound
+	}
+	return nil
+}
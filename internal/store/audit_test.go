@@ -0,0 +1,134 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Audit Store", func() {
+	var (
+		db         *gorm.DB
+		auditStore store.Audit
+		ctx        context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.AuditEntry{})).To(Succeed())
+
+		auditStore = store.NewAudit(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	newEntry := func(sequence int64) model.AuditEntry {
+		return model.AuditEntry{
+			Sequence: sequence,
+			Time:     time.Date(2026, 1, 9, 10, 30, 0, 0, time.UTC),
+			Type:     "PolicyDeleted",
+			Subject:  "policy-a",
+			Data:     `{"policy_id":"policy-a"}`,
+			Hash:     "hash",
+		}
+	}
+
+	Describe("Last", func() {
+		It("returns nil on an empty log", func() {
+			last, err := auditStore.Last(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(last).To(BeNil())
+		})
+
+		It("returns the entry with the highest sequence", func() {
+			for _, sequence := range []int64{1, 2, 3} {
+				_, err := auditStore.Append(ctx, newEntry(sequence))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			last, err := auditStore.Last(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(last.Sequence).To(Equal(int64(3)))
+		})
+	})
+
+	Describe("Append", func() {
+		It("rejects a sequence that is already taken", func() {
+			_, err := auditStore.Append(ctx, newEntry(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = auditStore.Append(ctx, newEntry(1))
+			Expect(err).To(MatchError(store.ErrAuditSequenceTaken))
+		})
+	})
+
+	Describe("List", func() {
+		It("filters by type", func() {
+			first := newEntry(1)
+			first.Type = "EvaluationCompleted"
+			_, err := auditStore.Append(ctx, first)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = auditStore.Append(ctx, newEntry(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := auditStore.List(ctx, &store.AuditListOptions{Types: []string{"EvaluationCompleted"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Entries).To(HaveLen(1))
+			Expect(result.Entries[0].Sequence).To(Equal(int64(1)))
+		})
+
+		It("pages through entries in sequence order", func() {
+			for _, sequence := range []int64{3, 1, 2} {
+				_, err := auditStore.Append(ctx, newEntry(sequence))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			result, err := auditStore.List(ctx, &store.AuditListOptions{PageSize: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Entries).To(HaveLen(2))
+			Expect(result.Entries[0].Sequence).To(Equal(int64(1)))
+			Expect(result.Entries[1].Sequence).To(Equal(int64(2)))
+			Expect(result.NextOffset).To(Equal(2))
+
+			result, err = auditStore.List(ctx, &store.AuditListOptions{PageSize: 2, Offset: result.NextOffset})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Entries).To(HaveLen(1))
+			Expect(result.Entries[0].Sequence).To(Equal(int64(3)))
+			Expect(result.NextOffset).To(BeZero())
+		})
+	})
+
+	Describe("Redact", func() {
+		It("replaces the data and marks the entry redacted", func() {
+			_, err := auditStore.Append(ctx, newEntry(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(auditStore.Redact(ctx, 1, "{}")).To(Succeed())
+
+			last, err := auditStore.Last(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(last.Data).To(Equal("{}"))
+			Expect(last.Redacted).To(BeTrue())
+			Expect(last.Hash).To(Equal("hash"))
+		})
+
+		It("returns ErrAuditEntryNotFound for an unknown sequence", func() {
+			Expect(auditStore.Redact(ctx, 9, "{}")).To(MatchError(store.ErrAuditEntryNotFound))
+		})
+	})
+})
@@ -58,14 +58,26 @@ func InitDB(cfg *config.Config) (*gorm.DB, error) {
 	sqlDB.SetMaxOpenConns(100)
 
 	// Auto-migrate schema
-	if err := db.AutoMigrate(&model.Policy{}); err != nil {
+	if err := db.AutoMigrate(&model.Policy{}, &model.AuditEntry{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{}, &model.EngineAPIKey{}); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
+	if err := dropUnusedIndexes(db); err != nil {
+		return nil, fmt.Errorf("failed to drop unused database indexes: %w", err)
+	}
+	if err := backfillRevisions(db); err != nil {
+		return nil, fmt.Errorf("failed to backfill policy revisions: %w", err)
+	}
 
 	slog.Info("Database schema migrated")
 	return db, nil
 }
 
+// dropUnusedIndexes drops the indexes earlier schemas created that no query uses: the
+// PostgreSQL GIN index over label selectors, which are matched in the engine, not in queries
+func dropUnusedIndexes(db *gorm.DB) error {
+	return db.Exec("DROP INDEX IF EXISTS idx_policies_label_selector").Error
+}
+
 // gormLogLevelFromString maps the application log level string to GORM and slog levels.
 func gormLogLevelFromString(level string) (logger.LogLevel, slog.Level) {
 	switch strings.ToLower(level) {
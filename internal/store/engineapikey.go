@@ -0,0 +1,109 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEngineAPIKeyNotFound = errors.New("engine API key not found")
+	ErrEngineAPIKeyIDTaken  = errors.New("engine API key ID already exists")
+)
+
+// EngineAPIKeyListOptions contains options for listing engine API keys.
+type EngineAPIKeyListOptions struct {
+	Offset   int
+	PageSize int
+}
+
+// EngineAPIKeyListResult contains the result of an engine API key List operation.
+type EngineAPIKeyListResult struct {
+	Keys model.EngineAPIKeyList
+	// NextOffset is the offset of the next page, or zero when this is the last page
+	NextOffset int
+}
+
+// EngineAPIKey stores the API keys of engine API callers
+type EngineAPIKey interface {
+	Create(ctx context.Context, key model.EngineAPIKey) (*model.EngineAPIKey, error)
+	List(ctx context.Context, opts *EngineAPIKeyListOptions) (*EngineAPIKeyListResult, error)
+	// GetByDigest returns the key with the SHA-256 digest
+	GetByDigest(ctx context.Context, digest string) (*model.EngineAPIKey, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type EngineAPIKeyStore struct {
+	db *gorm.DB
+}
+
+var _ EngineAPIKey = (*EngineAPIKeyStore)(nil)
+
+func NewEngineAPIKey(db *gorm.DB) EngineAPIKey {
+	return &EngineAPIKeyStore{db: db}
+}
+
+func (s *EngineAPIKeyStore) Create(ctx context.Context, key model.EngineAPIKey) (*model.EngineAPIKey, error) {
+	if err := s.db.WithContext(ctx).Create(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(strings.ToLower(err.Error()), "unique") {
+			return nil, ErrEngineAPIKeyIDTaken
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+// List returns the keys, oldest first
+func (s *EngineAPIKeyStore) List(ctx context.Context, opts *EngineAPIKeyListOptions) (*EngineAPIKeyListResult, error) {
+	pageSize := 50
+	offset := 0
+	if opts != nil {
+		if opts.PageSize > 0 {
+			pageSize = opts.PageSize
+		}
+		if opts.Offset > 0 {
+			offset = opts.Offset
+		}
+	}
+
+	var keys model.EngineAPIKeyList
+	err := s.db.WithContext(ctx).
+		Order("create_time ASC, id ASC").
+		Limit(pageSize + 1).Offset(offset).
+		Find(&keys).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EngineAPIKeyListResult{Keys: keys}
+	if len(keys) > pageSize {
+		result.Keys = keys[:pageSize]
+		result.NextOffset = offset + pageSize
+	}
+	return result, nil
+}
+
+func (s *EngineAPIKeyStore) GetByDigest(ctx context.Context, digest string) (*model.EngineAPIKey, error) {
+	var key model.EngineAPIKey
+	if err := s.db.WithContext(ctx).First(&key, "digest = ?", digest).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEngineAPIKeyNotFound
+		}
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (s *EngineAPIKeyStore) Delete(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&model.EngineAPIKey{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrEngineAPIKeyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package store_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("EngineAPIKey Store", func() {
+	var (
+		db       *gorm.DB
+		keyStore store.EngineAPIKey
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.EngineAPIKey{})).To(Succeed())
+
+		keyStore = store.NewEngineAPIKey(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	newKey := func(id, digest string, createTime time.Time) model.EngineAPIKey {
+		return model.EngineAPIKey{
+			ID:         id,
+			Identity:   "orchestrator",
+			Digest:     strings.Repeat(digest, 64),
+			Prefix:     "pmk_" + digest,
+			CreateTime: createTime,
+		}
+	}
+
+	It("stores keys, lists them oldest first and finds them by digest", func() {
+		now := time.Now()
+		for _, key := range []model.EngineAPIKey{
+			newKey("second", "b", now.Add(time.Second)),
+			newKey("first", "a", now),
+		} {
+			_, err := keyStore.Create(ctx, key)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		page, err := keyStore.List(ctx, &store.EngineAPIKeyListOptions{PageSize: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page.Keys).To(HaveLen(1))
+		Expect(page.Keys[0].ID).To(Equal("first"))
+		Expect(page.NextOffset).To(Equal(1))
+
+		got, err := keyStore.GetByDigest(ctx, strings.Repeat("b", 64))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.ID).To(Equal("second"))
+		Expect(got.Identity).To(Equal("orchestrator"))
+	})
+
+	It("rejects a taken ID", func() {
+		_, err := keyStore.Create(ctx, newKey("orchestrator", "a", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = keyStore.Create(ctx, newKey("orchestrator", "b", time.Now()))
+		Expect(err).To(MatchError(store.ErrEngineAPIKeyIDTaken))
+	})
+
+	It("deletes keys", func() {
+		_, err := keyStore.Create(ctx, newKey("orchestrator", "a", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(keyStore.Delete(ctx, "orchestrator")).To(Succeed())
+		_, err = keyStore.GetByDigest(ctx, strings.Repeat("a", 64))
+		Expect(err).To(MatchError(store.ErrEngineAPIKeyNotFound))
+		Expect(keyStore.Delete(ctx, "orchestrator")).To(MatchError(store.ErrEngineAPIKeyNotFound))
+	})
+})
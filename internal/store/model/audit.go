@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// AuditEntry is one link of the hash-chained audit log. Sequence is assigned by the writer
+// rather than the database so concurrent appends of the same link fail on the primary key.
+type AuditEntry struct {
+	Sequence   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Time       time.Time `gorm:"column:time;not null"`
+	Type       string    `gorm:"column:type;not null;index"`
+	Subject    string    `gorm:"column:subject;index"`
+	Data       string    `gorm:"column:data;type:text;not null"`
+	DataDigest string    `gorm:"column:data_digest;not null"`
+	PrevHash   string    `gorm:"column:prev_hash;not null"`
+	Hash       string    `gorm:"column:hash;not null"`
+	// Redacted marks details scrubbed after recording. DataDigest and Hash still cover the
+	// original details; the scrubbed ones are covered by the entry recording the scrub.
+	Redacted bool `gorm:"column:redacted;not null;default:false"`
+}
+
+type AuditEntryList []AuditEntry
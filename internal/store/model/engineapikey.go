@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// EngineAPIKey is an API key identifying engine API callers as Identity. Only the SHA-256
+// digest of the key is stored; Prefix is the start of the key, kept to tell keys apart.
+type EngineAPIKey struct {
+	ID         string    `gorm:"primaryKey;type:varchar(63)"`
+	Identity   string    `gorm:"column:identity;not null"`
+	Digest     string    `gorm:"column:digest;type:char(64);not null;uniqueIndex"`
+	Prefix     string    `gorm:"column:prefix;not null"`
+	CreatedBy  string    `gorm:"column:created_by"`
+	CreateTime time.Time `gorm:"column:create_time;autoCreateTime"`
+}
+
+type EngineAPIKeyList []EngineAPIKey
@@ -5,17 +5,33 @@ import (
 	"time"
 )
 
+// Policy is a stored policy. Fields tagged <-:create are immutable: they are written on
+// create and never updated; every other field is stored by each update.
 type Policy struct {
-	ID            string            `gorm:"primaryKey;type:varchar(63)"`
-	DisplayName   string            `gorm:"column:display_name;not null;uniqueIndex:idx_display_name_policy_type"`
-	Description   string            `gorm:"column:description"`
-	PolicyType    string            `gorm:"column:policy_type;not null;uniqueIndex:idx_display_name_policy_type;uniqueIndex:idx_priority_policy_type"`
-	LabelSelector map[string]string `gorm:"column:label_selector;serializer:json"`
-	Priority      int32             `gorm:"column:priority;not null;uniqueIndex:idx_priority_policy_type"`
-	RegoCode      string            `gorm:"column:rego_code;type:text;not null"`
-	Enabled       bool              `gorm:"column:enabled;not null"`
-	CreateTime    time.Time         `gorm:"column:create_time;autoCreateTime"`
-	UpdateTime    time.Time         `gorm:"column:update_time;autoUpdateTime"`
+	ID                string            `gorm:"primaryKey;type:varchar(63);<-:create"`
+	DisplayName       string            `gorm:"column:display_name;not null;uniqueIndex:idx_display_name_policy_type"`
+	Description       string            `gorm:"column:description"`
+	PolicyType        string            `gorm:"column:policy_type;<-:create;not null;uniqueIndex:idx_display_name_policy_type;uniqueIndex:idx_priority_policy_type;index:idx_policies_enabled_type_priority,priority:2"`
+	LabelSelector     map[string]string `gorm:"column:label_selector;serializer:json"`
+	Priority          int32             `gorm:"column:priority;not null;uniqueIndex:idx_priority_policy_type;index;index:idx_policies_enabled_type_priority,priority:3"`
+	RegoCode          string            `gorm:"column:rego_code;type:text;not null"`
+	RejectionMessages map[string]string `gorm:"column:rejection_messages;serializer:json"`
+	Annotations       map[string]string `gorm:"column:annotations;serializer:json"`
+	Parameters        map[string]any    `gorm:"column:parameters;serializer:json"`
+	Documentation     Documentation     `gorm:"column:documentation;serializer:json"`
+	Enabled           bool              `gorm:"column:enabled;not null;index:idx_policies_enabled_type_priority,priority:1;index:idx_policies_enabled_update_time,priority:1"`
+	CreateTime        time.Time         `gorm:"column:create_time;autoCreateTime;index;<-:create"`
+	UpdateTime        time.Time         `gorm:"column:update_time;autoUpdateTime;index;index:idx_policies_enabled_update_time,priority:2"`
+	CreatedBy         string            `gorm:"column:created_by;index;<-:create"`
+	UpdatedBy         string            `gorm:"column:updated_by;index"`
 }
 
 type PolicyList []Policy
+
+// Documentation is the human-readable documentation of a policy published in the catalog
+type Documentation struct {
+	Summary        string `json:"summary,omitempty"`
+	Rationale      string `json:"rationale,omitempty"`
+	RemediationURL string `json:"remediation_url,omitempty"`
+	OwnerContact   string `json:"owner_contact,omitempty"`
+}
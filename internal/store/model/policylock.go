@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// PolicyLock is an advisory lock an editor holds on a policy until ExpireTime. Locks are
+// deleted with their policy; an expired lock is ignored and replaced by the next lock.
+type PolicyLock struct {
+	PolicyID   string    `gorm:"primaryKey;type:varchar(63)"`
+	Owner      string    `gorm:"column:owner;not null"`
+	ExpireTime time.Time `gorm:"column:expire_time;not null;index"`
+}
+
+// Active reports whether the lock is still held at now
+func (l PolicyLock) Active(now time.Time) bool {
+	return l.ExpireTime.After(now)
+}
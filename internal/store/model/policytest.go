@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// PolicyTest is a test case of a policy: a service instance spec and the decision expected
+// when it is evaluated. Test cases are deleted with their policy.
+type PolicyTest struct {
+	PolicyID    string            `gorm:"primaryKey;type:varchar(63)"`
+	ID          string            `gorm:"primaryKey;type:varchar(63)"`
+	DisplayName string            `gorm:"column:display_name"`
+	Spec        map[string]any    `gorm:"column:spec;serializer:json;not null"`
+	Expected    PolicyTestOutcome `gorm:"column:expected;serializer:json;not null"`
+	CreateTime  time.Time         `gorm:"column:create_time;autoCreateTime"`
+}
+
+type PolicyTestList []PolicyTest
+
+// PolicyTestOutcome is the expected decision of a test case. Status is always compared; the
+// other fields only when they are set.
+type PolicyTestOutcome struct {
+	Status        string         `json:"status"`
+	PolicyOutcome string         `json:"policy_outcome,omitempty"`
+	EvaluatedSpec map[string]any `json:"evaluated_spec,omitempty"`
+	Detail        string         `json:"detail,omitempty"`
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+)
+
+// PolicyRevision is an immutable snapshot of a policy as it was after a create or update.
+// Revisions are numbered from 1 per policy in the order the changes were committed.
+type PolicyRevision struct {
+	PolicyID          string            `gorm:"primaryKey;type:varchar(63)"`
+	Revision          int64             `gorm:"primaryKey;autoIncrement:false"`
+	CreateTime        time.Time         `gorm:"column:create_time;not null"`
+	DisplayName       string            `gorm:"column:display_name;not null"`
+	Description       string            `gorm:"column:description"`
+	PolicyType        string            `gorm:"column:policy_type;not null"`
+	LabelSelector     map[string]string `gorm:"column:label_selector;serializer:json"`
+	Priority          int32             `gorm:"column:priority;not null"`
+	RegoCode          string            `gorm:"column:rego_code;type:text;not null"`
+	RejectionMessages map[string]string `gorm:"column:rejection_messages;serializer:json"`
+	Annotations       map[string]string `gorm:"column:annotations;serializer:json"`
+	Parameters        map[string]any    `gorm:"column:parameters;serializer:json"`
+	Documentation     Documentation     `gorm:"column:documentation;serializer:json"`
+	Enabled           bool              `gorm:"column:enabled;not null"`
+	PolicyCreateTime  time.Time         `gorm:"column:policy_create_time;not null"`
+	UpdatedBy         string            `gorm:"column:updated_by"`
+	PolicyCreatedBy   string            `gorm:"column:policy_created_by"`
+}
+
+type PolicyRevisionList []PolicyRevision
+
+// NewPolicyRevision snapshots policy as the given revision, made at the policy's update time
+func NewPolicyRevision(policy Policy, revision int64) PolicyRevision {
+	return PolicyRevision{
+		PolicyID:          policy.ID,
+		Revision:          revision,
+		CreateTime:        policy.UpdateTime,
+		DisplayName:       policy.DisplayName,
+		Description:       policy.Description,
+		PolicyType:        policy.PolicyType,
+		LabelSelector:     policy.LabelSelector,
+		Priority:          policy.Priority,
+		RegoCode:          policy.RegoCode,
+		RejectionMessages: policy.RejectionMessages,
+		Annotations:       policy.Annotations,
+		Parameters:        policy.Parameters,
+		Documentation:     policy.Documentation,
+		Enabled:           policy.Enabled,
+		PolicyCreateTime:  policy.CreateTime,
+		UpdatedBy:         policy.UpdatedBy,
+		PolicyCreatedBy:   policy.CreatedBy,
+	}
+}
+
+// Policy returns the policy as it was at this revision
+func (r PolicyRevision) Policy() Policy {
+	return Policy{
+		ID:                r.PolicyID,
+		DisplayName:       r.DisplayName,
+		Description:       r.Description,
+		PolicyType:        r.PolicyType,
+		LabelSelector:     r.LabelSelector,
+		Priority:          r.Priority,
+		RegoCode:          r.RegoCode,
+		RejectionMessages: r.RejectionMessages,
+		Annotations:       r.Annotations,
+		Parameters:        r.Parameters,
+		Documentation:     r.Documentation,
+		Enabled:           r.Enabled,
+		CreateTime:        r.PolicyCreateTime,
+		UpdateTime:        r.CreateTime,
+		CreatedBy:         r.PolicyCreatedBy,
+		UpdatedBy:         r.UpdatedBy,
+	}
+}
@@ -2,9 +2,9 @@ package store
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
-	"strconv"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/dcm-project/policy-manager/internal/store/model"
@@ -17,36 +17,99 @@ var (
 	ErrPolicyIDTaken              = errors.New("policy ID already taken")
 	ErrDisplayNamePolicyTypeTaken = errors.New("display_name and policy_type combination already taken")
 	ErrPriorityPolicyTypeTaken    = errors.New("priority and policy_type combination already taken")
+	ErrInvalidOrderBy             = errors.New("invalid order by clause")
+	ErrInvalidFilter              = errors.New("invalid filter")
 )
 
+// PolicyOrderByFields are the columns policy listings can be ordered by. List rejects an
+// OrderBy naming any other column.
+var PolicyOrderByFields = []string{"id", "display_name", "policy_type", "priority", "enabled", "create_time", "update_time"}
+
 // PolicyFilter contains optional fields for filtering policy queries.
 // nil fields are ignored (not filtered).
 type PolicyFilter struct {
 	PolicyType *string
 	Enabled    *bool
+	CreatedBy  *string
+	UpdatedBy  *string
+	// IDAfter keeps the policies whose ID sorts after it; ordered by "id ASC" it pages by key
+	// instead of offset, so policies created or deleted between pages shift nothing
+	IDAfter *string
+	// Comparisons must all hold
+	Comparisons []PolicyComparison
+}
+
+// PolicyComparison compares a column with a value
+type PolicyComparison struct {
+	// Field is one of PolicyComparisonFields
+	Field string
+	// Operator is one of =, <, <=, > or >=
+	Operator string
+	// Value is an int32 for priority and a time.Time for create_time and update_time
+	Value any
 }
 
+// PolicyComparisonFields are the columns a PolicyComparison can compare; each is indexed
+var PolicyComparisonFields = []string{"priority", "create_time", "update_time"}
+
+// policyComparisonOperators are the operators a PolicyComparison can use
+var policyComparisonOperators = []string{"=", "<", "<=", ">", ">="}
+
 // PolicyListOptions contains options for listing policies.
 type PolicyListOptions struct {
-	Filter    *PolicyFilter
-	OrderBy   string
-	PageToken *string
-	PageSize  int
+	Filter *PolicyFilter
+	// OrderBy is a comma-separated list of "<column> ASC|DESC" of PolicyOrderByFields
+	OrderBy  string
+	Offset   int
+	PageSize int
 }
 
 // PolicyListResult contains the result of a List operation.
 type PolicyListResult struct {
-	Policies      model.PolicyList
-	NextPageToken string
+	Policies model.PolicyList
+	// NextOffset is the offset of the next page, or zero when this is the last page
+	NextOffset int
+}
+
+// PrincipalCounts counts the policies and policy revisions created or updated by a principal.
+type PrincipalCounts struct {
+	Policies  int64
+	Revisions int64
+}
+
+// PolicyFacets counts policies per distinct value of the fields clients filter by.
+type PolicyFacets struct {
+	PolicyTypes map[string]int
+	// LabelSelectors maps each label selector key to the count of policies per selected value
+	LabelSelectors map[string]map[string]int
+	Priorities     map[int32]int
 }
 
 type Policy interface {
 	List(ctx context.Context, opts *PolicyListOptions) (*PolicyListResult, error)
 	ListAll(ctx context.Context) (model.PolicyList, error)
 	Create(ctx context.Context, policy model.Policy) (*model.Policy, error)
+	// CreateAll creates policies together: all of them or, on error, none. The error of a
+	// policy that cannot be created is a *BatchError.
+	CreateAll(ctx context.Context, policies []model.Policy) ([]model.Policy, error)
 	Delete(ctx context.Context, id string) error
+	// Discard removes a policy whose creation is rolled back, together with the revisions
+	// stored since it was created. Revisions of a deleted policy with the same ID are kept.
+	Discard(ctx context.Context, id string) error
 	Update(ctx context.Context, policy model.Policy) (*model.Policy, error)
+	// Revert stores policy, the state a rolled-back update replaced, and removes the revision
+	// the update stored, so the rollback leaves no revision behind
+	Revert(ctx context.Context, policy model.Policy) error
 	Get(ctx context.Context, id string) (*model.Policy, error)
+	Facets(ctx context.Context) (*PolicyFacets, error)
+	// CheckUnique returns the unique constraint error Create, or Update when isUpdate, would
+	// return for policy without writing it, or nil when no constraint would be violated.
+	CheckUnique(ctx context.Context, policy model.Policy, isUpdate bool) error
+	// CountPrincipal counts the policies and revisions created or updated by principal
+	CountPrincipal(ctx context.Context, principal string) (PrincipalCounts, error)
+	// RedactPrincipal replaces principal as the creator and updater of policies and their
+	// revisions with replacement, and returns the counts of those it changed
+	RedactPrincipal(ctx context.Context, principal, replacement string) (PrincipalCounts, error)
 }
 
 type PolicyStore struct {
@@ -69,15 +132,9 @@ func (s *PolicyStore) List(ctx context.Context, opts *PolicyListOptions) (*Polic
 		pageSize = opts.PageSize
 	}
 
-	// Decode page token to get offset
 	offset := 0
-	if opts != nil && opts.PageToken != nil && *opts.PageToken != "" {
-		decoded, err := base64.StdEncoding.DecodeString(*opts.PageToken)
-		if err == nil {
-			if parsedOffset, err := strconv.Atoi(string(decoded)); err == nil {
-				offset = parsedOffset
-			}
-		}
+	if opts != nil && opts.Offset > 0 {
+		offset = opts.Offset
 	}
 
 	if opts != nil {
@@ -88,10 +145,29 @@ func (s *PolicyStore) List(ctx context.Context, opts *PolicyListOptions) (*Polic
 			if opts.Filter.Enabled != nil {
 				query = query.Where("enabled = ?", *opts.Filter.Enabled)
 			}
+			if opts.Filter.CreatedBy != nil {
+				query = query.Where("created_by = ?", *opts.Filter.CreatedBy)
+			}
+			if opts.Filter.UpdatedBy != nil {
+				query = query.Where("updated_by = ?", *opts.Filter.UpdatedBy)
+			}
+			if opts.Filter.IDAfter != nil {
+				query = query.Where("id > ?", *opts.Filter.IDAfter)
+			}
+			for _, c := range opts.Filter.Comparisons {
+				// Field and operator are interpolated, so only known ones are accepted
+				if !slices.Contains(PolicyComparisonFields, c.Field) || !slices.Contains(policyComparisonOperators, c.Operator) {
+					return nil, fmt.Errorf("%w: '%s %s'", ErrInvalidFilter, c.Field, c.Operator)
+				}
+				query = query.Where(c.Field+" "+c.Operator+" ?", c.Value)
+			}
 		}
 
 		// Apply ordering
 		if opts.OrderBy != "" {
+			if err := validateOrderBy(opts.OrderBy); err != nil {
+				return nil, err
+			}
 			query = query.Order(opts.OrderBy)
 		} else {
 			// Default order by policy_type, priority, id ascending
@@ -117,14 +193,27 @@ func (s *PolicyStore) List(ctx context.Context, opts *PolicyListOptions) (*Polic
 	if len(policies) > pageSize {
 		// Trim to requested page size
 		result.Policies = policies[:pageSize]
-		// Encode next offset as page token
-		nextOffset := offset + pageSize
-		result.NextPageToken = base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(nextOffset)))
+		result.NextOffset = offset + pageSize
 	}
 
 	return result, nil
 }
 
+// validateOrderBy checks that orderBy only orders by PolicyOrderByFields, since it is passed
+// to the database as is
+func validateOrderBy(orderBy string) error {
+	for part := range strings.SplitSeq(orderBy, ",") {
+		tokens := strings.Fields(part)
+		if len(tokens) == 0 || len(tokens) > 2 || !slices.Contains(PolicyOrderByFields, tokens[0]) {
+			return fmt.Errorf("%w: '%s'", ErrInvalidOrderBy, strings.TrimSpace(part))
+		}
+		if len(tokens) == 2 && !strings.EqualFold(tokens[1], "ASC") && !strings.EqualFold(tokens[1], "DESC") {
+			return fmt.Errorf("%w: '%s'", ErrInvalidOrderBy, strings.TrimSpace(part))
+		}
+	}
+	return nil
+}
+
 // mapUniqueConstraintError maps a DB unique constraint violation to a store sentinel error.
 // by querying the DB to see which constraint would be violated (ID, display_name+policy_type, or priority+policy_type).
 func (s *PolicyStore) mapUniqueConstraintError(ctx context.Context, err error, attempted model.Policy, isUpdate bool) error {
@@ -139,6 +228,24 @@ func (s *PolicyStore) mapUniqueConstraintError(ctx context.Context, err error, a
 		}
 	}
 
+	if taken, dberr := s.takenConstraint(ctx, attempted, isUpdate); dberr == nil && taken != nil {
+		return taken
+	}
+	return err
+}
+
+// CheckUnique returns the sentinel error of the first unique constraint policy would violate.
+func (s *PolicyStore) CheckUnique(ctx context.Context, policy model.Policy, isUpdate bool) error {
+	taken, err := s.takenConstraint(ctx, policy, isUpdate)
+	if err != nil {
+		return err
+	}
+	return taken
+}
+
+// takenConstraint returns the sentinel error of the first unique constraint (ID,
+// display_name+policy_type, or priority+policy_type) another stored policy already holds
+func (s *PolicyStore) takenConstraint(ctx context.Context, attempted model.Policy, isUpdate bool) (taken error, err error) {
 	checks := []struct {
 		sentinel error
 		query    *gorm.DB
@@ -156,50 +263,161 @@ func (s *PolicyStore) mapUniqueConstraintError(ctx context.Context, err error, a
 		var row model.Policy
 		dberr := query.First(&row).Error
 		if dberr == nil {
-			return c.sentinel
+			return c.sentinel, nil
 		}
 		if !errors.Is(dberr, gorm.ErrRecordNotFound) {
-			return err
+			return nil, dberr
 		}
 	}
-
-	return err
+	return nil, nil
 }
 
+// Create stores policy and its first revision in one transaction.
 func (s *PolicyStore) Create(ctx context.Context, policy model.Policy) (*model.Policy, error) {
-	if err := s.db.WithContext(ctx).Clauses(clause.Returning{}).Select("*").Create(&policy).Error; err != nil {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Returning{}).Select("*").Create(&policy).Error; err != nil {
+			return err
+		}
+		return appendRevision(tx, policy)
+	})
+	if err != nil {
 		return nil, s.mapUniqueConstraintError(ctx, err, policy, false)
 	}
 	return &policy, nil
 }
 
+// BatchError reports the policy of a batch that could not be stored, by its index in the batch
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("policy %d of the batch: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
+}
+
+// CreateAll stores policies and their first revisions in one transaction.
+func (s *PolicyStore) CreateAll(ctx context.Context, policies []model.Policy) ([]model.Policy, error) {
+	created := slices.Clone(policies)
+	failed := -1
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range created {
+			failed = i
+			if err := tx.Clauses(clause.Returning{}).Select("*").Create(&created[i]).Error; err != nil {
+				return err
+			}
+			if err := appendRevision(tx, created[i]); err != nil {
+				return err
+			}
+		}
+		failed = -1
+		return nil
+	})
+	if err != nil {
+		if failed < 0 {
+			return nil, err
+		}
+		return nil, &BatchError{Index: failed, Err: s.mapUniqueConstraintError(ctx, err, policies[failed], false)}
+	}
+	return created, nil
+}
+
+// Delete removes a policy together with its test cases and lock. Its revisions are kept as
+// the history of the deleted policy.
 func (s *PolicyStore) Delete(ctx context.Context, id string) error {
-	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&model.Policy{})
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deletePolicy(tx, id)
+	})
+}
+
+// Discard removes a policy like Delete, and the revisions stored since it was created: those
+// whose policy create time is the policy's.
+func (s *PolicyStore) Discard(ctx context.Context, id string) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var policy model.Policy
+		if err := tx.Select("create_time").First(&policy, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPolicyNotFound
+			}
+			return err
+		}
+		err := tx.Where("policy_id = ? AND policy_create_time = ?", id, policy.CreateTime).
+			Delete(&model.PolicyRevision{}).Error
+		if err != nil {
+			return err
+		}
+		return deletePolicy(tx, id)
+	})
+}
+
+// deletePolicy removes policy id and its test cases and lock in tx
+func deletePolicy(tx *gorm.DB, id string) error {
+	result := tx.Where("id = ?", id).Delete(&model.Policy{})
 	if result.Error != nil {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
 		return ErrPolicyNotFound
 	}
-	return nil
+	if err := tx.Where("policy_id = ?", id).Delete(&model.PolicyTest{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("policy_id = ?", id).Delete(&model.PolicyLock{}).Error
 }
 
+// Update stores the mutable fields of policy and its next revision in one transaction.
 func (s *PolicyStore) Update(ctx context.Context, policy model.Policy) (*model.Policy, error) {
-	// Use Select to update all mutable fields including zero values
-	// Immutable fields (id, policy_type, create_time) are not updated
-	result := s.db.WithContext(ctx).Model(&policy).
-		Select("display_name", "description", "label_selector", "priority", "rego_code", "enabled").
-		Clauses(clause.Returning{}).
-		Updates(&policy)
-	if result.Error != nil {
-		return nil, s.mapUniqueConstraintError(ctx, result.Error, policy, true)
-	}
-	if result.RowsAffected == 0 {
-		return nil, ErrPolicyNotFound
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Select every column so zero values are stored too; columns tagged <-:create in the
+		// model (id, policy_type, create_time, created_by) are immutable and never updated
+		result := tx.Model(&policy).
+			Select("*").
+			Clauses(clause.Returning{}).
+			Updates(&policy)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPolicyNotFound
+		}
+		return appendRevision(tx, policy)
+	})
+	if err != nil {
+		if errors.Is(err, ErrPolicyNotFound) {
+			return nil, err
+		}
+		return nil, s.mapUniqueConstraintError(ctx, err, policy, true)
 	}
 	return &policy, nil
 }
 
+// Revert stores policy, update time included, in place of the update stored last, and
+// removes its latest revision, the one that update appended, in one transaction.
+func (s *PolicyStore) Revert(ctx context.Context, policy model.Policy) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&policy).Select("*").UpdateColumns(&policy)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPolicyNotFound
+		}
+		var last int64
+		err := tx.Model(&model.PolicyRevision{}).
+			Where("policy_id = ?", policy.ID).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("policy_id = ? AND revision = ?", policy.ID, last).Delete(&model.PolicyRevision{}).Error
+	})
+}
+
 func (s *PolicyStore) ListAll(ctx context.Context) (model.PolicyList, error) {
 	var policies model.PolicyList
 	if err := s.db.WithContext(ctx).Order("id ASC").Find(&policies).Error; err != nil {
@@ -221,3 +439,80 @@ func (s *PolicyStore) Get(ctx context.Context, id string) (*model.Policy, error)
 	}
 	return &policy, nil
 }
+
+// Facets counts the distinct policy types, label selector values and priorities.
+// Only the counted columns are loaded; label selectors are stored as JSON and counted here.
+func (s *PolicyStore) Facets(ctx context.Context) (*PolicyFacets, error) {
+	var policies model.PolicyList
+	if err := s.db.WithContext(ctx).Select("policy_type", "label_selector", "priority").Find(&policies).Error; err != nil {
+		return nil, err
+	}
+
+	facets := &PolicyFacets{
+		PolicyTypes:    map[string]int{},
+		LabelSelectors: map[string]map[string]int{},
+		Priorities:     map[int32]int{},
+	}
+	for _, policy := range policies {
+		facets.PolicyTypes[policy.PolicyType]++
+		facets.Priorities[policy.Priority]++
+		for key, value := range policy.LabelSelector {
+			if facets.LabelSelectors[key] == nil {
+				facets.LabelSelectors[key] = map[string]int{}
+			}
+			facets.LabelSelectors[key][value]++
+		}
+	}
+	return facets, nil
+}
+
+// CountPrincipal counts the policies and revisions created or updated by principal
+func (s *PolicyStore) CountPrincipal(ctx context.Context, principal string) (PrincipalCounts, error) {
+	return countPrincipal(s.db.WithContext(ctx), principal)
+}
+
+// RedactPrincipal replaces principal in the created_by and updated_by columns of policies and
+// revisions in one transaction. The columns are written directly, so neither update times nor
+// revisions change.
+func (s *PolicyStore) RedactPrincipal(ctx context.Context, principal, replacement string) (PrincipalCounts, error) {
+	var counts PrincipalCounts
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		if counts, err = countPrincipal(tx, principal); err != nil {
+			return err
+		}
+		for _, column := range []struct{ table, name string }{
+			{"policies", "created_by"},
+			{"policies", "updated_by"},
+			{"policy_revisions", "policy_created_by"},
+			{"policy_revisions", "updated_by"},
+		} {
+			err := tx.Table(column.table).Where(column.name+" = ?", principal).UpdateColumn(column.name, replacement).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return PrincipalCounts{}, err
+	}
+	return counts, nil
+}
+
+func countPrincipal(db *gorm.DB, principal string) (PrincipalCounts, error) {
+	var counts PrincipalCounts
+	err := db.Model(&model.Policy{}).
+		Where("created_by = ? OR updated_by = ?", principal, principal).
+		Count(&counts.Policies).Error
+	if err != nil {
+		return PrincipalCounts{}, err
+	}
+	err = db.Model(&model.PolicyRevision{}).
+		Where("policy_created_by = ? OR updated_by = ?", principal, principal).
+		Count(&counts.Revisions).Error
+	if err != nil {
+		return PrincipalCounts{}, err
+	}
+	return counts, nil
+}
@@ -2,6 +2,11 @@ package store_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/dcm-project/policy-manager/internal/store"
 	"github.com/dcm-project/policy-manager/internal/store/model"
@@ -25,7 +30,7 @@ var _ = Describe("Policy Store", func() {
 			Logger: logger.Default.LogMode(logger.Silent),
 		})
 		Expect(err).NotTo(HaveOccurred())
-		Expect(db.AutoMigrate(&model.Policy{})).To(Succeed())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
 
 		policyStore = store.NewPolicy(db)
 		ctx = context.Background()
@@ -86,6 +91,36 @@ var _ = Describe("Policy Store", func() {
 		})
 	})
 
+	Describe("CreateAll", func() {
+		It("persists every policy with its first revision", func() {
+			created, err := policyStore.CreateAll(ctx, []model.Policy{newPolicy("batch-a"), newPolicy("batch-b")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(HaveLen(2))
+			Expect(created[1].ID).To(Equal("batch-b"))
+
+			policies, err := policyStore.ListAll(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies).To(HaveLen(2))
+			var revisions int64
+			Expect(db.Model(&model.PolicyRevision{}).Count(&revisions).Error).To(Succeed())
+			Expect(revisions).To(Equal(int64(2)))
+		})
+
+		It("persists none of the policies when one fails, reporting its index", func() {
+			_, err := policyStore.Create(ctx, newPolicy("taken"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = policyStore.CreateAll(ctx, []model.Policy{newPolicy("batch-a"), newPolicy("taken")})
+			var batchErr *store.BatchError
+			Expect(errors.As(err, &batchErr)).To(BeTrue())
+			Expect(batchErr.Index).To(Equal(1))
+			Expect(errors.Is(err, store.ErrPolicyIDTaken)).To(BeTrue())
+
+			_, err = policyStore.Get(ctx, "batch-a")
+			Expect(err).To(Equal(store.ErrPolicyNotFound))
+		})
+	})
+
 	Describe("Get", func() {
 		It("retrieves by ID", func() {
 			p := newPolicy("get-test")
@@ -116,7 +151,7 @@ var _ = Describe("Policy Store", func() {
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result.Policies).To(HaveLen(2))
-			Expect(result.NextPageToken).To(BeEmpty())
+			Expect(result.NextOffset).To(BeZero())
 		})
 
 		It("filters by policy type", func() {
@@ -163,6 +198,50 @@ var _ = Describe("Policy Store", func() {
 			Expect(result.Policies[0].ID).To(Equal("enabled-policy"))
 		})
 
+		It("filters by comparisons", func() {
+			for i, id := range []string{"low", "mid", "high"} {
+				p := newPolicy(id)
+				p.Priority = int32((i + 1) * 100)
+				_, err := policyStore.Create(ctx, p)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			result, err := policyStore.List(ctx, &store.PolicyListOptions{Filter: &store.PolicyFilter{
+				Comparisons: []store.PolicyComparison{
+					{Field: "priority", Operator: ">", Value: int32(100)},
+					{Field: "create_time", Operator: "<", Value: time.Now().Add(time.Hour)},
+				},
+			}})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(2))
+		})
+
+		It("filters by ID after a key", func() {
+			for _, id := range []string{"alpha", "bravo", "charlie"} {
+				_, err := policyStore.Create(ctx, newPolicy(id))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			after := "alpha"
+			result, err := policyStore.List(ctx, &store.PolicyListOptions{
+				Filter:  &store.PolicyFilter{IDAfter: &after},
+				OrderBy: "id ASC",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Policies).To(HaveLen(2))
+			Expect(result.Policies[0].ID).To(Equal("bravo"))
+			Expect(result.Policies[1].ID).To(Equal("charlie"))
+		})
+
+		It("rejects comparisons of other columns", func() {
+			_, err := policyStore.List(ctx, &store.PolicyListOptions{Filter: &store.PolicyFilter{
+				Comparisons: []store.PolicyComparison{{Field: "rego_code", Operator: "=", Value: "x"}},
+			}})
+			Expect(err).To(MatchError(store.ErrInvalidFilter))
+		})
+
 		It("filters by both policy type and enabled status", func() {
 			p1 := newPolicy("global-enabled")
 			p1.PolicyType = "GLOBAL"
@@ -244,6 +323,14 @@ var _ = Describe("Policy Store", func() {
 			Expect(result.Policies[1].DisplayName).To(Equal("Zebra Policy"))
 		})
 
+		It("rejects ordering by other columns", func() {
+			_, err := policyStore.List(ctx, &store.PolicyListOptions{OrderBy: "rego_code ASC"})
+			Expect(err).To(MatchError(store.ErrInvalidOrderBy))
+
+			_, err = policyStore.List(ctx, &store.PolicyListOptions{OrderBy: "priority; DROP TABLE policies"})
+			Expect(err).To(MatchError(store.ErrInvalidOrderBy))
+		})
+
 		It("applies page size for pagination", func() {
 			for i := 1; i <= 5; i++ {
 				p := newPolicy("policy-" + string(rune('0'+i)))
@@ -261,10 +348,10 @@ var _ = Describe("Policy Store", func() {
 			Expect(result.Policies).To(HaveLen(2))
 			Expect(result.Policies[0].Priority).To(Equal(int32(100)))
 			Expect(result.Policies[1].Priority).To(Equal(int32(200)))
-			Expect(result.NextPageToken).NotTo(BeEmpty())
+			Expect(result.NextOffset).NotTo(BeZero())
 		})
 
-		It("uses page token for pagination across multiple pages", func() {
+		It("uses offset for pagination across multiple pages", func() {
 			for i := 1; i <= 5; i++ {
 				p := newPolicy("policy-" + string(rune('0'+i)))
 				p.Priority = int32(i * 100)
@@ -282,12 +369,12 @@ var _ = Describe("Policy Store", func() {
 			Expect(result.Policies).To(HaveLen(2))
 			Expect(result.Policies[0].Priority).To(Equal(int32(100)))
 			Expect(result.Policies[1].Priority).To(Equal(int32(200)))
-			Expect(result.NextPageToken).NotTo(BeEmpty())
+			Expect(result.NextOffset).NotTo(BeZero())
 
-			// Second page using page token
+			// Second page using next offset
 			opts = &store.PolicyListOptions{
-				PageSize:  2,
-				PageToken: &result.NextPageToken,
+				PageSize: 2,
+				Offset:   result.NextOffset,
 			}
 			result, err = policyStore.List(ctx, opts)
 
@@ -295,19 +382,19 @@ var _ = Describe("Policy Store", func() {
 			Expect(result.Policies).To(HaveLen(2))
 			Expect(result.Policies[0].Priority).To(Equal(int32(300)))
 			Expect(result.Policies[1].Priority).To(Equal(int32(400)))
-			Expect(result.NextPageToken).NotTo(BeEmpty())
+			Expect(result.NextOffset).NotTo(BeZero())
 
 			// Third page (last page with 1 item)
 			opts = &store.PolicyListOptions{
-				PageSize:  2,
-				PageToken: &result.NextPageToken,
+				PageSize: 2,
+				Offset:   result.NextOffset,
 			}
 			result, err = policyStore.List(ctx, opts)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result.Policies).To(HaveLen(1))
 			Expect(result.Policies[0].Priority).To(Equal(int32(500)))
-			Expect(result.NextPageToken).To(BeEmpty())
+			Expect(result.NextOffset).To(BeZero())
 		})
 
 		It("uses default page size of 50 when not specified", func() {
@@ -323,7 +410,7 @@ var _ = Describe("Policy Store", func() {
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(result.Policies).To(HaveLen(50))
-			Expect(result.NextPageToken).NotTo(BeEmpty())
+			Expect(result.NextOffset).NotTo(BeZero())
 		})
 	})
 
@@ -376,6 +463,42 @@ var _ = Describe("Policy Store", func() {
 		})
 	})
 
+	Describe("Facets", func() {
+		It("counts policy types, label selector values and priorities", func() {
+			for i, selector := range []map[string]string{
+				{"env": "prod", "region": "us"},
+				{"env": "prod"},
+				nil,
+			} {
+				p := newPolicy("facet-" + string(rune('a'+i)))
+				p.LabelSelector = selector
+				p.Priority = int32(10 + i)
+				if i == 2 {
+					p.PolicyType = "USER"
+				}
+				_, err := policyStore.Create(ctx, p)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			facets, err := policyStore.Facets(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(facets.PolicyTypes).To(Equal(map[string]int{"GLOBAL": 2, "USER": 1}))
+			Expect(facets.LabelSelectors).To(Equal(map[string]map[string]int{
+				"env":    {"prod": 2},
+				"region": {"us": 1},
+			}))
+			Expect(facets.Priorities).To(Equal(map[int32]int{10: 1, 11: 1, 12: 1}))
+		})
+
+		It("returns empty facets on empty DB", func() {
+			facets, err := policyStore.Facets(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(facets.PolicyTypes).To(BeEmpty())
+			Expect(facets.LabelSelectors).To(BeEmpty())
+			Expect(facets.Priorities).To(BeEmpty())
+		})
+	})
+
 	Describe("RegoCode persistence", func() {
 		It("persists rego_code on create", func() {
 			p := newPolicy("rego-create")
@@ -422,6 +545,73 @@ var _ = Describe("Policy Store", func() {
 		})
 	})
 
+	Describe("RedactPrincipal", func() {
+		It("replaces the principal in policies and revisions without changing them otherwise", func() {
+			p := newPolicy("owned")
+			p.CreatedBy = "jdoe"
+			p.UpdatedBy = "jdoe"
+			created, err := policyStore.Create(ctx, p)
+			Expect(err).NotTo(HaveOccurred())
+			update := *created
+			update.UpdatedBy = "other"
+			_, err = policyStore.Update(ctx, update)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = policyStore.Create(ctx, newPolicy("unowned"))
+			Expect(err).NotTo(HaveOccurred())
+
+			counts, err := policyStore.CountPrincipal(ctx, "jdoe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal(store.PrincipalCounts{Policies: 1, Revisions: 2}))
+			before, err := policyStore.Get(ctx, "owned")
+			Expect(err).NotTo(HaveOccurred())
+
+			counts, err = policyStore.RedactPrincipal(ctx, "jdoe", "[REDACTED]")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(Equal(store.PrincipalCounts{Policies: 1, Revisions: 2}))
+			found, err := policyStore.Get(ctx, "owned")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.CreatedBy).To(Equal("[REDACTED]"))
+			Expect(found.UpdatedBy).To(Equal("other"))
+			Expect(found.UpdateTime).To(Equal(before.UpdateTime))
+			counts, err = policyStore.CountPrincipal(ctx, "jdoe")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeZero())
+		})
+	})
+
+	Describe("CheckUnique", func() {
+		BeforeEach(func() {
+			p := newPolicy("unique-a")
+			p.DisplayName = "Name A"
+			p.Priority = 100
+			_, err := policyStore.Create(ctx, p)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the error Create would return without storing the policy", func() {
+			p := newPolicy("unique-b")
+			Expect(policyStore.CheckUnique(ctx, p, false)).To(Succeed())
+
+			p.Priority = 100
+			Expect(policyStore.CheckUnique(ctx, p, false)).To(Equal(store.ErrPriorityPolicyTypeTaken))
+			p.DisplayName = "Name A"
+			Expect(policyStore.CheckUnique(ctx, p, false)).To(Equal(store.ErrDisplayNamePolicyTypeTaken))
+			p.ID = "unique-a"
+			Expect(policyStore.CheckUnique(ctx, p, false)).To(Equal(store.ErrPolicyIDTaken))
+
+			_, err := policyStore.Get(ctx, "unique-b")
+			Expect(err).To(Equal(store.ErrPolicyNotFound))
+		})
+
+		It("ignores the policy itself on update", func() {
+			p := newPolicy("unique-a")
+			p.DisplayName = "Name A"
+			p.Priority = 100
+			Expect(policyStore.CheckUnique(ctx, p, true)).To(Succeed())
+		})
+	})
+
 	Describe("Update", func() {
 		It("modifies existing policy", func() {
 			p := newPolicy("to-update")
@@ -479,9 +669,66 @@ var _ = Describe("Policy Store", func() {
 			_, err = policyStore.Update(ctx, p2)
 			Expect(err).To(Equal(store.ErrPriorityPolicyTypeTaken))
 		})
+
+		// Update derives its columns from the model: this fails when a field is added that
+		// Update does not store, or that is stored although it is tagged <-:create
+		It("stores every model field except those tagged <-:create", func() {
+			created, err := policyStore.Create(ctx, newPolicy("persisted"))
+			Expect(err).NotTo(HaveOccurred())
+
+			policyType := reflect.TypeFor[model.Policy]()
+			for i := range policyType.NumField() {
+				field := policyType.Field(i)
+				if field.Name == "ID" || field.Name == "UpdateTime" {
+					continue // the ID selects the row and the update time is set by every update
+				}
+				updated := *created
+				value := reflect.ValueOf(&updated).Elem().Field(i)
+				value.Set(changedValue(field, value))
+
+				_, err := policyStore.Update(ctx, updated)
+				Expect(err).NotTo(HaveOccurred(), field.Name)
+				stored, err := policyStore.Get(ctx, created.ID)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := value.Interface()
+				if strings.Contains(field.Tag.Get("gorm"), "<-:create") {
+					expected = reflect.ValueOf(created).Elem().Field(i).Interface()
+				}
+				actual := reflect.ValueOf(stored).Elem().Field(i).Interface()
+				if t, ok := expected.(time.Time); ok {
+					Expect(actual).To(BeTemporally("==", t), field.Name)
+				} else {
+					Expect(actual).To(Equal(expected), field.Name)
+				}
+			}
+		})
 	})
 })
 
+// changedValue returns a value of the model field other than value. Fields of new types need
+// a case here so the persistence test covers them.
+func changedValue(field reflect.StructField, value reflect.Value) reflect.Value {
+	switch v := value.Interface().(type) {
+	case string:
+		return reflect.ValueOf(v + "-changed")
+	case int32:
+		return reflect.ValueOf(v + 1)
+	case bool:
+		return reflect.ValueOf(!v)
+	case map[string]string:
+		return reflect.ValueOf(map[string]string{"changed": "true"})
+	case map[string]any:
+		return reflect.ValueOf(map[string]any{"changed": true})
+	case time.Time:
+		return reflect.ValueOf(v.Add(-time.Hour))
+	case model.Documentation:
+		return reflect.ValueOf(model.Documentation{Summary: v.Summary + "-changed"})
+	}
+	Fail(fmt.Sprintf("no changed value for field %s of type %s", field.Name, field.Type))
+	return value
+}
+
 func newPolicy(id string) model.Policy {
 	// Convert ID to a title-cased display name
 	displayName := ""
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPolicyLockNotFound = errors.New("policy lock not found")
+	ErrPolicyLockHeld     = errors.New("policy is locked by another owner")
+)
+
+// PolicyLock stores the advisory edit locks of policies. Locks are deleted with their policy
+// by the policy store. Times are compared in the database, so callers pass them in UTC.
+type PolicyLock interface {
+	// Acquire stores lock unless an unexpired lock of another owner is held at now, in which
+	// case it returns ErrPolicyLockHeld. Acquiring a lock again as its owner replaces it.
+	Acquire(ctx context.Context, lock model.PolicyLock, now time.Time) (*model.PolicyLock, error)
+	// Release deletes the lock of owner on a policy. It returns ErrPolicyLockHeld when an
+	// unexpired lock of another owner is held at now, and nil when there is no lock.
+	Release(ctx context.Context, policyID, owner string, now time.Time) error
+	// Get returns the lock on a policy held at now
+	Get(ctx context.Context, policyID string, now time.Time) (*model.PolicyLock, error)
+	// ListActive returns the locks held at now on the given policies, keyed by policy ID
+	ListActive(ctx context.Context, policyIDs []string, now time.Time) (map[string]model.PolicyLock, error)
+}
+
+type PolicyLockStore struct {
+	db *gorm.DB
+}
+
+var _ PolicyLock = (*PolicyLockStore)(nil)
+
+func NewPolicyLock(db *gorm.DB) PolicyLock {
+	return &PolicyLockStore{db: db}
+}
+
+func (s *PolicyLockStore) Acquire(ctx context.Context, lock model.PolicyLock, now time.Time) (*model.PolicyLock, error) {
+	// The conflict update only replaces a lock of the same owner or an expired one, so two
+	// editors racing for a lock cannot both win it
+	result := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "policy_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"owner", "expire_time"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "policy_locks.owner = ? OR policy_locks.expire_time <= ?", Vars: []any{lock.Owner, now}},
+		}},
+	}).Create(&lock)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrPolicyLockHeld
+	}
+	return &lock, nil
+}
+
+func (s *PolicyLockStore) Release(ctx context.Context, policyID, owner string, now time.Time) error {
+	result := s.db.WithContext(ctx).
+		Where("policy_id = ? AND (owner = ? OR expire_time <= ?)", policyID, owner, now).
+		Delete(&model.PolicyLock{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	if _, err := s.Get(ctx, policyID, now); !errors.Is(err, ErrPolicyLockNotFound) {
+		if err != nil {
+			return err
+		}
+		return ErrPolicyLockHeld
+	}
+	return nil
+}
+
+func (s *PolicyLockStore) Get(ctx context.Context, policyID string, now time.Time) (*model.PolicyLock, error) {
+	var lock model.PolicyLock
+	if err := s.db.WithContext(ctx).First(&lock, "policy_id = ? AND expire_time > ?", policyID, now).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPolicyLockNotFound
+		}
+		return nil, err
+	}
+	return &lock, nil
+}
+
+func (s *PolicyLockStore) ListActive(ctx context.Context, policyIDs []string, now time.Time) (map[string]model.PolicyLock, error) {
+	locks := make(map[string]model.PolicyLock)
+	if len(policyIDs) == 0 {
+		return locks, nil
+	}
+	var rows []model.PolicyLock
+	if err := s.db.WithContext(ctx).Where("policy_id IN ? AND expire_time > ?", policyIDs, now).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, lock := range rows {
+		locks[lock.PolicyID] = lock
+	}
+	return locks, nil
+}
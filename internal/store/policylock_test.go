@@ -0,0 +1,103 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("PolicyLock Store", func() {
+	var (
+		db          *gorm.DB
+		policyStore store.Policy
+		lockStore   store.PolicyLock
+		ctx         context.Context
+		now         time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+
+		policyStore = store.NewPolicy(db)
+		lockStore = store.NewPolicyLock(db)
+		ctx = context.Background()
+		now = time.Now().UTC()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	lock := func(owner string, expireTime time.Time) model.PolicyLock {
+		return model.PolicyLock{PolicyID: "locked", Owner: owner, ExpireTime: expireTime}
+	}
+
+	It("keeps a lock from other owners until it expires", func() {
+		_, err := lockStore.Acquire(ctx, lock("alice", now.Add(time.Minute)), now)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = lockStore.Acquire(ctx, lock("bob", now.Add(time.Minute)), now)
+		Expect(err).To(MatchError(store.ErrPolicyLockHeld))
+		Expect(lockStore.Release(ctx, "locked", "bob", now)).To(MatchError(store.ErrPolicyLockHeld))
+
+		later := now.Add(2 * time.Minute)
+		_, err = lockStore.Get(ctx, "locked", later)
+		Expect(err).To(MatchError(store.ErrPolicyLockNotFound))
+		acquired, err := lockStore.Acquire(ctx, lock("bob", later.Add(time.Minute)), later)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(acquired.Owner).To(Equal("bob"))
+	})
+
+	It("extends a lock acquired again by its owner and releases it", func() {
+		_, err := lockStore.Acquire(ctx, lock("alice", now.Add(time.Minute)), now)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = lockStore.Acquire(ctx, lock("alice", now.Add(time.Hour)), now)
+		Expect(err).NotTo(HaveOccurred())
+
+		held, err := lockStore.Get(ctx, "locked", now.Add(30*time.Minute))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(held.Owner).To(Equal("alice"))
+
+		Expect(lockStore.Release(ctx, "locked", "alice", now)).To(Succeed())
+		_, err = lockStore.Get(ctx, "locked", now)
+		Expect(err).To(MatchError(store.ErrPolicyLockNotFound))
+		Expect(lockStore.Release(ctx, "locked", "alice", now)).To(Succeed())
+	})
+
+	It("lists the active locks of the given policies", func() {
+		_, err := lockStore.Acquire(ctx, lock("alice", now.Add(time.Minute)), now)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = lockStore.Acquire(ctx, model.PolicyLock{PolicyID: "expired", Owner: "bob", ExpireTime: now.Add(-time.Minute)}, now)
+		Expect(err).NotTo(HaveOccurred())
+
+		locks, err := lockStore.ListActive(ctx, []string{"locked", "expired", "unlocked"}, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(locks).To(HaveLen(1))
+		Expect(locks).To(HaveKey("locked"))
+	})
+
+	It("deletes locks with their policy", func() {
+		_, err := policyStore.Create(ctx, newPolicy("locked"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = lockStore.Acquire(ctx, lock("alice", now.Add(time.Minute)), now)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policyStore.Delete(ctx, "locked")).To(Succeed())
+
+		_, err = lockStore.Get(ctx, "locked", now)
+		Expect(err).To(MatchError(store.ErrPolicyLockNotFound))
+	})
+})
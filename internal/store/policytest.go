@@ -0,0 +1,124 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPolicyTestNotFound = errors.New("policy test not found")
+	ErrPolicyTestIDTaken  = errors.New("policy test ID already exists")
+)
+
+// PolicyTestListOptions contains options for listing policy test cases.
+type PolicyTestListOptions struct {
+	Offset   int
+	PageSize int
+}
+
+// PolicyTestListResult contains the result of a policy test List operation.
+type PolicyTestListResult struct {
+	Tests model.PolicyTestList
+	// NextOffset is the offset of the next page, or zero when this is the last page
+	NextOffset int
+}
+
+// PolicyTest stores the test cases of policies. Test cases are deleted with their policy by
+// the policy store.
+type PolicyTest interface {
+	Create(ctx context.Context, test model.PolicyTest) (*model.PolicyTest, error)
+	List(ctx context.Context, policyID string, opts *PolicyTestListOptions) (*PolicyTestListResult, error)
+	ListAll(ctx context.Context, policyID string) (model.PolicyTestList, error)
+	Get(ctx context.Context, policyID, id string) (*model.PolicyTest, error)
+	Delete(ctx context.Context, policyID, id string) error
+}
+
+type PolicyTestStore struct {
+	db *gorm.DB
+}
+
+var _ PolicyTest = (*PolicyTestStore)(nil)
+
+func NewPolicyTest(db *gorm.DB) PolicyTest {
+	return &PolicyTestStore{db: db}
+}
+
+func (s *PolicyTestStore) Create(ctx context.Context, test model.PolicyTest) (*model.PolicyTest, error) {
+	if err := s.db.WithContext(ctx).Create(&test).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(strings.ToLower(err.Error()), "unique") {
+			return nil, ErrPolicyTestIDTaken
+		}
+		return nil, err
+	}
+	return &test, nil
+}
+
+// List returns the test cases of a policy, oldest first
+func (s *PolicyTestStore) List(ctx context.Context, policyID string, opts *PolicyTestListOptions) (*PolicyTestListResult, error) {
+	pageSize := 50
+	offset := 0
+	if opts != nil {
+		if opts.PageSize > 0 {
+			pageSize = opts.PageSize
+		}
+		if opts.Offset > 0 {
+			offset = opts.Offset
+		}
+	}
+
+	var tests model.PolicyTestList
+	err := s.db.WithContext(ctx).
+		Where("policy_id = ?", policyID).
+		Order("create_time ASC, id ASC").
+		Limit(pageSize + 1).Offset(offset).
+		Find(&tests).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PolicyTestListResult{Tests: tests}
+	if len(tests) > pageSize {
+		result.Tests = tests[:pageSize]
+		result.NextOffset = offset + pageSize
+	}
+	return result, nil
+}
+
+// ListAll returns every test case of a policy, oldest first
+func (s *PolicyTestStore) ListAll(ctx context.Context, policyID string) (model.PolicyTestList, error) {
+	var tests model.PolicyTestList
+	err := s.db.WithContext(ctx).
+		Where("policy_id = ?", policyID).
+		Order("create_time ASC, id ASC").
+		Find(&tests).Error
+	if err != nil {
+		return nil, err
+	}
+	return tests, nil
+}
+
+func (s *PolicyTestStore) Get(ctx context.Context, policyID, id string) (*model.PolicyTest, error) {
+	var row model.PolicyTest
+	if err := s.db.WithContext(ctx).First(&row, "policy_id = ? AND id = ?", policyID, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPolicyTestNotFound
+		}
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (s *PolicyTestStore) Delete(ctx context.Context, policyID, id string) error {
+	result := s.db.WithContext(ctx).Where("policy_id = ? AND id = ?", policyID, id).Delete(&model.PolicyTest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPolicyTestNotFound
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("PolicyTest Store", func() {
+	var (
+		db          *gorm.DB
+		policyStore store.Policy
+		testStore   store.PolicyTest
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+
+		policyStore = store.NewPolicy(db)
+		testStore = store.NewPolicyTest(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	newTest := func(policyID, id string, createTime time.Time) model.PolicyTest {
+		return model.PolicyTest{
+			PolicyID:   policyID,
+			ID:         id,
+			Spec:       map[string]any{"service_type": "vm"},
+			Expected:   model.PolicyTestOutcome{Status: "APPROVED"},
+			CreateTime: createTime,
+		}
+	}
+
+	It("stores test cases per policy and lists them oldest first", func() {
+		now := time.Now()
+		for _, test := range []model.PolicyTest{
+			newTest("policy-a", "second", now.Add(time.Second)),
+			newTest("policy-a", "first", now),
+			newTest("policy-b", "first", now),
+		} {
+			_, err := testStore.Create(ctx, test)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		page, err := testStore.List(ctx, "policy-a", &store.PolicyTestListOptions{PageSize: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page.Tests).To(HaveLen(1))
+		Expect(page.Tests[0].ID).To(Equal("first"))
+		Expect(page.NextOffset).To(Equal(1))
+
+		page, err = testStore.List(ctx, "policy-a", &store.PolicyTestListOptions{PageSize: 1, Offset: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page.Tests[0].ID).To(Equal("second"))
+		Expect(page.NextOffset).To(BeZero())
+
+		got, err := testStore.Get(ctx, "policy-b", "first")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(got.Spec).To(Equal(map[string]any{"service_type": "vm"}))
+		Expect(got.Expected.Status).To(Equal("APPROVED"))
+	})
+
+	It("rejects a test ID taken within the policy", func() {
+		_, err := testStore.Create(ctx, newTest("policy-a", "dup", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = testStore.Create(ctx, newTest("policy-a", "dup", time.Now()))
+		Expect(err).To(MatchError(store.ErrPolicyTestIDTaken))
+	})
+
+	It("returns ErrPolicyTestNotFound for missing test cases", func() {
+		_, err := testStore.Get(ctx, "policy-a", "missing")
+		Expect(err).To(MatchError(store.ErrPolicyTestNotFound))
+
+		Expect(testStore.Delete(ctx, "policy-a", "missing")).To(MatchError(store.ErrPolicyTestNotFound))
+	})
+
+	It("deletes test cases with their policy", func() {
+		_, err := policyStore.Create(ctx, newPolicy("tested"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = testStore.Create(ctx, newTest("tested", "case", time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policyStore.Delete(ctx, "tested")).To(Succeed())
+
+		tests, err := testStore.ListAll(ctx, "tested")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,79 @@
+package store_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Policy query plans", func() {
+	var (
+		db          *gorm.DB
+		policyStore store.Policy
+		sql         string
+		vars        []any
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{})).To(Succeed())
+		// Record the query List issues so its plan can be explained
+		Expect(db.Callback().Query().After("gorm:query").Register("capture", func(tx *gorm.DB) {
+			sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+		})).To(Succeed())
+		policyStore = store.NewPolicy(db)
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	// plan lists the steps of the plan of the query List issues with opts
+	plan := func(opts *store.PolicyListOptions) []string {
+		_, err := policyStore.List(context.Background(), opts)
+		Expect(err).NotTo(HaveOccurred())
+		var steps []struct{ Detail string }
+		Expect(db.Raw("EXPLAIN QUERY PLAN "+sql, vars...).Scan(&steps).Error).To(Succeed())
+		details := make([]string, len(steps))
+		for i, step := range steps {
+			details[i] = step.Detail
+		}
+		return details
+	}
+	enabled := true
+	policyType := "GLOBAL"
+
+	DescribeTable("reads policies through an index",
+		func(opts *store.PolicyListOptions, index string) {
+			details := plan(opts)
+			Expect(details).To(ContainElement(ContainSubstring("INDEX " + index)))
+			for _, detail := range details {
+				Expect(strings.TrimSpace(detail)).NotTo(Equal("SCAN policies"), "the query scans the table")
+			}
+		},
+		Entry("enabled policies in evaluation order",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{Enabled: &enabled}, PageSize: 1000},
+			"idx_policies_enabled_type_priority"),
+		Entry("policies of a type in evaluation order",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{PolicyType: &policyType}},
+			"idx_priority_policy_type"),
+		Entry("policies by update time",
+			&store.PolicyListOptions{OrderBy: "update_time DESC"},
+			"idx_policies_update_time"),
+		Entry("enabled policies by update time",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{Enabled: &enabled}, OrderBy: "update_time DESC"},
+			"idx_policies_enabled_update_time"),
+	)
+})
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"gorm.io/gorm"
+)
+
+var ErrPolicyRevisionNotFound = errors.New("policy revision not found")
+
+// RevisionListOptions contains options for listing policy revisions.
+type RevisionListOptions struct {
+	Offset   int
+	PageSize int
+}
+
+// RevisionListResult contains the result of a revision List operation.
+type RevisionListResult struct {
+	Revisions model.PolicyRevisionList
+	// NextOffset is the offset of the next page, or zero when this is the last page
+	NextOffset int
+}
+
+// Revision reads policy revisions. Revisions are written by the policy store in the
+// transaction of each create and update, and kept after their policy is deleted. A policy
+// created again with the ID of a deleted one continues its revision numbers.
+type Revision interface {
+	List(ctx context.Context, policyID string, opts *RevisionListOptions) (*RevisionListResult, error)
+	Get(ctx context.Context, policyID string, revision int64) (*model.PolicyRevision, error)
+	// Latest returns the latest revision number of every policy, keyed by policy ID
+	Latest(ctx context.Context) (map[string]int64, error)
+}
+
+type RevisionStore struct {
+	db *gorm.DB
+}
+
+var _ Revision = (*RevisionStore)(nil)
+
+func NewRevision(db *gorm.DB) Revision {
+	return &RevisionStore{db: db}
+}
+
+// List returns the revisions of a policy, newest first
+func (s *RevisionStore) List(ctx context.Context, policyID string, opts *RevisionListOptions) (*RevisionListResult, error) {
+	pageSize := 50
+	offset := 0
+	if opts != nil {
+		if opts.PageSize > 0 {
+			pageSize = opts.PageSize
+		}
+		if opts.Offset > 0 {
+			offset = opts.Offset
+		}
+	}
+
+	var revisions model.PolicyRevisionList
+	err := s.db.WithContext(ctx).
+		Where("policy_id = ?", policyID).
+		Order("revision DESC").
+		Limit(pageSize + 1).Offset(offset).
+		Find(&revisions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RevisionListResult{Revisions: revisions}
+	if len(revisions) > pageSize {
+		result.Revisions = revisions[:pageSize]
+		result.NextOffset = offset + pageSize
+	}
+	return result, nil
+}
+
+func (s *RevisionStore) Get(ctx context.Context, policyID string, revision int64) (*model.PolicyRevision, error) {
+	var row model.PolicyRevision
+	if err := s.db.WithContext(ctx).First(&row, "policy_id = ? AND revision = ?", policyID, revision).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPolicyRevisionNotFound
+		}
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (s *RevisionStore) Latest(ctx context.Context) (map[string]int64, error) {
+	var rows []struct {
+		PolicyID string
+		Revision int64
+	}
+	err := s.db.WithContext(ctx).Model(&model.PolicyRevision{}).
+		Select("policy_id, MAX(revision) AS revision").
+		Group("policy_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		latest[row.PolicyID] = row.Revision
+	}
+	return latest, nil
+}
+
+// appendRevision stores policy as its next revision. Called in the transaction that changed
+// the policy, whose row lock orders concurrent changes so each sees the previous revision.
+func appendRevision(tx *gorm.DB, policy model.Policy) error {
+	var last int64
+	err := tx.Model(&model.PolicyRevision{}).
+		Where("policy_id = ?", policy.ID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&last).Error
+	if err != nil {
+		return err
+	}
+	revision := model.NewPolicyRevision(policy, last+1)
+	return tx.Create(&revision).Error
+}
+
+// backfillRevisions stores the current state of policies created before revisions were
+// recorded as their first revision
+func backfillRevisions(db *gorm.DB) error {
+	var policies model.PolicyList
+	err := db.Where("NOT EXISTS (SELECT 1 FROM policy_revisions WHERE policy_revisions.policy_id = policies.id)").
+		Find(&policies).Error
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		revision := model.NewPolicyRevision(policy, 1)
+		if err := db.Create(&revision).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package store_test
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/store"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var _ = Describe("Revision Store", func() {
+	var (
+		db            *gorm.DB
+		policyStore   store.Policy
+		revisionStore store.Revision
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(db.AutoMigrate(&model.Policy{}, &model.PolicyRevision{}, &model.PolicyTest{}, &model.PolicyLock{})).To(Succeed())
+
+		policyStore = store.NewPolicy(db)
+		revisionStore = store.NewRevision(db)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	It("stores revision 1 on create and the next revision on each update", func() {
+		created, err := policyStore.Create(ctx, newPolicy("revised"))
+		Expect(err).NotTo(HaveOccurred())
+
+		update := *created
+		update.DisplayName = "Renamed"
+		update.Enabled = false
+		_, err = policyStore.Update(ctx, update)
+		Expect(err).NotTo(HaveOccurred())
+
+		result, err := revisionStore.List(ctx, "revised", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(2))
+		Expect(result.NextOffset).To(BeZero())
+
+		latest, first := result.Revisions[0], result.Revisions[1]
+		Expect(latest.Revision).To(Equal(int64(2)))
+		Expect(latest.DisplayName).To(Equal("Renamed"))
+		Expect(latest.Enabled).To(BeFalse())
+		Expect(first.Revision).To(Equal(int64(1)))
+		Expect(first.DisplayName).To(Equal("Revised Policy"))
+		Expect(first.RegoCode).To(Equal(created.RegoCode))
+		Expect(first.CreateTime).To(BeTemporally("~", created.UpdateTime))
+	})
+
+	It("does not store a revision for a failed update", func() {
+		_, err := policyStore.Create(ctx, newPolicy("first"))
+		Expect(err).NotTo(HaveOccurred())
+		second, err := policyStore.Create(ctx, newPolicy("second"))
+		Expect(err).NotTo(HaveOccurred())
+
+		update := *second
+		update.DisplayName = "First Policy"
+		_, err = policyStore.Update(ctx, update)
+		Expect(err).To(Equal(store.ErrDisplayNamePolicyTypeTaken))
+
+		result, err := revisionStore.List(ctx, "second", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(1))
+	})
+
+	It("pages through revisions newest first", func() {
+		created, err := policyStore.Create(ctx, newPolicy("paged"))
+		Expect(err).NotTo(HaveOccurred())
+		for range 2 {
+			_, err = policyStore.Update(ctx, *created)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		page, err := revisionStore.List(ctx, "paged", &store.RevisionListOptions{PageSize: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page.Revisions[0].Revision).To(Equal(int64(3)))
+		Expect(page.Revisions[1].Revision).To(Equal(int64(2)))
+		Expect(page.NextOffset).To(Equal(2))
+
+		page, err = revisionStore.List(ctx, "paged", &store.RevisionListOptions{PageSize: 2, Offset: page.NextOffset})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(page.Revisions).To(HaveLen(1))
+		Expect(page.Revisions[0].Revision).To(Equal(int64(1)))
+		Expect(page.NextOffset).To(BeZero())
+	})
+
+	It("gets a revision by number", func() {
+		_, err := policyStore.Create(ctx, newPolicy("get-revision"))
+		Expect(err).NotTo(HaveOccurred())
+
+		revision, err := revisionStore.Get(ctx, "get-revision", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision.Policy().DisplayName).To(Equal("Get Revision Policy"))
+
+		_, err = revisionStore.Get(ctx, "get-revision", 2)
+		Expect(err).To(Equal(store.ErrPolicyRevisionNotFound))
+	})
+
+	It("keeps revisions after their policy is deleted", func() {
+		created, err := policyStore.Create(ctx, newPolicy("deleted"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = policyStore.Update(ctx, *created)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyStore.Delete(ctx, "deleted")).To(Succeed())
+
+		result, err := revisionStore.List(ctx, "deleted", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(2))
+		revision, err := revisionStore.Get(ctx, "deleted", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision.DisplayName).To(Equal("Deleted Policy"))
+
+		// A policy created again with the ID continues the history
+		_, err = policyStore.Create(ctx, newPolicy("deleted"))
+		Expect(err).NotTo(HaveOccurred())
+		result, err = revisionStore.List(ctx, "deleted", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(3))
+		Expect(result.Revisions[0].Revision).To(Equal(int64(3)))
+	})
+
+	It("reverts an update together with its revision", func() {
+		created, err := policyStore.Create(ctx, newPolicy("reverted"))
+		Expect(err).NotTo(HaveOccurred())
+		renamed := *created
+		renamed.DisplayName = "Renamed"
+		previous, err := policyStore.Update(ctx, renamed)
+		Expect(err).NotTo(HaveOccurred())
+		failed := *previous
+		failed.DisplayName = "Broken"
+		failed.UpdatedBy = "mallory"
+		_, err = policyStore.Update(ctx, failed)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policyStore.Revert(ctx, *previous)).To(Succeed())
+
+		stored, err := policyStore.Get(ctx, "reverted")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.DisplayName).To(Equal("Renamed"))
+		Expect(stored.UpdatedBy).To(BeEmpty())
+		Expect(stored.UpdateTime).To(BeTemporally("==", previous.UpdateTime))
+		result, err := revisionStore.List(ctx, "reverted", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(2))
+		Expect(result.Revisions[0].DisplayName).To(Equal("Renamed"))
+		Expect(policyStore.Revert(ctx, newPolicy("missing"))).To(Equal(store.ErrPolicyNotFound))
+	})
+
+	It("discards the revisions of a discarded policy only", func() {
+		_, err := policyStore.Create(ctx, newPolicy("discarded"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policyStore.Delete(ctx, "discarded")).To(Succeed())
+		_, err = policyStore.Create(ctx, newPolicy("discarded"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(policyStore.Discard(ctx, "discarded")).To(Succeed())
+
+		_, err = policyStore.Get(ctx, "discarded")
+		Expect(err).To(Equal(store.ErrPolicyNotFound))
+		result, err := revisionStore.List(ctx, "discarded", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Revisions).To(HaveLen(1))
+		Expect(result.Revisions[0].Revision).To(Equal(int64(1)))
+		Expect(policyStore.Discard(ctx, "discarded")).To(Equal(store.ErrPolicyNotFound))
+	})
+
+	It("backfills the first revision of policies stored before revisions on InitDB", func() {
+		name := filepath.Join(GinkgoT().TempDir(), "policies.db")
+		legacy, err := gorm.Open(sqlite.Open(name), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(legacy.AutoMigrate(&model.Policy{})).To(Succeed())
+		Expect(legacy.Create(&model.Policy{ID: "legacy", DisplayName: "Legacy", PolicyType: "GLOBAL", Priority: 1, RegoCode: "package legacy"}).Error).To(Succeed())
+		sqlDB, _ := legacy.DB()
+		Expect(sqlDB.Close()).To(Succeed())
+
+		migrated, err := store.InitDB(&config.Config{
+			Database: &config.DBConfig{Type: "sqlite", Name: name},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(func() {
+			sqlDB, _ := migrated.DB()
+			_ = sqlDB.Close()
+		})
+
+		revision, err := store.NewRevision(migrated).Get(ctx, "legacy", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(revision.RegoCode).To(Equal("package legacy"))
+	})
+})
@@ -1,21 +1,42 @@
 package store
 
-import "gorm.io/gorm"
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
 
 type Store interface {
 	Close() error
+	// Ping checks that the database answers
+	Ping(ctx context.Context) error
 	Policy() Policy
+	Audit() Audit
+	Revision() Revision
+	PolicyTest() PolicyTest
+	PolicyLock() PolicyLock
+	EngineAPIKey() EngineAPIKey
 }
 
 type DataStore struct {
-	db     *gorm.DB
-	policy Policy
+	db           *gorm.DB
+	policy       Policy
+	audit        Audit
+	revision     Revision
+	policyTest   PolicyTest
+	policyLock   PolicyLock
+	engineAPIKey EngineAPIKey
 }
 
 func NewStore(db *gorm.DB) Store {
 	return &DataStore{
-		db:     db,
-		policy: NewPolicy(db),
+		db:           db,
+		policy:       NewPolicy(db),
+		audit:        NewAudit(db),
+		revision:     NewRevision(db),
+		policyTest:   NewPolicyTest(db),
+		policyLock:   NewPolicyLock(db),
+		engineAPIKey: NewEngineAPIKey(db),
 	}
 }
 
@@ -27,6 +48,34 @@ func (s *DataStore) Close() error {
 	return sqlDB.Close()
 }
 
+func (s *DataStore) Ping(ctx context.Context) error {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 func (s *DataStore) Policy() Policy {
 	return s.policy
 }
+
+func (s *DataStore) Audit() Audit {
+	return s.audit
+}
+
+func (s *DataStore) Revision() Revision {
+	return s.revision
+}
+
+func (s *DataStore) PolicyTest() PolicyTest {
+	return s.policyTest
+}
+
+func (s *DataStore) PolicyLock() PolicyLock {
+	return s.policyLock
+}
+
+func (s *DataStore) EngineAPIKey() EngineAPIKey {
+	return s.engineAPIKey
+}
@@ -26,6 +26,8 @@ var _ = Describe("Store", func() {
 
 			Expect(s).NotTo(BeNil())
 			Expect(s.Policy()).NotTo(BeNil())
+			Expect(s.Audit()).NotTo(BeNil())
+			Expect(s.Revision()).NotTo(BeNil())
 		})
 	})
 
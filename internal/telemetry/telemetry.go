@@ -0,0 +1,248 @@
+// Package telemetry sends anonymous usage reports — how many policies exist, how many
+// requests were evaluated and which optional features are enabled — to an endpoint the
+// operator configures. It is off unless explicitly enabled. Reports carry no policy IDs,
+// names, Rego, labels or request content, and are identified only by an ID generated at
+// process start.
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/logging"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/google/uuid"
+)
+
+const sendTimeout = 10 * time.Second
+
+// Report is the document sent to the telemetry endpoint
+type Report struct {
+	InstanceID    string          `json:"instance_id"`    // random, regenerated on every start
+	Version       string          `json:"version"`        // release version of the binary
+	Time          time.Time       `json:"time"`           // when the report was made
+	PeriodSeconds int64           `json:"period_seconds"` // length of the period evaluations were counted over
+	Policies      PolicyCounts    `json:"policies"`
+	Evaluations   EvaluationCount `json:"evaluations"`
+	Features      map[string]bool `json:"features"` // optional features and whether they are enabled
+}
+
+// PolicyCounts counts the stored policies
+type PolicyCounts struct {
+	Total   int `json:"total"`
+	Enabled int `json:"enabled"`
+	Global  int `json:"global"`
+	User    int `json:"user"`
+}
+
+// EvaluationCount counts the evaluation outcomes of the report period; dry runs are not counted
+type EvaluationCount struct {
+	Completed int64 `json:"completed"`
+	Rejected  int64 `json:"rejected"`
+}
+
+// Status describes the telemetry configuration and the last report
+type Status struct {
+	Enabled    bool
+	Endpoint   string
+	Interval   time.Duration
+	LastReport *Report // nil until a report was sent
+	LastError  string  // why the last report could not be sent, empty after a success
+}
+
+// PolicyLister lists every stored policy
+type PolicyLister interface {
+	ListAll(ctx context.Context) (model.PolicyList, error)
+}
+
+// Reporter counts evaluation outcomes and periodically sends reports
+type Reporter struct {
+	endpoint   string
+	interval   time.Duration
+	version    string
+	features   map[string]bool
+	instanceID string
+	client     *http.Client
+	now        func() time.Time
+
+	completed atomic.Int64
+	rejected  atomic.Int64
+
+	mu         sync.Mutex
+	lastReport *Report
+	lastError  string
+	lastSent   time.Time
+}
+
+// New creates a Reporter from the telemetry configuration, or returns nil when telemetry is
+// disabled. features lists the optional features reported as enabled or not.
+func New(cfg config.TelemetryConfig, version string, features map[string]bool) (*Reporter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("TELEMETRY_ENDPOINT must be an http or https URL when telemetry is enabled (got '%s')", cfg.Endpoint)
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("TELEMETRY_INTERVAL must be positive (got %s)", cfg.Interval)
+	}
+	return &Reporter{
+		endpoint:   cfg.Endpoint,
+		interval:   cfg.Interval,
+		version:    version,
+		features:   maps.Clone(features),
+		instanceID: uuid.NewString(),
+		client:     &http.Client{Timeout: sendTimeout},
+		now:        time.Now,
+	}, nil
+}
+
+// RecordEvents counts the evaluation outcomes published on bus
+func (r *Reporter) RecordEvents(bus *events.Bus) (unsubscribe func()) {
+	return bus.Subscribe(func(_ context.Context, event events.Event) {
+		switch event.(type) {
+		case events.EvaluationCompleted:
+			r.completed.Add(1)
+		case events.EvaluationRejected:
+			r.rejected.Add(1)
+		}
+	})
+}
+
+// Run sends a report right away and then every interval until ctx is cancelled.
+// Failed reports are logged; their evaluations are counted in the next report.
+func (r *Reporter) Run(ctx context.Context, policies PolicyLister) error {
+	r.mu.Lock()
+	r.lastSent = r.now()
+	r.mu.Unlock()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.Send(ctx, policies); err != nil && ctx.Err() == nil {
+			logging.FromContext(ctx).Warn("Failed to send telemetry report", "endpoint", r.endpoint, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Send sends one report covering the evaluations since the previous report
+func (r *Reporter) Send(ctx context.Context, policies PolicyLister) error {
+	report, err := r.report(ctx, policies)
+	if err != nil {
+		r.recordFailure(err)
+		return err
+	}
+	if err := r.post(ctx, report); err != nil {
+		// Count the period's evaluations again in the next report
+		r.completed.Add(report.Evaluations.Completed)
+		r.rejected.Add(report.Evaluations.Rejected)
+		r.recordFailure(err)
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastReport = report
+	r.lastError = ""
+	r.lastSent = report.Time
+	return nil
+}
+
+// Status returns the telemetry status; a nil Reporter reports telemetry as disabled
+func (r *Reporter) Status() Status {
+	if r == nil {
+		return Status{}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Status{
+		Enabled:    true,
+		Endpoint:   r.endpoint,
+		Interval:   r.interval,
+		LastReport: r.lastReport,
+		LastError:  r.lastError,
+	}
+}
+
+func (r *Reporter) report(ctx context.Context, policies PolicyLister) (*Report, error) {
+	all, err := policies.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting policies: %w", err)
+	}
+	var counts PolicyCounts
+	for _, policy := range all {
+		counts.Total++
+		if policy.Enabled {
+			counts.Enabled++
+		}
+		switch policy.PolicyType {
+		case "GLOBAL":
+			counts.Global++
+		case "USER":
+			counts.User++
+		}
+	}
+
+	now := r.now()
+	r.mu.Lock()
+	period := now.Sub(r.lastSent)
+	r.mu.Unlock()
+	return &Report{
+		InstanceID:    r.instanceID,
+		Version:       r.version,
+		Time:          now.UTC(),
+		PeriodSeconds: int64(period / time.Second),
+		Policies:      counts,
+		Evaluations: EvaluationCount{
+			Completed: r.completed.Swap(0),
+			Rejected:  r.rejected.Swap(0),
+		},
+		Features: maps.Clone(r.features),
+	}, nil
+}
+
+func (r *Reporter) post(ctx context.Context, report *Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("telemetry endpoint answered %s", resp.Status)
+	}
+	return nil
+}
+
+func (r *Reporter) recordFailure(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastError = err.Error()
+}
@@ -0,0 +1,157 @@
+package telemetry_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/events"
+	"github.com/dcm-project/policy-manager/internal/store/model"
+	"github.com/dcm-project/policy-manager/internal/telemetry"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakePolicies struct {
+	policies model.PolicyList
+	err      error
+}
+
+func (f fakePolicies) ListAll(context.Context) (model.PolicyList, error) {
+	return f.policies, f.err
+}
+
+var _ = Describe("Reporter", func() {
+	var (
+		ctx      context.Context
+		mu       sync.Mutex
+		received []map[string]any
+		status   int
+		endpoint *httptest.Server
+		policies fakePolicies
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		received = nil
+		status = http.StatusAccepted
+		endpoint = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var report map[string]any
+			Expect(json.NewDecoder(r.Body).Decode(&report)).To(Succeed())
+			mu.Lock()
+			defer mu.Unlock()
+			received = append(received, report)
+			w.WriteHeader(status)
+		}))
+		DeferCleanup(endpoint.Close)
+		policies = fakePolicies{policies: model.PolicyList{
+			{ID: "a", PolicyType: "GLOBAL", Enabled: true},
+			{ID: "b", PolicyType: "USER", Enabled: true},
+			{ID: "c", PolicyType: "USER", Enabled: false},
+		}}
+	})
+
+	newReporter := func() *telemetry.Reporter {
+		reporter, err := telemetry.New(config.TelemetryConfig{Enabled: true, Endpoint: endpoint.URL, Interval: time.Hour},
+			"v1.2.3", map[string]bool{"audit_log": true, "ext_authz": false})
+		Expect(err).NotTo(HaveOccurred())
+		return reporter
+	}
+
+	It("is disabled by default", func() {
+		reporter, err := telemetry.New(config.TelemetryConfig{Endpoint: endpoint.URL, Interval: time.Hour}, "v1.2.3", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reporter).To(BeNil())
+		Expect(reporter.Status()).To(Equal(telemetry.Status{}))
+	})
+
+	It("rejects an invalid configuration", func() {
+		_, err := telemetry.New(config.TelemetryConfig{Enabled: true, Interval: time.Hour}, "", nil)
+		Expect(err).To(MatchError(ContainSubstring("TELEMETRY_ENDPOINT must be an http or https URL")))
+
+		_, err = telemetry.New(config.TelemetryConfig{Enabled: true, Endpoint: "ftp://example.com", Interval: time.Hour}, "", nil)
+		Expect(err).To(HaveOccurred())
+
+		_, err = telemetry.New(config.TelemetryConfig{Enabled: true, Endpoint: endpoint.URL}, "", nil)
+		Expect(err).To(MatchError(ContainSubstring("TELEMETRY_INTERVAL must be positive")))
+	})
+
+	It("sends anonymous counters and reports them in the status", func() {
+		reporter := newReporter()
+		bus := events.NewBus()
+		reporter.RecordEvents(bus)
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "APPROVED", RequestLabels: map[string]string{"tenant": "acme"}})
+		bus.Publish(ctx, events.EvaluationCompleted{Status: "MODIFIED"})
+		bus.Publish(ctx, events.EvaluationRejected{PolicyID: "a", Reason: "no"})
+		bus.Publish(ctx, events.PolicyCreated{})
+
+		Expect(reporter.Send(ctx, policies)).To(Succeed())
+
+		status := reporter.Status()
+		Expect(status.Enabled).To(BeTrue())
+		Expect(status.Endpoint).To(Equal(endpoint.URL))
+		Expect(status.Interval).To(Equal(time.Hour))
+		Expect(status.LastError).To(BeEmpty())
+		Expect(status.LastReport).NotTo(BeNil())
+		Expect(status.LastReport.InstanceID).NotTo(BeEmpty())
+
+		Expect(received).To(HaveLen(1))
+		Expect(received[0]).To(HaveLen(7))
+		Expect(received[0]).To(HaveKeyWithValue("instance_id", status.LastReport.InstanceID))
+		Expect(received[0]).To(HaveKeyWithValue("version", "v1.2.3"))
+		Expect(received[0]).To(HaveKey("time"))
+		Expect(received[0]).To(HaveKey("period_seconds"))
+		Expect(received[0]).To(HaveKeyWithValue("policies", map[string]any{
+			"total": float64(3), "enabled": float64(2), "global": float64(1), "user": float64(2),
+		}))
+		Expect(received[0]).To(HaveKeyWithValue("evaluations", map[string]any{"completed": float64(2), "rejected": float64(1)}))
+		Expect(received[0]).To(HaveKeyWithValue("features", map[string]any{"audit_log": true, "ext_authz": false}))
+	})
+
+	It("counts the evaluations of a failed report in the next one", func() {
+		reporter := newReporter()
+		bus := events.NewBus()
+		reporter.RecordEvents(bus)
+		bus.Publish(ctx, events.EvaluationCompleted{})
+		status = http.StatusServiceUnavailable
+
+		Expect(reporter.Send(ctx, policies)).To(MatchError(ContainSubstring("503")))
+		Expect(reporter.Status().LastError).To(ContainSubstring("503"))
+		Expect(reporter.Status().LastReport).To(BeNil())
+
+		status = http.StatusOK
+		bus.Publish(ctx, events.EvaluationCompleted{})
+		Expect(reporter.Send(ctx, policies)).To(Succeed())
+		Expect(reporter.Status().LastError).To(BeEmpty())
+		Expect(reporter.Status().LastReport.Evaluations.Completed).To(Equal(int64(2)))
+	})
+
+	It("does not send a report when the policies cannot be counted", func() {
+		reporter := newReporter()
+
+		err := reporter.Send(ctx, fakePolicies{err: errors.New("database unavailable")})
+
+		Expect(err).To(MatchError(ContainSubstring("database unavailable")))
+		Expect(received).To(BeEmpty())
+	})
+
+	It("reports at startup and stops when cancelled", func() {
+		reporter := newReporter()
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() { done <- reporter.Run(runCtx, policies) }()
+
+		Eventually(func() int {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(received)
+		}).Should(Equal(1))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
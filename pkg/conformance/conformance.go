@@ -0,0 +1,142 @@
+// Package conformance is the machine-readable conformance suite of the policy manager's
+// evaluation semantics: policy sets, requests and the outcome the central engine produces
+// for them, covering evaluation order, label selectors, constraint tightening, service
+// provider constraints and patch merging. Alternative engines, such as edge evaluators
+// consuming the policy manager's bundles, run it to prove they evaluate identically.
+package conformance
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+//go:embed cases/*.json
+var suiteFS embed.FS
+
+// Status is the outcome of evaluating a request
+type Status string
+
+const (
+	// StatusApproved is an approved request whose spec no policy changed
+	StatusApproved Status = "APPROVED"
+	// StatusModified is an approved request whose spec policies patched
+	StatusModified Status = "MODIFIED"
+	// StatusRejected is a request a policy rejected
+	StatusRejected Status = "REJECTED"
+	// StatusPolicyConflict is a request whose evaluation failed because a policy violated or
+	// loosened the constraints of a higher-priority policy
+	StatusPolicyConflict Status = "POLICY_CONFLICT"
+)
+
+// Policy is an enabled policy of a case's policy set
+type Policy struct {
+	ID            string            `json:"id"`
+	PolicyType    string            `json:"policy_type"` // GLOBAL or USER
+	Priority      int32             `json:"priority"`
+	LabelSelector map[string]string `json:"label_selector,omitempty"`
+	RegoCode      string            `json:"rego_code"`
+}
+
+// Outcome is the result of evaluating a request. Spec and SelectedProvider are only set for
+// approved requests, PolicyID only for rejected and conflicting ones.
+type Outcome struct {
+	Status           Status         `json:"status"`
+	Spec             map[string]any `json:"spec,omitempty"`
+	SelectedProvider string         `json:"selected_provider,omitempty"`
+	// PolicyID is the policy that rejected the request or violated a constraint
+	PolicyID string `json:"policy_id,omitempty"`
+	// PoliciesEvaluated are the policies evaluated for the request, in evaluation order; a
+	// case only checks them when its expected outcome lists them
+	PoliciesEvaluated []string `json:"policies_evaluated,omitempty"`
+}
+
+// Case is a policy set, the spec of a request evaluated against it and the expected outcome
+type Case struct {
+	// Name identifies the case as <file>/<name>
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Policies    []Policy `json:"policies"`
+	// Spec is the service instance spec of the request; its request labels are its
+	// service_type and spec.metadata.labels
+	Spec     map[string]any `json:"spec"`
+	Expected Outcome        `json:"expected"`
+}
+
+// file is the layout of a suite file
+type file struct {
+	Description string `json:"description,omitempty"`
+	Cases       []Case `json:"cases"`
+}
+
+// Suite returns the cases of the published suite
+func Suite() ([]Case, error) {
+	cases, err := fs.Sub(suiteFS, "cases")
+	if err != nil {
+		return nil, err
+	}
+	return Load(cases)
+}
+
+// Load reads the cases of every .json file at the root of fsys, in file name order, and
+// validates them
+func Load(fsys fs.FS) ([]Case, error) {
+	names, err := fs.Glob(fsys, "*.json")
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(names)
+
+	var cases []Case
+	seen := map[string]bool{}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		var f file
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, c := range f.Cases {
+			c.Name = strings.TrimSuffix(path.Base(name), ".json") + "/" + c.Name
+			if err := c.validate(); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if seen[c.Name] {
+				return nil, fmt.Errorf("%s: duplicate case '%s'", name, c.Name)
+			}
+			seen[c.Name] = true
+			cases = append(cases, c)
+		}
+	}
+	return cases, nil
+}
+
+// validate checks that the case is complete
+func (c Case) validate() error {
+	if strings.HasSuffix(c.Name, "/") {
+		return errors.New("a case has no name")
+	}
+	if _, ok := c.Spec["service_type"].(string); !ok {
+		return fmt.Errorf("case '%s': the spec has no service_type", c.Name)
+	}
+	for _, p := range c.Policies {
+		if p.ID == "" || p.RegoCode == "" {
+			return fmt.Errorf("case '%s': every policy needs an id and rego_code", c.Name)
+		}
+		if p.PolicyType != "GLOBAL" && p.PolicyType != "USER" {
+			return fmt.Errorf("case '%s': policy '%s' has policy_type '%s', not GLOBAL or USER", c.Name, p.ID, p.PolicyType)
+		}
+	}
+	switch c.Expected.Status {
+	case StatusApproved, StatusModified, StatusRejected, StatusPolicyConflict:
+		return nil
+	}
+	return fmt.Errorf("case '%s': unknown expected status '%s'", c.Name, c.Expected.Status)
+}
@@ -0,0 +1,129 @@
+package conformance_test
+
+import (
+	"context"
+	"errors"
+	"testing/fstest"
+
+	"github.com/dcm-project/policy-manager/pkg/conformance"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Load", func() {
+	It("names cases after their file", func() {
+		cases, err := conformance.Load(fstest.MapFS{
+			"b.json": {Data: []byte(`{"cases": [{"name": "two", "spec": {"service_type": "vm"}, "expected": {"status": "APPROVED"}}]}`)},
+			"a.json": {Data: []byte(`{"cases": [{"name": "one", "spec": {"service_type": "vm"}, "expected": {"status": "REJECTED"}}]}`)},
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cases).To(HaveLen(2))
+		Expect(cases[0].Name).To(Equal("a/one"))
+		Expect(cases[1].Name).To(Equal("b/two"))
+	})
+
+	DescribeTable("rejects incomplete cases",
+		func(content, message string) {
+			_, err := conformance.Load(fstest.MapFS{"a.json": {Data: []byte(content)}})
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("no name", `{"cases": [{"spec": {"service_type": "vm"}, "expected": {"status": "APPROVED"}}]}`, "no name"),
+		Entry("no service type", `{"cases": [{"name": "one", "spec": {}, "expected": {"status": "APPROVED"}}]}`, "no service_type"),
+		Entry("unknown status", `{"cases": [{"name": "one", "spec": {"service_type": "vm"}, "expected": {"status": "DENIED"}}]}`, "unknown expected status"),
+		Entry("unknown policy type", `{"cases": [{"name": "one", "spec": {"service_type": "vm"}, "policies": [{"id": "p", "policy_type": "SYSTEM", "rego_code": "package p"}], "expected": {"status": "APPROVED"}}]}`, "not GLOBAL or USER"),
+		Entry("duplicate name", `{"cases": [{"name": "one", "spec": {"service_type": "vm"}, "expected": {"status": "APPROVED"}}, {"name": "one", "spec": {"service_type": "vm"}, "expected": {"status": "APPROVED"}}]}`, "duplicate case 'a/one'"),
+	)
+})
+
+var _ = Describe("Compare", func() {
+	It("compares specs as JSON values", func() {
+		expected := conformance.Outcome{Status: conformance.StatusModified, Spec: map[string]any{"cpu_count": float64(8)}}
+		actual := conformance.Outcome{Status: conformance.StatusModified, Spec: map[string]any{"cpu_count": 8}}
+
+		Expect(conformance.Compare(expected, actual)).To(BeEmpty())
+	})
+
+	It("reports every difference", func() {
+		expected := conformance.Outcome{
+			Status:            conformance.StatusModified,
+			Spec:              map[string]any{"region": "eu-west-1"},
+			SelectedProvider:  "aws",
+			PoliciesEvaluated: []string{"a", "b"},
+		}
+		actual := conformance.Outcome{
+			Status:            conformance.StatusModified,
+			Spec:              map[string]any{"region": "us-east-1"},
+			PoliciesEvaluated: []string{"b", "a"},
+		}
+
+		Expect(conformance.Compare(expected, actual)).To(Equal([]string{
+			`spec: expected {"region":"eu-west-1"}, got {"region":"us-east-1"}`,
+			`selected_provider: expected "aws", got ""`,
+			`policies_evaluated: expected ["a","b"], got ["b","a"]`,
+		}))
+	})
+
+	It("only compares the failing policy of rejections", func() {
+		expected := conformance.Outcome{Status: conformance.StatusRejected, PolicyID: "deny"}
+
+		Expect(conformance.Compare(expected, conformance.Outcome{Status: conformance.StatusRejected, PolicyID: "deny", PoliciesEvaluated: []string{"deny"}})).To(BeEmpty())
+		Expect(conformance.Compare(expected, conformance.Outcome{Status: conformance.StatusRejected, PolicyID: "other"})).To(HaveLen(1))
+		Expect(conformance.Compare(expected, conformance.Outcome{Status: conformance.StatusApproved})).To(Equal([]string{
+			`status: expected "REJECTED", got "APPROVED"`,
+		}))
+	})
+})
+
+var _ = Describe("Run", func() {
+	It("reports evaluator errors and differences per case", func() {
+		cases := []conformance.Case{
+			{Name: "a/pass", Spec: map[string]any{"service_type": "vm"}, Expected: conformance.Outcome{Status: conformance.StatusApproved}},
+			{Name: "a/fail", Spec: map[string]any{"service_type": "vm"}, Expected: conformance.Outcome{Status: conformance.StatusRejected}},
+			{Name: "a/error", Spec: map[string]any{"service_type": "database"}, Expected: conformance.Outcome{Status: conformance.StatusApproved}},
+		}
+		evaluator := evaluatorFunc(func(_ context.Context, _ []conformance.Policy, spec map[string]any) (*conformance.Outcome, error) {
+			if spec["service_type"] == "database" {
+				return nil, errors.New("engine crashed")
+			}
+			return &conformance.Outcome{Status: conformance.StatusApproved}, nil
+		})
+
+		results := conformance.Run(context.Background(), evaluator, cases)
+
+		Expect(results).To(HaveLen(3))
+		Expect(results[0].Passed()).To(BeTrue())
+		Expect(results[1].Passed()).To(BeFalse())
+		Expect(results[1].Differences).To(HaveLen(1))
+		Expect(results[2].Err).To(MatchError("engine crashed"))
+	})
+})
+
+type evaluatorFunc func(ctx context.Context, policies []conformance.Policy, spec map[string]any) (*conformance.Outcome, error)
+
+func (f evaluatorFunc) Evaluate(ctx context.Context, policies []conformance.Policy, spec map[string]any) (*conformance.Outcome, error) {
+	return f(ctx, policies, spec)
+}
+
+var _ = Describe("CommandEvaluator", func() {
+	It("exchanges the case and outcome as JSON", func() {
+		evaluator := conformance.NewCommandEvaluator("sh", "-c",
+			`grep -q '"policies":\[{"id":"p"' && echo '{"status": "MODIFIED", "spec": {"service_type": "vm"}}'`)
+
+		outcome, err := evaluator.Evaluate(context.Background(),
+			[]conformance.Policy{{ID: "p", PolicyType: "GLOBAL", RegoCode: "package p"}},
+			map[string]any{"service_type": "vm"})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outcome.Status).To(Equal(conformance.StatusModified))
+		Expect(outcome.Spec).To(Equal(map[string]any{"service_type": "vm"}))
+	})
+
+	It("fails with the standard error of the command", func() {
+		evaluator := conformance.NewCommandEvaluator("sh", "-c", "echo 'unsupported keyword' >&2; exit 3")
+
+		_, err := evaluator.Evaluate(context.Background(), nil, map[string]any{"service_type": "vm"})
+
+		Expect(err).To(MatchError(ContainSubstring("unsupported keyword")))
+	})
+})
@@ -0,0 +1,146 @@
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Evaluator evaluates the spec of a case against its policy set with the engine under test
+type Evaluator interface {
+	Evaluate(ctx context.Context, policies []Policy, spec map[string]any) (*Outcome, error)
+}
+
+// Result is the result of running one case
+type Result struct {
+	Case string
+	// Differences describe how the outcome differs from the expected one; empty when the
+	// case passed
+	Differences []string
+	// Err is the error the evaluator returned instead of an outcome
+	Err error
+}
+
+// Passed reports whether the engine under test produced the expected outcome
+func (r Result) Passed() bool {
+	return r.Err == nil && len(r.Differences) == 0
+}
+
+// Run evaluates every case with evaluator and compares the outcomes with the expected ones
+func Run(ctx context.Context, evaluator Evaluator, cases []Case) []Result {
+	results := make([]Result, 0, len(cases))
+	for _, c := range cases {
+		result := Result{Case: c.Name}
+		outcome, err := evaluator.Evaluate(ctx, c.Policies, c.Spec)
+		if err != nil {
+			result.Err = err
+		} else {
+			result.Differences = Compare(c.Expected, *outcome)
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Compare returns how actual differs from expected. Specs are compared as JSON values, so
+// number types do not matter, and the evaluated policies only when expected lists them.
+func Compare(expected, actual Outcome) []string {
+	var differences []string
+	differ := func(field string, want, got any) {
+		differences = append(differences, fmt.Sprintf("%s: expected %s, got %s", field, jsonString(want), jsonString(got)))
+	}
+
+	if actual.Status != expected.Status {
+		differ("status", expected.Status, actual.Status)
+		return differences
+	}
+	switch expected.Status {
+	case StatusApproved, StatusModified:
+		if !reflect.DeepEqual(normalize(expected.Spec), normalize(actual.Spec)) {
+			differ("spec", expected.Spec, actual.Spec)
+		}
+		if actual.SelectedProvider != expected.SelectedProvider {
+			differ("selected_provider", expected.SelectedProvider, actual.SelectedProvider)
+		}
+	default:
+		if expected.PolicyID != "" && actual.PolicyID != expected.PolicyID {
+			differ("policy_id", expected.PolicyID, actual.PolicyID)
+		}
+	}
+	if expected.PoliciesEvaluated != nil && !slices.Equal(expected.PoliciesEvaluated, actual.PoliciesEvaluated) {
+		differ("policies_evaluated", expected.PoliciesEvaluated, actual.PoliciesEvaluated)
+	}
+	return differences
+}
+
+// normalize returns v as decoded from its JSON encoding
+func normalize(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return v
+	}
+	return normalized
+}
+
+func jsonString(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// CommandRequest is what a CommandEvaluator writes to the standard input of its command
+type CommandRequest struct {
+	Policies []Policy       `json:"policies"`
+	Spec     map[string]any `json:"spec"`
+}
+
+// CommandEvaluator evaluates each case by running a command, so engines written in any
+// language can be tested. The command reads a CommandRequest as JSON from its standard
+// input and writes the Outcome as JSON to its standard output; exiting with a non-zero
+// status fails the case with the command's standard error.
+type CommandEvaluator struct {
+	name string
+	args []string
+}
+
+var _ Evaluator = (*CommandEvaluator)(nil)
+
+// NewCommandEvaluator creates a CommandEvaluator running name with args
+func NewCommandEvaluator(name string, args ...string) *CommandEvaluator {
+	return &CommandEvaluator{name: name, args: args}
+}
+
+// Evaluate runs the command for one case
+func (e *CommandEvaluator) Evaluate(ctx context.Context, policies []Policy, spec map[string]any) (*Outcome, error) {
+	input, err := json.Marshal(CommandRequest{Policies: policies, Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.name, e.args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return nil, fmt.Errorf("%w: %s", err, detail)
+		}
+		return nil, err
+	}
+	var outcome Outcome
+	if err := json.Unmarshal(stdout.Bytes(), &outcome); err != nil {
+		return nil, fmt.Errorf("the evaluator did not write an outcome: %w", err)
+	}
+	return &outcome, nil
+}
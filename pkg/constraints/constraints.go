@@ -1,25 +1,37 @@
-package service
+// Package constraints accumulates the JSON Schema field constraints and service provider
+// constraints that policies set, tightening them as lower-priority policies add their own,
+// and validates patches and providers against them. Provider adapters in the DCM ecosystem
+// use it to enforce the policy manager's constraint semantics when reconciling drift.
+package constraints
 
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math"
 	"regexp"
 	"slices"
-	"strings"
+	"sync"
 
 	"github.com/brunoga/deep/v4"
-	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
 	"github.com/santhosh-tekuri/jsonschema/v6"
 )
 
-// ConstraintContext tracks JSON Schema constraints set by higher-priority policies
-type ConstraintContext struct {
+// Set tracks the JSON Schema constraints set by higher-priority policies during one evaluation
+type Set struct {
 	constrainedFieldsByFieldPath map[string]map[string]any // field path → JSON Schema keywords
 	policyIdByFieldPath          map[string]string         // field path → policy ID that set it
 	serviceProviderConstraints   *AccumulatedSPConstraints
 }
 
+// ServiceProviderConstraints restricts the service providers a request may select: to those in
+// AllowList when it is not empty, and to those matching every regular expression in Patterns
+type ServiceProviderConstraints struct {
+	AllowList []string `json:"allow_list,omitempty"`
+	Patterns  []string `json:"patterns,omitempty"`
+}
+
 // AccumulatedSPConstraints tracks accumulated service provider constraints
 type AccumulatedSPConstraints struct {
 	AllowList   []string // Intersection of all allow lists
@@ -27,30 +39,56 @@ type AccumulatedSPConstraints struct {
 	SetByPolicy string   // Policy ID that first set SP constraints
 }
 
-// ConstraintConflictError is returned by MergeConstraints when a lower-priority
-// policy would loosen a constraint set by a higher-priority policy.
-type ConstraintConflictError struct {
-	FieldPath   string // field path that caused the conflict
-	SetByPolicy string // policy ID that set the existing constraint
-	Reason      string // human-readable detail
+// ConflictError is returned by MergeConstraints when a lower-priority policy would loosen a
+// constraint set by a higher-priority policy.
+type ConflictError struct {
+	FieldPath   string      // field path that caused the conflict
+	SetByPolicy string      // policy ID that set the existing constraint
+	Reason      string      // human-readable detail
+	Suggestion  *Suggestion // constraint the policy may set instead; nil when there is none
 }
 
-func (e *ConstraintConflictError) Error() string {
+func (e *ConflictError) Error() string {
 	return e.Reason
 }
 
-// NewConstraintContext creates a new ConstraintContext
-func NewConstraintContext() *ConstraintContext {
-	return &ConstraintContext{
+// Violation is a patch field whose value violates the accumulated constraints
+type Violation struct {
+	FieldPath   string
+	Reason      string
+	SetByPolicy string      // policy ID that set the violated constraint
+	Suggestion  *Suggestion // allowed values to use instead; nil when there are none to suggest
+}
+
+// NewSet creates a Set without constraints
+func NewSet() *Set {
+	return &Set{
 		constrainedFieldsByFieldPath: make(map[string]map[string]any),
 		policyIdByFieldPath:          make(map[string]string),
 	}
 }
 
+// Clone returns an independent copy of the accumulated constraints
+func (c *Set) Clone() *Set {
+	clone := NewSet()
+	for fieldPath, constraint := range c.constrainedFieldsByFieldPath {
+		clone.constrainedFieldsByFieldPath[fieldPath] = deepCopySchemaMap(constraint)
+	}
+	maps.Copy(clone.policyIdByFieldPath, c.policyIdByFieldPath)
+	if sp := c.serviceProviderConstraints; sp != nil {
+		clone.serviceProviderConstraints = &AccumulatedSPConstraints{
+			AllowList:   slices.Clone(sp.AllowList),
+			Patterns:    slices.Clone(sp.Patterns),
+			SetByPolicy: sp.SetByPolicy,
+		}
+	}
+	return clone
+}
+
 // MergeConstraints merges new per-field JSON Schema constraints from a policy.
 // Constraints can only be tightened, never loosened. Returns an error if a
 // constraint would be loosened.
-func (c *ConstraintContext) MergeConstraints(newConstraints map[string]any, policyID string) error {
+func (c *Set) MergeConstraints(newConstraints map[string]any, policyID string) error {
 	for fieldPath, constraint := range newConstraints {
 		newConstraint, ok := constraint.(map[string]any)
 		if !ok {
@@ -77,21 +115,27 @@ func (c *ConstraintContext) MergeConstraints(newConstraints map[string]any, poli
 
 // ValidatePatch validates each field in the patch against the accumulated
 // constraints using JSON Schema validation. Returns a list of violations.
-// Uses a single compiler and caches compiled schemas per field path for the duration of the call.
-func (c *ConstraintContext) ValidatePatch(patch map[string]any) []ConstraintViolation {
-	var violations []ConstraintViolation
-	compiler := jsonschema.NewCompiler()
-	compiled := make(map[string]*jsonschema.Schema)
-	c.validatePatchRecursive("", patch, &violations, compiler, compiled)
+// Compiled schemas come from the process-wide schema cache and are memoized per field path for the call.
+func (c *Set) ValidatePatch(patch map[string]any) []Violation {
+	var violations []Violation
+	compiled := compiledSchemas.Get().(map[string]*jsonschema.Schema)
+	defer func() {
+		clear(compiled)
+		compiledSchemas.Put(compiled)
+	}()
+	c.validatePatchRecursive("", patch, &violations, compiled)
 	return violations
 }
 
+// compiledSchemas holds the maps ValidatePatch looks up the schemas of a patch's fields in,
+// so validating a patch on the evaluation hot path does not allocate one
+var compiledSchemas = sync.Pool{New: func() any { return make(map[string]*jsonschema.Schema) }}
+
 // validatePatchRecursive recursively validates patch fields against constraints
-func (c *ConstraintContext) validatePatchRecursive(
+func (c *Set) validatePatchRecursive(
 	prefix string,
 	patch map[string]any,
-	violations *[]ConstraintViolation,
-	compiler *jsonschema.Compiler,
+	violations *[]Violation,
 	compiled map[string]*jsonschema.Schema,
 ) {
 	for key, value := range patch {
@@ -102,33 +146,36 @@ func (c *ConstraintContext) validatePatchRecursive(
 
 		// Check if there's a constraint for this field
 		if schemaMap, exists := c.constrainedFieldsByFieldPath[fieldPath]; exists {
-			comp, err := getOrCompileSchema(compiler, compiled, fieldPath, schemaMap)
+			comp, err := getOrCompileSchema(compiled, fieldPath, schemaMap)
 			if err != nil {
-				*violations = append(*violations, ConstraintViolation{
+				*violations = append(*violations, Violation{
 					FieldPath:   fieldPath,
 					Reason:      err.Error(),
 					SetByPolicy: c.policyIdByFieldPath[fieldPath],
 				})
 			} else if err := comp.Validate(value); err != nil {
-				*violations = append(*violations, ConstraintViolation{
+				policyID := c.policyIdByFieldPath[fieldPath]
+				*violations = append(*violations, Violation{
 					FieldPath:   fieldPath,
 					Reason:      fmt.Sprintf("value %v violates constraint: %v", value, err),
-					SetByPolicy: c.policyIdByFieldPath[fieldPath],
+					SetByPolicy: policyID,
+					Suggestion:  suggestValue(fieldPath, policyID, schemaMap, comp, value),
 				})
 			}
 		}
 
 		// Recurse into nested maps
 		if nestedMap, ok := value.(map[string]any); ok {
-			c.validatePatchRecursive(fieldPath, nestedMap, violations, compiler, compiled)
+			c.validatePatchRecursive(fieldPath, nestedMap, violations, compiled)
 		}
 	}
 }
 
 // MergeSPConstraints merges service provider constraints from a policy decision.
 // If sp is nil or has neither allow list nor patterns, it is a no-op.
-// Allow lists are intersected once; all patterns are appended (ANDed).
-func (c *ConstraintContext) MergeSPConstraints(sp *opa.ServiceProviderConstraints, policyID string) error {
+// Allow lists are intersected once; all patterns are appended (ANDed). An empty intersection
+// is reported as a *ServiceProviderError.
+func (c *Set) MergeSPConstraints(sp *ServiceProviderConstraints, policyID string) error {
 	if sp == nil {
 		return nil
 	}
@@ -150,23 +197,32 @@ func (c *ConstraintContext) MergeSPConstraints(sp *opa.ServiceProviderConstraint
 	if len(allowList) > 0 && len(c.serviceProviderConstraints.AllowList) > 0 {
 		intersected := intersectStringSlices(c.serviceProviderConstraints.AllowList, allowList)
 		if len(intersected) == 0 {
-			return fmt.Errorf("service provider allow list intersection is empty: "+
-				"policy '%s' allows %v but existing constraints from policy '%s' allow %v",
-				policyID, allowList, c.serviceProviderConstraints.SetByPolicy, c.serviceProviderConstraints.AllowList)
+			return &ServiceProviderError{
+				Reason: fmt.Sprintf("service provider allow list intersection is empty: "+
+					"policy '%s' allows %v but existing constraints from policy '%s' allow %v",
+					policyID, allowList, c.serviceProviderConstraints.SetByPolicy, c.serviceProviderConstraints.AllowList),
+				Suggestion: c.providerSuggestion(),
+			}
 		}
 		c.serviceProviderConstraints.AllowList = intersected
 	} else if len(allowList) > 0 {
 		c.serviceProviderConstraints.AllowList = allowList
 	}
 
-	// AND patterns
-	c.serviceProviderConstraints.Patterns = append(c.serviceProviderConstraints.Patterns, patterns...)
+	// AND patterns; a pattern already required adds nothing, which keeps the list from growing
+	// when an evaluation session re-applies the same policies step after step
+	for _, pattern := range patterns {
+		if !slices.Contains(c.serviceProviderConstraints.Patterns, pattern) {
+			c.serviceProviderConstraints.Patterns = append(c.serviceProviderConstraints.Patterns, pattern)
+		}
+	}
 
 	return nil
 }
 
-// ValidateServiceProvider checks a provider against accumulated SP constraints
-func (c *ConstraintContext) ValidateServiceProvider(provider string) error {
+// ValidateServiceProvider checks a provider against accumulated SP constraints. A provider
+// that violates them is reported as a *ServiceProviderError.
+func (c *Set) ValidateServiceProvider(provider string) error {
 	if c.serviceProviderConstraints == nil || provider == "" {
 		return nil
 	}
@@ -177,8 +233,11 @@ func (c *ConstraintContext) ValidateServiceProvider(provider string) error {
 	if len(sp.AllowList) > 0 {
 		found := slices.Contains(sp.AllowList, provider)
 		if !found {
-			return fmt.Errorf("provider '%s' is not in the allowed list %v (constrained by policy '%s')",
-				provider, sp.AllowList, sp.SetByPolicy)
+			return &ServiceProviderError{
+				Reason: fmt.Sprintf("provider '%s' is not in the allowed list %v (constrained by policy '%s')",
+					provider, sp.AllowList, sp.SetByPolicy),
+				Suggestion: c.providerSuggestion(),
+			}
 		}
 	}
 
@@ -189,7 +248,10 @@ func (c *ConstraintContext) ValidateServiceProvider(provider string) error {
 			return fmt.Errorf("invalid service provider pattern '%s': %v", pattern, err)
 		}
 		if !matched {
-			return fmt.Errorf("provider '%s' does not match required pattern '%s'", provider, pattern)
+			return &ServiceProviderError{
+				Reason:     fmt.Sprintf("provider '%s' does not match required pattern '%s'", provider, pattern),
+				Suggestion: c.providerSuggestion(),
+			}
 		}
 	}
 
@@ -197,7 +259,7 @@ func (c *ConstraintContext) ValidateServiceProvider(provider string) error {
 }
 
 // GetConstraintsMap returns the accumulated constraints for inclusion in OPA input
-func (c *ConstraintContext) GetConstraintsMap() map[string]any {
+func (c *Set) GetConstraintsMap() map[string]any {
 	if len(c.constrainedFieldsByFieldPath) == 0 {
 		return nil
 	}
@@ -209,7 +271,7 @@ func (c *ConstraintContext) GetConstraintsMap() map[string]any {
 }
 
 // GetSPConstraintsMap returns SP constraints for inclusion in OPA input
-func (c *ConstraintContext) GetSPConstraintsMap() map[string]any {
+func (c *Set) GetSPConstraintsMap() map[string]any {
 	if c.serviceProviderConstraints == nil {
 		return nil
 	}
@@ -240,14 +302,20 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 		switch keyword {
 		case "const":
 			// const must be identical
-			if !jsonValuesEqual(existingVal, newVal) {
-				return nil, &ConstraintConflictError{
+			if !specmerge.Equal(existingVal, newVal) {
+				return nil, &ConflictError{
 					FieldPath:   fieldPath,
 					SetByPolicy: existingPolicyID,
 					Reason: fmt.Sprintf(
 						"cannot change const constraint on field '%s': existing value %v (set by policy '%s') differs from new value %v",
 						fieldPath, existingVal, existingPolicyID, newVal,
 					),
+					Suggestion: &Suggestion{
+						FieldPath:     fieldPath,
+						SetByPolicy:   existingPolicyID,
+						NearestValue:  existingVal,
+						AllowedValues: []any{existingVal},
+					},
 				}
 			}
 
@@ -258,13 +326,14 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			if ok1 && ok2 {
 				intersected := intersectAnySlices(existingEnum, newEnum)
 				if len(intersected) == 0 {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"enum constraint intersection is empty for field '%s': existing %v (set by policy '%s'), new %v",
 							fieldPath, existingEnum, existingPolicyID, newEnum,
 						),
+						Suggestion: &Suggestion{FieldPath: fieldPath, SetByPolicy: existingPolicyID, AllowedValues: existingEnum},
 					}
 				}
 				merged[keyword] = intersected
@@ -276,13 +345,14 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			newNum, ok2 := toFloat64(newVal)
 			if ok1 && ok2 {
 				if newNum < existingNum {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"cannot loosen %s constraint on field '%s': existing %v (set by policy '%s'), attempted %v",
 							keyword, fieldPath, existingNum, existingPolicyID, newNum,
 						),
+						Suggestion: &Suggestion{FieldPath: fieldPath, SetByPolicy: existingPolicyID, NearestValue: existingNum},
 					}
 				}
 				merged[keyword] = math.Max(existingNum, newNum)
@@ -294,13 +364,14 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			newNum, ok2 := toFloat64(newVal)
 			if ok1 && ok2 {
 				if newNum > existingNum {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"cannot loosen %s constraint on field '%s': existing %v (set by policy '%s'), attempted %v",
 							keyword, fieldPath, existingNum, existingPolicyID, newNum,
 						),
+						Suggestion: &Suggestion{FieldPath: fieldPath, SetByPolicy: existingPolicyID, NearestValue: existingNum},
 					}
 				}
 				merged[keyword] = math.Min(existingNum, newNum)
@@ -311,13 +382,14 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			newNum, ok2 := toFloat64(newVal)
 			if ok1 && ok2 {
 				if newNum < existingNum {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"cannot loosen %s constraint on field '%s': existing %v (set by policy '%s'), attempted %v",
 							keyword, fieldPath, existingNum, existingPolicyID, newNum,
 						),
+						Suggestion: &Suggestion{FieldPath: fieldPath, SetByPolicy: existingPolicyID, NearestValue: existingNum},
 					}
 				}
 				merged[keyword] = math.Max(existingNum, newNum)
@@ -328,13 +400,14 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			newNum, ok2 := toFloat64(newVal)
 			if ok1 && ok2 {
 				if newNum > existingNum {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"cannot loosen %s constraint on field '%s': existing %v (set by policy '%s'), attempted %v",
 							keyword, fieldPath, existingNum, existingPolicyID, newNum,
 						),
+						Suggestion: &Suggestion{FieldPath: fieldPath, SetByPolicy: existingPolicyID, NearestValue: existingNum},
 					}
 				}
 				merged[keyword] = math.Min(existingNum, newNum)
@@ -365,13 +438,18 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 			if ok1 && ok2 && existingNum != 0 {
 				remainder := math.Mod(newNum, existingNum)
 				if remainder != 0 {
-					return nil, &ConstraintConflictError{
+					return nil, &ConflictError{
 						FieldPath:   fieldPath,
 						SetByPolicy: existingPolicyID,
 						Reason: fmt.Sprintf(
 							"multipleOf %v is not a multiple of existing %v on field '%s' (set by policy '%s')",
 							newNum, existingNum, fieldPath, existingPolicyID,
 						),
+						Suggestion: &Suggestion{
+							FieldPath:    fieldPath,
+							SetByPolicy:  existingPolicyID,
+							NearestValue: math.Max(existingNum, math.Round(newNum/existingNum)*existingNum),
+						},
 					}
 				}
 				// Keep the new (more restrictive) multipleOf
@@ -388,10 +466,9 @@ func mergeSchemaKeywords(existing, new map[string]any, fieldPath, existingPolicy
 	return merged, nil
 }
 
-// getOrCompileSchema returns a compiled schema for the field path, compiling and caching
-// it with the shared compiler on first use.
+// getOrCompileSchema returns a compiled schema for the field path, taking it from the
+// schema cache and memoizing it in compiled for the rest of the call.
 func getOrCompileSchema(
-	compiler *jsonschema.Compiler,
 	compiled map[string]*jsonschema.Schema,
 	fieldPath string,
 	schemaMap map[string]any,
@@ -399,22 +476,9 @@ func getOrCompileSchema(
 	if s := compiled[fieldPath]; s != nil {
 		return s, nil
 	}
-	schemaBytes, err := json.Marshal(schemaMap)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal schema: %v", err)
-	}
-	schema, err := jsonschema.UnmarshalJSON(strings.NewReader(string(schemaBytes)))
+	comp, err := defaultSchemaCache.get(schemaMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse schema: %v", err)
-	}
-	// Unique URI per field path so the compiler can hold multiple schemas
-	uri := "file:///constraint/" + strings.ReplaceAll(fieldPath, ".", "_")
-	if err := compiler.AddResource(uri, schema); err != nil {
-		return nil, fmt.Errorf("failed to add schema resource: %v", err)
-	}
-	comp, err := compiler.Compile(uri)
-	if err != nil {
-		return nil, fmt.Errorf("failed to compile schema: %v", err)
+		return nil, err
 	}
 	compiled[fieldPath] = comp
 	return comp, nil
@@ -486,20 +550,11 @@ func toSlice(v any) ([]any, bool) {
 	return nil, false
 }
 
-func jsonValuesEqual(a, b any) bool {
-	aBytes, err1 := json.Marshal(a)
-	bBytes, err2 := json.Marshal(b)
-	if err1 != nil || err2 != nil {
-		return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
-	}
-	return string(aBytes) == string(bBytes)
-}
-
 func intersectAnySlices(a, b []any) []any {
 	var result []any
 	for _, av := range a {
 		for _, bv := range b {
-			if jsonValuesEqual(av, bv) {
+			if specmerge.Equal(av, bv) {
 				result = append(result, av)
 				break
 			}
@@ -1,18 +1,16 @@
-package service
+package constraints_test
 
 import (
-	"github.com/dcm-project/policy-manager/internal/opa"
+	"github.com/dcm-project/policy-manager/pkg/constraints"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
-// Test suite is registered in other test files - don't register again
-
-var _ = Describe("ConstraintContext", func() {
-	var constraintCtx *ConstraintContext
+var _ = Describe("Set", func() {
+	var constraintCtx *constraints.Set
 
 	BeforeEach(func() {
-		constraintCtx = NewConstraintContext()
+		constraintCtx = constraints.NewSet()
 	})
 
 	Describe("MergeConstraints", func() {
@@ -369,7 +367,7 @@ var _ = Describe("ConstraintContext", func() {
 
 	Describe("MergeSPConstraints", func() {
 		It("stores first SP constraints", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
 			spConstraints := constraintCtx.GetSPConstraintsMap()
@@ -377,10 +375,10 @@ var _ = Describe("ConstraintContext", func() {
 		})
 
 		It("intersects allow lists", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws", "gcp", "azure"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp", "azure"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
-			err = constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws", "azure"}}, "policy-2")
+			err = constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "azure"}}, "policy-2")
 			Expect(err).NotTo(HaveOccurred())
 
 			spConstraints := constraintCtx.GetSPConstraintsMap()
@@ -389,30 +387,39 @@ var _ = Describe("ConstraintContext", func() {
 		})
 
 		It("rejects empty allow list intersection", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
-			err = constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"gcp"}}, "policy-2")
+			err = constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"gcp"}}, "policy-2")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("empty"))
 		})
 
 		It("accumulates patterns", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
-			err = constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{Patterns: []string{".*-prod$"}}, "policy-2")
+			err = constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{Patterns: []string{".*-prod$"}}, "policy-2")
 			Expect(err).NotTo(HaveOccurred())
 
 			spConstraints := constraintCtx.GetSPConstraintsMap()
 			patterns := spConstraints["patterns"].([]string)
 			Expect(patterns).To(ConsistOf("^aws", ".*-prod$"))
 		})
+
+		It("does not repeat a pattern that is already required", func() {
+			for range 2 {
+				err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(constraintCtx.GetSPConstraintsMap()["patterns"]).To(Equal([]string{"^aws"}))
+		})
 	})
 
 	Describe("ValidateServiceProvider", func() {
 		It("allows provider in allow list", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = constraintCtx.ValidateServiceProvider("aws")
@@ -420,7 +427,7 @@ var _ = Describe("ConstraintContext", func() {
 		})
 
 		It("rejects provider not in allow list", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = constraintCtx.ValidateServiceProvider("azure")
@@ -429,7 +436,7 @@ var _ = Describe("ConstraintContext", func() {
 		})
 
 		It("validates provider against pattern", func() {
-			err := constraintCtx.MergeSPConstraints(&opa.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")
 			Expect(err).NotTo(HaveOccurred())
 
 			err = constraintCtx.ValidateServiceProvider("aws-prod")
@@ -463,4 +470,25 @@ var _ = Describe("ConstraintContext", func() {
 			Expect(constraints).To(HaveKey("region"))
 		})
 	})
+
+	Describe("Clone", func() {
+		It("returns constraints that change independently of the original", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"region": map[string]any{"enum": []any{"us-east-1", "us-west-2"}},
+			}, "policy-1")).To(Succeed())
+			Expect(constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")).To(Succeed())
+
+			clone := constraintCtx.Clone()
+			Expect(clone.MergeConstraints(map[string]any{
+				"region": map[string]any{"enum": []any{"us-east-1"}},
+			}, "policy-2")).To(Succeed())
+			Expect(clone.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws"}}, "policy-2")).To(Succeed())
+
+			Expect(constraintCtx.ValidatePatch(map[string]any{"region": "us-west-2"})).To(BeEmpty())
+			Expect(clone.ValidatePatch(map[string]any{"region": "us-west-2"})).To(HaveLen(1))
+			Expect(clone.ValidatePatch(map[string]any{"region": "us-west-2"})[0].SetByPolicy).To(Equal("policy-1"))
+			Expect(constraintCtx.ValidateServiceProvider("gcp")).To(Succeed())
+			Expect(clone.ValidateServiceProvider("gcp")).NotTo(Succeed())
+		})
+	})
 })
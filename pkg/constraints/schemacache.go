@@ -0,0 +1,102 @@
+package constraints
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// maxCachedSchemas bounds the schema cache; policies typically emit a small, stable set of constraints
+const maxCachedSchemas = 4096
+
+// defaultSchemaCache is shared by all evaluations so identical constraint schemas are compiled once per process
+var defaultSchemaCache = newSchemaCache(maxCachedSchemas)
+
+// schemaCache holds compiled JSON Schemas keyed by their canonical JSON encoding
+type schemaCache struct {
+	mu      sync.RWMutex
+	max     int
+	schemas map[string]*jsonschema.Schema
+}
+
+func newSchemaCache(max int) *schemaCache {
+	return &schemaCache{max: max, schemas: map[string]*jsonschema.Schema{}}
+}
+
+// keyBuffers holds the buffers schema keys are encoded into; a hit looks the key up without
+// allocating it, so only misses allocate
+var keyBuffers = sync.Pool{New: func() any { return new(bytes.Buffer) }}
+
+// get returns the compiled schema for schemaMap, compiling it on first use.
+// encoding/json sorts map keys, so equal schemas share an entry.
+func (c *schemaCache) get(schemaMap map[string]any) (*jsonschema.Schema, error) {
+	buf := keyBuffers.Get().(*bytes.Buffer)
+	defer func() {
+		buf.Reset()
+		keyBuffers.Put(buf)
+	}()
+	if err := json.NewEncoder(buf).Encode(schemaMap); err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %v", err)
+	}
+	schemaBytes := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+
+	c.mu.RLock()
+	comp, ok := c.schemas[string(schemaBytes)]
+	c.mu.RUnlock()
+	if ok {
+		return comp, nil
+	}
+
+	comp, err := compileSchema(schemaBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.schemas) >= c.max {
+		// Constraints are usually a small fixed set; start over rather than track recency
+		c.schemas = map[string]*jsonschema.Schema{}
+	}
+	c.schemas[string(schemaBytes)] = comp
+	return comp, nil
+}
+
+// len returns the number of cached schemas
+func (c *schemaCache) len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.schemas)
+}
+
+// Precompile compiles schema into the process-wide cache used by ValidatePatch, so the first
+// validation against it does not pay for compilation
+func Precompile(schema map[string]any) error {
+	_, err := defaultSchemaCache.get(schema)
+	return err
+}
+
+// compileSchema compiles a single schema with its own compiler; compilers are not safe for concurrent use
+func compileSchema(schemaBytes []byte) (*jsonschema.Schema, error) {
+	schema, err := jsonschema.UnmarshalJSON(strings.NewReader(string(schemaBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %v", err)
+	}
+	sum := sha256.Sum256(schemaBytes)
+	uri := "file:///constraint/" + hex.EncodeToString(sum[:])
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(uri, schema); err != nil {
+		return nil, fmt.Errorf("failed to add schema resource: %v", err)
+	}
+	comp, err := compiler.Compile(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema: %v", err)
+	}
+	return comp, nil
+}
@@ -0,0 +1,46 @@
+package constraints
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("schemaCache", func() {
+	It("shares compiled schemas between equal schema maps", func() {
+		cache := newSchemaCache(10)
+		first, err := cache.get(map[string]any{"const": "a", "type": "string"})
+		Expect(err).NotTo(HaveOccurred())
+		second, err := cache.get(map[string]any{"type": "string", "const": "a"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(BeIdenticalTo(first))
+		Expect(cache.len()).To(Equal(1))
+	})
+
+	It("starts over when full", func() {
+		cache := newSchemaCache(2)
+		for _, v := range []string{"a", "b", "c"} {
+			_, err := cache.get(map[string]any{"const": v})
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(cache.len()).To(Equal(1))
+	})
+
+	It("returns an error for invalid schemas", func() {
+		cache := newSchemaCache(2)
+		_, err := cache.get(map[string]any{"minimum": "not a number"})
+		Expect(err).To(HaveOccurred())
+		Expect(cache.len()).To(BeZero())
+	})
+})
+
+var _ = Describe("Precompile", func() {
+	It("adds the schema to the process-wide cache", func() {
+		schema := map[string]any{"enum": []any{"precompiled-us-east-1", "precompiled-eu-west-1"}}
+		Expect(Precompile(schema)).To(Succeed())
+
+		before := defaultSchemaCache.len()
+		_, err := defaultSchemaCache.get(schema)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(defaultSchemaCache.len()).To(Equal(before), "schema should already be cached")
+	})
+})
@@ -0,0 +1,169 @@
+package constraints
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// Suggestion is a way to resolve a constraint violation or conflict that a client can apply
+// or offer: the value nearest the rejected one that the accumulated constraints allow, the
+// values they allow, or the service providers they allow. Fields that do not apply are empty.
+type Suggestion struct {
+	FieldPath        string   // field path the suggestion is for; empty for service providers
+	SetByPolicy      string   // policy ID that set the constraint
+	NearestValue     any      // allowed value nearest the rejected one
+	AllowedValues    []any    // every allowed value, when the constraint enumerates them
+	AllowedProviders []string // service providers the accumulated constraints allow
+}
+
+// ServiceProviderError is returned by MergeSPConstraints and ValidateServiceProvider when the
+// service provider constraints of a policy, or the provider it selected, cannot be satisfied
+type ServiceProviderError struct {
+	Reason     string      // human-readable detail
+	Suggestion *Suggestion // providers that remain allowed; nil when they cannot be listed
+}
+
+func (e *ServiceProviderError) Error() string {
+	return e.Reason
+}
+
+// suggestValue returns the suggestion for a value of fieldPath that violates schemaMap,
+// compiled as schema: the allowed values of a const or enum keyword and, for a number, the
+// nearest number within the range and multiple the keywords allow. Every suggested value
+// satisfies schema; nil is returned when there is nothing to suggest.
+func suggestValue(fieldPath, policyID string, schemaMap map[string]any, schema *jsonschema.Schema, value any) *Suggestion {
+	valid := func(v any) bool { return schema.Validate(v) == nil }
+	suggestion := &Suggestion{FieldPath: fieldPath, SetByPolicy: policyID}
+
+	if constValue, ok := schemaMap["const"]; ok {
+		if valid(constValue) {
+			suggestion.AllowedValues = []any{constValue}
+		}
+	} else if enum, ok := toSlice(schemaMap["enum"]); ok {
+		for _, v := range enum {
+			if valid(v) {
+				suggestion.AllowedValues = append(suggestion.AllowedValues, v)
+			}
+		}
+	}
+
+	switch {
+	case len(suggestion.AllowedValues) == 1:
+		suggestion.NearestValue = suggestion.AllowedValues[0]
+	case len(suggestion.AllowedValues) > 1:
+		suggestion.NearestValue = nearestNumber(value, suggestion.AllowedValues)
+	default:
+		if n, ok := toFloat64(value); ok {
+			if nearest, ok := nearestInRange(schemaMap, n); ok && valid(nearest) {
+				suggestion.NearestValue = nearest
+			}
+		}
+	}
+
+	if suggestion.NearestValue == nil && len(suggestion.AllowedValues) == 0 {
+		return nil
+	}
+	return suggestion
+}
+
+// nearestNumber returns the value of allowed numerically nearest value, or nil when value or
+// none of allowed is a number
+func nearestNumber(value any, allowed []any) any {
+	n, ok := toFloat64(value)
+	if !ok {
+		return nil
+	}
+	var nearest any
+	distance := math.Inf(1)
+	for _, v := range allowed {
+		if candidate, ok := toFloat64(v); ok && math.Abs(candidate-n) < distance {
+			nearest, distance = v, math.Abs(candidate-n)
+		}
+	}
+	return nearest
+}
+
+// nearestInRange returns the number nearest n within the bounds of schemaMap that is a
+// multiple of its multipleOf. Without multipleOf, an integer n is only moved to integers,
+// and exclusive bounds, which have no nearest real number, are not suggested for.
+func nearestInRange(schemaMap map[string]any, n float64) (float64, bool) {
+	step := 0.0
+	if multipleOf, ok := toFloat64(schemaMap["multipleOf"]); ok && multipleOf > 0 {
+		step = multipleOf
+	} else if n == math.Trunc(n) {
+		step = 1
+	}
+
+	lower, upper := math.Inf(-1), math.Inf(1)
+	if minimum, ok := toFloat64(schemaMap["minimum"]); ok {
+		lower = math.Max(lower, roundToStep(minimum, step, math.Ceil))
+	}
+	if maximum, ok := toFloat64(schemaMap["maximum"]); ok {
+		upper = math.Min(upper, roundToStep(maximum, step, math.Floor))
+	}
+	if exclusiveMinimum, ok := toFloat64(schemaMap["exclusiveMinimum"]); ok {
+		if step == 0 {
+			return 0, false
+		}
+		lower = math.Max(lower, (math.Floor(exclusiveMinimum/step)+1)*step)
+	}
+	if exclusiveMaximum, ok := toFloat64(schemaMap["exclusiveMaximum"]); ok {
+		if step == 0 {
+			return 0, false
+		}
+		upper = math.Min(upper, (math.Ceil(exclusiveMaximum/step)-1)*step)
+	}
+	if lower > upper {
+		return 0, false
+	}
+
+	switch {
+	case n < lower:
+		return lower, true
+	case n > upper:
+		return upper, true
+	case step > 0:
+		// Within the bounds but not a multiple of multipleOf
+		return math.Min(math.Max(math.Round(n/step)*step, lower), upper), true
+	}
+	return 0, false
+}
+
+// roundToStep rounds n to a multiple of step with round, or returns n when step is 0
+func roundToStep(n, step float64, round func(float64) float64) float64 {
+	if step == 0 {
+		return n
+	}
+	return round(n/step) * step
+}
+
+// providerSuggestion returns the providers of the accumulated allow list that match every
+// accumulated pattern, or nil when there is no allow list to list them from or none match
+func (c *Set) providerSuggestion() *Suggestion {
+	sp := c.serviceProviderConstraints
+	if sp == nil || len(sp.AllowList) == 0 {
+		return nil
+	}
+	var allowed []string
+	for _, provider := range sp.AllowList {
+		if matchesAll(sp.Patterns, provider) {
+			allowed = append(allowed, provider)
+		}
+	}
+	if len(allowed) == 0 {
+		return nil
+	}
+	return &Suggestion{SetByPolicy: sp.SetByPolicy, AllowedProviders: allowed}
+}
+
+// matchesAll reports whether provider matches every pattern; invalid patterns match nothing
+func matchesAll(patterns []string, provider string) bool {
+	for _, pattern := range patterns {
+		if matched, err := regexp.MatchString(pattern, provider); err != nil || !matched {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,165 @@
+package constraints_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/dcm-project/policy-manager/pkg/constraints"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Suggestions", func() {
+	var constraintCtx *constraints.Set
+
+	BeforeEach(func() {
+		constraintCtx = constraints.NewSet()
+	})
+
+	// suggestionFor returns the suggestion of the single violation of patch
+	suggestionFor := func(patch map[string]any) *constraints.Suggestion {
+		violations := constraintCtx.ValidatePatch(patch)
+		Expect(violations).To(HaveLen(1))
+		return violations[0].Suggestion
+	}
+
+	Describe("of a violating patch value", func() {
+		It("clamps a number to the merged range", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"cpu_count": map[string]any{"minimum": float64(1), "maximum": float64(16)},
+			}, "policy-1")).To(Succeed())
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"cpu_count": map[string]any{"maximum": float64(8)},
+			}, "policy-2")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"cpu_count": json.Number("12")})).To(Equal(&constraints.Suggestion{
+				FieldPath:    "cpu_count",
+				SetByPolicy:  "policy-1",
+				NearestValue: float64(8),
+			}))
+			Expect(suggestionFor(map[string]any{"cpu_count": float64(0)}).NearestValue).To(Equal(float64(1)))
+		})
+
+		It("moves a number to the nearest allowed multiple", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"memory_gb": map[string]any{"multipleOf": float64(4), "maximum": float64(16)},
+			}, "policy-1")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"memory_gb": float64(7)}).NearestValue).To(Equal(float64(8)))
+			Expect(suggestionFor(map[string]any{"memory_gb": float64(30)}).NearestValue).To(Equal(float64(16)))
+		})
+
+		It("keeps integers on integers within exclusive bounds", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"replicas": map[string]any{"exclusiveMinimum": float64(2)},
+			}, "policy-1")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"replicas": float64(1)}).NearestValue).To(Equal(float64(3)))
+			Expect(suggestionFor(map[string]any{"replicas": 1.5})).To(BeNil())
+		})
+
+		It("lists the allowed enum values and the nearest number", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"region":  map[string]any{"enum": []any{"us-east-1", "us-west-2"}},
+				"disk_gb": map[string]any{"enum": []any{float64(50), float64(100), float64(200)}},
+			}, "policy-1")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"region": "eu-west-1"})).To(Equal(&constraints.Suggestion{
+				FieldPath:     "region",
+				SetByPolicy:   "policy-1",
+				AllowedValues: []any{"us-east-1", "us-west-2"},
+			}))
+			disk := suggestionFor(map[string]any{"disk_gb": float64(120)})
+			Expect(disk.AllowedValues).To(Equal([]any{float64(50), float64(100), float64(200)}))
+			Expect(disk.NearestValue).To(Equal(float64(100)))
+		})
+
+		It("only suggests enum values the other keywords allow", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"disk_gb": map[string]any{"enum": []any{float64(50), float64(100), float64(200)}, "maximum": float64(100)},
+			}, "policy-1")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"disk_gb": float64(200)}).AllowedValues).To(Equal([]any{float64(50), float64(100)}))
+		})
+
+		It("suggests nothing for a constraint it cannot resolve", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"name": map[string]any{"pattern": "^vm-"},
+			}, "policy-1")).To(Succeed())
+
+			Expect(suggestionFor(map[string]any{"name": "db-1"})).To(BeNil())
+		})
+	})
+
+	Describe("of a loosening constraint", func() {
+		It("suggests the existing bound", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"cpu_count": map[string]any{"maximum": float64(8)},
+			}, "policy-1")).To(Succeed())
+
+			err := constraintCtx.MergeConstraints(map[string]any{
+				"cpu_count": map[string]any{"maximum": float64(16)},
+			}, "policy-2")
+
+			var conflictErr *constraints.ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Suggestion).To(Equal(&constraints.Suggestion{
+				FieldPath:    "cpu_count",
+				SetByPolicy:  "policy-1",
+				NearestValue: float64(8),
+			}))
+		})
+
+		It("suggests the existing enum values", func() {
+			Expect(constraintCtx.MergeConstraints(map[string]any{
+				"region": map[string]any{"enum": []any{"us-east-1", "us-west-2"}},
+			}, "policy-1")).To(Succeed())
+
+			err := constraintCtx.MergeConstraints(map[string]any{
+				"region": map[string]any{"enum": []any{"eu-west-1"}},
+			}, "policy-2")
+
+			var conflictErr *constraints.ConflictError
+			Expect(errors.As(err, &conflictErr)).To(BeTrue())
+			Expect(conflictErr.Suggestion.AllowedValues).To(Equal([]any{"us-east-1", "us-west-2"}))
+		})
+	})
+
+	Describe("of a service provider", func() {
+		It("lists the allowed providers that match every pattern", func() {
+			Expect(constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{
+				AllowList: []string{"aws-prod", "aws-dev", "gcp-prod"},
+				Patterns:  []string{"-prod$"},
+			}, "policy-1")).To(Succeed())
+
+			err := constraintCtx.ValidateServiceProvider("azure-prod")
+
+			var spErr *constraints.ServiceProviderError
+			Expect(errors.As(err, &spErr)).To(BeTrue())
+			Expect(spErr.Suggestion).To(Equal(&constraints.Suggestion{
+				SetByPolicy:      "policy-1",
+				AllowedProviders: []string{"aws-prod", "gcp-prod"},
+			}))
+		})
+
+		It("lists the providers still allowed when allow lists do not intersect", func() {
+			Expect(constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"aws", "gcp"}}, "policy-1")).To(Succeed())
+
+			err := constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{AllowList: []string{"azure"}}, "policy-2")
+
+			var spErr *constraints.ServiceProviderError
+			Expect(errors.As(err, &spErr)).To(BeTrue())
+			Expect(spErr.Suggestion.AllowedProviders).To(Equal([]string{"aws", "gcp"}))
+		})
+
+		It("suggests nothing when only patterns constrain providers", func() {
+			Expect(constraintCtx.MergeSPConstraints(&constraints.ServiceProviderConstraints{Patterns: []string{"^aws"}}, "policy-1")).To(Succeed())
+
+			err := constraintCtx.ValidateServiceProvider("gcp")
+
+			var spErr *constraints.ServiceProviderError
+			Expect(errors.As(err, &spErr)).To(BeTrue())
+			Expect(spErr.Suggestion).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,63 @@
+// Package specmerge merges, copies and compares service instance specs the way the policy
+// manager does when it applies policy patches, so provider adapters reconciling drift on
+// managed resources can apply the same semantics locally.
+package specmerge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/brunoga/deep/v4"
+)
+
+// Merge performs a recursive JSON Merge Patch (RFC 7396) of patch into base and returns the
+// result; base is not modified. Fields in patch override fields in base, null values in
+// patch remove fields from base, and fields not mentioned in patch are preserved from base.
+func Merge(base, patch map[string]any) (map[string]any, error) {
+	result, err := Copy(base)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			// null means remove the field
+			delete(result, key)
+			continue
+		}
+
+		patchMap, patchIsMap := patchValue.(map[string]any)
+		baseValue, baseExists := result[key]
+		baseMap, baseIsMap := baseValue.(map[string]any)
+
+		if patchIsMap && baseExists && baseIsMap {
+			// Both are maps — recurse
+			result[key], err = Merge(baseMap, patchMap)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// Patch value overrides base
+			result[key] = patchValue
+		}
+	}
+
+	return result, nil
+}
+
+// Copy returns a deep copy of spec
+func Copy(spec map[string]any) (map[string]any, error) {
+	return deep.Copy(spec)
+}
+
+// Equal reports whether a and b have the same canonical JSON encoding, so numbers compare
+// equal whatever their Go type and maps compare equal whatever their key order. Values
+// that cannot be encoded are compared by their default formatting.
+func Equal(a, b any) bool {
+	aBytes, err1 := json.Marshal(a)
+	bBytes, err2 := json.Marshal(b)
+	if err1 != nil || err2 != nil {
+		return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+	}
+	return string(aBytes) == string(bBytes)
+}
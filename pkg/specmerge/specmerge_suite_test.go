@@ -0,0 +1,13 @@
+package specmerge_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSpecmerge(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Specmerge Suite")
+}
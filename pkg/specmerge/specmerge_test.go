@@ -0,0 +1,61 @@
+package specmerge_test
+
+import (
+	"github.com/dcm-project/policy-manager/pkg/specmerge"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Merge", func() {
+	It("merges nested objects, replaces other values and removes null fields", func() {
+		base := map[string]any{
+			"region":  "us-east-1",
+			"network": map[string]any{"vpc": "default", "subnet": "a"},
+			"tags":    []any{"a"},
+			"legacy":  true,
+		}
+
+		merged, err := specmerge.Merge(base, map[string]any{
+			"network": map[string]any{"subnet": "b"},
+			"tags":    []any{"b"},
+			"legacy":  nil,
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged).To(Equal(map[string]any{
+			"region":  "us-east-1",
+			"network": map[string]any{"vpc": "default", "subnet": "b"},
+			"tags":    []any{"b"},
+		}))
+		Expect(base["network"]).To(Equal(map[string]any{"vpc": "default", "subnet": "a"}), "base should not be modified")
+		Expect(base).To(HaveKey("legacy"))
+	})
+
+	It("replaces a non-object value with an object", func() {
+		merged, err := specmerge.Merge(map[string]any{"network": "default"}, map[string]any{"network": map[string]any{"vpc": "a"}})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged).To(Equal(map[string]any{"network": map[string]any{"vpc": "a"}}))
+	})
+})
+
+var _ = Describe("Copy", func() {
+	It("returns a copy that changes independently of the original", func() {
+		spec := map[string]any{"network": map[string]any{"vpc": "default"}}
+
+		copied, err := specmerge.Copy(spec)
+		Expect(err).NotTo(HaveOccurred())
+		copied["network"].(map[string]any)["vpc"] = "other"
+
+		Expect(spec["network"]).To(Equal(map[string]any{"vpc": "default"}))
+	})
+})
+
+var _ = Describe("Equal", func() {
+	It("compares canonical JSON encodings", func() {
+		Expect(specmerge.Equal(map[string]any{"a": 1, "b": []any{"x"}}, map[string]any{"b": []any{"x"}, "a": float64(1)})).To(BeTrue())
+		Expect(specmerge.Equal(int32(2), 2.0)).To(BeTrue())
+		Expect(specmerge.Equal("2", 2)).To(BeFalse())
+		Expect(specmerge.Equal([]any{1, 2}, []any{2, 1})).To(BeFalse())
+	})
+})
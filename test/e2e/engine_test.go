@@ -48,7 +48,7 @@ var _ = Describe("Engine API - Policy Evaluation", func() {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -93,7 +93,7 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policyID)
+				policyClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			})
 
 			It("should return MODIFIED with updated spec preserving existing fields", func() {
@@ -106,7 +106,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -149,7 +149,7 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policyID)
+				policyClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			})
 
 			It("should return 406 Not Acceptable", func() {
@@ -161,7 +161,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusNotAcceptable))
 				Expect(resp.JSON406).NotTo(BeNil())
@@ -235,8 +235,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict", func() {
@@ -248,7 +248,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -318,8 +318,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict for out-of-range value", func() {
@@ -331,7 +331,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -396,8 +396,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict for disallowed provider", func() {
@@ -409,7 +409,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -479,8 +479,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return MODIFIED with value within range", func() {
@@ -492,7 +492,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -561,8 +561,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict for value not in enum", func() {
@@ -574,7 +574,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -641,8 +641,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return MODIFIED with value from enum", func() {
@@ -654,7 +654,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -696,7 +696,7 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policyID)
+				policyClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			})
 
 			It("should return APPROVED with spec unchanged", func() {
@@ -711,7 +711,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -789,8 +789,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return MODIFIED with tightened constraints accepted", func() {
@@ -802,7 +802,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200).NotTo(BeNil())
@@ -871,8 +871,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict for loosened constraints", func() {
@@ -884,7 +884,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -949,8 +949,8 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policy1ID)
-				policyClient.DeletePolicyWithResponse(ctx, policy2ID)
+				policyClient.DeletePolicyWithResponse(ctx, policy1ID, nil)
+				policyClient.DeletePolicyWithResponse(ctx, policy2ID, nil)
 			})
 
 			It("should return 409 Conflict for provider not matching pattern", func() {
@@ -962,7 +962,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusConflict))
 				Expect(resp.JSON409).NotTo(BeNil())
@@ -1008,7 +1008,7 @@ main := {
 			})
 
 			AfterEach(func() {
-				policyClient.DeletePolicyWithResponse(ctx, policyID)
+				policyClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			})
 
 			It("should apply policy when labels match", func() {
@@ -1026,7 +1026,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200.Status).To(Equal(engineapi.MODIFIED))
@@ -1048,7 +1048,7 @@ main := {
 					},
 				}
 
-				resp, err := engineClient.EvaluateRequestWithResponse(ctx, request)
+				resp, err := engineClient.EvaluateRequestWithResponse(ctx, nil, request)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 				Expect(resp.JSON200.Status).To(Equal(engineapi.APPROVED))
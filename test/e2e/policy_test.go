@@ -25,7 +25,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 	AfterEach(func() {
 		// Clean up created policies
 		for _, id := range createdPolicyIDs {
-			_, _ = apiClient.DeletePolicyWithResponse(ctx, id)
+			_, _ = apiClient.DeletePolicyWithResponse(ctx, id, nil)
 		}
 		createdPolicyIDs = nil
 	})
@@ -85,7 +85,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			// Get the policy
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(getResp.JSON200).NotTo(BeNil())
@@ -116,7 +116,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				Priority:    ptr(int32(600)),
 			}
 
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, updatedPolicy)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, updatedPolicy)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(updateResp.JSON200).NotTo(BeNil())
@@ -140,15 +140,50 @@ var _ = Describe("Policy CRUD Operations", func() {
 			policyID := *createResp.JSON201.Id
 
 			// Delete the policy
-			deleteResp, err := apiClient.DeletePolicyWithResponse(ctx, policyID)
+			deleteResp, err := apiClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(deleteResp.StatusCode()).To(Equal(http.StatusNoContent))
 
 			// Verify policy is deleted
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusNotFound))
 		})
+
+		It("should revalidate a cached policy with its ETag", func() {
+			policy := v1alpha1.Policy{
+				DisplayName: ptr("Cached Policy"),
+				PolicyType:  ptr(v1alpha1.GLOBAL),
+				Priority:    ptr(int32(105)),
+				RegoCode:    ptr("package test\nallow = true"),
+			}
+
+			createResp, err := apiClient.CreatePolicyWithResponse(ctx, &v1alpha1.CreatePolicyParams{}, policy)
+			Expect(err).NotTo(HaveOccurred())
+			policyID := *createResp.JSON201.Id
+			createdPolicyIDs = append(createdPolicyIDs, policyID)
+
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
+			Expect(err).NotTo(HaveOccurred())
+			etag := getResp.HTTPResponse.Header.Get("ETag")
+			Expect(etag).NotTo(BeEmpty())
+			Expect(getResp.HTTPResponse.Header.Get("Last-Modified")).NotTo(BeEmpty())
+			Expect(getResp.HTTPResponse.Header.Get("Cache-Control")).NotTo(BeEmpty())
+
+			cachedResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, &v1alpha1.GetPolicyParams{IfNoneMatch: &etag})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cachedResp.StatusCode()).To(Equal(http.StatusNotModified))
+
+			_, err = apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, v1alpha1.Policy{
+				Description: ptr("Changed"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			staleResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, &v1alpha1.GetPolicyParams{IfNoneMatch: &etag})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(staleResp.StatusCode()).To(Equal(http.StatusOK))
+			Expect(staleResp.HTTPResponse.Header.Get("ETag")).NotTo(Equal(etag))
+		})
 	})
 
 	Describe("ID Handling", func() {
@@ -411,7 +446,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyBID)
 
 			patch := v1alpha1.Policy{DisplayName: ptr("Name A")}
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyBID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyBID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusConflict))
 			Expect(updateResp.JSON409).NotTo(BeNil())
@@ -447,7 +482,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyBID)
 
 			patch := v1alpha1.Policy{Priority: ptr(int32(401))}
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyBID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyBID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusConflict))
 			Expect(updateResp.JSON409).NotTo(BeNil())
@@ -471,7 +506,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{Description: ptr("Updated")}
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 		})
@@ -491,7 +526,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{DisplayName: ptr("Stable Renamed")}
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 		})
@@ -920,7 +955,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{RegoCode: ptr("")}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -940,7 +975,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{RegoCode: ptr("   \t\n ")}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -960,7 +995,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{Priority: ptr(int32(0))}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -980,7 +1015,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			patch := v1alpha1.Policy{Priority: ptr(int32(1001))}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1007,7 +1042,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				PolicyType: ptr(v1alpha1.USER),
 			}
 
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1029,7 +1064,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				Path:        ptr("policies/other-id"),
 				DisplayName: ptr("Updated"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1051,7 +1086,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				Id:          ptr("other-id"),
 				DisplayName: ptr("Updated"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1074,7 +1109,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				CreateTime:  ptr(otherTime),
 				DisplayName: ptr("Updated"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1097,7 +1132,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				UpdateTime:  ptr(otherTime),
 				DisplayName: ptr("Updated"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusBadRequest))
 		})
@@ -1120,7 +1155,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				PolicyType:  createResp.JSON201.PolicyType,
 				DisplayName: ptr("Same Value Updated"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(resp.JSON200).NotTo(BeNil())
@@ -1159,7 +1194,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				RegoCode: ptr("package updated\nallow = false"),
 			}
 
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(*updateResp.JSON200.DisplayName).To(Equal("Mutable Updated Name"))
@@ -1171,7 +1206,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 
 	Describe("Operations on non-existent policies", func() {
 		It("should return 404 for non-existent policy GET", func() {
-			resp, err := apiClient.GetPolicyWithResponse(ctx, "non-existent-id")
+			resp, err := apiClient.GetPolicyWithResponse(ctx, "non-existent-id", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusNotFound))
 		})
@@ -1180,13 +1215,13 @@ var _ = Describe("Policy CRUD Operations", func() {
 			update := v1alpha1.Policy{
 				DisplayName: ptr("Update Non-Existent"),
 			}
-			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, "non-existent-id", update)
+			resp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, "non-existent-id", nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusNotFound))
 		})
 
 		It("should return 404 for non-existent policy DELETE", func() {
-			resp, err := apiClient.DeletePolicyWithResponse(ctx, "non-existent-id")
+			resp, err := apiClient.DeletePolicyWithResponse(ctx, "non-existent-id", nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp.StatusCode()).To(Equal(http.StatusNotFound))
 		})
@@ -1258,7 +1293,7 @@ var _ = Describe("Policy CRUD Operations", func() {
 				},
 			}
 
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, update)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, update)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect((*updateResp.JSON200.LabelSelector)["env"]).To(Equal("prod"))
@@ -1388,7 +1423,7 @@ allow if {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			// Get policy - should return Rego code
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(getResp.JSON200.RegoCode).NotTo(BeNil())
@@ -1435,12 +1470,12 @@ allow if {
 				RegoCode: &updatedRego,
 			}
 
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusOK))
 
 			// Verify updated Rego is returned by GET
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(getResp.JSON200.RegoCode).NotTo(BeNil())
@@ -1465,13 +1500,13 @@ allow if {
 
 			invalidRego := "this is not valid rego syntax!!!"
 			patch := v1alpha1.Policy{RegoCode: &invalidRego}
-			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, patch)
+			updateResp, err := apiClient.UpdatePolicyWithApplicationMergePatchPlusJSONBodyWithResponse(ctx, policyID, nil, patch)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(updateResp.StatusCode()).To(Equal(http.StatusBadRequest), "Should reject invalid Rego on update")
 			Expect(updateResp.JSON400).NotTo(BeNil())
 			Expect(string(updateResp.JSON400.Type)).To(Equal("INVALID_ARGUMENT"))
 
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(getResp.JSON200.RegoCode).NotTo(BeNil())
@@ -1495,16 +1530,16 @@ allow if {
 			// Do not append to createdPolicyIDs - we are testing delete
 
 			// Verify policy exists via API before delete
-			getBeforeResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getBeforeResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getBeforeResp.StatusCode()).To(Equal(http.StatusOK), "Policy should exist after create")
 
-			deleteResp, err := apiClient.DeletePolicyWithResponse(ctx, policyID)
+			deleteResp, err := apiClient.DeletePolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(deleteResp.StatusCode()).To(Equal(http.StatusNoContent), "Delete should succeed")
 
 			// Verify policy is gone from API
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusNotFound))
 		})
@@ -1564,7 +1599,7 @@ allow if {
 			createdPolicyIDs = append(createdPolicyIDs, policyID)
 
 			// Get policy - verify Unicode is preserved
-			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID)
+			getResp, err := apiClient.GetPolicyWithResponse(ctx, policyID, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(getResp.StatusCode()).To(Equal(http.StatusOK))
 			Expect(getResp.JSON200.RegoCode).NotTo(BeNil())
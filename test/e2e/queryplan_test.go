@@ -0,0 +1,96 @@
+//go:build e2e
+
+package e2e_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/gorm"
+
+	"github.com/dcm-project/policy-manager/internal/config"
+	"github.com/dcm-project/policy-manager/internal/store"
+)
+
+// The plans are explained with sequential scans disabled, so the planner only scans the
+// table of the small e2e database when no index can serve the query
+var _ = Describe("PostgreSQL - Policy Query Plans", Ordered, func() {
+	var (
+		db   *gorm.DB
+		sql  string
+		vars []any
+	)
+
+	BeforeAll(func() {
+		var err error
+		db, err = store.InitDB(&config.Config{
+			Database: &config.DBConfig{
+				Type:     "pgsql",
+				Hostname: getEnvOrDefault("DB_HOST", "localhost"),
+				Port:     getEnvOrDefault("DB_PORT", "5432"),
+				Name:     getEnvOrDefault("DB_NAME", "policy_manager_test"),
+				User:     getEnvOrDefault("DB_USER", "test_user"),
+				Password: getEnvOrDefault("DB_PASSWORD", "test_password"),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred(), "Failed to connect to the service's database")
+		// Record the query List issues so its plan can be explained
+		Expect(db.Callback().Query().After("gorm:query").Register("capture", func(tx *gorm.DB) {
+			sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+		})).To(Succeed())
+	})
+
+	AfterAll(func() {
+		sqlDB, _ := db.DB()
+		_ = sqlDB.Close()
+	})
+
+	// explain returns the plan of query, explained in a transaction with sequential scans disabled
+	explain := func(query string, vars ...any) string {
+		var plan []string
+		Expect(db.Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SET LOCAL enable_seqscan = off").Error; err != nil {
+				return err
+			}
+			rows, err := tx.Statement.ConnPool.QueryContext(ctx, "EXPLAIN "+query, vars...)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var step string
+				if err := rows.Scan(&step); err != nil {
+					return err
+				}
+				plan = append(plan, step)
+			}
+			return rows.Err()
+		})).To(Succeed())
+		return strings.Join(plan, "\n")
+	}
+
+	DescribeTable("reads policies through an index",
+		func(opts *store.PolicyListOptions) {
+			_, err := store.NewPolicy(db).List(ctx, opts)
+			Expect(err).NotTo(HaveOccurred())
+			plan := explain(sql, vars...)
+			Expect(plan).To(ContainSubstring("Index"))
+			Expect(plan).NotTo(ContainSubstring("Seq Scan on policies"))
+		},
+		Entry("enabled policies in evaluation order",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{Enabled: ptr(true)}, PageSize: 1000}),
+		Entry("policies of a type in evaluation order",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{PolicyType: ptr("GLOBAL")}}),
+		Entry("policies by update time",
+			&store.PolicyListOptions{OrderBy: "update_time DESC"}),
+		Entry("enabled policies by update time",
+			&store.PolicyListOptions{Filter: &store.PolicyFilter{Enabled: ptr(true)}, OrderBy: "update_time DESC"}),
+	)
+
+	It("keeps no index over label selectors, which no query reads", func() {
+		var indexes int64
+		Expect(db.Raw("SELECT COUNT(*) FROM pg_indexes WHERE indexname = 'idx_policies_label_selector'").Scan(&indexes).Error).To(Succeed())
+		Expect(indexes).To(BeZero())
+	})
+})